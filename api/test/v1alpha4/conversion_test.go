@@ -148,6 +148,29 @@ var _ = Describe("FuzzyConversion", Label("api", "fuzz"), func() {
 		})
 	})
 
+	Context("VirtualMachineReplicaSet", func() {
+		BeforeEach(func() {
+			input = fuzztests.FuzzTestFuncInput{
+				Scheme: scheme,
+				Hub:    &vmopv1.VirtualMachineReplicaSet{},
+				Spoke:  &vmopv1a4.VirtualMachineReplicaSet{},
+				FuzzerFuncs: []fuzzer.FuzzerFuncs{
+					overrideVirtualMachineFieldsFuncs,
+				},
+			}
+		})
+		Context("Spoke-Hub-Spoke", func() {
+			It("should get fuzzy with it", func() {
+				fuzztests.SpokeHubSpoke(input)
+			})
+		})
+		Context("Hub-Spoke-Hub", func() {
+			It("should get fuzzy with it", func() {
+				fuzztests.HubSpokeHub(input)
+			})
+		})
+	})
+
 	Context("VirtualMachineService", func() {
 		BeforeEach(func() {
 			input = fuzztests.FuzzTestFuncInput{
@@ -16,6 +16,7 @@ import (
 
 	vmopv1a5 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	vmopv1cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha6/cloudinit"
 	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
 )
 
@@ -46,6 +47,95 @@ func TestVirtualMachineConversion(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "spec.bootstrap.ignition",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: vmopv1common.ValueOrSecretKeySelector{
+									From: &vmopv1common.SecretKeySelector{
+										Name: "my-secret",
+										Key:  "config.ign",
+									},
+								},
+								DisableNetworkConfig: true,
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "spec.bootstrap.cloudInit.cloudConfig modules",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								CloudConfig: &vmopv1cloudinit.CloudConfig{
+									Timezone: "UTC",
+									BootCmd:  []byte(`["echo hello"]`),
+									Packages: []vmopv1cloudinit.Package{
+										{
+											Name:    "nginx",
+											Version: "1.24.0",
+										},
+									},
+									PackageUpdate: true,
+									Apt: &vmopv1cloudinit.Apt{
+										Sources: []vmopv1cloudinit.AptSource{
+											{
+												Name:   "my-repo",
+												Source: "deb http://example.com/ubuntu $RELEASE main",
+												Key: &vmopv1common.ValueOrSecretKeySelector{
+													From: &vmopv1common.SecretKeySelector{
+														Name: "my-secret",
+														Key:  "apt-key",
+													},
+												},
+											},
+										},
+									},
+									YumRepos: []vmopv1cloudinit.YumRepo{
+										{
+											ID:      "my-repo",
+											BaseURL: "http://example.com/el9",
+										},
+									},
+									NTP: &vmopv1cloudinit.NTP{
+										Servers: []string{"ntp.example.com"},
+									},
+									Mounts: []vmopv1cloudinit.Mount{
+										{
+											Device:     "/dev/sdb1",
+											MountPoint: "/data",
+										},
+									},
+									DiskSetup: []vmopv1cloudinit.DiskSetup{
+										{
+											Device:    "/dev/sdb",
+											TableType: vmopv1cloudinit.PartitionTableTypeGPT,
+										},
+									},
+									FSSetup: []vmopv1cloudinit.FSSetup{
+										{
+											Device:     "/dev/sdb",
+											Filesystem: "ext4",
+											Partition:  "1",
+										},
+									},
+									CACerts: &vmopv1cloudinit.CACerts{
+										Trusted: []vmopv1common.ValueOrSecretKeySelector{
+											{
+												Value: ptr.To("my-cert"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			{
 				name: "spec.bootstrap=nil",
 				hub: &vmopv1.VirtualMachine{
@@ -842,13 +842,15 @@ func restore_v1alpha6_VirtualMachineBootstrapSpec(
 
 	dstBootstrap := dst.Spec.Bootstrap
 	if dstBootstrap == nil {
-		// v1a1 doesn't have a way to represent standalone LinuxPrep or Disabled.
-		// If we didn't do a conversion in convert_v1alpha1_VmMetadata_To_v1alpha6_BootstrapSpec()
-		// but we saved a LinuxPrep or Disabled in the conversion annotation, restore that here.
-		if srcBootstrap.LinuxPrep != nil || srcBootstrap.Disabled {
+		// v1a1 doesn't have a way to represent standalone LinuxPrep, Disabled,
+		// or Generation. If we didn't do a conversion in
+		// convert_v1alpha1_VmMetadata_To_v1alpha6_BootstrapSpec() but we saved
+		// one of them in the conversion annotation, restore that here.
+		if srcBootstrap.LinuxPrep != nil || srcBootstrap.Disabled || srcBootstrap.Generation != 0 {
 			dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
-				LinuxPrep: srcBootstrap.LinuxPrep,
-				Disabled:  srcBootstrap.Disabled,
+				LinuxPrep:  srcBootstrap.LinuxPrep,
+				Disabled:   srcBootstrap.Disabled,
+				Generation: srcBootstrap.Generation,
 			}
 		}
 		return
@@ -910,6 +912,7 @@ func restore_v1alpha6_VirtualMachineBootstrapSpec(
 	}
 
 	dstBootstrap.Disabled = srcBootstrap.Disabled
+	dstBootstrap.Generation = srcBootstrap.Generation
 }
 
 func restore_v1alpha6_VirtualMachineNetworkSpec(
@@ -932,6 +935,8 @@ func restore_v1alpha6_VirtualMachineNetworkSpec(
 	dstNetwork.Nameservers = srcNetwork.Nameservers
 	dstNetwork.SearchDomains = srcNetwork.SearchDomains
 	dstNetwork.VLANs = srcNetwork.VLANs
+	dstNetwork.Bonds = srcNetwork.Bonds
+	dstNetwork.Bridges = srcNetwork.Bridges
 
 	if len(dstNetwork.Interfaces) == 0 {
 		// No interfaces so nothing to fixup (the interfaces were removed): we ignore the restored interfaces.
@@ -954,6 +959,11 @@ func restore_v1alpha6_VirtualMachineReadinessProbeSpec(
 			dst.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{}
 		}
 		dst.Spec.ReadinessProbe.GuestInfo = src.Spec.ReadinessProbe.GuestInfo
+		dst.Spec.ReadinessProbe.HTTPGet = src.Spec.ReadinessProbe.HTTPGet
+		dst.Spec.ReadinessProbe.Exec = src.Spec.ReadinessProbe.Exec
+		dst.Spec.ReadinessProbe.InitialDelaySeconds = src.Spec.ReadinessProbe.InitialDelaySeconds
+		dst.Spec.ReadinessProbe.SuccessThreshold = src.Spec.ReadinessProbe.SuccessThreshold
+		dst.Spec.ReadinessProbe.FailureThreshold = src.Spec.ReadinessProbe.FailureThreshold
 	}
 }
 
@@ -1293,6 +1303,18 @@ func restore_v1alpha6_VirtualMachinePolicies(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Policies = slices.Clone(src.Spec.Policies)
 }
 
+func restore_v1alpha6_VirtualMachineLivenessProbe(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.LivenessProbe = src.Spec.LivenessProbe
+}
+
+func restore_v1alpha6_VirtualMachineStartupProbe(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.StartupProbe = src.Spec.StartupProbe
+}
+
+func restore_v1alpha6_VirtualMachineCloneMode(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.CloneMode = src.Spec.CloneMode
+}
+
 func restore_v1alpha6_VirtualMachineVolumeAttributesClassName(dst, src *vmopv1.VirtualMachine) {
 	if src.Spec.VolumeAttributesClassName != "" {
 		dst.Spec.VolumeAttributesClassName = src.Spec.VolumeAttributesClassName
@@ -1334,7 +1356,10 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	restore_v1alpha6_VirtualMachineHardware(dst, restored)
 	restore_v1alpha6_VirtualMachinePolicies(dst, restored)
 	restore_v1alpha6_VirtualMachineVolumeAttributesClassName(dst, restored)
+	restore_v1alpha6_VirtualMachineCloneMode(dst, restored)
 	restore_v1alpha6_VirtualMachineAdvancedProps(dst, restored)
+	restore_v1alpha6_VirtualMachineLivenessProbe(dst, restored)
+	restore_v1alpha6_VirtualMachineStartupProbe(dst, restored)
 
 	// END RESTORE
 
@@ -13,6 +13,7 @@ import (
 	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
 )
@@ -391,6 +392,13 @@ func (src *VirtualMachineImage) ConvertTo(dstRaw ctrlconversion.Hub) error {
 		return err
 	}
 
+	restored := &vmopv1.VirtualMachineImage{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.OCI = restored.Spec.OCI
+
 	return nil
 }
 
@@ -418,7 +426,13 @@ func (dst *VirtualMachineImage) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	}
 	dst.Status.ContentLibraryRef = readContentLibRefConversionAnnotation(src)
 
-	return nil
+	// Only preserve the hub object when it has fields that cannot be
+	// represented in this version.
+	if src.Spec.OCI == nil {
+		return nil
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachineImageList to the Hub version.
@@ -449,6 +463,13 @@ func (src *ClusterVirtualMachineImage) ConvertTo(dstRaw ctrlconversion.Hub) erro
 		return err
 	}
 
+	restored := &vmopv1.ClusterVirtualMachineImage{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.OCI = restored.Spec.OCI
+
 	return nil
 }
 
@@ -470,7 +491,13 @@ func (dst *ClusterVirtualMachineImage) ConvertFrom(srcRaw ctrlconversion.Hub) er
 
 	dst.Status.ContentLibraryRef = readContentLibRefConversionAnnotation(src)
 
-	return nil
+	// Only preserve the hub object when it has fields that cannot be
+	// represented in this version.
+	if src.Spec.OCI == nil {
+		return nil
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 func readContentLibRefConversionAnnotation(from metav1.Object) (objRef *corev1.TypedLocalObjectReference) {
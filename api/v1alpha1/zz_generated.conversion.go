@@ -347,21 +347,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTarget)(nil), (*v1alpha6.VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(a.(*VirtualMachinePublishRequestTarget), b.(*v1alpha6.VirtualMachinePublishRequestTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTargetItem)(nil), (*v1alpha6.VirtualMachinePublishRequestTargetItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(a.(*VirtualMachinePublishRequestTargetItem), b.(*v1alpha6.VirtualMachinePublishRequestTargetItem), scope)
 	}); err != nil {
@@ -427,11 +417,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineServiceStatus)(nil), (*v1alpha6.VirtualMachineServiceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(a.(*VirtualMachineServiceStatus), b.(*v1alpha6.VirtualMachineServiceStatus), scope)
 	}); err != nil {
@@ -562,11 +547,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*Probe)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha1_Probe(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*Probe), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineSetResourcePolicySpec)(nil), (*VirtualMachineSetResourcePolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineSetResourcePolicySpec_To_v1alpha1_VirtualMachineSetResourcePolicySpec(a.(*v1alpha6.VirtualMachineSetResourcePolicySpec), b.(*VirtualMachineSetResourcePolicySpec), scope)
 	}); err != nil {
@@ -1419,6 +1419,7 @@ func autoConvert_v1alpha1_VirtualMachineImageSpec_To_v1alpha6_VirtualMachineImag
 
 func autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha1_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
 	// WARNING: in.ProviderRef requires manual conversion: inconvertible types (*github.com/vmware-tanzu/vm-operator/api/v1alpha6/common.LocalObjectRef vs github.com/vmware-tanzu/vm-operator/api/v1alpha1.ContentProviderReference)
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1642,7 +1643,15 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestSpec_To_v1alpha1_VirtualMa
 
 func autoConvert_v1alpha1_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMachinePublishRequestStatus(in *VirtualMachinePublishRequestStatus, out *v1alpha6.VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*v1alpha6.VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*v1alpha6.VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(v1alpha6.VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha1_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
@@ -1670,12 +1679,21 @@ func Convert_v1alpha1_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMach
 
 func autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
 	out.LastAttemptTime = in.LastAttemptTime
 	out.ImageName = in.ImageName
+	// WARNING: in.ArtifactDigest requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1691,11 +1709,6 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_Virtual
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_VirtualMachinePublishRequestStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha1_VirtualMachinePublishRequestStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(in *VirtualMachinePublishRequestTarget, out *v1alpha6.VirtualMachinePublishRequestTarget, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(&in.Item, &out.Item, s); err != nil {
 		return err
@@ -1718,14 +1731,10 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_Virtual
 	if err := Convert_v1alpha6_VirtualMachinePublishRequestTargetLocation_To_v1alpha1_VirtualMachinePublishRequestTargetLocation(&in.Location, &out.Location, s); err != nil {
 		return err
 	}
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_VirtualMachinePublishRequestTarget is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_VirtualMachinePublishRequestTarget(in *v1alpha6.VirtualMachinePublishRequestTarget, out *VirtualMachinePublishRequestTarget, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha1_VirtualMachinePublishRequestTarget(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(in *VirtualMachinePublishRequestTargetItem, out *v1alpha6.VirtualMachinePublishRequestTargetItem, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Description = in.Description
@@ -1828,7 +1837,17 @@ func Convert_v1alpha6_VirtualMachineService_To_v1alpha1_VirtualMachineService(in
 
 func autoConvert_v1alpha1_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServiceList(in *VirtualMachineServiceList, out *v1alpha6.VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualMachineService_To_v1alpha6_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1839,7 +1858,17 @@ func Convert_v1alpha1_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServic
 
 func autoConvert_v1alpha6_VirtualMachineServiceList_To_v1alpha1_VirtualMachineServiceList(in *v1alpha6.VirtualMachineServiceList, out *VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineService_To_v1alpha1_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1898,14 +1927,16 @@ func autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineSe
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.ClusterIP = in.ClusterIP
 	out.ExternalName = in.ExternalName
+	// WARNING: in.SessionAffinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.SessionAffinityConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExternalTrafficPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheckNodePort requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilies requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilyPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.PublishNotReadyAddresses requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(in *v1alpha6.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(in *VirtualMachineServiceStatus, out *v1alpha6.VirtualMachineServiceStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha1_LoadBalancerStatus_To_v1alpha6_LoadBalancerStatus(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
@@ -2085,6 +2116,7 @@ func autoConvert_v1alpha1_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *
 func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(in *v1alpha6.VirtualMachineSpec, out *VirtualMachineSpec, s conversion.Scope) error {
 	// WARNING: in.Image requires manual conversion: does not exist in peer-type
 	out.ImageName = in.ImageName
+	// WARNING: in.CloneMode requires manual conversion: does not exist in peer-type
 	out.ClassName = in.ClassName
 	// WARNING: in.Class requires manual conversion: does not exist in peer-type
 	// WARNING: in.Affinity requires manual conversion: does not exist in peer-type
@@ -2118,6 +2150,8 @@ func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(in *
 	} else {
 		out.ReadinessProbe = nil
 	}
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	// WARNING: in.Advanced requires manual conversion: does not exist in peer-type
 	// WARNING: in.Reserved requires manual conversion: does not exist in peer-type
 	out.MinHardwareVersion = in.MinHardwareVersion
@@ -2206,6 +2240,10 @@ func autoConvert_v1alpha6_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(
 	out.ChangeBlockTracking = (*bool)(unsafe.Pointer(in.ChangeBlockTracking))
 	out.Zone = in.Zone
 	out.LastRestartTime = (*v1.Time)(unsafe.Pointer(in.LastRestartTime))
+	// WARNING: in.BootstrapGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadinessProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	out.HardwareVersion = in.HardwareVersion
 	// WARNING: in.Storage requires manual conversion: does not exist in peer-type
 	// WARNING: in.Provider requires manual conversion: does not exist in peer-type
@@ -0,0 +1,31 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	"unsafe"
+
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+
+	vmopv1a2cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha2/cloudinit"
+	vmopv1cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha6/cloudinit"
+)
+
+// Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig converts the
+// CloudConfig from v1alpha2 to v1alpha6.
+// Please see https://github.com/kubernetes/code-generator/issues/172 for why
+// this function exists in this directory structure.
+func Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(
+	in *vmopv1a2cloudinit.CloudConfig, out *vmopv1cloudinit.CloudConfig, s apiconversion.Scope) error {
+
+	out.Timezone = in.Timezone
+	out.DefaultUserEnabled = in.DefaultUserEnabled
+	out.Users = *(*[]vmopv1cloudinit.User)(unsafe.Pointer(&in.Users))
+	out.RunCmd = in.RunCmd
+	out.WriteFiles = *(*[]vmopv1cloudinit.WriteFile)(unsafe.Pointer(&in.WriteFiles))
+	out.SSHPwdAuth = in.SSHPwdAuth
+
+	return nil
+}
@@ -14,10 +14,12 @@ import (
 	unsafe "unsafe"
 
 	v1alpha2cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha2/cloudinit"
+	conversionv1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2/cloudinit/conversion/v1alpha2"
+	conversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha2/cloudinit/conversion/v1alpha6"
 	v1alpha2common "github.com/vmware-tanzu/vm-operator/api/v1alpha2/common"
 	v1alpha2sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha2/sysprep"
-	conversionv1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2/sysprep/conversion/v1alpha2"
-	conversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha2/sysprep/conversion/v1alpha6"
+	sysprepconversionv1alpha2 "github.com/vmware-tanzu/vm-operator/api/v1alpha2/sysprep/conversion/v1alpha2"
+	sysprepconversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha2/sysprep/conversion/v1alpha6"
 	v1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha6/cloudinit"
 	common "github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
@@ -446,21 +448,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineGroupSpec)(nil), (*VirtualMachineGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha2_VirtualMachineGroupSpec(a.(*v1alpha6.VirtualMachineGroupSpec), b.(*VirtualMachineGroupSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineGroupStatus)(nil), (*v1alpha6.VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupStatus(a.(*VirtualMachineGroupStatus), b.(*v1alpha6.VirtualMachineGroupStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineGroupStatus)(nil), (*VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha2_VirtualMachineGroupStatus(a.(*v1alpha6.VirtualMachineGroupStatus), b.(*VirtualMachineGroupStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineImage)(nil), (*v1alpha6.VirtualMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(a.(*VirtualMachineImage), b.(*v1alpha6.VirtualMachineImage), scope)
 	}); err != nil {
@@ -506,11 +498,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineImageSpec)(nil), (*VirtualMachineImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha2_VirtualMachineImageSpec(a.(*v1alpha6.VirtualMachineImageSpec), b.(*VirtualMachineImageSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineImageStatus)(nil), (*v1alpha6.VirtualMachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineImageStatus_To_v1alpha6_VirtualMachineImageStatus(a.(*VirtualMachineImageStatus), b.(*v1alpha6.VirtualMachineImageStatus), scope)
 	}); err != nil {
@@ -576,11 +563,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineNetworkConfigStatus)(nil), (*VirtualMachineNetworkConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus(a.(*v1alpha6.VirtualMachineNetworkConfigStatus), b.(*VirtualMachineNetworkConfigStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineNetworkDHCPOptionsStatus)(nil), (*v1alpha6.VirtualMachineNetworkDHCPOptionsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineNetworkDHCPOptionsStatus_To_v1alpha6_VirtualMachineNetworkDHCPOptionsStatus(a.(*VirtualMachineNetworkDHCPOptionsStatus), b.(*v1alpha6.VirtualMachineNetworkDHCPOptionsStatus), scope)
 	}); err != nil {
@@ -761,21 +743,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha2_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTarget)(nil), (*v1alpha6.VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(a.(*VirtualMachinePublishRequestTarget), b.(*v1alpha6.VirtualMachinePublishRequestTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTargetItem)(nil), (*v1alpha6.VirtualMachinePublishRequestTargetItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(a.(*VirtualMachinePublishRequestTargetItem), b.(*v1alpha6.VirtualMachinePublishRequestTargetItem), scope)
 	}); err != nil {
@@ -801,11 +773,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*VirtualMachineReadinessProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMachineReadinessProbeSpec(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*VirtualMachineReadinessProbeSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineReservedSpec)(nil), (*v1alpha6.VirtualMachineReservedSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineReservedSpec_To_v1alpha6_VirtualMachineReservedSpec(a.(*VirtualMachineReservedSpec), b.(*v1alpha6.VirtualMachineReservedSpec), scope)
 	}); err != nil {
@@ -861,11 +828,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha2_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineServiceStatus)(nil), (*v1alpha6.VirtualMachineServiceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(a.(*VirtualMachineServiceStatus), b.(*v1alpha6.VirtualMachineServiceStatus), scope)
 	}); err != nil {
@@ -971,11 +933,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineWebConsoleRequestSpec)(nil), (*VirtualMachineWebConsoleRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha2_VirtualMachineWebConsoleRequestSpec(a.(*v1alpha6.VirtualMachineWebConsoleRequestSpec), b.(*VirtualMachineWebConsoleRequestSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineWebConsoleRequestStatus)(nil), (*v1alpha6.VirtualMachineWebConsoleRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VirtualMachineWebConsoleRequestStatus_To_v1alpha6_VirtualMachineWebConsoleRequestStatus(a.(*VirtualMachineWebConsoleRequestStatus), b.(*v1alpha6.VirtualMachineWebConsoleRequestStatus), scope)
 	}); err != nil {
@@ -1036,6 +993,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineGroupSpec)(nil), (*VirtualMachineGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha2_VirtualMachineGroupSpec(a.(*v1alpha6.VirtualMachineGroupSpec), b.(*VirtualMachineGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineGroupStatus)(nil), (*VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha2_VirtualMachineGroupStatus(a.(*v1alpha6.VirtualMachineGroupStatus), b.(*VirtualMachineGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineImageSpec)(nil), (*VirtualMachineImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha2_VirtualMachineImageSpec(a.(*v1alpha6.VirtualMachineImageSpec), b.(*VirtualMachineImageSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineImageStatus)(nil), (*VirtualMachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineImageStatus_To_v1alpha2_VirtualMachineImageStatus(a.(*v1alpha6.VirtualMachineImageStatus), b.(*VirtualMachineImageStatus), scope)
 	}); err != nil {
@@ -1046,6 +1018,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineNetworkConfigStatus)(nil), (*VirtualMachineNetworkConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus(a.(*v1alpha6.VirtualMachineNetworkConfigStatus), b.(*VirtualMachineNetworkConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineNetworkInterfaceSpec)(nil), (*VirtualMachineNetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(a.(*v1alpha6.VirtualMachineNetworkInterfaceSpec), b.(*VirtualMachineNetworkInterfaceSpec), scope)
 	}); err != nil {
@@ -1061,6 +1038,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha2_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*VirtualMachineReadinessProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMachineReadinessProbeSpec(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*VirtualMachineReadinessProbeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha2_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineSpec)(nil), (*VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(a.(*v1alpha6.VirtualMachineSpec), b.(*VirtualMachineSpec), scope)
 	}); err != nil {
@@ -1081,6 +1078,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineWebConsoleRequestSpec)(nil), (*VirtualMachineWebConsoleRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha2_VirtualMachineWebConsoleRequestSpec(a.(*v1alpha6.VirtualMachineWebConsoleRequestSpec), b.(*VirtualMachineWebConsoleRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachine)(nil), (*VirtualMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachine_To_v1alpha2_VirtualMachine(a.(*v1alpha6.VirtualMachine), b.(*VirtualMachine), scope)
 	}); err != nil {
@@ -1723,7 +1725,15 @@ func autoConvert_v1alpha6_VirtualMachineAdvancedSpec_To_v1alpha2_VirtualMachineA
 }
 
 func autoConvert_v1alpha2_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMachineBootstrapCloudInitSpec(in *VirtualMachineBootstrapCloudInitSpec, out *v1alpha6.VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
-	out.CloudConfig = (*cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(cloudinit.CloudConfig)
+		if err := conversionv1alpha2.Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudConfig = nil
+	}
 	out.RawCloudConfig = (*common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
 	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
 	out.UseGlobalNameserversAsDefault = (*bool)(unsafe.Pointer(in.UseGlobalNameserversAsDefault))
@@ -1738,7 +1748,15 @@ func Convert_v1alpha2_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMa
 
 func autoConvert_v1alpha6_VirtualMachineBootstrapCloudInitSpec_To_v1alpha2_VirtualMachineBootstrapCloudInitSpec(in *v1alpha6.VirtualMachineBootstrapCloudInitSpec, out *VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
 	// WARNING: in.InstanceID requires manual conversion: does not exist in peer-type
-	out.CloudConfig = (*v1alpha2cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(v1alpha2cloudinit.CloudConfig)
+		if err := conversionv1alpha6.Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudConfig = nil
+	}
 	out.RawCloudConfig = (*v1alpha2common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
 	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
 	out.UseGlobalNameserversAsDefault = (*bool)(unsafe.Pointer(in.UseGlobalNameserversAsDefault))
@@ -1835,7 +1853,9 @@ func autoConvert_v1alpha6_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachine
 		out.Sysprep = nil
 	}
 	out.VAppConfig = (*VirtualMachineBootstrapVAppConfigSpec)(unsafe.Pointer(in.VAppConfig))
+	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
 	// WARNING: in.Disabled requires manual conversion: does not exist in peer-type
+	// WARNING: in.Generation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1843,7 +1863,7 @@ func autoConvert_v1alpha2_VirtualMachineBootstrapSysprepSpec_To_v1alpha6_Virtual
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(sysprep.Sysprep)
-		if err := conversionv1alpha2.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
+		if err := sysprepconversionv1alpha2.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -1862,7 +1882,7 @@ func autoConvert_v1alpha6_VirtualMachineBootstrapSysprepSpec_To_v1alpha2_Virtual
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(v1alpha2sysprep.Sysprep)
-		if err := conversionv1alpha6.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
+		if err := sysprepconversionv1alpha6.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -2169,7 +2189,17 @@ func Convert_v1alpha6_VirtualMachineGroupBootOrderGroup_To_v1alpha2_VirtualMachi
 
 func autoConvert_v1alpha2_VirtualMachineGroupList_To_v1alpha6_VirtualMachineGroupList(in *VirtualMachineGroupList, out *v1alpha6.VirtualMachineGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineGroup)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VirtualMachineGroup_To_v1alpha6_VirtualMachineGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2180,7 +2210,17 @@ func Convert_v1alpha2_VirtualMachineGroupList_To_v1alpha6_VirtualMachineGroupLis
 
 func autoConvert_v1alpha6_VirtualMachineGroupList_To_v1alpha2_VirtualMachineGroupList(in *v1alpha6.VirtualMachineGroupList, out *VirtualMachineGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineGroup)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineGroup_To_v1alpha2_VirtualMachineGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2271,14 +2311,10 @@ func autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha2_VirtualMachineGrou
 	out.NextForcePowerStateSyncTime = in.NextForcePowerStateSyncTime
 	out.PowerOffMode = VirtualMachinePowerOpMode(in.PowerOffMode)
 	out.SuspendMode = VirtualMachinePowerOpMode(in.SuspendMode)
+	// WARNING: in.CurrentSnapshotName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha2_VirtualMachineGroupSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha2_VirtualMachineGroupSpec(in *v1alpha6.VirtualMachineGroupSpec, out *VirtualMachineGroupSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha2_VirtualMachineGroupSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupStatus(in *VirtualMachineGroupStatus, out *v1alpha6.VirtualMachineGroupStatus, s conversion.Scope) error {
 	out.Members = *(*[]v1alpha6.VirtualMachineGroupMemberStatus)(unsafe.Pointer(&in.Members))
 	out.LastUpdatedPowerStateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdatedPowerStateTime))
@@ -2294,15 +2330,11 @@ func Convert_v1alpha2_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupS
 func autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha2_VirtualMachineGroupStatus(in *v1alpha6.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s conversion.Scope) error {
 	out.Members = *(*[]VirtualMachineGroupMemberStatus)(unsafe.Pointer(&in.Members))
 	out.LastUpdatedPowerStateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdatedPowerStateTime))
+	// WARNING: in.CurrentSnapshotName requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha2_VirtualMachineGroupStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha2_VirtualMachineGroupStatus(in *v1alpha6.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha2_VirtualMachineGroupStatus(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(in *VirtualMachineImage, out *v1alpha6.VirtualMachineImage, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_VirtualMachineImageSpec_To_v1alpha6_VirtualMachineImageSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2439,14 +2471,10 @@ func Convert_v1alpha2_VirtualMachineImageSpec_To_v1alpha6_VirtualMachineImageSpe
 
 func autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha2_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
 	out.ProviderRef = (*v1alpha2common.LocalObjectRef)(unsafe.Pointer(in.ProviderRef))
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha2_VirtualMachineImageSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha2_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha2_VirtualMachineImageSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineImageStatus_To_v1alpha6_VirtualMachineImageStatus(in *VirtualMachineImageStatus, out *v1alpha6.VirtualMachineImageStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Capabilities = *(*[]string)(unsafe.Pointer(&in.Capabilities))
@@ -2712,14 +2740,11 @@ func autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualM
 	} else {
 		out.DNS = nil
 	}
+	// WARNING: in.Bonds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bridges requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus(in *v1alpha6.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineNetworkDHCPOptionsStatus_To_v1alpha6_VirtualMachineNetworkDHCPOptionsStatus(in *VirtualMachineNetworkDHCPOptionsStatus, out *v1alpha6.VirtualMachineNetworkDHCPOptionsStatus, s conversion.Scope) error {
 	out.Config = *(*[]common.KeyValuePair)(unsafe.Pointer(&in.Config))
 	out.Enabled = in.Enabled
@@ -2954,6 +2979,7 @@ func autoConvert_v1alpha6_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_Virtual
 	out.GuestDeviceName = in.GuestDeviceName
 	out.MACAddr = in.MACAddr
 	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	// WARNING: in.IPPoolName requires manual conversion: does not exist in peer-type
 	out.DHCP4 = in.DHCP4
 	out.DHCP6 = in.DHCP6
 	out.Gateway4 = in.Gateway4
@@ -3081,6 +3107,8 @@ func autoConvert_v1alpha6_VirtualMachineNetworkSpec_To_v1alpha2_VirtualMachineNe
 		out.Interfaces = nil
 	}
 	// WARNING: in.VLANs requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bonds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bridges requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -3284,7 +3312,15 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestSpec_To_v1alpha2_VirtualMa
 
 func autoConvert_v1alpha2_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMachinePublishRequestStatus(in *VirtualMachinePublishRequestStatus, out *v1alpha6.VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*v1alpha6.VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*v1alpha6.VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(v1alpha6.VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha2_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
@@ -3302,22 +3338,26 @@ func Convert_v1alpha2_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMach
 
 func autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha2_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
 	out.LastAttemptTime = in.LastAttemptTime
 	out.ImageName = in.ImageName
+	// WARNING: in.ArtifactDigest requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha2_VirtualMachinePublishRequestStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha2_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha2_VirtualMachinePublishRequestStatus(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(in *VirtualMachinePublishRequestTarget, out *v1alpha6.VirtualMachinePublishRequestTarget, s conversion.Scope) error {
 	if err := Convert_v1alpha2_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(&in.Item, &out.Item, s); err != nil {
 		return err
@@ -3340,14 +3380,10 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_Virtual
 	if err := Convert_v1alpha6_VirtualMachinePublishRequestTargetLocation_To_v1alpha2_VirtualMachinePublishRequestTargetLocation(&in.Location, &out.Location, s); err != nil {
 		return err
 	}
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_VirtualMachinePublishRequestTarget is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_VirtualMachinePublishRequestTarget(in *v1alpha6.VirtualMachinePublishRequestTarget, out *VirtualMachinePublishRequestTarget, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha2_VirtualMachinePublishRequestTarget(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(in *VirtualMachinePublishRequestTargetItem, out *v1alpha6.VirtualMachinePublishRequestTargetItem, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Description = in.Description
@@ -3412,16 +3448,16 @@ func autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMa
 	out.TCPSocket = (*TCPSocketAction)(unsafe.Pointer(in.TCPSocket))
 	out.GuestHeartbeat = (*GuestHeartbeatAction)(unsafe.Pointer(in.GuestHeartbeat))
 	out.GuestInfo = *(*[]GuestInfoAction)(unsafe.Pointer(&in.GuestInfo))
+	// WARNING: in.HTTPGet requires manual conversion: does not exist in peer-type
+	// WARNING: in.Exec requires manual conversion: does not exist in peer-type
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.PeriodSeconds = in.PeriodSeconds
+	// WARNING: in.InitialDelaySeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.SuccessThreshold requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureThreshold requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMachineReadinessProbeSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMachineReadinessProbeSpec(in *v1alpha6.VirtualMachineReadinessProbeSpec, out *VirtualMachineReadinessProbeSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMachineReadinessProbeSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineReservedSpec_To_v1alpha6_VirtualMachineReservedSpec(in *VirtualMachineReservedSpec, out *v1alpha6.VirtualMachineReservedSpec, s conversion.Scope) error {
 	out.ResourcePolicyName = in.ResourcePolicyName
 	return nil
@@ -3498,7 +3534,17 @@ func Convert_v1alpha6_VirtualMachineService_To_v1alpha2_VirtualMachineService(in
 
 func autoConvert_v1alpha2_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServiceList(in *VirtualMachineServiceList, out *v1alpha6.VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VirtualMachineService_To_v1alpha6_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3509,7 +3555,17 @@ func Convert_v1alpha2_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServic
 
 func autoConvert_v1alpha6_VirtualMachineServiceList_To_v1alpha2_VirtualMachineServiceList(in *v1alpha6.VirtualMachineServiceList, out *VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineService_To_v1alpha2_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3568,14 +3624,16 @@ func autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha2_VirtualMachineSe
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.ClusterIP = in.ClusterIP
 	out.ExternalName = in.ExternalName
+	// WARNING: in.SessionAffinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.SessionAffinityConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExternalTrafficPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheckNodePort requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilies requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilyPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.PublishNotReadyAddresses requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha2_VirtualMachineServiceSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha2_VirtualMachineServiceSpec(in *v1alpha6.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha2_VirtualMachineServiceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(in *VirtualMachineServiceStatus, out *v1alpha6.VirtualMachineServiceStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha2_LoadBalancerStatus_To_v1alpha6_LoadBalancerStatus(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
@@ -3752,7 +3810,15 @@ func autoConvert_v1alpha2_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *
 	} else {
 		out.Volumes = nil
 	}
-	out.ReadinessProbe = (*v1alpha6.VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1alpha6.VirtualMachineReadinessProbeSpec)
+		if err := Convert_v1alpha2_VirtualMachineReadinessProbeSpec_To_v1alpha6_VirtualMachineReadinessProbeSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ReadinessProbe = nil
+	}
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(v1alpha6.VirtualMachineAdvancedSpec)
@@ -3776,6 +3842,7 @@ func Convert_v1alpha2_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *Virt
 func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(in *v1alpha6.VirtualMachineSpec, out *VirtualMachineSpec, s conversion.Scope) error {
 	// WARNING: in.Image requires manual conversion: does not exist in peer-type
 	out.ImageName = in.ImageName
+	// WARNING: in.CloneMode requires manual conversion: does not exist in peer-type
 	out.ClassName = in.ClassName
 	// WARNING: in.Class requires manual conversion: does not exist in peer-type
 	out.Affinity = (*AffinitySpec)(unsafe.Pointer(in.Affinity))
@@ -3824,7 +3891,17 @@ func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(in *
 	} else {
 		out.Volumes = nil
 	}
-	out.ReadinessProbe = (*VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(VirtualMachineReadinessProbeSpec)
+		if err := Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha2_VirtualMachineReadinessProbeSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ReadinessProbe = nil
+	}
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(VirtualMachineAdvancedSpec)
@@ -3916,6 +3993,10 @@ func autoConvert_v1alpha6_VirtualMachineStatus_To_v1alpha2_VirtualMachineStatus(
 	out.ChangeBlockTracking = (*bool)(unsafe.Pointer(in.ChangeBlockTracking))
 	out.Zone = in.Zone
 	out.LastRestartTime = (*v1.Time)(unsafe.Pointer(in.LastRestartTime))
+	// WARNING: in.BootstrapGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadinessProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	out.HardwareVersion = in.HardwareVersion
 	// WARNING: in.Storage requires manual conversion: does not exist in peer-type
 	// WARNING: in.Provider requires manual conversion: does not exist in peer-type
@@ -4094,7 +4175,17 @@ func Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha2_VirtualMachine
 
 func autoConvert_v1alpha2_VirtualMachineWebConsoleRequestList_To_v1alpha6_VirtualMachineWebConsoleRequestList(in *VirtualMachineWebConsoleRequestList, out *v1alpha6.VirtualMachineWebConsoleRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineWebConsoleRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineWebConsoleRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VirtualMachineWebConsoleRequest_To_v1alpha6_VirtualMachineWebConsoleRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4105,7 +4196,17 @@ func Convert_v1alpha2_VirtualMachineWebConsoleRequestList_To_v1alpha6_VirtualMac
 
 func autoConvert_v1alpha6_VirtualMachineWebConsoleRequestList_To_v1alpha2_VirtualMachineWebConsoleRequestList(in *v1alpha6.VirtualMachineWebConsoleRequestList, out *VirtualMachineWebConsoleRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineWebConsoleRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineWebConsoleRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha2_VirtualMachineWebConsoleRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4128,14 +4229,11 @@ func Convert_v1alpha2_VirtualMachineWebConsoleRequestSpec_To_v1alpha6_VirtualMac
 func autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha2_VirtualMachineWebConsoleRequestSpec(in *v1alpha6.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.PublicKey = in.PublicKey
+	// WARNING: in.TTLSeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Revoked requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha2_VirtualMachineWebConsoleRequestSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha2_VirtualMachineWebConsoleRequestSpec(in *v1alpha6.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha2_VirtualMachineWebConsoleRequestSpec(in, out, s)
-}
-
 func autoConvert_v1alpha2_VirtualMachineWebConsoleRequestStatus_To_v1alpha6_VirtualMachineWebConsoleRequestStatus(in *VirtualMachineWebConsoleRequestStatus, out *v1alpha6.VirtualMachineWebConsoleRequestStatus, s conversion.Scope) error {
 	out.Response = in.Response
 	out.ExpiryTime = in.ExpiryTime
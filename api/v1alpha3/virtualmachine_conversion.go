@@ -405,6 +405,52 @@ func restore_v1alpha6_VirtualMachineBootstrapDisabled(dst, src *vmopv1.VirtualMa
 	}
 }
 
+func restore_v1alpha6_VirtualMachineBootstrapGeneration(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil {
+		if bs.Generation != 0 {
+			if dst.Spec.Bootstrap == nil {
+				dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{}
+			}
+			dst.Spec.Bootstrap.Generation = bs.Generation
+		}
+	}
+}
+
+func restore_v1alpha6_VirtualMachineBootstrapIgnition(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil {
+		if bs.Ignition != nil {
+			if dst.Spec.Bootstrap == nil {
+				dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{}
+			}
+			dst.Spec.Bootstrap.Ignition = bs.Ignition
+		}
+	}
+}
+
+func restore_v1alpha6_VirtualMachineBootstrapCloudInitCloudConfig(dst, src *vmopv1.VirtualMachine) {
+	srcBS, dstBS := src.Spec.Bootstrap, dst.Spec.Bootstrap
+	if srcBS == nil || srcBS.CloudInit == nil || srcBS.CloudInit.CloudConfig == nil {
+		return
+	}
+	if dstBS == nil || dstBS.CloudInit == nil || dstBS.CloudInit.CloudConfig == nil {
+		return
+	}
+
+	in, out := srcBS.CloudInit.CloudConfig, dstBS.CloudInit.CloudConfig
+	out.BootCmd = in.BootCmd
+	out.Packages = in.Packages
+	out.PackageUpdate = in.PackageUpdate
+	out.PackageUpgrade = in.PackageUpgrade
+	out.PackageRebootIfRequired = in.PackageRebootIfRequired
+	out.Apt = in.Apt
+	out.YumRepos = in.YumRepos
+	out.NTP = in.NTP
+	out.Mounts = in.Mounts
+	out.DiskSetup = in.DiskSetup
+	out.FSSetup = in.FSSetup
+	out.CACerts = in.CACerts
+}
+
 func restore_v1alpha6_VirtualMachinePolicies(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Policies = slices.Clone(src.Spec.Policies)
 }
@@ -423,6 +469,40 @@ func restore_v1alpha6_VirtualMachineAffinity(dst, src *vmopv1.VirtualMachine) {
 	}
 }
 
+// Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec drops
+// fields that do not exist in v1alpha3; they are preserved via MarshalData on ConvertFrom.
+func Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(
+	in *vmopv1.VirtualMachineReadinessProbeSpec, out *VirtualMachineReadinessProbeSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(in, out, s)
+}
+
+func restore_v1alpha6_VirtualMachineReadinessProbe(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.ReadinessProbe == nil {
+		return
+	}
+	if dst.Spec.ReadinessProbe == nil {
+		dst.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{}
+	}
+	dst.Spec.ReadinessProbe.HTTPGet = src.Spec.ReadinessProbe.HTTPGet
+	dst.Spec.ReadinessProbe.Exec = src.Spec.ReadinessProbe.Exec
+	dst.Spec.ReadinessProbe.InitialDelaySeconds = src.Spec.ReadinessProbe.InitialDelaySeconds
+	dst.Spec.ReadinessProbe.SuccessThreshold = src.Spec.ReadinessProbe.SuccessThreshold
+	dst.Spec.ReadinessProbe.FailureThreshold = src.Spec.ReadinessProbe.FailureThreshold
+}
+
+func restore_v1alpha6_VirtualMachineLivenessProbe(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.LivenessProbe = src.Spec.LivenessProbe
+}
+
+func restore_v1alpha6_VirtualMachineStartupProbe(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.StartupProbe = src.Spec.StartupProbe
+}
+
+func restore_v1alpha6_VirtualMachineCloneMode(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.CloneMode = src.Spec.CloneMode
+}
+
 func restore_v1alpha6_VirtualMachineVolumeAttributesClassName(dst, src *vmopv1.VirtualMachine) {
 	if src.Spec.VolumeAttributesClassName != "" {
 		dst.Spec.VolumeAttributesClassName = src.Spec.VolumeAttributesClassName
@@ -440,6 +520,71 @@ func restore_v1alpha6_VirtualMachineNetworkVLANs(dst, src *vmopv1.VirtualMachine
 	dst.Spec.Network.VLANs = src.Spec.Network.VLANs
 }
 
+func restore_v1alpha6_VirtualMachineNetworkBondsAndBridges(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil ||
+		(len(src.Spec.Network.Bonds) == 0 && len(src.Spec.Network.Bridges) == 0) {
+
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.Bonds = src.Spec.Network.Bonds
+	dst.Spec.Network.Bridges = src.Spec.Network.Bridges
+}
+
+func restore_v1alpha6_VirtualMachineNetworkInterfaceIPPoolName(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || dst.Spec.Network == nil {
+		return
+	}
+	ipPoolNames := map[string]string{}
+	for i := range src.Spec.Network.Interfaces {
+		if n := src.Spec.Network.Interfaces[i].IPPoolName; n != "" {
+			ipPoolNames[src.Spec.Network.Interfaces[i].Name] = n
+		}
+	}
+	for i := range dst.Spec.Network.Interfaces {
+		dst.Spec.Network.Interfaces[i].IPPoolName = ipPoolNames[dst.Spec.Network.Interfaces[i].Name]
+	}
+}
+
+func Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(
+	in *vmopv1.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(in, out, s)
+}
+
+// restore_v1alpha6_VirtualMachineSpec restores the fields of the spec that do
+// not exist in v1alpha3.
+func restore_v1alpha6_VirtualMachineSpec(dst, src *vmopv1.VirtualMachine) {
+	restore_v1alpha6_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, src)
+	restore_v1alpha6_VirtualMachineBootstrapLinuxPrep(dst, src)
+	restore_v1alpha6_VirtualMachineBootstrapSysprep(dst, src)
+	restore_v1alpha6_VirtualMachineBootstrapDisabled(dst, src)
+	restore_v1alpha6_VirtualMachineBootstrapGeneration(dst, src)
+	restore_v1alpha6_VirtualMachineBootstrapIgnition(dst, src)
+	restore_v1alpha6_VirtualMachineBootstrapCloudInitCloudConfig(dst, src)
+	restore_v1alpha6_VirtualMachinePromoteDisksMode(dst, src)
+	restore_v1alpha6_VirtualMachineBootOptions(dst, src)
+	restore_v1alpha6_VirtualMachineVolumes(dst, src)
+	restore_v1alpha6_VirtualMachineHardware(dst, src)
+	restore_v1alpha6_VirtualMachinePolicies(dst, src)
+	restore_v1alpha6_VirtualMachineCryptoVTPM(dst, src)
+	restore_v1alpha6_VirtualMachineAffinity(dst, src)
+	restore_v1alpha6_VirtualMachineCryptoVTPM(dst, src)
+	restore_v1alpha6_VirtualMachineVolumeAttributesClassName(dst, src)
+	restore_v1alpha6_VirtualMachineCloneMode(dst, src)
+	restore_v1alpha6_VirtualMachineNetworkVLANs(dst, src)
+	restore_v1alpha6_VirtualMachineNetworkBondsAndBridges(dst, src)
+	restore_v1alpha6_VirtualMachineNetworkInterfaceIPPoolName(dst, src)
+	restore_v1alpha6_VirtualMachineAdvancedProps(dst, src)
+	restore_v1alpha6_VirtualMachineNetworkInterfaceAdvancedProps(dst, src)
+	restore_v1alpha6_VirtualMachineReadinessProbe(dst, src)
+	restore_v1alpha6_VirtualMachineLivenessProbe(dst, src)
+	restore_v1alpha6_VirtualMachineStartupProbe(dst, src)
+}
+
 // ConvertTo converts this VirtualMachine to the Hub version.
 func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachine)
@@ -455,22 +600,7 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 
 	// BEGIN RESTORE
 
-	restore_v1alpha6_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, restored)
-	restore_v1alpha6_VirtualMachineBootstrapLinuxPrep(dst, restored)
-	restore_v1alpha6_VirtualMachineBootstrapSysprep(dst, restored)
-	restore_v1alpha6_VirtualMachineBootstrapDisabled(dst, restored)
-	restore_v1alpha6_VirtualMachinePromoteDisksMode(dst, restored)
-	restore_v1alpha6_VirtualMachineBootOptions(dst, restored)
-	restore_v1alpha6_VirtualMachineVolumes(dst, restored)
-	restore_v1alpha6_VirtualMachineHardware(dst, restored)
-	restore_v1alpha6_VirtualMachinePolicies(dst, restored)
-	restore_v1alpha6_VirtualMachineCryptoVTPM(dst, restored)
-	restore_v1alpha6_VirtualMachineAffinity(dst, restored)
-	restore_v1alpha6_VirtualMachineCryptoVTPM(dst, restored)
-	restore_v1alpha6_VirtualMachineVolumeAttributesClassName(dst, restored)
-	restore_v1alpha6_VirtualMachineNetworkVLANs(dst, restored)
-	restore_v1alpha6_VirtualMachineAdvancedProps(dst, restored)
-	restore_v1alpha6_VirtualMachineNetworkInterfaceAdvancedProps(dst, restored)
+	restore_v1alpha6_VirtualMachineSpec(dst, restored)
 
 	// END RESTORE
 
@@ -5,21 +5,58 @@
 package v1alpha3
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
+
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 )
 
+func Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec(
+	in *vmopv1.VirtualMachineGroupSpec, out *VirtualMachineGroupSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec(in, out, s)
+}
+
+func Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(
+	in *vmopv1.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(in, out, s)
+}
+
+func restore_v1alpha6_VirtualMachineGroupCurrentSnapshotName(dst, src *vmopv1.VirtualMachineGroup) {
+	dst.Spec.CurrentSnapshotName = src.Spec.CurrentSnapshotName
+	dst.Status.CurrentSnapshotName = src.Status.CurrentSnapshotName
+}
+
 // ConvertTo converts this VirtualMachineGroup to the Hub version.
 func (src *VirtualMachineGroup) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineGroup)
-	return Convert_v1alpha3_VirtualMachineGroup_To_v1alpha6_VirtualMachineGroup(src, dst, nil)
+	if err := Convert_v1alpha3_VirtualMachineGroup_To_v1alpha6_VirtualMachineGroup(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &vmopv1.VirtualMachineGroup{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restore_v1alpha6_VirtualMachineGroupCurrentSnapshotName(dst, restored)
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineGroup.
 func (dst *VirtualMachineGroup) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachineGroup)
-	return Convert_v1alpha6_VirtualMachineGroup_To_v1alpha3_VirtualMachineGroup(src, dst, nil)
+	if err := Convert_v1alpha6_VirtualMachineGroup_To_v1alpha3_VirtualMachineGroup(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachineGroupList to the Hub version.
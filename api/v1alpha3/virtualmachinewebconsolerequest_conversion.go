@@ -5,21 +5,39 @@
 package v1alpha3
 
 import (
+	"k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 )
 
 // ConvertTo converts this VirtualMachineWebConsoleRequest to the Hub version.
 func (src *VirtualMachineWebConsoleRequest) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineWebConsoleRequest)
-	return Convert_v1alpha3_VirtualMachineWebConsoleRequest_To_v1alpha6_VirtualMachineWebConsoleRequest(src, dst, nil)
+	if err := Convert_v1alpha3_VirtualMachineWebConsoleRequest_To_v1alpha6_VirtualMachineWebConsoleRequest(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &vmopv1.VirtualMachineWebConsoleRequest{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.TTLSeconds = restored.Spec.TTLSeconds
+	dst.Spec.Revoked = restored.Spec.Revoked
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineWebConsoleRequest.
 func (dst *VirtualMachineWebConsoleRequest) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachineWebConsoleRequest)
-	return Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha3_VirtualMachineWebConsoleRequest(src, dst, nil)
+	if err := Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha3_VirtualMachineWebConsoleRequest(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachineWebConsoleRequestList to the Hub version.
@@ -33,3 +51,9 @@ func (dst *VirtualMachineWebConsoleRequestList) ConvertFrom(srcRaw ctrlconversio
 	src := srcRaw.(*vmopv1.VirtualMachineWebConsoleRequestList)
 	return Convert_v1alpha6_VirtualMachineWebConsoleRequestList_To_v1alpha3_VirtualMachineWebConsoleRequestList(src, dst, nil)
 }
+
+func Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(
+	in *vmopv1.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(in, out, s)
+}
@@ -14,12 +14,14 @@ import (
 	unsafe "unsafe"
 
 	v1alpha3cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha3/cloudinit"
+	conversionv1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/cloudinit/conversion/v1alpha3"
+	conversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/cloudinit/conversion/v1alpha6"
 	v1alpha3common "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common"
 	commonconversionv1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common/conversion/v1alpha3"
 	commonconversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/common/conversion/v1alpha6"
 	v1alpha3sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep"
-	conversionv1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep/conversion/v1alpha3"
-	conversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep/conversion/v1alpha6"
+	sysprepconversionv1alpha3 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep/conversion/v1alpha3"
+	sysprepconversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha3/sysprep/conversion/v1alpha6"
 	v1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha6/cloudinit"
 	common "github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
@@ -463,21 +465,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineGroupSpec)(nil), (*VirtualMachineGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec(a.(*v1alpha6.VirtualMachineGroupSpec), b.(*VirtualMachineGroupSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineGroupStatus)(nil), (*v1alpha6.VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupStatus(a.(*VirtualMachineGroupStatus), b.(*v1alpha6.VirtualMachineGroupStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineGroupStatus)(nil), (*VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(a.(*v1alpha6.VirtualMachineGroupStatus), b.(*VirtualMachineGroupStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineImage)(nil), (*v1alpha6.VirtualMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(a.(*VirtualMachineImage), b.(*v1alpha6.VirtualMachineImage), scope)
 	}); err != nil {
@@ -613,11 +605,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineImageSpec)(nil), (*VirtualMachineImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha3_VirtualMachineImageSpec(a.(*v1alpha6.VirtualMachineImageSpec), b.(*VirtualMachineImageSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineImageStatus)(nil), (*v1alpha6.VirtualMachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineImageStatus_To_v1alpha6_VirtualMachineImageStatus(a.(*VirtualMachineImageStatus), b.(*v1alpha6.VirtualMachineImageStatus), scope)
 	}); err != nil {
@@ -693,11 +680,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineNetworkConfigStatus)(nil), (*VirtualMachineNetworkConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(a.(*v1alpha6.VirtualMachineNetworkConfigStatus), b.(*VirtualMachineNetworkConfigStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineNetworkDHCPOptionsStatus)(nil), (*v1alpha6.VirtualMachineNetworkDHCPOptionsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineNetworkDHCPOptionsStatus_To_v1alpha6_VirtualMachineNetworkDHCPOptionsStatus(a.(*VirtualMachineNetworkDHCPOptionsStatus), b.(*v1alpha6.VirtualMachineNetworkDHCPOptionsStatus), scope)
 	}); err != nil {
@@ -878,21 +860,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha3_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTarget)(nil), (*v1alpha6.VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(a.(*VirtualMachinePublishRequestTarget), b.(*v1alpha6.VirtualMachinePublishRequestTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTargetItem)(nil), (*v1alpha6.VirtualMachinePublishRequestTargetItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(a.(*VirtualMachinePublishRequestTargetItem), b.(*v1alpha6.VirtualMachinePublishRequestTargetItem), scope)
 	}); err != nil {
@@ -918,11 +890,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*VirtualMachineReadinessProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*VirtualMachineReadinessProbeSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineReplicaSet)(nil), (*v1alpha6.VirtualMachineReplicaSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineReplicaSet_To_v1alpha6_VirtualMachineReplicaSet(a.(*VirtualMachineReplicaSet), b.(*v1alpha6.VirtualMachineReplicaSet), scope)
 	}); err != nil {
@@ -958,11 +925,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineReplicaSetStatus)(nil), (*VirtualMachineReplicaSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha3_VirtualMachineReplicaSetStatus(a.(*v1alpha6.VirtualMachineReplicaSetStatus), b.(*VirtualMachineReplicaSetStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineReservedSpec)(nil), (*v1alpha6.VirtualMachineReservedSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineReservedSpec_To_v1alpha6_VirtualMachineReservedSpec(a.(*VirtualMachineReservedSpec), b.(*v1alpha6.VirtualMachineReservedSpec), scope)
 	}); err != nil {
@@ -1018,11 +980,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha3_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineServiceStatus)(nil), (*v1alpha6.VirtualMachineServiceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(a.(*VirtualMachineServiceStatus), b.(*v1alpha6.VirtualMachineServiceStatus), scope)
 	}); err != nil {
@@ -1088,11 +1045,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineTemplateSpec)(nil), (*VirtualMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha3_VirtualMachineTemplateSpec(a.(*v1alpha6.VirtualMachineTemplateSpec), b.(*VirtualMachineTemplateSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineVolume)(nil), (*v1alpha6.VirtualMachineVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineVolume_To_v1alpha6_VirtualMachineVolume(a.(*VirtualMachineVolume), b.(*v1alpha6.VirtualMachineVolume), scope)
 	}); err != nil {
@@ -1148,11 +1100,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineWebConsoleRequestSpec)(nil), (*VirtualMachineWebConsoleRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(a.(*v1alpha6.VirtualMachineWebConsoleRequestSpec), b.(*VirtualMachineWebConsoleRequestSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineWebConsoleRequestStatus)(nil), (*v1alpha6.VirtualMachineWebConsoleRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VirtualMachineWebConsoleRequestStatus_To_v1alpha6_VirtualMachineWebConsoleRequestStatus(a.(*VirtualMachineWebConsoleRequestStatus), b.(*v1alpha6.VirtualMachineWebConsoleRequestStatus), scope)
 	}); err != nil {
@@ -1233,11 +1180,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineGroupSpec)(nil), (*VirtualMachineGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec(a.(*v1alpha6.VirtualMachineGroupSpec), b.(*VirtualMachineGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineGroupStatus)(nil), (*VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(a.(*v1alpha6.VirtualMachineGroupStatus), b.(*VirtualMachineGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineImageDiskInfo)(nil), (*VirtualMachineImageDiskInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineImageDiskInfo_To_v1alpha3_VirtualMachineImageDiskInfo(a.(*v1alpha6.VirtualMachineImageDiskInfo), b.(*VirtualMachineImageDiskInfo), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineImageSpec)(nil), (*VirtualMachineImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha3_VirtualMachineImageSpec(a.(*v1alpha6.VirtualMachineImageSpec), b.(*VirtualMachineImageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineNetworkConfigStatus)(nil), (*VirtualMachineNetworkConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(a.(*v1alpha6.VirtualMachineNetworkConfigStatus), b.(*VirtualMachineNetworkConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineNetworkInterfaceSpec)(nil), (*VirtualMachineNetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineNetworkInterfaceSpec_To_v1alpha3_VirtualMachineNetworkInterfaceSpec(a.(*v1alpha6.VirtualMachineNetworkInterfaceSpec), b.(*VirtualMachineNetworkInterfaceSpec), scope)
 	}); err != nil {
@@ -1253,6 +1220,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha3_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*VirtualMachineReadinessProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*VirtualMachineReadinessProbeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineReplicaSetStatus)(nil), (*VirtualMachineReplicaSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha3_VirtualMachineReplicaSetStatus(a.(*v1alpha6.VirtualMachineReplicaSetStatus), b.(*VirtualMachineReplicaSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha3_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineSpec)(nil), (*VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(a.(*v1alpha6.VirtualMachineSpec), b.(*VirtualMachineSpec), scope)
 	}); err != nil {
@@ -1268,6 +1260,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineTemplateSpec)(nil), (*VirtualMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha3_VirtualMachineTemplateSpec(a.(*v1alpha6.VirtualMachineTemplateSpec), b.(*VirtualMachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineVolumeStatus)(nil), (*VirtualMachineVolumeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineVolumeStatus_To_v1alpha3_VirtualMachineVolumeStatus(a.(*v1alpha6.VirtualMachineVolumeStatus), b.(*VirtualMachineVolumeStatus), scope)
 	}); err != nil {
@@ -1278,6 +1275,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineWebConsoleRequestSpec)(nil), (*VirtualMachineWebConsoleRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(a.(*v1alpha6.VirtualMachineWebConsoleRequestSpec), b.(*VirtualMachineWebConsoleRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1926,7 +1928,15 @@ func autoConvert_v1alpha6_VirtualMachineAdvancedSpec_To_v1alpha3_VirtualMachineA
 
 func autoConvert_v1alpha3_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMachineBootstrapCloudInitSpec(in *VirtualMachineBootstrapCloudInitSpec, out *v1alpha6.VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
 	out.InstanceID = in.InstanceID
-	out.CloudConfig = (*cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(cloudinit.CloudConfig)
+		if err := conversionv1alpha3.Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudConfig = nil
+	}
 	out.RawCloudConfig = (*common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
 	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
 	out.UseGlobalNameserversAsDefault = (*bool)(unsafe.Pointer(in.UseGlobalNameserversAsDefault))
@@ -1941,7 +1951,15 @@ func Convert_v1alpha3_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMa
 
 func autoConvert_v1alpha6_VirtualMachineBootstrapCloudInitSpec_To_v1alpha3_VirtualMachineBootstrapCloudInitSpec(in *v1alpha6.VirtualMachineBootstrapCloudInitSpec, out *VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
 	out.InstanceID = in.InstanceID
-	out.CloudConfig = (*v1alpha3cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(v1alpha3cloudinit.CloudConfig)
+		if err := conversionv1alpha6.Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudConfig = nil
+	}
 	out.RawCloudConfig = (*v1alpha3common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
 	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
 	out.UseGlobalNameserversAsDefault = (*bool)(unsafe.Pointer(in.UseGlobalNameserversAsDefault))
@@ -2038,7 +2056,9 @@ func autoConvert_v1alpha6_VirtualMachineBootstrapSpec_To_v1alpha3_VirtualMachine
 		out.Sysprep = nil
 	}
 	out.VAppConfig = (*VirtualMachineBootstrapVAppConfigSpec)(unsafe.Pointer(in.VAppConfig))
+	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
 	// WARNING: in.Disabled requires manual conversion: does not exist in peer-type
+	// WARNING: in.Generation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2046,7 +2066,7 @@ func autoConvert_v1alpha3_VirtualMachineBootstrapSysprepSpec_To_v1alpha6_Virtual
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(sysprep.Sysprep)
-		if err := conversionv1alpha3.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
+		if err := sysprepconversionv1alpha3.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -2065,7 +2085,7 @@ func autoConvert_v1alpha6_VirtualMachineBootstrapSysprepSpec_To_v1alpha3_Virtual
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(v1alpha3sysprep.Sysprep)
-		if err := conversionv1alpha6.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
+		if err := sysprepconversionv1alpha6.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -2415,7 +2435,17 @@ func Convert_v1alpha6_VirtualMachineGroupBootOrderGroup_To_v1alpha3_VirtualMachi
 
 func autoConvert_v1alpha3_VirtualMachineGroupList_To_v1alpha6_VirtualMachineGroupList(in *VirtualMachineGroupList, out *v1alpha6.VirtualMachineGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineGroup)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_VirtualMachineGroup_To_v1alpha6_VirtualMachineGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2426,7 +2456,17 @@ func Convert_v1alpha3_VirtualMachineGroupList_To_v1alpha6_VirtualMachineGroupLis
 
 func autoConvert_v1alpha6_VirtualMachineGroupList_To_v1alpha3_VirtualMachineGroupList(in *v1alpha6.VirtualMachineGroupList, out *VirtualMachineGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineGroup)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineGroup_To_v1alpha3_VirtualMachineGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2517,14 +2557,10 @@ func autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGrou
 	out.NextForcePowerStateSyncTime = in.NextForcePowerStateSyncTime
 	out.PowerOffMode = VirtualMachinePowerOpMode(in.PowerOffMode)
 	out.SuspendMode = VirtualMachinePowerOpMode(in.SuspendMode)
+	// WARNING: in.CurrentSnapshotName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec(in *v1alpha6.VirtualMachineGroupSpec, out *VirtualMachineGroupSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha3_VirtualMachineGroupSpec(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupStatus(in *VirtualMachineGroupStatus, out *v1alpha6.VirtualMachineGroupStatus, s conversion.Scope) error {
 	out.Members = *(*[]v1alpha6.VirtualMachineGroupMemberStatus)(unsafe.Pointer(&in.Members))
 	out.LastUpdatedPowerStateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdatedPowerStateTime))
@@ -2540,15 +2576,11 @@ func Convert_v1alpha3_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupS
 func autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(in *v1alpha6.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s conversion.Scope) error {
 	out.Members = *(*[]VirtualMachineGroupMemberStatus)(unsafe.Pointer(&in.Members))
 	out.LastUpdatedPowerStateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdatedPowerStateTime))
+	// WARNING: in.CurrentSnapshotName requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(in *v1alpha6.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha3_VirtualMachineGroupStatus(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(in *VirtualMachineImage, out *v1alpha6.VirtualMachineImage, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_VirtualMachineImageSpec_To_v1alpha6_VirtualMachineImageSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2925,14 +2957,10 @@ func Convert_v1alpha3_VirtualMachineImageSpec_To_v1alpha6_VirtualMachineImageSpe
 
 func autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha3_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
 	out.ProviderRef = (*v1alpha3common.LocalObjectRef)(unsafe.Pointer(in.ProviderRef))
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha3_VirtualMachineImageSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha3_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha3_VirtualMachineImageSpec(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineImageStatus_To_v1alpha6_VirtualMachineImageStatus(in *VirtualMachineImageStatus, out *v1alpha6.VirtualMachineImageStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Capabilities = *(*[]string)(unsafe.Pointer(&in.Capabilities))
@@ -3179,14 +3207,11 @@ func Convert_v1alpha3_VirtualMachineNetworkConfigStatus_To_v1alpha6_VirtualMachi
 func autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(in *v1alpha6.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s conversion.Scope) error {
 	out.Interfaces = *(*[]VirtualMachineNetworkConfigInterfaceStatus)(unsafe.Pointer(&in.Interfaces))
 	out.DNS = (*VirtualMachineNetworkConfigDNSStatus)(unsafe.Pointer(in.DNS))
+	// WARNING: in.Bonds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bridges requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(in *v1alpha6.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineNetworkDHCPOptionsStatus_To_v1alpha6_VirtualMachineNetworkDHCPOptionsStatus(in *VirtualMachineNetworkDHCPOptionsStatus, out *v1alpha6.VirtualMachineNetworkDHCPOptionsStatus, s conversion.Scope) error {
 	out.Config = *(*[]common.KeyValuePair)(unsafe.Pointer(&in.Config))
 	out.Enabled = in.Enabled
@@ -3421,6 +3446,7 @@ func autoConvert_v1alpha6_VirtualMachineNetworkInterfaceSpec_To_v1alpha3_Virtual
 	out.GuestDeviceName = in.GuestDeviceName
 	out.MACAddr = in.MACAddr
 	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	// WARNING: in.IPPoolName requires manual conversion: does not exist in peer-type
 	out.DHCP4 = in.DHCP4
 	out.DHCP6 = in.DHCP6
 	out.Gateway4 = in.Gateway4
@@ -3549,11 +3575,21 @@ func autoConvert_v1alpha6_VirtualMachineNetworkSpec_To_v1alpha3_VirtualMachineNe
 		out.Interfaces = nil
 	}
 	// WARNING: in.VLANs requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bonds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bridges requires manual conversion: does not exist in peer-type
 	return nil
 }
 
 func autoConvert_v1alpha3_VirtualMachineNetworkStatus_To_v1alpha6_VirtualMachineNetworkStatus(in *VirtualMachineNetworkStatus, out *v1alpha6.VirtualMachineNetworkStatus, s conversion.Scope) error {
-	out.Config = (*v1alpha6.VirtualMachineNetworkConfigStatus)(unsafe.Pointer(in.Config))
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha6.VirtualMachineNetworkConfigStatus)
+		if err := Convert_v1alpha3_VirtualMachineNetworkConfigStatus_To_v1alpha6_VirtualMachineNetworkConfigStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Config = nil
+	}
 	out.HostName = in.HostName
 	out.Interfaces = *(*[]v1alpha6.VirtualMachineNetworkInterfaceStatus)(unsafe.Pointer(&in.Interfaces))
 	out.IPStacks = *(*[]v1alpha6.VirtualMachineNetworkIPStackStatus)(unsafe.Pointer(&in.IPStacks))
@@ -3568,7 +3604,15 @@ func Convert_v1alpha3_VirtualMachineNetworkStatus_To_v1alpha6_VirtualMachineNetw
 }
 
 func autoConvert_v1alpha6_VirtualMachineNetworkStatus_To_v1alpha3_VirtualMachineNetworkStatus(in *v1alpha6.VirtualMachineNetworkStatus, out *VirtualMachineNetworkStatus, s conversion.Scope) error {
-	out.Config = (*VirtualMachineNetworkConfigStatus)(unsafe.Pointer(in.Config))
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(VirtualMachineNetworkConfigStatus)
+		if err := Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Config = nil
+	}
 	out.HostName = in.HostName
 	out.Interfaces = *(*[]VirtualMachineNetworkInterfaceStatus)(unsafe.Pointer(&in.Interfaces))
 	out.IPStacks = *(*[]VirtualMachineNetworkIPStackStatus)(unsafe.Pointer(&in.IPStacks))
@@ -3736,7 +3780,15 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestSpec_To_v1alpha3_VirtualMa
 
 func autoConvert_v1alpha3_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMachinePublishRequestStatus(in *VirtualMachinePublishRequestStatus, out *v1alpha6.VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*v1alpha6.VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*v1alpha6.VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(v1alpha6.VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha3_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
@@ -3754,22 +3806,26 @@ func Convert_v1alpha3_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMach
 
 func autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha3_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
 	out.LastAttemptTime = in.LastAttemptTime
 	out.ImageName = in.ImageName
+	// WARNING: in.ArtifactDigest requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha3_VirtualMachinePublishRequestStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha3_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha3_VirtualMachinePublishRequestStatus(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(in *VirtualMachinePublishRequestTarget, out *v1alpha6.VirtualMachinePublishRequestTarget, s conversion.Scope) error {
 	if err := Convert_v1alpha3_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(&in.Item, &out.Item, s); err != nil {
 		return err
@@ -3792,14 +3848,10 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_Virtual
 	if err := Convert_v1alpha6_VirtualMachinePublishRequestTargetLocation_To_v1alpha3_VirtualMachinePublishRequestTargetLocation(&in.Location, &out.Location, s); err != nil {
 		return err
 	}
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_VirtualMachinePublishRequestTarget is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_VirtualMachinePublishRequestTarget(in *v1alpha6.VirtualMachinePublishRequestTarget, out *VirtualMachinePublishRequestTarget, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha3_VirtualMachinePublishRequestTarget(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(in *VirtualMachinePublishRequestTargetItem, out *v1alpha6.VirtualMachinePublishRequestTargetItem, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Description = in.Description
@@ -3864,16 +3916,16 @@ func autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMa
 	out.TCPSocket = (*TCPSocketAction)(unsafe.Pointer(in.TCPSocket))
 	out.GuestHeartbeat = (*GuestHeartbeatAction)(unsafe.Pointer(in.GuestHeartbeat))
 	out.GuestInfo = *(*[]GuestInfoAction)(unsafe.Pointer(&in.GuestInfo))
+	// WARNING: in.HTTPGet requires manual conversion: does not exist in peer-type
+	// WARNING: in.Exec requires manual conversion: does not exist in peer-type
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.PeriodSeconds = in.PeriodSeconds
+	// WARNING: in.InitialDelaySeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.SuccessThreshold requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureThreshold requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(in *v1alpha6.VirtualMachineReadinessProbeSpec, out *VirtualMachineReadinessProbeSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineReplicaSet_To_v1alpha6_VirtualMachineReplicaSet(in *VirtualMachineReplicaSet, out *v1alpha6.VirtualMachineReplicaSet, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_VirtualMachineReplicaSetSpec_To_v1alpha6_VirtualMachineReplicaSetSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3998,14 +4050,10 @@ func autoConvert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha3_VirtualMach
 	out.ReadyReplicas = in.ReadyReplicas
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Selector requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha3_VirtualMachineReplicaSetStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha3_VirtualMachineReplicaSetStatus(in *v1alpha6.VirtualMachineReplicaSetStatus, out *VirtualMachineReplicaSetStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha3_VirtualMachineReplicaSetStatus(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineReservedSpec_To_v1alpha6_VirtualMachineReservedSpec(in *VirtualMachineReservedSpec, out *v1alpha6.VirtualMachineReservedSpec, s conversion.Scope) error {
 	out.ResourcePolicyName = in.ResourcePolicyName
 	return nil
@@ -4082,7 +4130,17 @@ func Convert_v1alpha6_VirtualMachineService_To_v1alpha3_VirtualMachineService(in
 
 func autoConvert_v1alpha3_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServiceList(in *VirtualMachineServiceList, out *v1alpha6.VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_VirtualMachineService_To_v1alpha6_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4093,7 +4151,17 @@ func Convert_v1alpha3_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServic
 
 func autoConvert_v1alpha6_VirtualMachineServiceList_To_v1alpha3_VirtualMachineServiceList(in *v1alpha6.VirtualMachineServiceList, out *VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineService_To_v1alpha3_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4152,14 +4220,16 @@ func autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha3_VirtualMachineSe
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.ClusterIP = in.ClusterIP
 	out.ExternalName = in.ExternalName
+	// WARNING: in.SessionAffinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.SessionAffinityConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExternalTrafficPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheckNodePort requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilies requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilyPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.PublishNotReadyAddresses requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha3_VirtualMachineServiceSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha3_VirtualMachineServiceSpec(in *v1alpha6.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha3_VirtualMachineServiceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(in *VirtualMachineServiceStatus, out *v1alpha6.VirtualMachineServiceStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha3_LoadBalancerStatus_To_v1alpha6_LoadBalancerStatus(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
@@ -4338,7 +4408,15 @@ func autoConvert_v1alpha3_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *
 	} else {
 		out.Volumes = nil
 	}
-	out.ReadinessProbe = (*v1alpha6.VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1alpha6.VirtualMachineReadinessProbeSpec)
+		if err := Convert_v1alpha3_VirtualMachineReadinessProbeSpec_To_v1alpha6_VirtualMachineReadinessProbeSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ReadinessProbe = nil
+	}
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(v1alpha6.VirtualMachineAdvancedSpec)
@@ -4360,6 +4438,7 @@ func autoConvert_v1alpha3_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *
 func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(in *v1alpha6.VirtualMachineSpec, out *VirtualMachineSpec, s conversion.Scope) error {
 	out.Image = (*VirtualMachineImageRef)(unsafe.Pointer(in.Image))
 	out.ImageName = in.ImageName
+	// WARNING: in.CloneMode requires manual conversion: does not exist in peer-type
 	out.ClassName = in.ClassName
 	// WARNING: in.Class requires manual conversion: does not exist in peer-type
 	out.Affinity = (*AffinitySpec)(unsafe.Pointer(in.Affinity))
@@ -4408,7 +4487,17 @@ func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(in *
 	} else {
 		out.Volumes = nil
 	}
-	out.ReadinessProbe = (*VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(VirtualMachineReadinessProbeSpec)
+		if err := Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha3_VirtualMachineReadinessProbeSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ReadinessProbe = nil
+	}
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(VirtualMachineAdvancedSpec)
@@ -4446,7 +4535,15 @@ func autoConvert_v1alpha3_VirtualMachineStatus_To_v1alpha6_VirtualMachineStatus(
 	} else {
 		out.Crypto = nil
 	}
-	out.Network = (*v1alpha6.VirtualMachineNetworkStatus)(unsafe.Pointer(in.Network))
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(v1alpha6.VirtualMachineNetworkStatus)
+		if err := Convert_v1alpha3_VirtualMachineNetworkStatus_To_v1alpha6_VirtualMachineNetworkStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Network = nil
+	}
 	out.UniqueID = in.UniqueID
 	out.BiosUUID = in.BiosUUID
 	out.InstanceUUID = in.InstanceUUID
@@ -4491,7 +4588,15 @@ func autoConvert_v1alpha6_VirtualMachineStatus_To_v1alpha3_VirtualMachineStatus(
 	} else {
 		out.Crypto = nil
 	}
-	out.Network = (*VirtualMachineNetworkStatus)(unsafe.Pointer(in.Network))
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(VirtualMachineNetworkStatus)
+		if err := Convert_v1alpha6_VirtualMachineNetworkStatus_To_v1alpha3_VirtualMachineNetworkStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Network = nil
+	}
 	out.UniqueID = in.UniqueID
 	out.BiosUUID = in.BiosUUID
 	out.InstanceUUID = in.InstanceUUID
@@ -4509,6 +4614,10 @@ func autoConvert_v1alpha6_VirtualMachineStatus_To_v1alpha3_VirtualMachineStatus(
 	out.ChangeBlockTracking = (*bool)(unsafe.Pointer(in.ChangeBlockTracking))
 	out.Zone = in.Zone
 	out.LastRestartTime = (*v1.Time)(unsafe.Pointer(in.LastRestartTime))
+	// WARNING: in.BootstrapGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadinessProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	out.HardwareVersion = in.HardwareVersion
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
@@ -4605,14 +4714,10 @@ func autoConvert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha3_VirtualMachineT
 	if err := Convert_v1alpha6_VirtualMachineSpec_To_v1alpha3_VirtualMachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	// WARNING: in.TopologySpreadConstraints requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha3_VirtualMachineTemplateSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha3_VirtualMachineTemplateSpec(in *v1alpha6.VirtualMachineTemplateSpec, out *VirtualMachineTemplateSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha3_VirtualMachineTemplateSpec(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineVolume_To_v1alpha6_VirtualMachineVolume(in *VirtualMachineVolume, out *v1alpha6.VirtualMachineVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha3_VirtualMachineVolumeSource_To_v1alpha6_VirtualMachineVolumeSource(&in.VirtualMachineVolumeSource, &out.VirtualMachineVolumeSource, s); err != nil {
@@ -4768,7 +4873,17 @@ func Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha3_VirtualMachine
 
 func autoConvert_v1alpha3_VirtualMachineWebConsoleRequestList_To_v1alpha6_VirtualMachineWebConsoleRequestList(in *VirtualMachineWebConsoleRequestList, out *v1alpha6.VirtualMachineWebConsoleRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineWebConsoleRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineWebConsoleRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_VirtualMachineWebConsoleRequest_To_v1alpha6_VirtualMachineWebConsoleRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4779,7 +4894,17 @@ func Convert_v1alpha3_VirtualMachineWebConsoleRequestList_To_v1alpha6_VirtualMac
 
 func autoConvert_v1alpha6_VirtualMachineWebConsoleRequestList_To_v1alpha3_VirtualMachineWebConsoleRequestList(in *v1alpha6.VirtualMachineWebConsoleRequestList, out *VirtualMachineWebConsoleRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineWebConsoleRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineWebConsoleRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha3_VirtualMachineWebConsoleRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4802,14 +4927,11 @@ func Convert_v1alpha3_VirtualMachineWebConsoleRequestSpec_To_v1alpha6_VirtualMac
 func autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(in *v1alpha6.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.PublicKey = in.PublicKey
+	// WARNING: in.TTLSeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Revoked requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(in *v1alpha6.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha3_VirtualMachineWebConsoleRequestSpec(in, out, s)
-}
-
 func autoConvert_v1alpha3_VirtualMachineWebConsoleRequestStatus_To_v1alpha6_VirtualMachineWebConsoleRequestStatus(in *VirtualMachineWebConsoleRequestStatus, out *v1alpha6.VirtualMachineWebConsoleRequestStatus, s conversion.Scope) error {
 	out.Response = in.Response
 	out.ExpiryTime = in.ExpiryTime
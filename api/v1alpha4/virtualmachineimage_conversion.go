@@ -5,31 +5,68 @@
 package v1alpha4
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 )
 
+func Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha4_VirtualMachineImageSpec(
+	in *vmopv1.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s apiconversion.Scope) error {
+	return autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha4_VirtualMachineImageSpec(in, out, s)
+}
+
 // ConvertTo converts this VirtualMachineImage to the Hub version.
 func (src *VirtualMachineImage) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineImage)
-	return Convert_v1alpha4_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(src, dst, nil)
+	if err := Convert_v1alpha4_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &vmopv1.VirtualMachineImage{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.OCI = restored.Spec.OCI
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineImage.
 func (dst *VirtualMachineImage) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachineImage)
-	return Convert_v1alpha6_VirtualMachineImage_To_v1alpha4_VirtualMachineImage(src, dst, nil)
+	if err := Convert_v1alpha6_VirtualMachineImage_To_v1alpha4_VirtualMachineImage(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this ClusterVirtualMachineImage to the Hub version.
 func (src *ClusterVirtualMachineImage) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.ClusterVirtualMachineImage)
-	return Convert_v1alpha4_ClusterVirtualMachineImage_To_v1alpha6_ClusterVirtualMachineImage(src, dst, nil)
+	if err := Convert_v1alpha4_ClusterVirtualMachineImage_To_v1alpha6_ClusterVirtualMachineImage(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &vmopv1.ClusterVirtualMachineImage{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.OCI = restored.Spec.OCI
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this ClusterVirtualMachineImage.
 func (dst *ClusterVirtualMachineImage) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.ClusterVirtualMachineImage)
-	return Convert_v1alpha6_ClusterVirtualMachineImage_To_v1alpha4_ClusterVirtualMachineImage(src, dst, nil)
+	if err := Convert_v1alpha6_ClusterVirtualMachineImage_To_v1alpha4_ClusterVirtualMachineImage(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
@@ -25,6 +25,11 @@ func (src *VirtualMachinePublishRequest) ConvertTo(dstRaw ctrlconversion.Hub) er
 	}
 
 	dst.Spec.BackoffLimit = restored.Spec.BackoffLimit
+	dst.Spec.Target.OCI = restored.Spec.Target.OCI
+	if dst.Status.TargetRef != nil && restored.Status.TargetRef != nil {
+		dst.Status.TargetRef.OCI = restored.Status.TargetRef.OCI
+	}
+	dst.Status.ArtifactDigest = restored.Status.ArtifactDigest
 
 	return nil
 }
@@ -48,3 +53,15 @@ func Convert_v1alpha6_VirtualMachinePublishRequestSpec_To_v1alpha4_VirtualMachin
 
 	return nil
 }
+
+func Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha4_VirtualMachinePublishRequestTarget(
+	in *vmopv1.VirtualMachinePublishRequestTarget, out *VirtualMachinePublishRequestTarget, s conversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha4_VirtualMachinePublishRequestTarget(in, out, s)
+}
+
+func Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha4_VirtualMachinePublishRequestStatus(
+	in *vmopv1.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha4_VirtualMachinePublishRequestStatus(in, out, s)
+}
@@ -5,12 +5,32 @@
 package v1alpha4
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
 	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 )
 
+func Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha4_VirtualMachineReplicaSetStatus(
+	in *vmopv1.VirtualMachineReplicaSetStatus, out *VirtualMachineReplicaSetStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha4_VirtualMachineReplicaSetStatus(in, out, s)
+}
+
+func Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha4_VirtualMachineTemplateSpec(
+	in *vmopv1.VirtualMachineTemplateSpec, out *VirtualMachineTemplateSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha4_VirtualMachineTemplateSpec(in, out, s)
+}
+
+func restore_v1alpha6_VirtualMachineTemplateSpec(dst, src *vmopv1.VirtualMachineTemplateSpec) {
+	dstVM := &vmopv1.VirtualMachine{Spec: dst.Spec}
+	restore_v1alpha6_VirtualMachineSpec(dstVM, &vmopv1.VirtualMachine{Spec: src.Spec})
+	dst.Spec = dstVM.Spec
+	dst.TopologySpreadConstraints = src.TopologySpreadConstraints
+}
+
 // ConvertTo converts this VirtualMachineReplicaSet to the Hub version.
 func (src *VirtualMachineReplicaSet) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineReplicaSet)
@@ -24,6 +44,7 @@ func (src *VirtualMachineReplicaSet) ConvertTo(dstRaw ctrlconversion.Hub) error
 		return err
 	}
 
+	restore_v1alpha6_VirtualMachineTemplateSpec(&dst.Spec.Template, &restored.Spec.Template)
 	dst.Status = restored.Status
 
 	return nil
@@ -5,19 +5,50 @@
 package v1alpha4
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 )
 
+func Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha4_VirtualMachineServiceSpec(
+	in *vmopv1.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha4_VirtualMachineServiceSpec(in, out, s)
+}
+
 // ConvertTo converts this VirtualMachineService to the Hub version.
 func (src *VirtualMachineService) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineService)
-	return Convert_v1alpha4_VirtualMachineService_To_v1alpha6_VirtualMachineService(src, dst, nil)
+	if err := Convert_v1alpha4_VirtualMachineService_To_v1alpha6_VirtualMachineService(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &vmopv1.VirtualMachineService{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.SessionAffinity = restored.Spec.SessionAffinity
+	dst.Spec.SessionAffinityConfig = restored.Spec.SessionAffinityConfig
+	dst.Spec.ExternalTrafficPolicy = restored.Spec.ExternalTrafficPolicy
+	dst.Spec.HealthCheckNodePort = restored.Spec.HealthCheckNodePort
+	dst.Spec.IPFamilies = restored.Spec.IPFamilies
+	dst.Spec.IPFamilyPolicy = restored.Spec.IPFamilyPolicy
+	dst.Spec.PublishNotReadyAddresses = restored.Spec.PublishNotReadyAddresses
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineService.
 func (dst *VirtualMachineService) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachineService)
-	return Convert_v1alpha6_VirtualMachineService_To_v1alpha4_VirtualMachineService(src, dst, nil)
+	if err := Convert_v1alpha6_VirtualMachineService_To_v1alpha4_VirtualMachineService(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata
+	return utilconversion.MarshalData(src, dst)
 }
@@ -5,21 +5,42 @@
 package v1alpha5
 
 import (
+	"k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 )
 
 // ConvertTo converts this VirtualMachinePublishRequest to the Hub version.
 func (src *VirtualMachinePublishRequest) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachinePublishRequest)
-	return Convert_v1alpha5_VirtualMachinePublishRequest_To_v1alpha6_VirtualMachinePublishRequest(src, dst, nil)
+	if err := Convert_v1alpha5_VirtualMachinePublishRequest_To_v1alpha6_VirtualMachinePublishRequest(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &vmopv1.VirtualMachinePublishRequest{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.Target.OCI = restored.Spec.Target.OCI
+	if dst.Status.TargetRef != nil && restored.Status.TargetRef != nil {
+		dst.Status.TargetRef.OCI = restored.Status.TargetRef.OCI
+	}
+	dst.Status.ArtifactDigest = restored.Status.ArtifactDigest
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachinePublishRequest.
 func (dst *VirtualMachinePublishRequest) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachinePublishRequest)
-	return Convert_v1alpha6_VirtualMachinePublishRequest_To_v1alpha5_VirtualMachinePublishRequest(src, dst, nil)
+	if err := Convert_v1alpha6_VirtualMachinePublishRequest_To_v1alpha5_VirtualMachinePublishRequest(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachinePublishRequestList to the Hub version.
@@ -33,3 +54,15 @@ func (dst *VirtualMachinePublishRequestList) ConvertFrom(srcRaw ctrlconversion.H
 	src := srcRaw.(*vmopv1.VirtualMachinePublishRequestList)
 	return Convert_v1alpha6_VirtualMachinePublishRequestList_To_v1alpha5_VirtualMachinePublishRequestList(src, dst, nil)
 }
+
+func Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(
+	in *vmopv1.VirtualMachinePublishRequestTarget, out *VirtualMachinePublishRequestTarget, s conversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(in, out, s)
+}
+
+func Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(
+	in *vmopv1.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
+
+	return autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(in, out, s)
+}
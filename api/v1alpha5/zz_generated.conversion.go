@@ -14,12 +14,14 @@ import (
 	unsafe "unsafe"
 
 	v1alpha5cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit"
+	conversionv1alpha5 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit/conversion/v1alpha5"
+	conversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit/conversion/v1alpha6"
 	v1alpha5common "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
 	commonconversionv1alpha5 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common/conversion/v1alpha5"
 	commonconversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common/conversion/v1alpha6"
 	v1alpha5sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
-	conversionv1alpha5 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep/conversion/v1alpha5"
-	conversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep/conversion/v1alpha6"
+	sysprepconversionv1alpha5 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep/conversion/v1alpha5"
+	sysprepconversionv1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep/conversion/v1alpha6"
 	v1alpha6 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha6/cloudinit"
 	common "github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
@@ -713,21 +715,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineGroupSpec)(nil), (*VirtualMachineGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha5_VirtualMachineGroupSpec(a.(*v1alpha6.VirtualMachineGroupSpec), b.(*VirtualMachineGroupSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineGroupStatus)(nil), (*v1alpha6.VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupStatus(a.(*VirtualMachineGroupStatus), b.(*v1alpha6.VirtualMachineGroupStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineGroupStatus)(nil), (*VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha5_VirtualMachineGroupStatus(a.(*v1alpha6.VirtualMachineGroupStatus), b.(*VirtualMachineGroupStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineGuestStatus)(nil), (*v1alpha6.VirtualMachineGuestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineGuestStatus_To_v1alpha6_VirtualMachineGuestStatus(a.(*VirtualMachineGuestStatus), b.(*v1alpha6.VirtualMachineGuestStatus), scope)
 	}); err != nil {
@@ -743,11 +735,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineHardwareSpec)(nil), (*VirtualMachineHardwareSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineHardwareSpec(a.(*v1alpha6.VirtualMachineHardwareSpec), b.(*VirtualMachineHardwareSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineHardwareStatus)(nil), (*v1alpha6.VirtualMachineHardwareStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineHardwareStatus_To_v1alpha6_VirtualMachineHardwareStatus(a.(*VirtualMachineHardwareStatus), b.(*v1alpha6.VirtualMachineHardwareStatus), scope)
 	}); err != nil {
@@ -913,11 +900,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineImageSpec)(nil), (*VirtualMachineImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec(a.(*v1alpha6.VirtualMachineImageSpec), b.(*VirtualMachineImageSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineImageStatus)(nil), (*v1alpha6.VirtualMachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineImageStatus_To_v1alpha6_VirtualMachineImageStatus(a.(*VirtualMachineImageStatus), b.(*v1alpha6.VirtualMachineImageStatus), scope)
 	}); err != nil {
@@ -1003,11 +985,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineNetworkConfigStatus)(nil), (*VirtualMachineNetworkConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus(a.(*v1alpha6.VirtualMachineNetworkConfigStatus), b.(*VirtualMachineNetworkConfigStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineNetworkDHCPOptionsStatus)(nil), (*v1alpha6.VirtualMachineNetworkDHCPOptionsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineNetworkDHCPOptionsStatus_To_v1alpha6_VirtualMachineNetworkDHCPOptionsStatus(a.(*VirtualMachineNetworkDHCPOptionsStatus), b.(*v1alpha6.VirtualMachineNetworkDHCPOptionsStatus), scope)
 	}); err != nil {
@@ -1203,21 +1180,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTarget)(nil), (*v1alpha6.VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(a.(*VirtualMachinePublishRequestTarget), b.(*v1alpha6.VirtualMachinePublishRequestTarget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachinePublishRequestTargetItem)(nil), (*v1alpha6.VirtualMachinePublishRequestTargetItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(a.(*VirtualMachinePublishRequestTargetItem), b.(*v1alpha6.VirtualMachinePublishRequestTargetItem), scope)
 	}); err != nil {
@@ -1243,11 +1210,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*VirtualMachineReadinessProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMachineReadinessProbeSpec(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*VirtualMachineReadinessProbeSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineReplicaSet)(nil), (*v1alpha6.VirtualMachineReplicaSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineReplicaSet_To_v1alpha6_VirtualMachineReplicaSet(a.(*VirtualMachineReplicaSet), b.(*v1alpha6.VirtualMachineReplicaSet), scope)
 	}); err != nil {
@@ -1283,11 +1245,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineReplicaSetStatus)(nil), (*VirtualMachineReplicaSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha5_VirtualMachineReplicaSetStatus(a.(*v1alpha6.VirtualMachineReplicaSetStatus), b.(*VirtualMachineReplicaSetStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineReservedSpec)(nil), (*v1alpha6.VirtualMachineReservedSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineReservedSpec_To_v1alpha6_VirtualMachineReservedSpec(a.(*VirtualMachineReservedSpec), b.(*v1alpha6.VirtualMachineReservedSpec), scope)
 	}); err != nil {
@@ -1343,11 +1300,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineServiceStatus)(nil), (*v1alpha6.VirtualMachineServiceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(a.(*VirtualMachineServiceStatus), b.(*v1alpha6.VirtualMachineServiceStatus), scope)
 	}); err != nil {
@@ -1533,11 +1485,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineTemplateSpec)(nil), (*VirtualMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha5_VirtualMachineTemplateSpec(a.(*v1alpha6.VirtualMachineTemplateSpec), b.(*VirtualMachineTemplateSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineVolume)(nil), (*v1alpha6.VirtualMachineVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineVolume_To_v1alpha6_VirtualMachineVolume(a.(*VirtualMachineVolume), b.(*v1alpha6.VirtualMachineVolume), scope)
 	}); err != nil {
@@ -1603,11 +1550,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha6.VirtualMachineWebConsoleRequestSpec)(nil), (*VirtualMachineWebConsoleRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha5_VirtualMachineWebConsoleRequestSpec(a.(*v1alpha6.VirtualMachineWebConsoleRequestSpec), b.(*VirtualMachineWebConsoleRequestSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineWebConsoleRequestStatus)(nil), (*v1alpha6.VirtualMachineWebConsoleRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineWebConsoleRequestStatus_To_v1alpha6_VirtualMachineWebConsoleRequestStatus(a.(*VirtualMachineWebConsoleRequestStatus), b.(*v1alpha6.VirtualMachineWebConsoleRequestStatus), scope)
 	}); err != nil {
@@ -1628,6 +1570,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineGroupSpec)(nil), (*VirtualMachineGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha5_VirtualMachineGroupSpec(a.(*v1alpha6.VirtualMachineGroupSpec), b.(*VirtualMachineGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineGroupStatus)(nil), (*VirtualMachineGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha5_VirtualMachineGroupStatus(a.(*v1alpha6.VirtualMachineGroupStatus), b.(*VirtualMachineGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineHardwareSpec)(nil), (*VirtualMachineHardwareSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineHardwareSpec(a.(*v1alpha6.VirtualMachineHardwareSpec), b.(*VirtualMachineHardwareSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineImageSpec)(nil), (*VirtualMachineImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec(a.(*v1alpha6.VirtualMachineImageSpec), b.(*VirtualMachineImageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineNetworkConfigStatus)(nil), (*VirtualMachineNetworkConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus(a.(*v1alpha6.VirtualMachineNetworkConfigStatus), b.(*VirtualMachineNetworkConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineNetworkInterfaceSpec)(nil), (*VirtualMachineNetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineNetworkInterfaceSpec_To_v1alpha5_VirtualMachineNetworkInterfaceSpec(a.(*v1alpha6.VirtualMachineNetworkInterfaceSpec), b.(*VirtualMachineNetworkInterfaceSpec), scope)
 	}); err != nil {
@@ -1638,6 +1605,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestStatus)(nil), (*VirtualMachinePublishRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(a.(*v1alpha6.VirtualMachinePublishRequestStatus), b.(*VirtualMachinePublishRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachinePublishRequestTarget)(nil), (*VirtualMachinePublishRequestTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(a.(*v1alpha6.VirtualMachinePublishRequestTarget), b.(*VirtualMachinePublishRequestTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineReadinessProbeSpec)(nil), (*VirtualMachineReadinessProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMachineReadinessProbeSpec(a.(*v1alpha6.VirtualMachineReadinessProbeSpec), b.(*VirtualMachineReadinessProbeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineReplicaSetStatus)(nil), (*VirtualMachineReplicaSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha5_VirtualMachineReplicaSetStatus(a.(*v1alpha6.VirtualMachineReplicaSetStatus), b.(*VirtualMachineReplicaSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec(a.(*v1alpha6.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineSpec)(nil), (*VirtualMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha6_VirtualMachineSpec_To_v1alpha5_VirtualMachineSpec(a.(*v1alpha6.VirtualMachineSpec), b.(*VirtualMachineSpec), scope)
 	}); err != nil {
@@ -1648,6 +1640,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineTemplateSpec)(nil), (*VirtualMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha5_VirtualMachineTemplateSpec(a.(*v1alpha6.VirtualMachineTemplateSpec), b.(*VirtualMachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha6.VirtualMachineWebConsoleRequestSpec)(nil), (*VirtualMachineWebConsoleRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha5_VirtualMachineWebConsoleRequestSpec(a.(*v1alpha6.VirtualMachineWebConsoleRequestSpec), b.(*VirtualMachineWebConsoleRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1707,7 +1709,17 @@ func Convert_v1alpha6_ClusterVirtualMachineImage_To_v1alpha5_ClusterVirtualMachi
 
 func autoConvert_v1alpha5_ClusterVirtualMachineImageList_To_v1alpha6_ClusterVirtualMachineImageList(in *ClusterVirtualMachineImageList, out *v1alpha6.ClusterVirtualMachineImageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.ClusterVirtualMachineImage)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.ClusterVirtualMachineImage, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_ClusterVirtualMachineImage_To_v1alpha6_ClusterVirtualMachineImage(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1718,7 +1730,17 @@ func Convert_v1alpha5_ClusterVirtualMachineImageList_To_v1alpha6_ClusterVirtualM
 
 func autoConvert_v1alpha6_ClusterVirtualMachineImageList_To_v1alpha5_ClusterVirtualMachineImageList(in *v1alpha6.ClusterVirtualMachineImageList, out *ClusterVirtualMachineImageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterVirtualMachineImage)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterVirtualMachineImage, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_ClusterVirtualMachineImage_To_v1alpha5_ClusterVirtualMachineImage(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2539,7 +2561,15 @@ func Convert_v1alpha6_VirtualMachineBootOptionsBootableDevice_To_v1alpha5_Virtua
 
 func autoConvert_v1alpha5_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMachineBootstrapCloudInitSpec(in *VirtualMachineBootstrapCloudInitSpec, out *v1alpha6.VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
 	out.InstanceID = in.InstanceID
-	out.CloudConfig = (*cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(cloudinit.CloudConfig)
+		if err := conversionv1alpha5.Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudConfig = nil
+	}
 	out.RawCloudConfig = (*common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
 	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
 	out.UseGlobalNameserversAsDefault = (*bool)(unsafe.Pointer(in.UseGlobalNameserversAsDefault))
@@ -2556,7 +2586,15 @@ func Convert_v1alpha5_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMa
 
 func autoConvert_v1alpha6_VirtualMachineBootstrapCloudInitSpec_To_v1alpha5_VirtualMachineBootstrapCloudInitSpec(in *v1alpha6.VirtualMachineBootstrapCloudInitSpec, out *VirtualMachineBootstrapCloudInitSpec, s conversion.Scope) error {
 	out.InstanceID = in.InstanceID
-	out.CloudConfig = (*v1alpha5cloudinit.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(v1alpha5cloudinit.CloudConfig)
+		if err := conversionv1alpha6.Convert_cloudinit_CloudConfig_To_cloudinit_CloudConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudConfig = nil
+	}
 	out.RawCloudConfig = (*v1alpha5common.SecretKeySelector)(unsafe.Pointer(in.RawCloudConfig))
 	out.SSHAuthorizedKeys = *(*[]string)(unsafe.Pointer(&in.SSHAuthorizedKeys))
 	out.UseGlobalNameserversAsDefault = (*bool)(unsafe.Pointer(in.UseGlobalNameserversAsDefault))
@@ -2602,7 +2640,15 @@ func Convert_v1alpha6_VirtualMachineBootstrapLinuxPrepSpec_To_v1alpha5_VirtualMa
 }
 
 func autoConvert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha6_VirtualMachineBootstrapSpec(in *VirtualMachineBootstrapSpec, out *v1alpha6.VirtualMachineBootstrapSpec, s conversion.Scope) error {
-	out.CloudInit = (*v1alpha6.VirtualMachineBootstrapCloudInitSpec)(unsafe.Pointer(in.CloudInit))
+	if in.CloudInit != nil {
+		in, out := &in.CloudInit, &out.CloudInit
+		*out = new(v1alpha6.VirtualMachineBootstrapCloudInitSpec)
+		if err := Convert_v1alpha5_VirtualMachineBootstrapCloudInitSpec_To_v1alpha6_VirtualMachineBootstrapCloudInitSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudInit = nil
+	}
 	out.LinuxPrep = (*v1alpha6.VirtualMachineBootstrapLinuxPrepSpec)(unsafe.Pointer(in.LinuxPrep))
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
@@ -2623,7 +2669,15 @@ func Convert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha6_VirtualMachineBoot
 }
 
 func autoConvert_v1alpha6_VirtualMachineBootstrapSpec_To_v1alpha5_VirtualMachineBootstrapSpec(in *v1alpha6.VirtualMachineBootstrapSpec, out *VirtualMachineBootstrapSpec, s conversion.Scope) error {
-	out.CloudInit = (*VirtualMachineBootstrapCloudInitSpec)(unsafe.Pointer(in.CloudInit))
+	if in.CloudInit != nil {
+		in, out := &in.CloudInit, &out.CloudInit
+		*out = new(VirtualMachineBootstrapCloudInitSpec)
+		if err := Convert_v1alpha6_VirtualMachineBootstrapCloudInitSpec_To_v1alpha5_VirtualMachineBootstrapCloudInitSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CloudInit = nil
+	}
 	out.LinuxPrep = (*VirtualMachineBootstrapLinuxPrepSpec)(unsafe.Pointer(in.LinuxPrep))
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
@@ -2635,7 +2689,9 @@ func autoConvert_v1alpha6_VirtualMachineBootstrapSpec_To_v1alpha5_VirtualMachine
 		out.Sysprep = nil
 	}
 	out.VAppConfig = (*VirtualMachineBootstrapVAppConfigSpec)(unsafe.Pointer(in.VAppConfig))
+	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
 	// WARNING: in.Disabled requires manual conversion: does not exist in peer-type
+	// WARNING: in.Generation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2643,7 +2699,7 @@ func autoConvert_v1alpha5_VirtualMachineBootstrapSysprepSpec_To_v1alpha6_Virtual
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(sysprep.Sysprep)
-		if err := conversionv1alpha5.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
+		if err := sysprepconversionv1alpha5.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -2663,7 +2719,7 @@ func autoConvert_v1alpha6_VirtualMachineBootstrapSysprepSpec_To_v1alpha5_Virtual
 	if in.Sysprep != nil {
 		in, out := &in.Sysprep, &out.Sysprep
 		*out = new(v1alpha5sysprep.Sysprep)
-		if err := conversionv1alpha6.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
+		if err := sysprepconversionv1alpha6.Convert_sysprep_Sysprep_To_sysprep_Sysprep(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
@@ -3161,7 +3217,17 @@ func Convert_v1alpha6_VirtualMachineGroupBootOrderGroup_To_v1alpha5_VirtualMachi
 
 func autoConvert_v1alpha5_VirtualMachineGroupList_To_v1alpha6_VirtualMachineGroupList(in *VirtualMachineGroupList, out *v1alpha6.VirtualMachineGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineGroup)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachineGroup_To_v1alpha6_VirtualMachineGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3172,7 +3238,17 @@ func Convert_v1alpha5_VirtualMachineGroupList_To_v1alpha6_VirtualMachineGroupLis
 
 func autoConvert_v1alpha6_VirtualMachineGroupList_To_v1alpha5_VirtualMachineGroupList(in *v1alpha6.VirtualMachineGroupList, out *VirtualMachineGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineGroup)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineGroup, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineGroup_To_v1alpha5_VirtualMachineGroup(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3399,14 +3475,10 @@ func autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha5_VirtualMachineGrou
 	out.NextForcePowerStateSyncTime = in.NextForcePowerStateSyncTime
 	out.PowerOffMode = VirtualMachinePowerOpMode(in.PowerOffMode)
 	out.SuspendMode = VirtualMachinePowerOpMode(in.SuspendMode)
+	// WARNING: in.CurrentSnapshotName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha5_VirtualMachineGroupSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha5_VirtualMachineGroupSpec(in *v1alpha6.VirtualMachineGroupSpec, out *VirtualMachineGroupSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineGroupSpec_To_v1alpha5_VirtualMachineGroupSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupStatus(in *VirtualMachineGroupStatus, out *v1alpha6.VirtualMachineGroupStatus, s conversion.Scope) error {
 	out.Members = *(*[]v1alpha6.VirtualMachineGroupMemberStatus)(unsafe.Pointer(&in.Members))
 	out.LastUpdatedPowerStateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdatedPowerStateTime))
@@ -3422,15 +3494,11 @@ func Convert_v1alpha5_VirtualMachineGroupStatus_To_v1alpha6_VirtualMachineGroupS
 func autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha5_VirtualMachineGroupStatus(in *v1alpha6.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s conversion.Scope) error {
 	out.Members = *(*[]VirtualMachineGroupMemberStatus)(unsafe.Pointer(&in.Members))
 	out.LastUpdatedPowerStateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdatedPowerStateTime))
+	// WARNING: in.CurrentSnapshotName requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha5_VirtualMachineGroupStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha5_VirtualMachineGroupStatus(in *v1alpha6.VirtualMachineGroupStatus, out *VirtualMachineGroupStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineGroupStatus_To_v1alpha5_VirtualMachineGroupStatus(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineGuestStatus_To_v1alpha6_VirtualMachineGuestStatus(in *VirtualMachineGuestStatus, out *v1alpha6.VirtualMachineGuestStatus, s conversion.Scope) error {
 	out.GuestID = in.GuestID
 	out.GuestFullName = in.GuestFullName
@@ -3473,14 +3541,10 @@ func autoConvert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineH
 	out.NVMEControllers = *(*[]NVMEControllerSpec)(unsafe.Pointer(&in.NVMEControllers))
 	out.SATAControllers = *(*[]SATAControllerSpec)(unsafe.Pointer(&in.SATAControllers))
 	out.SCSIControllers = *(*[]SCSIControllerSpec)(unsafe.Pointer(&in.SCSIControllers))
+	// WARNING: in.SerialConsole requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineHardwareSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineHardwareSpec(in *v1alpha6.VirtualMachineHardwareSpec, out *VirtualMachineHardwareSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineHardwareSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineHardwareStatus_To_v1alpha6_VirtualMachineHardwareStatus(in *VirtualMachineHardwareStatus, out *v1alpha6.VirtualMachineHardwareStatus, s conversion.Scope) error {
 	out.Controllers = *(*[]v1alpha6.VirtualControllerStatus)(unsafe.Pointer(&in.Controllers))
 	out.CPU = (*v1alpha6.VirtualMachineCPUAllocationStatus)(unsafe.Pointer(in.CPU))
@@ -3797,7 +3861,17 @@ func Convert_v1alpha6_VirtualMachineImageDiskInfo_To_v1alpha5_VirtualMachineImag
 
 func autoConvert_v1alpha5_VirtualMachineImageList_To_v1alpha6_VirtualMachineImageList(in *VirtualMachineImageList, out *v1alpha6.VirtualMachineImageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineImage)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineImage, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachineImage_To_v1alpha6_VirtualMachineImage(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3808,7 +3882,17 @@ func Convert_v1alpha5_VirtualMachineImageList_To_v1alpha6_VirtualMachineImageLis
 
 func autoConvert_v1alpha6_VirtualMachineImageList_To_v1alpha5_VirtualMachineImageList(in *v1alpha6.VirtualMachineImageList, out *VirtualMachineImageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineImage)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineImage, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineImage_To_v1alpha5_VirtualMachineImage(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3901,14 +3985,10 @@ func Convert_v1alpha5_VirtualMachineImageSpec_To_v1alpha6_VirtualMachineImageSpe
 
 func autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
 	out.ProviderRef = (*v1alpha5common.LocalObjectRef)(unsafe.Pointer(in.ProviderRef))
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec(in *v1alpha6.VirtualMachineImageSpec, out *VirtualMachineImageSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineImageStatus_To_v1alpha6_VirtualMachineImageStatus(in *VirtualMachineImageStatus, out *v1alpha6.VirtualMachineImageStatus, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Capabilities = *(*[]string)(unsafe.Pointer(&in.Capabilities))
@@ -4157,14 +4237,11 @@ func Convert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha6_VirtualMachi
 func autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus(in *v1alpha6.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s conversion.Scope) error {
 	out.Interfaces = *(*[]VirtualMachineNetworkConfigInterfaceStatus)(unsafe.Pointer(&in.Interfaces))
 	out.DNS = (*VirtualMachineNetworkConfigDNSStatus)(unsafe.Pointer(in.DNS))
+	// WARNING: in.Bonds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bridges requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus(in *v1alpha6.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineNetworkDHCPOptionsStatus_To_v1alpha6_VirtualMachineNetworkDHCPOptionsStatus(in *VirtualMachineNetworkDHCPOptionsStatus, out *v1alpha6.VirtualMachineNetworkDHCPOptionsStatus, s conversion.Scope) error {
 	out.Config = *(*[]common.KeyValuePair)(unsafe.Pointer(&in.Config))
 	out.Enabled = in.Enabled
@@ -4399,6 +4476,7 @@ func autoConvert_v1alpha6_VirtualMachineNetworkInterfaceSpec_To_v1alpha5_Virtual
 	out.GuestDeviceName = in.GuestDeviceName
 	out.MACAddr = in.MACAddr
 	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	// WARNING: in.IPPoolName requires manual conversion: does not exist in peer-type
 	out.DHCP4 = in.DHCP4
 	out.DHCP6 = in.DHCP6
 	out.Gateway4 = in.Gateway4
@@ -4527,11 +4605,21 @@ func autoConvert_v1alpha6_VirtualMachineNetworkSpec_To_v1alpha5_VirtualMachineNe
 		out.Interfaces = nil
 	}
 	// WARNING: in.VLANs requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bonds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bridges requires manual conversion: does not exist in peer-type
 	return nil
 }
 
 func autoConvert_v1alpha5_VirtualMachineNetworkStatus_To_v1alpha6_VirtualMachineNetworkStatus(in *VirtualMachineNetworkStatus, out *v1alpha6.VirtualMachineNetworkStatus, s conversion.Scope) error {
-	out.Config = (*v1alpha6.VirtualMachineNetworkConfigStatus)(unsafe.Pointer(in.Config))
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha6.VirtualMachineNetworkConfigStatus)
+		if err := Convert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha6_VirtualMachineNetworkConfigStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Config = nil
+	}
 	out.HostName = in.HostName
 	out.Interfaces = *(*[]v1alpha6.VirtualMachineNetworkInterfaceStatus)(unsafe.Pointer(&in.Interfaces))
 	out.IPStacks = *(*[]v1alpha6.VirtualMachineNetworkIPStackStatus)(unsafe.Pointer(&in.IPStacks))
@@ -4546,7 +4634,15 @@ func Convert_v1alpha5_VirtualMachineNetworkStatus_To_v1alpha6_VirtualMachineNetw
 }
 
 func autoConvert_v1alpha6_VirtualMachineNetworkStatus_To_v1alpha5_VirtualMachineNetworkStatus(in *v1alpha6.VirtualMachineNetworkStatus, out *VirtualMachineNetworkStatus, s conversion.Scope) error {
-	out.Config = (*VirtualMachineNetworkConfigStatus)(unsafe.Pointer(in.Config))
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(VirtualMachineNetworkConfigStatus)
+		if err := Convert_v1alpha6_VirtualMachineNetworkConfigStatus_To_v1alpha5_VirtualMachineNetworkConfigStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Config = nil
+	}
 	out.HostName = in.HostName
 	out.Interfaces = *(*[]VirtualMachineNetworkInterfaceStatus)(unsafe.Pointer(&in.Interfaces))
 	out.IPStacks = *(*[]VirtualMachineNetworkIPStackStatus)(unsafe.Pointer(&in.IPStacks))
@@ -4640,7 +4736,17 @@ func Convert_v1alpha6_VirtualMachinePublishRequest_To_v1alpha5_VirtualMachinePub
 
 func autoConvert_v1alpha5_VirtualMachinePublishRequestList_To_v1alpha6_VirtualMachinePublishRequestList(in *VirtualMachinePublishRequestList, out *v1alpha6.VirtualMachinePublishRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachinePublishRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachinePublishRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachinePublishRequest_To_v1alpha6_VirtualMachinePublishRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4651,7 +4757,17 @@ func Convert_v1alpha5_VirtualMachinePublishRequestList_To_v1alpha6_VirtualMachin
 
 func autoConvert_v1alpha6_VirtualMachinePublishRequestList_To_v1alpha5_VirtualMachinePublishRequestList(in *v1alpha6.VirtualMachinePublishRequestList, out *VirtualMachinePublishRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachinePublishRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachinePublishRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachinePublishRequest_To_v1alpha5_VirtualMachinePublishRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -4720,7 +4836,15 @@ func Convert_v1alpha6_VirtualMachinePublishRequestSpec_To_v1alpha5_VirtualMachin
 
 func autoConvert_v1alpha5_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMachinePublishRequestStatus(in *VirtualMachinePublishRequestStatus, out *v1alpha6.VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*v1alpha6.VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*v1alpha6.VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(v1alpha6.VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha5_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
@@ -4738,22 +4862,26 @@ func Convert_v1alpha5_VirtualMachinePublishRequestStatus_To_v1alpha6_VirtualMach
 
 func autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
 	out.SourceRef = (*VirtualMachinePublishRequestSource)(unsafe.Pointer(in.SourceRef))
-	out.TargetRef = (*VirtualMachinePublishRequestTarget)(unsafe.Pointer(in.TargetRef))
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(VirtualMachinePublishRequestTarget)
+		if err := Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetRef = nil
+	}
 	out.CompletionTime = in.CompletionTime
 	out.StartTime = in.StartTime
 	out.Attempts = in.Attempts
 	out.LastAttemptTime = in.LastAttemptTime
 	out.ImageName = in.ImageName
+	// WARNING: in.ArtifactDigest requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(in *v1alpha6.VirtualMachinePublishRequestStatus, out *VirtualMachinePublishRequestStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestStatus_To_v1alpha5_VirtualMachinePublishRequestStatus(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachinePublishRequestTarget_To_v1alpha6_VirtualMachinePublishRequestTarget(in *VirtualMachinePublishRequestTarget, out *v1alpha6.VirtualMachinePublishRequestTarget, s conversion.Scope) error {
 	if err := Convert_v1alpha5_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(&in.Item, &out.Item, s); err != nil {
 		return err
@@ -4776,14 +4904,10 @@ func autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_Virtual
 	if err := Convert_v1alpha6_VirtualMachinePublishRequestTargetLocation_To_v1alpha5_VirtualMachinePublishRequestTargetLocation(&in.Location, &out.Location, s); err != nil {
 		return err
 	}
+	// WARNING: in.OCI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(in *v1alpha6.VirtualMachinePublishRequestTarget, out *VirtualMachinePublishRequestTarget, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachinePublishRequestTarget_To_v1alpha5_VirtualMachinePublishRequestTarget(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachinePublishRequestTargetItem_To_v1alpha6_VirtualMachinePublishRequestTargetItem(in *VirtualMachinePublishRequestTargetItem, out *v1alpha6.VirtualMachinePublishRequestTargetItem, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Description = in.Description
@@ -4848,16 +4972,16 @@ func autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMa
 	out.TCPSocket = (*TCPSocketAction)(unsafe.Pointer(in.TCPSocket))
 	out.GuestHeartbeat = (*GuestHeartbeatAction)(unsafe.Pointer(in.GuestHeartbeat))
 	out.GuestInfo = *(*[]GuestInfoAction)(unsafe.Pointer(&in.GuestInfo))
+	// WARNING: in.HTTPGet requires manual conversion: does not exist in peer-type
+	// WARNING: in.Exec requires manual conversion: does not exist in peer-type
 	out.TimeoutSeconds = in.TimeoutSeconds
 	out.PeriodSeconds = in.PeriodSeconds
+	// WARNING: in.InitialDelaySeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.SuccessThreshold requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureThreshold requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMachineReadinessProbeSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMachineReadinessProbeSpec(in *v1alpha6.VirtualMachineReadinessProbeSpec, out *VirtualMachineReadinessProbeSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMachineReadinessProbeSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineReplicaSet_To_v1alpha6_VirtualMachineReplicaSet(in *VirtualMachineReplicaSet, out *v1alpha6.VirtualMachineReplicaSet, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha5_VirtualMachineReplicaSetSpec_To_v1alpha6_VirtualMachineReplicaSetSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4982,14 +5106,10 @@ func autoConvert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha5_VirtualMach
 	out.ReadyReplicas = in.ReadyReplicas
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.Selector requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha5_VirtualMachineReplicaSetStatus is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha5_VirtualMachineReplicaSetStatus(in *v1alpha6.VirtualMachineReplicaSetStatus, out *VirtualMachineReplicaSetStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineReplicaSetStatus_To_v1alpha5_VirtualMachineReplicaSetStatus(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineReservedSpec_To_v1alpha6_VirtualMachineReservedSpec(in *VirtualMachineReservedSpec, out *v1alpha6.VirtualMachineReservedSpec, s conversion.Scope) error {
 	out.ResourcePolicyName = in.ResourcePolicyName
 	return nil
@@ -5066,7 +5186,17 @@ func Convert_v1alpha6_VirtualMachineService_To_v1alpha5_VirtualMachineService(in
 
 func autoConvert_v1alpha5_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServiceList(in *VirtualMachineServiceList, out *v1alpha6.VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachineService_To_v1alpha6_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -5077,7 +5207,17 @@ func Convert_v1alpha5_VirtualMachineServiceList_To_v1alpha6_VirtualMachineServic
 
 func autoConvert_v1alpha6_VirtualMachineServiceList_To_v1alpha5_VirtualMachineServiceList(in *v1alpha6.VirtualMachineServiceList, out *VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineService_To_v1alpha5_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -5136,14 +5276,16 @@ func autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineSe
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.ClusterIP = in.ClusterIP
 	out.ExternalName = in.ExternalName
+	// WARNING: in.SessionAffinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.SessionAffinityConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExternalTrafficPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheckNodePort requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilies requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilyPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.PublishNotReadyAddresses requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec(in *v1alpha6.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineServiceStatus_To_v1alpha6_VirtualMachineServiceStatus(in *VirtualMachineServiceStatus, out *v1alpha6.VirtualMachineServiceStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha5_LoadBalancerStatus_To_v1alpha6_LoadBalancerStatus(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
@@ -5480,7 +5622,15 @@ func autoConvert_v1alpha5_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *
 	out.NextRestartTime = in.NextRestartTime
 	out.RestartMode = v1alpha6.VirtualMachinePowerOpMode(in.RestartMode)
 	out.Volumes = *(*[]v1alpha6.VirtualMachineVolume)(unsafe.Pointer(&in.Volumes))
-	out.ReadinessProbe = (*v1alpha6.VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1alpha6.VirtualMachineReadinessProbeSpec)
+		if err := Convert_v1alpha5_VirtualMachineReadinessProbeSpec_To_v1alpha6_VirtualMachineReadinessProbeSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ReadinessProbe = nil
+	}
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(v1alpha6.VirtualMachineAdvancedSpec)
@@ -5499,7 +5649,15 @@ func autoConvert_v1alpha5_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *
 	out.BootOptions = (*v1alpha6.VirtualMachineBootOptions)(unsafe.Pointer(in.BootOptions))
 	out.CurrentSnapshotName = in.CurrentSnapshotName
 	out.GroupName = in.GroupName
-	out.Hardware = (*v1alpha6.VirtualMachineHardwareSpec)(unsafe.Pointer(in.Hardware))
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(v1alpha6.VirtualMachineHardwareSpec)
+		if err := Convert_v1alpha5_VirtualMachineHardwareSpec_To_v1alpha6_VirtualMachineHardwareSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hardware = nil
+	}
 	out.Policies = *(*[]v1alpha6.PolicySpec)(unsafe.Pointer(&in.Policies))
 	return nil
 }
@@ -5512,6 +5670,7 @@ func Convert_v1alpha5_VirtualMachineSpec_To_v1alpha6_VirtualMachineSpec(in *Virt
 func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha5_VirtualMachineSpec(in *v1alpha6.VirtualMachineSpec, out *VirtualMachineSpec, s conversion.Scope) error {
 	out.Image = (*VirtualMachineImageRef)(unsafe.Pointer(in.Image))
 	out.ImageName = in.ImageName
+	// WARNING: in.CloneMode requires manual conversion: does not exist in peer-type
 	out.ClassName = in.ClassName
 	out.Class = (*v1alpha5common.LocalObjectRef)(unsafe.Pointer(in.Class))
 	out.Affinity = (*AffinitySpec)(unsafe.Pointer(in.Affinity))
@@ -5542,7 +5701,17 @@ func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha5_VirtualMachineSpec(in *
 	out.NextRestartTime = in.NextRestartTime
 	out.RestartMode = VirtualMachinePowerOpMode(in.RestartMode)
 	out.Volumes = *(*[]VirtualMachineVolume)(unsafe.Pointer(&in.Volumes))
-	out.ReadinessProbe = (*VirtualMachineReadinessProbeSpec)(unsafe.Pointer(in.ReadinessProbe))
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(VirtualMachineReadinessProbeSpec)
+		if err := Convert_v1alpha6_VirtualMachineReadinessProbeSpec_To_v1alpha5_VirtualMachineReadinessProbeSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ReadinessProbe = nil
+	}
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(VirtualMachineAdvancedSpec)
@@ -5561,7 +5730,15 @@ func autoConvert_v1alpha6_VirtualMachineSpec_To_v1alpha5_VirtualMachineSpec(in *
 	out.BootOptions = (*VirtualMachineBootOptions)(unsafe.Pointer(in.BootOptions))
 	out.CurrentSnapshotName = in.CurrentSnapshotName
 	out.GroupName = in.GroupName
-	out.Hardware = (*VirtualMachineHardwareSpec)(unsafe.Pointer(in.Hardware))
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(VirtualMachineHardwareSpec)
+		if err := Convert_v1alpha6_VirtualMachineHardwareSpec_To_v1alpha5_VirtualMachineHardwareSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hardware = nil
+	}
 	out.Policies = *(*[]PolicySpec)(unsafe.Pointer(&in.Policies))
 	return nil
 }
@@ -5572,7 +5749,15 @@ func autoConvert_v1alpha5_VirtualMachineStatus_To_v1alpha6_VirtualMachineStatus(
 	out.PowerState = v1alpha6.VirtualMachinePowerState(in.PowerState)
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.Crypto = (*v1alpha6.VirtualMachineCryptoStatus)(unsafe.Pointer(in.Crypto))
-	out.Network = (*v1alpha6.VirtualMachineNetworkStatus)(unsafe.Pointer(in.Network))
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(v1alpha6.VirtualMachineNetworkStatus)
+		if err := Convert_v1alpha5_VirtualMachineNetworkStatus_To_v1alpha6_VirtualMachineNetworkStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Network = nil
+	}
 	out.UniqueID = in.UniqueID
 	out.BiosUUID = in.BiosUUID
 	out.InstanceUUID = in.InstanceUUID
@@ -5602,7 +5787,15 @@ func autoConvert_v1alpha6_VirtualMachineStatus_To_v1alpha5_VirtualMachineStatus(
 	out.PowerState = VirtualMachinePowerState(in.PowerState)
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.Crypto = (*VirtualMachineCryptoStatus)(unsafe.Pointer(in.Crypto))
-	out.Network = (*VirtualMachineNetworkStatus)(unsafe.Pointer(in.Network))
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(VirtualMachineNetworkStatus)
+		if err := Convert_v1alpha6_VirtualMachineNetworkStatus_To_v1alpha5_VirtualMachineNetworkStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Network = nil
+	}
 	out.UniqueID = in.UniqueID
 	out.BiosUUID = in.BiosUUID
 	out.InstanceUUID = in.InstanceUUID
@@ -5610,6 +5803,10 @@ func autoConvert_v1alpha6_VirtualMachineStatus_To_v1alpha5_VirtualMachineStatus(
 	out.ChangeBlockTracking = (*bool)(unsafe.Pointer(in.ChangeBlockTracking))
 	out.Zone = in.Zone
 	out.LastRestartTime = (*v1.Time)(unsafe.Pointer(in.LastRestartTime))
+	// WARNING: in.BootstrapGeneration requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadinessProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.StartupProbe requires manual conversion: does not exist in peer-type
+	// WARNING: in.LivenessProbe requires manual conversion: does not exist in peer-type
 	out.HardwareVersion = in.HardwareVersion
 	out.Storage = (*VirtualMachineStorageStatus)(unsafe.Pointer(in.Storage))
 	out.Provider = (*VirtualMachineProviderStatus)(unsafe.Pointer(in.Provider))
@@ -5776,14 +5973,10 @@ func autoConvert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha5_VirtualMachineT
 	if err := Convert_v1alpha6_VirtualMachineSpec_To_v1alpha5_VirtualMachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	// WARNING: in.TopologySpreadConstraints requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha5_VirtualMachineTemplateSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha5_VirtualMachineTemplateSpec(in *v1alpha6.VirtualMachineTemplateSpec, out *VirtualMachineTemplateSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineTemplateSpec_To_v1alpha5_VirtualMachineTemplateSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineVolume_To_v1alpha6_VirtualMachineVolume(in *VirtualMachineVolume, out *v1alpha6.VirtualMachineVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha5_VirtualMachineVolumeSource_To_v1alpha6_VirtualMachineVolumeSource(&in.VirtualMachineVolumeSource, &out.VirtualMachineVolumeSource, s); err != nil {
@@ -5946,7 +6139,17 @@ func Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha5_VirtualMachine
 
 func autoConvert_v1alpha5_VirtualMachineWebConsoleRequestList_To_v1alpha6_VirtualMachineWebConsoleRequestList(in *VirtualMachineWebConsoleRequestList, out *v1alpha6.VirtualMachineWebConsoleRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha6.VirtualMachineWebConsoleRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha6.VirtualMachineWebConsoleRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachineWebConsoleRequest_To_v1alpha6_VirtualMachineWebConsoleRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -5957,7 +6160,17 @@ func Convert_v1alpha5_VirtualMachineWebConsoleRequestList_To_v1alpha6_VirtualMac
 
 func autoConvert_v1alpha6_VirtualMachineWebConsoleRequestList_To_v1alpha5_VirtualMachineWebConsoleRequestList(in *v1alpha6.VirtualMachineWebConsoleRequestList, out *VirtualMachineWebConsoleRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineWebConsoleRequest)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineWebConsoleRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha6_VirtualMachineWebConsoleRequest_To_v1alpha5_VirtualMachineWebConsoleRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -5980,14 +6193,11 @@ func Convert_v1alpha5_VirtualMachineWebConsoleRequestSpec_To_v1alpha6_VirtualMac
 func autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha5_VirtualMachineWebConsoleRequestSpec(in *v1alpha6.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.PublicKey = in.PublicKey
+	// WARNING: in.TTLSeconds requires manual conversion: does not exist in peer-type
+	// WARNING: in.Revoked requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha5_VirtualMachineWebConsoleRequestSpec is an autogenerated conversion function.
-func Convert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha5_VirtualMachineWebConsoleRequestSpec(in *v1alpha6.VirtualMachineWebConsoleRequestSpec, out *VirtualMachineWebConsoleRequestSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha6_VirtualMachineWebConsoleRequestSpec_To_v1alpha5_VirtualMachineWebConsoleRequestSpec(in, out, s)
-}
-
 func autoConvert_v1alpha5_VirtualMachineWebConsoleRequestStatus_To_v1alpha6_VirtualMachineWebConsoleRequestStatus(in *VirtualMachineWebConsoleRequestStatus, out *v1alpha6.VirtualMachineWebConsoleRequestStatus, s conversion.Scope) error {
 	out.Response = in.Response
 	out.ExpiryTime = in.ExpiryTime
@@ -69,6 +69,81 @@ type CloudConfig struct {
 	// already been started. On non-systemd systems, a restart will be attempted
 	// regardless of the service state.
 	SSHPwdAuth *bool `json:"ssh_pwauth,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+
+	// BootCmd allows running one or more commands on the guest very early in
+	// the boot process, on every boot.
+	// The entries in this list adhere to the same formats as RunCmd.
+	BootCmd json.RawMessage `json:"bootcmd,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Packages is a list of packages to install on the guest.
+	Packages []Package `json:"packages,omitempty"`
+
+	// +optional
+
+	// PackageUpdate may be set to true to update the guest's package database
+	// prior to installing packages.
+	PackageUpdate bool `json:"package_update,omitempty"`
+
+	// +optional
+
+	// PackageUpgrade may be set to true to upgrade the guest's packages prior
+	// to installing packages.
+	PackageUpgrade bool `json:"package_upgrade,omitempty"`
+
+	// +optional
+
+	// PackageRebootIfRequired may be set to true to reboot the guest if it is
+	// required after updating, upgrading, or installing packages.
+	PackageRebootIfRequired bool `json:"package_reboot_if_required,omitempty"`
+
+	// +optional
+
+	// Apt configures the APT package manager on Debian-based guests.
+	Apt *Apt `json:"apt,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+
+	// YumRepos allows adding repositories to the YUM and DNF package managers
+	// on RHEL-based guests.
+	YumRepos []YumRepo `json:"yum_repos,omitempty"`
+
+	// +optional
+
+	// NTP configures the guest's NTP client.
+	NTP *NTP `json:"ntp,omitempty"`
+
+	// +optional
+
+	// Mounts is a list of file systems to add to /etc/fstab and mount.
+	Mounts []Mount `json:"mounts,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=device
+
+	// DiskSetup allows partitioning one or more of the guest's disks.
+	DiskSetup []DiskSetup `json:"disk_setup,omitempty"`
+
+	// +optional
+
+	// FSSetup allows creating file systems on the guest's disks and
+	// partitions.
+	FSSetup []FSSetup `json:"fs_setup,omitempty"`
+
+	// +optional
+
+	// CACerts configures the guest's trusted CA certificates.
+	CACerts *CACerts `json:"ca_certs,omitempty"`
 }
 
 // User is a CloudConfig user data structure.
@@ -322,3 +397,396 @@ type WriteFile struct {
 	// When omitted the guest will default this value to "0644".
 	Permissions string `json:"permissions,omitempty"`
 }
+
+// Package is a CloudConfig package data structure.
+type Package struct {
+	// Name is the name of the package.
+	Name string `json:"name"`
+
+	// +optional
+
+	// Version is the optional version of the package to install.
+	//
+	// When omitted the guest's package manager installs its preferred
+	// version.
+	Version string `json:"version,omitempty"`
+}
+
+// Apt is a CloudConfig apt data structure.
+type Apt struct {
+	// +optional
+
+	// PreserveSourcesList may be set to true to preserve the guest's existing
+	// sources list instead of generating a new one.
+	//
+	// Please note this does not prevent Sources from being added.
+	PreserveSourcesList *bool `json:"preserve_sources_list,omitempty"`
+
+	// +optional
+
+	// Primary is a list of the archive mirrors to use, per architecture.
+	Primary []AptMirror `json:"primary,omitempty"`
+
+	// +optional
+
+	// Security is a list of the security archive mirrors to use, per
+	// architecture.
+	//
+	// When omitted the mirrors from Primary are used.
+	Security []AptMirror `json:"security,omitempty"`
+
+	// +optional
+
+	// HTTPProxy is the optional proxy for HTTP requests, in the format
+	// "http://[[user][:pass]@]host[:port]/".
+	//
+	// Please use a Secret resource when the proxy URL includes credentials.
+	HTTPProxy *vmopv1common.ValueOrSecretKeySelector `json:"http_proxy,omitempty"`
+
+	// +optional
+
+	// HTTPSProxy is the optional proxy for HTTPS requests, in the format
+	// "https://[[user][:pass]@]host[:port]/".
+	//
+	// Please use a Secret resource when the proxy URL includes credentials.
+	HTTPSProxy *vmopv1common.ValueOrSecretKeySelector `json:"https_proxy,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Sources is a list of additional sources to add to the guest.
+	Sources []AptSource `json:"sources,omitempty"`
+}
+
+// AptMirror is a CloudConfig apt mirror data structure.
+type AptMirror struct {
+	// +kubebuilder:validation:MinItems=1
+
+	// Arches is the list of architectures to which the mirror applies.
+	//
+	// Please note the special value "default" applies the mirror to any
+	// architecture that is not explicitly listed.
+	Arches []string `json:"arches"`
+
+	// +optional
+
+	// URI is the URI of the mirror.
+	URI string `json:"uri,omitempty"`
+
+	// +optional
+
+	// Search is a list of mirror URIs, the first of which that can be resolved
+	// is used.
+	Search []string `json:"search,omitempty"`
+}
+
+// AptSource is a CloudConfig apt source data structure.
+type AptSource struct {
+	// Name is the name of the source and is also used as the name of the
+	// source's file in /etc/apt/sources.list.d.
+	Name string `json:"name"`
+
+	// +optional
+
+	// Source is the sources.list entry for the source, ex.
+	// "deb http://example.com/ubuntu $RELEASE main".
+	Source string `json:"source,omitempty"`
+
+	// +optional
+
+	// KeyID is the ID or fingerprint of the source's key to import from
+	// KeyServer.
+	KeyID string `json:"keyid,omitempty"`
+
+	// +optional
+
+	// KeyServer is the key server from which to import KeyID.
+	KeyServer string `json:"keyserver,omitempty"`
+
+	// +optional
+
+	// Key is the source's raw PGP key.
+	Key *vmopv1common.ValueOrSecretKeySelector `json:"key,omitempty"`
+
+	// +optional
+
+	// Filename is the optional name of the source's file in
+	// /etc/apt/sources.list.d.
+	//
+	// Defaults to the value of the Name field.
+	Filename string `json:"filename,omitempty"`
+
+	// +optional
+
+	// Append specifies whether or not to append the source to its file if the
+	// file already exists instead of replacing the file.
+	//
+	// Defaults to true.
+	Append *bool `json:"append,omitempty"`
+}
+
+// YumRepo is a CloudConfig yum_repos data structure.
+type YumRepo struct {
+	// ID is the ID of the repository and is also used as the name of the
+	// repository's file in /etc/yum.repos.d.
+	ID string `json:"id"`
+
+	// +optional
+
+	// Name is the human-readable name of the repository.
+	Name string `json:"name,omitempty"`
+
+	// +optional
+
+	// BaseURL is the URL of the repository.
+	//
+	// Please note one of BaseURL, MetaLink, or MirrorList is required.
+	BaseURL string `json:"baseurl,omitempty"`
+
+	// +optional
+
+	// MetaLink is the URL of a metalink file for the repository.
+	MetaLink string `json:"metalink,omitempty"`
+
+	// +optional
+
+	// MirrorList is the URL of a file that contains a list of the
+	// repository's base URLs.
+	MirrorList string `json:"mirrorlist,omitempty"`
+
+	// +optional
+
+	// Enabled specifies whether or not the repository is enabled.
+	//
+	// Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +optional
+
+	// GPGCheck specifies whether or not to verify the GPG signatures of the
+	// repository's packages.
+	GPGCheck *bool `json:"gpgcheck,omitempty"`
+
+	// +optional
+
+	// GPGKey is the URL of the repository's GPG key.
+	GPGKey string `json:"gpgkey,omitempty"`
+
+	// +optional
+
+	// Username is the name of the user used to authenticate to the
+	// repository.
+	Username string `json:"username,omitempty"`
+
+	// +optional
+
+	// Password is the password used to authenticate to the repository.
+	Password *vmopv1common.SecretKeySelector `json:"password,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=auto;chrony;ntp;ntpdate;openntpd;systemd-timesyncd
+
+// NTPClient specifies the NTP client to configure on the guest.
+type NTPClient string
+
+const (
+	NTPClientAuto            NTPClient = "auto"
+	NTPClientChrony          NTPClient = "chrony"
+	NTPClientNTP             NTPClient = "ntp"
+	NTPClientNTPDate         NTPClient = "ntpdate"
+	NTPClientOpenNTPD        NTPClient = "openntpd"
+	NTPClientSystemdTimesync NTPClient = "systemd-timesyncd"
+)
+
+// NTP is a CloudConfig ntp data structure.
+type NTP struct {
+	// +optional
+
+	// Enabled specifies whether or not to install and configure the NTP
+	// client.
+	//
+	// Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +optional
+
+	// NTPClient is the NTP client to configure.
+	//
+	// When omitted or set to "auto", the distribution's preferred client is
+	// used.
+	NTPClient NTPClient `json:"ntp_client,omitempty"`
+
+	// +optional
+
+	// Servers is a list of NTP servers.
+	Servers []string `json:"servers,omitempty"`
+
+	// +optional
+
+	// Pools is a list of NTP pools.
+	//
+	// Please note when both Servers and Pools are empty, the guest uses the
+	// distribution's default pools.
+	Pools []string `json:"pools,omitempty"`
+}
+
+// Mount is a CloudConfig mounts data structure and describes an /etc/fstab
+// entry.
+type Mount struct {
+	// Device is the block device or remote file system to mount, ex.
+	// "/dev/sdb1" or "LABEL=data".
+	Device string `json:"device"`
+
+	// MountPoint is the path at which to mount the file system.
+	MountPoint string `json:"mountPoint"`
+
+	// +optional
+
+	// FSType is the type of the file system.
+	//
+	// Defaults to "auto".
+	FSType string `json:"fsType,omitempty"`
+
+	// +optional
+
+	// Options is the comma-separated list of mount options.
+	//
+	// Defaults to the guest's default mount options.
+	Options string `json:"options,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// Dump specifies whether or not the file system should be dumped.
+	//
+	// Defaults to 0.
+	Dump *int32 `json:"dump,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// Pass is the order in which the file system is checked at boot time.
+	//
+	// Defaults to 2.
+	Pass *int32 `json:"pass,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=gpt;mbr
+
+// PartitionTableType specifies the type of a disk's partition table.
+type PartitionTableType string
+
+const (
+	PartitionTableTypeGPT PartitionTableType = "gpt"
+	PartitionTableTypeMBR PartitionTableType = "mbr"
+)
+
+// DiskSetup is a CloudConfig disk_setup data structure.
+type DiskSetup struct {
+	// Device is the path or alias of the disk to partition, ex. "/dev/sdb".
+	Device string `json:"device"`
+
+	// +optional
+	// +kubebuilder:default=mbr
+
+	// TableType is the type of the disk's partition table.
+	TableType PartitionTableType `json:"table_type,omitempty"`
+
+	// +optional
+
+	// Partitions is a list of the partitions to create on the disk.
+	//
+	// When omitted a single partition that spans the entire disk is created.
+	Partitions []DiskPartition `json:"partitions,omitempty"`
+
+	// +optional
+
+	// Overwrite may be set to true to partition the disk even if it already
+	// has a partition table or file system.
+	//
+	// Please note this is destructive and may result in the loss of data.
+	Overwrite bool `json:"overwrite,omitempty"`
+}
+
+// DiskPartition describes a partition created by a DiskSetup.
+type DiskPartition struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Percentage is the size of the partition, as a percentage of the disk.
+	Percentage int32 `json:"percentage"`
+
+	// +optional
+
+	// Type is the optional partition type, ex. "82" for a Linux swap
+	// partition in an MBR partition table.
+	//
+	// Defaults to a Linux partition.
+	Type string `json:"type,omitempty"`
+}
+
+// FSSetup is a CloudConfig fs_setup data structure.
+type FSSetup struct {
+	// Device is the path or alias of the device on which to create the file
+	// system, ex. "/dev/sdb".
+	Device string `json:"device"`
+
+	// Filesystem is the type of file system to create, ex. "ext4".
+	Filesystem string `json:"filesystem"`
+
+	// +optional
+
+	// Label is the label for the file system.
+	Label string `json:"label,omitempty"`
+
+	// +optional
+
+	// Partition is the partition on which to create the file system.
+	//
+	// The value may be a partition number, ex. "1", or one of the following
+	// special values:
+	//
+	// - "auto" -- Use the first partition that does not contain a file
+	//             system, and skip creating the file system if one with the
+	//             same label already exists.
+	// - "any"  -- Skip creating the file system if any file system of the
+	//             same type already exists.
+	// - "none" -- Create the file system directly on the device.
+	Partition string `json:"partition,omitempty"`
+
+	// +optional
+
+	// Overwrite may be set to true to create the file system even if one
+	// already exists.
+	//
+	// Please note this is destructive and may result in the loss of data.
+	Overwrite bool `json:"overwrite,omitempty"`
+
+	// +optional
+
+	// ReplaceFS is the type of an existing file system that may be replaced
+	// when Partition is "auto" or "any".
+	ReplaceFS string `json:"replace_fs,omitempty"`
+
+	// +optional
+
+	// ExtraOpts is a list of additional options for the command used to
+	// create the file system.
+	ExtraOpts []string `json:"extra_opts,omitempty"`
+}
+
+// CACerts is a CloudConfig ca_certs data structure.
+type CACerts struct {
+	// +optional
+
+	// RemoveDefaults may be set to true to remove the guest's default trusted
+	// CA certificates.
+	RemoveDefaults bool `json:"remove_defaults,omitempty"`
+
+	// +optional
+
+	// Trusted is a list of PEM-encoded CA certificates to add to the guest's
+	// trusted CA certificates.
+	Trusted []vmopv1common.ValueOrSecretKeySelector `json:"trusted,omitempty"`
+}
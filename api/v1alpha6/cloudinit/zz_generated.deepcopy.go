@@ -13,6 +13,129 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Apt) DeepCopyInto(out *Apt) {
+	*out = *in
+	if in.PreserveSourcesList != nil {
+		in, out := &in.PreserveSourcesList, &out.PreserveSourcesList
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = make([]AptMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = make([]AptMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HTTPProxy != nil {
+		in, out := &in.HTTPProxy, &out.HTTPProxy
+		*out = new(common.ValueOrSecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPSProxy != nil {
+		in, out := &in.HTTPSProxy, &out.HTTPSProxy
+		*out = new(common.ValueOrSecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]AptSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Apt.
+func (in *Apt) DeepCopy() *Apt {
+	if in == nil {
+		return nil
+	}
+	out := new(Apt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AptMirror) DeepCopyInto(out *AptMirror) {
+	*out = *in
+	if in.Arches != nil {
+		in, out := &in.Arches, &out.Arches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Search != nil {
+		in, out := &in.Search, &out.Search
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AptMirror.
+func (in *AptMirror) DeepCopy() *AptMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(AptMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AptSource) DeepCopyInto(out *AptSource) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(common.ValueOrSecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Append != nil {
+		in, out := &in.Append, &out.Append
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AptSource.
+func (in *AptSource) DeepCopy() *AptSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AptSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CACerts) DeepCopyInto(out *CACerts) {
+	*out = *in
+	if in.Trusted != nil {
+		in, out := &in.Trusted, &out.Trusted
+		*out = make([]common.ValueOrSecretKeySelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CACerts.
+func (in *CACerts) DeepCopy() *CACerts {
+	if in == nil {
+		return nil
+	}
+	out := new(CACerts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudConfig) DeepCopyInto(out *CloudConfig) {
 	*out = *in
@@ -40,6 +163,59 @@ func (in *CloudConfig) DeepCopyInto(out *CloudConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BootCmd != nil {
+		in, out := &in.BootCmd, &out.BootCmd
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]Package, len(*in))
+		copy(*out, *in)
+	}
+	if in.Apt != nil {
+		in, out := &in.Apt, &out.Apt
+		*out = new(Apt)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.YumRepos != nil {
+		in, out := &in.YumRepos, &out.YumRepos
+		*out = make([]YumRepo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NTP != nil {
+		in, out := &in.NTP, &out.NTP
+		*out = new(NTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]Mount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DiskSetup != nil {
+		in, out := &in.DiskSetup, &out.DiskSetup
+		*out = make([]DiskSetup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FSSetup != nil {
+		in, out := &in.FSSetup, &out.FSSetup
+		*out = make([]FSSetup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CACerts != nil {
+		in, out := &in.CACerts, &out.CACerts
+		*out = new(CACerts)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudConfig.
@@ -52,6 +228,131 @@ func (in *CloudConfig) DeepCopy() *CloudConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskPartition) DeepCopyInto(out *DiskPartition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskPartition.
+func (in *DiskPartition) DeepCopy() *DiskPartition {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskPartition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSetup) DeepCopyInto(out *DiskSetup) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = make([]DiskPartition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSetup.
+func (in *DiskSetup) DeepCopy() *DiskSetup {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSetup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSSetup) DeepCopyInto(out *FSSetup) {
+	*out = *in
+	if in.ExtraOpts != nil {
+		in, out := &in.ExtraOpts, &out.ExtraOpts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSSetup.
+func (in *FSSetup) DeepCopy() *FSSetup {
+	if in == nil {
+		return nil
+	}
+	out := new(FSSetup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mount) DeepCopyInto(out *Mount) {
+	*out = *in
+	if in.Dump != nil {
+		in, out := &in.Dump, &out.Dump
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Pass != nil {
+		in, out := &in.Pass, &out.Pass
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mount.
+func (in *Mount) DeepCopy() *Mount {
+	if in == nil {
+		return nil
+	}
+	out := new(Mount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NTP) DeepCopyInto(out *NTP) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTP.
+func (in *NTP) DeepCopy() *NTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Package) DeepCopyInto(out *Package) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Package.
+func (in *Package) DeepCopy() *Package {
+	if in == nil {
+		return nil
+	}
+	out := new(Package)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -196,3 +497,33 @@ func (in *WriteFile) DeepCopy() *WriteFile {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YumRepo) DeepCopyInto(out *YumRepo) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GPGCheck != nil {
+		in, out := &in.GPGCheck, &out.GPGCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YumRepo.
+func (in *YumRepo) DeepCopy() *YumRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(YumRepo)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -37,8 +37,8 @@ type VirtualMachineBootstrapSpec struct {
 	// VAppConfig bootstrap provider when wanting to configure the guest's
 	// network with GOSC but also send vApp/OVF properties into the guest.
 	//
-	// This bootstrap provider may not be used in conjunction with the CloudInit
-	// or Sysprep bootstrap providers.
+	// This bootstrap provider may not be used in conjunction with the
+	// CloudInit, Ignition, or Sysprep bootstrap providers.
 	LinuxPrep *VirtualMachineBootstrapLinuxPrepSpec `json:"linuxPrep,omitempty"`
 
 	// +optional
@@ -52,8 +52,8 @@ type VirtualMachineBootstrapSpec struct {
 	// VAppConfig bootstrap provider when wanting to configure the guest's
 	// network with GOSC but also send vApp/OVF properties into the guest.
 	//
-	// This bootstrap provider may not be used in conjunction with the CloudInit
-	// or LinuxPrep bootstrap providers.
+	// This bootstrap provider may not be used in conjunction with the
+	// CloudInit, Ignition, or LinuxPrep bootstrap providers.
 	Sysprep *VirtualMachineBootstrapSysprepSpec `json:"sysprep,omitempty"`
 
 	// +optional
@@ -79,18 +79,52 @@ type VirtualMachineBootstrapSpec struct {
 	// VM metadata transport to "OvfEnv".
 	//
 	// This bootstrap provider may not be used in conjunction with the CloudInit
-	// bootstrap provider.
+	// or Ignition bootstrap providers.
 	VAppConfig *VirtualMachineBootstrapVAppConfigSpec `json:"vAppConfig,omitempty"`
 
 	// +optional
 
+	// Ignition may be used to bootstrap Linux guests that use Ignition, such
+	// as Fedora CoreOS and Flatcar Container Linux.
+	//
+	// The guest's networking stack is configured by the Ignition config, into
+	// which the VM's network configuration is merged.
+	//
+	// Please note this bootstrap provider may not be used in conjunction with
+	// the other bootstrap providers.
+	Ignition *VirtualMachineBootstrapIgnitionSpec `json:"ignition,omitempty"`
+
+	// +optional
+
 	// Disabled is a flag that indicates whether or not to disable bootstrap
 	// for this VM.
 	//
 	// When set to true, the bootstrap customization is not applied to the VM,
-	// even if a bootstrap provider such as CloudInit, LinuxPrep, Sysprep, or
-	// VAppConfig is specified.
+	// even if a bootstrap provider such as CloudInit, LinuxPrep, Sysprep,
+	// VAppConfig, or Ignition is specified.
 	Disabled bool `json:"disabled,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// Generation may be increased to re-run bootstrap on a VM that has already
+	// been deployed, for example to rotate the guest's host name, network
+	// configuration, or SSH keys without recreating the VM.
+	//
+	// When this value differs from status.bootstrapGeneration, the VM is
+	// powered off using spec.powerOffMode, the bootstrap provider is applied
+	// to the powered off VM as if it were being deployed, and the VM is
+	// powered on again if spec.powerState is PoweredOn.
+	//
+	// When this value is non-zero and the CloudInit bootstrap provider is
+	// used, the value is appended to the Cloud-Init instance ID so that
+	// Cloud-Init treats the next boot as the first boot of a new instance.
+	//
+	// The progress of re-running bootstrap is reported by the
+	// GuestCustomization condition.
+	//
+	// Please note this value may not be decreased.
+	Generation int64 `json:"generation,omitempty"`
 }
 
 // VirtualMachineBootstrapCloudInitSpec describes the CloudInit configuration
@@ -166,6 +200,29 @@ type VirtualMachineBootstrapCloudInitSpec struct {
 	WaitOnNetwork6 *bool `json:"waitOnNetwork6,omitempty"`
 }
 
+// VirtualMachineBootstrapIgnitionSpec describes the Ignition configuration
+// used to bootstrap the VM.
+type VirtualMachineBootstrapIgnitionSpec struct {
+	// Config is the Ignition config used to bootstrap the VM, either
+	// specified directly or from a key in a Secret resource.
+	//
+	// The config must be JSON that conforms to one of the Ignition
+	// specification versions 3.0.0 through 3.5.0, and the version must be
+	// supported by the guest. The config may be plain-text, base64-encoded,
+	// or gzipped and base64-encoded.
+	Config vmopv1common.ValueOrSecretKeySelector `json:"config"`
+
+	// +optional
+
+	// DisableNetworkConfig indicates whether or not to skip merging the VM's
+	// network configuration into the Ignition config.
+	//
+	// When omitted or false, the config is merged with a config that writes
+	// the guest's host name and a systemd-networkd configuration for each of
+	// the VM's network interfaces.
+	DisableNetworkConfig bool `json:"disableNetworkConfig,omitempty"`
+}
+
 // VirtualMachineBootstrapLinuxPrepSpec describes the LinuxPrep configuration
 // used to bootstrap the VM.
 type VirtualMachineBootstrapLinuxPrepSpec struct {
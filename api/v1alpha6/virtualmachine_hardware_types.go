@@ -381,6 +381,31 @@ type VirtualMachineHardwareSpec struct {
 	// SCSIControllers describes the desired list of SCSI controllers for the
 	// VM.
 	SCSIControllers []SCSIControllerSpec `json:"scsiControllers,omitempty"`
+
+	// +optional
+
+	// SerialConsole describes the desired state of the VM's serial console.
+	//
+	// The serial console is a network-backed serial port that may be accessed
+	// with a VirtualMachineSerialConsoleRequest resource.
+	SerialConsole *VirtualMachineSerialConsoleSpec `json:"serialConsole,omitempty"`
+}
+
+// VirtualMachineSerialConsoleSpec describes the desired state of a VM's serial
+// console.
+type VirtualMachineSerialConsoleSpec struct {
+	// +optional
+
+	// Enabled describes whether or not the VM has a network-backed serial
+	// port that may be used as a serial console.
+	//
+	// The serial port may only be added or removed when the VM is powered
+	// off.
+	//
+	// Please note, the guest must be configured to use the serial port as a
+	// console, ex. by specifying console=ttyS0 on the Linux kernel command
+	// line.
+	Enabled bool `json:"enabled,omitempty"`
 }
 
 type VirtualMachineCPUAllocationStatus struct {
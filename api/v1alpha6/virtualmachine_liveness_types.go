@@ -0,0 +1,119 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineLivenessProbeFailedReason is the reason used for events
+	// emitted when a VM's liveness probe fails.
+	VirtualMachineLivenessProbeFailedReason = "LivenessProbeFailed"
+
+	// VirtualMachineLivenessRestartReason is the reason used for events
+	// emitted when a VM is restarted because its liveness probe failed too
+	// many times in a row.
+	VirtualMachineLivenessRestartReason = "LivenessRestart"
+)
+
+// VirtualMachineLivenessProbeSpec describes a probe used to determine if a
+// VM's guest is alive. When the probe fails FailureThreshold times in a row,
+// the VM is restarted in accordance with spec.restartMode, unless restarting
+// the VM would violate a VirtualMachineDisruptionBudget that selects the VM,
+// in which case the restart is retried the next time the probe fails.
+//
+// All probe actions are mutually exclusive.
+type VirtualMachineLivenessProbeSpec struct {
+	// +optional
+
+	// TCPSocket specifies an action involving a TCP port.
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"`
+
+	// +optional
+
+	// HTTPGet specifies an action involving an HTTP GET request to the VM.
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+
+	// +optional
+
+	// Exec specifies an action involving a command that is run in the guest
+	// using VMware Tools guest operations.
+	Exec *ExecAction `json:"exec,omitempty"`
+
+	// +optional
+
+	// GuestHeartbeat specifies an action involving the guest heartbeat status.
+	GuestHeartbeat *GuestHeartbeatAction `json:"guestHeartbeat,omitempty"`
+
+	// +optional
+
+	// GuestInfo specifies an action involving key/value pairs from GuestInfo.
+	//
+	// The elements are evaluated with the logical AND operator, meaning
+	// all expressions must evaluate as true for the probe to succeed.
+	GuestInfo []GuestInfoAction `json:"guestInfo,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=60
+
+	// TimeoutSeconds specifies a number of seconds after which the probe times out.
+	// Defaults to 10 seconds. Minimum value is 1.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+
+	// PeriodSeconds specifics how often (in seconds) to perform the probe.
+	// Defaults to 10 seconds. Minimum value is 1.
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+
+	// InitialDelaySeconds specifies the number of seconds after the VM is
+	// powered on or restarted before failures of the probe are counted.
+	// Defaults to 0 seconds.
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum:=1
+
+	// FailureThreshold specifies the number of consecutive failures of the
+	// probe after which the VM is restarted.
+	// Defaults to 3. Minimum value is 1.
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// VirtualMachineLivenessProbeStatus describes the observed state of a VM's
+// liveness probe.
+type VirtualMachineLivenessProbeStatus struct {
+	// +optional
+
+	// ConsecutiveFailures is the number of times in a row the probe has
+	// failed since it last succeeded or the VM was last restarted.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// +optional
+
+	// StartTime is the time the probe began to observe the VM as powered on,
+	// either after the VM was powered on or restarted. Failures of the probe
+	// are not counted until InitialDelaySeconds after this time.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+
+	// RestartCount is the number of times the VM has been restarted because
+	// the probe failed.
+	RestartCount int32 `json:"restartCount,omitempty"`
+
+	// +optional
+
+	// LastRestartTime describes the last time the VM was restarted because
+	// the probe failed.
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+}
@@ -54,9 +54,9 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// +kubebuilder:validation:Pattern=^\w\w+$
 
 	// GuestDeviceName is used to rename the device inside the guest when the
-	// bootstrap provider is Cloud-Init. Please note it is up to the user to
-	// ensure the provided device name does not conflict with any other devices
-	// inside the guest, ex. dvd, cdrom, sda, etc.
+	// bootstrap provider is Cloud-Init or Ignition. Please note it is up to
+	// the user to ensure the provided device name does not conflict with any
+	// other devices inside the guest, ex. dvd, cdrom, sda, etc.
 	GuestDeviceName string `json:"guestDeviceName,omitempty"`
 
 	// +optional
@@ -89,6 +89,22 @@ type VirtualMachineNetworkInterfaceSpec struct {
 
 	// +optional
 
+	// IPPoolName is the name of a VirtualMachineIPPool in the same namespace
+	// from which the addresses for this interface are allocated.
+	//
+	// One address is allocated from the pool for each IP family of the pool's
+	// CIDRs. The pool's gateways and nameservers are also applied to this
+	// interface unless the Gateway4, Gateway6, or Nameservers fields are set.
+	// The allocated addresses are released when the interface is removed or
+	// the VM is deleted.
+	//
+	// Please note this field is only supported when the network provider is
+	// Named, and it may not be specified with the Addresses, DHCP4, or DHCP6
+	// fields.
+	IPPoolName string `json:"ipPoolName,omitempty"`
+
+	// +optional
+
 	// DHCP4 indicates whether or not this interface uses DHCP for IP4
 	// networking.
 	//
@@ -142,7 +158,7 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// MTU is the Maximum Transmission Unit size in bytes.
 	//
 	// Please note this feature is available only with the following bootstrap
-	// providers: CloudInit.
+	// providers: CloudInit and Ignition.
 	MTU *int64 `json:"mtu,omitempty"`
 
 	// +optional
@@ -151,7 +167,7 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// nameservers.
 	//
 	// Please note this feature is available only with the following bootstrap
-	// providers: CloudInit and Sysprep.
+	// providers: CloudInit, Ignition, and Sysprep.
 	//
 	// When using CloudInit and UseGlobalNameserversAsDefault is either unset or
 	// true, if nameservers is not provided, the global nameservers will be used
@@ -166,7 +182,7 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// Routes is a list of optional, static routes.
 	//
 	// Please note this feature is available only with the following bootstrap
-	// providers: CloudInit.
+	// providers: CloudInit and Ignition.
 	Routes []VirtualMachineNetworkRouteSpec `json:"routes,omitempty"`
 
 	// +optional
@@ -175,7 +191,7 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// addresses with DNS.
 	//
 	// Please note this feature is available only with the following bootstrap
-	// providers: CloudInit.
+	// providers: CloudInit and Ignition.
 	//
 	// When using CloudInit and UseGlobalSearchDomainsAsDefault is either unset
 	// or true, if search domains is not provided, the global search domains
@@ -245,10 +261,226 @@ type VirtualMachineNetworkVLANSpec struct {
 	// +kubebuilder:validation:Required
 
 	// Link is the name of the parent interface on which this VLAN is created.
-	// This must reference an interface name from the Interfaces list.
+	// This must reference an interface name from the Interfaces list, or the
+	// name of a bond or bridge.
 	Link string `json:"link"`
 }
 
+// +kubebuilder:validation:Enum=balance-rr;active-backup;balance-xor;broadcast;"802.3ad";balance-tlb;balance-alb
+
+// VirtualMachineNetworkBondMode describes the bonding mode of a bond
+// interface.
+type VirtualMachineNetworkBondMode string
+
+const (
+	// VirtualMachineNetworkBondModeBalanceRR transmits packets in sequential
+	// order from the first available member through the last.
+	VirtualMachineNetworkBondModeBalanceRR VirtualMachineNetworkBondMode = "balance-rr"
+
+	// VirtualMachineNetworkBondModeActiveBackup uses only one member at a
+	// time. A different member becomes active if the active member fails.
+	VirtualMachineNetworkBondModeActiveBackup VirtualMachineNetworkBondMode = "active-backup"
+
+	// VirtualMachineNetworkBondModeBalanceXOR transmits packets based on the
+	// transmit hash policy.
+	VirtualMachineNetworkBondModeBalanceXOR VirtualMachineNetworkBondMode = "balance-xor"
+
+	// VirtualMachineNetworkBondModeBroadcast transmits every packet on all
+	// members.
+	VirtualMachineNetworkBondModeBroadcast VirtualMachineNetworkBondMode = "broadcast"
+
+	// VirtualMachineNetworkBondMode8023AD uses IEEE 802.3ad dynamic link
+	// aggregation (LACP).
+	VirtualMachineNetworkBondMode8023AD VirtualMachineNetworkBondMode = "802.3ad"
+
+	// VirtualMachineNetworkBondModeBalanceTLB uses adaptive transmit load
+	// balancing.
+	VirtualMachineNetworkBondModeBalanceTLB VirtualMachineNetworkBondMode = "balance-tlb"
+
+	// VirtualMachineNetworkBondModeBalanceALB uses adaptive transmit and
+	// receive load balancing.
+	VirtualMachineNetworkBondModeBalanceALB VirtualMachineNetworkBondMode = "balance-alb"
+)
+
+// +kubebuilder:validation:Enum=slow;fast
+
+// VirtualMachineNetworkBondLACPRate describes the rate at which LACPDUs are
+// transmitted.
+type VirtualMachineNetworkBondLACPRate string
+
+const (
+	// VirtualMachineNetworkBondLACPRateSlow transmits LACPDUs every 30
+	// seconds.
+	VirtualMachineNetworkBondLACPRateSlow VirtualMachineNetworkBondLACPRate = "slow"
+
+	// VirtualMachineNetworkBondLACPRateFast transmits LACPDUs every second.
+	VirtualMachineNetworkBondLACPRateFast VirtualMachineNetworkBondLACPRate = "fast"
+)
+
+// +kubebuilder:validation:Enum=layer2;"layer2+3";"layer3+4";"encap2+3";"encap3+4"
+
+// VirtualMachineNetworkBondTransmitHashPolicy describes the hash policy used
+// to select a bond member when transmitting packets.
+type VirtualMachineNetworkBondTransmitHashPolicy string
+
+const (
+	// VirtualMachineNetworkBondTransmitHashPolicyLayer2 uses the MAC
+	// addresses to select a member.
+	VirtualMachineNetworkBondTransmitHashPolicyLayer2 VirtualMachineNetworkBondTransmitHashPolicy = "layer2"
+
+	// VirtualMachineNetworkBondTransmitHashPolicyLayer23 uses the MAC and IP
+	// addresses to select a member.
+	VirtualMachineNetworkBondTransmitHashPolicyLayer23 VirtualMachineNetworkBondTransmitHashPolicy = "layer2+3"
+
+	// VirtualMachineNetworkBondTransmitHashPolicyLayer34 uses the IP
+	// addresses and ports to select a member.
+	VirtualMachineNetworkBondTransmitHashPolicyLayer34 VirtualMachineNetworkBondTransmitHashPolicy = "layer3+4"
+
+	// VirtualMachineNetworkBondTransmitHashPolicyEncap23 is the same as
+	// layer2+3, but uses the inner headers of encapsulated packets.
+	VirtualMachineNetworkBondTransmitHashPolicyEncap23 VirtualMachineNetworkBondTransmitHashPolicy = "encap2+3"
+
+	// VirtualMachineNetworkBondTransmitHashPolicyEncap34 is the same as
+	// layer3+4, but uses the inner headers of encapsulated packets.
+	VirtualMachineNetworkBondTransmitHashPolicyEncap34 VirtualMachineNetworkBondTransmitHashPolicy = "encap3+4"
+)
+
+// VirtualMachineNetworkBondParameters describes the parameters of a bond
+// interface.
+type VirtualMachineNetworkBondParameters struct {
+	// +optional
+
+	// Mode describes the bonding mode.
+	//
+	// Defaults to balance-rr.
+	Mode VirtualMachineNetworkBondMode `json:"mode,omitempty"`
+
+	// +optional
+
+	// Primary is the name of the member interface that is preferred when it
+	// is available. The primary interface's IP configuration is applied to
+	// the bond.
+	//
+	// This field is only used with the active-backup, balance-tlb, and
+	// balance-alb modes.
+	Primary string `json:"primary,omitempty"`
+
+	// +optional
+
+	// LACPRate describes the rate at which LACPDUs are transmitted.
+	//
+	// This field is only used with the 802.3ad mode.
+	LACPRate VirtualMachineNetworkBondLACPRate `json:"lacpRate,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// MIIMonitorIntervalMilliseconds describes how often, in milliseconds,
+	// the carrier of each member interface is checked. A value of zero
+	// disables MII monitoring.
+	MIIMonitorIntervalMilliseconds int64 `json:"miiMonitorIntervalMilliseconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// UpDelayMilliseconds describes how long, in milliseconds, to wait before
+	// enabling a member interface after its link comes up. This field is only
+	// used with MII monitoring.
+	UpDelayMilliseconds int64 `json:"upDelayMilliseconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// DownDelayMilliseconds describes how long, in milliseconds, to wait
+	// before disabling a member interface after its link goes down. This
+	// field is only used with MII monitoring.
+	DownDelayMilliseconds int64 `json:"downDelayMilliseconds,omitempty"`
+
+	// +optional
+
+	// TransmitHashPolicy describes the hash policy used to select a member
+	// interface when transmitting packets.
+	//
+	// This field is only used with the balance-xor, 802.3ad, and balance-tlb
+	// modes.
+	TransmitHashPolicy VirtualMachineNetworkBondTransmitHashPolicy `json:"transmitHashPolicy,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// MinLinks is the minimum number of member interfaces that must be up
+	// for the bond to be considered up.
+	MinLinks int64 `json:"minLinks,omitempty"`
+}
+
+// VirtualMachineNetworkBondSpec describes a bond interface that aggregates
+// one or more of the VM's network interfaces.
+type VirtualMachineNetworkBondSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9][a-zA-Z0-9._-]*$"
+
+	// Name is the name of this bond interface.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+
+	// Interfaces is the list of member interfaces. Each member must reference
+	// an interface name from the Interfaces list and may not be a member of
+	// another bond or bridge.
+	//
+	// The IP configuration of the primary member interface, or the first
+	// member interface if no primary is specified, is applied to the bond.
+	// The member interfaces are not assigned any IP configuration.
+	Interfaces []string `json:"interfaces"`
+
+	// +optional
+
+	// Parameters describes the bonding parameters.
+	Parameters *VirtualMachineNetworkBondParameters `json:"parameters,omitempty"`
+}
+
+// VirtualMachineNetworkBridgeSpec describes a bridge interface that connects
+// one or more of the VM's network or bond interfaces.
+type VirtualMachineNetworkBridgeSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9][a-zA-Z0-9._-]*$"
+
+	// Name is the name of this bridge interface.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+
+	// Interfaces is the list of member interfaces. Each member must reference
+	// an interface name from the Interfaces list or the name of a bond, and
+	// may not be a member of another bond or bridge.
+	//
+	// The IP configuration of the first member interface is applied to the
+	// bridge. The member interfaces are not assigned any IP configuration.
+	Interfaces []string `json:"interfaces"`
+
+	// +optional
+
+	// STP describes whether the bridge uses the Spanning Tree Protocol.
+	//
+	// Defaults to true.
+	STP *bool `json:"stp,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// ForwardDelaySeconds describes how long, in seconds, the bridge remains
+	// in the listening and learning states before forwarding packets.
+	ForwardDelaySeconds int64 `json:"forwardDelaySeconds,omitempty"`
+}
+
 // VirtualMachineNetworkSpec defines a VM's desired network configuration.
 type VirtualMachineNetworkSpec struct {
 	// +optional
@@ -257,7 +489,7 @@ type VirtualMachineNetworkSpec struct {
 	// the name of the VM will be used.
 	//
 	// Please note, this feature is available with the following bootstrap
-	// providers: CloudInit, LinuxPrep, and Sysprep.
+	// providers: CloudInit, Ignition, LinuxPrep, and Sysprep.
 	//
 	// This field must adhere to the format specified in RFC-1034, Section 3.5
 	// for DNS labels:
@@ -325,9 +557,11 @@ type VirtualMachineNetworkSpec struct {
 	// nameservers. These are applied globally.
 	//
 	// Please note global nameservers are only available with the following
-	// bootstrap providers: LinuxPrep and Sysprep. The Cloud-Init bootstrap
-	// provider supports per-interface nameservers. However, when Cloud-Init
-	// is used and UseGlobalNameserversAsDefault is true, the global
+	// bootstrap providers: Ignition, LinuxPrep, and Sysprep. The Ignition
+	// bootstrap provider uses the global nameservers for the interfaces that
+	// neither use DHCP nor specify their own nameservers. The Cloud-Init
+	// bootstrap provider supports per-interface nameservers. However, when
+	// Cloud-Init is used and UseGlobalNameserversAsDefault is true, the global
 	// nameservers will be used when the per-interface nameservers is not
 	// provided.
 	//
@@ -341,10 +575,13 @@ type VirtualMachineNetworkSpec struct {
 	// addresses with DNS. These are applied globally.
 	//
 	// Please note global search domains are only available with the following
-	// bootstrap providers: LinuxPrep and Sysprep. The Cloud-Init bootstrap
-	// provider supports per-interface search domains. However, when Cloud-Init
-	// is used and UseGlobalSearchDomainsAsDefault is true, the global search
-	// domains will be used when the per-interface search domains is not provided.
+	// bootstrap providers: Ignition, LinuxPrep, and Sysprep. The Ignition
+	// bootstrap provider uses the global search domains for the interfaces
+	// that neither use DHCP nor specify their own search domains. The
+	// Cloud-Init bootstrap provider supports per-interface search domains.
+	// However, when Cloud-Init is used and UseGlobalSearchDomainsAsDefault is
+	// true, the global search domains will be used when the per-interface
+	// search domains is not provided.
 	SearchDomains []string `json:"searchDomains,omitempty"`
 
 	// +optional
@@ -375,6 +612,29 @@ type VirtualMachineNetworkSpec struct {
 	// Please note this feature is available only with the following bootstrap
 	// providers: CloudInit.
 	VLANs []VirtualMachineNetworkVLANSpec `json:"vlans,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Bonds is a list of bond interfaces that aggregate the network interfaces
+	// from the Interfaces list, ex. to provide active-backup failover across
+	// two interfaces.
+	//
+	// Please note this feature is available only with the following bootstrap
+	// providers: CloudInit.
+	Bonds []VirtualMachineNetworkBondSpec `json:"bonds,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Bridges is a list of bridge interfaces that connect the network
+	// interfaces from the Interfaces list, or bonds from the Bonds list.
+	//
+	// Please note this feature is available only with the following bootstrap
+	// providers: CloudInit.
+	Bridges []VirtualMachineNetworkBridgeSpec `json:"bridges,omitempty"`
 }
 
 // VirtualMachineNetworkDNSStatus describes the observed state of the guest's
@@ -821,4 +1081,39 @@ type VirtualMachineNetworkConfigStatus struct {
 
 	// DNS describes the configured state of client-side DNS.
 	DNS *VirtualMachineNetworkConfigDNSStatus `json:"dns,omitempty"`
+
+	// +optional
+
+	// Bonds describes the configured state of the bond interfaces.
+	Bonds []VirtualMachineNetworkConfigAggregateStatus `json:"bonds,omitempty"`
+
+	// +optional
+
+	// Bridges describes the configured state of the bridge interfaces.
+	Bridges []VirtualMachineNetworkConfigAggregateStatus `json:"bridges,omitempty"`
+}
+
+// VirtualMachineNetworkConfigAggregateStatus describes the configured state of
+// a bond or bridge interface.
+type VirtualMachineNetworkConfigAggregateStatus struct {
+	// +optional
+
+	// Name describes the corresponding bond or bridge with the same name in
+	// the VM's desired network configuration.
+	Name string `json:"name,omitempty"`
+
+	// +optional
+
+	// Interfaces describes the names of the member interfaces.
+	Interfaces []string `json:"interfaces,omitempty"`
+
+	// +optional
+
+	// IP describes the bond or bridge's configured IP information.
+	IP *VirtualMachineNetworkConfigInterfaceIPStatus `json:"ip,omitempty"`
+
+	// +optional
+
+	// DNS describes the bond or bridge's configured DNS information.
+	DNS *VirtualMachineNetworkConfigDNSStatus `json:"dns,omitempty"`
 }
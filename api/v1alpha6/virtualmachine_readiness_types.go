@@ -5,9 +5,25 @@
 package v1alpha6
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+const (
+	// VirtualMachineNotStartedReason is the reason used for the Ready
+	// condition when a VM's startup probe has not yet succeeded.
+	VirtualMachineNotStartedReason = "NotStarted"
+
+	// VirtualMachineStartupProbeFailedReason is the reason used for events
+	// emitted when a VM's startup probe fails.
+	VirtualMachineStartupProbeFailedReason = "StartupProbeFailed"
+
+	// VirtualMachineStartupRestartReason is the reason used for events
+	// emitted when a VM is restarted because its startup probe failed too
+	// many times in a row.
+	VirtualMachineStartupRestartReason = "StartupRestart"
+)
+
 // VirtualMachineReadinessProbeSpec describes a probe used to determine if a VM
 // is in a ready state. All probe actions are mutually exclusive.
 type VirtualMachineReadinessProbeSpec struct {
@@ -52,6 +68,123 @@ type VirtualMachineReadinessProbeSpec struct {
 	// VM resource will be marked as ready.
 	GuestInfo []GuestInfoAction `json:"guestInfo,omitempty"`
 
+	// +optional
+
+	// HTTPGet specifies an action involving an HTTP GET request to the VM.
+	//
+	// The probe succeeds when the response's status code is one of the
+	// expected statuses.
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+
+	// +optional
+
+	// Exec specifies an action involving a command that is run in the guest
+	// using VMware Tools guest operations.
+	//
+	// The probe succeeds when the command exits with a status code of zero.
+	Exec *ExecAction `json:"exec,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=60
+
+	// TimeoutSeconds specifies a number of seconds after which the probe times out.
+	// Defaults to 10 seconds. Minimum value is 1.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+
+	// PeriodSeconds specifics how often (in seconds) to perform the probe.
+	// Defaults to 10 seconds. Minimum value is 1.
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+
+	// InitialDelaySeconds specifies the number of seconds after the VM is
+	// powered on, restarted, or its startup probe succeeds before the probe is
+	// run.
+	// Defaults to 0 seconds.
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+
+	// SuccessThreshold specifies the number of consecutive successes of the
+	// probe after which a VM that is not ready is considered ready.
+	// Defaults to 1. Minimum value is 1.
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+
+	// FailureThreshold specifies the number of consecutive failures of the
+	// probe after which a VM that is ready is considered not ready.
+	// Defaults to 1. Minimum value is 1.
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// VirtualMachineReadinessProbeStatus describes the observed state of a VM's
+// readiness probe.
+type VirtualMachineReadinessProbeStatus struct {
+	// +optional
+
+	// ConsecutiveSuccesses is the number of times in a row the probe has
+	// succeeded, up to the probe's SuccessThreshold.
+	ConsecutiveSuccesses int32 `json:"consecutiveSuccesses,omitempty"`
+
+	// +optional
+
+	// ConsecutiveFailures is the number of times in a row the probe has
+	// failed, up to the probe's FailureThreshold.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// +optional
+
+	// StartTime is the time the probe began to observe the VM as powered on
+	// and started. The probe is not run until InitialDelaySeconds after this
+	// time.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// VirtualMachineStartupProbeSpec describes a probe used to determine if a
+// VM's guest has finished booting. The VM's readiness probe is not run until
+// the startup probe succeeds, and the VM is not considered ready before then.
+// When the probe fails FailureThreshold times in a row, the VM is restarted in
+// accordance with spec.restartMode.
+//
+// All probe actions are mutually exclusive.
+type VirtualMachineStartupProbeSpec struct {
+	// +optional
+
+	// TCPSocket specifies an action involving a TCP port.
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"`
+
+	// +optional
+
+	// HTTPGet specifies an action involving an HTTP GET request to the VM.
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
+
+	// +optional
+
+	// Exec specifies an action involving a command that is run in the guest
+	// using VMware Tools guest operations.
+	Exec *ExecAction `json:"exec,omitempty"`
+
+	// +optional
+
+	// GuestHeartbeat specifies an action involving the guest heartbeat status.
+	GuestHeartbeat *GuestHeartbeatAction `json:"guestHeartbeat,omitempty"`
+
+	// +optional
+
+	// GuestInfo specifies an action involving key/value pairs from GuestInfo.
+	//
+	// The elements are evaluated with the logical AND operator, meaning
+	// all expressions must evaluate as true for the probe to succeed.
+	GuestInfo []GuestInfoAction `json:"guestInfo,omitempty"`
+
 	// +optional
 	// +kubebuilder:validation:Minimum:=1
 	// +kubebuilder:validation:Maximum:=60
@@ -66,19 +199,172 @@ type VirtualMachineReadinessProbeSpec struct {
 	// PeriodSeconds specifics how often (in seconds) to perform the probe.
 	// Defaults to 10 seconds. Minimum value is 1.
 	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+
+	// InitialDelaySeconds specifies the number of seconds after the VM is
+	// powered on or restarted before the probe is run.
+	// Defaults to 0 seconds.
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+
+	// FailureThreshold specifies the number of consecutive failures of the
+	// probe after which the VM is restarted.
+	// Defaults to 30. Minimum value is 1.
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// VirtualMachineStartupProbeStatus describes the observed state of a VM's
+// startup probe.
+type VirtualMachineStartupProbeStatus struct {
+	// +optional
+
+	// Started is true once the probe has succeeded since the VM was last
+	// powered on or restarted.
+	Started bool `json:"started,omitempty"`
+
+	// +optional
+
+	// ConsecutiveFailures is the number of times in a row the probe has
+	// failed.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// +optional
+
+	// StartTime is the time the probe began to observe the VM as powered on.
+	// The probe is not run until InitialDelaySeconds after this time.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
 }
 
 // TCPSocketAction describes an action based on opening a socket.
 type TCPSocketAction struct {
 	// Port specifies a number or name of the port to access on the VM.
 	// If the format of port is a number, it must be in the range 1 to 65535.
-	// If the format of name is a string, it must be an IANA_SVC_NAME.
+	// If the format of name is a string, it must be an IANA_SVC_NAME, which is
+	// resolved to the port registered for the service, ex. "https" is 443.
+	Port intstr.IntOrString `json:"port"`
+
+	// +optional
+
+	// Host is an optional host name to connect to. Host defaults to the VM IP.
+	Host string `json:"host,omitempty"`
+}
+
+// URIScheme identifies the scheme used for connection to a host for Get
+// actions.
+// +kubebuilder:validation:Enum=HTTP;HTTPS
+type URIScheme string
+
+const (
+	// URISchemeHTTP means that the scheme used will be http://.
+	URISchemeHTTP URIScheme = "HTTP"
+	// URISchemeHTTPS means that the scheme used will be https://.
+	URISchemeHTTPS URIScheme = "HTTPS"
+)
+
+// HTTPHeader describes a custom header to be used in HTTP probes.
+type HTTPHeader struct {
+	// Name is the header field name.
+	// This will be canonicalized upon output, so case-variant names will be
+	// understood as the same header.
+	Name string `json:"name"`
+
+	// Value is the header field value.
+	Value string `json:"value"`
+}
+
+// HTTPStatusRange describes an inclusive range of HTTP status codes.
+type HTTPStatusRange struct {
+	// +kubebuilder:validation:Minimum:=100
+	// +kubebuilder:validation:Maximum:=599
+
+	// Min is the lowest status code in the range.
+	Min int32 `json:"min"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum:=100
+	// +kubebuilder:validation:Maximum:=599
+
+	// Max is the highest status code in the range.
+	// Defaults to the value of Min.
+	Max int32 `json:"max,omitempty"`
+}
+
+// HTTPGetAction describes an action based on HTTP GET requests.
+type HTTPGetAction struct {
+	// +optional
+
+	// Path is the path to access on the HTTP server.
+	// Defaults to "/".
+	Path string `json:"path,omitempty"`
+
+	// Port specifies a number or name of the port to access on the VM.
+	// If the format of port is a number, it must be in the range 1 to 65535.
+	// If the format of name is a string, it must be an IANA_SVC_NAME, which is
+	// resolved to the port registered for the service, ex. "https" is 443.
 	Port intstr.IntOrString `json:"port"`
 
 	// +optional
 
 	// Host is an optional host name to connect to. Host defaults to the VM IP.
+	// Set the "Host" header in HTTPHeaders to send a different host name in
+	// the request.
 	Host string `json:"host,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=HTTP
+
+	// Scheme is the scheme used to connect to the host.
+	// Defaults to HTTP.
+	//
+	// Please note, the certificate presented by the server is not verified
+	// when the scheme is HTTPS.
+	Scheme URIScheme `json:"scheme,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// HTTPHeaders are the custom headers to set in the request.
+	HTTPHeaders []HTTPHeader `json:"httpHeaders,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// ExpectedStatuses is the list of status code ranges that indicate the
+	// probe succeeded.
+	//
+	// Defaults to any status code greater than or equal to 200 and less than
+	// 400.
+	ExpectedStatuses []HTTPStatusRange `json:"expectedStatuses,omitempty"`
+}
+
+// ExecAction describes an action that runs a command in the guest using
+// VMware Tools guest operations.
+type ExecAction struct {
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+
+	// Command is the command to run in the guest. The first element is the
+	// absolute path of the program to run, and the remaining elements are
+	// the arguments passed to the program. Each argument is quoted for the
+	// guest operating system, so arguments may contain spaces and quotes.
+	//
+	// Please note, shell expansion is not performed on the arguments.
+	Command []string `json:"command"`
+
+	// +optional
+
+	// WorkingDirectory is the absolute path of the directory in which the
+	// command is run. Defaults to the guest user's home directory.
+	WorkingDirectory string `json:"workingDirectory,omitempty"`
+
+	// SecretName is the name of a Secret in the same namespace as the VM that
+	// contains the credentials used to authenticate with the guest. The
+	// Secret must have the keys "username" and "password".
+	SecretName string `json:"secretName"`
 }
 
 // GuestHeartbeatStatus is the guest heartbeat status.
@@ -194,6 +194,11 @@ const (
 	// GuestCustomizationFailedReason documents that the guest
 	// customization failed within the guest OS.
 	GuestCustomizationFailedReason = "GuestCustomizationFailed"
+
+	// GuestCustomizationRerunPendingReason documents that bootstrap will be
+	// re-run once the VM is powered off because spec.bootstrap.generation
+	// was changed.
+	GuestCustomizationRerunPendingReason = "GuestCustomizationRerunPending"
 )
 
 const (
@@ -248,6 +253,13 @@ const (
 	// be removed once set until the VM is deleted.
 	FirstBootDoneAnnotation = "virtualmachine." + GroupName + "/first-boot-done"
 
+	// SerialConsolePortAnnotation is an annotation that records the port on
+	// the ESXi host on which the VM's serial console listens for connections.
+	// The port is allocated by VM Operator so it is unique among the VMs with a
+	// serial console, and is removed when the serial console is removed. This
+	// annotation cannot be set by users.
+	SerialConsolePortAnnotation = "virtualmachine." + GroupName + "/serial-console-port"
+
 	// V1alpha1ConfigMapTransportAnnotation is an annotation that indicates that the VM
 	// was created with the v1alpha1 API and specifies a configMap as the metadata transport resource type.
 	V1alpha1ConfigMapTransportAnnotation = GroupName + "/v1a1-configmap-md-transport"
@@ -439,10 +451,15 @@ const (
 type VirtualMachineImageRef struct {
 	// Kind describes the type of image, either a namespace-scoped
 	// VirtualMachineImage or cluster-scoped ClusterVirtualMachineImage.
+	//
+	// When used to deploy a VM, Kind may also be VirtualMachine or
+	// VirtualMachineSnapshot in order to clone the VM from an existing VM or
+	// VM snapshot.
 	Kind string `json:"kind"`
 
-	// Name refers to the name of a VirtualMachineImage resource in the same
-	// namespace as this VM or a cluster-scoped ClusterVirtualMachineImage.
+	// Name refers to the name of a VirtualMachineImage, VirtualMachine, or
+	// VirtualMachineSnapshot resource in the same namespace as this VM or a
+	// cluster-scoped ClusterVirtualMachineImage.
 	Name string `json:"name"`
 }
 
@@ -730,6 +747,22 @@ const (
 	VirtualMachineDeployModeLinked VirtualMachineDeployMode = "Linked"
 )
 
+// +kubebuilder:validation:Enum=Full;Linked
+
+// VirtualMachineCloneMode represents the available modes in which a VM may be
+// cloned from another VM or VM snapshot.
+type VirtualMachineCloneMode string
+
+const (
+	// VirtualMachineCloneModeFull indicates the cloned VM's disks are full,
+	// independent copies of the source's disks.
+	VirtualMachineCloneModeFull VirtualMachineCloneMode = "Full"
+
+	// VirtualMachineCloneModeLinked indicates the cloned VM's disks are child
+	// disks backed by the source's snapshot.
+	VirtualMachineCloneModeLinked VirtualMachineCloneMode = "Linked"
+)
+
 // +kubebuilder:validation:Enum=Online;Offline;Disabled
 
 // VirtualMachinePromoteDisksMode represents the available modes for promoting
@@ -749,6 +782,11 @@ type VirtualMachineSpec struct {
 	// Image describes the reference to the VirtualMachineImage or
 	// ClusterVirtualMachineImage resource used to deploy this VM.
 	//
+	// Image may also refer to a VirtualMachine or VirtualMachineSnapshot
+	// resource in the same namespace as this VM, in which case this VM is
+	// cloned from that VM or snapshot. Please see spec.cloneMode for more
+	// information.
+	//
 	// Please note, unlike the field spec.imageName, the value of
 	// spec.image.name MUST be a Kubernetes object name.
 	//
@@ -795,6 +833,25 @@ type VirtualMachineSpec struct {
 
 	// +optional
 
+	// CloneMode describes how this VM is cloned when spec.image refers to a
+	// VirtualMachine or VirtualMachineSnapshot resource.
+	//
+	// When set to Full, the VM's disks are full copies of the source's disks.
+	//
+	// When set to Linked, the VM's disks are child disks of the source's
+	// snapshot. When spec.image refers to a VirtualMachine, that VM must have
+	// a current snapshot, and the clone is linked to that snapshot.
+	//
+	// Any PersistentVolumeClaim volumes of the source VM are copied to new
+	// claims for this VM, and the VM's bootstrap identity is regenerated so
+	// the guest is customized as a new VM.
+	//
+	// Defaults to Full. This field is ignored for all other image kinds and is
+	// immutable once the VM is created.
+	CloneMode VirtualMachineCloneMode `json:"cloneMode,omitempty"`
+
+	// +optional
+
 	// ClassName describes the name of the VirtualMachineClass resource used to
 	// deploy this VM.
 	//
@@ -976,6 +1033,20 @@ type VirtualMachineSpec struct {
 
 	// +optional
 
+	// StartupProbe describes a probe used to determine if the VM's guest has
+	// finished booting. The VM's readiness probe is not run until the startup
+	// probe succeeds.
+	StartupProbe *VirtualMachineStartupProbeSpec `json:"startupProbe,omitempty"`
+
+	// +optional
+
+	// LivenessProbe describes a probe used to determine if the VM's guest is
+	// alive. The VM is restarted when the probe fails too many times in a
+	// row.
+	LivenessProbe *VirtualMachineLivenessProbeSpec `json:"livenessProbe,omitempty"`
+
+	// +optional
+
 	// Advanced describes a set of optional, advanced VM configuration options.
 	Advanced *VirtualMachineAdvancedSpec `json:"advanced,omitempty"`
 
@@ -1411,6 +1482,27 @@ type VirtualMachineStatus struct {
 
 	// +optional
 
+	// BootstrapGeneration describes the value of spec.bootstrap.generation
+	// when bootstrap was last applied to the VM.
+	BootstrapGeneration *int64 `json:"bootstrapGeneration,omitempty"`
+
+	// +optional
+
+	// ReadinessProbe describes the observed state of the VM's readiness probe.
+	ReadinessProbe *VirtualMachineReadinessProbeStatus `json:"readinessProbe,omitempty"`
+
+	// +optional
+
+	// StartupProbe describes the observed state of the VM's startup probe.
+	StartupProbe *VirtualMachineStartupProbeStatus `json:"startupProbe,omitempty"`
+
+	// +optional
+
+	// LivenessProbe describes the observed state of the VM's liveness probe.
+	LivenessProbe *VirtualMachineLivenessProbeStatus `json:"livenessProbe,omitempty"`
+
+	// +optional
+
 	// HardwareVersion describes the VirtualMachine resource's observed
 	// hardware version.
 	//
@@ -0,0 +1,288 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// VirtualMachineDeploymentNameLabel is the key of the label applied to the
+	// VirtualMachineReplicaSet objects owned by a VirtualMachineDeployment, as
+	// well as to their replica VirtualMachine objects. The value of this label
+	// is the name of the VirtualMachineDeployment.
+	VirtualMachineDeploymentNameLabel = "vmoperator.vmware.com/deployment-name"
+
+	// VirtualMachineTemplateHashLabel is the key of the label applied to the
+	// VirtualMachineReplicaSet objects owned by a VirtualMachineDeployment, as
+	// well as to their selectors and templates. The value of this label is a
+	// hash of the VirtualMachineDeployment's template and is used to tell
+	// apart the replica sets that belong to different revisions.
+	VirtualMachineTemplateHashLabel = "vmoperator.vmware.com/vm-template-hash"
+
+	// VirtualMachineDeploymentRevisionAnnotation is the key of the annotation
+	// that records the revision of a VirtualMachineReplicaSet owned by a
+	// VirtualMachineDeployment. Revisions start at 1 and are incremented
+	// every time the deployment's template changes.
+	VirtualMachineDeploymentRevisionAnnotation = "vmoperator.vmware.com/deployment-revision"
+
+	// VirtualMachineDeploymentDesiredReplicasAnnotation is the key of the
+	// annotation that records the desired number of replicas of the
+	// VirtualMachineDeployment at the time its VirtualMachineReplicaSet was
+	// last scaled.
+	VirtualMachineDeploymentDesiredReplicasAnnotation = "vmoperator.vmware.com/deployment-desired-replicas"
+)
+
+const (
+	// VirtualMachineDeploymentConditionAvailable indicates the deployment has
+	// at least the minimum number of ready replicas required by its strategy.
+	VirtualMachineDeploymentConditionAvailable = "Available"
+
+	// VirtualMachineDeploymentConditionRolledOut indicates all of the
+	// deployment's replicas match its current template and are ready. When
+	// this condition is false, its reason indicates why the rollout is not yet
+	// complete.
+	VirtualMachineDeploymentConditionRolledOut = "RolledOut"
+
+	// MinimumReplicasUnavailableReason documents a VirtualMachineDeployment
+	// does not have the minimum number of ready replicas.
+	MinimumReplicasUnavailableReason = "MinimumReplicasUnavailable"
+
+	// NewReplicaSetCreatedReason documents a VirtualMachineDeployment created
+	// a new VirtualMachineReplicaSet for its current template.
+	NewReplicaSetCreatedReason = "NewReplicaSetCreated"
+
+	// ReplicaSetUpdatedReason documents a VirtualMachineDeployment is shifting
+	// replicas between its VirtualMachineReplicaSets.
+	ReplicaSetUpdatedReason = "ReplicaSetUpdated"
+
+	// DeploymentPausedReason documents a VirtualMachineDeployment is paused.
+	DeploymentPausedReason = "DeploymentPaused"
+)
+
+// VirtualMachineDeploymentStrategyType describes how a VirtualMachineDeployment
+// replaces its existing replicas with new ones.
+//
+// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+type VirtualMachineDeploymentStrategyType string
+
+const (
+	// VirtualMachineDeploymentStrategyTypeRecreate deletes all of the existing
+	// replicas before any new ones are created.
+	VirtualMachineDeploymentStrategyTypeRecreate VirtualMachineDeploymentStrategyType = "Recreate"
+
+	// VirtualMachineDeploymentStrategyTypeRollingUpdate gradually scales down
+	// the old VirtualMachineReplicaSets and scales up the new one.
+	VirtualMachineDeploymentStrategyTypeRollingUpdate VirtualMachineDeploymentStrategyType = "RollingUpdate"
+)
+
+// VirtualMachineDeploymentRollingUpdate controls the pace of a rolling update.
+type VirtualMachineDeploymentRollingUpdate struct {
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+
+	// MaxUnavailable is the maximum number of replicas that may be unavailable
+	// during the update. The value may be an absolute number, ex. 5, or a
+	// percentage of the desired replicas, ex. 10%. An absolute number is
+	// calculated from a percentage by rounding down.
+	//
+	// This value may not be zero if MaxSurge is also zero.
+	//
+	// Defaults to 25%.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+
+	// MaxSurge is the maximum number of replicas that may be created above the
+	// desired number of replicas during the update. The value may be an
+	// absolute number, ex. 5, or a percentage of the desired replicas, ex.
+	// 10%. An absolute number is calculated from a percentage by rounding up.
+	//
+	// This value may not be zero if MaxUnavailable is also zero.
+	//
+	// Defaults to 25%.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// VirtualMachineDeploymentStrategy describes how to replace existing replicas
+// with new ones.
+type VirtualMachineDeploymentStrategy struct {
+	// +optional
+	// +kubebuilder:default=RollingUpdate
+
+	// Type of the deployment strategy. May be "Recreate" or "RollingUpdate".
+	// Defaults to "RollingUpdate".
+	Type VirtualMachineDeploymentStrategyType `json:"type,omitempty"`
+
+	// +optional
+
+	// RollingUpdate contains the parameters used when Type is "RollingUpdate".
+	RollingUpdate *VirtualMachineDeploymentRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// VirtualMachineDeploymentRollback describes a request to roll a
+// VirtualMachineDeployment back to a previous revision.
+type VirtualMachineDeploymentRollback struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// Revision is the revision to which to roll back. If zero, the deployment
+	// is rolled back to the revision prior to the current one.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// VirtualMachineDeploymentSpec is the specification of a
+// VirtualMachineDeployment.
+type VirtualMachineDeploymentSpec struct {
+	// +optional
+	// +kubebuilder:default=1
+
+	// Replicas is the number of desired replicas.
+	// This is a pointer to distinguish between explicit zero and unspecified.
+	// Defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Selector is a label query over the virtual machines that are managed by
+	// this deployment. It must match the template's labels.
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// +optional
+
+	// Template is the object that describes the virtual machines that are
+	// created by this deployment. Changing the template triggers the rollout
+	// of a new revision.
+	Template VirtualMachineTemplateSpec `json:"template,omitempty"`
+
+	// +optional
+
+	// Strategy describes how to replace existing replicas with new ones.
+	Strategy VirtualMachineDeploymentStrategy `json:"strategy,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;NotReadyFirst
+
+	// DeletePolicy is the delete policy of the VirtualMachineReplicaSets
+	// created by this deployment. Please refer to
+	// VirtualMachineReplicaSetSpec.DeletePolicy for more information.
+	DeletePolicy string `json:"deletePolicy,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+
+	// RevisionHistoryLimit is the number of old VirtualMachineReplicaSets to
+	// retain so the deployment may be rolled back to them.
+	// Defaults to 10.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// +optional
+
+	// Paused indicates the deployment is paused and changes to its template do
+	// not result in a rollout.
+	Paused bool `json:"paused,omitempty"`
+
+	// +optional
+
+	// RollbackTo is the revision to which the deployment is rolled back. The
+	// field is cleared once the rollback has been processed.
+	RollbackTo *VirtualMachineDeploymentRollback `json:"rollbackTo,omitempty"`
+}
+
+// VirtualMachineDeploymentStatus represents the observed state of a
+// VirtualMachineDeployment resource.
+type VirtualMachineDeploymentStatus struct {
+	// +optional
+
+	// ObservedGeneration reflects the generation of the most recently observed
+	// VirtualMachineDeployment.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+
+	// Revision is the revision of the newest VirtualMachineReplicaSet.
+	Revision int64 `json:"revision,omitempty"`
+
+	// +optional
+
+	// Replicas is the total number of replicas targeted by this deployment.
+	Replicas int32 `json:"replicas"`
+
+	// +optional
+
+	// UpdatedReplicas is the number of replicas that match the deployment's
+	// current template.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// +optional
+
+	// ReadyReplicas is the number of ready replicas targeted by this
+	// deployment.
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// +optional
+
+	// UnavailableReplicas is the number of desired replicas that are not yet
+	// ready.
+	UnavailableReplicas int32 `json:"unavailableReplicas,omitempty"`
+
+	// +optional
+
+	// CollisionCount is the number of hash collisions for the
+	// VirtualMachineDeployment. It is included in the hash of the template
+	// when the name of a new VirtualMachineReplicaSet is computed, so the
+	// name no longer collides with an existing object.
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+
+	// +optional
+
+	// Conditions represents the latest available observations of a
+	// VirtualMachineDeployment's current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (d *VirtualMachineDeployment) GetConditions() []metav1.Condition {
+	return d.Status.Conditions
+}
+
+func (d *VirtualMachineDeployment) SetConditions(conditions []metav1.Condition) {
+	d.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmdeploy;vmdeployment
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Total number of non-terminated virtual machines targeted by this VirtualMachineDeployment"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas",description="Total number of ready virtual machines targeted by this VirtualMachineDeployment"
+// +kubebuilder:printcolumn:name="Up-To-Date",type="integer",JSONPath=".status.updatedReplicas",description="Total number of virtual machines that match the current template"
+// +kubebuilder:printcolumn:name="Revision",type="integer",JSONPath=".status.revision",description="Revision of the newest VirtualMachineReplicaSet"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of VirtualMachineDeployment"
+
+// VirtualMachineDeployment is the schema for the virtualmachinedeployments API
+// and represents a set of VirtualMachines that are rolled out declaratively
+// through VirtualMachineReplicaSets.
+type VirtualMachineDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineDeploymentSpec   `json:"spec,omitempty"`
+	Status VirtualMachineDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineDeploymentList contains a list of VirtualMachineDeployment.
+type VirtualMachineDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDeployment `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &VirtualMachineDeployment{}, &VirtualMachineDeploymentList{})
+}
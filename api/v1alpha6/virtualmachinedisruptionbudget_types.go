@@ -0,0 +1,151 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// VirtualMachineDisruptionBudgetConditionDisruptionAllowed indicates
+	// whether the VirtualMachineDisruptionBudget currently allows at least one
+	// of its virtual machines to be disrupted.
+	VirtualMachineDisruptionBudgetConditionDisruptionAllowed = "DisruptionAllowed"
+
+	// InsufficientHealthyVirtualMachinesReason documents a
+	// VirtualMachineDisruptionBudget does not have enough healthy virtual
+	// machines to allow a disruption.
+	InsufficientHealthyVirtualMachinesReason = "InsufficientHealthyVirtualMachines"
+
+	// DisruptionBudgetExceededReason documents an operation that would disrupt
+	// a virtual machine was blocked because it would violate a
+	// VirtualMachineDisruptionBudget. This reason is used with the condition
+	// of the object whose operation was blocked.
+	DisruptionBudgetExceededReason = "DisruptionBudgetExceeded"
+)
+
+// VirtualMachineDisruptionBudgetSpec is the specification of a
+// VirtualMachineDisruptionBudget.
+type VirtualMachineDisruptionBudgetSpec struct {
+	// +optional
+	//
+	// Selector is a label query over the virtual machines whose disruptions
+	// are limited by this budget. A nil selector selects no virtual machines,
+	// while an empty selector selects all of the virtual machines in the
+	// namespace.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	//
+	// MinAvailable is the number of the selected virtual machines that must
+	// still be available after a disruption. The value may be an absolute
+	// number, ex. 2, or a percentage of the selected virtual machines, ex.
+	// 50%. An absolute number is calculated from a percentage by rounding up.
+	//
+	// Only one of MinAvailable and MaxUnavailable may be specified.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	//
+	// MaxUnavailable is the number of the selected virtual machines that may
+	// be unavailable after a disruption. The value may be an absolute number,
+	// ex. 1, or a percentage of the selected virtual machines, ex. 25%. An
+	// absolute number is calculated from a percentage by rounding up.
+	//
+	// Only one of MinAvailable and MaxUnavailable may be specified.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// VirtualMachineDisruptionBudgetStatus represents the observed state of a
+// VirtualMachineDisruptionBudget resource.
+type VirtualMachineDisruptionBudgetStatus struct {
+	// +optional
+	//
+	// ObservedGeneration reflects the generation of the most recently observed
+	// VirtualMachineDisruptionBudget.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	//
+	// ExpectedVirtualMachines is the number of virtual machines selected by
+	// this budget.
+	ExpectedVirtualMachines int32 `json:"expectedVirtualMachines,omitempty"`
+
+	// +optional
+	//
+	// CurrentHealthy is the number of selected virtual machines that are
+	// healthy. A virtual machine is healthy when it is ready and is not
+	// being deleted or powered off.
+	CurrentHealthy int32 `json:"currentHealthy,omitempty"`
+
+	// +optional
+	//
+	// DesiredHealthy is the minimum number of selected virtual machines that
+	// must be healthy.
+	DesiredHealthy int32 `json:"desiredHealthy,omitempty"`
+
+	// +optional
+	//
+	// DisruptionsAllowed is the number of selected virtual machines that may
+	// currently be disrupted.
+	DisruptionsAllowed int32 `json:"disruptionsAllowed,omitempty"`
+
+	// +optional
+	//
+	// Conditions represents the latest available observations of a
+	// VirtualMachineDisruptionBudget's current state.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (db *VirtualMachineDisruptionBudget) GetConditions() []metav1.Condition {
+	return db.Status.Conditions
+}
+
+func (db *VirtualMachineDisruptionBudget) SetConditions(conditions []metav1.Condition) {
+	db.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmdb;vmdisruptionbudget
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Min-Available",type="string",JSONPath=".spec.minAvailable",description="Minimum number of available virtual machines"
+// +kubebuilder:printcolumn:name="Max-Unavailable",type="string",JSONPath=".spec.maxUnavailable",description="Maximum number of unavailable virtual machines"
+// +kubebuilder:printcolumn:name="Allowed-Disruptions",type="integer",JSONPath=".status.disruptionsAllowed",description="Number of virtual machines that may currently be disrupted"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of VirtualMachineDisruptionBudget"
+
+// VirtualMachineDisruptionBudget is the schema for the
+// virtualmachinedisruptionbudgets API and limits the number of virtual
+// machines that operations initiated by VM Operator may disrupt at once, such
+// as powering off the members of a VirtualMachineGroup, scaling down a
+// VirtualMachineReplicaSet, or reverting a virtual machine to a snapshot.
+//
+// Resizing a virtual machine to a new or updated VirtualMachineClass is not
+// limited by a budget. A resize is only applied while the virtual machine is
+// powered off, and it never powers the virtual machine off or on.
+type VirtualMachineDisruptionBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineDisruptionBudgetSpec   `json:"spec,omitempty"`
+	Status VirtualMachineDisruptionBudgetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineDisruptionBudgetList contains a list of
+// VirtualMachineDisruptionBudget.
+type VirtualMachineDisruptionBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDisruptionBudget `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &VirtualMachineDisruptionBudget{}, &VirtualMachineDisruptionBudgetList{})
+}
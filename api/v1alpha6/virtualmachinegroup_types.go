@@ -21,6 +21,10 @@ const (
 	// VirtualMachineGroupMemberConditionPlacementReady indicates that the
 	// member has a placement decision ready.
 	VirtualMachineGroupMemberConditionPlacementReady = "PlacementReady"
+
+	// VirtualMachineGroupMemberConditionSnapshotReverted indicates that the
+	// member has been reverted to the group's current snapshot.
+	VirtualMachineGroupMemberConditionSnapshotReverted = "SnapshotReverted"
 )
 
 // GroupMember describes a member of a VirtualMachineGroup.
@@ -134,6 +138,22 @@ type VirtualMachineGroupSpec struct {
 	// the group's power state is changed or the nextForcePowerStateSyncTime
 	// field is set to "now".
 	SuspendMode VirtualMachinePowerOpMode `json:"suspendMode,omitempty"`
+
+	// +optional
+
+	// CurrentSnapshotName describes the name of a VirtualMachineGroupSnapshot
+	// of this group, or of one of its parent groups, to which the group's
+	// members should be reverted.
+	//
+	// Each VirtualMachine member is reverted by setting its
+	// spec.currentSnapshotName field to the name of its VirtualMachineSnapshot
+	// from the group snapshot, and each VirtualMachineGroup member is reverted
+	// by setting its spec.currentSnapshotName field to the same value as this
+	// field. Members that are powered on after the revert are powered on in
+	// the group's boot order, honoring any power-on delays.
+	//
+	// This field is cleared once all of the members have been reverted.
+	CurrentSnapshotName string `json:"currentSnapshotName,omitempty"`
 }
 
 type VirtualMachineGroupPlacementDatastoreStatus struct {
@@ -233,6 +253,9 @@ type VirtualMachineGroupMemberStatus struct {
 	//   when the member has a placement decision ready.
 	// - The ReadyType condition is True for the VirtualMachineGroup member
 	//   when all of its members' conditions are True.
+	// - The SnapshotReverted condition is True when the member has been
+	//   reverted to the group's spec.currentSnapshotName. This condition is
+	//   removed once all of the members have been reverted.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
@@ -254,6 +277,12 @@ type VirtualMachineGroupStatus struct {
 
 	// +optional
 
+	// CurrentSnapshotName describes the name of the VirtualMachineGroupSnapshot
+	// to which the group was most recently reverted.
+	CurrentSnapshotName string `json:"currentSnapshotName,omitempty"`
+
+	// +optional
+
 	// Conditions describes any conditions associated with this VM Group.
 	//
 	// - The ReadyType condition is True when all of the group members have
@@ -0,0 +1,176 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GroupSnapshotNameLabel label represents the name of the
+	// VirtualMachineGroupSnapshot that created a VirtualMachineSnapshot.
+	GroupSnapshotNameLabel = "snapshot." + GroupName + "/group-snapshot-name"
+)
+
+const (
+	// VirtualMachineGroupSnapshotPendingReason documents that one or more of
+	// the VirtualMachineSnapshots of a VirtualMachineGroupSnapshot are not yet
+	// ready.
+	VirtualMachineGroupSnapshotPendingReason = "SnapshotsPending"
+
+	// VirtualMachineGroupSnapshotFailedReason documents that one or more of
+	// the VirtualMachineSnapshots of a VirtualMachineGroupSnapshot could not
+	// be created.
+	VirtualMachineGroupSnapshotFailedReason = "SnapshotFailed"
+
+	// VirtualMachineGroupSnapshotGroupNotFoundReason documents that the
+	// VirtualMachineGroup of a VirtualMachineGroupSnapshot does not exist.
+	VirtualMachineGroupSnapshotGroupNotFoundReason = "GroupNotFound"
+
+	// VirtualMachineGroupSnapshotNoMembersReason documents that the
+	// VirtualMachineGroup of a VirtualMachineGroupSnapshot does not have any
+	// virtual machines.
+	VirtualMachineGroupSnapshotNoMembersReason = "NoMembers"
+)
+
+// VirtualMachineGroupSnapshotSpec defines the desired state of
+// VirtualMachineGroupSnapshot.
+type VirtualMachineGroupSnapshotSpec struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// GroupName is the name of the VirtualMachineGroup to snapshot. A snapshot
+	// is created of each of the virtual machines that are members of the
+	// group, either directly or indirectly via a nested group.
+	GroupName string `json:"groupName"`
+
+	// +optional
+
+	// Memory represents whether the snapshots include the VMs' memory. Please
+	// see VirtualMachineSnapshotSpec.Memory for more information.
+	Memory bool `json:"memory,omitempty"`
+
+	// +optional
+
+	// Quiesce represents the spec used for granular control over quiesce
+	// details. Please see VirtualMachineSnapshotSpec.Quiesce for more
+	// information.
+	//
+	// The snapshots of all of the members of the group are created
+	// concurrently, so the members are quiesced together and the snapshots
+	// capture the same point in time. The group's boot order is honored when
+	// the members are powered on after the group is reverted.
+	Quiesce *QuiesceSpec `json:"quiesce,omitempty"`
+
+	// +optional
+
+	// Description represents the description of the snapshots.
+	Description string `json:"description,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotMemberStatus describes the observed status of the
+// VirtualMachineSnapshot of a member of a VirtualMachineGroupSnapshot.
+type VirtualMachineGroupSnapshotMemberStatus struct {
+	// VMName is the name of the virtual machine.
+	VMName string `json:"vmName"`
+
+	// SnapshotName is the name of the VirtualMachineSnapshot of the virtual
+	// machine.
+	SnapshotName string `json:"snapshotName"`
+
+	// +optional
+
+	// BootOrder is the index of the boot order group of the virtual machine,
+	// with the boot orders of nested groups flattened into the boot order of
+	// their parent group. Snapshots are created in increasing boot order.
+	BootOrder int32 `json:"bootOrder,omitempty"`
+
+	// +optional
+
+	// Conditions is a copy of the conditions from the VirtualMachineSnapshot
+	// of the virtual machine.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotStatus defines the observed state of
+// VirtualMachineGroupSnapshot.
+type VirtualMachineGroupSnapshotStatus struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=vmName
+
+	// Members describes the observed status of the VirtualMachineSnapshots of
+	// the members of the group.
+	//
+	// The members are determined when the VirtualMachineGroupSnapshot is
+	// first reconciled and do not change afterwards.
+	Members []VirtualMachineGroupSnapshotMemberStatus `json:"members,omitempty"`
+
+	// +optional
+
+	// CompletionTime is the time at which all of the VirtualMachineSnapshots
+	// became ready.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineGroupSnapshot.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (s *VirtualMachineGroupSnapshot) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+func (s *VirtualMachineGroupSnapshot) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func (m VirtualMachineGroupSnapshotMemberStatus) GetConditions() []metav1.Condition {
+	return m.Conditions
+}
+
+func (m *VirtualMachineGroupSnapshotMemberStatus) SetConditions(conditions []metav1.Condition) {
+	m.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmgsnapshot
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Group",type="string",JSONPath=".spec.groupName"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineGroupSnapshot is the schema for the
+// virtualmachinegroupsnapshots API and represents a snapshot of all of the
+// virtual machines in a VirtualMachineGroup, taken as a single operation.
+//
+// A VirtualMachineGroup may be reverted to a VirtualMachineGroupSnapshot by
+// setting the group's spec.currentSnapshotName field.
+type VirtualMachineGroupSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineGroupSnapshotSpec   `json:"spec,omitempty"`
+	Status VirtualMachineGroupSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineGroupSnapshotList contains a list of
+// VirtualMachineGroupSnapshot.
+type VirtualMachineGroupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineGroupSnapshot `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineGroupSnapshot{},
+		&VirtualMachineGroupSnapshotList{},
+	)
+}
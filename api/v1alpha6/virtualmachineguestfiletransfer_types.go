@@ -0,0 +1,223 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GuestFileTransferNameLabel is the label applied to the helper Pod of a
+	// VirtualMachineGuestFileTransfer that refers to a PersistentVolumeClaim.
+	// Its value is the name of the transfer. VM Operator only operates on
+	// helper Pods that have this label and are owned by the transfer.
+	GuestFileTransferNameLabel = GroupName + "/guest-file-transfer-name"
+)
+
+const (
+	// VirtualMachineGuestFileTransferVMNotFoundReason documents that the
+	// VirtualMachine of a VirtualMachineGuestFileTransfer does not exist.
+	VirtualMachineGuestFileTransferVMNotFoundReason = "VirtualMachineNotFound"
+
+	// VirtualMachineGuestFileTransferGuestNotReadyReason documents that the
+	// guest of the VirtualMachine of a VirtualMachineGuestFileTransfer does
+	// not yet have a green heartbeat, i.e. VMware Tools is not running.
+	VirtualMachineGuestFileTransferGuestNotReadyReason = "GuestNotReady"
+
+	// VirtualMachineGuestFileTransferObjectNotFoundReason documents that the
+	// Secret or ConfigMap key, or the file in the PersistentVolumeClaim, of a
+	// VirtualMachineGuestFileTransfer to the guest does not exist.
+	VirtualMachineGuestFileTransferObjectNotFoundReason = "ObjectNotFound"
+
+	// VirtualMachineGuestFileTransferHelperPodNotReadyReason documents that
+	// the Pod that mounts the PersistentVolumeClaim of a
+	// VirtualMachineGuestFileTransfer is not yet running.
+	VirtualMachineGuestFileTransferHelperPodNotReadyReason = "HelperPodNotReady"
+
+	// VirtualMachineGuestFileTransferFailedReason documents that the file of
+	// a VirtualMachineGuestFileTransfer could not be copied.
+	VirtualMachineGuestFileTransferFailedReason = "TransferFailed"
+)
+
+// VirtualMachineGuestFileTransferDirection is the direction in which a file is
+// copied.
+type VirtualMachineGuestFileTransferDirection string
+
+const (
+	// VirtualMachineGuestFileTransferDirectionToGuest copies the data of a
+	// Secret or ConfigMap key, or a file in a PersistentVolumeClaim, to a file
+	// in the guest.
+	VirtualMachineGuestFileTransferDirectionToGuest VirtualMachineGuestFileTransferDirection = "ToGuest"
+
+	// VirtualMachineGuestFileTransferDirectionFromGuest copies a file in the
+	// guest to a Secret or ConfigMap key, or to a file in a
+	// PersistentVolumeClaim.
+	VirtualMachineGuestFileTransferDirectionFromGuest VirtualMachineGuestFileTransferDirection = "FromGuest"
+)
+
+// VirtualMachineGuestFileTransferObjectKind is the kind of resource whose
+// data is copied to or from the guest.
+type VirtualMachineGuestFileTransferObjectKind string
+
+const (
+	// VirtualMachineGuestFileTransferObjectKindSecret refers to a Secret.
+	VirtualMachineGuestFileTransferObjectKindSecret VirtualMachineGuestFileTransferObjectKind = "Secret"
+
+	// VirtualMachineGuestFileTransferObjectKindConfigMap refers to a
+	// ConfigMap.
+	VirtualMachineGuestFileTransferObjectKindConfigMap VirtualMachineGuestFileTransferObjectKind = "ConfigMap"
+
+	// VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim refers
+	// to a PersistentVolumeClaim.
+	VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim VirtualMachineGuestFileTransferObjectKind = "PersistentVolumeClaim"
+)
+
+// VirtualMachineGuestFileTransferObject references a key of a Secret or
+// ConfigMap, or a file in a PersistentVolumeClaim, in the same namespace as
+// the transfer.
+type VirtualMachineGuestFileTransferObject struct {
+	// +kubebuilder:validation:Enum=Secret;ConfigMap;PersistentVolumeClaim
+
+	// Kind is the kind of the resource.
+	Kind VirtualMachineGuestFileTransferObjectKind `json:"kind"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// Key is the key in the resource's data that contains the file.
+	//
+	// For a PersistentVolumeClaim, Key is the path of the file relative to
+	// the root of the claim's volume, for example "certs/ca.crt".
+	Key string `json:"key"`
+}
+
+// VirtualMachineGuestFileTransferSpec defines the desired state of
+// VirtualMachineGuestFileTransfer.
+type VirtualMachineGuestFileTransferSpec struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// VMName is the name of the VirtualMachine in the same namespace.
+	VMName string `json:"vmName"`
+
+	// +kubebuilder:validation:Enum=ToGuest;FromGuest
+
+	// Direction is the direction in which the file is copied.
+	Direction VirtualMachineGuestFileTransferDirection `json:"direction"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// GuestPath is the absolute path of the file in the guest.
+	GuestPath string `json:"guestPath"`
+
+	// Object is the Secret or ConfigMap key, or the file in a
+	// PersistentVolumeClaim, that is the source of a transfer to the guest, or
+	// the destination of a transfer from the guest.
+	//
+	// When the direction is FromGuest, a Secret or ConfigMap is created if it
+	// does not exist, and a file in a PersistentVolumeClaim is created or
+	// replaced. Please note the data of a Secret or ConfigMap may not exceed
+	// 1 MiB, which limits the size of the file that may be copied.
+	//
+	// A PersistentVolumeClaim must already exist and is mounted by a helper
+	// Pod for the duration of the transfer, so the claim must not be in use
+	// by a VirtualMachine or a Pod on another node. PersistentVolumeClaims
+	// are only supported when the FSS_WCP_VMSERVICE_GUEST_FILE_TRANSFER_PVC
+	// feature is enabled.
+	Object VirtualMachineGuestFileTransferObject `json:"object"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// CredentialsSecretName is the name of a Secret in the same namespace
+	// with the keys "username" and "password" used to authenticate with the
+	// guest.
+	CredentialsSecretName string `json:"credentialsSecretName"`
+
+	// +optional
+
+	// Overwrite specifies whether an existing file in the guest is replaced
+	// when the direction is ToGuest. Defaults to false.
+	Overwrite bool `json:"overwrite,omitempty"`
+}
+
+// VirtualMachineGuestFileTransferStatus defines the observed state of
+// VirtualMachineGuestFileTransfer.
+type VirtualMachineGuestFileTransferStatus struct {
+	// +optional
+
+	// Size is the size of the copied file in bytes.
+	Size int64 `json:"size,omitempty"`
+
+	// +optional
+
+	// Checksum is the hex-encoded SHA-256 checksum of the copied file.
+	Checksum string `json:"checksum,omitempty"`
+
+	// +optional
+
+	// CompletionTime is the time at which the transfer completed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineGuestFileTransfer.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (t *VirtualMachineGuestFileTransfer) GetConditions() []metav1.Condition {
+	return t.Status.Conditions
+}
+
+func (t *VirtualMachineGuestFileTransfer) SetConditions(conditions []metav1.Condition) {
+	t.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmfiletransfer
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".spec.vmName"
+// +kubebuilder:printcolumn:name="Direction",type="string",JSONPath=".spec.direction"
+// +kubebuilder:printcolumn:name="Guest-Path",type="string",JSONPath=".spec.guestPath"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineGuestFileTransfer is the schema for the
+// virtualmachineguestfiletransfers API and represents a one-time copy of a
+// file between a Secret or ConfigMap key, or a file in a
+// PersistentVolumeClaim, and a running VirtualMachine's guest using VMware
+// Tools guest operations.
+//
+// The transfer waits until the guest's heartbeat is green, which allows data
+// such as certificates to be pushed into running VMs without re-bootstrapping
+// them.
+type VirtualMachineGuestFileTransfer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineGuestFileTransferSpec   `json:"spec,omitempty"`
+	Status VirtualMachineGuestFileTransferStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineGuestFileTransferList contains a list of
+// VirtualMachineGuestFileTransfer.
+type VirtualMachineGuestFileTransferList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineGuestFileTransfer `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineGuestFileTransfer{},
+		&VirtualMachineGuestFileTransferList{},
+	)
+}
@@ -64,6 +64,11 @@ const (
 	// VirtualMachineImageProviderSecurityNotCompliantReason documents that the
 	// VirtualMachineImage provider doesn't meet security compliance requirements.
 	VirtualMachineImageProviderSecurityNotCompliantReason = "VirtualMachineImageProviderSecurityNotCompliant"
+
+	// VirtualMachineImageOCIArtifactUnavailableReason documents that the OCI
+	// artifact that is the source of the VirtualMachineImage could not be read
+	// from the registry.
+	VirtualMachineImageOCIArtifactUnavailableReason = "OCIArtifactUnavailable"
 )
 
 // VirtualMachineImageProductInfo describes product information for an image.
@@ -193,6 +198,45 @@ type VirtualMachineImageSpec struct {
 	// ProviderRef is a reference to the resource that contains the source of
 	// this image's information.
 	ProviderRef *vmopv1common.LocalObjectRef `json:"providerRef,omitempty"`
+
+	// +optional
+
+	// OCI describes the OCI artifact that is the source of this image, ex.
+	// an artifact pushed by a VirtualMachinePublishRequest with
+	// spec.target.oci.
+	//
+	// When set, VM Operator reads the image's information from the artifact's
+	// OVF descriptor, and VMs are deployed by importing the OVF descriptor and
+	// disks pulled from the registry.
+	OCI *VirtualMachineImageOCISource `json:"oci,omitempty"`
+}
+
+// VirtualMachineImageOCISource describes an OCI artifact that is the source of
+// a VirtualMachineImage.
+type VirtualMachineImageOCISource struct {
+	// Reference is the reference to the artifact, including the registry
+	// host, ex. registry.example.com/project/my-image@sha256:abc.
+	//
+	// Please note a reference by digest is recommended, as a tag may be moved
+	// to a different artifact after the image is created.
+	Reference string `json:"reference"`
+
+	// +optional
+
+	// SecretName is the name of a Secret that contains the credentials used
+	// to pull from the registry. The Secret must be of type
+	// kubernetes.io/dockerconfigjson or kubernetes.io/basic-auth.
+	//
+	// For a VirtualMachineImage the Secret must be in the same namespace as
+	// the image. For a ClusterVirtualMachineImage the Secret must be in the
+	// namespace in which VM Operator is deployed.
+	SecretName string `json:"secretName,omitempty"`
+
+	// +optional
+
+	// InsecureSkipTLSVerify disables the verification of the registry's TLS
+	// certificate.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
 }
 
 // VirtualMachineImageStatus defines the observed state of VirtualMachineImage.
@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineIPAddressClaimSpec defines the desired state of
+// VirtualMachineIPAddressClaim.
+type VirtualMachineIPAddressClaimSpec struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// IPPoolName is the name of the VirtualMachineIPPool in the same namespace
+	// from which the address is allocated.
+	IPPoolName string `json:"ipPoolName"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// Address is the allocated IP4 or IP6 address in CIDR notation, using the
+	// network prefix length of the pool CIDR that contains the address, ex.
+	// 192.168.0.10/24 or 2001:db8:101::a/64.
+	Address string `json:"address"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// VMName is the name of the VirtualMachine to which the address is
+	// assigned.
+	VMName string `json:"vmName"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// InterfaceName is the name of the VirtualMachine's network interface to
+	// which the address is assigned.
+	InterfaceName string `json:"interfaceName"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmipclaim
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".spec.ipPoolName"
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=".spec.address"
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".spec.vmName"
+// +kubebuilder:printcolumn:name="Interface",type="string",JSONPath=".spec.interfaceName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineIPAddressClaim is the schema for the
+// virtualmachineipaddressclaims API and records the allocation of a single
+// address from a VirtualMachineIPPool.
+//
+// Claims are created by VM Operator. The name of a claim is derived from the
+// pool name and the address, which ensures an address is never allocated more
+// than once. Deleting a claim releases its address.
+type VirtualMachineIPAddressClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+
+	Spec VirtualMachineIPAddressClaimSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineIPAddressClaimList contains a list of
+// VirtualMachineIPAddressClaim.
+type VirtualMachineIPAddressClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineIPAddressClaim `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineIPAddressClaim{},
+		&VirtualMachineIPAddressClaimList{},
+	)
+}
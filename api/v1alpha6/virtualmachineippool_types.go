@@ -0,0 +1,84 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineIPPoolSpec defines the desired state of VirtualMachineIPPool.
+type VirtualMachineIPPoolSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+
+	// CIDRs is the list of IP4 and IP6 networks from which addresses are
+	// allocated, ex. 192.168.0.0/24 or 2001:db8:101::/64.
+	//
+	// Please note the network and broadcast addresses of IP4 networks and the
+	// first address of IP6 networks are never allocated.
+	CIDRs []string `json:"cidrs"`
+
+	// +optional
+	// +listType=set
+
+	// Exclusions is a list of IP4 and IP6 addresses or networks that are
+	// never allocated, ex. 192.168.0.1 or 192.168.0.240/28.
+	Exclusions []string `json:"exclusions,omitempty"`
+
+	// +optional
+
+	// Gateway4 is the default, IP4 gateway for interfaces that are allocated
+	// an IP4 address from this pool. The gateway is never allocated.
+	Gateway4 string `json:"gateway4,omitempty"`
+
+	// +optional
+
+	// Gateway6 is the default, IP6 gateway for interfaces that are allocated
+	// an IP6 address from this pool. The gateway is never allocated.
+	Gateway6 string `json:"gateway6,omitempty"`
+
+	// +optional
+
+	// Nameservers is a list of IP4 and/or IP6 addresses used as DNS
+	// nameservers for interfaces that are allocated addresses from this pool.
+	Nameservers []string `json:"nameservers,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmippool
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="CIDRs",type="string",JSONPath=".spec.cidrs"
+// +kubebuilder:printcolumn:name="Gateway4",type="string",JSONPath=".spec.gateway4"
+// +kubebuilder:printcolumn:name="Gateway6",type="string",JSONPath=".spec.gateway6",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineIPPool is the schema for the virtualmachineippools API and
+// represents a range of IP addresses from which VM Operator allocates the
+// addresses of network interfaces that reference the pool.
+//
+// Each allocated address is recorded by a VirtualMachineIPAddressClaim, which
+// is owned by the VirtualMachine to which the address is assigned.
+type VirtualMachineIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineIPPoolSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineIPPoolList contains a list of VirtualMachineIPPool.
+type VirtualMachineIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineIPPool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineIPPool{},
+		&VirtualMachineIPPoolList{},
+	)
+}
@@ -0,0 +1,256 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineNetworkPolicyConditionEnforced indicates whether the
+	// network provider has realized the security constructs that the
+	// VirtualMachineNetworkPolicy was translated into.
+	VirtualMachineNetworkPolicyConditionEnforced = "Enforced"
+
+	// VirtualMachineNetworkPolicyNotSupportedReason documents the network
+	// provider does not support VirtualMachineNetworkPolicy.
+	VirtualMachineNetworkPolicyNotSupportedReason = "NetworkProviderNotSupported"
+
+	// VirtualMachineNetworkPolicyNotRealizedReason documents the network
+	// provider has not yet realized the security constructs.
+	VirtualMachineNetworkPolicyNotRealizedReason = "NotRealized"
+)
+
+// VirtualMachineNetworkPolicyType is the direction of traffic to which a
+// VirtualMachineNetworkPolicy applies.
+// +kubebuilder:validation:Enum=Ingress;Egress
+type VirtualMachineNetworkPolicyType string
+
+const (
+	// VirtualMachineNetworkPolicyTypeIngress is traffic to the selected VMs.
+	VirtualMachineNetworkPolicyTypeIngress VirtualMachineNetworkPolicyType = "Ingress"
+
+	// VirtualMachineNetworkPolicyTypeEgress is traffic from the selected VMs.
+	VirtualMachineNetworkPolicyTypeEgress VirtualMachineNetworkPolicyType = "Egress"
+)
+
+// VirtualMachineNetworkPolicyPort describes the protocol and ports of the
+// traffic allowed by a rule.
+type VirtualMachineNetworkPolicyPort struct {
+	// +optional
+	// +kubebuilder:default=TCP
+	// +kubebuilder:validation:Enum=TCP;UDP
+
+	// Protocol is the protocol of the traffic, either TCP or UDP.
+	//
+	// Defaults to TCP.
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// Port is the port number of the traffic. If omitted, all ports of the
+	// protocol match.
+	Port *int32 `json:"port,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// EndPort is the last port number of a range of ports that starts with
+	// Port. EndPort may only be specified with Port, and must be greater than
+	// or equal to Port.
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyIPBlock describes a network CIDR.
+type VirtualMachineNetworkPolicyIPBlock struct {
+	// CIDR is an IP4 or IP6 network, ex. 192.168.1.0/24 or 2001:db8::/64.
+	CIDR string `json:"cidr"`
+}
+
+// VirtualMachineNetworkPolicyPeer describes the VMs or networks that are the
+// source or destination of traffic. Exactly one of VMSelector or IPBlock must
+// be specified. NamespaceSelector may only be specified with VMSelector.
+type VirtualMachineNetworkPolicyPeer struct {
+	// +optional
+
+	// VMSelector selects VMs by label. The VMs are selected from the
+	// policy's namespace unless NamespaceSelector is specified.
+	VMSelector *metav1.LabelSelector `json:"vmSelector,omitempty"`
+
+	// +optional
+
+	// NamespaceSelector selects the namespaces from which VMSelector selects
+	// VMs. An empty selector selects all namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// +optional
+
+	// IPBlock selects a network by CIDR.
+	IPBlock *VirtualMachineNetworkPolicyIPBlock `json:"ipBlock,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyIngressRule describes traffic that is allowed to
+// the selected VMs.
+type VirtualMachineNetworkPolicyIngressRule struct {
+	// +optional
+	// +listType=atomic
+
+	// From is the list of sources of the traffic. If empty, traffic from all
+	// sources is allowed.
+	From []VirtualMachineNetworkPolicyPeer `json:"from,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// Ports is the list of ports of the traffic. If empty, traffic to all
+	// ports is allowed.
+	Ports []VirtualMachineNetworkPolicyPort `json:"ports,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyEgressRule describes traffic that is allowed from
+// the selected VMs.
+type VirtualMachineNetworkPolicyEgressRule struct {
+	// +optional
+	// +listType=atomic
+
+	// To is the list of destinations of the traffic. If empty, traffic to all
+	// destinations is allowed.
+	To []VirtualMachineNetworkPolicyPeer `json:"to,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// Ports is the list of ports of the traffic. If empty, traffic to all
+	// ports is allowed.
+	Ports []VirtualMachineNetworkPolicyPort `json:"ports,omitempty"`
+}
+
+// VirtualMachineNetworkPolicySpec defines the desired state of
+// VirtualMachineNetworkPolicy.
+type VirtualMachineNetworkPolicySpec struct {
+	// VMSelector selects the VMs in the policy's namespace to which the policy
+	// applies. An empty selector selects all of the VMs in the namespace.
+	VMSelector metav1.LabelSelector `json:"vmSelector"`
+
+	// +optional
+	// +listType=set
+
+	// PolicyTypes is the list of directions of traffic to which the policy
+	// applies. Traffic in these directions is denied to or from the selected
+	// VMs unless it is allowed by a rule of this or another policy that
+	// selects the VMs.
+	//
+	// Defaults to Ingress, and to Egress as well if the policy has any egress
+	// rules.
+	PolicyTypes []VirtualMachineNetworkPolicyType `json:"policyTypes,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// Ingress is the list of rules that allow traffic to the selected VMs.
+	Ingress []VirtualMachineNetworkPolicyIngressRule `json:"ingress,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// Egress is the list of rules that allow traffic from the selected VMs.
+	Egress []VirtualMachineNetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyVMStatus describes the enforcement of the policy
+// for one of the selected VMs.
+//
+// The network provider reports whether the SecurityPolicies the policy was
+// translated into are realized, but not whether they are enforced on each
+// VM. Therefore the status of a VM reflects the realization of the policy as
+// a whole, except the policy is never enforced for a VM whose networking is
+// disabled.
+type VirtualMachineNetworkPolicyVMStatus struct {
+	// Name is the name of the VM.
+	Name string `json:"name"`
+
+	// Enforced is true when the policy is enforced for the VM.
+	Enforced bool `json:"enforced"`
+
+	// +optional
+
+	// Message describes why the policy is not enforced for the VM.
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyStatus defines the observed state of
+// VirtualMachineNetworkPolicy.
+type VirtualMachineNetworkPolicyStatus struct {
+	// +optional
+
+	// ObservedGeneration reflects the generation of the most recently
+	// observed VirtualMachineNetworkPolicy.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// VirtualMachines describes the enforcement of the policy for each of the
+	// selected VMs.
+	VirtualMachines []VirtualMachineNetworkPolicyVMStatus `json:"virtualMachines,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineNetworkPolicy.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (p *VirtualMachineNetworkPolicy) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *VirtualMachineNetworkPolicy) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmnetpol
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Enforced",type="string",JSONPath=".status.conditions[?(@.type=='Enforced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineNetworkPolicy is the schema for the
+// virtualmachinenetworkpolicies API and describes the ingress and egress
+// traffic allowed to and from VMs.
+//
+// The policy is translated into the native security constructs of the
+// network provider. This is supported for the NSX-T and VPC network
+// providers.
+type VirtualMachineNetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineNetworkPolicySpec   `json:"spec,omitempty"`
+	Status VirtualMachineNetworkPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineNetworkPolicyList contains a list of
+// VirtualMachineNetworkPolicy.
+type VirtualMachineNetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineNetworkPolicy `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineNetworkPolicy{},
+		&VirtualMachineNetworkPolicyList{},
+	)
+}
@@ -84,6 +84,19 @@ const (
 	// class which is incompatible with the encryption status of the source VM.
 	TargetContentLibraryIncompatibleStorageClassReason = "TargetContentLibraryIncompatibleStorageClass"
 
+	// TargetOCIRepositoryInvalidReason documents that the target OCI
+	// repository is not a valid reference to a repository in a registry.
+	TargetOCIRepositoryInvalidReason = "TargetOCIRepositoryInvalid"
+
+	// TargetOCISecretNotFoundReason documents that the Secret with the
+	// credentials for the target OCI registry does not exist.
+	TargetOCISecretNotFoundReason = "TargetOCISecretNotFound"
+
+	// TargetOCISourceNotPoweredOffReason documents that the source VM cannot
+	// be published to the target OCI repository because it is not powered
+	// off.
+	TargetOCISourceNotPoweredOffReason = "TargetOCISourceNotPoweredOff"
+
 	// TargetItemAlreadyExistsReason documents that an item with the same name
 	// as the VirtualMachinePublishRequest's target item name exists in
 	// the target content library.
@@ -230,6 +243,49 @@ type VirtualMachinePublishRequestTarget struct {
 	// Location contains information about the location to which to publish
 	// the VM.
 	Location VirtualMachinePublishRequestTargetLocation `json:"location,omitempty"`
+
+	// +optional
+
+	// OCI contains information about the OCI registry repository to which to
+	// publish the VM.
+	//
+	// When set, spec.target.location is ignored. The VM is exported as an OVF
+	// descriptor and disks, which are pushed to the repository as an OCI
+	// artifact. Once the artifact is pushed, a VirtualMachineImage resource
+	// named spec.target.item.name whose spec.oci refers to the artifact is
+	// created in the same namespace as the publication request.
+	//
+	// The VM must be powered off to be published to an OCI repository.
+	OCI *VirtualMachinePublishRequestTargetOCI `json:"oci,omitempty"`
+}
+
+// VirtualMachinePublishRequestTargetOCI is the OCI registry part of a
+// publication request's target.
+type VirtualMachinePublishRequestTargetOCI struct {
+	// Repository is the repository to which the artifact is pushed, including
+	// the registry host, ex. registry.example.com/project/my-image.
+	Repository string `json:"repository"`
+
+	// +optional
+
+	// Tag is the tag assigned to the pushed artifact.
+	//
+	// If omitted then the artifact is tagged "latest".
+	Tag string `json:"tag,omitempty"`
+
+	// +optional
+
+	// SecretName is the name of a Secret in the same namespace as the
+	// publication request that contains the credentials used to push to the
+	// registry. The Secret must be of type kubernetes.io/dockerconfigjson or
+	// kubernetes.io/basic-auth.
+	SecretName string `json:"secretName,omitempty"`
+
+	// +optional
+
+	// InsecureSkipTLSVerify disables the verification of the registry's TLS
+	// certificate.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
 }
 
 // VirtualMachinePublishRequestSpec defines the desired state of a
@@ -348,6 +404,12 @@ type VirtualMachinePublishRequestStatus struct {
 
 	// +optional
 
+	// ArtifactDigest is the digest of the manifest of the OCI artifact pushed
+	// to the registry when spec.target.oci is specified.
+	ArtifactDigest string `json:"artifactDigest,omitempty"`
+
+	// +optional
+
 	// Ready is set to true only when the VM has been published successfully
 	// and the new VirtualMachineImage resource is ready.
 	//
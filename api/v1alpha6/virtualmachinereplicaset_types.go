@@ -28,6 +28,10 @@ const (
 	// the virtual machines controlled by the VirtualMachineReplicaSet.
 	VirtualMachinesReadyCondition = "VirtualMachinesReady"
 
+	// VirtualMachinesNotReadyReason documents one or more of the virtual
+	// machines controlled by the VirtualMachineReplicaSet are not ready.
+	VirtualMachinesNotReadyReason = "VirtualMachinesNotReady"
+
 	// VirtualMachineCreationFailedReason documents a VirtualMachineReplicaSet failing to
 	// generate a VirtualMachine object.
 	VirtualMachineCreationFailedReason = "VirtualMachineCreationFailed"
@@ -47,8 +51,82 @@ const (
 	// replicas VirtualMachine objects that it owns.  The value of this label is the
 	// name of the VirtualMachineReplicaSet.
 	VirtualMachineReplicaSetNameLabel = "vmoperator.vmware.com/replicaset-name"
+
+	// VirtualMachineReplicaSetDeleteAnnotation is the key of an annotation
+	// that may be applied to a replica VirtualMachine to mark it as the
+	// preferred candidate for deletion when its VirtualMachineReplicaSet is
+	// scaled down. This annotation is honored by every delete policy, and
+	// its value is ignored.
+	VirtualMachineReplicaSetDeleteAnnotation = "vmoperator.vmware.com/replicaset-delete-me"
+)
+
+const (
+	// VirtualMachineReplicaSetDeletePolicyRandom prioritizes replicas that are
+	// being deleted or are annotated with
+	// VirtualMachineReplicaSetDeleteAnnotation. All other replicas are equally
+	// likely to be deleted.
+	VirtualMachineReplicaSetDeletePolicyRandom = "Random"
+
+	// VirtualMachineReplicaSetDeletePolicyNewest prioritizes replicas that are
+	// being deleted, annotated with VirtualMachineReplicaSetDeleteAnnotation,
+	// or not ready, followed by the most recently created replicas.
+	VirtualMachineReplicaSetDeletePolicyNewest = "Newest"
+
+	// VirtualMachineReplicaSetDeletePolicyOldest prioritizes replicas that are
+	// being deleted, annotated with VirtualMachineReplicaSetDeleteAnnotation,
+	// or not ready, followed by the least recently created replicas.
+	VirtualMachineReplicaSetDeletePolicyOldest = "Oldest"
+
+	// VirtualMachineReplicaSetDeletePolicyNotReadyFirst prioritizes replicas
+	// that are being deleted, annotated with
+	// VirtualMachineReplicaSetDeleteAnnotation, or not ready. All other
+	// replicas are equally likely to be deleted.
+	VirtualMachineReplicaSetDeletePolicyNotReadyFirst = "NotReadyFirst"
 )
 
+// UnsatisfiableConstraintAction is the action to take when a
+// VirtualMachineTopologySpreadConstraint cannot be satisfied.
+//
+// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+type UnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule instructs placement not to place the virtual machine when
+	// doing so would violate the constraint.
+	DoNotSchedule UnsatisfiableConstraintAction = "DoNotSchedule"
+
+	// ScheduleAnyway instructs placement to place the virtual machine in the
+	// zones that minimize the skew when the constraint cannot be satisfied.
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// VirtualMachineTopologySpreadConstraint specifies how to spread the replica
+// virtual machines of a VirtualMachineReplicaSet across zones.
+type VirtualMachineTopologySpreadConstraint struct {
+	// +kubebuilder:validation:Minimum=1
+	//
+	// MaxSkew describes the degree to which the replicas may be unevenly
+	// distributed. It is the maximum permitted difference between the number of
+	// replicas in a given zone and the minimum number of replicas in any of
+	// the zones available to the namespace. For example, with three zones and
+	// a MaxSkew of 1, the replicas may be spread as 2/2/1 but not 3/1/1.
+	MaxSkew int32 `json:"maxSkew"`
+
+	// +optional
+	// +kubebuilder:default=DoNotSchedule
+	//
+	// WhenUnsatisfiable indicates how to deal with a replica when it does not
+	// satisfy the spread constraint:
+	//
+	// - DoNotSchedule -- the replica is not placed until a zone that
+	//                    satisfies the constraint is available.
+	// - ScheduleAnyway -- the replica is placed in the zones that minimize the
+	//                     skew.
+	//
+	// Defaults to DoNotSchedule.
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
 // VirtualMachineTemplateSpec describes the data needed to create a VirtualMachine
 // from a template.
 type VirtualMachineTemplateSpec struct {
@@ -62,6 +140,16 @@ type VirtualMachineTemplateSpec struct {
 	//
 	// Specification of the desired behavior of each replica virtual machine.
 	Spec VirtualMachineSpec `json:"spec,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	//
+	// TopologySpreadConstraints describes how the replica virtual machines are
+	// spread across the zones available to the namespace. Placement only
+	// considers the zones that satisfy all of the constraints. When scaling
+	// down, the delete policy is always honored, and the replicas it ranks
+	// equally are removed from the most populated zones first.
+	TopologySpreadConstraints []VirtualMachineTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // VirtualMachineReplicaSetSpec is the specification of a VirtualMachineReplicaSet.
@@ -75,10 +163,17 @@ type VirtualMachineReplicaSetSpec struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 
 	// +optional
-	// +kubebuilder:validation:Enum=Random
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;NotReadyFirst
 	//
-	// DeletePolicy defines the policy used to identify nodes to delete when downscaling.
-	// Only supported deletion policy is "Random".
+	// DeletePolicy defines the policy used to identify virtual machines to
+	// delete when downscaling. Supported policies are "Random", "Newest",
+	// "Oldest", and "NotReadyFirst". Defaults to "Random".
+	//
+	// Regardless of the policy, virtual machines that are already being
+	// deleted or have the "vmoperator.vmware.com/replicaset-delete-me"
+	// annotation are always deleted first. When several virtual machines share
+	// the same priority, the ones in the zone with the most replicas are
+	// deleted first so the replicas remain spread across zones.
 	DeletePolicy string `json:"deletePolicy,omitempty"`
 
 	// +optional
@@ -130,6 +225,15 @@ type VirtualMachineReplicaSetStatus struct {
 	// Conditions represents the latest available observations of a
 	// VirtualMachineReplicaSet's current state.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	//
+	// Selector is the same as the label selector from the spec, but in the
+	// string format. This field is exposed via the scale subresource so
+	// clients such as the HorizontalPodAutoscaler can discover the virtual
+	// machines that belong to this VirtualMachineReplicaSet.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors
+	Selector string `json:"selector,omitempty"`
 }
 
 func (rs *VirtualMachineReplicaSet) GetConditions() []metav1.Condition {
@@ -144,7 +248,7 @@ func (rs *VirtualMachineReplicaSet) SetConditions(conditions []metav1.Condition)
 // +kubebuilder:resource:scope=Namespaced,shortName=vmrs;vmreplicaset
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
-// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Total number of non-terminated virtual machines targeted by this VirtualMachineReplicaSet"
 // +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyReplicas",description="Total number of ready virtual machines targeted by this VirtualMachineReplicaSet"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of VirtualMachineReplicaSet"
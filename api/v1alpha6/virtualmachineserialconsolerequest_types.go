@@ -0,0 +1,108 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SerialConsoleRequestUUIDLabelKey is the label key that records the UUID
+	// of a serial console request. The value is set by VM Operator once the
+	// request is ready, and must be presented to the serial console proxy in
+	// order to connect to the VM's serial console.
+	SerialConsoleRequestUUIDLabelKey = "vmoperator.vmware.com/serialconsolerequest-uuid"
+)
+
+// VirtualMachineSerialConsoleRequestSpec describes the desired state for a
+// serial console request to a VM.
+type VirtualMachineSerialConsoleRequestSpec struct {
+	// Name is the name of a VM in the same Namespace as this serial console
+	// request.
+	//
+	// The VM must have spec.hardware.serialConsole.enabled set to true.
+	//
+	// This field is immutable.
+	Name string `json:"name"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+
+	// TTLSeconds is the number of seconds for which access via this request
+	// is valid, starting from when the request is ready. Connections to the
+	// serial console using this request are closed once the request expires.
+	//
+	// If omitted, the request is valid for 300 seconds.
+	//
+	// This field is immutable.
+	TTLSeconds *int64 `json:"ttlSeconds,omitempty"`
+}
+
+// VirtualMachineSerialConsoleRequestStatus describes the observed state of
+// the request.
+type VirtualMachineSerialConsoleRequestStatus struct {
+	// +optional
+
+	// ExpiryTime is the time at which access via this request will expire.
+	ExpiryTime metav1.Time `json:"expiryTime,omitempty"`
+
+	// +optional
+
+	// ProxyAddr describes the host address and optional port used to access
+	// the VM's serial console.
+	//
+	// The serial console is streamed over a websocket at:
+	//
+	//     wss://<proxyAddr>/serialconsole?namespace=<namespace>&uuid=<uuid>
+	//
+	// where <uuid> is the value of the request's
+	// vmoperator.vmware.com/serialconsolerequest-uuid label.
+	ProxyAddr string `json:"proxyAddr,omitempty"`
+
+	// +optional
+
+	// Endpoint describes the address and port of the VM's network-backed
+	// serial port on the ESXi host on which the VM is running.
+	//
+	// This field is used by the serial console proxy and is not meant to be
+	// accessed directly.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmserialconsole
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Expiry",type="string",JSONPath=".status.expiryTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineSerialConsoleRequest allows the creation of a time-limited
+// connection to a VM's serial console.
+type VirtualMachineSerialConsoleRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSerialConsoleRequestSpec   `json:"spec,omitempty"`
+	Status VirtualMachineSerialConsoleRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineSerialConsoleRequestList contains a list of
+// VirtualMachineSerialConsoleRequests.
+type VirtualMachineSerialConsoleRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineSerialConsoleRequest `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineSerialConsoleRequest{},
+		&VirtualMachineSerialConsoleRequestList{},
+	)
+}
@@ -29,6 +29,95 @@ const (
 	VirtualMachineServiceTypeExternalName VirtualMachineServiceType = "ExternalName"
 )
 
+// VirtualMachineServiceSessionAffinity describes the session affinity of a
+// VirtualMachineService.
+//
+// +kubebuilder:validation:Enum=None;ClientIP
+type VirtualMachineServiceSessionAffinity string
+
+const (
+	// VirtualMachineServiceSessionAffinityNone means no session affinity.
+	VirtualMachineServiceSessionAffinityNone VirtualMachineServiceSessionAffinity = "None"
+
+	// VirtualMachineServiceSessionAffinityClientIP means connections from the
+	// same client IP address are sent to the same VirtualMachine.
+	VirtualMachineServiceSessionAffinityClientIP VirtualMachineServiceSessionAffinity = "ClientIP"
+)
+
+// VirtualMachineServiceExternalTrafficPolicy describes how a
+// VirtualMachineService routes external traffic.
+//
+// +kubebuilder:validation:Enum=Cluster;Local
+type VirtualMachineServiceExternalTrafficPolicy string
+
+const (
+	// VirtualMachineServiceExternalTrafficPolicyCluster means external traffic
+	// is routed to all VirtualMachines backing the service.
+	VirtualMachineServiceExternalTrafficPolicyCluster VirtualMachineServiceExternalTrafficPolicy = "Cluster"
+
+	// VirtualMachineServiceExternalTrafficPolicyLocal means external traffic
+	// is only routed to VirtualMachines local to the node that received it,
+	// preserving the client source IP address.
+	VirtualMachineServiceExternalTrafficPolicyLocal VirtualMachineServiceExternalTrafficPolicy = "Local"
+)
+
+// VirtualMachineServiceIPFamily describes an IP family of a
+// VirtualMachineService.
+//
+// +kubebuilder:validation:Enum=IPv4;IPv6
+type VirtualMachineServiceIPFamily string
+
+const (
+	// VirtualMachineServiceIPFamilyIPv4 is the IPv4 family.
+	VirtualMachineServiceIPFamilyIPv4 VirtualMachineServiceIPFamily = "IPv4"
+
+	// VirtualMachineServiceIPFamilyIPv6 is the IPv6 family.
+	VirtualMachineServiceIPFamilyIPv6 VirtualMachineServiceIPFamily = "IPv6"
+)
+
+// VirtualMachineServiceIPFamilyPolicy describes the dual-stack-ness requested
+// for a VirtualMachineService.
+//
+// +kubebuilder:validation:Enum=SingleStack;PreferDualStack;RequireDualStack
+type VirtualMachineServiceIPFamilyPolicy string
+
+const (
+	// VirtualMachineServiceIPFamilyPolicySingleStack means the service is
+	// assigned a single IP family.
+	VirtualMachineServiceIPFamilyPolicySingleStack VirtualMachineServiceIPFamilyPolicy = "SingleStack"
+
+	// VirtualMachineServiceIPFamilyPolicyPreferDualStack means the service is
+	// assigned both IPv4 and IPv6 families if the cluster supports it,
+	// otherwise a single IP family.
+	VirtualMachineServiceIPFamilyPolicyPreferDualStack VirtualMachineServiceIPFamilyPolicy = "PreferDualStack"
+
+	// VirtualMachineServiceIPFamilyPolicyRequireDualStack means the service is
+	// assigned both IPv4 and IPv6 families, and fails if the cluster does not
+	// support it.
+	VirtualMachineServiceIPFamilyPolicyRequireDualStack VirtualMachineServiceIPFamilyPolicy = "RequireDualStack"
+)
+
+// VirtualMachineServiceSessionAffinityConfig describes the configuration of
+// a VirtualMachineService's session affinity.
+type VirtualMachineServiceSessionAffinityConfig struct {
+	// +optional
+
+	// ClientIP contains the configuration of ClientIP based session affinity.
+	ClientIP *VirtualMachineServiceClientIPConfig `json:"clientIP,omitempty"`
+}
+
+// VirtualMachineServiceClientIPConfig describes the configuration of ClientIP
+// based session affinity.
+type VirtualMachineServiceClientIPConfig struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+
+	// TimeoutSeconds specifies the number of seconds a session is sticky.
+	// Defaults to 10800 (three hours).
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
 // VirtualMachineServicePort describes the specification of a service port to
 // be exposed by a VirtualMachineService. This VirtualMachineServicePort
 // specification includes attributes that define the external and internal
@@ -138,6 +227,74 @@ type VirtualMachineServiceSpec struct {
 	// Must be a valid RFC-1123 hostname (https://tools.ietf.org/html/rfc1123)
 	// and requires Type to be ExternalName.
 	ExternalName string `json:"externalName,omitempty"`
+
+	// +optional
+
+	// SessionAffinity specifies whether connections from the same client are
+	// sent to the same VirtualMachine. Supports "None" and "ClientIP".
+	// Defaults to None.
+	// Ignored if type is ExternalName.
+	SessionAffinity VirtualMachineServiceSessionAffinity `json:"sessionAffinity,omitempty"`
+
+	// +optional
+
+	// SessionAffinityConfig contains the configuration of session affinity.
+	// Only applies when SessionAffinity is ClientIP.
+	SessionAffinityConfig *VirtualMachineServiceSessionAffinityConfig `json:"sessionAffinityConfig,omitempty"`
+
+	// +optional
+
+	// ExternalTrafficPolicy describes how external traffic is routed to the
+	// VirtualMachines backing the service. Supports "Cluster" and "Local".
+	// Only applies to VirtualMachineService Type: LoadBalancer.
+	//
+	// This field takes precedence over the
+	// virtualmachineservice.vmoperator.vmware.com/service.externalTrafficPolicy
+	// annotation.
+	ExternalTrafficPolicy VirtualMachineServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// HealthCheckNodePort specifies the health check node port for the
+	// service. Only applies to VirtualMachineService Type: LoadBalancer when
+	// ExternalTrafficPolicy is Local. If not specified, a port is allocated
+	// by the service API backend.
+	//
+	// This field takes precedence over the
+	// virtualmachineservice.vmoperator.vmware.com/service.healthCheckNodePort
+	// annotation.
+	HealthCheckNodePort int32 `json:"healthCheckNodePort,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=2
+
+	// IPFamilies is the list of IP families, ex. IPv4 and IPv6, assigned to
+	// the service. The first family is the service's primary family and
+	// determines which of a VirtualMachine's IP addresses is used as its
+	// endpoint address.
+	// If not specified, the families are assigned by the service API backend
+	// based on IPFamilyPolicy.
+	// Ignored if type is ExternalName.
+	IPFamilies []VirtualMachineServiceIPFamily `json:"ipFamilies,omitempty"`
+
+	// +optional
+
+	// IPFamilyPolicy describes the dual-stack-ness requested for the service.
+	// Supports "SingleStack", "PreferDualStack" and "RequireDualStack".
+	// If not specified, defaults to SingleStack.
+	// Ignored if type is ExternalName.
+	IPFamilyPolicy *VirtualMachineServiceIPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+
+	// +optional
+
+	// PublishNotReadyAddresses indicates that the addresses of VirtualMachines
+	// that are not ready are published as ready addresses of the service.
+	// This may be used to allow peer discovery between VirtualMachines before
+	// they are ready.
+	PublishNotReadyAddresses bool `json:"publishNotReadyAddresses,omitempty"`
 }
 
 // VirtualMachineServiceStatus defines the observed state of
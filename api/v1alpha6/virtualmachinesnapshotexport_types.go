@@ -0,0 +1,249 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SnapshotExportNameLabel label represents the name of the
+	// VirtualMachineSnapshotExport whose archive contains a
+	// PersistentVolumeClaim.
+	SnapshotExportNameLabel = "snapshot." + GroupName + "/export-name"
+
+	// SnapshotImportNameLabel label represents the name of the
+	// VirtualMachineSnapshotImport that created a VirtualMachine or
+	// PersistentVolumeClaim.
+	SnapshotImportNameLabel = "snapshot." + GroupName + "/import-name"
+
+	// SnapshotExportConfigMapAnnotation annotation represents the name of the
+	// ConfigMap, in the archive of a VirtualMachineSnapshotExport, that
+	// contains the VM YAML and PVC disk data of the exported snapshot.
+	SnapshotExportConfigMapAnnotation = "snapshot." + GroupName + "/export-configmap"
+)
+
+const (
+	// VirtualMachineSnapshotExportSnapshotNotFoundReason documents that the
+	// VirtualMachineSnapshot of a VirtualMachineSnapshotExport does not exist.
+	VirtualMachineSnapshotExportSnapshotNotFoundReason = "SnapshotNotFound"
+
+	// VirtualMachineSnapshotExportSnapshotNotReadyReason documents that the
+	// VirtualMachineSnapshot of a VirtualMachineSnapshotExport is not yet
+	// ready.
+	VirtualMachineSnapshotExportSnapshotNotReadyReason = "SnapshotNotReady"
+
+	// VirtualMachineSnapshotExportInProgressReason documents that the data of
+	// a VirtualMachineSnapshotExport is still being written to its target.
+	VirtualMachineSnapshotExportInProgressReason = "ExportInProgress"
+
+	// VirtualMachineSnapshotExportFailedReason documents that the data of a
+	// VirtualMachineSnapshotExport could not be written to its target.
+	VirtualMachineSnapshotExportFailedReason = "ExportFailed"
+)
+
+// VirtualMachineSnapshotExportPersistentVolumeClaimTarget describes an archive
+// of PersistentVolumeClaims to which a snapshot is exported.
+type VirtualMachineSnapshotExportPersistentVolumeClaimTarget struct {
+	// +optional
+
+	// StorageClass is the name of the StorageClass used by the
+	// PersistentVolumeClaims of the archive.
+	//
+	// If omitted, the storage class of the snapshot's virtual machine is
+	// used.
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
+// VirtualMachineSnapshotExportOVATarget describes a content library item, in
+// the OVF/OVA format, to which a snapshot is exported.
+type VirtualMachineSnapshotExportOVATarget struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// ContentLibraryName is the name of the ContentLibrary resource, in the
+	// same namespace as the export, in which the OVA is created.
+	ContentLibraryName string `json:"contentLibraryName"`
+
+	// +optional
+
+	// ItemName is the name of the content library item created for the OVA.
+	//
+	// If omitted, the name of the export is used.
+	ItemName string `json:"itemName,omitempty"`
+}
+
+// VirtualMachineSnapshotExportTarget describes the target to which a snapshot
+// is exported. Exactly one of the fields must be specified.
+type VirtualMachineSnapshotExportTarget struct {
+	// +optional
+
+	// PersistentVolumeClaim exports the snapshot to an archive of
+	// PersistentVolumeClaims in the export's namespace. Each of the snapshot's
+	// disks is consolidated into its own PersistentVolumeClaim. The snapshot's
+	// VM YAML and PVC disk data are stored in a ConfigMap named after the
+	// export, and each of the claims is annotated with
+	// snapshot.vmoperator.vmware.com/export-configmap, which refers to the
+	// ConfigMap.
+	//
+	// The claims are named <EXPORT_NAME>-<DISK_INDEX> and, like the ConfigMap,
+	// are labeled with snapshot.vmoperator.vmware.com/export-name. They are
+	// not deleted when the export is deleted, which allows the archive to be
+	// kept offline.
+	PersistentVolumeClaim *VirtualMachineSnapshotExportPersistentVolumeClaimTarget `json:"persistentVolumeClaim,omitempty"`
+
+	// +optional
+
+	// OVA exports the snapshot, including its disks and ExtraConfig, to an
+	// OVF/OVA item in a content library.
+	OVA *VirtualMachineSnapshotExportOVATarget `json:"ova,omitempty"`
+}
+
+// VirtualMachineSnapshotExportSpec defines the desired state of
+// VirtualMachineSnapshotExport.
+type VirtualMachineSnapshotExportSpec struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// SnapshotName is the name of the VirtualMachineSnapshot to export.
+	SnapshotName string `json:"snapshotName"`
+
+	// Target describes where the snapshot is exported.
+	Target VirtualMachineSnapshotExportTarget `json:"target"`
+
+	// +optional
+	// +listType=set
+
+	// ImportNamespaces is the list of namespaces, other than the export's own
+	// namespace, into which the export may be imported with a
+	// VirtualMachineSnapshotImport.
+	ImportNamespaces []string `json:"importNamespaces,omitempty"`
+}
+
+// VirtualMachineSnapshotExportDiskStatus describes a disk of an exported
+// snapshot.
+type VirtualMachineSnapshotExportDiskStatus struct {
+	// FileName is the datastore path of the exported copy of the disk.
+	FileName string `json:"fileName"`
+
+	// +optional
+
+	// Capacity is the capacity of the disk.
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+
+	// +optional
+
+	// SourceClaimName is the name of the PersistentVolumeClaim that backed
+	// the disk when the snapshot was taken. It is empty for the virtual
+	// machine's classic disks.
+	SourceClaimName string `json:"sourceClaimName,omitempty"`
+
+	// +optional
+
+	// ClaimName is the name of the PersistentVolumeClaim in the archive that
+	// contains the disk.
+	ClaimName string `json:"claimName,omitempty"`
+
+	// +optional
+
+	// CopyTaskID is the ID of the vSphere task that copies the disk. It is
+	// only set while the disk is being copied.
+	CopyTaskID string `json:"copyTaskID,omitempty"`
+
+	// +optional
+
+	// Copied is true once the disk has been copied completely. A copy of the
+	// disk that exists while this is false may be incomplete, and is replaced
+	// by a new copy.
+	Copied bool `json:"copied,omitempty"`
+}
+
+// VirtualMachineSnapshotExportStatus defines the observed state of
+// VirtualMachineSnapshotExport.
+type VirtualMachineSnapshotExportStatus struct {
+	// +optional
+
+	// VMName is the name of the virtual machine of the exported snapshot.
+	VMName string `json:"vmName,omitempty"`
+
+	// +optional
+
+	// VMResourceYAML is the YAML of the VirtualMachine resource as it was
+	// when the snapshot was taken, compressed using gzip and base64-encoded.
+	VMResourceYAML string `json:"vmResourceYAML,omitempty"`
+
+	// +optional
+	// +listType=atomic
+
+	// Disks describes the exported copies of the snapshot's disks. It is only
+	// set for exports to a PersistentVolumeClaim target, and includes the
+	// disks that are still being copied.
+	Disks []VirtualMachineSnapshotExportDiskStatus `json:"disks,omitempty"`
+
+	// +optional
+
+	// ItemID is the ID of the content library item created for the OVA. It
+	// is only set for exports to an OVA target.
+	ItemID string `json:"itemID,omitempty"`
+
+	// +optional
+
+	// CompletionTime is the time at which the export completed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineSnapshotExport.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (e *VirtualMachineSnapshotExport) GetConditions() []metav1.Condition {
+	return e.Status.Conditions
+}
+
+func (e *VirtualMachineSnapshotExport) SetConditions(conditions []metav1.Condition) {
+	e.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmsnapshotexport
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Snapshot",type="string",JSONPath=".spec.snapshotName"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineSnapshotExport is the schema for the
+// virtualmachinesnapshotexports API and represents the export of the disks
+// and VM YAML of a VirtualMachineSnapshot to an archive of
+// PersistentVolumeClaims or to an OVA.
+//
+// An exported snapshot may be restored as a new VirtualMachine, in the
+// export's namespace or in one of its import namespaces, with a
+// VirtualMachineSnapshotImport.
+type VirtualMachineSnapshotExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSnapshotExportSpec   `json:"spec,omitempty"`
+	Status VirtualMachineSnapshotExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineSnapshotExportList contains a list of
+// VirtualMachineSnapshotExport.
+type VirtualMachineSnapshotExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineSnapshotExport `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineSnapshotExport{},
+		&VirtualMachineSnapshotExportList{},
+	)
+}
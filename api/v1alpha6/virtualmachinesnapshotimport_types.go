@@ -0,0 +1,176 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineSnapshotImportExportNotFoundReason documents that the
+	// VirtualMachineSnapshotExport of a VirtualMachineSnapshotImport does not
+	// exist.
+	VirtualMachineSnapshotImportExportNotFoundReason = "ExportNotFound"
+
+	// VirtualMachineSnapshotImportExportNotReadyReason documents that the
+	// VirtualMachineSnapshotExport of a VirtualMachineSnapshotImport is not
+	// yet ready.
+	VirtualMachineSnapshotImportExportNotReadyReason = "ExportNotReady"
+
+	// VirtualMachineSnapshotImportNotAllowedReason documents that the
+	// VirtualMachineSnapshotExport of a VirtualMachineSnapshotImport does not
+	// allow being imported into the namespace of the import.
+	VirtualMachineSnapshotImportNotAllowedReason = "ImportNotAllowed"
+
+	// VirtualMachineSnapshotImportImageNotFoundReason documents that the
+	// VirtualMachineImage for the OVA of a VirtualMachineSnapshotExport is
+	// not available in the namespace of a VirtualMachineSnapshotImport.
+	VirtualMachineSnapshotImportImageNotFoundReason = "ImageNotFound"
+
+	// VirtualMachineSnapshotImportInProgressReason documents that the disks
+	// of a VirtualMachineSnapshotImport are still being copied.
+	VirtualMachineSnapshotImportInProgressReason = "ImportInProgress"
+
+	// VirtualMachineSnapshotImportFailedReason documents that the
+	// VirtualMachine of a VirtualMachineSnapshotImport could not be created.
+	VirtualMachineSnapshotImportFailedReason = "ImportFailed"
+)
+
+// VirtualMachineSnapshotImportSource refers to the
+// VirtualMachineSnapshotExport from which a VirtualMachine is imported.
+type VirtualMachineSnapshotImportSource struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// Namespace is the namespace of the VirtualMachineSnapshotExport.
+	Namespace string `json:"namespace"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// Name is the name of the VirtualMachineSnapshotExport.
+	Name string `json:"name"`
+}
+
+// VirtualMachineSnapshotImportSpec defines the desired state of
+// VirtualMachineSnapshotImport.
+type VirtualMachineSnapshotImportSpec struct {
+	// Source refers to the VirtualMachineSnapshotExport to import.
+	Source VirtualMachineSnapshotImportSource `json:"source"`
+
+	// +optional
+
+	// VMName is the name of the VirtualMachine created by the import.
+	//
+	// If omitted, the name of the import is used.
+	VMName string `json:"vmName,omitempty"`
+
+	// +optional
+
+	// ClassName overrides the name of the VirtualMachineClass of the imported
+	// VirtualMachine.
+	ClassName string `json:"className,omitempty"`
+
+	// +optional
+
+	// StorageClass overrides the name of the StorageClass of the imported
+	// VirtualMachine and of its PersistentVolumeClaims.
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
+// VirtualMachineSnapshotImportVolumeStatus describes a volume of an imported
+// VirtualMachine.
+type VirtualMachineSnapshotImportVolumeStatus struct {
+	// Name is the name of the volume.
+	Name string `json:"name"`
+
+	// +optional
+
+	// FileName is the datastore path of the imported copy of the volume's
+	// disk. It is set once the disk has been copied completely.
+	FileName string `json:"fileName,omitempty"`
+
+	// ClaimName is the name of the PersistentVolumeClaim created for the
+	// volume in the import's namespace.
+	ClaimName string `json:"claimName"`
+
+	// +optional
+
+	// CopyTaskID is the ID of the vSphere task that copies the volume's disk.
+	// It is only set while the disk is being copied.
+	CopyTaskID string `json:"copyTaskID,omitempty"`
+}
+
+// VirtualMachineSnapshotImportStatus defines the observed state of
+// VirtualMachineSnapshotImport.
+type VirtualMachineSnapshotImportStatus struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Volumes describes the volumes imported from an archive of
+	// PersistentVolumeClaims.
+	Volumes []VirtualMachineSnapshotImportVolumeStatus `json:"volumes,omitempty"`
+
+	// +optional
+
+	// VMName is the name of the imported VirtualMachine.
+	VMName string `json:"vmName,omitempty"`
+
+	// +optional
+
+	// CompletionTime is the time at which the VirtualMachine was created.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineSnapshotImport.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (i *VirtualMachineSnapshotImport) GetConditions() []metav1.Condition {
+	return i.Status.Conditions
+}
+
+func (i *VirtualMachineSnapshotImport) SetConditions(conditions []metav1.Condition) {
+	i.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmsnapshotimport
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Export",type="string",JSONPath=".spec.source.name"
+// +kubebuilder:printcolumn:name="VM",type="string",JSONPath=".status.vmName"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineSnapshotImport is the schema for the
+// virtualmachinesnapshotimports API and represents the restore of a
+// VirtualMachineSnapshotExport as a new VirtualMachine in the import's
+// namespace, which may differ from the namespace of the export.
+type VirtualMachineSnapshotImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSnapshotImportSpec   `json:"spec,omitempty"`
+	Status VirtualMachineSnapshotImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineSnapshotImportList contains a list of
+// VirtualMachineSnapshotImport.
+type VirtualMachineSnapshotImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineSnapshotImport `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes,
+		&VirtualMachineSnapshotImport{},
+		&VirtualMachineSnapshotImportList{},
+	)
+}
@@ -0,0 +1,255 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha6
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SnapshotScheduleNameLabel label represents the name of the
+	// VirtualMachineSnapshotSchedule that created a VirtualMachineSnapshot.
+	SnapshotScheduleNameLabel = "snapshot." + GroupName + "/schedule-name"
+)
+
+const (
+	// VirtualMachineSnapshotScheduleConditionScheduled indicates whether the
+	// VirtualMachineSnapshotSchedule has a valid schedule on which snapshots
+	// are being created.
+	VirtualMachineSnapshotScheduleConditionScheduled = "Scheduled"
+
+	// VirtualMachineSnapshotScheduleConditionLastRunSucceeded indicates
+	// whether a snapshot was created for each of the selected virtual machines
+	// during the most recent run of a VirtualMachineSnapshotSchedule.
+	VirtualMachineSnapshotScheduleConditionLastRunSucceeded = "LastRunSucceeded"
+
+	// VirtualMachineSnapshotScheduleConditionPruned indicates whether the
+	// snapshots that exceeded the retention policy of a
+	// VirtualMachineSnapshotSchedule were deleted.
+	VirtualMachineSnapshotScheduleConditionPruned = "Pruned"
+
+	// VirtualMachineSnapshotScheduleInvalidScheduleReason documents a
+	// VirtualMachineSnapshotSchedule whose schedule or time zone cannot be
+	// parsed.
+	VirtualMachineSnapshotScheduleInvalidScheduleReason = "InvalidSchedule"
+
+	// VirtualMachineSnapshotScheduleSuspendedReason documents a
+	// VirtualMachineSnapshotSchedule that is suspended.
+	VirtualMachineSnapshotScheduleSuspendedReason = "Suspended"
+
+	// VirtualMachineSnapshotScheduleSnapshotFailedReason documents a
+	// VirtualMachineSnapshotSchedule that failed to create a snapshot for one
+	// or more of the selected virtual machines.
+	VirtualMachineSnapshotScheduleSnapshotFailedReason = "SnapshotFailed"
+
+	// VirtualMachineSnapshotSchedulePruneFailedReason documents a
+	// VirtualMachineSnapshotSchedule that failed to delete one or more
+	// snapshots that exceeded its retention policy.
+	VirtualMachineSnapshotSchedulePruneFailedReason = "PruneFailed"
+)
+
+// VirtualMachineSnapshotTemplateSpec describes the snapshots that are created
+// by a VirtualMachineSnapshotSchedule.
+type VirtualMachineSnapshotTemplateSpec struct {
+	// +optional
+
+	// Memory represents whether the snapshots include the VM's memory. Please
+	// see VirtualMachineSnapshotSpec.Memory for more information.
+	Memory bool `json:"memory,omitempty"`
+
+	// +optional
+
+	// Quiesce represents the spec used for granular control over quiesce
+	// details. Please see VirtualMachineSnapshotSpec.Quiesce for more
+	// information.
+	Quiesce *QuiesceSpec `json:"quiesce,omitempty"`
+
+	// +optional
+
+	// Description represents the description of the snapshots.
+	Description string `json:"description,omitempty"`
+}
+
+// VirtualMachineSnapshotRetentionPolicy describes which of the snapshots
+// created by a VirtualMachineSnapshotSchedule are kept. The policy is applied
+// to each of the selected virtual machines separately.
+type VirtualMachineSnapshotRetentionPolicy struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+
+	// MaxCount is the maximum number of snapshots to keep for each virtual
+	// machine. When exceeded, the oldest snapshots are deleted.
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// +optional
+
+	// MaxAge is the maximum age of the snapshots to keep. Snapshots that are
+	// older are deleted.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec defines the desired state of
+// VirtualMachineSnapshotSchedule.
+type VirtualMachineSnapshotScheduleSpec struct {
+	// +optional
+
+	// Selector is a label query over the virtual machines to snapshot. A nil
+	// selector selects no virtual machines, while an empty selector selects
+	// all of the virtual machines in the namespace.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+
+	// Schedule is the schedule on which snapshots are created, in cron
+	// format, ex. "0 2 * * *" to create the snapshots every day at 02:00.
+	// The descriptors @hourly, @daily, @weekly, @monthly, and @yearly are
+	// also supported.
+	Schedule string `json:"schedule"`
+
+	// +optional
+
+	// TimeZone is the name of the time zone in which the schedule is
+	// interpreted, ex. "America/New_York". Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// +optional
+
+	// Suspend may be set to true to stop creating new snapshots. Snapshots
+	// that were already created are still pruned according to the retention
+	// policy.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+
+	// StartingDeadlineSeconds is the deadline in seconds for creating the
+	// snapshots if they miss their scheduled time for any reason, ex. the
+	// controller was unavailable. Missed activations older than the deadline
+	// are skipped.
+	//
+	// When omitted, there is no deadline. Please note only the most recent of
+	// the missed activations is run, and if more than 100 activations were
+	// missed, a warning event is recorded and the older activations are not
+	// considered when finding the most recent one.
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// +optional
+
+	// SnapshotTemplate describes the snapshots that are created.
+	SnapshotTemplate VirtualMachineSnapshotTemplateSpec `json:"snapshotTemplate,omitempty"`
+
+	// +optional
+
+	// Retention describes which of the created snapshots are kept. When
+	// omitted, snapshots are never deleted by the schedule.
+	Retention *VirtualMachineSnapshotRetentionPolicy `json:"retention,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleFailure describes a failure to create a
+// snapshot for a virtual machine.
+type VirtualMachineSnapshotScheduleFailure struct {
+	// VMName is the name of the virtual machine.
+	VMName string `json:"vmName"`
+
+	// +optional
+
+	// SnapshotName is the name of the VirtualMachineSnapshot that could not
+	// be created.
+	SnapshotName string `json:"snapshotName,omitempty"`
+
+	// Message describes the failure.
+	Message string `json:"message"`
+}
+
+// VirtualMachineSnapshotScheduleStatus defines the observed state of
+// VirtualMachineSnapshotSchedule.
+type VirtualMachineSnapshotScheduleStatus struct {
+	// +optional
+
+	// ObservedGeneration reflects the generation of the most recently
+	// observed VirtualMachineSnapshotSchedule.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+
+	// LastScheduleTime is the time at which snapshots were last scheduled to
+	// be created.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// +optional
+
+	// LastSuccessfulTime is the most recent time at which a snapshot was
+	// created for each of the selected virtual machines.
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// +optional
+
+	// NextScheduleTime is the time at which snapshots are next scheduled to
+	// be created. This field is not set while the schedule is suspended.
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+
+	// SnapshotCount is the number of VirtualMachineSnapshots created by this
+	// schedule that currently exist.
+	SnapshotCount int32 `json:"snapshotCount,omitempty"`
+
+	// +optional
+
+	// Failures describes the virtual machines for which a snapshot could not
+	// be created during the most recent run.
+	Failures []VirtualMachineSnapshotScheduleFailure `json:"failures,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineSnapshotSchedule.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func (s *VirtualMachineSnapshotSchedule) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+func (s *VirtualMachineSnapshotSchedule) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmsnapshotschedule
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend"
+// +kubebuilder:printcolumn:name="Last-Schedule",type="date",JSONPath=".status.lastScheduleTime"
+// +kubebuilder:printcolumn:name="Next-Schedule",type="string",JSONPath=".status.nextScheduleTime",priority=1
+// +kubebuilder:printcolumn:name="Snapshots",type="integer",JSONPath=".status.snapshotCount"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineSnapshotSchedule is the schema for the
+// virtualmachinesnapshotschedules API and creates VirtualMachineSnapshots of
+// the selected virtual machines on a schedule, deleting the snapshots that
+// exceed its retention policy.
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSnapshotScheduleSpec   `json:"spec,omitempty"`
+	Status VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineSnapshotScheduleList contains a list of
+// VirtualMachineSnapshotSchedule.
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &VirtualMachineSnapshotSchedule{}, &VirtualMachineSnapshotScheduleList{})
+}
@@ -8,6 +8,22 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// WebConsoleRequestRequesterAnnotation is the annotation key that
+	// records the name of the user that created a web console request. The
+	// value is set by VM Operator on create and cannot be changed.
+	WebConsoleRequestRequesterAnnotation = "vmoperator.vmware.com/webconsolerequest-requester"
+
+	// WebConsoleRequestMaxTTLSecondsAnnotation is the annotation key that may
+	// be set on a Namespace to specify the maximum number of seconds a web
+	// console request in the Namespace may be valid. Web console requests
+	// that specify a larger spec.ttlSeconds are denied.
+	//
+	// When the annotation is not set, the maximum is the default TTL of 120
+	// seconds.
+	WebConsoleRequestMaxTTLSecondsAnnotation = "vmoperator.vmware.com/webconsolerequest-max-ttl-seconds"
+)
+
 // VirtualMachineWebConsoleRequestSpec describes the desired state for a web
 // console request to a VM.
 type VirtualMachineWebConsoleRequestSpec struct {
@@ -16,6 +32,28 @@ type VirtualMachineWebConsoleRequestSpec struct {
 	Name string `json:"name"`
 	// PublicKey is used to encrypt the status.response. This is expected to be a RSA OAEP public key in X.509 PEM format.
 	PublicKey string `json:"publicKey"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+
+	// TTLSeconds is the number of seconds for which access via this request
+	// is valid, starting from when the ticket is acquired.
+	//
+	// If omitted, the request is valid for 120 seconds. The value may not
+	// exceed the maximum specified by the Namespace's
+	// vmoperator.vmware.com/webconsolerequest-max-ttl-seconds annotation.
+	//
+	// This field is immutable.
+	TTLSeconds *int64 `json:"ttlSeconds,omitempty"`
+
+	// +optional
+
+	// Revoked may be set to true to revoke access via this request before it
+	// expires. Connections to the web console using this request are denied
+	// once it is revoked. A request may also be revoked by deleting it.
+	//
+	// Once set to true, this field cannot be set back to false.
+	Revoked bool `json:"revoked,omitempty"`
 }
 
 // VirtualMachineWebConsoleRequestStatus describes the observed state of the
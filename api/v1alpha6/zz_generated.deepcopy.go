@@ -15,6 +15,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha6/sysprep"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -116,6 +117,26 @@ func (in *DynamicDirectPathIODevice) DeepCopy() *DynamicDirectPathIODevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAction) DeepCopyInto(out *ExecAction) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAction.
+func (in *ExecAction) DeepCopy() *ExecAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupMember) DeepCopyInto(out *GroupMember) {
 	*out = *in
@@ -161,6 +182,62 @@ func (in *GuestInfoAction) DeepCopy() *GuestInfoAction {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGetAction) DeepCopyInto(out *HTTPGetAction) {
+	*out = *in
+	out.Port = in.Port
+	if in.HTTPHeaders != nil {
+		in, out := &in.HTTPHeaders, &out.HTTPHeaders
+		*out = make([]HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpectedStatuses != nil {
+		in, out := &in.ExpectedStatuses, &out.ExpectedStatuses
+		*out = make([]HTTPStatusRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGetAction.
+func (in *HTTPGetAction) DeepCopy() *HTTPGetAction {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGetAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStatusRange) DeepCopyInto(out *HTTPStatusRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusRange.
+func (in *HTTPStatusRange) DeepCopy() *HTTPStatusRange {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStatusRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IDEControllerSpec) DeepCopyInto(out *IDEControllerSpec) {
 	*out = *in
@@ -847,6 +924,22 @@ func (in *VirtualMachineBootstrapCloudInitSpec) DeepCopy() *VirtualMachineBootst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBootstrapIgnitionSpec) DeepCopyInto(out *VirtualMachineBootstrapIgnitionSpec) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBootstrapIgnitionSpec.
+func (in *VirtualMachineBootstrapIgnitionSpec) DeepCopy() *VirtualMachineBootstrapIgnitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBootstrapIgnitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineBootstrapLinuxPrepSpec) DeepCopyInto(out *VirtualMachineBootstrapLinuxPrepSpec) {
 	*out = *in
@@ -905,6 +998,11 @@ func (in *VirtualMachineBootstrapSpec) DeepCopyInto(out *VirtualMachineBootstrap
 		*out = new(VirtualMachineBootstrapVAppConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(VirtualMachineBootstrapIgnitionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBootstrapSpec.
@@ -1298,7 +1396,7 @@ func (in *VirtualMachineCryptoStatus) DeepCopy() *VirtualMachineCryptoStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroup) DeepCopyInto(out *VirtualMachineGroup) {
+func (in *VirtualMachineDeployment) DeepCopyInto(out *VirtualMachineDeployment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1306,18 +1404,18 @@ func (in *VirtualMachineGroup) DeepCopyInto(out *VirtualMachineGroup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroup.
-func (in *VirtualMachineGroup) DeepCopy() *VirtualMachineGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeployment.
+func (in *VirtualMachineDeployment) DeepCopy() *VirtualMachineDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroup)
+	out := new(VirtualMachineDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineGroup) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineDeployment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1325,56 +1423,31 @@ func (in *VirtualMachineGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupBootOrderGroup) DeepCopyInto(out *VirtualMachineGroupBootOrderGroup) {
-	*out = *in
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]GroupMember, len(*in))
-		copy(*out, *in)
-	}
-	if in.PowerOnDelay != nil {
-		in, out := &in.PowerOnDelay, &out.PowerOnDelay
-		*out = new(v1.Duration)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupBootOrderGroup.
-func (in *VirtualMachineGroupBootOrderGroup) DeepCopy() *VirtualMachineGroupBootOrderGroup {
-	if in == nil {
-		return nil
-	}
-	out := new(VirtualMachineGroupBootOrderGroup)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupList) DeepCopyInto(out *VirtualMachineGroupList) {
+func (in *VirtualMachineDeploymentList) DeepCopyInto(out *VirtualMachineDeploymentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineGroup, len(*in))
+		*out = make([]VirtualMachineDeployment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupList.
-func (in *VirtualMachineGroupList) DeepCopy() *VirtualMachineGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentList.
+func (in *VirtualMachineDeploymentList) DeepCopy() *VirtualMachineDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupList)
+	out := new(VirtualMachineDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineGroupList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineDeploymentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1382,16 +1455,88 @@ func (in *VirtualMachineGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupMemberStatus) DeepCopyInto(out *VirtualMachineGroupMemberStatus) {
+func (in *VirtualMachineDeploymentRollback) DeepCopyInto(out *VirtualMachineDeploymentRollback) {
 	*out = *in
-	if in.Placement != nil {
-		in, out := &in.Placement, &out.Placement
-		*out = new(VirtualMachinePlacementStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentRollback.
+func (in *VirtualMachineDeploymentRollback) DeepCopy() *VirtualMachineDeploymentRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentRollingUpdate) DeepCopyInto(out *VirtualMachineDeploymentRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentRollingUpdate.
+func (in *VirtualMachineDeploymentRollingUpdate) DeepCopy() *VirtualMachineDeploymentRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentSpec) DeepCopyInto(out *VirtualMachineDeploymentSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PowerState != nil {
-		in, out := &in.PowerState, &out.PowerState
-		*out = new(VirtualMachinePowerState)
+	in.Template.DeepCopyInto(&out.Template)
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RollbackTo != nil {
+		in, out := &in.RollbackTo, &out.RollbackTo
+		*out = new(VirtualMachineDeploymentRollback)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentSpec.
+func (in *VirtualMachineDeploymentSpec) DeepCopy() *VirtualMachineDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDeploymentStatus) DeepCopyInto(out *VirtualMachineDeploymentStatus) {
+	*out = *in
+	if in.CollisionCount != nil {
+		in, out := &in.CollisionCount, &out.CollisionCount
+		*out = new(int32)
 		**out = **in
 	}
 	if in.Conditions != nil {
@@ -1403,43 +1548,38 @@ func (in *VirtualMachineGroupMemberStatus) DeepCopyInto(out *VirtualMachineGroup
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupMemberStatus.
-func (in *VirtualMachineGroupMemberStatus) DeepCopy() *VirtualMachineGroupMemberStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentStatus.
+func (in *VirtualMachineDeploymentStatus) DeepCopy() *VirtualMachineDeploymentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupMemberStatus)
+	out := new(VirtualMachineDeploymentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupPlacementDatastoreStatus) DeepCopyInto(out *VirtualMachineGroupPlacementDatastoreStatus) {
+func (in *VirtualMachineDeploymentStrategy) DeepCopyInto(out *VirtualMachineDeploymentStrategy) {
 	*out = *in
-	if in.SupportedDiskFormats != nil {
-		in, out := &in.SupportedDiskFormats, &out.SupportedDiskFormats
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.DiskKey != nil {
-		in, out := &in.DiskKey, &out.DiskKey
-		*out = new(int32)
-		**out = **in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachineDeploymentRollingUpdate)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPlacementDatastoreStatus.
-func (in *VirtualMachineGroupPlacementDatastoreStatus) DeepCopy() *VirtualMachineGroupPlacementDatastoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDeploymentStrategy.
+func (in *VirtualMachineDeploymentStrategy) DeepCopy() *VirtualMachineDeploymentStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupPlacementDatastoreStatus)
+	out := new(VirtualMachineDeploymentStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupPublishRequest) DeepCopyInto(out *VirtualMachineGroupPublishRequest) {
+func (in *VirtualMachineDisruptionBudget) DeepCopyInto(out *VirtualMachineDisruptionBudget) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1447,18 +1587,18 @@ func (in *VirtualMachineGroupPublishRequest) DeepCopyInto(out *VirtualMachineGro
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequest.
-func (in *VirtualMachineGroupPublishRequest) DeepCopy() *VirtualMachineGroupPublishRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDisruptionBudget.
+func (in *VirtualMachineDisruptionBudget) DeepCopy() *VirtualMachineDisruptionBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupPublishRequest)
+	out := new(VirtualMachineDisruptionBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineGroupPublishRequest) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineDisruptionBudget) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1466,53 +1606,31 @@ func (in *VirtualMachineGroupPublishRequest) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupPublishRequestImageStatus) DeepCopyInto(out *VirtualMachineGroupPublishRequestImageStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestImageStatus.
-func (in *VirtualMachineGroupPublishRequestImageStatus) DeepCopy() *VirtualMachineGroupPublishRequestImageStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(VirtualMachineGroupPublishRequestImageStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupPublishRequestList) DeepCopyInto(out *VirtualMachineGroupPublishRequestList) {
+func (in *VirtualMachineDisruptionBudgetList) DeepCopyInto(out *VirtualMachineDisruptionBudgetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineGroupPublishRequest, len(*in))
+		*out = make([]VirtualMachineDisruptionBudget, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestList.
-func (in *VirtualMachineGroupPublishRequestList) DeepCopy() *VirtualMachineGroupPublishRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDisruptionBudgetList.
+func (in *VirtualMachineDisruptionBudgetList) DeepCopy() *VirtualMachineDisruptionBudgetList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupPublishRequestList)
+	out := new(VirtualMachineDisruptionBudgetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineGroupPublishRequestList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineDisruptionBudgetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1520,42 +1638,38 @@ func (in *VirtualMachineGroupPublishRequestList) DeepCopyObject() runtime.Object
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupPublishRequestSpec) DeepCopyInto(out *VirtualMachineGroupPublishRequestSpec) {
+func (in *VirtualMachineDisruptionBudgetSpec) DeepCopyInto(out *VirtualMachineDisruptionBudgetSpec) {
 	*out = *in
-	if in.VirtualMachines != nil {
-		in, out := &in.VirtualMachines, &out.VirtualMachines
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.TTLSecondsAfterFinished != nil {
-		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
-		*out = new(int64)
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestSpec.
-func (in *VirtualMachineGroupPublishRequestSpec) DeepCopy() *VirtualMachineGroupPublishRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDisruptionBudgetSpec.
+func (in *VirtualMachineDisruptionBudgetSpec) DeepCopy() *VirtualMachineDisruptionBudgetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupPublishRequestSpec)
+	out := new(VirtualMachineDisruptionBudgetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupPublishRequestStatus) DeepCopyInto(out *VirtualMachineGroupPublishRequestStatus) {
+func (in *VirtualMachineDisruptionBudgetStatus) DeepCopyInto(out *VirtualMachineDisruptionBudgetStatus) {
 	*out = *in
-	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
-	in.StartTime.DeepCopyInto(&out.StartTime)
-	if in.Images != nil {
-		in, out := &in.Images, &out.Images
-		*out = make([]VirtualMachineGroupPublishRequestImageStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1565,209 +1679,159 @@ func (in *VirtualMachineGroupPublishRequestStatus) DeepCopyInto(out *VirtualMach
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestStatus.
-func (in *VirtualMachineGroupPublishRequestStatus) DeepCopy() *VirtualMachineGroupPublishRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDisruptionBudgetStatus.
+func (in *VirtualMachineDisruptionBudgetStatus) DeepCopy() *VirtualMachineDisruptionBudgetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupPublishRequestStatus)
+	out := new(VirtualMachineDisruptionBudgetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupSpec) DeepCopyInto(out *VirtualMachineGroupSpec) {
+func (in *VirtualMachineGroup) DeepCopyInto(out *VirtualMachineGroup) {
 	*out = *in
-	if in.BootOrder != nil {
-		in, out := &in.BootOrder, &out.BootOrder
-		*out = make([]VirtualMachineGroupBootOrderGroup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSpec.
-func (in *VirtualMachineGroupSpec) DeepCopy() *VirtualMachineGroupSpec {
-	if in == nil {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroup.
+func (in *VirtualMachineGroup) DeepCopy() *VirtualMachineGroup {
+	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGroupSpec)
+	out := new(VirtualMachineGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGroupStatus) DeepCopyInto(out *VirtualMachineGroupStatus) {
+func (in *VirtualMachineGroupBootOrderGroup) DeepCopyInto(out *VirtualMachineGroupBootOrderGroup) {
 	*out = *in
 	if in.Members != nil {
 		in, out := &in.Members, &out.Members
-		*out = make([]VirtualMachineGroupMemberStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.LastUpdatedPowerStateTime != nil {
-		in, out := &in.LastUpdatedPowerStateTime, &out.LastUpdatedPowerStateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+		*out = make([]GroupMember, len(*in))
+		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupStatus.
-func (in *VirtualMachineGroupStatus) DeepCopy() *VirtualMachineGroupStatus {
-	if in == nil {
-		return nil
+	if in.PowerOnDelay != nil {
+		in, out := &in.PowerOnDelay, &out.PowerOnDelay
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	out := new(VirtualMachineGroupStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineGuestStatus) DeepCopyInto(out *VirtualMachineGuestStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestStatus.
-func (in *VirtualMachineGuestStatus) DeepCopy() *VirtualMachineGuestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupBootOrderGroup.
+func (in *VirtualMachineGroupBootOrderGroup) DeepCopy() *VirtualMachineGroupBootOrderGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineGuestStatus)
+	out := new(VirtualMachineGroupBootOrderGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineHardwareSpec) DeepCopyInto(out *VirtualMachineHardwareSpec) {
+func (in *VirtualMachineGroupList) DeepCopyInto(out *VirtualMachineGroupList) {
 	*out = *in
-	if in.Cdrom != nil {
-		in, out := &in.Cdrom, &out.Cdrom
-		*out = make([]VirtualMachineCdromSpec, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.IDEControllers != nil {
-		in, out := &in.IDEControllers, &out.IDEControllers
-		*out = make([]IDEControllerSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.NVMEControllers != nil {
-		in, out := &in.NVMEControllers, &out.NVMEControllers
-		*out = make([]NVMEControllerSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.SATAControllers != nil {
-		in, out := &in.SATAControllers, &out.SATAControllers
-		*out = make([]SATAControllerSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.SCSIControllers != nil {
-		in, out := &in.SCSIControllers, &out.SCSIControllers
-		*out = make([]SCSIControllerSpec, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareSpec.
-func (in *VirtualMachineHardwareSpec) DeepCopy() *VirtualMachineHardwareSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupList.
+func (in *VirtualMachineGroupList) DeepCopy() *VirtualMachineGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineHardwareSpec)
+	out := new(VirtualMachineGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineHardwareStatus) DeepCopyInto(out *VirtualMachineHardwareStatus) {
+func (in *VirtualMachineGroupMemberStatus) DeepCopyInto(out *VirtualMachineGroupMemberStatus) {
 	*out = *in
-	if in.Controllers != nil {
-		in, out := &in.Controllers, &out.Controllers
-		*out = make([]VirtualControllerStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(VirtualMachinePlacementStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.CPU != nil {
-		in, out := &in.CPU, &out.CPU
-		*out = new(VirtualMachineCPUAllocationStatus)
+	if in.PowerState != nil {
+		in, out := &in.PowerState, &out.PowerState
+		*out = new(VirtualMachinePowerState)
 		**out = **in
 	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		*out = new(VirtualMachineMemoryAllocationStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.VGPUs != nil {
-		in, out := &in.VGPUs, &out.VGPUs
-		*out = make([]VirtualMachineHardwareVGPUStatus, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareStatus.
-func (in *VirtualMachineHardwareStatus) DeepCopy() *VirtualMachineHardwareStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupMemberStatus.
+func (in *VirtualMachineGroupMemberStatus) DeepCopy() *VirtualMachineGroupMemberStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineHardwareStatus)
+	out := new(VirtualMachineGroupMemberStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineHardwareVGPUStatus) DeepCopyInto(out *VirtualMachineHardwareVGPUStatus) {
+func (in *VirtualMachineGroupPlacementDatastoreStatus) DeepCopyInto(out *VirtualMachineGroupPlacementDatastoreStatus) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareVGPUStatus.
-func (in *VirtualMachineHardwareVGPUStatus) DeepCopy() *VirtualMachineHardwareVGPUStatus {
-	if in == nil {
-		return nil
+	if in.SupportedDiskFormats != nil {
+		in, out := &in.SupportedDiskFormats, &out.SupportedDiskFormats
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskKey != nil {
+		in, out := &in.DiskKey, &out.DiskKey
+		*out = new(int32)
+		**out = **in
 	}
-	out := new(VirtualMachineHardwareVGPUStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImage) DeepCopyInto(out *VirtualMachineImage) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImage.
-func (in *VirtualMachineImage) DeepCopy() *VirtualMachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPlacementDatastoreStatus.
+func (in *VirtualMachineGroupPlacementDatastoreStatus) DeepCopy() *VirtualMachineGroupPlacementDatastoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImage)
+	out := new(VirtualMachineGroupPlacementDatastoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineImage) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCache) DeepCopyInto(out *VirtualMachineImageCache) {
+func (in *VirtualMachineGroupPublishRequest) DeepCopyInto(out *VirtualMachineGroupPublishRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1775,18 +1839,18 @@ func (in *VirtualMachineImageCache) DeepCopyInto(out *VirtualMachineImageCache)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCache.
-func (in *VirtualMachineImageCache) DeepCopy() *VirtualMachineImageCache {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequest.
+func (in *VirtualMachineGroupPublishRequest) DeepCopy() *VirtualMachineGroupPublishRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCache)
+	out := new(VirtualMachineGroupPublishRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineImageCache) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineGroupPublishRequest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1794,46 +1858,53 @@ func (in *VirtualMachineImageCache) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheFileStatus) DeepCopyInto(out *VirtualMachineImageCacheFileStatus) {
+func (in *VirtualMachineGroupPublishRequestImageStatus) DeepCopyInto(out *VirtualMachineGroupPublishRequestImageStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheFileStatus.
-func (in *VirtualMachineImageCacheFileStatus) DeepCopy() *VirtualMachineImageCacheFileStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestImageStatus.
+func (in *VirtualMachineGroupPublishRequestImageStatus) DeepCopy() *VirtualMachineGroupPublishRequestImageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheFileStatus)
+	out := new(VirtualMachineGroupPublishRequestImageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheList) DeepCopyInto(out *VirtualMachineImageCacheList) {
+func (in *VirtualMachineGroupPublishRequestList) DeepCopyInto(out *VirtualMachineGroupPublishRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineImageCache, len(*in))
+		*out = make([]VirtualMachineGroupPublishRequest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheList.
-func (in *VirtualMachineImageCacheList) DeepCopy() *VirtualMachineImageCacheList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestList.
+func (in *VirtualMachineGroupPublishRequestList) DeepCopy() *VirtualMachineGroupPublishRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheList)
+	out := new(VirtualMachineGroupPublishRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineImageCacheList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineGroupPublishRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1841,27 +1912,41 @@ func (in *VirtualMachineImageCacheList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheLocationSpec) DeepCopyInto(out *VirtualMachineImageCacheLocationSpec) {
+func (in *VirtualMachineGroupPublishRequestSpec) DeepCopyInto(out *VirtualMachineGroupPublishRequestSpec) {
 	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheLocationSpec.
-func (in *VirtualMachineImageCacheLocationSpec) DeepCopy() *VirtualMachineImageCacheLocationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestSpec.
+func (in *VirtualMachineGroupPublishRequestSpec) DeepCopy() *VirtualMachineGroupPublishRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheLocationSpec)
+	out := new(VirtualMachineGroupPublishRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheLocationStatus) DeepCopyInto(out *VirtualMachineImageCacheLocationStatus) {
+func (in *VirtualMachineGroupPublishRequestStatus) DeepCopyInto(out *VirtualMachineGroupPublishRequestStatus) {
 	*out = *in
-	if in.Files != nil {
-		in, out := &in.Files, &out.Files
-		*out = make([]VirtualMachineImageCacheFileStatus, len(*in))
-		copy(*out, *in)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]VirtualMachineGroupPublishRequestImageStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1872,146 +1957,69 @@ func (in *VirtualMachineImageCacheLocationStatus) DeepCopyInto(out *VirtualMachi
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheLocationStatus.
-func (in *VirtualMachineImageCacheLocationStatus) DeepCopy() *VirtualMachineImageCacheLocationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupPublishRequestStatus.
+func (in *VirtualMachineGroupPublishRequestStatus) DeepCopy() *VirtualMachineGroupPublishRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheLocationStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheOVFStatus) DeepCopyInto(out *VirtualMachineImageCacheOVFStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheOVFStatus.
-func (in *VirtualMachineImageCacheOVFStatus) DeepCopy() *VirtualMachineImageCacheOVFStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(VirtualMachineImageCacheOVFStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheSpec) DeepCopyInto(out *VirtualMachineImageCacheSpec) {
-	*out = *in
-	if in.Locations != nil {
-		in, out := &in.Locations, &out.Locations
-		*out = make([]VirtualMachineImageCacheLocationSpec, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheSpec.
-func (in *VirtualMachineImageCacheSpec) DeepCopy() *VirtualMachineImageCacheSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(VirtualMachineImageCacheSpec)
+	out := new(VirtualMachineGroupPublishRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageCacheStatus) DeepCopyInto(out *VirtualMachineImageCacheStatus) {
+func (in *VirtualMachineGroupSnapshot) DeepCopyInto(out *VirtualMachineGroupSnapshot) {
 	*out = *in
-	if in.Locations != nil {
-		in, out := &in.Locations, &out.Locations
-		*out = make([]VirtualMachineImageCacheLocationStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.OVF != nil {
-		in, out := &in.OVF, &out.OVF
-		*out = new(VirtualMachineImageCacheOVFStatus)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheStatus.
-func (in *VirtualMachineImageCacheStatus) DeepCopy() *VirtualMachineImageCacheStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSnapshot.
+func (in *VirtualMachineGroupSnapshot) DeepCopy() *VirtualMachineGroupSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageCacheStatus)
+	out := new(VirtualMachineGroupSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageDiskInfo) DeepCopyInto(out *VirtualMachineImageDiskInfo) {
-	*out = *in
-	if in.Limit != nil {
-		in, out := &in.Limit, &out.Limit
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.Requested != nil {
-		in, out := &in.Requested, &out.Requested
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.ControllerBusNumber != nil {
-		in, out := &in.ControllerBusNumber, &out.ControllerBusNumber
-		*out = new(int32)
-		**out = **in
-	}
-	if in.UnitNumber != nil {
-		in, out := &in.UnitNumber, &out.UnitNumber
-		*out = new(int32)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageDiskInfo.
-func (in *VirtualMachineImageDiskInfo) DeepCopy() *VirtualMachineImageDiskInfo {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineGroupSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(VirtualMachineImageDiskInfo)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageList) DeepCopyInto(out *VirtualMachineImageList) {
+func (in *VirtualMachineGroupSnapshotList) DeepCopyInto(out *VirtualMachineGroupSnapshotList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineImage, len(*in))
+		*out = make([]VirtualMachineGroupSnapshot, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageList.
-func (in *VirtualMachineImageList) DeepCopy() *VirtualMachineImageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSnapshotList.
+func (in *VirtualMachineGroupSnapshotList) DeepCopy() *VirtualMachineGroupSnapshotList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageList)
+	out := new(VirtualMachineGroupSnapshotList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineImageList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineGroupSnapshotList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2019,103 +2027,115 @@ func (in *VirtualMachineImageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageOSInfo) DeepCopyInto(out *VirtualMachineImageOSInfo) {
+func (in *VirtualMachineGroupSnapshotMemberStatus) DeepCopyInto(out *VirtualMachineGroupSnapshotMemberStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageOSInfo.
-func (in *VirtualMachineImageOSInfo) DeepCopy() *VirtualMachineImageOSInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSnapshotMemberStatus.
+func (in *VirtualMachineGroupSnapshotMemberStatus) DeepCopy() *VirtualMachineGroupSnapshotMemberStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageOSInfo)
+	out := new(VirtualMachineGroupSnapshotMemberStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageProductInfo) DeepCopyInto(out *VirtualMachineImageProductInfo) {
+func (in *VirtualMachineGroupSnapshotSpec) DeepCopyInto(out *VirtualMachineGroupSnapshotSpec) {
 	*out = *in
+	if in.Quiesce != nil {
+		in, out := &in.Quiesce, &out.Quiesce
+		*out = new(QuiesceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageProductInfo.
-func (in *VirtualMachineImageProductInfo) DeepCopy() *VirtualMachineImageProductInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSnapshotSpec.
+func (in *VirtualMachineGroupSnapshotSpec) DeepCopy() *VirtualMachineGroupSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageProductInfo)
+	out := new(VirtualMachineGroupSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageRef) DeepCopyInto(out *VirtualMachineImageRef) {
+func (in *VirtualMachineGroupSnapshotStatus) DeepCopyInto(out *VirtualMachineGroupSnapshotStatus) {
 	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]VirtualMachineGroupSnapshotMemberStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageRef.
-func (in *VirtualMachineImageRef) DeepCopy() *VirtualMachineImageRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSnapshotStatus.
+func (in *VirtualMachineGroupSnapshotStatus) DeepCopy() *VirtualMachineGroupSnapshotStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageRef)
+	out := new(VirtualMachineGroupSnapshotStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageSpec) DeepCopyInto(out *VirtualMachineImageSpec) {
+func (in *VirtualMachineGroupSpec) DeepCopyInto(out *VirtualMachineGroupSpec) {
 	*out = *in
-	if in.ProviderRef != nil {
-		in, out := &in.ProviderRef, &out.ProviderRef
-		*out = new(common.LocalObjectRef)
-		**out = **in
+	if in.BootOrder != nil {
+		in, out := &in.BootOrder, &out.BootOrder
+		*out = make([]VirtualMachineGroupBootOrderGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageSpec.
-func (in *VirtualMachineImageSpec) DeepCopy() *VirtualMachineImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupSpec.
+func (in *VirtualMachineGroupSpec) DeepCopy() *VirtualMachineGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageSpec)
+	out := new(VirtualMachineGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus) {
+func (in *VirtualMachineGroupStatus) DeepCopyInto(out *VirtualMachineGroupStatus) {
 	*out = *in
-	if in.Capabilities != nil {
-		in, out := &in.Capabilities, &out.Capabilities
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.HardwareVersion != nil {
-		in, out := &in.HardwareVersion, &out.HardwareVersion
-		*out = new(int32)
-		**out = **in
-	}
-	out.OSInfo = in.OSInfo
-	if in.OVFProperties != nil {
-		in, out := &in.OVFProperties, &out.OVFProperties
-		*out = make([]OVFProperty, len(*in))
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]VirtualMachineGroupMemberStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.VMwareSystemProperties != nil {
-		in, out := &in.VMwareSystemProperties, &out.VMwareSystemProperties
-		*out = make([]common.KeyValuePair, len(*in))
-		copy(*out, *in)
-	}
-	out.ProductInfo = in.ProductInfo
-	if in.Disks != nil {
-		in, out := &in.Disks, &out.Disks
-		*out = make([]VirtualMachineImageDiskInfo, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastUpdatedPowerStateTime != nil {
+		in, out := &in.LastUpdatedPowerStateTime, &out.LastUpdatedPowerStateTime
+		*out = (*in).DeepCopy()
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2126,42 +2146,69 @@ func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageStatus.
-func (in *VirtualMachineImageStatus) DeepCopy() *VirtualMachineImageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGroupStatus.
+func (in *VirtualMachineGroupStatus) DeepCopy() *VirtualMachineGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineImageStatus)
+	out := new(VirtualMachineGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
+func (in *VirtualMachineGuestFileTransfer) DeepCopyInto(out *VirtualMachineGuestFileTransfer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestFileTransfer.
+func (in *VirtualMachineGuestFileTransfer) DeepCopy() *VirtualMachineGuestFileTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineGuestFileTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineGuestFileTransfer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineGuestFileTransferList) DeepCopyInto(out *VirtualMachineGuestFileTransferList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachine, len(*in))
+		*out = make([]VirtualMachineGuestFileTransfer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineList.
-func (in *VirtualMachineList) DeepCopy() *VirtualMachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestFileTransferList.
+func (in *VirtualMachineGuestFileTransferList) DeepCopy() *VirtualMachineGuestFileTransferList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineList)
+	out := new(VirtualMachineGuestFileTransferList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineGuestFileTransferList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2169,647 +2216,2561 @@ func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineMemoryAllocationStatus) DeepCopyInto(out *VirtualMachineMemoryAllocationStatus) {
+func (in *VirtualMachineGuestFileTransferObject) DeepCopyInto(out *VirtualMachineGuestFileTransferObject) {
 	*out = *in
-	if in.Total != nil {
-		in, out := &in.Total, &out.Total
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.Reservation != nil {
-		in, out := &in.Reservation, &out.Reservation
-		x := (*in).DeepCopy()
-		*out = &x
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMemoryAllocationStatus.
-func (in *VirtualMachineMemoryAllocationStatus) DeepCopy() *VirtualMachineMemoryAllocationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestFileTransferObject.
+func (in *VirtualMachineGuestFileTransferObject) DeepCopy() *VirtualMachineGuestFileTransferObject {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineMemoryAllocationStatus)
+	out := new(VirtualMachineGuestFileTransferObject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkConfigDHCPOptionsStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDHCPOptionsStatus) {
+func (in *VirtualMachineGuestFileTransferSpec) DeepCopyInto(out *VirtualMachineGuestFileTransferSpec) {
 	*out = *in
+	out.Object = in.Object
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigDHCPOptionsStatus.
-func (in *VirtualMachineNetworkConfigDHCPOptionsStatus) DeepCopy() *VirtualMachineNetworkConfigDHCPOptionsStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestFileTransferSpec.
+func (in *VirtualMachineGuestFileTransferSpec) DeepCopy() *VirtualMachineGuestFileTransferSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkConfigDHCPOptionsStatus)
+	out := new(VirtualMachineGuestFileTransferSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkConfigDHCPStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDHCPStatus) {
+func (in *VirtualMachineGuestFileTransferStatus) DeepCopyInto(out *VirtualMachineGuestFileTransferStatus) {
 	*out = *in
-	if in.IP4 != nil {
-		in, out := &in.IP4, &out.IP4
-		*out = new(VirtualMachineNetworkConfigDHCPOptionsStatus)
-		**out = **in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.IP6 != nil {
-		in, out := &in.IP6, &out.IP6
-		*out = new(VirtualMachineNetworkConfigDHCPOptionsStatus)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigDHCPStatus.
-func (in *VirtualMachineNetworkConfigDHCPStatus) DeepCopy() *VirtualMachineNetworkConfigDHCPStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestFileTransferStatus.
+func (in *VirtualMachineGuestFileTransferStatus) DeepCopy() *VirtualMachineGuestFileTransferStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkConfigDHCPStatus)
+	out := new(VirtualMachineGuestFileTransferStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkConfigDNSStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDNSStatus) {
+func (in *VirtualMachineGuestStatus) DeepCopyInto(out *VirtualMachineGuestStatus) {
 	*out = *in
-	if in.Nameservers != nil {
-		in, out := &in.Nameservers, &out.Nameservers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SearchDomains != nil {
-		in, out := &in.SearchDomains, &out.SearchDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigDNSStatus.
-func (in *VirtualMachineNetworkConfigDNSStatus) DeepCopy() *VirtualMachineNetworkConfigDNSStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineGuestStatus.
+func (in *VirtualMachineGuestStatus) DeepCopy() *VirtualMachineGuestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkConfigDNSStatus)
+	out := new(VirtualMachineGuestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkConfigInterfaceIPStatus) DeepCopyInto(out *VirtualMachineNetworkConfigInterfaceIPStatus) {
+func (in *VirtualMachineHardwareSpec) DeepCopyInto(out *VirtualMachineHardwareSpec) {
 	*out = *in
-	if in.DHCP != nil {
-		in, out := &in.DHCP, &out.DHCP
-		*out = new(VirtualMachineNetworkConfigDHCPStatus)
-		(*in).DeepCopyInto(*out)
+	if in.Cdrom != nil {
+		in, out := &in.Cdrom, &out.Cdrom
+		*out = make([]VirtualMachineCdromSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]string, len(*in))
+	if in.IDEControllers != nil {
+		in, out := &in.IDEControllers, &out.IDEControllers
+		*out = make([]IDEControllerSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.NVMEControllers != nil {
+		in, out := &in.NVMEControllers, &out.NVMEControllers
+		*out = make([]NVMEControllerSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.SATAControllers != nil {
+		in, out := &in.SATAControllers, &out.SATAControllers
+		*out = make([]SATAControllerSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.SCSIControllers != nil {
+		in, out := &in.SCSIControllers, &out.SCSIControllers
+		*out = make([]SCSIControllerSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.SerialConsole != nil {
+		in, out := &in.SerialConsole, &out.SerialConsole
+		*out = new(VirtualMachineSerialConsoleSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigInterfaceIPStatus.
-func (in *VirtualMachineNetworkConfigInterfaceIPStatus) DeepCopy() *VirtualMachineNetworkConfigInterfaceIPStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareSpec.
+func (in *VirtualMachineHardwareSpec) DeepCopy() *VirtualMachineHardwareSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkConfigInterfaceIPStatus)
+	out := new(VirtualMachineHardwareSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkConfigInterfaceStatus) DeepCopyInto(out *VirtualMachineNetworkConfigInterfaceStatus) {
+func (in *VirtualMachineHardwareStatus) DeepCopyInto(out *VirtualMachineHardwareStatus) {
 	*out = *in
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
-		*out = new(VirtualMachineNetworkConfigInterfaceIPStatus)
-		(*in).DeepCopyInto(*out)
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]VirtualControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.DNS != nil {
-		in, out := &in.DNS, &out.DNS
-		*out = new(VirtualMachineNetworkConfigDNSStatus)
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(VirtualMachineCPUAllocationStatus)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(VirtualMachineMemoryAllocationStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VGPUs != nil {
+		in, out := &in.VGPUs, &out.VGPUs
+		*out = make([]VirtualMachineHardwareVGPUStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigInterfaceStatus.
-func (in *VirtualMachineNetworkConfigInterfaceStatus) DeepCopy() *VirtualMachineNetworkConfigInterfaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareStatus.
+func (in *VirtualMachineHardwareStatus) DeepCopy() *VirtualMachineHardwareStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkConfigInterfaceStatus)
+	out := new(VirtualMachineHardwareStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkConfigStatus) DeepCopyInto(out *VirtualMachineNetworkConfigStatus) {
+func (in *VirtualMachineHardwareVGPUStatus) DeepCopyInto(out *VirtualMachineHardwareVGPUStatus) {
 	*out = *in
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = make([]VirtualMachineNetworkConfigInterfaceStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.DNS != nil {
-		in, out := &in.DNS, &out.DNS
-		*out = new(VirtualMachineNetworkConfigDNSStatus)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigStatus.
-func (in *VirtualMachineNetworkConfigStatus) DeepCopy() *VirtualMachineNetworkConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareVGPUStatus.
+func (in *VirtualMachineHardwareVGPUStatus) DeepCopy() *VirtualMachineHardwareVGPUStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkConfigStatus)
+	out := new(VirtualMachineHardwareVGPUStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkDHCPOptionsStatus) DeepCopyInto(out *VirtualMachineNetworkDHCPOptionsStatus) {
+func (in *VirtualMachineIPAddressClaim) DeepCopyInto(out *VirtualMachineIPAddressClaim) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make([]common.KeyValuePair, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkDHCPOptionsStatus.
-func (in *VirtualMachineNetworkDHCPOptionsStatus) DeepCopy() *VirtualMachineNetworkDHCPOptionsStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPAddressClaim.
+func (in *VirtualMachineIPAddressClaim) DeepCopy() *VirtualMachineIPAddressClaim {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkDHCPOptionsStatus)
+	out := new(VirtualMachineIPAddressClaim)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineIPAddressClaim) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkDHCPStatus) DeepCopyInto(out *VirtualMachineNetworkDHCPStatus) {
+func (in *VirtualMachineIPAddressClaimList) DeepCopyInto(out *VirtualMachineIPAddressClaimList) {
 	*out = *in
-	in.IP4.DeepCopyInto(&out.IP4)
-	in.IP6.DeepCopyInto(&out.IP6)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineIPAddressClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkDHCPStatus.
-func (in *VirtualMachineNetworkDHCPStatus) DeepCopy() *VirtualMachineNetworkDHCPStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPAddressClaimList.
+func (in *VirtualMachineIPAddressClaimList) DeepCopy() *VirtualMachineIPAddressClaimList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkDHCPStatus)
+	out := new(VirtualMachineIPAddressClaimList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineIPAddressClaimList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkDNSStatus) DeepCopyInto(out *VirtualMachineNetworkDNSStatus) {
+func (in *VirtualMachineIPAddressClaimSpec) DeepCopyInto(out *VirtualMachineIPAddressClaimSpec) {
 	*out = *in
-	if in.Nameservers != nil {
-		in, out := &in.Nameservers, &out.Nameservers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SearchDomains != nil {
-		in, out := &in.SearchDomains, &out.SearchDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkDNSStatus.
-func (in *VirtualMachineNetworkDNSStatus) DeepCopy() *VirtualMachineNetworkDNSStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPAddressClaimSpec.
+func (in *VirtualMachineIPAddressClaimSpec) DeepCopy() *VirtualMachineIPAddressClaimSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkDNSStatus)
+	out := new(VirtualMachineIPAddressClaimSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkIPRouteGatewayStatus) DeepCopyInto(out *VirtualMachineNetworkIPRouteGatewayStatus) {
+func (in *VirtualMachineIPPool) DeepCopyInto(out *VirtualMachineIPPool) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkIPRouteGatewayStatus.
-func (in *VirtualMachineNetworkIPRouteGatewayStatus) DeepCopy() *VirtualMachineNetworkIPRouteGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPool.
+func (in *VirtualMachineIPPool) DeepCopy() *VirtualMachineIPPool {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkIPRouteGatewayStatus)
+	out := new(VirtualMachineIPPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkIPRouteStatus) DeepCopyInto(out *VirtualMachineNetworkIPRouteStatus) {
+func (in *VirtualMachineIPPoolList) DeepCopyInto(out *VirtualMachineIPPoolList) {
 	*out = *in
-	out.Gateway = in.Gateway
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkIPRouteStatus.
-func (in *VirtualMachineNetworkIPRouteStatus) DeepCopy() *VirtualMachineNetworkIPRouteStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolList.
+func (in *VirtualMachineIPPoolList) DeepCopy() *VirtualMachineIPPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkIPRouteStatus)
+	out := new(VirtualMachineIPPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkIPStackStatus) DeepCopyInto(out *VirtualMachineNetworkIPStackStatus) {
+func (in *VirtualMachineIPPoolSpec) DeepCopyInto(out *VirtualMachineIPPoolSpec) {
 	*out = *in
-	if in.DHCP != nil {
-		in, out := &in.DHCP, &out.DHCP
-		*out = new(VirtualMachineNetworkDHCPStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DNS != nil {
-		in, out := &in.DNS, &out.DNS
-		*out = new(VirtualMachineNetworkDNSStatus)
-		(*in).DeepCopyInto(*out)
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.IPRoutes != nil {
-		in, out := &in.IPRoutes, &out.IPRoutes
-		*out = make([]VirtualMachineNetworkIPRouteStatus, len(*in))
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.KernelConfig != nil {
-		in, out := &in.KernelConfig, &out.KernelConfig
-		*out = make([]common.KeyValuePair, len(*in))
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkIPStackStatus.
-func (in *VirtualMachineNetworkIPStackStatus) DeepCopy() *VirtualMachineNetworkIPStackStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolSpec.
+func (in *VirtualMachineIPPoolSpec) DeepCopy() *VirtualMachineIPPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkIPStackStatus)
+	out := new(VirtualMachineIPPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkInterfaceIPAddrStatus) DeepCopyInto(out *VirtualMachineNetworkInterfaceIPAddrStatus) {
+func (in *VirtualMachineImage) DeepCopyInto(out *VirtualMachineImage) {
 	*out = *in
-	in.Lifetime.DeepCopyInto(&out.Lifetime)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceIPAddrStatus.
-func (in *VirtualMachineNetworkInterfaceIPAddrStatus) DeepCopy() *VirtualMachineNetworkInterfaceIPAddrStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImage.
+func (in *VirtualMachineImage) DeepCopy() *VirtualMachineImage {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkInterfaceIPAddrStatus)
+	out := new(VirtualMachineImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkInterfaceIPStatus) DeepCopyInto(out *VirtualMachineNetworkInterfaceIPStatus) {
-	*out = *in
-	if in.AutoConfigurationEnabled != nil {
-		in, out := &in.AutoConfigurationEnabled, &out.AutoConfigurationEnabled
-		*out = new(bool)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCache) DeepCopyInto(out *VirtualMachineImageCache) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCache.
+func (in *VirtualMachineImageCache) DeepCopy() *VirtualMachineImageCache {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImageCache) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheFileStatus) DeepCopyInto(out *VirtualMachineImageCacheFileStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheFileStatus.
+func (in *VirtualMachineImageCacheFileStatus) DeepCopy() *VirtualMachineImageCacheFileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheFileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheList) DeepCopyInto(out *VirtualMachineImageCacheList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineImageCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheList.
+func (in *VirtualMachineImageCacheList) DeepCopy() *VirtualMachineImageCacheList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImageCacheList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheLocationSpec) DeepCopyInto(out *VirtualMachineImageCacheLocationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheLocationSpec.
+func (in *VirtualMachineImageCacheLocationSpec) DeepCopy() *VirtualMachineImageCacheLocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheLocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheLocationStatus) DeepCopyInto(out *VirtualMachineImageCacheLocationStatus) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]VirtualMachineImageCacheFileStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheLocationStatus.
+func (in *VirtualMachineImageCacheLocationStatus) DeepCopy() *VirtualMachineImageCacheLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheOVFStatus) DeepCopyInto(out *VirtualMachineImageCacheOVFStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheOVFStatus.
+func (in *VirtualMachineImageCacheOVFStatus) DeepCopy() *VirtualMachineImageCacheOVFStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheOVFStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheSpec) DeepCopyInto(out *VirtualMachineImageCacheSpec) {
+	*out = *in
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]VirtualMachineImageCacheLocationSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheSpec.
+func (in *VirtualMachineImageCacheSpec) DeepCopy() *VirtualMachineImageCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageCacheStatus) DeepCopyInto(out *VirtualMachineImageCacheStatus) {
+	*out = *in
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]VirtualMachineImageCacheLocationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OVF != nil {
+		in, out := &in.OVF, &out.OVF
+		*out = new(VirtualMachineImageCacheOVFStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageCacheStatus.
+func (in *VirtualMachineImageCacheStatus) DeepCopy() *VirtualMachineImageCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageDiskInfo) DeepCopyInto(out *VirtualMachineImageDiskInfo) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ControllerBusNumber != nil {
+		in, out := &in.ControllerBusNumber, &out.ControllerBusNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnitNumber != nil {
+		in, out := &in.UnitNumber, &out.UnitNumber
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageDiskInfo.
+func (in *VirtualMachineImageDiskInfo) DeepCopy() *VirtualMachineImageDiskInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageDiskInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageList) DeepCopyInto(out *VirtualMachineImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageList.
+func (in *VirtualMachineImageList) DeepCopy() *VirtualMachineImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageOCISource) DeepCopyInto(out *VirtualMachineImageOCISource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageOCISource.
+func (in *VirtualMachineImageOCISource) DeepCopy() *VirtualMachineImageOCISource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageOCISource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageOSInfo) DeepCopyInto(out *VirtualMachineImageOSInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageOSInfo.
+func (in *VirtualMachineImageOSInfo) DeepCopy() *VirtualMachineImageOSInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageOSInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageProductInfo) DeepCopyInto(out *VirtualMachineImageProductInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageProductInfo.
+func (in *VirtualMachineImageProductInfo) DeepCopy() *VirtualMachineImageProductInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageProductInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageRef) DeepCopyInto(out *VirtualMachineImageRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageRef.
+func (in *VirtualMachineImageRef) DeepCopy() *VirtualMachineImageRef {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageSpec) DeepCopyInto(out *VirtualMachineImageSpec) {
+	*out = *in
+	if in.ProviderRef != nil {
+		in, out := &in.ProviderRef, &out.ProviderRef
+		*out = new(common.LocalObjectRef)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(VirtualMachineImageOCISource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageSpec.
+func (in *VirtualMachineImageSpec) DeepCopy() *VirtualMachineImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineImageStatus) DeepCopyInto(out *VirtualMachineImageStatus) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HardwareVersion != nil {
+		in, out := &in.HardwareVersion, &out.HardwareVersion
+		*out = new(int32)
+		**out = **in
+	}
+	out.OSInfo = in.OSInfo
+	if in.OVFProperties != nil {
+		in, out := &in.OVFProperties, &out.OVFProperties
+		*out = make([]OVFProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VMwareSystemProperties != nil {
+		in, out := &in.VMwareSystemProperties, &out.VMwareSystemProperties
+		*out = make([]common.KeyValuePair, len(*in))
+		copy(*out, *in)
+	}
+	out.ProductInfo = in.ProductInfo
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineImageDiskInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineImageStatus.
+func (in *VirtualMachineImageStatus) DeepCopy() *VirtualMachineImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineList.
+func (in *VirtualMachineList) DeepCopy() *VirtualMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineLivenessProbeSpec) DeepCopyInto(out *VirtualMachineLivenessProbeSpec) {
+	*out = *in
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(TCPSocketAction)
+		**out = **in
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestHeartbeat != nil {
+		in, out := &in.GuestHeartbeat, &out.GuestHeartbeat
+		*out = new(GuestHeartbeatAction)
+		**out = **in
+	}
+	if in.GuestInfo != nil {
+		in, out := &in.GuestInfo, &out.GuestInfo
+		*out = make([]GuestInfoAction, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineLivenessProbeSpec.
+func (in *VirtualMachineLivenessProbeSpec) DeepCopy() *VirtualMachineLivenessProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineLivenessProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineLivenessProbeStatus) DeepCopyInto(out *VirtualMachineLivenessProbeStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineLivenessProbeStatus.
+func (in *VirtualMachineLivenessProbeStatus) DeepCopy() *VirtualMachineLivenessProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineLivenessProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMemoryAllocationStatus) DeepCopyInto(out *VirtualMachineMemoryAllocationStatus) {
+	*out = *in
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Reservation != nil {
+		in, out := &in.Reservation, &out.Reservation
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMemoryAllocationStatus.
+func (in *VirtualMachineMemoryAllocationStatus) DeepCopy() *VirtualMachineMemoryAllocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMemoryAllocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkBondParameters) DeepCopyInto(out *VirtualMachineNetworkBondParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkBondParameters.
+func (in *VirtualMachineNetworkBondParameters) DeepCopy() *VirtualMachineNetworkBondParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkBondParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkBondSpec) DeepCopyInto(out *VirtualMachineNetworkBondSpec) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(VirtualMachineNetworkBondParameters)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkBondSpec.
+func (in *VirtualMachineNetworkBondSpec) DeepCopy() *VirtualMachineNetworkBondSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkBondSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkBridgeSpec) DeepCopyInto(out *VirtualMachineNetworkBridgeSpec) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.STP != nil {
+		in, out := &in.STP, &out.STP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkBridgeSpec.
+func (in *VirtualMachineNetworkBridgeSpec) DeepCopy() *VirtualMachineNetworkBridgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkBridgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigAggregateStatus) DeepCopyInto(out *VirtualMachineNetworkConfigAggregateStatus) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(VirtualMachineNetworkConfigInterfaceIPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(VirtualMachineNetworkConfigDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigAggregateStatus.
+func (in *VirtualMachineNetworkConfigAggregateStatus) DeepCopy() *VirtualMachineNetworkConfigAggregateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigAggregateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigDHCPOptionsStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDHCPOptionsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigDHCPOptionsStatus.
+func (in *VirtualMachineNetworkConfigDHCPOptionsStatus) DeepCopy() *VirtualMachineNetworkConfigDHCPOptionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigDHCPOptionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigDHCPStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDHCPStatus) {
+	*out = *in
+	if in.IP4 != nil {
+		in, out := &in.IP4, &out.IP4
+		*out = new(VirtualMachineNetworkConfigDHCPOptionsStatus)
+		**out = **in
+	}
+	if in.IP6 != nil {
+		in, out := &in.IP6, &out.IP6
+		*out = new(VirtualMachineNetworkConfigDHCPOptionsStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigDHCPStatus.
+func (in *VirtualMachineNetworkConfigDHCPStatus) DeepCopy() *VirtualMachineNetworkConfigDHCPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigDHCPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigDNSStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDNSStatus) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigDNSStatus.
+func (in *VirtualMachineNetworkConfigDNSStatus) DeepCopy() *VirtualMachineNetworkConfigDNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigDNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigInterfaceIPStatus) DeepCopyInto(out *VirtualMachineNetworkConfigInterfaceIPStatus) {
+	*out = *in
+	if in.DHCP != nil {
+		in, out := &in.DHCP, &out.DHCP
+		*out = new(VirtualMachineNetworkConfigDHCPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigInterfaceIPStatus.
+func (in *VirtualMachineNetworkConfigInterfaceIPStatus) DeepCopy() *VirtualMachineNetworkConfigInterfaceIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigInterfaceIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigInterfaceStatus) DeepCopyInto(out *VirtualMachineNetworkConfigInterfaceStatus) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(VirtualMachineNetworkConfigInterfaceIPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(VirtualMachineNetworkConfigDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigInterfaceStatus.
+func (in *VirtualMachineNetworkConfigInterfaceStatus) DeepCopy() *VirtualMachineNetworkConfigInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigStatus) DeepCopyInto(out *VirtualMachineNetworkConfigStatus) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineNetworkConfigInterfaceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(VirtualMachineNetworkConfigDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bonds != nil {
+		in, out := &in.Bonds, &out.Bonds
+		*out = make([]VirtualMachineNetworkConfigAggregateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bridges != nil {
+		in, out := &in.Bridges, &out.Bridges
+		*out = make([]VirtualMachineNetworkConfigAggregateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigStatus.
+func (in *VirtualMachineNetworkConfigStatus) DeepCopy() *VirtualMachineNetworkConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkDHCPOptionsStatus) DeepCopyInto(out *VirtualMachineNetworkDHCPOptionsStatus) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make([]common.KeyValuePair, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkDHCPOptionsStatus.
+func (in *VirtualMachineNetworkDHCPOptionsStatus) DeepCopy() *VirtualMachineNetworkDHCPOptionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkDHCPOptionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkDHCPStatus) DeepCopyInto(out *VirtualMachineNetworkDHCPStatus) {
+	*out = *in
+	in.IP4.DeepCopyInto(&out.IP4)
+	in.IP6.DeepCopyInto(&out.IP6)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkDHCPStatus.
+func (in *VirtualMachineNetworkDHCPStatus) DeepCopy() *VirtualMachineNetworkDHCPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkDHCPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkDNSStatus) DeepCopyInto(out *VirtualMachineNetworkDNSStatus) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkDNSStatus.
+func (in *VirtualMachineNetworkDNSStatus) DeepCopy() *VirtualMachineNetworkDNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkDNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkIPRouteGatewayStatus) DeepCopyInto(out *VirtualMachineNetworkIPRouteGatewayStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkIPRouteGatewayStatus.
+func (in *VirtualMachineNetworkIPRouteGatewayStatus) DeepCopy() *VirtualMachineNetworkIPRouteGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkIPRouteGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkIPRouteStatus) DeepCopyInto(out *VirtualMachineNetworkIPRouteStatus) {
+	*out = *in
+	out.Gateway = in.Gateway
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkIPRouteStatus.
+func (in *VirtualMachineNetworkIPRouteStatus) DeepCopy() *VirtualMachineNetworkIPRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkIPRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkIPStackStatus) DeepCopyInto(out *VirtualMachineNetworkIPStackStatus) {
+	*out = *in
+	if in.DHCP != nil {
+		in, out := &in.DHCP, &out.DHCP
+		*out = new(VirtualMachineNetworkDHCPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(VirtualMachineNetworkDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPRoutes != nil {
+		in, out := &in.IPRoutes, &out.IPRoutes
+		*out = make([]VirtualMachineNetworkIPRouteStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.KernelConfig != nil {
+		in, out := &in.KernelConfig, &out.KernelConfig
+		*out = make([]common.KeyValuePair, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkIPStackStatus.
+func (in *VirtualMachineNetworkIPStackStatus) DeepCopy() *VirtualMachineNetworkIPStackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkIPStackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterfaceIPAddrStatus) DeepCopyInto(out *VirtualMachineNetworkInterfaceIPAddrStatus) {
+	*out = *in
+	in.Lifetime.DeepCopyInto(&out.Lifetime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceIPAddrStatus.
+func (in *VirtualMachineNetworkInterfaceIPAddrStatus) DeepCopy() *VirtualMachineNetworkInterfaceIPAddrStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterfaceIPAddrStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterfaceIPStatus) DeepCopyInto(out *VirtualMachineNetworkInterfaceIPStatus) {
+	*out = *in
+	if in.AutoConfigurationEnabled != nil {
+		in, out := &in.AutoConfigurationEnabled, &out.AutoConfigurationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCP != nil {
+		in, out := &in.DHCP, &out.DHCP
+		*out = new(VirtualMachineNetworkDHCPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]VirtualMachineNetworkInterfaceIPAddrStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceIPStatus.
+func (in *VirtualMachineNetworkInterfaceIPStatus) DeepCopy() *VirtualMachineNetworkInterfaceIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterfaceIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterfaceSpec) DeepCopyInto(out *VirtualMachineNetworkInterfaceSpec) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(common.PartialObjectRef)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]VirtualMachineNetworkRouteSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VNUMANodeID != nil {
+		in, out := &in.VNUMANodeID, &out.VNUMANodeID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VMXNet3 != nil {
+		in, out := &in.VMXNet3, &out.VMXNet3
+		*out = new(VirtualMachineNetworkInterfaceVMXNet3Spec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdvancedProperties != nil {
+		in, out := &in.AdvancedProperties, &out.AdvancedProperties
+		*out = make([]common.KeyValuePair, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceSpec.
+func (in *VirtualMachineNetworkInterfaceSpec) DeepCopy() *VirtualMachineNetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterfaceStatus) DeepCopyInto(out *VirtualMachineNetworkInterfaceStatus) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(VirtualMachineNetworkInterfaceIPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(VirtualMachineNetworkDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceStatus.
+func (in *VirtualMachineNetworkInterfaceStatus) DeepCopy() *VirtualMachineNetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkInterfaceVMXNet3Spec) DeepCopyInto(out *VirtualMachineNetworkInterfaceVMXNet3Spec) {
+	*out = *in
+	if in.UPTv2Enabled != nil {
+		in, out := &in.UPTv2Enabled, &out.UPTv2Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CtxPerDev != nil {
+		in, out := &in.CtxPerDev, &out.CtxPerDev
+		*out = new(TxContextThreadingMode)
+		**out = **in
+	}
+	if in.RSSOffloadEnabled != nil {
+		in, out := &in.RSSOffloadEnabled, &out.RSSOffloadEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UDPRSSEnabled != nil {
+		in, out := &in.UDPRSSEnabled, &out.UDPRSSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PNICFeatures != nil {
+		in, out := &in.PNICFeatures, &out.PNICFeatures
+		*out = make([]PNICQueueFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.CoalescingScheme != nil {
+		in, out := &in.CoalescingScheme, &out.CoalescingScheme
+		*out = new(CoalescingScheme)
+		**out = **in
+	}
+	if in.CoalescingParams != nil {
+		in, out := &in.CoalescingParams, &out.CoalescingParams
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceVMXNet3Spec.
+func (in *VirtualMachineNetworkInterfaceVMXNet3Spec) DeepCopy() *VirtualMachineNetworkInterfaceVMXNet3Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkInterfaceVMXNet3Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicy) DeepCopyInto(out *VirtualMachineNetworkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicy.
+func (in *VirtualMachineNetworkPolicy) DeepCopy() *VirtualMachineNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineNetworkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyEgressRule) DeepCopyInto(out *VirtualMachineNetworkPolicyEgressRule) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]VirtualMachineNetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]VirtualMachineNetworkPolicyPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyEgressRule.
+func (in *VirtualMachineNetworkPolicyEgressRule) DeepCopy() *VirtualMachineNetworkPolicyEgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyEgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyIPBlock) DeepCopyInto(out *VirtualMachineNetworkPolicyIPBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyIPBlock.
+func (in *VirtualMachineNetworkPolicyIPBlock) DeepCopy() *VirtualMachineNetworkPolicyIPBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyIPBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyIngressRule) DeepCopyInto(out *VirtualMachineNetworkPolicyIngressRule) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]VirtualMachineNetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]VirtualMachineNetworkPolicyPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyIngressRule.
+func (in *VirtualMachineNetworkPolicyIngressRule) DeepCopy() *VirtualMachineNetworkPolicyIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyList) DeepCopyInto(out *VirtualMachineNetworkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineNetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyList.
+func (in *VirtualMachineNetworkPolicyList) DeepCopy() *VirtualMachineNetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineNetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyPeer) DeepCopyInto(out *VirtualMachineNetworkPolicyPeer) {
+	*out = *in
+	if in.VMSelector != nil {
+		in, out := &in.VMSelector, &out.VMSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPBlock != nil {
+		in, out := &in.IPBlock, &out.IPBlock
+		*out = new(VirtualMachineNetworkPolicyIPBlock)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyPeer.
+func (in *VirtualMachineNetworkPolicyPeer) DeepCopy() *VirtualMachineNetworkPolicyPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyPort) DeepCopyInto(out *VirtualMachineNetworkPolicyPort) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyPort.
+func (in *VirtualMachineNetworkPolicyPort) DeepCopy() *VirtualMachineNetworkPolicyPort {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicySpec) DeepCopyInto(out *VirtualMachineNetworkPolicySpec) {
+	*out = *in
+	in.VMSelector.DeepCopyInto(&out.VMSelector)
+	if in.PolicyTypes != nil {
+		in, out := &in.PolicyTypes, &out.PolicyTypes
+		*out = make([]VirtualMachineNetworkPolicyType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]VirtualMachineNetworkPolicyIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]VirtualMachineNetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicySpec.
+func (in *VirtualMachineNetworkPolicySpec) DeepCopy() *VirtualMachineNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyStatus) DeepCopyInto(out *VirtualMachineNetworkPolicyStatus) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]VirtualMachineNetworkPolicyVMStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyStatus.
+func (in *VirtualMachineNetworkPolicyStatus) DeepCopy() *VirtualMachineNetworkPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkPolicyVMStatus) DeepCopyInto(out *VirtualMachineNetworkPolicyVMStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkPolicyVMStatus.
+func (in *VirtualMachineNetworkPolicyVMStatus) DeepCopy() *VirtualMachineNetworkPolicyVMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkPolicyVMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkRouteSpec) DeepCopyInto(out *VirtualMachineNetworkRouteSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkRouteSpec.
+func (in *VirtualMachineNetworkRouteSpec) DeepCopy() *VirtualMachineNetworkRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkRouteStatus) DeepCopyInto(out *VirtualMachineNetworkRouteStatus) {
+	*out = *in
+	if in.IPRoutes != nil {
+		in, out := &in.IPRoutes, &out.IPRoutes
+		*out = make([]VirtualMachineNetworkIPRouteStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkRouteStatus.
+func (in *VirtualMachineNetworkRouteStatus) DeepCopy() *VirtualMachineNetworkRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkSpec) DeepCopyInto(out *VirtualMachineNetworkSpec) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineNetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VLANs != nil {
+		in, out := &in.VLANs, &out.VLANs
+		*out = make([]VirtualMachineNetworkVLANSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bonds != nil {
+		in, out := &in.Bonds, &out.Bonds
+		*out = make([]VirtualMachineNetworkBondSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bridges != nil {
+		in, out := &in.Bridges, &out.Bridges
+		*out = make([]VirtualMachineNetworkBridgeSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkSpec.
+func (in *VirtualMachineNetworkSpec) DeepCopy() *VirtualMachineNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkStatus) DeepCopyInto(out *VirtualMachineNetworkStatus) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(VirtualMachineNetworkConfigStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineNetworkInterfaceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPStacks != nil {
+		in, out := &in.IPStacks, &out.IPStacks
+		*out = make([]VirtualMachineNetworkIPStackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkStatus.
+func (in *VirtualMachineNetworkStatus) DeepCopy() *VirtualMachineNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkVLANSpec) DeepCopyInto(out *VirtualMachineNetworkVLANSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkVLANSpec.
+func (in *VirtualMachineNetworkVLANSpec) DeepCopy() *VirtualMachineNetworkVLANSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkVLANSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePlacementStatus) DeepCopyInto(out *VirtualMachinePlacementStatus) {
+	*out = *in
+	if in.Datastores != nil {
+		in, out := &in.Datastores, &out.Datastores
+		*out = make([]VirtualMachineGroupPlacementDatastoreStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePlacementStatus.
+func (in *VirtualMachinePlacementStatus) DeepCopy() *VirtualMachinePlacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePlacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineProviderStatus) DeepCopyInto(out *VirtualMachineProviderStatus) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineProviderStatus.
+func (in *VirtualMachineProviderStatus) DeepCopy() *VirtualMachineProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequest) DeepCopyInto(out *VirtualMachinePublishRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequest.
+func (in *VirtualMachinePublishRequest) DeepCopy() *VirtualMachinePublishRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePublishRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestList) DeepCopyInto(out *VirtualMachinePublishRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachinePublishRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestList.
+func (in *VirtualMachinePublishRequestList) DeepCopy() *VirtualMachinePublishRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePublishRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestSource) DeepCopyInto(out *VirtualMachinePublishRequestSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestSource.
+func (in *VirtualMachinePublishRequestSource) DeepCopy() *VirtualMachinePublishRequestSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestSpec) DeepCopyInto(out *VirtualMachinePublishRequestSpec) {
+	*out = *in
+	out.Source = in.Source
+	in.Target.DeepCopyInto(&out.Target)
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestSpec.
+func (in *VirtualMachinePublishRequestSpec) DeepCopy() *VirtualMachinePublishRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestStatus) DeepCopyInto(out *VirtualMachinePublishRequestStatus) {
+	*out = *in
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(VirtualMachinePublishRequestSource)
+		**out = **in
+	}
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(VirtualMachinePublishRequestTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.LastAttemptTime.DeepCopyInto(&out.LastAttemptTime)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestStatus.
+func (in *VirtualMachinePublishRequestStatus) DeepCopy() *VirtualMachinePublishRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestTarget) DeepCopyInto(out *VirtualMachinePublishRequestTarget) {
+	*out = *in
+	out.Item = in.Item
+	out.Location = in.Location
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(VirtualMachinePublishRequestTargetOCI)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTarget.
+func (in *VirtualMachinePublishRequestTarget) DeepCopy() *VirtualMachinePublishRequestTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestTargetItem) DeepCopyInto(out *VirtualMachinePublishRequestTargetItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTargetItem.
+func (in *VirtualMachinePublishRequestTargetItem) DeepCopy() *VirtualMachinePublishRequestTargetItem {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestTargetItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestTargetLocation) DeepCopyInto(out *VirtualMachinePublishRequestTargetLocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTargetLocation.
+func (in *VirtualMachinePublishRequestTargetLocation) DeepCopy() *VirtualMachinePublishRequestTargetLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestTargetLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePublishRequestTargetOCI) DeepCopyInto(out *VirtualMachinePublishRequestTargetOCI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTargetOCI.
+func (in *VirtualMachinePublishRequestTargetOCI) DeepCopy() *VirtualMachinePublishRequestTargetOCI {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePublishRequestTargetOCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReadinessProbeSpec) DeepCopyInto(out *VirtualMachineReadinessProbeSpec) {
+	*out = *in
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(TCPSocketAction)
 		**out = **in
 	}
-	if in.DHCP != nil {
-		in, out := &in.DHCP, &out.DHCP
-		*out = new(VirtualMachineNetworkDHCPStatus)
+	if in.GuestHeartbeat != nil {
+		in, out := &in.GuestHeartbeat, &out.GuestHeartbeat
+		*out = new(GuestHeartbeatAction)
+		**out = **in
+	}
+	if in.GuestInfo != nil {
+		in, out := &in.GuestInfo, &out.GuestInfo
+		*out = make([]GuestInfoAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(HTTPGetAction)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]VirtualMachineNetworkInterfaceIPAddrStatus, len(*in))
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReadinessProbeSpec.
+func (in *VirtualMachineReadinessProbeSpec) DeepCopy() *VirtualMachineReadinessProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReadinessProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReadinessProbeStatus) DeepCopyInto(out *VirtualMachineReadinessProbeStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReadinessProbeStatus.
+func (in *VirtualMachineReadinessProbeStatus) DeepCopy() *VirtualMachineReadinessProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReadinessProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSet) DeepCopyInto(out *VirtualMachineReplicaSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSet.
+func (in *VirtualMachineReplicaSet) DeepCopy() *VirtualMachineReplicaSet {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineReplicaSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetList) DeepCopyInto(out *VirtualMachineReplicaSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineReplicaSet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceIPStatus.
-func (in *VirtualMachineNetworkInterfaceIPStatus) DeepCopy() *VirtualMachineNetworkInterfaceIPStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetList.
+func (in *VirtualMachineReplicaSetList) DeepCopy() *VirtualMachineReplicaSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkInterfaceIPStatus)
+	out := new(VirtualMachineReplicaSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineReplicaSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetSpec) DeepCopyInto(out *VirtualMachineReplicaSetSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetSpec.
+func (in *VirtualMachineReplicaSetSpec) DeepCopy() *VirtualMachineReplicaSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReplicaSetStatus) DeepCopyInto(out *VirtualMachineReplicaSetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetStatus.
+func (in *VirtualMachineReplicaSetStatus) DeepCopy() *VirtualMachineReplicaSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReplicaSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineReservedSpec) DeepCopyInto(out *VirtualMachineReservedSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReservedSpec.
+func (in *VirtualMachineReservedSpec) DeepCopy() *VirtualMachineReservedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineReservedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineResourceSpec) DeepCopyInto(out *VirtualMachineResourceSpec) {
+	*out = *in
+	out.Cpu = in.Cpu.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResourceSpec.
+func (in *VirtualMachineResourceSpec) DeepCopy() *VirtualMachineResourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineResourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSerialConsoleRequest) DeepCopyInto(out *VirtualMachineSerialConsoleRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSerialConsoleRequest.
+func (in *VirtualMachineSerialConsoleRequest) DeepCopy() *VirtualMachineSerialConsoleRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSerialConsoleRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSerialConsoleRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSerialConsoleRequestList) DeepCopyInto(out *VirtualMachineSerialConsoleRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSerialConsoleRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSerialConsoleRequestList.
+func (in *VirtualMachineSerialConsoleRequestList) DeepCopy() *VirtualMachineSerialConsoleRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSerialConsoleRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSerialConsoleRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkInterfaceSpec) DeepCopyInto(out *VirtualMachineNetworkInterfaceSpec) {
+func (in *VirtualMachineSerialConsoleRequestSpec) DeepCopyInto(out *VirtualMachineSerialConsoleRequestSpec) {
 	*out = *in
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(common.PartialObjectRef)
-		**out = **in
-	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.MTU != nil {
-		in, out := &in.MTU, &out.MTU
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Nameservers != nil {
-		in, out := &in.Nameservers, &out.Nameservers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make([]VirtualMachineNetworkRouteSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.SearchDomains != nil {
-		in, out := &in.SearchDomains, &out.SearchDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.VNUMANodeID != nil {
-		in, out := &in.VNUMANodeID, &out.VNUMANodeID
-		*out = new(int32)
-		**out = **in
-	}
-	if in.VMXNet3 != nil {
-		in, out := &in.VMXNet3, &out.VMXNet3
-		*out = new(VirtualMachineNetworkInterfaceVMXNet3Spec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AdvancedProperties != nil {
-		in, out := &in.AdvancedProperties, &out.AdvancedProperties
-		*out = make([]common.KeyValuePair, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceSpec.
-func (in *VirtualMachineNetworkInterfaceSpec) DeepCopy() *VirtualMachineNetworkInterfaceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSerialConsoleRequestSpec.
+func (in *VirtualMachineSerialConsoleRequestSpec) DeepCopy() *VirtualMachineSerialConsoleRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkInterfaceSpec)
+	out := new(VirtualMachineSerialConsoleRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkInterfaceStatus) DeepCopyInto(out *VirtualMachineNetworkInterfaceStatus) {
+func (in *VirtualMachineSerialConsoleRequestStatus) DeepCopyInto(out *VirtualMachineSerialConsoleRequestStatus) {
 	*out = *in
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
-		*out = new(VirtualMachineNetworkInterfaceIPStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DNS != nil {
-		in, out := &in.DNS, &out.DNS
-		*out = new(VirtualMachineNetworkDNSStatus)
-		(*in).DeepCopyInto(*out)
-	}
+	in.ExpiryTime.DeepCopyInto(&out.ExpiryTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceStatus.
-func (in *VirtualMachineNetworkInterfaceStatus) DeepCopy() *VirtualMachineNetworkInterfaceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSerialConsoleRequestStatus.
+func (in *VirtualMachineSerialConsoleRequestStatus) DeepCopy() *VirtualMachineSerialConsoleRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkInterfaceStatus)
+	out := new(VirtualMachineSerialConsoleRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkInterfaceVMXNet3Spec) DeepCopyInto(out *VirtualMachineNetworkInterfaceVMXNet3Spec) {
+func (in *VirtualMachineSerialConsoleSpec) DeepCopyInto(out *VirtualMachineSerialConsoleSpec) {
 	*out = *in
-	if in.UPTv2Enabled != nil {
-		in, out := &in.UPTv2Enabled, &out.UPTv2Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CtxPerDev != nil {
-		in, out := &in.CtxPerDev, &out.CtxPerDev
-		*out = new(TxContextThreadingMode)
-		**out = **in
-	}
-	if in.RSSOffloadEnabled != nil {
-		in, out := &in.RSSOffloadEnabled, &out.RSSOffloadEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.UDPRSSEnabled != nil {
-		in, out := &in.UDPRSSEnabled, &out.UDPRSSEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PNICFeatures != nil {
-		in, out := &in.PNICFeatures, &out.PNICFeatures
-		*out = make([]PNICQueueFeature, len(*in))
-		copy(*out, *in)
-	}
-	if in.CoalescingScheme != nil {
-		in, out := &in.CoalescingScheme, &out.CoalescingScheme
-		*out = new(CoalescingScheme)
-		**out = **in
-	}
-	if in.CoalescingParams != nil {
-		in, out := &in.CoalescingParams, &out.CoalescingParams
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkInterfaceVMXNet3Spec.
-func (in *VirtualMachineNetworkInterfaceVMXNet3Spec) DeepCopy() *VirtualMachineNetworkInterfaceVMXNet3Spec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSerialConsoleSpec.
+func (in *VirtualMachineSerialConsoleSpec) DeepCopy() *VirtualMachineSerialConsoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkInterfaceVMXNet3Spec)
+	out := new(VirtualMachineSerialConsoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkRouteSpec) DeepCopyInto(out *VirtualMachineNetworkRouteSpec) {
+func (in *VirtualMachineService) DeepCopyInto(out *VirtualMachineService) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkRouteSpec.
-func (in *VirtualMachineNetworkRouteSpec) DeepCopy() *VirtualMachineNetworkRouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineService.
+func (in *VirtualMachineService) DeepCopy() *VirtualMachineService {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkRouteSpec)
+	out := new(VirtualMachineService)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkRouteStatus) DeepCopyInto(out *VirtualMachineNetworkRouteStatus) {
+func (in *VirtualMachineServiceClientIPConfig) DeepCopyInto(out *VirtualMachineServiceClientIPConfig) {
 	*out = *in
-	if in.IPRoutes != nil {
-		in, out := &in.IPRoutes, &out.IPRoutes
-		*out = make([]VirtualMachineNetworkIPRouteStatus, len(*in))
-		copy(*out, *in)
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkRouteStatus.
-func (in *VirtualMachineNetworkRouteStatus) DeepCopy() *VirtualMachineNetworkRouteStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceClientIPConfig.
+func (in *VirtualMachineServiceClientIPConfig) DeepCopy() *VirtualMachineServiceClientIPConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkRouteStatus)
+	out := new(VirtualMachineServiceClientIPConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkSpec) DeepCopyInto(out *VirtualMachineNetworkSpec) {
+func (in *VirtualMachineServiceList) DeepCopyInto(out *VirtualMachineServiceList) {
 	*out = *in
-	if in.Nameservers != nil {
-		in, out := &in.Nameservers, &out.Nameservers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SearchDomains != nil {
-		in, out := &in.SearchDomains, &out.SearchDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = make([]VirtualMachineNetworkInterfaceSpec, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineService, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.VLANs != nil {
-		in, out := &in.VLANs, &out.VLANs
-		*out = make([]VirtualMachineNetworkVLANSpec, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkSpec.
-func (in *VirtualMachineNetworkSpec) DeepCopy() *VirtualMachineNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceList.
+func (in *VirtualMachineServiceList) DeepCopy() *VirtualMachineServiceList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkSpec)
+	out := new(VirtualMachineServiceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkStatus) DeepCopyInto(out *VirtualMachineNetworkStatus) {
+func (in *VirtualMachineServicePort) DeepCopyInto(out *VirtualMachineServicePort) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = new(VirtualMachineNetworkConfigStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = make([]VirtualMachineNetworkInterfaceStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.IPStacks != nil {
-		in, out := &in.IPStacks, &out.IPStacks
-		*out = make([]VirtualMachineNetworkIPStackStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkStatus.
-func (in *VirtualMachineNetworkStatus) DeepCopy() *VirtualMachineNetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServicePort.
+func (in *VirtualMachineServicePort) DeepCopy() *VirtualMachineServicePort {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkStatus)
+	out := new(VirtualMachineServicePort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineNetworkVLANSpec) DeepCopyInto(out *VirtualMachineNetworkVLANSpec) {
+func (in *VirtualMachineServiceSessionAffinityConfig) DeepCopyInto(out *VirtualMachineServiceSessionAffinityConfig) {
 	*out = *in
+	if in.ClientIP != nil {
+		in, out := &in.ClientIP, &out.ClientIP
+		*out = new(VirtualMachineServiceClientIPConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkVLANSpec.
-func (in *VirtualMachineNetworkVLANSpec) DeepCopy() *VirtualMachineNetworkVLANSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceSessionAffinityConfig.
+func (in *VirtualMachineServiceSessionAffinityConfig) DeepCopy() *VirtualMachineServiceSessionAffinityConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineNetworkVLANSpec)
+	out := new(VirtualMachineServiceSessionAffinityConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePlacementStatus) DeepCopyInto(out *VirtualMachinePlacementStatus) {
+func (in *VirtualMachineServiceSpec) DeepCopyInto(out *VirtualMachineServiceSpec) {
 	*out = *in
-	if in.Datastores != nil {
-		in, out := &in.Datastores, &out.Datastores
-		*out = make([]VirtualMachineGroupPlacementDatastoreStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]VirtualMachineServicePort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SessionAffinityConfig != nil {
+		in, out := &in.SessionAffinityConfig, &out.SessionAffinityConfig
+		*out = new(VirtualMachineServiceSessionAffinityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]VirtualMachineServiceIPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(VirtualMachineServiceIPFamilyPolicy)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePlacementStatus.
-func (in *VirtualMachinePlacementStatus) DeepCopy() *VirtualMachinePlacementStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceSpec.
+func (in *VirtualMachineServiceSpec) DeepCopy() *VirtualMachineServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePlacementStatus)
+	out := new(VirtualMachineServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineProviderStatus) DeepCopyInto(out *VirtualMachineProviderStatus) {
+func (in *VirtualMachineServiceStatus) DeepCopyInto(out *VirtualMachineServiceStatus) {
 	*out = *in
-	if in.CreationTimestamp != nil {
-		in, out := &in.CreationTimestamp, &out.CreationTimestamp
-		*out = (*in).DeepCopy()
-	}
+	in.LoadBalancer.DeepCopyInto(&out.LoadBalancer)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineProviderStatus.
-func (in *VirtualMachineProviderStatus) DeepCopy() *VirtualMachineProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceStatus.
+func (in *VirtualMachineServiceStatus) DeepCopy() *VirtualMachineServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineProviderStatus)
+	out := new(VirtualMachineServiceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequest) DeepCopyInto(out *VirtualMachinePublishRequest) {
+func (in *VirtualMachineSetResourcePolicy) DeepCopyInto(out *VirtualMachineSetResourcePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2817,18 +4778,18 @@ func (in *VirtualMachinePublishRequest) DeepCopyInto(out *VirtualMachinePublishR
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequest.
-func (in *VirtualMachinePublishRequest) DeepCopy() *VirtualMachinePublishRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicy.
+func (in *VirtualMachineSetResourcePolicy) DeepCopy() *VirtualMachineSetResourcePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePublishRequest)
+	out := new(VirtualMachineSetResourcePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachinePublishRequest) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSetResourcePolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2836,31 +4797,31 @@ func (in *VirtualMachinePublishRequest) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestList) DeepCopyInto(out *VirtualMachinePublishRequestList) {
+func (in *VirtualMachineSetResourcePolicyList) DeepCopyInto(out *VirtualMachineSetResourcePolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachinePublishRequest, len(*in))
+		*out = make([]VirtualMachineSetResourcePolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestList.
-func (in *VirtualMachinePublishRequestList) DeepCopy() *VirtualMachinePublishRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyList.
+func (in *VirtualMachineSetResourcePolicyList) DeepCopy() *VirtualMachineSetResourcePolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePublishRequestList)
+	out := new(VirtualMachineSetResourcePolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachinePublishRequestList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSetResourcePolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2868,175 +4829,151 @@ func (in *VirtualMachinePublishRequestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestSource) DeepCopyInto(out *VirtualMachinePublishRequestSource) {
+func (in *VirtualMachineSetResourcePolicySpec) DeepCopyInto(out *VirtualMachineSetResourcePolicySpec) {
 	*out = *in
+	in.ResourcePool.DeepCopyInto(&out.ResourcePool)
+	if in.ClusterModuleGroups != nil {
+		in, out := &in.ClusterModuleGroups, &out.ClusterModuleGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestSource.
-func (in *VirtualMachinePublishRequestSource) DeepCopy() *VirtualMachinePublishRequestSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicySpec.
+func (in *VirtualMachineSetResourcePolicySpec) DeepCopy() *VirtualMachineSetResourcePolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePublishRequestSource)
+	out := new(VirtualMachineSetResourcePolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestSpec) DeepCopyInto(out *VirtualMachinePublishRequestSpec) {
+func (in *VirtualMachineSetResourcePolicyStatus) DeepCopyInto(out *VirtualMachineSetResourcePolicyStatus) {
 	*out = *in
-	out.Source = in.Source
-	out.Target = in.Target
-	if in.TTLSecondsAfterFinished != nil {
-		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
-		*out = new(int64)
-		**out = **in
+	if in.ResourcePools != nil {
+		in, out := &in.ResourcePools, &out.ResourcePools
+		*out = make([]ResourcePoolStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterModules != nil {
+		in, out := &in.ClusterModules, &out.ClusterModules
+		*out = make([]VSphereClusterModuleStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestSpec.
-func (in *VirtualMachinePublishRequestSpec) DeepCopy() *VirtualMachinePublishRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyStatus.
+func (in *VirtualMachineSetResourcePolicyStatus) DeepCopy() *VirtualMachineSetResourcePolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePublishRequestSpec)
+	out := new(VirtualMachineSetResourcePolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestStatus) DeepCopyInto(out *VirtualMachinePublishRequestStatus) {
+func (in *VirtualMachineSnapshot) DeepCopyInto(out *VirtualMachineSnapshot) {
 	*out = *in
-	if in.SourceRef != nil {
-		in, out := &in.SourceRef, &out.SourceRef
-		*out = new(VirtualMachinePublishRequestSource)
-		**out = **in
-	}
-	if in.TargetRef != nil {
-		in, out := &in.TargetRef, &out.TargetRef
-		*out = new(VirtualMachinePublishRequestTarget)
-		**out = **in
-	}
-	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
-	in.StartTime.DeepCopyInto(&out.StartTime)
-	in.LastAttemptTime.DeepCopyInto(&out.LastAttemptTime)
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestStatus.
-func (in *VirtualMachinePublishRequestStatus) DeepCopy() *VirtualMachinePublishRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshot.
+func (in *VirtualMachineSnapshot) DeepCopy() *VirtualMachineSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePublishRequestStatus)
+	out := new(VirtualMachineSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestTarget) DeepCopyInto(out *VirtualMachinePublishRequestTarget) {
-	*out = *in
-	out.Item = in.Item
-	out.Location = in.Location
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTarget.
-func (in *VirtualMachinePublishRequestTarget) DeepCopy() *VirtualMachinePublishRequestTarget {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(VirtualMachinePublishRequestTarget)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestTargetItem) DeepCopyInto(out *VirtualMachinePublishRequestTargetItem) {
+func (in *VirtualMachineSnapshotExport) DeepCopyInto(out *VirtualMachineSnapshotExport) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTargetItem.
-func (in *VirtualMachinePublishRequestTargetItem) DeepCopy() *VirtualMachinePublishRequestTargetItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExport.
+func (in *VirtualMachineSnapshotExport) DeepCopy() *VirtualMachineSnapshotExport {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachinePublishRequestTargetItem)
+	out := new(VirtualMachineSnapshotExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachinePublishRequestTargetLocation) DeepCopyInto(out *VirtualMachinePublishRequestTargetLocation) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePublishRequestTargetLocation.
-func (in *VirtualMachinePublishRequestTargetLocation) DeepCopy() *VirtualMachinePublishRequestTargetLocation {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(VirtualMachinePublishRequestTargetLocation)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineReadinessProbeSpec) DeepCopyInto(out *VirtualMachineReadinessProbeSpec) {
+func (in *VirtualMachineSnapshotExportDiskStatus) DeepCopyInto(out *VirtualMachineSnapshotExportDiskStatus) {
 	*out = *in
-	if in.TCPSocket != nil {
-		in, out := &in.TCPSocket, &out.TCPSocket
-		*out = new(TCPSocketAction)
-		**out = **in
-	}
-	if in.GuestHeartbeat != nil {
-		in, out := &in.GuestHeartbeat, &out.GuestHeartbeat
-		*out = new(GuestHeartbeatAction)
-		**out = **in
-	}
-	if in.GuestInfo != nil {
-		in, out := &in.GuestInfo, &out.GuestInfo
-		*out = make([]GuestInfoAction, len(*in))
-		copy(*out, *in)
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReadinessProbeSpec.
-func (in *VirtualMachineReadinessProbeSpec) DeepCopy() *VirtualMachineReadinessProbeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportDiskStatus.
+func (in *VirtualMachineSnapshotExportDiskStatus) DeepCopy() *VirtualMachineSnapshotExportDiskStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineReadinessProbeSpec)
+	out := new(VirtualMachineSnapshotExportDiskStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineReplicaSet) DeepCopyInto(out *VirtualMachineReplicaSet) {
+func (in *VirtualMachineSnapshotExportList) DeepCopyInto(out *VirtualMachineSnapshotExportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSet.
-func (in *VirtualMachineReplicaSet) DeepCopy() *VirtualMachineReplicaSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportList.
+func (in *VirtualMachineSnapshotExportList) DeepCopy() *VirtualMachineSnapshotExportList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineReplicaSet)
+	out := new(VirtualMachineSnapshotExportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineReplicaSet) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSnapshotExportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3044,138 +4981,135 @@ func (in *VirtualMachineReplicaSet) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineReplicaSetList) DeepCopyInto(out *VirtualMachineReplicaSetList) {
+func (in *VirtualMachineSnapshotExportOVATarget) DeepCopyInto(out *VirtualMachineSnapshotExportOVATarget) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineReplicaSet, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetList.
-func (in *VirtualMachineReplicaSetList) DeepCopy() *VirtualMachineReplicaSetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportOVATarget.
+func (in *VirtualMachineSnapshotExportOVATarget) DeepCopy() *VirtualMachineSnapshotExportOVATarget {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineReplicaSetList)
+	out := new(VirtualMachineSnapshotExportOVATarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineReplicaSetList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineReplicaSetSpec) DeepCopyInto(out *VirtualMachineReplicaSetSpec) {
+func (in *VirtualMachineSnapshotExportPersistentVolumeClaimTarget) DeepCopyInto(out *VirtualMachineSnapshotExportPersistentVolumeClaimTarget) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetSpec.
-func (in *VirtualMachineReplicaSetSpec) DeepCopy() *VirtualMachineReplicaSetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportPersistentVolumeClaimTarget.
+func (in *VirtualMachineSnapshotExportPersistentVolumeClaimTarget) DeepCopy() *VirtualMachineSnapshotExportPersistentVolumeClaimTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineReplicaSetSpec)
+	out := new(VirtualMachineSnapshotExportPersistentVolumeClaimTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineReplicaSetStatus) DeepCopyInto(out *VirtualMachineReplicaSetStatus) {
+func (in *VirtualMachineSnapshotExportSpec) DeepCopyInto(out *VirtualMachineSnapshotExportSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.Target.DeepCopyInto(&out.Target)
+	if in.ImportNamespaces != nil {
+		in, out := &in.ImportNamespaces, &out.ImportNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReplicaSetStatus.
-func (in *VirtualMachineReplicaSetStatus) DeepCopy() *VirtualMachineReplicaSetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportSpec.
+func (in *VirtualMachineSnapshotExportSpec) DeepCopy() *VirtualMachineSnapshotExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineReplicaSetStatus)
+	out := new(VirtualMachineSnapshotExportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineReservedSpec) DeepCopyInto(out *VirtualMachineReservedSpec) {
+func (in *VirtualMachineSnapshotExportStatus) DeepCopyInto(out *VirtualMachineSnapshotExportStatus) {
 	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineSnapshotExportDiskStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineReservedSpec.
-func (in *VirtualMachineReservedSpec) DeepCopy() *VirtualMachineReservedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportStatus.
+func (in *VirtualMachineSnapshotExportStatus) DeepCopy() *VirtualMachineSnapshotExportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineReservedSpec)
+	out := new(VirtualMachineSnapshotExportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineResourceSpec) DeepCopyInto(out *VirtualMachineResourceSpec) {
+func (in *VirtualMachineSnapshotExportTarget) DeepCopyInto(out *VirtualMachineSnapshotExportTarget) {
 	*out = *in
-	out.Cpu = in.Cpu.DeepCopy()
-	out.Memory = in.Memory.DeepCopy()
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(VirtualMachineSnapshotExportPersistentVolumeClaimTarget)
+		**out = **in
+	}
+	if in.OVA != nil {
+		in, out := &in.OVA, &out.OVA
+		*out = new(VirtualMachineSnapshotExportOVATarget)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResourceSpec.
-func (in *VirtualMachineResourceSpec) DeepCopy() *VirtualMachineResourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportTarget.
+func (in *VirtualMachineSnapshotExportTarget) DeepCopy() *VirtualMachineSnapshotExportTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineResourceSpec)
+	out := new(VirtualMachineSnapshotExportTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineService) DeepCopyInto(out *VirtualMachineService) {
+func (in *VirtualMachineSnapshotImport) DeepCopyInto(out *VirtualMachineSnapshotImport) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineService.
-func (in *VirtualMachineService) DeepCopy() *VirtualMachineService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotImport.
+func (in *VirtualMachineSnapshotImport) DeepCopy() *VirtualMachineSnapshotImport {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineService)
+	out := new(VirtualMachineSnapshotImport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineService) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSnapshotImport) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3183,31 +5117,31 @@ func (in *VirtualMachineService) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineServiceList) DeepCopyInto(out *VirtualMachineServiceList) {
+func (in *VirtualMachineSnapshotImportList) DeepCopyInto(out *VirtualMachineSnapshotImportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineService, len(*in))
+		*out = make([]VirtualMachineSnapshotImport, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceList.
-func (in *VirtualMachineServiceList) DeepCopy() *VirtualMachineServiceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotImportList.
+func (in *VirtualMachineSnapshotImportList) DeepCopy() *VirtualMachineSnapshotImportList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineServiceList)
+	out := new(VirtualMachineSnapshotImportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineServiceList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSnapshotImportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3215,121 +5149,108 @@ func (in *VirtualMachineServiceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineServicePort) DeepCopyInto(out *VirtualMachineServicePort) {
+func (in *VirtualMachineSnapshotImportSource) DeepCopyInto(out *VirtualMachineSnapshotImportSource) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServicePort.
-func (in *VirtualMachineServicePort) DeepCopy() *VirtualMachineServicePort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotImportSource.
+func (in *VirtualMachineSnapshotImportSource) DeepCopy() *VirtualMachineSnapshotImportSource {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineServicePort)
+	out := new(VirtualMachineSnapshotImportSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineServiceSpec) DeepCopyInto(out *VirtualMachineServiceSpec) {
+func (in *VirtualMachineSnapshotImportSpec) DeepCopyInto(out *VirtualMachineSnapshotImportSpec) {
 	*out = *in
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]VirtualMachineServicePort, len(*in))
-		copy(*out, *in)
-	}
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.LoadBalancerSourceRanges != nil {
-		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.Source = in.Source
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceSpec.
-func (in *VirtualMachineServiceSpec) DeepCopy() *VirtualMachineServiceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotImportSpec.
+func (in *VirtualMachineSnapshotImportSpec) DeepCopy() *VirtualMachineSnapshotImportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineServiceSpec)
+	out := new(VirtualMachineSnapshotImportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineServiceStatus) DeepCopyInto(out *VirtualMachineServiceStatus) {
+func (in *VirtualMachineSnapshotImportStatus) DeepCopyInto(out *VirtualMachineSnapshotImportStatus) {
 	*out = *in
-	in.LoadBalancer.DeepCopyInto(&out.LoadBalancer)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineSnapshotImportVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceStatus.
-func (in *VirtualMachineServiceStatus) DeepCopy() *VirtualMachineServiceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotImportStatus.
+func (in *VirtualMachineSnapshotImportStatus) DeepCopy() *VirtualMachineSnapshotImportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineServiceStatus)
+	out := new(VirtualMachineSnapshotImportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSetResourcePolicy) DeepCopyInto(out *VirtualMachineSetResourcePolicy) {
+func (in *VirtualMachineSnapshotImportVolumeStatus) DeepCopyInto(out *VirtualMachineSnapshotImportVolumeStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicy.
-func (in *VirtualMachineSetResourcePolicy) DeepCopy() *VirtualMachineSetResourcePolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotImportVolumeStatus.
+func (in *VirtualMachineSnapshotImportVolumeStatus) DeepCopy() *VirtualMachineSnapshotImportVolumeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSetResourcePolicy)
+	out := new(VirtualMachineSnapshotImportVolumeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineSetResourcePolicy) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSetResourcePolicyList) DeepCopyInto(out *VirtualMachineSetResourcePolicyList) {
+func (in *VirtualMachineSnapshotList) DeepCopyInto(out *VirtualMachineSnapshotList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineSetResourcePolicy, len(*in))
+		*out = make([]VirtualMachineSnapshot, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyList.
-func (in *VirtualMachineSetResourcePolicyList) DeepCopy() *VirtualMachineSetResourcePolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotList.
+func (in *VirtualMachineSnapshotList) DeepCopy() *VirtualMachineSnapshotList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSetResourcePolicyList)
+	out := new(VirtualMachineSnapshotList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineSetResourcePolicyList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSnapshotList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3337,53 +5258,47 @@ func (in *VirtualMachineSetResourcePolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSetResourcePolicySpec) DeepCopyInto(out *VirtualMachineSetResourcePolicySpec) {
+func (in *VirtualMachineSnapshotReference) DeepCopyInto(out *VirtualMachineSnapshotReference) {
 	*out = *in
-	in.ResourcePool.DeepCopyInto(&out.ResourcePool)
-	if in.ClusterModuleGroups != nil {
-		in, out := &in.ClusterModuleGroups, &out.ClusterModuleGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicySpec.
-func (in *VirtualMachineSetResourcePolicySpec) DeepCopy() *VirtualMachineSetResourcePolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotReference.
+func (in *VirtualMachineSnapshotReference) DeepCopy() *VirtualMachineSnapshotReference {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSetResourcePolicySpec)
+	out := new(VirtualMachineSnapshotReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSetResourcePolicyStatus) DeepCopyInto(out *VirtualMachineSetResourcePolicyStatus) {
+func (in *VirtualMachineSnapshotRetentionPolicy) DeepCopyInto(out *VirtualMachineSnapshotRetentionPolicy) {
 	*out = *in
-	if in.ResourcePools != nil {
-		in, out := &in.ResourcePools, &out.ResourcePools
-		*out = make([]ResourcePoolStatus, len(*in))
-		copy(*out, *in)
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
 	}
-	if in.ClusterModules != nil {
-		in, out := &in.ClusterModules, &out.ClusterModules
-		*out = make([]VSphereClusterModuleStatus, len(*in))
-		copy(*out, *in)
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSetResourcePolicyStatus.
-func (in *VirtualMachineSetResourcePolicyStatus) DeepCopy() *VirtualMachineSetResourcePolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotRetentionPolicy.
+func (in *VirtualMachineSnapshotRetentionPolicy) DeepCopy() *VirtualMachineSnapshotRetentionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSetResourcePolicyStatus)
+	out := new(VirtualMachineSnapshotRetentionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSnapshot) DeepCopyInto(out *VirtualMachineSnapshot) {
+func (in *VirtualMachineSnapshotSchedule) DeepCopyInto(out *VirtualMachineSnapshotSchedule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3391,18 +5306,18 @@ func (in *VirtualMachineSnapshot) DeepCopyInto(out *VirtualMachineSnapshot) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshot.
-func (in *VirtualMachineSnapshot) DeepCopy() *VirtualMachineSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotSchedule.
+func (in *VirtualMachineSnapshotSchedule) DeepCopy() *VirtualMachineSnapshotSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSnapshot)
+	out := new(VirtualMachineSnapshotSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineSnapshot) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSnapshotSchedule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3410,31 +5325,46 @@ func (in *VirtualMachineSnapshot) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSnapshotList) DeepCopyInto(out *VirtualMachineSnapshotList) {
+func (in *VirtualMachineSnapshotScheduleFailure) DeepCopyInto(out *VirtualMachineSnapshotScheduleFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleFailure.
+func (in *VirtualMachineSnapshotScheduleFailure) DeepCopy() *VirtualMachineSnapshotScheduleFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotScheduleFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotScheduleList) DeepCopyInto(out *VirtualMachineSnapshotScheduleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VirtualMachineSnapshot, len(*in))
+		*out = make([]VirtualMachineSnapshotSchedule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotList.
-func (in *VirtualMachineSnapshotList) DeepCopy() *VirtualMachineSnapshotList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleList.
+func (in *VirtualMachineSnapshotScheduleList) DeepCopy() *VirtualMachineSnapshotScheduleList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSnapshotList)
+	out := new(VirtualMachineSnapshotScheduleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachineSnapshotList) DeepCopyObject() runtime.Object {
+func (in *VirtualMachineSnapshotScheduleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3442,16 +5372,76 @@ func (in *VirtualMachineSnapshotList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineSnapshotReference) DeepCopyInto(out *VirtualMachineSnapshotReference) {
+func (in *VirtualMachineSnapshotScheduleSpec) DeepCopyInto(out *VirtualMachineSnapshotScheduleSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.SnapshotTemplate.DeepCopyInto(&out.SnapshotTemplate)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(VirtualMachineSnapshotRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleSpec.
+func (in *VirtualMachineSnapshotScheduleSpec) DeepCopy() *VirtualMachineSnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotScheduleStatus) DeepCopyInto(out *VirtualMachineSnapshotScheduleStatus) {
 	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]VirtualMachineSnapshotScheduleFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotReference.
-func (in *VirtualMachineSnapshotReference) DeepCopy() *VirtualMachineSnapshotReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotScheduleStatus.
+func (in *VirtualMachineSnapshotScheduleStatus) DeepCopy() *VirtualMachineSnapshotScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineSnapshotReference)
+	out := new(VirtualMachineSnapshotScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3555,6 +5545,26 @@ func (in *VirtualMachineSnapshotStorageStatusRequested) DeepCopy() *VirtualMachi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotTemplateSpec) DeepCopyInto(out *VirtualMachineSnapshotTemplateSpec) {
+	*out = *in
+	if in.Quiesce != nil {
+		in, out := &in.Quiesce, &out.Quiesce
+		*out = new(QuiesceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotTemplateSpec.
+func (in *VirtualMachineSnapshotTemplateSpec) DeepCopy() *VirtualMachineSnapshotTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -3600,6 +5610,16 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(VirtualMachineReadinessProbeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(VirtualMachineStartupProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(VirtualMachineLivenessProbeSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Advanced != nil {
 		in, out := &in.Advanced, &out.Advanced
 		*out = new(VirtualMachineAdvancedSpec)
@@ -3637,6 +5657,65 @@ func (in *VirtualMachineSpec) DeepCopy() *VirtualMachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStartupProbeSpec) DeepCopyInto(out *VirtualMachineStartupProbeSpec) {
+	*out = *in
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(TCPSocketAction)
+		**out = **in
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestHeartbeat != nil {
+		in, out := &in.GuestHeartbeat, &out.GuestHeartbeat
+		*out = new(GuestHeartbeatAction)
+		**out = **in
+	}
+	if in.GuestInfo != nil {
+		in, out := &in.GuestInfo, &out.GuestInfo
+		*out = make([]GuestInfoAction, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStartupProbeSpec.
+func (in *VirtualMachineStartupProbeSpec) DeepCopy() *VirtualMachineStartupProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStartupProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStartupProbeStatus) DeepCopyInto(out *VirtualMachineStartupProbeStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStartupProbeStatus.
+func (in *VirtualMachineStartupProbeStatus) DeepCopy() *VirtualMachineStartupProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStartupProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 	*out = *in
@@ -3678,6 +5757,26 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		in, out := &in.LastRestartTime, &out.LastRestartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.BootstrapGeneration != nil {
+		in, out := &in.BootstrapGeneration, &out.BootstrapGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(VirtualMachineReadinessProbeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(VirtualMachineStartupProbeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(VirtualMachineLivenessProbeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
 		*out = new(VirtualMachineStorageStatus)
@@ -3861,6 +5960,11 @@ func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSp
 	*out = *in
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]VirtualMachineTopologySpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateSpec.
@@ -3873,6 +5977,21 @@ func (in *VirtualMachineTemplateSpec) DeepCopy() *VirtualMachineTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTopologySpreadConstraint) DeepCopyInto(out *VirtualMachineTopologySpreadConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTopologySpreadConstraint.
+func (in *VirtualMachineTopologySpreadConstraint) DeepCopy() *VirtualMachineTopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineVolume) DeepCopyInto(out *VirtualMachineVolume) {
 	*out = *in
@@ -3989,7 +6108,7 @@ func (in *VirtualMachineWebConsoleRequest) DeepCopyInto(out *VirtualMachineWebCo
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -4046,6 +6165,11 @@ func (in *VirtualMachineWebConsoleRequestList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineWebConsoleRequestSpec) DeepCopyInto(out *VirtualMachineWebConsoleRequestSpec) {
 	*out = *in
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineWebConsoleRequestSpec.
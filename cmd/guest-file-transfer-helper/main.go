@@ -0,0 +1,27 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/guestfiletransfer"
+)
+
+func main() {
+	ctx := signals.SetupSignalHandler()
+
+	if err := guestfiletransfer.Run(ctx, os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if errors.Is(err, guestfiletransfer.ErrNotFound) {
+			os.Exit(guestfiletransfer.ExitCodeNotFound)
+		}
+		os.Exit(1)
+	}
+}
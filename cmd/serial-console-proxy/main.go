@@ -0,0 +1,179 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	clientgorecord "k8s.io/client-go/tools/record"
+	klog "k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/serialconsole"
+)
+
+var (
+	defaultServerPort  = 9869
+	defaultServerPath  = "/serialconsole"
+	defaultTLSCertFile = "/etc/serial-console-proxy/tls/tls.crt"
+	defaultTLSKeyFile  = "/etc/serial-console-proxy/tls/tls.key"
+)
+
+func init() {
+	if v := os.Getenv("SERVER_PATH"); v != "" {
+		defaultServerPath = v
+	}
+	if v := os.Getenv("TLS_CERT_FILE"); v != "" {
+		defaultTLSCertFile = v
+	}
+	if v := os.Getenv("TLS_KEY_FILE"); v != "" {
+		defaultTLSKeyFile = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("SERVER_PORT")); err == nil {
+		defaultServerPort = v
+	}
+}
+
+func main() {
+	// Using the same type of logger as in the controller-manager.
+	klog.InitFlags(nil)
+	ctrllog.SetLogger(textlogger.NewLogger(textlogger.NewConfig()))
+	logger := ctrllog.Log.WithName("entrypoint")
+
+	logger.Info("VM Operator serial-console proxy server info", "version", pkg.BuildVersion,
+		"buildnumber", pkg.BuildNumber, "buildtype", pkg.BuildType, "commit", pkg.BuildCommit)
+
+	serverPort := flag.Int(
+		"server-port",
+		defaultServerPort,
+		"The port on which serial-console proxy server to listen for incoming connections.",
+	)
+	serverPath := flag.String(
+		"server-path",
+		defaultServerPath,
+		"The pattern path to handle the serial-console connections.",
+	)
+	tlsCertFile := flag.String(
+		"tls-cert-file",
+		defaultTLSCertFile,
+		"The path to the certificate used to serve TLS.",
+	)
+	tlsKeyFile := flag.String(
+		"tls-key-file",
+		defaultTLSKeyFile,
+		"The path to the private key used to serve TLS.",
+	)
+
+	flag.Parse()
+
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		logger.Error(err, "Failed to get Kubernetes in-cluster config")
+		os.Exit(1)
+	}
+
+	ctx := signals.SetupSignalHandler()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		logger.Error(err, "Failed to add client-go scheme")
+		os.Exit(1)
+	}
+	if err := vmopv1.AddToScheme(scheme); err != nil {
+		logger.Error(err, "Failed to add vm-operator stable scheme")
+		os.Exit(1)
+	}
+
+	// Use an informer cache to avoid hitting the API server for every
+	// connection and for the periodic checks of open connections.
+	cache, err := ctrlcache.New(restConfig, ctrlcache.Options{Scheme: scheme})
+	if err != nil {
+		logger.Error(err, "Failed to initialize controller-runtime cache")
+		os.Exit(1)
+	}
+	if _, err := cache.GetInformer(ctx, &vmopv1.VirtualMachineSerialConsoleRequest{}); err != nil {
+		logger.Error(err, "Failed to get informer for VirtualMachineSerialConsoleRequest")
+		os.Exit(1)
+	}
+	// VMs are used to verify the endpoint of a request is the serial console
+	// of the requested VM.
+	if _, err := cache.GetInformer(ctx, &vmopv1.VirtualMachine{}); err != nil {
+		logger.Error(err, "Failed to get informer for VirtualMachine")
+		os.Exit(1)
+	}
+	go func() {
+		if err := cache.Start(ctx); err != nil {
+			logger.Error(err, "Failed to start controller-runtime cache")
+			os.Exit(1)
+		}
+	}()
+	if !cache.WaitForCacheSync(ctx) {
+		logger.Error(errors.New("cache did not sync"), "Failed to sync controller-runtime cache")
+		os.Exit(1)
+	}
+
+	client, err := ctrlclient.New(restConfig, ctrlclient.Options{
+		Scheme: scheme,
+		Cache: &ctrlclient.CacheOptions{
+			Reader: cache,
+		},
+	})
+	if err != nil {
+		logger.Error(err, "Failed to initialize controller-runtime client")
+		os.Exit(1)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		logger.Error(err, "Failed to initialize Kubernetes clientset")
+		os.Exit(1)
+	}
+
+	// Record each connection as an event on the serial console request for
+	// auditing.
+	broadcaster := clientgorecord.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: clientset.CoreV1().Events(""),
+	})
+	eventRecorder := broadcaster.NewRecorder(scheme, corev1.EventSource{
+		Component: "serial-console-proxy",
+	})
+
+	server, err := serialconsole.NewServer(
+		":"+strconv.Itoa(*serverPort),
+		*serverPath,
+		*tlsCertFile,
+		*tlsKeyFile,
+		client,
+		record.New(eventRecorder),
+	)
+	if err != nil {
+		logger.Error(err, "Failed to initialize serial-console proxy server")
+		os.Exit(1)
+	}
+
+	logger.Info("Starting the serial-console proxy server", "port", *serverPort, "path", *serverPath)
+	if err := server.Run(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(err, "Failed to run the serial-console proxy server")
+		os.Exit(1)
+	}
+}
@@ -7,20 +7,29 @@ package main
 import (
 	"errors"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	clientgorecord "k8s.io/client-go/tools/record"
 	klog "k8s.io/klog/v2"
 	"k8s.io/klog/v2/textlogger"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	"github.com/vmware-tanzu/vm-operator/pkg"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/webconsolevalidation"
 )
 
@@ -66,7 +75,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	ctx := signals.SetupSignalHandler()
+
 	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		logger.Error(err, "Failed to add client-go scheme")
+		os.Exit(1)
+	}
 	if err := vmopv1.AddToScheme(scheme); err != nil {
 		logger.Error(err, "Failed to add vm-operator stable scheme")
 		os.Exit(1)
@@ -78,16 +93,65 @@ func main() {
 		os.Exit(1)
 	}
 
-	client, err := ctrlclient.New(restConfig, ctrlclient.Options{Scheme: scheme})
+	// Use an informer cache to avoid hitting the API server for every
+	// validation request.
+	cache, err := ctrlcache.New(restConfig, ctrlcache.Options{Scheme: scheme})
+	if err != nil {
+		logger.Error(err, "Failed to initialize controller-runtime cache")
+		os.Exit(1)
+	}
+	for _, obj := range []ctrlclient.Object{
+		&vmopv1.VirtualMachineWebConsoleRequest{},
+		&vmopv1a1.WebConsoleRequest{},
+	} {
+		if _, err := cache.GetInformer(ctx, obj); err != nil {
+			logger.Error(err, "Failed to get informer", "type", fmt.Sprintf("%T", obj))
+			os.Exit(1)
+		}
+	}
+	go func() {
+		if err := cache.Start(ctx); err != nil {
+			logger.Error(err, "Failed to start controller-runtime cache")
+			os.Exit(1)
+		}
+	}()
+	if !cache.WaitForCacheSync(ctx) {
+		logger.Error(errors.New("cache did not sync"), "Failed to sync controller-runtime cache")
+		os.Exit(1)
+	}
+
+	client, err := ctrlclient.New(restConfig, ctrlclient.Options{
+		Scheme: scheme,
+		Cache: &ctrlclient.CacheOptions{
+			Reader: cache,
+		},
+	})
 	if err != nil {
 		logger.Error(err, "Failed to initialize controller-runtime client")
 		os.Exit(1)
 	}
 
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		logger.Error(err, "Failed to initialize Kubernetes clientset")
+		os.Exit(1)
+	}
+
+	// Record the result of each validation as an event on the web console
+	// request for auditing.
+	broadcaster := clientgorecord.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: clientset.CoreV1().Events(""),
+	})
+	eventRecorder := broadcaster.NewRecorder(scheme, corev1.EventSource{
+		Component: "web-console-validator",
+	})
+
 	server, err := webconsolevalidation.NewServer(
 		":"+strconv.Itoa(*serverPort),
 		*serverPath,
 		client,
+		record.New(eventRecorder),
 	)
 	if err != nil {
 		logger.Error(err, "Failed to initialize web-console validation server")
@@ -14,14 +14,24 @@ import (
 	"github.com/vmware-tanzu/vm-operator/controllers/storage"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineclass"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinedeployment"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinedisruptionbudget"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinegroup"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinegrouppublishrequest"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinegroupsnapshot"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineguestfiletransfer"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimage"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimagecache"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinenetworkpolicy"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinepublishrequest"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinereplicaset"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineserialconsolerequest"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesetresourcepolicy"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshot"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshotexport"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshotimport"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshotschedule"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinewebconsolerequest"
 	"github.com/vmware-tanzu/vm-operator/controllers/vspherepolicy"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
@@ -54,14 +64,36 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	if err := virtualmachinewebconsolerequest.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachineWebConsoleRequest controller: %w", err)
 	}
+	if pkgcfg.FromContext(ctx).Features.VMSerialConsole {
+		if err := virtualmachineserialconsolerequest.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSerialConsoleRequest controller: %w", err)
+		}
+	}
+	if err := virtualmachineguestfiletransfer.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize VirtualMachineGuestFileTransfer controller: %w", err)
+	}
 	if err := virtualmachinepublishrequest.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachinePublishRequest controller: %w", err)
 	}
+	if err := virtualmachineimage.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize VirtualMachineImage controllers: %w", err)
+	}
+	if pkgcfg.FromContext(ctx).Features.VMNetworkPolicies {
+		if err := virtualmachinenetworkpolicy.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineNetworkPolicy controller: %w", err)
+		}
+	}
 
 	if pkgcfg.FromContext(ctx).Features.K8sWorkloadMgmtAPI {
 		if err := virtualmachinereplicaset.AddToManager(ctx, mgr); err != nil {
 			return fmt.Errorf("failed to initialize VirtualMachineReplicaSet controller: %w", err)
 		}
+		if err := virtualmachinedeployment.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineDeployment controller: %w", err)
+		}
+		if err := virtualmachinedisruptionbudget.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineDisruptionBudget controller: %w", err)
+		}
 	}
 
 	if pkgcfg.FromContext(ctx).Features.FastDeploy {
@@ -74,6 +106,15 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		if err := virtualmachinesnapshot.AddToManager(ctx, mgr); err != nil {
 			return fmt.Errorf("failed to initialize VirtualMachineSnapshot controller: %w", err)
 		}
+		if err := virtualmachinesnapshotexport.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSnapshotExport controller: %w", err)
+		}
+		if err := virtualmachinesnapshotimport.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSnapshotImport controller: %w", err)
+		}
+		if err := virtualmachinesnapshotschedule.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSnapshotSchedule controller: %w", err)
+		}
 	}
 
 	if pkgcfg.FromContext(ctx).Features.VMGroups {
@@ -85,6 +126,12 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		}
 	}
 
+	if pkgcfg.FromContext(ctx).Features.VMGroups && pkgcfg.FromContext(ctx).Features.VMSnapshots {
+		if err := virtualmachinegroupsnapshot.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineGroupSnapshot controller: %w", err)
+		}
+	}
+
 	if pkgcfg.FromContext(ctx).Features.VSpherePolicies {
 		if err := vspherepolicy.AddToManager(ctx, mgr); err != nil {
 			return fmt.Errorf("failed to initialize vSphere Policy controllers: %w", err)
@@ -273,6 +273,8 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclasses,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineippools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineipaddressclaims,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=vmware.com,resources=virtualnetworkinterfaces;virtualnetworkinterfaces/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=netoperator.vmware.com,resources=networkinterfaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
@@ -611,12 +613,14 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr
 		// Add the VM to the probe manager. This is idempotent.
 		r.Prober.AddToProberManager(ctx.VM)
 
-	} else if p := ctx.VM.Spec.ReadinessProbe; p != nil && p.TCPSocket != nil {
-		// TCP probes still use the probe manager.
+	} else if vmopv1util.RequiresPeriodicReadinessProbe(*ctx.VM) || ctx.VM.Spec.LivenessProbe != nil {
+		// TCP and HTTP readiness probes, readiness probes that use thresholds
+		// or a startup probe, as well as liveness probes still use the probe
+		// manager.
 		r.Prober.AddToProberManager(ctx.VM)
 	} else {
-		// Remove the probe in case it *was* a TCP probe but switched to one
-		// of the other types.
+		// Remove the probe in case it *was* a TCP or HTTP probe but switched
+		// to one of the other types.
 		r.Prober.RemoveFromProberManager(ctx.VM)
 	}
 
@@ -0,0 +1,293 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedeployment
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+)
+
+var (
+	// deploymentKind contains the schema.GroupVersionKind for the
+	// VirtualMachineDeployment type.
+	deploymentKind = vmopv1.GroupVersion.WithKind("VirtualMachineDeployment")
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineDeployment{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Owns(&vmopv1.VirtualMachineReplicaSet{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineDeployment object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinedeployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinedeployments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinereplicasets,verbs=create;get;list;watch;update;patch;delete
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	d := &vmopv1.VirtualMachineDeployment{}
+	if err := r.Get(ctx, req.NamespacedName, d); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The owned VirtualMachineReplicaSets are garbage collected once the
+	// deployment is deleted, so there is nothing to do here.
+	if !d.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	dCtx := &pkgctx.VirtualMachineDeploymentContext{
+		Context:    ctx,
+		Logger:     pkglog.FromContextOrDefault(ctx),
+		Deployment: d,
+	}
+
+	patchHelper, err := patch.NewHelper(d, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", dCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, d); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			dCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	result, err := r.ReconcileNormal(dCtx)
+	if err != nil {
+		dCtx.Logger.Error(err, "Failed to reconcile VirtualMachineDeployment")
+		return ctrl.Result{}, err
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineDeploymentContext) (ctrl.Result, error) {
+	ctx.Logger.Info("Reconciling VirtualMachineDeployment")
+
+	d := ctx.Deployment
+
+	if d.Spec.Replicas == nil {
+		return ctrl.Result{}, fmt.Errorf(
+			"the Replicas field in Spec for VirtualMachineDeployment %v is nil, this should not be allowed", d.Name)
+	}
+
+	rsList, err := r.getReplicaSetsForDeployment(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if d.Spec.RollbackTo != nil {
+		// The template is updated by the rollback, and patching the
+		// deployment's spec triggers another reconcile that rolls out the
+		// selected revision.
+		r.rollback(ctx, rsList)
+		return ctrl.Result{}, nil
+	}
+
+	newRS, oldRSs := findNewAndOldReplicaSets(d, rsList)
+
+	if d.Spec.Paused {
+		r.updateStatus(ctx, newRS, oldRSs)
+		return ctrl.Result{}, nil
+	}
+
+	var syncErr error
+	switch d.Spec.Strategy.Type {
+	case vmopv1.VirtualMachineDeploymentStrategyTypeRecreate:
+		newRS, syncErr = r.rolloutRecreate(ctx, newRS, oldRSs)
+	default:
+		newRS, syncErr = r.rolloutRolling(ctx, newRS, oldRSs)
+	}
+
+	if syncErr == nil {
+		syncErr = r.cleanupDeployment(ctx, oldRSs)
+	}
+
+	// Update the status of the VirtualMachineDeployment even in case of error
+	// since the rollout might have resulted in replica sets being scaled.
+	r.updateStatus(ctx, newRS, oldRSs)
+
+	if syncErr != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sync VirtualMachineDeployment: %w", syncErr)
+	}
+
+	// Queue faster reconciles until the rollout is complete.
+	if d.Status.UpdatedReplicas != *d.Spec.Replicas ||
+		d.Status.ReadyReplicas != *d.Spec.Replicas ||
+		d.Status.Replicas != *d.Spec.Replicas {
+
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getReplicaSetsForDeployment returns the VirtualMachineReplicaSets that are
+// selected by and controlled by the deployment. Orphaned replica sets that
+// match the deployment's selector are adopted.
+func (r *Reconciler) getReplicaSetsForDeployment(
+	ctx *pkgctx.VirtualMachineDeploymentContext) ([]*vmopv1.VirtualMachineReplicaSet, error) {
+
+	d := ctx.Deployment
+
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to convert VirtualMachineDeployment %q label selector: %w", d.Name, err)
+	}
+
+	rsList := &vmopv1.VirtualMachineReplicaSetList{}
+	if err := r.Client.List(ctx,
+		rsList,
+		client.InNamespace(d.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list VirtualMachineReplicaSets matched by the VirtualMachineDeployment. selector: %q, err: %w",
+			selector.String(), err)
+	}
+
+	filtered := make([]*vmopv1.VirtualMachineReplicaSet, 0, len(rsList.Items))
+	for idx := range rsList.Items {
+		rs := &rsList.Items[idx]
+
+		if metav1.GetControllerOfNoCopy(rs) == nil {
+			if !rs.DeletionTimestamp.IsZero() {
+				continue
+			}
+			if err := r.adoptOrphan(ctx, rs); err != nil {
+				ctx.Logger.Error(err, "VirtualMachineDeployment failed to adopt VirtualMachineReplicaSet", "replicaSet", rs.Name)
+				r.Recorder.Warnf(d, "FailedAdopt", "Failed to adopt VirtualMachineReplicaSet %q: %v", rs.Name, err)
+				continue
+			}
+			ctx.Logger.Info("Adopted VirtualMachineReplicaSet", "replicaSet", rs.Name)
+			r.Recorder.Eventf(d, "SuccessfulAdopt", "Adopted VirtualMachineReplicaSet %q", rs.Name)
+		} else if !metav1.IsControlledBy(rs, d) {
+			// Skip this VirtualMachineReplicaSet if it is controlled by someone
+			// else.
+			continue
+		}
+
+		filtered = append(filtered, rs)
+	}
+
+	return filtered, nil
+}
+
+// adoptOrphan sets the VirtualMachineDeployment as a controller OwnerReference
+// to the VirtualMachineReplicaSet.
+func (r *Reconciler) adoptOrphan(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	rs *vmopv1.VirtualMachineReplicaSet) error {
+
+	patch := client.MergeFrom(rs.DeepCopy())
+	rs.OwnerReferences = append(rs.OwnerReferences, *metav1.NewControllerRef(ctx.Deployment, deploymentKind))
+	return r.Client.Patch(ctx, rs, patch)
+}
+
+// rollback replaces the deployment's template with the template of the
+// VirtualMachineReplicaSet that has the requested revision.
+func (r *Reconciler) rollback(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	rsList []*vmopv1.VirtualMachineReplicaSet) {
+
+	d := ctx.Deployment
+
+	revision := d.Spec.RollbackTo.Revision
+	if revision == 0 {
+		revision = lastRevision(rsList)
+	}
+
+	// The rollback request is always cleared, even when the revision is not
+	// found, otherwise the deployment would never make progress again.
+	d.Spec.RollbackTo = nil
+
+	for _, rs := range rsList {
+		if revision == 0 || getRevision(rs) != revision {
+			continue
+		}
+
+		template := rs.Spec.Template.DeepCopy()
+		delete(template.Labels, vmopv1.VirtualMachineTemplateHashLabel)
+
+		if equalIgnoreHash(&d.Spec.Template, template) {
+			ctx.Logger.Info("Rollback template is the same as the current template", "revision", revision)
+			r.Recorder.Eventf(d, "RollbackTemplateUnchanged",
+				"The rollback revision %d contains the same template as the current one", revision)
+			return
+		}
+
+		ctx.Logger.Info("Rolling back VirtualMachineDeployment", "revision", revision)
+		d.Spec.Template = *template
+		r.Recorder.Eventf(d, "RollbackDone", "Rolled back to revision %d", revision)
+		return
+	}
+
+	ctx.Logger.Info("Unable to find revision to roll back to", "revision", revision)
+	r.Recorder.Warnf(d, "RollbackRevisionNotFound", "Unable to find revision %d to roll back to", revision)
+}
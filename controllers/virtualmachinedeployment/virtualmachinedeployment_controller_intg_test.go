@@ -0,0 +1,85 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedeployment_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx *builder.IntegrationTestContext
+		d   *vmopv1.VirtualMachineDeployment
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		d = builder.DummyVirtualMachineDeployment()
+		d.GenerateName = ""
+		d.Name = "dummy-deployment"
+		d.Namespace = ctx.Namespace
+		d.Spec.Selector.MatchLabels = map[string]string{"appname": "db"}
+		d.Spec.Template.Labels = map[string]string{"appname": "db"}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	getReplicaSets := func(g Gomega) []vmopv1.VirtualMachineReplicaSet {
+		list := &vmopv1.VirtualMachineReplicaSetList{}
+		g.Expect(ctx.Client.List(ctx, list, client.InNamespace(ctx.Namespace))).To(Succeed())
+		return list.Items
+	}
+
+	It("should create and replace VirtualMachineReplicaSets", func() {
+		Expect(ctx.Client.Create(ctx, d)).To(Succeed())
+
+		var oldHash string
+		Eventually(func(g Gomega) {
+			rsList := getReplicaSets(g)
+			g.Expect(rsList).To(HaveLen(1))
+			g.Expect(rsList[0].Labels).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentNameLabel, d.Name))
+			g.Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(1)))
+			oldHash = rsList[0].Labels[vmopv1.VirtualMachineTemplateHashLabel]
+		}).Should(Succeed())
+
+		Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(d), d)).To(Succeed())
+		d.Spec.Template.Spec.ClassName = "new-class"
+		Expect(ctx.Client.Update(ctx, d)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			rsList := getReplicaSets(g)
+			g.Expect(rsList).To(HaveLen(2))
+			for _, rs := range rsList {
+				if rs.Labels[vmopv1.VirtualMachineTemplateHashLabel] != oldHash {
+					g.Expect(rs.Spec.Template.Spec.ClassName).To(Equal("new-class"))
+					g.Expect(rs.Annotations).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentRevisionAnnotation, "2"))
+				}
+			}
+		}).Should(Succeed())
+	})
+}
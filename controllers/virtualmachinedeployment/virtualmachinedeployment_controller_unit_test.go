@@ -0,0 +1,419 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedeployment_test
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinedeployment"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace = "test-namespace"
+		name      = "test-deployment"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinedeployment.Reconciler
+		d          *vmopv1.VirtualMachineDeployment
+	)
+
+	BeforeEach(func() {
+		d = builder.DummyVirtualMachineDeployment()
+		d.GenerateName = ""
+		d.Name = name
+		d.Namespace = namespace
+		d.UID = types.UID(name + "-uid")
+		d.Spec.Replicas = ptr.To(int32(3))
+		d.Spec.Selector.MatchLabels = map[string]string{"app": name}
+		d.Spec.Template.Labels = map[string]string{"app": name}
+
+		initObjects = []client.Object{d}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinedeployment.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcileDeployment := func() {
+		_, err := reconciler.Reconcile(
+			cource.WithContext(ctx),
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: d.Name}})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	}
+
+	getDeployment := func() *vmopv1.VirtualMachineDeployment {
+		obj := &vmopv1.VirtualMachineDeployment{}
+		ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: d.Name}, obj)).To(Succeed())
+		return obj
+	}
+
+	updateDeployment := func(mutateFn func(d *vmopv1.VirtualMachineDeployment)) {
+		obj := getDeployment()
+		mutateFn(obj)
+		ExpectWithOffset(1, ctx.Client.Update(ctx, obj)).To(Succeed())
+	}
+
+	revisionOf := func(rs vmopv1.VirtualMachineReplicaSet) int {
+		v, _ := strconv.Atoi(rs.Annotations[vmopv1.VirtualMachineDeploymentRevisionAnnotation])
+		return v
+	}
+
+	// getReplicaSets returns the replica sets sorted by revision.
+	getReplicaSets := func() []vmopv1.VirtualMachineReplicaSet {
+		list := &vmopv1.VirtualMachineReplicaSetList{}
+		ExpectWithOffset(1, ctx.Client.List(ctx, list, client.InNamespace(namespace))).To(Succeed())
+		slices.SortFunc(list.Items, func(a, b vmopv1.VirtualMachineReplicaSet) int {
+			return revisionOf(a) - revisionOf(b)
+		})
+		return list.Items
+	}
+
+	// setReplicaSetStatus emulates the VirtualMachineReplicaSet controller.
+	setReplicaSetStatus := func(rs vmopv1.VirtualMachineReplicaSet, replicas, ready int32) {
+		rs.Status.Replicas = replicas
+		rs.Status.ReadyReplicas = ready
+		conditions.MarkTrue(&rs, vmopv1.VirtualMachinesCreatedCondition)
+		ExpectWithOffset(1, ctx.Client.Status().Update(ctx, &rs)).To(Succeed())
+	}
+
+	setAllReplicaSetsReady := func() {
+		for _, rs := range getReplicaSets() {
+			setReplicaSetStatus(rs, *rs.Spec.Replicas, *rs.Spec.Replicas)
+		}
+	}
+
+	When("the deployment is created", func() {
+		It("should create a new replica set with the desired replicas", func() {
+			reconcileDeployment()
+
+			rsList := getReplicaSets()
+			Expect(rsList).To(HaveLen(1))
+
+			rs := rsList[0]
+			hash := rs.Labels[vmopv1.VirtualMachineTemplateHashLabel]
+			Expect(hash).ToNot(BeEmpty())
+			Expect(rs.Name).To(Equal(name + "-" + hash))
+			Expect(rs.Labels).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentNameLabel, name))
+			Expect(rs.Labels).To(HaveKeyWithValue("app", name))
+			Expect(rs.Spec.Selector.MatchLabels).To(HaveKeyWithValue(vmopv1.VirtualMachineTemplateHashLabel, hash))
+			Expect(rs.Spec.Template.Labels).To(HaveKeyWithValue(vmopv1.VirtualMachineTemplateHashLabel, hash))
+			Expect(rs.Annotations).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentRevisionAnnotation, "1"))
+			Expect(rs.Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+			Expect(metav1.IsControlledBy(&rs, d)).To(BeTrue())
+
+			obj := getDeployment()
+			Expect(obj.Status.Revision).To(BeEquivalentTo(1))
+			c := conditions.Get(obj, vmopv1.VirtualMachineDeploymentConditionRolledOut)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.NewReplicaSetCreatedReason))
+			Expect(conditions.IsFalse(obj, vmopv1.VirtualMachineDeploymentConditionAvailable)).To(BeTrue())
+		})
+
+		It("should mark the deployment rolled out once all replicas are ready", func() {
+			reconcileDeployment()
+			setAllReplicaSetsReady()
+			reconcileDeployment()
+
+			obj := getDeployment()
+			Expect(obj.Status.Replicas).To(BeEquivalentTo(3))
+			Expect(obj.Status.UpdatedReplicas).To(BeEquivalentTo(3))
+			Expect(obj.Status.ReadyReplicas).To(BeEquivalentTo(3))
+			Expect(obj.Status.UnavailableReplicas).To(BeZero())
+			Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineDeploymentConditionRolledOut)).To(BeTrue())
+			Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineDeploymentConditionAvailable)).To(BeTrue())
+		})
+	})
+
+	When("the deployment name is long", func() {
+		BeforeEach(func() {
+			d.Name = strings.Repeat("a", validation.DNS1123SubdomainMaxLength)
+		})
+
+		It("should truncate the name of the new replica set", func() {
+			reconcileDeployment()
+
+			rsList := getReplicaSets()
+			Expect(rsList).To(HaveLen(1))
+
+			hash := rsList[0].Labels[vmopv1.VirtualMachineTemplateHashLabel]
+			Expect(hash).ToNot(BeEmpty())
+			Expect(rsList[0].Name).To(HaveLen(validation.DNS1123SubdomainMaxLength))
+			Expect(rsList[0].Name).To(HaveSuffix("a-" + hash))
+		})
+	})
+
+	When("an old replica set has the name of the new replica set", func() {
+		var oldName string
+
+		JustBeforeEach(func() {
+			reconcileDeployment()
+
+			// Change the template of the replica set so it no longer matches
+			// the deployment's template, but keep its name.
+			rsList := getReplicaSets()
+			Expect(rsList).To(HaveLen(1))
+			rs := rsList[0]
+			oldName = rs.Name
+			rs.Spec.Template.Spec.ClassName = "other-class"
+			Expect(ctx.Client.Update(ctx, &rs)).To(Succeed())
+		})
+
+		It("should not adopt the replica set and create one with a new name", func() {
+			_, err := reconciler.Reconcile(
+				cource.WithContext(ctx),
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+			Expect(err).To(MatchError(ContainSubstring("hash collision")))
+			Expect(getDeployment().Status.CollisionCount).To(HaveValue(BeEquivalentTo(1)))
+
+			reconcileDeployment()
+
+			rsList := getReplicaSets()
+			Expect(rsList).To(HaveLen(2))
+			Expect(rsList[0].Name).To(Equal(oldName))
+			Expect(rsList[0].Spec.Template.Spec.ClassName).To(Equal("other-class"))
+			Expect(rsList[1].Name).ToNot(Equal(oldName))
+			Expect(rsList[1].Spec.Template.Spec.ClassName).To(Equal(d.Spec.Template.Spec.ClassName))
+			Expect(rsList[1].Name).To(Equal(name + "-" + rsList[1].Labels[vmopv1.VirtualMachineTemplateHashLabel]))
+		})
+	})
+
+	When("the template is changed", func() {
+		JustBeforeEach(func() {
+			reconcileDeployment()
+			setAllReplicaSetsReady()
+			reconcileDeployment()
+
+			updateDeployment(func(d *vmopv1.VirtualMachineDeployment) {
+				d.Spec.Template.Spec.ClassName = "new-class"
+			})
+		})
+
+		It("should gradually roll out a new replica set", func() {
+			By("surging the new replica set", func() {
+				reconcileDeployment()
+
+				rsList := getReplicaSets()
+				Expect(rsList).To(HaveLen(2))
+				Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+				Expect(rsList[1].Spec.Replicas).To(HaveValue(BeEquivalentTo(1)))
+				Expect(rsList[1].Annotations).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentRevisionAnnotation, "2"))
+				Expect(rsList[1].Spec.Template.Spec.ClassName).To(Equal("new-class"))
+			})
+
+			By("waiting for the new replicas to be ready", func() {
+				setReplicaSetStatus(getReplicaSets()[1], 1, 0)
+				reconcileDeployment()
+
+				rsList := getReplicaSets()
+				Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+				Expect(rsList[1].Spec.Replicas).To(HaveValue(BeEquivalentTo(1)))
+
+				obj := getDeployment()
+				c := conditions.Get(obj, vmopv1.VirtualMachineDeploymentConditionRolledOut)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.ReplicaSetUpdatedReason))
+			})
+
+			By("scaling down the old replica set", func() {
+				setReplicaSetStatus(getReplicaSets()[1], 1, 1)
+				reconcileDeployment()
+
+				rsList := getReplicaSets()
+				Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+			})
+
+			By("completing the rollout", func() {
+				for range 5 {
+					setAllReplicaSetsReady()
+					reconcileDeployment()
+				}
+
+				rsList := getReplicaSets()
+				Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(0)))
+				Expect(rsList[1].Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+
+				setAllReplicaSetsReady()
+				reconcileDeployment()
+
+				obj := getDeployment()
+				Expect(obj.Status.Revision).To(BeEquivalentTo(2))
+				Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineDeploymentConditionRolledOut)).To(BeTrue())
+			})
+		})
+
+		When("the rollout does not allow surging", func() {
+			BeforeEach(func() {
+				d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+					MaxSurge:       ptr.To(intstr.FromInt32(0)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				}
+			})
+
+			It("should scale down an old replica before creating a new one", func() {
+				reconcileDeployment()
+
+				rsList := getReplicaSets()
+				Expect(rsList).To(HaveLen(2))
+				Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+				Expect(rsList[1].Spec.Replicas).To(HaveValue(BeEquivalentTo(0)))
+			})
+		})
+
+		When("the strategy is Recreate", func() {
+			BeforeEach(func() {
+				d.Spec.Strategy.Type = vmopv1.VirtualMachineDeploymentStrategyTypeRecreate
+			})
+
+			It("should delete the old replicas before creating new ones", func() {
+				reconcileDeployment()
+
+				rsList := getReplicaSets()
+				Expect(rsList).To(HaveLen(1))
+				Expect(rsList[0].Spec.Replicas).To(HaveValue(BeEquivalentTo(0)))
+
+				// The old replicas are still being deleted.
+				reconcileDeployment()
+				Expect(getReplicaSets()).To(HaveLen(1))
+
+				setReplicaSetStatus(getReplicaSets()[0], 0, 0)
+				reconcileDeployment()
+
+				rsList = getReplicaSets()
+				Expect(rsList).To(HaveLen(2))
+				Expect(rsList[1].Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+			})
+		})
+
+		When("the deployment is paused", func() {
+			It("should not roll out the new template", func() {
+				updateDeployment(func(d *vmopv1.VirtualMachineDeployment) {
+					d.Spec.Paused = true
+				})
+				reconcileDeployment()
+
+				Expect(getReplicaSets()).To(HaveLen(1))
+
+				obj := getDeployment()
+				c := conditions.Get(obj, vmopv1.VirtualMachineDeploymentConditionRolledOut)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.DeploymentPausedReason))
+			})
+		})
+	})
+
+	When("the deployment is rolled back", func() {
+		JustBeforeEach(func() {
+			reconcileDeployment()
+			setAllReplicaSetsReady()
+
+			updateDeployment(func(d *vmopv1.VirtualMachineDeployment) {
+				d.Spec.Template.Spec.ClassName = "new-class"
+			})
+			reconcileDeployment()
+		})
+
+		It("should restore the template of the previous revision", func() {
+			updateDeployment(func(d *vmopv1.VirtualMachineDeployment) {
+				d.Spec.RollbackTo = &vmopv1.VirtualMachineDeploymentRollback{}
+			})
+			reconcileDeployment()
+
+			obj := getDeployment()
+			Expect(obj.Spec.RollbackTo).To(BeNil())
+			Expect(obj.Spec.Template.Spec.ClassName).To(Equal(d.Spec.Template.Spec.ClassName))
+			Expect(obj.Spec.Template.Labels).ToNot(HaveKey(vmopv1.VirtualMachineTemplateHashLabel))
+
+			By("reusing the old replica set as the newest revision", func() {
+				reconcileDeployment()
+
+				rsList := getReplicaSets()
+				Expect(rsList).To(HaveLen(2))
+				Expect(rsList[1].Spec.Template.Spec.ClassName).To(Equal(d.Spec.Template.Spec.ClassName))
+				Expect(rsList[1].Annotations).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentRevisionAnnotation, "3"))
+			})
+		})
+
+		It("should ignore an unknown revision", func() {
+			updateDeployment(func(d *vmopv1.VirtualMachineDeployment) {
+				d.Spec.RollbackTo = &vmopv1.VirtualMachineDeploymentRollback{Revision: 42}
+			})
+			reconcileDeployment()
+
+			obj := getDeployment()
+			Expect(obj.Spec.RollbackTo).To(BeNil())
+			Expect(obj.Spec.Template.Spec.ClassName).To(Equal("new-class"))
+		})
+	})
+
+	When("there are more old replica sets than the revision history limit", func() {
+		BeforeEach(func() {
+			d.Spec.RevisionHistoryLimit = ptr.To(int32(1))
+			d.Spec.Strategy.Type = vmopv1.VirtualMachineDeploymentStrategyTypeRecreate
+			d.Spec.Replicas = ptr.To(int32(0))
+		})
+
+		It("should delete the oldest replica sets", func() {
+			for _, className := range []string{"class-a", "class-b", "class-c"} {
+				updateDeployment(func(d *vmopv1.VirtualMachineDeployment) {
+					d.Spec.Template.Spec.ClassName = className
+				})
+				reconcileDeployment()
+			}
+
+			rsList := getReplicaSets()
+			Expect(rsList).To(HaveLen(2))
+			Expect(rsList[0].Spec.Template.Spec.ClassName).To(Equal("class-b"))
+			Expect(rsList[1].Spec.Template.Spec.ClassName).To(Equal("class-c"))
+		})
+	})
+}
@@ -0,0 +1,689 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedeployment
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+const (
+	// defaultRevisionHistoryLimit is the number of old replica sets retained
+	// when the deployment does not specify a revision history limit.
+	defaultRevisionHistoryLimit = 10
+)
+
+var (
+	// defaultMaxSurge and defaultMaxUnavailable are used when the deployment
+	// does not specify the parameters of its rolling update.
+	defaultMaxSurge       = intstr.FromString("25%")
+	defaultMaxUnavailable = intstr.FromString("25%")
+)
+
+// computeTemplateHash returns a hash of the template and collision count that
+// is safe to use as a label value and an object name suffix.
+func computeTemplateHash(
+	template *vmopv1.VirtualMachineTemplateSpec,
+	collisionCount *int32) string {
+
+	t := template.DeepCopy()
+	delete(t.Labels, vmopv1.VirtualMachineTemplateHashLabel)
+
+	// Marshaling the template cannot fail since it only consists of types
+	// that are serialized by the API server.
+	data, _ := json.Marshal(t)
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(data)
+
+	// The collision count is added to the hash so a new name is computed
+	// when the name collides with an existing replica set.
+	if collisionCount != nil {
+		_, _ = hasher.Write([]byte(strconv.FormatInt(int64(*collisionCount), 10)))
+	}
+
+	return rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+}
+
+// getReplicaSetName returns the name of the deployment's replica set with the
+// provided template hash. The name of the deployment is truncated if the name
+// would exceed the maximum length of an object name.
+func getReplicaSetName(deploymentName, hash string) string {
+	suffix := "-" + hash
+	if maxLen := validation.DNS1123SubdomainMaxLength - len(suffix); len(deploymentName) > maxLen {
+		deploymentName = strings.TrimRight(deploymentName[:maxLen], "-.")
+	}
+	return deploymentName + suffix
+}
+
+// equalIgnoreHash returns true if the two templates are semantically equal
+// when the template hash label is ignored.
+func equalIgnoreHash(a, b *vmopv1.VirtualMachineTemplateSpec) bool {
+	a, b = a.DeepCopy(), b.DeepCopy()
+	delete(a.Labels, vmopv1.VirtualMachineTemplateHashLabel)
+	delete(b.Labels, vmopv1.VirtualMachineTemplateHashLabel)
+	if len(a.Labels) == 0 {
+		a.Labels = nil
+	}
+	if len(b.Labels) == 0 {
+		b.Labels = nil
+	}
+	return apiequality.Semantic.DeepEqual(a, b)
+}
+
+// findNewAndOldReplicaSets returns the oldest replica set whose template
+// matches the deployment's current template, if any, and the remaining
+// replica sets. The templates are compared instead of the template hash
+// labels, since replica sets with different templates may have the same hash.
+func findNewAndOldReplicaSets(
+	d *vmopv1.VirtualMachineDeployment,
+	rsList []*vmopv1.VirtualMachineReplicaSet) (*vmopv1.VirtualMachineReplicaSet, []*vmopv1.VirtualMachineReplicaSet) {
+
+	rsList = slices.Clone(rsList)
+	sortOldestFirst(rsList)
+
+	var (
+		newRS  *vmopv1.VirtualMachineReplicaSet
+		oldRSs []*vmopv1.VirtualMachineReplicaSet
+	)
+
+	for _, rs := range rsList {
+		if newRS == nil && equalIgnoreHash(&d.Spec.Template, &rs.Spec.Template) {
+			newRS = rs
+			continue
+		}
+		oldRSs = append(oldRSs, rs)
+	}
+
+	return newRS, oldRSs
+}
+
+// getRevision returns the revision of the replica set, or zero if the
+// replica set does not have a valid revision.
+func getRevision(rs *vmopv1.VirtualMachineReplicaSet) int64 {
+	v, err := strconv.ParseInt(rs.Annotations[vmopv1.VirtualMachineDeploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// maxRevision returns the highest revision of the replica sets.
+func maxRevision(rsList []*vmopv1.VirtualMachineReplicaSet) int64 {
+	var m int64
+	for _, rs := range rsList {
+		m = max(m, getRevision(rs))
+	}
+	return m
+}
+
+// lastRevision returns the second highest revision of the replica sets,
+// i.e. the revision prior to the current one.
+func lastRevision(rsList []*vmopv1.VirtualMachineReplicaSet) int64 {
+	var m, secondMax int64
+	for _, rs := range rsList {
+		switch v := getRevision(rs); {
+		case v > m:
+			secondMax, m = m, v
+		case v > secondMax && v != m:
+			secondMax = v
+		}
+	}
+	return secondMax
+}
+
+// resolveFenceposts returns the absolute values of the deployment's maxSurge
+// and maxUnavailable. Both values cannot be zero, so maxUnavailable is set to
+// one if they are.
+func resolveFenceposts(d *vmopv1.VirtualMachineDeployment) (int32, int32, error) {
+	var (
+		replicas       = int(ptr.Deref(d.Spec.Replicas))
+		maxSurge       = defaultMaxSurge
+		maxUnavailable = defaultMaxUnavailable
+	)
+
+	if ru := d.Spec.Strategy.RollingUpdate; ru != nil {
+		if ru.MaxSurge != nil {
+			maxSurge = *ru.MaxSurge
+		}
+		if ru.MaxUnavailable != nil {
+			maxUnavailable = *ru.MaxUnavailable
+		}
+	}
+
+	surge, err := intstr.GetScaledValueFromIntOrPercent(&maxSurge, replicas, true)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxSurge: %w", err)
+	}
+	unavailable, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, replicas, false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxUnavailable: %w", err)
+	}
+
+	if surge == 0 && unavailable == 0 {
+		unavailable = 1
+	}
+
+	return int32(surge), int32(unavailable), nil //nolint:gosec // disable G115
+}
+
+// getMaxUnavailable returns the number of replicas that may be unavailable
+// without the deployment losing its availability.
+func getMaxUnavailable(d *vmopv1.VirtualMachineDeployment) int32 {
+	replicas := ptr.Deref(d.Spec.Replicas)
+	if d.Spec.Strategy.Type == vmopv1.VirtualMachineDeploymentStrategyTypeRecreate || replicas == 0 {
+		return 0
+	}
+	_, maxUnavailable, _ := resolveFenceposts(d)
+	return min(maxUnavailable, replicas)
+}
+
+func specReplicas(rsList ...*vmopv1.VirtualMachineReplicaSet) int32 {
+	var n int32
+	for _, rs := range rsList {
+		if rs != nil {
+			n += ptr.Deref(rs.Spec.Replicas)
+		}
+	}
+	return n
+}
+
+func statusReplicas(rsList ...*vmopv1.VirtualMachineReplicaSet) int32 {
+	var n int32
+	for _, rs := range rsList {
+		if rs != nil {
+			n += rs.Status.Replicas
+		}
+	}
+	return n
+}
+
+func readyReplicas(rsList ...*vmopv1.VirtualMachineReplicaSet) int32 {
+	var n int32
+	for _, rs := range rsList {
+		if rs != nil {
+			n += rs.Status.ReadyReplicas
+		}
+	}
+	return n
+}
+
+// sortOldestFirst sorts the replica sets by their creation time, oldest first.
+func sortOldestFirst(rsList []*vmopv1.VirtualMachineReplicaSet) {
+	slices.SortStableFunc(rsList, func(a, b *vmopv1.VirtualMachineReplicaSet) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+// getOrCreateNewReplicaSet returns the replica set that matches the
+// deployment's current template, creating it with the provided number of
+// replicas if it does not exist.
+func (r *Reconciler) getOrCreateNewReplicaSet(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	newRS *vmopv1.VirtualMachineReplicaSet,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet,
+	replicas int32) (*vmopv1.VirtualMachineReplicaSet, error) {
+
+	d := ctx.Deployment
+	newRevision := maxRevision(oldRSs) + 1
+
+	if newRS != nil {
+		// The new replica set may be an old one that is being reused, ex.
+		// after a rollback, in which case it becomes the newest revision.
+		revisionNeedsUpdate := getRevision(newRS) < newRevision
+		deletePolicyNeedsUpdate := newRS.Spec.DeletePolicy != d.Spec.DeletePolicy
+		if !revisionNeedsUpdate && !deletePolicyNeedsUpdate {
+			return newRS, nil
+		}
+
+		patch := client.MergeFrom(newRS.DeepCopy())
+		if revisionNeedsUpdate {
+			if newRS.Annotations == nil {
+				newRS.Annotations = map[string]string{}
+			}
+			newRS.Annotations[vmopv1.VirtualMachineDeploymentRevisionAnnotation] = strconv.FormatInt(newRevision, 10)
+		}
+		newRS.Spec.DeletePolicy = d.Spec.DeletePolicy
+
+		if err := r.Client.Patch(ctx, newRS, patch); err != nil {
+			return nil, fmt.Errorf("failed to update VirtualMachineReplicaSet %q: %w", newRS.Name, err)
+		}
+
+		return newRS, nil
+	}
+
+	hash := computeTemplateHash(&d.Spec.Template, d.Status.CollisionCount)
+
+	labels := make(map[string]string, len(d.Spec.Template.Labels)+2)
+	maps.Copy(labels, d.Spec.Template.Labels)
+	labels[vmopv1.VirtualMachineTemplateHashLabel] = hash
+	// MustFormatValue is used here as the value of this label will be a hash
+	// if the VirtualMachineDeployment name is longer than max allowed label
+	// value length of 63 characters.
+	labels[vmopv1.VirtualMachineDeploymentNameLabel] = pkgutil.MustFormatValue(d.Name)
+
+	selector := d.Spec.Selector.DeepCopy()
+	if selector.MatchLabels == nil {
+		selector.MatchLabels = map[string]string{}
+	}
+	selector.MatchLabels[vmopv1.VirtualMachineTemplateHashLabel] = hash
+
+	template := d.Spec.Template.DeepCopy()
+	if template.Labels == nil {
+		template.Labels = map[string]string{}
+	}
+	template.Labels[vmopv1.VirtualMachineTemplateHashLabel] = hash
+
+	rs := &vmopv1.VirtualMachineReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getReplicaSetName(d.Name, hash),
+			Namespace: d.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				vmopv1.VirtualMachineDeploymentRevisionAnnotation:        strconv.FormatInt(newRevision, 10),
+				vmopv1.VirtualMachineDeploymentDesiredReplicasAnnotation: strconv.Itoa(int(*d.Spec.Replicas)),
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(d, deploymentKind)},
+		},
+		Spec: vmopv1.VirtualMachineReplicaSetSpec{
+			Replicas:     ptr.To(replicas),
+			Selector:     selector,
+			Template:     *template,
+			DeletePolicy: d.Spec.DeletePolicy,
+		},
+	}
+
+	ctx.Logger.Info("Creating new VirtualMachineReplicaSet",
+		"replicaSet", rs.Name, "revision", newRevision, "replicas", replicas)
+
+	if err := r.Client.Create(ctx, rs); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return r.handleReplicaSetCollision(ctx, rs)
+		}
+		r.Recorder.Warnf(d, "FailedCreate", "Failed to create VirtualMachineReplicaSet %q: %v", rs.Name, err)
+		return nil, fmt.Errorf("failed to create VirtualMachineReplicaSet %q: %w", rs.Name, err)
+	}
+
+	r.Recorder.Eventf(d, "SuccessfulCreate", "Created VirtualMachineReplicaSet %q with %d replicas", rs.Name, replicas)
+
+	return rs, nil
+}
+
+// handleReplicaSetCollision is called when the new replica set could not be
+// created because an object with its name already exists. The existing replica
+// set is returned if it is the deployment's replica set for the current
+// template, which happens when it is not in the cache yet. Otherwise, the
+// deployment's collision count is incremented so a different name is computed
+// on the next reconcile.
+func (r *Reconciler) handleReplicaSetCollision(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	rs *vmopv1.VirtualMachineReplicaSet) (*vmopv1.VirtualMachineReplicaSet, error) {
+
+	d := ctx.Deployment
+
+	existing := &vmopv1.VirtualMachineReplicaSet{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(rs), existing); err != nil {
+		return nil, fmt.Errorf("failed to get VirtualMachineReplicaSet %q: %w", rs.Name, err)
+	}
+
+	if metav1.IsControlledBy(existing, d) && equalIgnoreHash(&d.Spec.Template, &existing.Spec.Template) {
+		return existing, nil
+	}
+
+	d.Status.CollisionCount = ptr.To(ptr.Deref(d.Status.CollisionCount) + 1)
+
+	ctx.Logger.Info("VirtualMachineReplicaSet name collision",
+		"replicaSet", rs.Name, "collisionCount", *d.Status.CollisionCount)
+	r.Recorder.Warnf(d, "HashCollision",
+		"VirtualMachineReplicaSet %q already exists with a different template or owner", rs.Name)
+
+	return nil, fmt.Errorf("hash collision for VirtualMachineReplicaSet %q, collision count is now %d",
+		rs.Name, *d.Status.CollisionCount)
+}
+
+// scaleReplicaSet updates the number of replicas of the replica set as well
+// as the annotation that records the desired replicas of the deployment.
+// It returns true if the number of replicas was changed.
+func (r *Reconciler) scaleReplicaSet(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	rs *vmopv1.VirtualMachineReplicaSet,
+	newScale int32) (bool, error) {
+
+	d := ctx.Deployment
+	desired := strconv.Itoa(int(*d.Spec.Replicas))
+	oldScale := ptr.Deref(rs.Spec.Replicas)
+
+	sizeNeedsUpdate := rs.Spec.Replicas == nil || oldScale != newScale
+	annotationNeedsUpdate := rs.Annotations[vmopv1.VirtualMachineDeploymentDesiredReplicasAnnotation] != desired
+	if !sizeNeedsUpdate && !annotationNeedsUpdate {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(rs.DeepCopy())
+	rs.Spec.Replicas = ptr.To(newScale)
+	if rs.Annotations == nil {
+		rs.Annotations = map[string]string{}
+	}
+	rs.Annotations[vmopv1.VirtualMachineDeploymentDesiredReplicasAnnotation] = desired
+
+	if err := r.Client.Patch(ctx, rs, patch); err != nil {
+		r.Recorder.Warnf(d, "FailedScale", "Failed to scale VirtualMachineReplicaSet %q to %d: %v", rs.Name, newScale, err)
+		return false, fmt.Errorf("failed to scale VirtualMachineReplicaSet %q: %w", rs.Name, err)
+	}
+
+	if sizeNeedsUpdate {
+		ctx.Logger.Info("Scaled VirtualMachineReplicaSet",
+			"replicaSet", rs.Name, "oldReplicas", oldScale, "newReplicas", newScale)
+		r.Recorder.Eventf(d, "ScalingReplicaSet", "Scaled VirtualMachineReplicaSet %q from %d to %d", rs.Name, oldScale, newScale)
+	}
+
+	return sizeNeedsUpdate, nil
+}
+
+// rolloutRecreate scales down all of the old replica sets before the new one
+// is created and scaled up.
+func (r *Reconciler) rolloutRecreate(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	newRS *vmopv1.VirtualMachineReplicaSet,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet) (*vmopv1.VirtualMachineReplicaSet, error) {
+
+	var scaledDown bool
+	for _, rs := range oldRSs {
+		if ptr.Deref(rs.Spec.Replicas) == 0 {
+			continue
+		}
+		scaled, err := r.scaleReplicaSet(ctx, rs, 0)
+		if err != nil {
+			return newRS, err
+		}
+		scaledDown = scaledDown || scaled
+	}
+
+	if scaledDown {
+		return newRS, nil
+	}
+
+	// Wait until all of the old replicas are deleted.
+	if statusReplicas(oldRSs...) > 0 {
+		ctx.Logger.V(4).Info("Waiting for old VirtualMachineReplicaSets to scale down")
+		return newRS, nil
+	}
+
+	replicas := *ctx.Deployment.Spec.Replicas
+
+	newRS, err := r.getOrCreateNewReplicaSet(ctx, newRS, oldRSs, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.scaleReplicaSet(ctx, newRS, replicas); err != nil {
+		return newRS, err
+	}
+
+	return newRS, nil
+}
+
+// rolloutRolling gradually scales up the new replica set and scales down the
+// old ones while respecting the deployment's maxSurge and maxUnavailable.
+func (r *Reconciler) rolloutRolling(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	newRS *vmopv1.VirtualMachineReplicaSet,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet) (*vmopv1.VirtualMachineReplicaSet, error) {
+
+	d := ctx.Deployment
+
+	maxSurge, maxUnavailable, err := resolveFenceposts(d)
+	if err != nil {
+		return newRS, err
+	}
+
+	if newRS == nil {
+		replicas := newRSNewReplicas(d, maxSurge, oldRSs, 0)
+		if newRS, err = r.getOrCreateNewReplicaSet(ctx, nil, oldRSs, replicas); err != nil {
+			return nil, err
+		}
+	} else if newRS, err = r.getOrCreateNewReplicaSet(ctx, newRS, oldRSs, 0); err != nil {
+		return nil, err
+	}
+
+	// Scale up the new replica set, if possible.
+	replicas := *d.Spec.Replicas
+	newScale := replicas
+	if cur := ptr.Deref(newRS.Spec.Replicas); cur < replicas {
+		newScale = newRSNewReplicas(d, maxSurge, oldRSs, cur)
+	}
+	scaledUp, err := r.scaleReplicaSet(ctx, newRS, newScale)
+	if err != nil {
+		return newRS, err
+	}
+	if scaledUp {
+		return newRS, nil
+	}
+
+	// Scale down the old replica sets, if possible.
+	if err := r.reconcileOldReplicaSets(ctx, newRS, oldRSs, maxUnavailable); err != nil {
+		return newRS, err
+	}
+
+	return newRS, nil
+}
+
+// newRSNewReplicas returns the number of replicas the new replica set may be
+// scaled to without exceeding the deployment's replicas plus maxSurge.
+func newRSNewReplicas(
+	d *vmopv1.VirtualMachineDeployment,
+	maxSurge int32,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet,
+	newRSReplicas int32) int32 {
+
+	replicas := *d.Spec.Replicas
+	current := specReplicas(oldRSs...) + newRSReplicas
+	maxTotal := replicas + maxSurge
+	if current >= maxTotal {
+		return newRSReplicas
+	}
+	return newRSReplicas + min(maxTotal-current, replicas-newRSReplicas)
+}
+
+// reconcileOldReplicaSets scales down the old replica sets while keeping at
+// least the deployment's replicas minus maxUnavailable ready.
+func (r *Reconciler) reconcileOldReplicaSets(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	newRS *vmopv1.VirtualMachineReplicaSet,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet,
+	maxUnavailable int32) error {
+
+	if specReplicas(oldRSs...) == 0 {
+		return nil
+	}
+
+	var (
+		allReplicas      = specReplicas(oldRSs...) + specReplicas(newRS)
+		minAvailable     = *ctx.Deployment.Spec.Replicas - maxUnavailable
+		newRSUnavailable = max(specReplicas(newRS)-readyReplicas(newRS), 0)
+		maxScaledDown    = allReplicas - minAvailable - newRSUnavailable
+	)
+
+	if maxScaledDown <= 0 {
+		return nil
+	}
+
+	sortOldestFirst(oldRSs)
+
+	// Scale down the unhealthy replicas of the old replica sets first since
+	// doing so does not decrease the number of ready replicas.
+	var cleanedUp int32
+	for _, rs := range oldRSs {
+		if cleanedUp >= maxScaledDown {
+			break
+		}
+		cur := ptr.Deref(rs.Spec.Replicas)
+		unhealthy := cur - rs.Status.ReadyReplicas
+		if cur == 0 || unhealthy <= 0 {
+			continue
+		}
+		scaleDown := min(maxScaledDown-cleanedUp, unhealthy)
+		if _, err := r.scaleReplicaSet(ctx, rs, cur-scaleDown); err != nil {
+			return err
+		}
+		cleanedUp += scaleDown
+	}
+
+	// Scale down the ready replicas of the old replica sets as long as the
+	// minimum number of ready replicas is available.
+	available := readyReplicas(oldRSs...) + readyReplicas(newRS)
+	if available <= minAvailable {
+		return nil
+	}
+
+	var (
+		scaledDown          int32
+		totalScaleDownCount = available - minAvailable
+	)
+	for _, rs := range oldRSs {
+		if scaledDown >= totalScaleDownCount {
+			break
+		}
+		cur := ptr.Deref(rs.Spec.Replicas)
+		if cur == 0 {
+			continue
+		}
+		scaleDown := min(cur, totalScaleDownCount-scaledDown)
+		if _, err := r.scaleReplicaSet(ctx, rs, cur-scaleDown); err != nil {
+			return err
+		}
+		scaledDown += scaleDown
+	}
+
+	return nil
+}
+
+// cleanupDeployment deletes the old replica sets that exceed the deployment's
+// revision history limit, lowest revisions first.
+func (r *Reconciler) cleanupDeployment(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet) error {
+
+	d := ctx.Deployment
+	limit := int(ptr.DerefWithDefault(d.Spec.RevisionHistoryLimit, defaultRevisionHistoryLimit))
+
+	var cleanable []*vmopv1.VirtualMachineReplicaSet
+	for _, rs := range oldRSs {
+		if ptr.Deref(rs.Spec.Replicas) == 0 && rs.Status.Replicas == 0 && rs.DeletionTimestamp.IsZero() {
+			cleanable = append(cleanable, rs)
+		}
+	}
+
+	diff := len(cleanable) - limit
+	if diff <= 0 {
+		return nil
+	}
+
+	slices.SortStableFunc(cleanable, func(a, b *vmopv1.VirtualMachineReplicaSet) int {
+		return cmp.Compare(getRevision(a), getRevision(b))
+	})
+
+	for _, rs := range cleanable[:diff] {
+		ctx.Logger.Info("Deleting old VirtualMachineReplicaSet", "replicaSet", rs.Name, "revision", getRevision(rs))
+		if err := r.Client.Delete(ctx, rs); client.IgnoreNotFound(err) != nil {
+			r.Recorder.Warnf(d, "FailedDelete", "Failed to delete VirtualMachineReplicaSet %q: %v", rs.Name, err)
+			return fmt.Errorf("failed to delete VirtualMachineReplicaSet %q: %w", rs.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// updateStatus updates the Status field of the VirtualMachineDeployment.
+func (r *Reconciler) updateStatus(
+	ctx *pkgctx.VirtualMachineDeploymentContext,
+	newRS *vmopv1.VirtualMachineReplicaSet,
+	oldRSs []*vmopv1.VirtualMachineReplicaSet) {
+
+	d := ctx.Deployment
+	desired := *d.Spec.Replicas
+
+	allRSs := slices.Clone(oldRSs)
+	if newRS != nil {
+		allRSs = append(allRSs, newRS)
+	}
+
+	d.Status.ObservedGeneration = d.Generation
+	d.Status.Revision = maxRevision(allRSs)
+	d.Status.Replicas = statusReplicas(allRSs...)
+	d.Status.UpdatedReplicas = statusReplicas(newRS)
+	d.Status.ReadyReplicas = readyReplicas(allRSs...)
+	d.Status.UnavailableReplicas = max(desired-d.Status.ReadyReplicas, 0)
+
+	minAvailable := desired - getMaxUnavailable(d)
+	if d.Status.ReadyReplicas >= minAvailable {
+		conditions.MarkTrue(d, vmopv1.VirtualMachineDeploymentConditionAvailable)
+	} else {
+		conditions.MarkFalse(
+			d,
+			vmopv1.VirtualMachineDeploymentConditionAvailable,
+			vmopv1.MinimumReplicasUnavailableReason,
+			"%d of %d replicas are ready, %d required",
+			d.Status.ReadyReplicas,
+			desired,
+			minAvailable)
+	}
+
+	switch {
+	case d.Spec.Paused:
+		conditions.MarkFalse(
+			d,
+			vmopv1.VirtualMachineDeploymentConditionRolledOut,
+			vmopv1.DeploymentPausedReason,
+			"VirtualMachineDeployment is paused")
+	case newRS != nil && len(newRS.Status.Conditions) == 0:
+		// The new replica set has not yet been reconciled.
+		conditions.MarkFalse(
+			d,
+			vmopv1.VirtualMachineDeploymentConditionRolledOut,
+			vmopv1.NewReplicaSetCreatedReason,
+			"Created new VirtualMachineReplicaSet %q",
+			newRS.Name)
+	case d.Status.UpdatedReplicas == desired &&
+		d.Status.Replicas == desired &&
+		readyReplicas(newRS) == desired:
+
+		if conditions.IsFalse(d, vmopv1.VirtualMachineDeploymentConditionRolledOut) {
+			ctx.Logger.Info("VirtualMachineDeployment has been rolled out", "revision", d.Status.Revision)
+		}
+		conditions.MarkTrue(d, vmopv1.VirtualMachineDeploymentConditionRolledOut)
+	default:
+		conditions.MarkFalse(
+			d,
+			vmopv1.VirtualMachineDeploymentConditionRolledOut,
+			vmopv1.ReplicaSetUpdatedReason,
+			"%d of %d replicas have been updated",
+			d.Status.UpdatedReplicas,
+			desired)
+	}
+}
@@ -0,0 +1,211 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedisruptionbudget
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineDisruptionBudget{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.VMToDisruptionBudgets(ctx)),
+		).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// VMToDisruptionBudgets is a mapper function to be used to enqueue requests
+// for reconciliation for the VirtualMachineDisruptionBudgets that select a VM.
+func (r *Reconciler) VMToDisruptionBudgets(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vm, ok := o.(*vmopv1.VirtualMachine)
+		if !ok {
+			panic(fmt.Sprintf("Expected a VirtualMachine, but got a %T", o))
+		}
+
+		var budgetList vmopv1.VirtualMachineDisruptionBudgetList
+		if err := r.List(ctx, &budgetList, client.InNamespace(vm.Namespace)); err != nil {
+			ctx.Logger.Error(err, "Failed listing VirtualMachineDisruptionBudgets for VM")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, budget := range budgetList.Items {
+			selector, err := vmopv1util.GetDisruptionBudgetSelector(budget)
+			if err != nil || !selector.Matches(labels.Set(vm.Labels)) {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&budget),
+			})
+		}
+
+		return requests
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineDisruptionBudget object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinedisruptionbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinedisruptionbudgets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	budget := &vmopv1.VirtualMachineDisruptionBudget{}
+	if err := r.Get(ctx, req.NamespacedName, budget); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !budget.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	dbCtx := &pkgctx.VirtualMachineDisruptionBudgetContext{
+		Context:          ctx,
+		Logger:           pkglog.FromContextOrDefault(ctx),
+		DisruptionBudget: budget,
+	}
+
+	patchHelper, err := patch.NewHelper(budget, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", dbCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, budget); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			dbCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	if err := r.ReconcileNormal(dbCtx); err != nil {
+		dbCtx.Logger.Error(err, "Failed to reconcile VirtualMachineDisruptionBudget")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcileNormal updates the status of the budget with the number of selected
+// VMs that are healthy and the number of disruptions currently allowed.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineDisruptionBudgetContext) error {
+	budget := ctx.DisruptionBudget
+	budget.Status.ObservedGeneration = budget.Generation
+
+	selector, err := vmopv1util.GetDisruptionBudgetSelector(*budget)
+	if err != nil {
+		conditions.MarkError(budget, vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed, "InvalidSelector", err)
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+
+	var vmList vmopv1.VirtualMachineList
+	if err := r.List(
+		ctx,
+		&vmList,
+		client.InNamespace(budget.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+
+		return fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	var currentHealthy int32
+	for i := range vmList.Items {
+		if vmopv1util.IsVirtualMachineHealthy(&vmList.Items[i]) {
+			currentHealthy++
+		}
+	}
+
+	expected := int32(len(vmList.Items)) //nolint:gosec // disable G115
+	desiredHealthy, err := vmopv1util.GetDisruptionBudgetDesiredHealthy(*budget, expected)
+	if err != nil {
+		conditions.MarkError(budget, vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed, "InvalidSpec", err)
+		return err
+	}
+
+	budget.Status.ExpectedVirtualMachines = expected
+	budget.Status.CurrentHealthy = currentHealthy
+	budget.Status.DesiredHealthy = desiredHealthy
+	budget.Status.DisruptionsAllowed = max(currentHealthy-desiredHealthy, 0)
+
+	if budget.Status.DisruptionsAllowed > 0 {
+		conditions.MarkTrue(budget, vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed)
+	} else {
+		conditions.MarkFalse(
+			budget,
+			vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed,
+			vmopv1.InsufficientHealthyVirtualMachinesReason,
+			"%d of %d virtual machines are healthy and %d must remain healthy",
+			currentHealthy, expected, desiredHealthy)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedisruptionbudget_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx    *builder.IntegrationTestContext
+		budget *vmopv1.VirtualMachineDisruptionBudget
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		budget = &vmopv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-budget",
+				Namespace: ctx.Namespace,
+			},
+			Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"appname": "db"},
+				},
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should update the status as selected VMs are created", func() {
+		Expect(ctx.Client.Create(ctx, budget)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(budget), budget)).To(Succeed())
+			g.Expect(budget.Status.ExpectedVirtualMachines).To(BeZero())
+			g.Expect(conditions.IsFalse(budget, vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed)).To(BeTrue())
+		}).Should(Succeed())
+
+		vm := builder.DummyBasicVirtualMachine("dummy-vm", ctx.Namespace)
+		vm.Labels = map[string]string{"appname": "db"}
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(budget), budget)).To(Succeed())
+			g.Expect(budget.Status.ExpectedVirtualMachines).To(BeEquivalentTo(1))
+		}).Should(Succeed())
+	})
+}
@@ -0,0 +1,158 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinedisruptionbudget_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinedisruptionbudget"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace  = "test-namespace"
+		budgetName = "test-budget"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinedisruptionbudget.Reconciler
+		budget     *vmopv1.VirtualMachineDisruptionBudget
+	)
+
+	newVM := func(name string, ready bool) *vmopv1.VirtualMachine {
+		vm := builder.DummyBasicVirtualMachine(name, namespace)
+		vm.Labels = map[string]string{"app": "db"}
+		if ready {
+			conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+		} else {
+			conditions.MarkFalse(vm, vmopv1.ReadyConditionType, "NotReady", "")
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		budget = &vmopv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      budgetName,
+				Namespace: namespace,
+			},
+			Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+				MinAvailable: ptr.To(intstr.FromString("50%")),
+			},
+		}
+
+		unselected := newVM("vm-web", true)
+		unselected.Labels = map[string]string{"app": "web"}
+
+		initObjects = []client.Object{
+			budget,
+			newVM("vm-1", true),
+			newVM("vm-2", true),
+			newVM("vm-3", false),
+			unselected,
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinedisruptionbudget.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcileBudget := func() error {
+		_, err := reconciler.Reconcile(
+			cource.WithContext(ctx),
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: budgetName}})
+		return err
+	}
+
+	It("updates the status from the selected VMs", func() {
+		Expect(reconcileBudget()).To(Succeed())
+		Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(budget), budget)).To(Succeed())
+
+		Expect(budget.Status.ExpectedVirtualMachines).To(Equal(int32(3)))
+		Expect(budget.Status.CurrentHealthy).To(Equal(int32(2)))
+		Expect(budget.Status.DesiredHealthy).To(Equal(int32(2)))
+		Expect(budget.Status.DisruptionsAllowed).To(BeZero())
+
+		c := conditions.Get(budget, vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed)
+		Expect(c).ToNot(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionFalse))
+		Expect(c.Reason).To(Equal(vmopv1.InsufficientHealthyVirtualMachinesReason))
+	})
+
+	When("enough VMs are healthy", func() {
+		BeforeEach(func() {
+			budget.Spec.MinAvailable = nil
+			budget.Spec.MaxUnavailable = ptr.To(intstr.FromInt32(2))
+		})
+
+		It("allows disruptions", func() {
+			Expect(reconcileBudget()).To(Succeed())
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(budget), budget)).To(Succeed())
+
+			Expect(budget.Status.DesiredHealthy).To(Equal(int32(1)))
+			Expect(budget.Status.DisruptionsAllowed).To(Equal(int32(1)))
+			Expect(conditions.IsTrue(budget, vmopv1.VirtualMachineDisruptionBudgetConditionDisruptionAllowed)).To(BeTrue())
+		})
+	})
+
+	When("a VM is being powered off", func() {
+		BeforeEach(func() {
+			vm := newVM("vm-4", true)
+			vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+			initObjects = append(initObjects, vm)
+		})
+
+		It("does not count the VM as healthy", func() {
+			Expect(reconcileBudget()).To(Succeed())
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(budget), budget)).To(Succeed())
+
+			Expect(budget.Status.ExpectedVirtualMachines).To(Equal(int32(4)))
+			Expect(budget.Status.CurrentHealthy).To(Equal(int32(2)))
+		})
+	})
+}
@@ -118,6 +118,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinegroups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinegroups/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinegroupsnapshots,verbs=get;list;watch
 
 // Reconcile reconciles a VirtualMachineGroup object.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -213,6 +214,11 @@ func (r *Reconciler) ReconcileNormal(
 		return reterr
 	}
 
+	if err := r.reconcileSnapshotRevert(ctx); err != nil {
+		reterr = fmt.Errorf("failed to reconcile group snapshot revert: %w", err)
+		return reterr
+	}
+
 	if err := r.reconcilePlacement(ctx); err != nil {
 		reterr = fmt.Errorf("failed to reconcile group placement: %w", err)
 		return reterr
@@ -289,6 +295,20 @@ func (r *Reconciler) reconcileMembers(
 		applyPowerOnTime = lastUpdateAnnoTime
 	}
 
+	// Powering off or suspending the group's VMs disrupts them, so the
+	// VirtualMachineDisruptionBudgets in the namespace must be honored.
+	var disruptionChecker *vmopv1util.DisruptionChecker
+	if updatePowerState {
+		switch ctx.VMGroup.Spec.PowerState {
+		case vmopv1.VirtualMachinePowerStateOff, vmopv1.VirtualMachinePowerStateSuspended:
+			disruptionChecker, err = vmopv1util.NewDisruptionChecker(
+				ctx, r.Client, ctx.VMGroup.Namespace)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	var (
 		memberStatuses = []vmopv1.VirtualMachineGroupMemberStatus{}
 		memberErrs     = []error{}
@@ -316,6 +336,7 @@ func (r *Reconciler) reconcileMembers(
 
 			if err := r.reconcileMember(
 				ctx, member, ms, updatePowerState, applyPowerOnTime,
+				disruptionChecker,
 			); err != nil {
 				memberErrs = append(memberErrs, err)
 			}
@@ -338,12 +359,15 @@ func (r *Reconciler) reconcileMembers(
 }
 
 // reconcileMember reconciles a group member and updates the member's status.
+// The power state of a VM member is not updated if disruptionChecker is
+// non-nil and disrupting the VM would violate a VirtualMachineDisruptionBudget.
 func (r *Reconciler) reconcileMember(
 	ctx *pkgctx.VirtualMachineGroupContext,
 	member vmopv1.GroupMember,
 	ms *vmopv1.VirtualMachineGroupMemberStatus,
 	updatePowerState bool,
 	applyPowerOnTime time.Time,
+	disruptionChecker *vmopv1util.DisruptionChecker,
 ) error {
 
 	var obj vmopv1util.VirtualMachineOrGroup
@@ -354,7 +378,10 @@ func (r *Reconciler) reconcileMember(
 		obj = &vmopv1.VirtualMachineGroup{}
 	}
 
-	memberKindAndName := member.Kind + "/" + member.Name
+	var (
+		memberKindAndName = member.Kind + "/" + member.Name
+		disruptionErr     error
+	)
 
 	if err := r.Get(ctx, client.ObjectKey{
 		Namespace: ctx.VMGroup.Namespace,
@@ -468,7 +495,19 @@ func (r *Reconciler) reconcileMember(
 		}
 	}
 
-	if updatePowerState {
+	if updatePowerState && disruptionChecker != nil && member.Kind == vmKind {
+		if err := disruptionChecker.Disrupt(obj.(*vmopv1.VirtualMachine)); err != nil {
+			conditions.MarkError(
+				ms,
+				vmopv1.VirtualMachineGroupMemberConditionPowerStateSynced,
+				vmopv1.DisruptionBudgetExceededReason,
+				err,
+			)
+			disruptionErr = err
+		}
+	}
+
+	if updatePowerState && disruptionErr == nil {
 		// Update power state specs for both member types (VMs and VM Groups).
 		updateMemberPowerState(*ctx.VMGroup, obj, applyPowerOnTime)
 	}
@@ -503,9 +542,304 @@ patchMember:
 		conditions.SetMirror(ms, vmopv1.ReadyConditionType, obj)
 	}
 
+	// Return the error so the group's power state continues to be applied
+	// until the disruption budget allows the member to be disrupted.
+	return disruptionErr
+}
+
+// reconcileSnapshotRevert reverts the group's members to the
+// VirtualMachineGroupSnapshot specified by the group's spec.currentSnapshotName.
+//
+// Each VM member is reverted to its VirtualMachineSnapshot from the group
+// snapshot and each nested group member is reverted to the same group
+// snapshot. The members are annotated with the time at which their power
+// state may be applied, so the members that are powered on after the revert
+// are powered on in the group's boot order. Once all of the members have been
+// reverted, spec.currentSnapshotName is cleared and status.currentSnapshotName
+// is set.
+func (r *Reconciler) reconcileSnapshotRevert(
+	ctx *pkgctx.VirtualMachineGroupContext) error {
+
+	var (
+		vmGroup      = ctx.VMGroup
+		snapshotName = vmGroup.Spec.CurrentSnapshotName
+	)
+
+	if snapshotName == "" {
+		for i := range vmGroup.Status.Members {
+			conditions.Delete(
+				&vmGroup.Status.Members[i],
+				vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+			)
+		}
+		conditions.Delete(vmGroup, vmopv1.VirtualMachineSnapshotRevertSucceeded)
+		return nil
+	}
+
+	groupSnapshot := &vmopv1.VirtualMachineGroupSnapshot{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: vmGroup.Namespace,
+		Name:      snapshotName,
+	}, groupSnapshot); err != nil {
+		conditions.MarkError(
+			vmGroup,
+			vmopv1.VirtualMachineSnapshotRevertSucceeded,
+			vmopv1.VirtualMachineSnapshotRevertFailedReason,
+			err,
+		)
+		return fmt.Errorf("failed to get group snapshot %q: %w", snapshotName, err)
+	}
+
+	if !conditions.IsTrue(groupSnapshot, vmopv1.ReadyConditionType) {
+		conditions.MarkFalse(
+			vmGroup,
+			vmopv1.VirtualMachineSnapshotRevertSucceeded,
+			vmopv1.VirtualMachineSnapshotRevertFailedReason,
+			"group snapshot %q is not ready",
+			snapshotName,
+		)
+		return fmt.Errorf("group snapshot %q is not ready", snapshotName)
+	}
+
+	vmSnapshotNames := make(map[string]string, len(groupSnapshot.Status.Members))
+	for _, m := range groupSnapshot.Status.Members {
+		vmSnapshotNames[m.VMName] = m.SnapshotName
+	}
+
+	// Get the group's apply power state change time that may be set from its
+	// parent group when the parent group is reverted.
+	applyPowerOnTime := time.Now().UTC()
+	if v := vmGroup.Annotations[constants.ApplyPowerStateTimeAnnotation]; v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			ctx.Logger.Error(err, "Failed to parse time from annotation",
+				"annotationKey", constants.ApplyPowerStateTimeAnnotation,
+				"annotationValue", v)
+			return err
+		}
+		if t.After(applyPowerOnTime) {
+			applyPowerOnTime = t
+		}
+	}
+
+	var (
+		revertedCount int
+		memberErrs    []error
+	)
+
+	for _, bootOrder := range vmGroup.Spec.BootOrder {
+		if bootOrder.PowerOnDelay != nil {
+			applyPowerOnTime = applyPowerOnTime.Add(bootOrder.PowerOnDelay.Duration)
+		}
+
+		for _, member := range bootOrder.Members {
+			_, ms := findMemberStatus(member.Name, member.Kind, vmGroup.Status.Members)
+			if ms == nil {
+				memberErrs = append(memberErrs,
+					fmt.Errorf("member %s/%s has no status", member.Kind, member.Name))
+				continue
+			}
+
+			if conditions.IsTrue(ms, vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted) {
+				revertedCount++
+				continue
+			}
+
+			var memberSnapshotName string
+			switch member.Kind {
+			case vmKind:
+				memberSnapshotName = vmSnapshotNames[member.Name]
+				if memberSnapshotName == "" {
+					err := fmt.Errorf("group snapshot %q does not have a snapshot of member %q",
+						snapshotName, member.Name)
+					conditions.MarkError(
+						ms,
+						vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+						"NotFound",
+						err,
+					)
+					memberErrs = append(memberErrs, err)
+					continue
+				}
+			case vmgKind:
+				memberSnapshotName = snapshotName
+			}
+
+			reverted, err := r.reconcileMemberSnapshotRevert(
+				ctx, member, ms, memberSnapshotName, applyPowerOnTime)
+			if err != nil {
+				memberErrs = append(memberErrs, err)
+			} else if reverted {
+				revertedCount++
+			}
+		}
+	}
+
+	if len(memberErrs) > 0 {
+		err := apierrorsutil.NewAggregate(memberErrs)
+		conditions.MarkError(
+			vmGroup,
+			vmopv1.VirtualMachineSnapshotRevertSucceeded,
+			vmopv1.VirtualMachineSnapshotRevertFailedReason,
+			err,
+		)
+		return err
+	}
+
+	if total := len(vmGroup.Status.Members); revertedCount < total {
+		conditions.MarkFalse(
+			vmGroup,
+			vmopv1.VirtualMachineSnapshotRevertSucceeded,
+			vmopv1.VirtualMachineSnapshotRevertInProgressReason,
+			"%d of %d members reverted",
+			revertedCount, total,
+		)
+		return nil
+	}
+
+	ctx.Logger.Info("Reverted group to snapshot", "snapshotName", snapshotName)
+
+	vmGroup.Spec.CurrentSnapshotName = ""
+	vmGroup.Status.CurrentSnapshotName = snapshotName
+	for i := range vmGroup.Status.Members {
+		conditions.Delete(
+			&vmGroup.Status.Members[i],
+			vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+		)
+	}
+	conditions.Delete(vmGroup, vmopv1.VirtualMachineSnapshotRevertSucceeded)
+
 	return nil
 }
 
+// reconcileMemberSnapshotRevert starts reverting a group member to the given
+// snapshot if it has not already been started, and returns true if the member
+// has been reverted.
+func (r *Reconciler) reconcileMemberSnapshotRevert(
+	ctx *pkgctx.VirtualMachineGroupContext,
+	member vmopv1.GroupMember,
+	ms *vmopv1.VirtualMachineGroupMemberStatus,
+	snapshotName string,
+	applyPowerOnTime time.Time) (bool, error) {
+
+	var obj vmopv1util.VirtualMachineOrGroup
+	switch member.Kind {
+	case vmKind:
+		obj = &vmopv1.VirtualMachine{}
+	case vmgKind:
+		obj = &vmopv1.VirtualMachineGroup{}
+	}
+
+	memberKindAndName := member.Kind + "/" + member.Name
+
+	// Use the API reader to get the member since the revert is only complete
+	// once the member's status has been updated, and the cached member could
+	// be stale.
+	if err := r.APIReader.Get(ctx, client.ObjectKey{
+		Namespace: ctx.VMGroup.Namespace,
+		Name:      member.Name,
+	}, obj); err != nil {
+		conditions.MarkError(
+			ms,
+			vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+			"Error",
+			err,
+		)
+		return false, fmt.Errorf("failed to get group member %q: %w",
+			memberKindAndName, err)
+	}
+
+	var (
+		currentSnapshotName  string
+		observedSnapshotName string
+		revertCondition      *metav1.Condition
+	)
+
+	switch obj := obj.(type) {
+	case *vmopv1.VirtualMachine:
+		currentSnapshotName = obj.Spec.CurrentSnapshotName
+		if obj.Status.CurrentSnapshot != nil {
+			observedSnapshotName = obj.Status.CurrentSnapshot.Name
+		}
+		revertCondition = conditions.Get(obj, vmopv1.VirtualMachineSnapshotRevertSucceeded)
+	case *vmopv1.VirtualMachineGroup:
+		currentSnapshotName = obj.Spec.CurrentSnapshotName
+		observedSnapshotName = obj.Status.CurrentSnapshotName
+		revertCondition = conditions.Get(obj, vmopv1.VirtualMachineSnapshotRevertSucceeded)
+	}
+
+	if conditions.Has(ms, vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted) {
+		// The revert was already started, check if it is complete.
+		if currentSnapshotName == "" && observedSnapshotName == snapshotName {
+			conditions.MarkTrue(
+				ms,
+				vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+			)
+			return true, nil
+		}
+
+		// Surface the reason the member has not been reverted yet.
+		if c := revertCondition; c != nil && c.Status == metav1.ConditionFalse {
+			conditions.MarkFalse(
+				ms,
+				vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+				c.Reason,
+				"%s",
+				c.Message,
+			)
+		}
+		return false, nil
+	}
+
+	if currentSnapshotName != "" && currentSnapshotName != snapshotName {
+		err := fmt.Errorf("member %q is being reverted to snapshot %q",
+			memberKindAndName, currentSnapshotName)
+		conditions.MarkError(
+			ms,
+			vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+			"RevertInProgress",
+			err,
+		)
+		return false, err
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(vmopv1util.VirtualMachineOrGroup))
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[constants.ApplyPowerStateTimeAnnotation] = applyPowerOnTime.Format(time.RFC3339Nano)
+	obj.SetAnnotations(annotations)
+
+	switch obj := obj.(type) {
+	case *vmopv1.VirtualMachine:
+		obj.Spec.CurrentSnapshotName = snapshotName
+	case *vmopv1.VirtualMachineGroup:
+		obj.Spec.CurrentSnapshotName = snapshotName
+	}
+
+	if err := r.Patch(ctx, obj, patch); err != nil {
+		conditions.MarkError(
+			ms,
+			vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+			"PatchError",
+			err,
+		)
+		return false, fmt.Errorf("failed to patch group member %q: %w",
+			memberKindAndName, err)
+	}
+
+	conditions.MarkFalse(
+		ms,
+		vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted,
+		vmopv1.VirtualMachineSnapshotRevertInProgressReason,
+		"",
+	)
+
+	return false, nil
+}
+
 // reconcilePlacement reconciles and updates the placement status of
 // the members of the group and its children groups.
 //
@@ -28,6 +28,7 @@ var suite = builder.NewTestSuiteForControllerWithContext(
 		ctx.VMProvider = intgFakeVMProvider
 		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
 			config.Features.VSpherePolicies = true
+			config.Features.K8sWorkloadMgmtAPI = true
 		})
 		return nil
 	})
@@ -19,6 +19,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	vspherepolv1 "github.com/vmware-tanzu/vm-operator/external/vsphere-policy/api/v1alpha1"
@@ -26,6 +27,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -878,6 +880,70 @@ var _ = Describe(
 				})
 			})
 
+			When("power off the group would violate a disruption budget", func() {
+				BeforeEach(func() {
+					vm1 := &vmopv1.VirtualMachine{}
+					Expect(ctx.Client.Get(ctx, vm1Key, vm1)).To(Succeed())
+					vm1Copy := vm1.DeepCopy()
+					vm1Copy.Labels = map[string]string{"app": "db"}
+					Expect(ctx.Client.Patch(ctx, vm1Copy, client.MergeFrom(vm1))).To(Succeed())
+
+					Expect(ctx.Client.Get(ctx, vm1Key, vm1)).To(Succeed())
+					vm1Copy = vm1.DeepCopy()
+					vm1Copy.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+					conditions.MarkTrue(vm1Copy, vmopv1.ReadyConditionType)
+					Expect(ctx.Client.Status().Patch(ctx, vm1Copy, client.MergeFrom(vm1))).To(Succeed())
+
+					budget := &vmopv1.VirtualMachineDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "db-budget",
+							Namespace: ctx.Namespace,
+						},
+						Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "db"},
+							},
+							MinAvailable: ptr.To(intstr.FromInt32(1)),
+						},
+					}
+					Expect(ctx.Client.Create(ctx, budget)).To(Succeed())
+
+					vmGroup1 := &vmopv1.VirtualMachineGroup{}
+					Expect(ctx.Client.Get(ctx, vmGroup1Key, vmGroup1)).To(Succeed())
+					vmGroup1Copy := vmGroup1.DeepCopy()
+					vmGroup1Copy.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+					// Mimic mutating webhook to set last updated power state time annotation.
+					vmGroup1Copy.Annotations = map[string]string{
+						constants.LastUpdatedPowerStateTimeAnnotation: updateGroupPowerStateTime.Format(time.RFC3339),
+					}
+					Expect(ctx.Client.Patch(ctx, vmGroup1Copy, client.MergeFrom(vmGroup1))).To(Succeed())
+				})
+
+				It("should not power off the member selected by the budget", func() {
+					Eventually(func(g Gomega) {
+						vmGroup1 := &vmopv1.VirtualMachineGroup{}
+						g.Expect(ctx.Client.Get(ctx, vmGroup1Key, vmGroup1)).To(Succeed())
+						g.Expect(vmGroup1.Status.Members).To(HaveLen(2))
+						for _, ms := range vmGroup1.Status.Members {
+							if ms.Kind == virtualMachineKind {
+								g.Expect(conditions.IsFalse(&ms, vmopv1.VirtualMachineGroupMemberConditionPowerStateSynced)).To(BeTrue())
+								g.Expect(conditions.GetReason(&ms, vmopv1.VirtualMachineGroupMemberConditionPowerStateSynced)).To(Equal(vmopv1.DisruptionBudgetExceededReason))
+							}
+						}
+						g.Expect(vmGroup1.Status.LastUpdatedPowerStateTime).To(BeNil())
+
+						vm1 := &vmopv1.VirtualMachine{}
+						g.Expect(ctx.Client.Get(ctx, vm1Key, vm1)).To(Succeed())
+						g.Expect(vm1.Spec.PowerState).ToNot(Equal(vmopv1.VirtualMachinePowerStateOff))
+
+						By("other members should still be powered off")
+						vmGroup2 := &vmopv1.VirtualMachineGroup{}
+						g.Expect(ctx.Client.Get(ctx, vmGroup2Key, vmGroup2)).To(Succeed())
+						g.Expect(vmGroup2.Spec.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOff))
+					}, "5s", "100ms").Should(Succeed())
+				})
+			})
+
 			When("power on the group with delay", func() {
 				const (
 					bootOrder1Delay = 1 * time.Minute
@@ -1030,6 +1096,157 @@ var _ = Describe(
 			})
 		})
 
+		Context("SnapshotRevert", func() {
+			const (
+				bootOrder1Delay = 1 * time.Minute
+				bootOrder2Delay = 2 * time.Minute
+			)
+
+			var (
+				groupSnapshotName string
+				revertTime        time.Time
+			)
+
+			snapshotNameForVM := func(vmName string) string {
+				return groupSnapshotName + "-" + vmName
+			}
+
+			completeVMRevert := func(vmKey types.NamespacedName) {
+				GinkgoHelper()
+				vm := &vmopv1.VirtualMachine{}
+				Expect(ctx.Client.Get(ctx, vmKey, vm)).To(Succeed())
+				vmCopy := vm.DeepCopy()
+				vmCopy.Spec.CurrentSnapshotName = ""
+				Expect(ctx.Client.Patch(ctx, vmCopy, client.MergeFrom(vm))).To(Succeed())
+
+				vmStatusCopy := vmCopy.DeepCopy()
+				vmStatusCopy.Status.CurrentSnapshot = &vmopv1.VirtualMachineSnapshotReference{
+					Type: vmopv1.VirtualMachineSnapshotReferenceTypeManaged,
+					Name: snapshotNameForVM(vmKey.Name),
+				}
+				Expect(ctx.Client.Status().Patch(ctx, vmStatusCopy, client.MergeFrom(vmCopy))).To(Succeed())
+			}
+
+			BeforeEach(func() {
+				groupSnapshotName = "vmgs-" + uuid.NewString()
+
+				By("setting up group-1 with members vm-1 and vmgroup-2")
+				setupGroupWithMembers(vmGroup1Key, []vmopv1.VirtualMachineGroupBootOrderGroup{
+					{
+						Members: []vmopv1.GroupMember{
+							{Kind: virtualMachineKind, Name: vm1Key.Name},
+						},
+						PowerOnDelay: &metav1.Duration{Duration: bootOrder1Delay},
+					},
+					{
+						Members: []vmopv1.GroupMember{
+							{Kind: virtualMachineGroupKind, Name: vmGroup2Key.Name},
+						},
+						PowerOnDelay: &metav1.Duration{Duration: bootOrder2Delay},
+					},
+				})
+
+				By("setting up group-2 with members vm-2")
+				setupGroupWithMembers(vmGroup2Key, []vmopv1.VirtualMachineGroupBootOrderGroup{
+					{
+						Members: []vmopv1.GroupMember{
+							{Kind: virtualMachineKind, Name: vm2Key.Name},
+						},
+					},
+				}, vmGroup1Key.Name)
+
+				assignVMToGroup(vm1Key, vmGroup1Key.Name)
+				assignVMToGroup(vm2Key, vmGroup2Key.Name)
+
+				By("creating a ready group snapshot of group-1")
+				groupSnapshot := builder.DummyVirtualMachineGroupSnapshot(ctx.Namespace, groupSnapshotName, vmGroup1Key.Name)
+				Expect(ctx.Client.Create(ctx, groupSnapshot)).To(Succeed())
+				groupSnapshotCopy := groupSnapshot.DeepCopy()
+				groupSnapshotCopy.Status.Members = []vmopv1.VirtualMachineGroupSnapshotMemberStatus{
+					{
+						VMName:       vm1Key.Name,
+						SnapshotName: snapshotNameForVM(vm1Key.Name),
+					},
+					{
+						VMName:       vm2Key.Name,
+						SnapshotName: snapshotNameForVM(vm2Key.Name),
+						BootOrder:    1,
+					},
+				}
+				conditions.MarkTrue(groupSnapshotCopy, vmopv1.ReadyConditionType)
+				Expect(ctx.Client.Status().Patch(ctx, groupSnapshotCopy, client.MergeFrom(groupSnapshot))).To(Succeed())
+
+				By("waiting for the members to be linked to their groups")
+				Eventually(func(g Gomega) {
+					for _, key := range []types.NamespacedName{vmGroup1Key, vmGroup2Key} {
+						vmGroup := &vmopv1.VirtualMachineGroup{}
+						g.Expect(ctx.Client.Get(ctx, key, vmGroup)).To(Succeed())
+						g.Expect(vmGroup.Status.Members).To(HaveLen(1))
+						g.Expect(conditions.IsTrue(&vmGroup.Status.Members[0], vmopv1.VirtualMachineGroupMemberConditionGroupLinked)).To(BeTrue())
+					}
+				}, "5s", "100ms").Should(Succeed())
+
+				By("reverting group-1 to the group snapshot")
+				revertTime = time.Now()
+				vmGroup1 := &vmopv1.VirtualMachineGroup{}
+				Expect(ctx.Client.Get(ctx, vmGroup1Key, vmGroup1)).To(Succeed())
+				vmGroup1Copy := vmGroup1.DeepCopy()
+				vmGroup1Copy.Spec.CurrentSnapshotName = groupSnapshotName
+				Expect(ctx.Client.Patch(ctx, vmGroup1Copy, client.MergeFrom(vmGroup1))).To(Succeed())
+			})
+
+			It("should revert all members with the expected power-on delay", func() {
+				Eventually(func(g Gomega) {
+					By("group members CRs should be reverted")
+					vm1 := &vmopv1.VirtualMachine{}
+					g.Expect(ctx.Client.Get(ctx, vm1Key, vm1)).To(Succeed())
+					g.Expect(vm1.Spec.CurrentSnapshotName).To(Equal(snapshotNameForVM(vm1Key.Name)))
+					vm1ApplyPowerStateTime, err := time.Parse(time.RFC3339Nano, vm1.Annotations[constants.ApplyPowerStateTimeAnnotation])
+					g.Expect(err).ToNot(HaveOccurred())
+					g.Expect(vm1ApplyPowerStateTime).To(BeTemporally("~", revertTime.Add(bootOrder1Delay), 5*time.Second))
+
+					vmGroup2 := &vmopv1.VirtualMachineGroup{}
+					g.Expect(ctx.Client.Get(ctx, vmGroup2Key, vmGroup2)).To(Succeed())
+					g.Expect(vmGroup2.Spec.CurrentSnapshotName).To(Equal(groupSnapshotName))
+					vmGroup2ApplyPowerStateTime, err := time.Parse(time.RFC3339Nano, vmGroup2.Annotations[constants.ApplyPowerStateTimeAnnotation])
+					g.Expect(err).ToNot(HaveOccurred())
+					// Boot order delay is cumulative.
+					bootOrder2DelayCum := bootOrder1Delay + bootOrder2Delay
+					g.Expect(vmGroup2ApplyPowerStateTime).To(BeTemporally("~", revertTime.Add(bootOrder2DelayCum), 5*time.Second))
+
+					vm2 := &vmopv1.VirtualMachine{}
+					g.Expect(ctx.Client.Get(ctx, vm2Key, vm2)).To(Succeed())
+					g.Expect(vm2.Spec.CurrentSnapshotName).To(Equal(snapshotNameForVM(vm2Key.Name)))
+					vm2ApplyPowerStateTime, err := time.Parse(time.RFC3339Nano, vm2.Annotations[constants.ApplyPowerStateTimeAnnotation])
+					g.Expect(err).ToNot(HaveOccurred())
+					g.Expect(vm2ApplyPowerStateTime).To(BeTemporally("~", revertTime.Add(bootOrder2DelayCum), 5*time.Second))
+
+					By("group should have the revert in progress")
+					vmGroup1 := &vmopv1.VirtualMachineGroup{}
+					g.Expect(ctx.Client.Get(ctx, vmGroup1Key, vmGroup1)).To(Succeed())
+					g.Expect(conditions.GetReason(vmGroup1, vmopv1.VirtualMachineSnapshotRevertSucceeded)).To(
+						Equal(vmopv1.VirtualMachineSnapshotRevertInProgressReason))
+				}, "5s", "100ms").Should(Succeed())
+
+				By("completing the revert of the VMs")
+				completeVMRevert(vm1Key)
+				completeVMRevert(vm2Key)
+
+				Eventually(func(g Gomega) {
+					for _, key := range []types.NamespacedName{vmGroup1Key, vmGroup2Key} {
+						vmGroup := &vmopv1.VirtualMachineGroup{}
+						g.Expect(ctx.Client.Get(ctx, key, vmGroup)).To(Succeed())
+						g.Expect(vmGroup.Spec.CurrentSnapshotName).To(BeEmpty())
+						g.Expect(vmGroup.Status.CurrentSnapshotName).To(Equal(groupSnapshotName))
+						g.Expect(conditions.Has(vmGroup, vmopv1.VirtualMachineSnapshotRevertSucceeded)).To(BeFalse())
+						for _, ms := range vmGroup.Status.Members {
+							g.Expect(conditions.Has(&ms, vmopv1.VirtualMachineGroupMemberConditionSnapshotReverted)).To(BeFalse())
+						}
+					}
+				}, "5s", "100ms").Should(Succeed())
+			})
+		})
+
 		Context("PolicyEvaluation", func() {
 			var policyEvalKey types.NamespacedName
 
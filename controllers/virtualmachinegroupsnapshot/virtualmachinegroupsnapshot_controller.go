@@ -0,0 +1,433 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinegroupsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineGroupSnapshot{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Owns(&vmopv1.VirtualMachineSnapshot{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineGroupSnapshot object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinegroupsnapshots,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinegroupsnapshots/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshots,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinegroups,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	groupSnapshot := &vmopv1.VirtualMachineGroupSnapshot{}
+	if err := r.Get(ctx, req.NamespacedName, groupSnapshot); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The VirtualMachineSnapshots are owned by the group snapshot and are
+	// deleted by the garbage collector.
+	if !groupSnapshot.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	groupSnapshotCtx := &pkgctx.VirtualMachineGroupSnapshotContext{
+		Context:       ctx,
+		Logger:        pkglog.FromContextOrDefault(ctx),
+		GroupSnapshot: groupSnapshot,
+	}
+
+	patchHelper, err := patch.NewHelper(groupSnapshot, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", groupSnapshotCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, groupSnapshot); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			groupSnapshotCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	return pkgerr.ResultFromError(r.ReconcileNormal(groupSnapshotCtx))
+}
+
+// ReconcileNormal creates a VirtualMachineSnapshot of each of the VMs in the
+// group and updates the group snapshot's status from the
+// VirtualMachineSnapshots.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineGroupSnapshotContext) error {
+	ctx.Logger.Info("Reconciling VirtualMachineGroupSnapshot")
+
+	if len(ctx.GroupSnapshot.Status.Members) == 0 {
+		if err := r.reconcileMembers(ctx); err != nil {
+			return err
+		}
+	}
+
+	snapshots, err := r.getSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+
+	failures, err := r.reconcileSnapshots(ctx, snapshots)
+
+	r.reconcileStatus(ctx, snapshots, failures)
+
+	return err
+}
+
+// reconcileMembers records the VMs in the group, in the group's boot order,
+// along with the names of their VirtualMachineSnapshots. The members are only
+// determined once so the group snapshot is not affected by later changes to
+// the group.
+func (r *Reconciler) reconcileMembers(ctx *pkgctx.VirtualMachineGroupSnapshotContext) error {
+	groupSnapshot := ctx.GroupSnapshot
+
+	bootOrder, err := vmopv1util.RetrieveVMGroupBootOrder(
+		ctx,
+		r.Client,
+		client.ObjectKey{
+			Namespace: groupSnapshot.Namespace,
+			Name:      groupSnapshot.Spec.GroupName,
+		},
+		&sets.Set[string]{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(
+				groupSnapshot,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineGroupSnapshotGroupNotFoundReason,
+				"%s",
+				err)
+		} else {
+			conditions.MarkError(
+				groupSnapshot,
+				vmopv1.ReadyConditionType,
+				"Error",
+				err)
+		}
+		return fmt.Errorf("failed to get members of group %q: %w", groupSnapshot.Spec.GroupName, err)
+	}
+
+	var members []vmopv1.VirtualMachineGroupSnapshotMemberStatus
+	for i, vmNames := range bootOrder {
+		slices.Sort(vmNames)
+		for _, vmName := range vmNames {
+			members = append(members, vmopv1.VirtualMachineGroupSnapshotMemberStatus{
+				VMName:       vmName,
+				SnapshotName: GetSnapshotName(groupSnapshot.Name, vmName),
+				BootOrder:    int32(i), //nolint:gosec // disable G115
+			})
+		}
+	}
+
+	if len(members) == 0 {
+		conditions.MarkFalse(
+			groupSnapshot,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineGroupSnapshotNoMembersReason,
+			"group %q has no virtual machines",
+			groupSnapshot.Spec.GroupName)
+		return fmt.Errorf("group %q has no virtual machines", groupSnapshot.Spec.GroupName)
+	}
+
+	groupSnapshot.Status.Members = members
+
+	return nil
+}
+
+// getSnapshots returns the VirtualMachineSnapshots owned by the group
+// snapshot, keyed by the name of their VM.
+func (r *Reconciler) getSnapshots(
+	ctx *pkgctx.VirtualMachineGroupSnapshotContext) (map[string]*vmopv1.VirtualMachineSnapshot, error) {
+
+	var snapshotList vmopv1.VirtualMachineSnapshotList
+	if err := r.List(
+		ctx,
+		&snapshotList,
+		client.InNamespace(ctx.GroupSnapshot.Namespace),
+		client.MatchingLabels{vmopv1.GroupSnapshotNameLabel: ctx.GroupSnapshot.Name}); err != nil {
+
+		return nil, fmt.Errorf("failed to list VirtualMachineSnapshots: %w", err)
+	}
+
+	snapshots := make(map[string]*vmopv1.VirtualMachineSnapshot, len(snapshotList.Items))
+	for i := range snapshotList.Items {
+		s := &snapshotList.Items[i]
+		if metav1.IsControlledBy(s, ctx.GroupSnapshot) {
+			snapshots[s.Spec.VMName] = s
+		}
+	}
+
+	return snapshots, nil
+}
+
+// reconcileSnapshots creates the missing VirtualMachineSnapshots. The
+// snapshots of all of the members are created concurrently, so the members
+// are quiesced together and the snapshots capture the same point in time. The
+// members whose VirtualMachineSnapshot name is already used by an object that
+// is not owned by the group snapshot are returned with the reason their
+// snapshot could not be created.
+func (r *Reconciler) reconcileSnapshots(
+	ctx *pkgctx.VirtualMachineGroupSnapshotContext,
+	snapshots map[string]*vmopv1.VirtualMachineSnapshot) (map[string]error, error) {
+
+	groupSnapshot := ctx.GroupSnapshot
+
+	gvk, err := apiutil.GVKForObject(groupSnapshot, r.Scheme())
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []vmopv1.VirtualMachineGroupSnapshotMemberStatus
+	for _, m := range groupSnapshot.Status.Members {
+		if _, ok := snapshots[m.VMName]; !ok {
+			missing = append(missing, m)
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(missing))
+	)
+
+	for i := range missing {
+		m := missing[i]
+
+		snapshot := &vmopv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: groupSnapshot.Namespace,
+				Name:      m.SnapshotName,
+				Labels: map[string]string{
+					vmopv1.GroupSnapshotNameLabel: groupSnapshot.Name,
+					vmopv1.VMNameForSnapshotLabel: m.VMName,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(groupSnapshot, gvk),
+				},
+			},
+			Spec: vmopv1.VirtualMachineSnapshotSpec{
+				VMName:      m.VMName,
+				Memory:      groupSnapshot.Spec.Memory,
+				Quiesce:     groupSnapshot.Spec.Quiesce.DeepCopy(),
+				Description: groupSnapshot.Spec.Description,
+			},
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = r.createSnapshot(ctx, snapshot, m.BootOrder)
+		}(i)
+	}
+
+	wg.Wait()
+
+	var failures map[string]error
+	for i, m := range missing {
+		if errors.Is(errs[i], errSnapshotNotOwned) {
+			if failures == nil {
+				failures = map[string]error{}
+			}
+			failures[m.VMName] = errs[i]
+			errs[i] = nil
+		}
+	}
+
+	return failures, errors.Join(errs...)
+}
+
+// errSnapshotNotOwned is returned by createSnapshot when an object with the
+// name of the snapshot exists but is not owned by the group snapshot.
+var errSnapshotNotOwned = errors.New("not owned by the group snapshot")
+
+// createSnapshot creates the VirtualMachineSnapshot of a member.
+func (r *Reconciler) createSnapshot(
+	ctx *pkgctx.VirtualMachineGroupSnapshotContext,
+	snapshot *vmopv1.VirtualMachineSnapshot,
+	bootOrder int32) error {
+
+	err := r.Create(ctx, snapshot)
+	if err == nil {
+		ctx.Logger.Info("Created VirtualMachineSnapshot",
+			"vmName", snapshot.Spec.VMName, "snapshotName", snapshot.Name, "bootOrder", bootOrder)
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create VirtualMachineSnapshot %q: %w", snapshot.Name, err)
+	}
+
+	existing := &vmopv1.VirtualMachineSnapshot{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(snapshot), existing); err != nil {
+		return fmt.Errorf("failed to get VirtualMachineSnapshot %q: %w", snapshot.Name, err)
+	}
+
+	// The snapshot was created by a previous reconcile but is not in the
+	// cache yet.
+	if metav1.IsControlledBy(existing, ctx.GroupSnapshot) {
+		return nil
+	}
+
+	return fmt.Errorf("VirtualMachineSnapshot %q already exists and is %w",
+		snapshot.Name, errSnapshotNotOwned)
+}
+
+// reconcileStatus copies the conditions of the VirtualMachineSnapshots to the
+// group snapshot's member statuses and sets the group snapshot's Ready
+// condition.
+func (r *Reconciler) reconcileStatus(
+	ctx *pkgctx.VirtualMachineGroupSnapshotContext,
+	snapshots map[string]*vmopv1.VirtualMachineSnapshot,
+	failures map[string]error) {
+
+	groupSnapshot := ctx.GroupSnapshot
+
+	var readyCount, failedCount int
+
+	for i := range groupSnapshot.Status.Members {
+		m := &groupSnapshot.Status.Members[i]
+
+		s, ok := snapshots[m.VMName]
+		if !ok {
+			m.Conditions = nil
+			if err, ok := failures[m.VMName]; ok {
+				conditions.MarkFalse(
+					m,
+					vmopv1.VirtualMachineSnapshotCreatedCondition,
+					vmopv1.VirtualMachineSnapshotCreationFailedReason,
+					"%s",
+					err)
+				failedCount++
+			}
+			continue
+		}
+
+		m.Conditions = slices.Clone(s.Status.Conditions)
+
+		if conditions.IsTrue(s, vmopv1.VirtualMachineSnapshotReadyCondition) {
+			readyCount++
+		} else if c := conditions.Get(s, vmopv1.VirtualMachineSnapshotCreatedCondition); c != nil &&
+			c.Reason == vmopv1.VirtualMachineSnapshotCreationFailedReason {
+			failedCount++
+		}
+	}
+
+	total := len(groupSnapshot.Status.Members)
+
+	switch {
+	case readyCount == total:
+		if groupSnapshot.Status.CompletionTime == nil {
+			groupSnapshot.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+			ctx.Logger.Info("VirtualMachineGroupSnapshot completed successfully")
+		}
+		conditions.MarkTrue(groupSnapshot, vmopv1.ReadyConditionType)
+	case failedCount > 0:
+		conditions.MarkFalse(
+			groupSnapshot,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineGroupSnapshotFailedReason,
+			"failed to create %d of %d snapshots",
+			failedCount, total)
+	default:
+		conditions.MarkFalse(
+			groupSnapshot,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineGroupSnapshotPendingReason,
+			"%d of %d snapshots ready",
+			readyCount, total)
+	}
+}
+
+// GetSnapshotName returns the name of the VirtualMachineSnapshot created by
+// the group snapshot for a VM. If the name would exceed the maximum length of
+// an object name, the names of the group snapshot and VM are truncated and a
+// hash of them is added so the name remains unique.
+func GetSnapshotName(groupSnapshotName, vmName string) string {
+	name := groupSnapshotName + "-" + vmName
+
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+
+	name = name[:validation.DNS1123SubdomainMaxLength-len(suffix)]
+	return strings.TrimRight(name, "-.") + suffix
+}
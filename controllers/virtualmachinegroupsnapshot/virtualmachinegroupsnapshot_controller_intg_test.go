@@ -0,0 +1,151 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinegroupsnapshot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinegroupsnapshot"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	const (
+		groupName         = "dummy-group"
+		groupSnapshotName = "dummy-group-snapshot"
+	)
+
+	var (
+		ctx           *builder.IntegrationTestContext
+		groupSnapshot *vmopv1.VirtualMachineGroupSnapshot
+	)
+
+	getReadyCondition := func(g Gomega) *metav1.Condition {
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(groupSnapshot), groupSnapshot)).To(Succeed())
+		c := conditions.Get(groupSnapshot, vmopv1.ReadyConditionType)
+		g.Expect(c).ToNot(BeNil())
+		return c
+	}
+
+	getSnapshot := func(g Gomega, vmName string) *vmopv1.VirtualMachineSnapshot {
+		snapshot := &vmopv1.VirtualMachineSnapshot{}
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKey{
+			Namespace: ctx.Namespace,
+			Name:      virtualmachinegroupsnapshot.GetSnapshotName(groupSnapshotName, vmName),
+		}, snapshot)).To(Succeed())
+		return snapshot
+	}
+
+	// markSnapshot sets the conditions that would be set by the
+	// VirtualMachineSnapshot controller on the snapshot of the given VM.
+	markSnapshot := func(vmName string, ready bool) {
+		Eventually(func(g Gomega) {
+			snapshot := getSnapshot(g, vmName)
+			conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotCreatedCondition)
+			if ready {
+				conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition)
+			}
+			g.Expect(ctx.Client.Status().Update(ctx, snapshot)).To(Succeed())
+		}).Should(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		groupSnapshot = builder.DummyVirtualMachineGroupSnapshot(ctx.Namespace, groupSnapshotName, groupName)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("the group does not exist", func() {
+		It("should report that the group does not exist", func() {
+			Expect(ctx.Client.Create(ctx, groupSnapshot)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				c := getReadyCondition(g)
+				g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotGroupNotFoundReason))
+			}).Should(Succeed())
+		})
+	})
+
+	When("the group has VMs in multiple boot order groups", func() {
+		BeforeEach(func() {
+			vm0 := vmopv1.GroupMember{Name: "vm-0", Kind: "VirtualMachine"}
+			vm1 := vmopv1.GroupMember{Name: "vm-1", Kind: "VirtualMachine"}
+
+			vmGroup := &vmopv1.VirtualMachineGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ctx.Namespace,
+					Name:      groupName,
+				},
+				Spec: vmopv1.VirtualMachineGroupSpec{
+					BootOrder: []vmopv1.VirtualMachineGroupBootOrderGroup{
+						{Members: []vmopv1.GroupMember{vm0}},
+						{Members: []vmopv1.GroupMember{vm1}},
+					},
+				},
+			}
+			Expect(ctx.Client.Create(ctx, vmGroup)).To(Succeed())
+
+			for _, m := range []vmopv1.GroupMember{vm0, vm1} {
+				ms := vmopv1.VirtualMachineGroupMemberStatus{Name: m.Name, Kind: m.Kind}
+				conditions.MarkTrue(&ms, vmopv1.VirtualMachineGroupMemberConditionGroupLinked)
+				vmGroup.Status.Members = append(vmGroup.Status.Members, ms)
+			}
+			Expect(ctx.Client.Status().Update(ctx, vmGroup)).To(Succeed())
+		})
+
+		It("should snapshot all of the VMs at the same time", func() {
+			Expect(ctx.Client.Create(ctx, groupSnapshot)).To(Succeed())
+
+			By("creating the snapshots of the VMs in all of the boot order groups", func() {
+				Eventually(func(g Gomega) {
+					c := getReadyCondition(g)
+					g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotPendingReason))
+					g.Expect(groupSnapshot.Status.Members).To(HaveLen(2))
+
+					for _, vmName := range []string{"vm-0", "vm-1"} {
+						snapshot := getSnapshot(g, vmName)
+						g.Expect(snapshot.Labels).To(HaveKeyWithValue(vmopv1.GroupSnapshotNameLabel, groupSnapshotName))
+						g.Expect(metav1.IsControlledBy(snapshot, groupSnapshot)).To(BeTrue())
+					}
+				}).Should(Succeed())
+			})
+
+			By("marking the group snapshot ready once all of the snapshots are ready", func() {
+				markSnapshot("vm-0", true)
+				markSnapshot("vm-1", true)
+
+				Eventually(func(g Gomega) {
+					g.Expect(getReadyCondition(g).Status).To(Equal(metav1.ConditionTrue))
+					g.Expect(groupSnapshot.Status.CompletionTime).ToNot(BeNil())
+				}).Should(Succeed())
+			})
+		})
+	})
+}
@@ -0,0 +1,330 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinegroupsnapshot_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinegroupsnapshot"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace         = "test-namespace"
+		groupName         = "test-group"
+		childGroupName    = "test-child-group"
+		groupSnapshotName = "test-group-snapshot"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler    *virtualmachinegroupsnapshot.Reconciler
+		groupSnapshot *vmopv1.VirtualMachineGroupSnapshot
+		vmGroup       *vmopv1.VirtualMachineGroup
+		vmChildGroup  *vmopv1.VirtualMachineGroup
+	)
+
+	linked := func(members ...vmopv1.GroupMember) []vmopv1.VirtualMachineGroupMemberStatus {
+		var statuses []vmopv1.VirtualMachineGroupMemberStatus
+		for _, m := range members {
+			ms := vmopv1.VirtualMachineGroupMemberStatus{Name: m.Name, Kind: m.Kind}
+			conditions.MarkTrue(&ms, vmopv1.VirtualMachineGroupMemberConditionGroupLinked)
+			statuses = append(statuses, ms)
+		}
+		return statuses
+	}
+
+	listSnapshots := func() map[string]vmopv1.VirtualMachineSnapshot {
+		var list vmopv1.VirtualMachineSnapshotList
+		Expect(ctx.Client.List(
+			ctx,
+			&list,
+			client.InNamespace(namespace),
+			client.MatchingLabels{vmopv1.GroupSnapshotNameLabel: groupSnapshotName})).To(Succeed())
+		snapshots := map[string]vmopv1.VirtualMachineSnapshot{}
+		for _, s := range list.Items {
+			snapshots[s.Spec.VMName] = s
+		}
+		return snapshots
+	}
+
+	// markSnapshots sets the conditions that would be set by the
+	// VirtualMachineSnapshot controller on the snapshots of the given VMs.
+	markSnapshots := func(created, ready bool, vmNames ...string) {
+		for _, vmName := range vmNames {
+			snapshot := &vmopv1.VirtualMachineSnapshot{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{
+				Namespace: namespace,
+				Name:      virtualmachinegroupsnapshot.GetSnapshotName(groupSnapshotName, vmName),
+			}, snapshot)).To(Succeed())
+
+			if created {
+				conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotCreatedCondition)
+			} else {
+				conditions.MarkFalse(
+					snapshot,
+					vmopv1.VirtualMachineSnapshotCreatedCondition,
+					vmopv1.VirtualMachineSnapshotCreationFailedReason,
+					"failed")
+			}
+			if ready {
+				conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition)
+			}
+			Expect(ctx.Client.Status().Update(ctx, snapshot)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		vm0 := vmopv1.GroupMember{Name: "vm-0", Kind: "VirtualMachine"}
+		vm1 := vmopv1.GroupMember{Name: "vm-1", Kind: "VirtualMachine"}
+		vm2 := vmopv1.GroupMember{Name: "vm-2", Kind: "VirtualMachine"}
+		vm3 := vmopv1.GroupMember{Name: "vm-3", Kind: "VirtualMachine"}
+		child := vmopv1.GroupMember{Name: childGroupName, Kind: "VirtualMachineGroup"}
+
+		vmGroup = &vmopv1.VirtualMachineGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      groupName,
+			},
+		}
+		vmGroup.Spec.BootOrder = []vmopv1.VirtualMachineGroupBootOrderGroup{
+			{Members: []vmopv1.GroupMember{vm0, child}},
+			{Members: []vmopv1.GroupMember{vm1}},
+		}
+		vmGroup.Status.Members = linked(vm0, child, vm1)
+
+		vmChildGroup = &vmopv1.VirtualMachineGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      childGroupName,
+			},
+		}
+		vmChildGroup.Spec.BootOrder = []vmopv1.VirtualMachineGroupBootOrderGroup{
+			{Members: []vmopv1.GroupMember{vm2}},
+			{Members: []vmopv1.GroupMember{vm3}},
+		}
+		vmChildGroup.Status.Members = linked(vm2, vm3)
+
+		groupSnapshot = builder.DummyVirtualMachineGroupSnapshot(namespace, groupSnapshotName, groupName)
+		groupSnapshot.Spec.Memory = true
+		groupSnapshot.Spec.Description = "before upgrade"
+
+		initObjects = []client.Object{
+			groupSnapshot,
+			vmGroup,
+			vmChildGroup,
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+
+		// The fake client does not persist the status on create.
+		for _, obj := range initObjects {
+			if g, ok := obj.(*vmopv1.VirtualMachineGroup); ok {
+				Expect(ctx.Client.Status().Update(ctx, g)).To(Succeed())
+			}
+		}
+
+		reconciler = virtualmachinegroupsnapshot.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcileNormal := func() error {
+		return reconciler.ReconcileNormal(&pkgctx.VirtualMachineGroupSnapshotContext{
+			Context:       ctx,
+			Logger:        ctx.Logger,
+			GroupSnapshot: groupSnapshot,
+		})
+	}
+
+	When("the group exists", func() {
+		It("records the members in the flattened boot order", func() {
+			Expect(reconcileNormal()).To(Succeed())
+
+			members := groupSnapshot.Status.Members
+			Expect(members).To(HaveLen(4))
+			Expect(members[0].VMName).To(Equal("vm-0"))
+			Expect(members[0].BootOrder).To(BeEquivalentTo(0))
+			Expect(members[1].VMName).To(Equal("vm-2"))
+			Expect(members[1].BootOrder).To(BeEquivalentTo(0))
+			Expect(members[2].VMName).To(Equal("vm-1"))
+			Expect(members[2].BootOrder).To(BeEquivalentTo(1))
+			Expect(members[3].VMName).To(Equal("vm-3"))
+			Expect(members[3].BootOrder).To(BeEquivalentTo(1))
+			for _, m := range members {
+				Expect(m.SnapshotName).To(Equal(virtualmachinegroupsnapshot.GetSnapshotName(groupSnapshotName, m.VMName)))
+			}
+		})
+
+		It("creates the snapshots of all of the members at the same time", func() {
+			Expect(reconcileNormal()).To(Succeed())
+
+			snapshots := listSnapshots()
+			Expect(snapshots).To(HaveLen(4))
+			Expect(snapshots).To(HaveKey("vm-0"))
+			Expect(snapshots).To(HaveKey("vm-1"))
+			Expect(snapshots).To(HaveKey("vm-2"))
+			Expect(snapshots).To(HaveKey("vm-3"))
+			for vmName, s := range snapshots {
+				Expect(s.Spec.Memory).To(BeTrue())
+				Expect(s.Spec.Description).To(Equal("before upgrade"))
+				Expect(s.Labels).To(HaveKeyWithValue(vmopv1.VMNameForSnapshotLabel, vmName))
+				Expect(s.OwnerReferences).To(HaveLen(1))
+				Expect(s.OwnerReferences[0].Name).To(Equal(groupSnapshotName))
+			}
+
+			c := conditions.Get(groupSnapshot, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotPendingReason))
+
+			By("marking the group snapshot ready once all of the snapshots are ready", func() {
+				markSnapshots(true, true, "vm-0", "vm-1", "vm-2", "vm-3")
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(listSnapshots()).To(HaveLen(4))
+				Expect(conditions.IsTrue(groupSnapshot, vmopv1.ReadyConditionType)).To(BeTrue())
+				Expect(groupSnapshot.Status.CompletionTime).ToNot(BeNil())
+				for _, m := range groupSnapshot.Status.Members {
+					Expect(conditions.IsTrue(m, vmopv1.VirtualMachineSnapshotReadyCondition)).To(BeTrue())
+				}
+			})
+		})
+
+		When("a snapshot with the name of a member's snapshot is not owned by the group snapshot", func() {
+			BeforeEach(func() {
+				initObjects = append(initObjects, &vmopv1.VirtualMachineSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      virtualmachinegroupsnapshot.GetSnapshotName(groupSnapshotName, "vm-1"),
+					},
+					Spec: vmopv1.VirtualMachineSnapshotSpec{
+						VMName: "vm-1",
+					},
+				})
+			})
+
+			It("reports the member as failed", func() {
+				Expect(reconcileNormal()).To(Succeed())
+
+				snapshots := listSnapshots()
+				Expect(snapshots).To(HaveLen(3))
+				Expect(snapshots).ToNot(HaveKey("vm-1"))
+
+				Expect(groupSnapshot.Status.Members[2].VMName).To(Equal("vm-1"))
+				mc := conditions.Get(groupSnapshot.Status.Members[2], vmopv1.VirtualMachineSnapshotCreatedCondition)
+				Expect(mc).ToNot(BeNil())
+				Expect(mc.Status).To(Equal(metav1.ConditionFalse))
+				Expect(mc.Reason).To(Equal(vmopv1.VirtualMachineSnapshotCreationFailedReason))
+				Expect(mc.Message).To(ContainSubstring("is not owned by the group snapshot"))
+
+				c := conditions.Get(groupSnapshot, vmopv1.ReadyConditionType)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotFailedReason))
+				Expect(c.Message).To(Equal("failed to create 1 of 4 snapshots"))
+			})
+		})
+
+		It("reports snapshots that failed to be created", func() {
+			Expect(reconcileNormal()).To(Succeed())
+
+			markSnapshots(false, false, "vm-0")
+			Expect(reconcileNormal()).To(Succeed())
+
+			Expect(listSnapshots()).To(HaveLen(4))
+			c := conditions.Get(groupSnapshot, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotFailedReason))
+			Expect(c.Message).To(Equal("failed to create 1 of 4 snapshots"))
+		})
+	})
+
+	When("the group does not exist", func() {
+		BeforeEach(func() {
+			initObjects = []client.Object{groupSnapshot}
+		})
+
+		It("returns an error", func() {
+			Expect(reconcileNormal()).ToNot(Succeed())
+
+			c := conditions.Get(groupSnapshot, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotGroupNotFoundReason))
+			Expect(listSnapshots()).To(BeEmpty())
+		})
+	})
+
+	When("the group does not have any VMs", func() {
+		BeforeEach(func() {
+			vmGroup.Spec.BootOrder = nil
+			vmGroup.Status.Members = nil
+		})
+
+		It("returns an error", func() {
+			Expect(reconcileNormal()).ToNot(Succeed())
+
+			c := conditions.Get(groupSnapshot, vmopv1.ReadyConditionType)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGroupSnapshotNoMembersReason))
+			Expect(listSnapshots()).To(BeEmpty())
+		})
+	})
+
+	Context("GetSnapshotName", func() {
+		It("returns the names of the group snapshot and VM", func() {
+			Expect(virtualmachinegroupsnapshot.GetSnapshotName(groupSnapshotName, "vm-1")).To(
+				Equal(groupSnapshotName + "-vm-1"))
+		})
+
+		It("returns a name that is not too long when the names are long", func() {
+			longGroupSnapshotName := strings.Repeat("g", 63)
+			longVMName := strings.Repeat("v", 199)
+
+			name1 := virtualmachinegroupsnapshot.GetSnapshotName(longGroupSnapshotName, longVMName+"1")
+			name2 := virtualmachinegroupsnapshot.GetSnapshotName(longGroupSnapshotName, longVMName+"2")
+
+			for _, name := range []string{name1, name2} {
+				Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+			}
+			Expect(name1).ToNot(Equal(name2))
+			Expect(virtualmachinegroupsnapshot.GetSnapshotName(longGroupSnapshotName, longVMName+"1")).To(Equal(name1))
+		})
+	})
+}
@@ -0,0 +1,473 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineguestfiletransfer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-logr/logr"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+const (
+	// MaxFileSize is the maximum size of a file copied from the guest, which
+	// is the maximum size of the data of a Secret or ConfigMap.
+	MaxFileSize = 1024 * 1024
+
+	// GuestNotReadyRequeueDelay is how long to wait before checking the
+	// guest heartbeat again when it is not green.
+	GuestNotReadyRequeueDelay = 10 * time.Second
+
+	// HelperPodNotReadyRequeueDelay is how long to wait before checking the
+	// helper Pod of a PersistentVolumeClaim transfer again when it is not
+	// running.
+	HelperPodNotReadyRequeueDelay = 5 * time.Second
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineGuestFileTransfer{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	podExecutor, err := kubeutil.NewPodExecutor(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create pod executor: %w", err)
+	}
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		mgr.GetAPIReader(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider,
+		podExecutor)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.VMToFileTransfers(ctx))).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	apiReader client.Reader,
+	logger logr.Logger,
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface,
+	podExecutor kubeutil.PodExecutor) *Reconciler {
+
+	return &Reconciler{
+		Context:     ctx,
+		Client:      client,
+		APIReader:   apiReader,
+		Logger:      logger,
+		Recorder:    recorder,
+		VMProvider:  vmProvider,
+		PodExecutor: podExecutor,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineGuestFileTransfer object.
+type Reconciler struct {
+	client.Client
+	Context     context.Context
+	APIReader   client.Reader
+	Logger      logr.Logger
+	Recorder    record.Recorder
+	VMProvider  providers.VirtualMachineProviderInterface
+	PodExecutor kubeutil.PodExecutor
+}
+
+// VMToFileTransfers returns a mapper function that enqueues the incomplete
+// file transfers of a VirtualMachine.
+func (r *Reconciler) VMToFileTransfers(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		var list vmopv1.VirtualMachineGuestFileTransferList
+		if err := r.List(ctx, &list, client.InNamespace(o.GetNamespace())); err != nil {
+			ctx.Logger.Error(err, "Failed to list VirtualMachineGuestFileTransfers")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range list.Items {
+			t := &list.Items[i]
+			if t.Spec.VMName == o.GetName() && !conditions.IsTrue(t, vmopv1.ReadyConditionType) {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKey{Namespace: t.Namespace, Name: t.Name},
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineguestfiletransfers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineguestfiletransfers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+//
+// The permissions to manage the helper Pods of PersistentVolumeClaim transfers
+// are not granted cluster-wide. Please see
+// config/rbac/guest_file_transfer_helper_role.yaml.
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	fileTransfer := &vmopv1.VirtualMachineGuestFileTransfer{}
+	if err := r.Get(ctx, req.NamespacedName, fileTransfer); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !fileTransfer.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	fileTransferCtx := &pkgctx.VirtualMachineGuestFileTransferContext{
+		Context:      ctx,
+		Logger:       pkglog.FromContextOrDefault(ctx),
+		FileTransfer: fileTransfer,
+	}
+
+	patchHelper, err := patch.NewHelper(fileTransfer, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", fileTransferCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, fileTransfer); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			fileTransferCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	return pkgerr.ResultFromError(r.ReconcileNormal(fileTransferCtx))
+}
+
+// ReconcileNormal copies the file of the transfer once the guest of the VM
+// has a green heartbeat. A transfer is only completed once.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineGuestFileTransferContext) error {
+	fileTransfer := ctx.FileTransfer
+
+	isPVC := fileTransfer.Spec.Object.Kind == vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim
+
+	if isPVC && !pkgcfg.FromContext(ctx).Features.VMGuestFileTransferPVC {
+		markTransferFailed(fileTransfer,
+			errors.New("copying files to or from a PersistentVolumeClaim is not enabled"))
+		return nil
+	}
+
+	if conditions.IsTrue(fileTransfer, vmopv1.ReadyConditionType) {
+		if isPVC {
+			return r.deleteHelperPod(ctx)
+		}
+		return nil
+	}
+
+	ctx.Logger.Info("Reconciling VirtualMachineGuestFileTransfer")
+
+	vm := &vmopv1.VirtualMachine{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: fileTransfer.Namespace,
+		Name:      fileTransfer.Spec.VMName,
+	}, vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(
+				fileTransfer,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineGuestFileTransferVMNotFoundReason,
+				"%s",
+				err)
+			return nil
+		}
+		return fmt.Errorf("failed to get VirtualMachine %q: %w", fileTransfer.Spec.VMName, err)
+	}
+
+	heartbeat, err := r.VMProvider.GetVirtualMachineGuestHeartbeat(ctx, vm)
+	if err != nil {
+		return fmt.Errorf("failed to get guest heartbeat: %w", err)
+	}
+	if heartbeat != vmopv1.GreenHeartbeatStatus {
+		conditions.MarkFalse(
+			fileTransfer,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineGuestFileTransferGuestNotReadyReason,
+			"Guest heartbeat is %q",
+			heartbeat)
+		return pkgerr.RequeueError{
+			After:   GuestNotReadyRequeueDelay,
+			Message: "guest heartbeat is not green",
+		}
+	}
+
+	auth, err := pkgutil.GetGuestCredentials(
+		ctx,
+		r.Client,
+		fileTransfer.Namespace,
+		fileTransfer.Spec.CredentialsSecretName)
+	if err != nil {
+		return err
+	}
+
+	var (
+		size     int64
+		checksum []byte
+	)
+
+	if isPVC {
+		size, checksum, err = r.copyVolumeFile(ctx, vm, auth)
+	} else {
+		size, checksum, err = r.copyObjectFile(ctx, vm, auth)
+	}
+	if err != nil {
+		return err
+	}
+
+	fileTransfer.Status.Size = size
+	fileTransfer.Status.Checksum = hex.EncodeToString(checksum)
+	fileTransfer.Status.CompletionTime = ptr.To(metav1.Now())
+	conditions.MarkTrue(fileTransfer, vmopv1.ReadyConditionType)
+
+	ctx.Logger.Info("Copied guest file",
+		"direction", fileTransfer.Spec.Direction,
+		"guestPath", fileTransfer.Spec.GuestPath,
+		"size", fileTransfer.Status.Size,
+		"checksum", fileTransfer.Status.Checksum)
+
+	if isPVC {
+		return r.deleteHelperPod(ctx)
+	}
+
+	return nil
+}
+
+// copyObjectFile copies the file between the guest and the Secret or
+// ConfigMap key of the transfer, and returns the size and SHA-256 checksum of
+// the file.
+func (r *Reconciler) copyObjectFile(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication) (int64, []byte, error) {
+
+	fileTransfer := ctx.FileTransfer
+
+	var data []byte
+
+	switch fileTransfer.Spec.Direction {
+	case vmopv1.VirtualMachineGuestFileTransferDirectionToGuest:
+		var (
+			ok  bool
+			err error
+		)
+		if data, ok, err = r.getObjectData(ctx); err != nil {
+			return 0, nil, err
+		} else if !ok {
+			conditions.MarkFalse(
+				fileTransfer,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason,
+				"%s %q does not have the key %q",
+				fileTransfer.Spec.Object.Kind,
+				fileTransfer.Spec.Object.Name,
+				fileTransfer.Spec.Object.Key)
+			return 0, nil, fmt.Errorf("failed to get data of %s %q",
+				fileTransfer.Spec.Object.Kind, fileTransfer.Spec.Object.Name)
+		}
+
+		if err := r.VMProvider.PutVirtualMachineGuestFile(
+			ctx,
+			vm,
+			auth,
+			fileTransfer.Spec.GuestPath,
+			bytes.NewReader(data),
+			int64(len(data)),
+			fileTransfer.Spec.Overwrite); err != nil {
+
+			markTransferFailed(fileTransfer, err)
+			return 0, nil, fmt.Errorf("failed to copy file to guest: %w", err)
+		}
+
+	case vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest:
+		rc, _, err := r.VMProvider.GetVirtualMachineGuestFile(
+			ctx,
+			vm,
+			auth,
+			fileTransfer.Spec.GuestPath,
+			MaxFileSize)
+		if err == nil {
+			data, err = io.ReadAll(rc)
+			_ = rc.Close()
+		}
+		if err != nil {
+			markTransferFailed(fileTransfer, err)
+			return 0, nil, fmt.Errorf("failed to copy file from guest: %w", err)
+		}
+
+		if err := r.setObjectData(ctx, data); err != nil {
+			return 0, nil, err
+		}
+
+	default:
+		return 0, nil, pkgerr.NoRequeueError{
+			Message: fmt.Sprintf("unsupported direction %q", fileTransfer.Spec.Direction),
+		}
+	}
+
+	checksum := sha256.Sum256(data)
+	return int64(len(data)), checksum[:], nil
+}
+
+func markTransferFailed(fileTransfer *vmopv1.VirtualMachineGuestFileTransfer, err error) {
+	conditions.MarkFalse(
+		fileTransfer,
+		vmopv1.ReadyConditionType,
+		vmopv1.VirtualMachineGuestFileTransferFailedReason,
+		"%s",
+		err)
+}
+
+// getObjectData returns the data of the Secret or ConfigMap key of the
+// transfer, and false if the resource or key does not exist.
+func (r *Reconciler) getObjectData(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext) ([]byte, bool, error) {
+
+	obj := ctx.FileTransfer.Spec.Object
+	key := client.ObjectKey{Namespace: ctx.FileTransfer.Namespace, Name: obj.Name}
+
+	switch obj.Kind {
+	case vmopv1.VirtualMachineGuestFileTransferObjectKindSecret:
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, false, nil
+			}
+			return nil, false, fmt.Errorf("failed to get Secret %q: %w", obj.Name, err)
+		}
+		data, ok := secret.Data[obj.Key]
+		return data, ok, nil
+
+	case vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap:
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, key, configMap); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, false, nil
+			}
+			return nil, false, fmt.Errorf("failed to get ConfigMap %q: %w", obj.Name, err)
+		}
+		if data, ok := configMap.Data[obj.Key]; ok {
+			return []byte(data), true, nil
+		}
+		data, ok := configMap.BinaryData[obj.Key]
+		return data, ok, nil
+	}
+
+	return nil, false, pkgerr.NoRequeueError{
+		Message: fmt.Sprintf("unsupported object kind %q", obj.Kind),
+	}
+}
+
+// setObjectData writes data to the Secret or ConfigMap key of the transfer,
+// creating the resource if it does not exist.
+func (r *Reconciler) setObjectData(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext,
+	data []byte) error {
+
+	obj := ctx.FileTransfer.Spec.Object
+	objMeta := metav1.ObjectMeta{Namespace: ctx.FileTransfer.Namespace, Name: obj.Name}
+
+	switch obj.Kind {
+	case vmopv1.VirtualMachineGuestFileTransferObjectKindSecret:
+		secret := &corev1.Secret{ObjectMeta: objMeta}
+		if _, err := controllerutil.CreateOrPatch(ctx, r.Client, secret, func() error {
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			secret.Data[obj.Key] = data
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to write Secret %q: %w", obj.Name, err)
+		}
+		return nil
+
+	case vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap:
+		configMap := &corev1.ConfigMap{ObjectMeta: objMeta}
+		if _, err := controllerutil.CreateOrPatch(ctx, r.Client, configMap, func() error {
+			// Data only holds UTF-8 strings, so store anything else as
+			// BinaryData.
+			delete(configMap.Data, obj.Key)
+			delete(configMap.BinaryData, obj.Key)
+			if utf8.Valid(data) {
+				if configMap.Data == nil {
+					configMap.Data = map[string]string{}
+				}
+				configMap.Data[obj.Key] = string(data)
+			} else {
+				if configMap.BinaryData == nil {
+					configMap.BinaryData = map[string][]byte{}
+				}
+				configMap.BinaryData[obj.Key] = data
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to write ConfigMap %q: %w", obj.Name, err)
+		}
+		return nil
+	}
+
+	return pkgerr.NoRequeueError{
+		Message: fmt.Sprintf("unsupported object kind %q", obj.Kind),
+	}
+}
@@ -0,0 +1,249 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineguestfiletransfer_test
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	const (
+		vmName       = "dummy-vm"
+		transferName = "dummy-file-transfer"
+		fileData     = "-----BEGIN CERTIFICATE-----"
+
+		// The SHA-256 checksum of fileData.
+		fileChecksum = "ddddb6cbd348658f02fa6c8a46b6f51cf6b6bbe22d54fc6a4f0e3b3f59c5d012"
+	)
+
+	var (
+		ctx          *builder.IntegrationTestContext
+		fileTransfer *vmopv1.VirtualMachineGuestFileTransfer
+	)
+
+	getReadyCondition := func(g Gomega) *metav1.Condition {
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(fileTransfer), fileTransfer)).To(Succeed())
+		c := conditions.Get(fileTransfer, vmopv1.ReadyConditionType)
+		g.Expect(c).ToNot(BeNil())
+		return c
+	}
+
+	setHeartbeat := func(heartbeat vmopv1.GuestHeartbeatStatus) {
+		intgFakeVMProvider.Lock()
+		defer intgFakeVMProvider.Unlock()
+		intgFakeVMProvider.GetVirtualMachineGuestHeartbeatFn = func(
+			_ context.Context,
+			_ *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error) {
+
+			return heartbeat, nil
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		fileTransfer = builder.DummyVirtualMachineGuestFileTransfer(ctx.Namespace, transferName, vmName)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		intgFakeVMProvider.Reset()
+	})
+
+	When("the VM does not exist", func() {
+		It("should report that the VM does not exist", func() {
+			Expect(ctx.Client.Create(ctx, fileTransfer)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				c := getReadyCondition(g)
+				g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGuestFileTransferVMNotFoundReason))
+			}).Should(Succeed())
+		})
+	})
+
+	When("the VM exists", func() {
+		BeforeEach(func() {
+			Expect(ctx.Client.Create(ctx, builder.DummyBasicVirtualMachine(vmName, ctx.Namespace))).To(Succeed())
+
+			Expect(ctx.Client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ctx.Namespace,
+					Name:      fileTransfer.Spec.CredentialsSecretName,
+				},
+				StringData: map[string]string{
+					"username": "root",
+					"password": "password",
+				},
+			})).To(Succeed())
+		})
+
+		When("the guest heartbeat is not green", func() {
+			BeforeEach(func() {
+				setHeartbeat(vmopv1.YellowHeartbeatStatus)
+			})
+
+			It("should report that the guest is not ready", func() {
+				Expect(ctx.Client.Create(ctx, fileTransfer)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					c := getReadyCondition(g)
+					g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGuestFileTransferGuestNotReadyReason))
+				}).Should(Succeed())
+			})
+		})
+
+		When("the guest heartbeat is green", func() {
+			BeforeEach(func() {
+				setHeartbeat(vmopv1.GreenHeartbeatStatus)
+			})
+
+			When("the file is copied to the guest", func() {
+				var putData chan string
+
+				BeforeEach(func() {
+					putData = make(chan string, 1)
+
+					intgFakeVMProvider.Lock()
+					intgFakeVMProvider.PutVirtualMachineGuestFileFn = func(
+						_ context.Context,
+						_ *vmopv1.VirtualMachine,
+						_ vimtypes.NamePasswordAuthentication,
+						_ string,
+						r io.Reader,
+						size int64,
+						_ bool) error {
+
+						data, err := io.ReadAll(io.LimitReader(r, size))
+						select {
+						case putData <- string(data):
+						default:
+						}
+						return err
+					}
+					intgFakeVMProvider.Unlock()
+				})
+
+				It("should report that the source does not exist", func() {
+					Expect(ctx.Client.Create(ctx, fileTransfer)).To(Succeed())
+
+					Eventually(func(g Gomega) {
+						c := getReadyCondition(g)
+						g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+						g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason))
+					}).Should(Succeed())
+				})
+
+				It("should copy the Secret key to the guest and report that the transfer is ready", func() {
+					Expect(ctx.Client.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: ctx.Namespace,
+							Name:      fileTransfer.Spec.Object.Name,
+						},
+						StringData: map[string]string{
+							fileTransfer.Spec.Object.Key: fileData,
+						},
+					})).To(Succeed())
+					Expect(ctx.Client.Create(ctx, fileTransfer)).To(Succeed())
+
+					Eventually(putData).Should(Receive(Equal(fileData)))
+
+					Eventually(func(g Gomega) {
+						c := getReadyCondition(g)
+						g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+						g.Expect(fileTransfer.Status.Size).To(BeEquivalentTo(len(fileData)))
+						g.Expect(fileTransfer.Status.Checksum).To(Equal(fileChecksum))
+						g.Expect(fileTransfer.Status.CompletionTime).ToNot(BeNil())
+					}).Should(Succeed())
+				})
+
+				When("the PersistentVolumeClaim does not exist", func() {
+					BeforeEach(func() {
+						fileTransfer.Spec.Object = vmopv1.VirtualMachineGuestFileTransferObject{
+							Kind: vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim,
+							Name: "dummy-pvc",
+							Key:  "certs/ca.crt",
+						}
+					})
+
+					It("should report that the source does not exist", func() {
+						Expect(ctx.Client.Create(ctx, fileTransfer)).To(Succeed())
+
+						Eventually(func(g Gomega) {
+							c := getReadyCondition(g)
+							g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+							g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason))
+						}).Should(Succeed())
+					})
+				})
+			})
+
+			When("the file is copied from the guest", func() {
+				BeforeEach(func() {
+					fileTransfer.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+					fileTransfer.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap
+
+					intgFakeVMProvider.Lock()
+					intgFakeVMProvider.GetVirtualMachineGuestFileFn = func(
+						_ context.Context,
+						_ *vmopv1.VirtualMachine,
+						_ vimtypes.NamePasswordAuthentication,
+						_ string,
+						_ int64) (io.ReadCloser, int64, error) {
+
+						return io.NopCloser(strings.NewReader(fileData)), int64(len(fileData)), nil
+					}
+					intgFakeVMProvider.Unlock()
+				})
+
+				It("should create the ConfigMap and report that the transfer is ready", func() {
+					Expect(ctx.Client.Create(ctx, fileTransfer)).To(Succeed())
+
+					Eventually(func(g Gomega) {
+						c := getReadyCondition(g)
+						g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+						g.Expect(fileTransfer.Status.Checksum).To(Equal(fileChecksum))
+					}).Should(Succeed())
+
+					configMap := &corev1.ConfigMap{}
+					Expect(ctx.Client.Get(ctx, client.ObjectKey{
+						Namespace: ctx.Namespace,
+						Name:      fileTransfer.Spec.Object.Name,
+					}, configMap)).To(Succeed())
+					Expect(configMap.Data).To(HaveKeyWithValue(fileTransfer.Spec.Object.Key, fileData))
+				})
+			})
+		})
+	})
+}
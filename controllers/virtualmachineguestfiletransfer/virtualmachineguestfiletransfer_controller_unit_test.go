@@ -0,0 +1,730 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineguestfiletransfer_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilexec "k8s.io/client-go/util/exec"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineguestfiletransfer"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	"github.com/vmware-tanzu/vm-operator/pkg/guestfiletransfer"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+// fakePodExecutor is a kubeutil.PodExecutor that runs commands with ExecFn.
+type fakePodExecutor struct {
+	ExecFn func(command []string, stdin io.Reader, stdout io.Writer) error
+}
+
+func (e *fakePodExecutor) Exec(
+	_ context.Context,
+	_, _, _ string,
+	command []string,
+	stdin io.Reader,
+	stdout io.Writer) error {
+
+	return e.ExecFn(command, stdin, stdout)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace    = "test-namespace"
+		vmName       = "test-vm"
+		transferName = "test-file-transfer"
+		fileData     = "-----BEGIN CERTIFICATE-----"
+
+		// The SHA-256 checksum of fileData.
+		fileChecksum = "ddddb6cbd348658f02fa6c8a46b6f51cf6b6bbe22d54fc6a4f0e3b3f59c5d012"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler     *virtualmachineguestfiletransfer.Reconciler
+		fakeVMProvider *providerfake.VMProvider
+		podExecutor    *fakePodExecutor
+		fileTransfer   *vmopv1.VirtualMachineGuestFileTransfer
+		credentials    *corev1.Secret
+		source         *corev1.Secret
+	)
+
+	reconcileNormal := func() error {
+		return reconciler.ReconcileNormal(&pkgctx.VirtualMachineGuestFileTransferContext{
+			Context:      ctx,
+			Logger:       ctx.Logger,
+			FileTransfer: fileTransfer,
+		})
+	}
+
+	readyReason := func() string {
+		c := conditions.Get(fileTransfer, vmopv1.ReadyConditionType)
+		Expect(c).ToNot(BeNil())
+		return c.Reason
+	}
+
+	BeforeEach(func() {
+		fileTransfer = builder.DummyVirtualMachineGuestFileTransfer(namespace, transferName, vmName)
+
+		credentials = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      fileTransfer.Spec.CredentialsSecretName,
+			},
+			Data: map[string][]byte{
+				"username": []byte("root"),
+				"password": []byte("password"),
+			},
+		}
+
+		source = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      fileTransfer.Spec.Object.Name,
+			},
+			Data: map[string][]byte{
+				fileTransfer.Spec.Object.Key: []byte(fileData),
+			},
+		}
+
+		initObjects = []client.Object{
+			builder.DummyBasicVirtualMachine(vmName, namespace),
+			credentials,
+		}
+	})
+
+	JustBeforeEach(func() {
+		if source != nil {
+			initObjects = append(initObjects, source)
+		}
+
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		podExecutor = &fakePodExecutor{
+			ExecFn: func(_ []string, _ io.Reader, _ io.Writer) error {
+				return errors.New("unexpected exec")
+			},
+		}
+		reconciler = virtualmachineguestfiletransfer.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+			ctx.VMProvider,
+			podExecutor,
+		)
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.Reset()
+		fakeVMProvider.GetVirtualMachineGuestHeartbeatFn = func(
+			_ context.Context,
+			_ *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error) {
+
+			return vmopv1.GreenHeartbeatStatus, nil
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+		source = nil
+	})
+
+	When("the VM does not exist", func() {
+		BeforeEach(func() {
+			fileTransfer.Spec.VMName = "does-not-exist"
+		})
+
+		It("marks the transfer as not ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferVMNotFoundReason))
+		})
+	})
+
+	When("the guest heartbeat is not green", func() {
+		JustBeforeEach(func() {
+			fakeVMProvider.GetVirtualMachineGuestHeartbeatFn = func(
+				_ context.Context,
+				_ *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error) {
+
+				return vmopv1.YellowHeartbeatStatus, nil
+			}
+		})
+
+		It("marks the transfer as not ready and requeues", func() {
+			err := reconcileNormal()
+			Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferGuestNotReadyReason))
+		})
+	})
+
+	When("the credentials are missing a key", func() {
+		BeforeEach(func() {
+			delete(credentials.Data, "password")
+		})
+
+		It("returns an error", func() {
+			Expect(reconcileNormal()).To(MatchError(ContainSubstring(`must contain the keys "username" and "password"`)))
+		})
+	})
+
+	Context("ToGuest", func() {
+		When("the source object does not exist", func() {
+			BeforeEach(func() {
+				source = nil
+			})
+
+			It("returns an error", func() {
+				Expect(reconcileNormal()).ToNot(Succeed())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason))
+			})
+		})
+
+		When("the transfer fails", func() {
+			JustBeforeEach(func() {
+				fakeVMProvider.PutVirtualMachineGuestFileFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					_ vimtypes.NamePasswordAuthentication,
+					_ string,
+					_ io.Reader,
+					_ int64,
+					_ bool) error {
+
+					return errors.New("fubar")
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(reconcileNormal()).To(MatchError(ContainSubstring("fubar")))
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferFailedReason))
+			})
+		})
+
+		When("the transfer succeeds", func() {
+			var (
+				putAuth vimtypes.NamePasswordAuthentication
+				putPath string
+				putData []byte
+			)
+
+			JustBeforeEach(func() {
+				fakeVMProvider.PutVirtualMachineGuestFileFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					auth vimtypes.NamePasswordAuthentication,
+					guestPath string,
+					r io.Reader,
+					size int64,
+					_ bool) error {
+
+					data, err := io.ReadAll(r)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(data).To(HaveLen(int(size)))
+					putAuth, putPath, putData = auth, guestPath, data
+					return nil
+				}
+			})
+
+			It("copies the file to the guest and marks the transfer as ready", func() {
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(putAuth.Username).To(Equal("root"))
+				Expect(putAuth.Password).To(Equal("password"))
+				Expect(putPath).To(Equal(fileTransfer.Spec.GuestPath))
+				Expect(string(putData)).To(Equal(fileData))
+
+				Expect(conditions.IsTrue(fileTransfer, vmopv1.ReadyConditionType)).To(BeTrue())
+				Expect(fileTransfer.Status.Size).To(BeEquivalentTo(len(fileData)))
+				Expect(fileTransfer.Status.Checksum).To(Equal(fileChecksum))
+				Expect(fileTransfer.Status.CompletionTime).ToNot(BeNil())
+			})
+
+			It("does not copy the file again", func() {
+				Expect(reconcileNormal()).To(Succeed())
+				putData = nil
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(putData).To(BeNil())
+			})
+		})
+	})
+
+	Context("FromGuest", func() {
+		var guestData []byte
+
+		BeforeEach(func() {
+			source = nil
+			guestData = []byte(fileData)
+			fileTransfer.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+		})
+
+		JustBeforeEach(func() {
+			fakeVMProvider.GetVirtualMachineGuestFileFn = func(
+				_ context.Context,
+				_ *vmopv1.VirtualMachine,
+				_ vimtypes.NamePasswordAuthentication,
+				_ string,
+				maxSize int64) (io.ReadCloser, int64, error) {
+
+				Expect(maxSize).To(BeEquivalentTo(virtualmachineguestfiletransfer.MaxFileSize))
+				return io.NopCloser(bytes.NewReader(guestData)), int64(len(guestData)), nil
+			}
+		})
+
+		When("the transfer fails", func() {
+			JustBeforeEach(func() {
+				fakeVMProvider.GetVirtualMachineGuestFileFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					_ vimtypes.NamePasswordAuthentication,
+					_ string,
+					_ int64) (io.ReadCloser, int64, error) {
+
+					return nil, 0, errors.New("fubar")
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(reconcileNormal()).To(MatchError(ContainSubstring("fubar")))
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferFailedReason))
+			})
+		})
+
+		It("creates the Secret and marks the transfer as ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fileTransfer.Spec.Object.Name}, secret)).To(Succeed())
+			Expect(string(secret.Data[fileTransfer.Spec.Object.Key])).To(Equal(fileData))
+
+			Expect(conditions.IsTrue(fileTransfer, vmopv1.ReadyConditionType)).To(BeTrue())
+			Expect(fileTransfer.Status.Checksum).To(Equal(fileChecksum))
+		})
+
+		When("the object is a ConfigMap", func() {
+			BeforeEach(func() {
+				fileTransfer.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap
+			})
+
+			It("stores text as Data", func() {
+				Expect(reconcileNormal()).To(Succeed())
+
+				configMap := &corev1.ConfigMap{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fileTransfer.Spec.Object.Name}, configMap)).To(Succeed())
+				Expect(configMap.Data).To(HaveKeyWithValue(fileTransfer.Spec.Object.Key, fileData))
+				Expect(configMap.BinaryData).ToNot(HaveKey(fileTransfer.Spec.Object.Key))
+			})
+
+			When("the file is not valid UTF-8", func() {
+				BeforeEach(func() {
+					guestData = []byte{0xff, 0xfe, 0xfd}
+				})
+
+				It("stores the file as BinaryData", func() {
+					Expect(reconcileNormal()).To(Succeed())
+
+					configMap := &corev1.ConfigMap{}
+					Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fileTransfer.Spec.Object.Name}, configMap)).To(Succeed())
+					Expect(configMap.Data).ToNot(HaveKey(fileTransfer.Spec.Object.Key))
+					Expect(configMap.BinaryData).To(HaveKeyWithValue(fileTransfer.Spec.Object.Key, guestData))
+				})
+			})
+		})
+	})
+
+	Context("PersistentVolumeClaim", func() {
+		const (
+			pvcName  = "test-pvc"
+			fileKey  = "certs/ca.crt"
+			image    = "vmoperator:test"
+			capacity = "1Gi"
+		)
+
+		var (
+			pvc       *corev1.PersistentVolumeClaim
+			helperPod *corev1.Pod
+			volume    *bytes.Buffer
+			execCmds  [][]string
+		)
+
+		getHelperPod := func() (*corev1.Pod, error) {
+			pod := &corev1.Pod{}
+			err := ctx.Client.Get(ctx, client.ObjectKey{
+				Namespace: namespace,
+				Name:      virtualmachineguestfiletransfer.GetHelperPodName(transferName),
+			}, pod)
+			return pod, err
+		}
+
+		BeforeEach(func() {
+			source = nil
+			volume = bytes.NewBufferString(fileData)
+			execCmds = nil
+
+			fileTransfer.UID = "test-file-transfer-uid"
+			fileTransfer.Spec.Object = vmopv1.VirtualMachineGuestFileTransferObject{
+				Kind: vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim,
+				Name: pvcName,
+				Key:  fileKey,
+			}
+
+			pvc = &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      pvcName,
+				},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse(capacity),
+					},
+				},
+			}
+
+			helperPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      virtualmachineguestfiletransfer.GetHelperPodName(transferName),
+					Labels: map[string]string{
+						vmopv1.GuestFileTransferNameLabel: transferName,
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(
+							fileTransfer,
+							vmopv1.GroupVersion.WithKind("VirtualMachineGuestFileTransfer")),
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.PodNamespace = "vmop-system"
+				config.PodName = "vmop-controller-manager"
+				config.Features.VMGuestFileTransferPVC = true
+			})
+			Expect(ctx.Client.Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "vmop-system",
+					Name:      "vmop-controller-manager",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "sidecar", Image: "sidecar:test"},
+						{Name: "manager", Image: image},
+					},
+				},
+			})).To(Succeed())
+
+			if pvc != nil {
+				Expect(ctx.Client.Create(ctx, pvc)).To(Succeed())
+			}
+			if helperPod != nil {
+				Expect(ctx.Client.Create(ctx, helperPod)).To(Succeed())
+			}
+
+			filePath := "/data/" + fileKey
+
+			podExecutor.ExecFn = func(command []string, stdin io.Reader, stdout io.Writer) error {
+				execCmds = append(execCmds, command)
+				Expect(command).To(HaveLen(3))
+				Expect(command[0]).To(Equal(guestfiletransfer.HelperPath))
+				Expect(command[2]).To(Equal(filePath))
+
+				switch command[1] {
+				case guestfiletransfer.CommandSize:
+					if volume == nil {
+						return utilexec.CodeExitError{
+							Err:  errors.New("file not found"),
+							Code: guestfiletransfer.ExitCodeNotFound,
+						}
+					}
+					_, err := fmt.Fprintln(stdout, volume.Len())
+					return err
+				case guestfiletransfer.CommandRead:
+					_, err := io.Copy(stdout, bytes.NewReader(volume.Bytes()))
+					return err
+				case guestfiletransfer.CommandWrite:
+					volume = &bytes.Buffer{}
+					_, err := io.Copy(volume, stdin)
+					return err
+				}
+				return fmt.Errorf("unknown command %q", command[1])
+			}
+		})
+
+		AfterEach(func() {
+			pvc = nil
+			helperPod = nil
+		})
+
+		When("copying files to or from PersistentVolumeClaims is not enabled", func() {
+			JustBeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.VMGuestFileTransferPVC = false
+				})
+			})
+
+			It("marks the transfer as failed and does not exec into the helper Pod", func() {
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferFailedReason))
+				Expect(execCmds).To(BeEmpty())
+
+				_, err := getHelperPod()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		When("a Pod with the name of the helper Pod was not created for the transfer", func() {
+			BeforeEach(func() {
+				helperPod.Labels = nil
+				helperPod.OwnerReferences = nil
+			})
+
+			It("does not exec into or delete the Pod and requeues", func() {
+				err := reconcileNormal()
+				Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason))
+				Expect(execCmds).To(BeEmpty())
+
+				_, err = getHelperPod()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			When("the transfer is ready", func() {
+				BeforeEach(func() {
+					conditions.MarkTrue(fileTransfer, vmopv1.ReadyConditionType)
+				})
+
+				It("does not delete the Pod", func() {
+					Expect(reconcileNormal()).To(Succeed())
+
+					_, err := getHelperPod()
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		When("the PersistentVolumeClaim does not exist", func() {
+			BeforeEach(func() {
+				pvc = nil
+			})
+
+			It("returns an error", func() {
+				Expect(reconcileNormal()).ToNot(Succeed())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason))
+			})
+		})
+
+		When("the helper Pod does not exist", func() {
+			BeforeEach(func() {
+				helperPod = nil
+			})
+
+			It("creates the helper Pod and requeues", func() {
+				err := reconcileNormal()
+				Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason))
+
+				pod, err := getHelperPod()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metav1.IsControlledBy(pod, fileTransfer)).To(BeTrue())
+				Expect(pod.Labels).To(HaveKeyWithValue(vmopv1.GuestFileTransferNameLabel, transferName))
+				Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+				Expect(pod.Spec.Containers[0].Image).To(Equal(image))
+				Expect(pod.Spec.Containers[0].Command).To(Equal([]string{
+					guestfiletransfer.HelperPath,
+					guestfiletransfer.CommandWait,
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(HaveLen(1))
+				Expect(pod.Spec.Containers[0].VolumeMounts[0].ReadOnly).To(BeTrue())
+				Expect(pod.Spec.Volumes).To(HaveLen(1))
+				Expect(pod.Spec.Volumes[0].PersistentVolumeClaim).ToNot(BeNil())
+				Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pvcName))
+				Expect(execCmds).To(BeEmpty())
+			})
+
+			When("the file is copied from the guest", func() {
+				BeforeEach(func() {
+					fileTransfer.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+				})
+
+				It("mounts the PersistentVolumeClaim read-write", func() {
+					Expect(pkgerr.IsRequeueError(reconcileNormal())).To(BeTrue())
+
+					pod, err := getHelperPod()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Containers[0].VolumeMounts[0].ReadOnly).To(BeFalse())
+					Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeFalse())
+				})
+			})
+		})
+
+		When("the helper Pod failed", func() {
+			BeforeEach(func() {
+				helperPod.Status.Phase = corev1.PodFailed
+			})
+
+			It("deletes the helper Pod and requeues", func() {
+				err := reconcileNormal()
+				Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason))
+
+				_, err = getHelperPod()
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		Context("ToGuest", func() {
+			var putData []byte
+
+			JustBeforeEach(func() {
+				putData = nil
+				fakeVMProvider.PutVirtualMachineGuestFileFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					_ vimtypes.NamePasswordAuthentication,
+					_ string,
+					r io.Reader,
+					size int64,
+					_ bool) error {
+
+					var err error
+					putData, err = io.ReadAll(io.LimitReader(r, size))
+					return err
+				}
+			})
+
+			When("the file does not exist", func() {
+				BeforeEach(func() {
+					volume = nil
+				})
+
+				It("returns an error", func() {
+					Expect(reconcileNormal()).ToNot(Succeed())
+					Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason))
+				})
+			})
+
+			When("the transfer fails", func() {
+				JustBeforeEach(func() {
+					fakeVMProvider.PutVirtualMachineGuestFileFn = func(
+						_ context.Context,
+						_ *vmopv1.VirtualMachine,
+						_ vimtypes.NamePasswordAuthentication,
+						_ string,
+						_ io.Reader,
+						_ int64,
+						_ bool) error {
+
+						return errors.New("fubar")
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(reconcileNormal()).To(MatchError(ContainSubstring("fubar")))
+					Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferFailedReason))
+				})
+			})
+
+			It("copies the file to the guest, marks the transfer as ready, and deletes the helper Pod", func() {
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(string(putData)).To(Equal(fileData))
+
+				Expect(conditions.IsTrue(fileTransfer, vmopv1.ReadyConditionType)).To(BeTrue())
+				Expect(fileTransfer.Status.Size).To(BeEquivalentTo(len(fileData)))
+				Expect(fileTransfer.Status.Checksum).To(Equal(fileChecksum))
+
+				_, err := getHelperPod()
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		Context("FromGuest", func() {
+			var getMaxSize int64
+
+			BeforeEach(func() {
+				volume = nil
+				fileTransfer.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+			})
+
+			JustBeforeEach(func() {
+				fakeVMProvider.GetVirtualMachineGuestFileFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachine,
+					_ vimtypes.NamePasswordAuthentication,
+					_ string,
+					maxSize int64) (io.ReadCloser, int64, error) {
+
+					getMaxSize = maxSize
+					return io.NopCloser(strings.NewReader(fileData)), int64(len(fileData)), nil
+				}
+			})
+
+			It("copies the file to the PersistentVolumeClaim, marks the transfer as ready, and deletes the helper Pod", func() {
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(getMaxSize).To(BeEquivalentTo(1024 * 1024 * 1024))
+				Expect(volume).ToNot(BeNil())
+				Expect(volume.String()).To(Equal(fileData))
+
+				Expect(conditions.IsTrue(fileTransfer, vmopv1.ReadyConditionType)).To(BeTrue())
+				Expect(fileTransfer.Status.Size).To(BeEquivalentTo(len(fileData)))
+				Expect(fileTransfer.Status.Checksum).To(Equal(fileChecksum))
+
+				_, err := getHelperPod()
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			When("the helper fails to write the file", func() {
+				JustBeforeEach(func() {
+					podExecutor.ExecFn = func(_ []string, _ io.Reader, _ io.Writer) error {
+						return errors.New("read-only file system")
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(reconcileNormal()).To(MatchError(ContainSubstring("read-only file system")))
+					Expect(readyReason()).To(Equal(vmopv1.VirtualMachineGuestFileTransferFailedReason))
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,466 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineguestfiletransfer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	utilexec "k8s.io/client-go/util/exec"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	"github.com/vmware-tanzu/vm-operator/pkg/guestfiletransfer"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+const (
+	// HelperContainerName is the name of the container of the helper Pod.
+	HelperContainerName = "helper"
+
+	// HelperVolumeMountPath is the path at which the PersistentVolumeClaim is
+	// mounted in the helper Pod.
+	HelperVolumeMountPath = "/data"
+
+	// HelperPodActiveDeadline is how long the helper Pod may run before it is
+	// terminated, so a Pod is not left running if a transfer never completes.
+	HelperPodActiveDeadline = time.Hour
+
+	// managerContainerName is the name of the container of the VM Operator
+	// Pod whose image is used for the helper Pod.
+	managerContainerName = "manager"
+
+	// helperUserID is the ID of the nobody user the helper Pod runs as.
+	helperUserID = 65534
+
+	helperVolumeName = "data"
+)
+
+// GetHelperPodName returns the name of the helper Pod of a transfer. If the
+// name would exceed the maximum length of an object name, the name of the
+// transfer is truncated and a hash of it is added so the name remains unique.
+func GetHelperPodName(fileTransferName string) string {
+	name := fileTransferName + "-file-transfer"
+
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+
+	name = name[:validation.DNS1123SubdomainMaxLength-len(suffix)]
+	return strings.TrimRight(name, "-.") + suffix
+}
+
+// copyVolumeFile copies the file between the guest and the
+// PersistentVolumeClaim of the transfer, and returns the size and SHA-256
+// checksum of the file. The claim is mounted by a helper Pod, and the file is
+// streamed through the helper with the pods/exec subresource.
+func (r *Reconciler) copyVolumeFile(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication) (int64, []byte, error) {
+
+	fileTransfer := ctx.FileTransfer
+	obj := fileTransfer.Spec.Object
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: fileTransfer.Namespace,
+		Name:      obj.Name,
+	}, pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(
+				fileTransfer,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason,
+				"%s",
+				err)
+		}
+		return 0, nil, fmt.Errorf("failed to get PersistentVolumeClaim %q: %w", obj.Name, err)
+	}
+
+	pod, err := r.getOrCreateHelperPod(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	filePath := path.Join(HelperVolumeMountPath, obj.Key)
+
+	switch fileTransfer.Spec.Direction {
+	case vmopv1.VirtualMachineGuestFileTransferDirectionToGuest:
+		var stdout bytes.Buffer
+		if err := r.execHelper(ctx, pod, guestfiletransfer.CommandSize, filePath, nil, &stdout); err != nil {
+			if isHelperNotFoundError(err) {
+				conditions.MarkFalse(
+					fileTransfer,
+					vmopv1.ReadyConditionType,
+					vmopv1.VirtualMachineGuestFileTransferObjectNotFoundReason,
+					"PersistentVolumeClaim %q does not have the file %q",
+					obj.Name,
+					obj.Key)
+			}
+			return 0, nil, fmt.Errorf("failed to get size of file in PersistentVolumeClaim %q: %w", obj.Name, err)
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(stdout.String()), 10, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to parse size of file in PersistentVolumeClaim %q: %w", obj.Name, err)
+		}
+
+		// The helper writes the file to the pipe while the provider uploads
+		// what is read from the pipe to the guest.
+		pr, pw := io.Pipe()
+		execErr := make(chan error, 1)
+		go func() {
+			err := r.execHelper(ctx, pod, guestfiletransfer.CommandRead, filePath, nil, pw)
+			_ = pw.CloseWithError(err)
+			execErr <- err
+		}()
+
+		h := sha256.New()
+		err = r.VMProvider.PutVirtualMachineGuestFile(
+			ctx,
+			vm,
+			auth,
+			fileTransfer.Spec.GuestPath,
+			io.TeeReader(pr, h),
+			size,
+			fileTransfer.Spec.Overwrite)
+		if err == nil {
+			// Anything left in the pipe means the file grew after its size
+			// was read.
+			if n, _ := io.Copy(io.Discard, pr); n > 0 {
+				err = fmt.Errorf("file %q changed while it was copied", obj.Key)
+			}
+		}
+		_ = pr.CloseWithError(err)
+		if rerr := <-execErr; err == nil && rerr != nil {
+			err = fmt.Errorf("failed to read file from PersistentVolumeClaim %q: %w", obj.Name, rerr)
+		}
+		if err != nil {
+			markTransferFailed(fileTransfer, err)
+			return 0, nil, fmt.Errorf("failed to copy file to guest: %w", err)
+		}
+
+		return size, h.Sum(nil), nil
+
+	case vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest:
+		// The file cannot be larger than the claim.
+		maxSize := int64(math.MaxInt64)
+		if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+			maxSize = capacity.Value()
+		}
+
+		rc, size, err := r.VMProvider.GetVirtualMachineGuestFile(
+			ctx,
+			vm,
+			auth,
+			fileTransfer.Spec.GuestPath,
+			maxSize)
+		if err != nil {
+			markTransferFailed(fileTransfer, err)
+			return 0, nil, fmt.Errorf("failed to copy file from guest: %w", err)
+		}
+		defer rc.Close()
+
+		h := sha256.New()
+		if err := r.execHelper(
+			ctx,
+			pod,
+			guestfiletransfer.CommandWrite,
+			filePath,
+			io.TeeReader(rc, h),
+			io.Discard); err != nil {
+
+			markTransferFailed(fileTransfer, err)
+			return 0, nil, fmt.Errorf("failed to write file to PersistentVolumeClaim %q: %w", obj.Name, err)
+		}
+
+		return size, h.Sum(nil), nil
+	}
+
+	return 0, nil, pkgerr.NoRequeueError{
+		Message: fmt.Sprintf("unsupported direction %q", fileTransfer.Spec.Direction),
+	}
+}
+
+// getOrCreateHelperPod returns the running helper Pod of the transfer,
+// creating it if it does not exist. A RequeueError is returned if the Pod is
+// not running yet.
+func (r *Reconciler) getOrCreateHelperPod(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext) (*corev1.Pod, error) {
+
+	fileTransfer := ctx.FileTransfer
+
+	pod, err := r.getHelperPod(ctx)
+	if err != nil {
+		if errors.Is(err, errNotHelperPod) {
+			conditions.MarkFalse(
+				fileTransfer,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason,
+				"Pod %q was not created for the transfer",
+				GetHelperPodName(fileTransfer.Name))
+			return nil, pkgerr.RequeueError{
+				After:   HelperPodNotReadyRequeueDelay,
+				Message: err.Error(),
+			}
+		}
+		return nil, err
+	}
+	if pod == nil {
+		pod, err = r.newHelperPod(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.Create(ctx, pod); err != nil {
+			if apierrors.IsForbidden(err) {
+				conditions.MarkFalse(
+					fileTransfer,
+					vmopv1.ReadyConditionType,
+					vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason,
+					"VM Operator is not allowed to create the helper Pod in namespace %q: %s",
+					fileTransfer.Namespace,
+					err)
+			}
+			return nil, fmt.Errorf("failed to create helper Pod: %w", err)
+		}
+		ctx.Logger.Info("Created helper Pod", "pod", pod.Name)
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning:
+		return pod, nil
+
+	case corev1.PodFailed, corev1.PodSucceeded:
+		// The Pod is recreated on the next reconcile.
+		if err := r.Delete(ctx, pod, client.Preconditions{UID: &pod.UID}); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to delete helper Pod: %w", err)
+		}
+		conditions.MarkFalse(
+			fileTransfer,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason,
+			"Helper Pod %q is %s",
+			pod.Name,
+			pod.Status.Phase)
+		return nil, pkgerr.RequeueError{
+			After:   HelperPodNotReadyRequeueDelay,
+			Message: "helper pod is not running",
+		}
+	}
+
+	conditions.MarkFalse(
+		fileTransfer,
+		vmopv1.ReadyConditionType,
+		vmopv1.VirtualMachineGuestFileTransferHelperPodNotReadyReason,
+		"Helper Pod %q is not running",
+		pod.Name)
+	return nil, pkgerr.RequeueError{
+		After:   HelperPodNotReadyRequeueDelay,
+		Message: "helper pod is not running",
+	}
+}
+
+// errNotHelperPod is returned by getHelperPod when a Pod with the name of the
+// helper Pod exists but was not created by VM Operator for the transfer.
+var errNotHelperPod = errors.New("pod is not the helper pod of the transfer")
+
+// getHelperPod returns the helper Pod of the transfer, or nil if it does not
+// exist. errNotHelperPod is returned if the Pod does not have the label of
+// the transfer or is not owned by the transfer, so VM Operator never execs
+// into or deletes a Pod it did not create.
+func (r *Reconciler) getHelperPod(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext) (*corev1.Pod, error) {
+
+	fileTransfer := ctx.FileTransfer
+
+	// Pods are read with the API reader to avoid caching every Pod in the
+	// cluster.
+	pod := &corev1.Pod{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{
+		Namespace: fileTransfer.Namespace,
+		Name:      GetHelperPodName(fileTransfer.Name),
+	}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get helper Pod: %w", err)
+	}
+
+	if pod.Labels[vmopv1.GuestFileTransferNameLabel] != fileTransfer.Name ||
+		!metav1.IsControlledBy(pod, fileTransfer) {
+
+		return nil, errNotHelperPod
+	}
+
+	return pod, nil
+}
+
+// newHelperPod returns the helper Pod of the transfer. The Pod runs the VM
+// Operator image, which includes the helper, and mounts the claim read-only
+// when the file is copied to the guest.
+func (r *Reconciler) newHelperPod(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext) (*corev1.Pod, error) {
+
+	fileTransfer := ctx.FileTransfer
+	readOnly := fileTransfer.Spec.Direction == vmopv1.VirtualMachineGuestFileTransferDirectionToGuest
+
+	image, err := r.getHelperImage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: fileTransfer.Namespace,
+			Name:      GetHelperPodName(fileTransfer.Name),
+			Labels: map[string]string{
+				vmopv1.GuestFileTransferNameLabel: fileTransfer.Name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds:        ptr.To(int64(HelperPodActiveDeadline.Seconds())),
+			AutomountServiceAccountToken: ptr.To(false),
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:        ptr.To(true),
+				RunAsUser:           ptr.To(int64(helperUserID)),
+				RunAsGroup:          ptr.To(int64(helperUserID)),
+				FSGroup:             ptr.To(int64(helperUserID)),
+				FSGroupChangePolicy: ptr.To(corev1.FSGroupChangeOnRootMismatch),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:            HelperContainerName,
+					Image:           image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{guestfiletransfer.HelperPath, guestfiletransfer.CommandWait},
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: ptr.To(false),
+						ReadOnlyRootFilesystem:   ptr.To(true),
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{"ALL"},
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      helperVolumeName,
+							MountPath: HelperVolumeMountPath,
+							ReadOnly:  readOnly,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: helperVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: fileTransfer.Spec.Object.Name,
+							ReadOnly:  readOnly,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(fileTransfer, pod, r.Scheme()); err != nil {
+		return nil, fmt.Errorf("failed to set owner of helper Pod: %w", err)
+	}
+
+	return pod, nil
+}
+
+// getHelperImage returns the image of the VM Operator Pod.
+func (r *Reconciler) getHelperImage(ctx *pkgctx.VirtualMachineGuestFileTransferContext) (string, error) {
+	cfg := pkgcfg.FromContext(ctx)
+
+	pod := &corev1.Pod{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{
+		Namespace: cfg.PodNamespace,
+		Name:      cfg.PodName,
+	}, pod); err != nil {
+		return "", fmt.Errorf("failed to get VM Operator Pod: %w", err)
+	}
+
+	for _, c := range pod.Spec.Containers {
+		if c.Name == managerContainerName {
+			return c.Image, nil
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Image, nil
+	}
+
+	return "", fmt.Errorf("VM Operator Pod %s/%s has no containers", pod.Namespace, pod.Name)
+}
+
+// deleteHelperPod deletes the helper Pod of the transfer if it exists.
+func (r *Reconciler) deleteHelperPod(ctx *pkgctx.VirtualMachineGuestFileTransferContext) error {
+	pod, err := r.getHelperPod(ctx)
+	if err != nil {
+		if errors.Is(err, errNotHelperPod) {
+			return nil
+		}
+		return err
+	}
+	if pod == nil {
+		return nil
+	}
+	// The precondition ensures a Pod that replaced the helper Pod since it
+	// was read is not deleted.
+	if err := r.Delete(ctx, pod, client.Preconditions{UID: &pod.UID}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete helper Pod: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) execHelper(
+	ctx *pkgctx.VirtualMachineGuestFileTransferContext,
+	pod *corev1.Pod,
+	command, filePath string,
+	stdin io.Reader,
+	stdout io.Writer) error {
+
+	return r.PodExecutor.Exec(
+		ctx,
+		pod.Namespace,
+		pod.Name,
+		HelperContainerName,
+		[]string{guestfiletransfer.HelperPath, command, filePath},
+		stdin,
+		stdout)
+}
+
+func isHelperNotFoundError(err error) bool {
+	var exitErr utilexec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitStatus() == guestfiletransfer.ExitCodeNotFound
+}
@@ -0,0 +1,303 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineimage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/ovf"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/contentlibrary"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+)
+
+// SkipNameValidation is used for testing to allow multiple controllers with the
+// same name since Controller-Runtime has a global singleton registry to
+// prevent controllers with the same name, even if attached to different
+// managers.
+var SkipNameValidation *bool
+
+// AddToManager adds this package's controllers to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	if err := addToManager(ctx, mgr, &vmopv1.VirtualMachineImage{}); err != nil {
+		return err
+	}
+	return addToManager(ctx, mgr, &vmopv1.ClusterVirtualMachineImage{})
+}
+
+func addToManager(
+	ctx *pkgctx.ControllerManagerContext,
+	mgr manager.Manager,
+	obj client.Object) error {
+
+	var (
+		controlledType     = obj
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(o client.Object) bool {
+				return ociSource(o) != nil
+			}))).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			SkipNameValidation:      SkipNameValidation,
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// ociSource returns the OCI source of a VirtualMachineImage or
+// ClusterVirtualMachineImage, or nil if the image is not from an OCI
+// registry.
+func ociSource(obj client.Object) *vmopv1.VirtualMachineImageOCISource {
+	switch o := obj.(type) {
+	case *vmopv1.VirtualMachineImage:
+		return o.Spec.OCI
+	case *vmopv1.ClusterVirtualMachineImage:
+		return o.Spec.OCI
+	}
+	return nil
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineImage or ClusterVirtualMachineImage
+// object whose content is an OCI artifact.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=clustervirtualmachineimages,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=clustervirtualmachineimages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	var (
+		obj             client.Object
+		status          *vmopv1.VirtualMachineImageStatus
+		secretNamespace string
+	)
+
+	if req.Namespace != "" {
+		var o vmopv1.VirtualMachineImage
+		if err := r.Get(ctx, req.NamespacedName, &o); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		obj, status, secretNamespace = &o, &o.Status, o.Namespace
+	} else {
+		var o vmopv1.ClusterVirtualMachineImage
+		if err := r.Get(ctx, req.NamespacedName, &o); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		// The Secret for a cluster-scoped image is in the pod's namespace.
+		obj, status, secretNamespace = &o, &o.Status, pkgcfg.FromContext(ctx).PodNamespace
+	}
+
+	src := ociSource(obj)
+	if src == nil || !obj.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(obj, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf(
+			"failed to init patch helper for %s: %w", req.NamespacedName, err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, obj); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			pkglog.FromContextOrDefault(ctx).Error(err, "patch failed")
+		}
+	}()
+
+	return pkgerr.ResultFromError(r.ReconcileNormal(ctx, obj, *src, status, secretNamespace))
+}
+
+// ReconcileNormal syncs the status of the image from the OVF descriptor of
+// the OCI artifact.
+func (r *Reconciler) ReconcileNormal(
+	ctx context.Context,
+	obj client.Object,
+	src vmopv1.VirtualMachineImageOCISource,
+	status *vmopv1.VirtualMachineImageStatus,
+	secretNamespace string) error {
+
+	logger := pkglog.FromContextOrDefault(ctx).WithValues("reference", src.Reference)
+
+	err := r.syncImageContent(ctx, obj, src, status, secretNamespace)
+	if err != nil {
+		logger.Error(err, "Failed to sync image from OCI artifact")
+		conditions.MarkError(
+			status,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineImageOCIArtifactUnavailableReason,
+			err)
+	} else {
+		conditions.MarkTrue(status, vmopv1.ReadyConditionType)
+	}
+
+	r.Recorder.EmitEvent(obj, "Update", err, false)
+	return err
+}
+
+func (r *Reconciler) syncImageContent(
+	ctx context.Context,
+	obj client.Object,
+	src vmopv1.VirtualMachineImageOCISource,
+	status *vmopv1.VirtualMachineImageStatus,
+	secretNamespace string) error {
+
+	ref, err := oci.ParseReference(src.Reference)
+	if err != nil {
+		return pkgerr.NoRequeueError{Message: err.Error()}
+	}
+
+	// An artifact referred to by its digest never changes.
+	if ref.Digest != "" && ref.Digest == status.ProviderContentVersion &&
+		conditions.IsTrue(status, vmopv1.ReadyConditionType) {
+
+		return nil
+	}
+
+	creds, err := oci.GetCredentials(ctx, r.Client, secretNamespace, src.SecretName, ref.Registry)
+	if err != nil {
+		return fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+
+	ociClient := oci.NewClient(oci.Options{
+		Credentials:        creds,
+		InsecureSkipVerify: src.InsecureSkipTLSVerify,
+	})
+
+	manifest, digest, err := ociClient.GetManifest(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("failed to get manifest %s: %w", ref, err)
+	}
+
+	if digest == status.ProviderContentVersion &&
+		conditions.IsTrue(status, vmopv1.ReadyConditionType) {
+
+		return nil
+	}
+
+	if manifest.ArtifactType != oci.ArtifactType &&
+		manifest.Config.MediaType != oci.MediaTypeConfig {
+
+		return pkgerr.NoRequeueError{
+			Message: fmt.Sprintf("%s is not a VM artifact", ref),
+		}
+	}
+
+	ovfLayer, ok := manifest.LayerByMediaType(oci.MediaTypeOVF)
+	if !ok {
+		return pkgerr.NoRequeueError{
+			Message: fmt.Sprintf("%s does not have an OVF descriptor", ref),
+		}
+	}
+
+	ovfData, err := ociClient.GetBlobBytes(ctx, ref, ovfLayer)
+	if err != nil {
+		return fmt.Errorf("failed to get OVF descriptor: %w", err)
+	}
+
+	envelope, err := ovf.Unmarshal(bytes.NewReader(ovfData))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OVF descriptor: %w", err)
+	}
+
+	configData, err := ociClient.GetBlobBytes(ctx, ref, manifest.Config)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	var config oci.Config
+	if err := json.Unmarshal(configData, &config); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	if err := contentlibrary.UpdateVmiWithOvfEnvelope(obj, *envelope); err != nil {
+		return err
+	}
+
+	status.Name = config.Name
+	if status.Name == "" {
+		status.Name = obj.GetName()
+	}
+	status.Type = string(imgregv1a1.ContentLibraryItemTypeOvf)
+	status.ProviderItemID = digest
+	status.ProviderContentVersion = digest
+
+	// Ensure the friendly name is set in an annotation for metadata-only
+	// listers.
+	annos := obj.GetAnnotations()
+	if annos == nil {
+		annos = map[string]string{}
+	}
+	annos[pkgconst.DisplayNameAnnotationKey] = status.Name
+	obj.SetAnnotations(annos)
+
+	// Sync the image's type, OS information and capabilities to the resource's
+	// labels to make it easier for clients to search for images.
+	imgutil.SyncStatusToLabels(obj, *status)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineimage_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci/ocitest"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx      *builder.IntegrationTestContext
+		registry *ocitest.Registry
+		digest   string
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+		registry = ocitest.NewRegistry()
+		digest = pushOCIImage(registry, registry.Host()+"/project/ttylinux:v1")
+	})
+
+	AfterEach(func() {
+		registry.Close()
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should sync the image from the OCI artifact", func() {
+		vmi := &vmopv1.VirtualMachineImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-image",
+				Namespace: ctx.Namespace,
+			},
+			Spec: vmopv1.VirtualMachineImageSpec{
+				OCI: &vmopv1.VirtualMachineImageOCISource{
+					Reference:             registry.Host() + "/project/ttylinux:v1",
+					InsecureSkipTLSVerify: true,
+				},
+			},
+		}
+		Expect(ctx.Client.Create(ctx, vmi)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(vmi), vmi)).To(Succeed())
+			g.Expect(conditions.IsTrue(vmi, vmopv1.ReadyConditionType)).To(BeTrue())
+			g.Expect(vmi.Status.ProviderContentVersion).To(Equal(digest))
+		}).Should(Succeed())
+	})
+}
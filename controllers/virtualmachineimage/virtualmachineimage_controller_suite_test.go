@@ -0,0 +1,75 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineimage_test
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimage"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/manager"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci/ocitest"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+	"github.com/vmware-tanzu/vm-operator/test/testutil"
+)
+
+var suite = builder.NewTestSuiteForControllerWithContext(
+	pkgcfg.NewContextWithDefaultConfig(),
+	virtualmachineimage.AddToManager,
+	manager.InitializeProvidersNoopFn)
+
+func TestVirtualMachineImage(t *testing.T) {
+	suite.Register(t, "VirtualMachineImage controller suite", intgTests, unitTests)
+}
+
+var _ = BeforeSuite(suite.BeforeSuite)
+
+var _ = AfterSuite(suite.AfterSuite)
+
+// pushOCIImage pushes a VM artifact with the ttylinux OVF descriptor to the
+// registry and returns the digest of its manifest.
+func pushOCIImage(registry *ocitest.Registry, reference string) string {
+	GinkgoHelper()
+
+	ovfData, err := os.ReadFile(path.Join(
+		testutil.GetRootDirOrDie(),
+		"test", "builder", "testdata",
+		"images", "ttylinux-pc_i486-16.1.ovf"))
+	Expect(err).ToNot(HaveOccurred())
+
+	ref, err := oci.ParseReference(reference)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		ctx    = context.Background()
+		client = oci.NewClient(oci.Options{InsecureSkipVerify: true})
+	)
+
+	ovfDesc, err := client.PushBlob(ctx, ref, oci.MediaTypeOVF, strings.NewReader(string(ovfData)))
+	Expect(err).ToNot(HaveOccurred())
+	ovfDesc.Annotations = map[string]string{oci.AnnotationTitle: "ttylinux-pc_i486-16.1.ovf"}
+
+	configDesc, err := client.PushBlob(ctx, ref, oci.MediaTypeConfig, strings.NewReader(`{"name":"ttylinux"}`))
+	Expect(err).ToNot(HaveOccurred())
+
+	digest, err := client.PushManifest(ctx, ref, oci.Manifest{
+		SchemaVersion: 2,
+		MediaType:     oci.MediaTypeImageManifest,
+		ArtifactType:  oci.ArtifactType,
+		Config:        configDesc,
+		Layers:        []oci.Descriptor{ovfDesc},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	return digest
+}
@@ -0,0 +1,176 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineimage_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineimage"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci/ocitest"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachineimage.Reconciler
+		registry   *ocitest.Registry
+		digest     string
+		vmi        *vmopv1.VirtualMachineImage
+	)
+
+	BeforeEach(func() {
+		registry = ocitest.NewRegistry()
+		digest = pushOCIImage(registry, registry.Host()+"/project/ttylinux:v1")
+
+		vmi = &vmopv1.VirtualMachineImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-image",
+				Namespace: "dummy-ns",
+			},
+			Spec: vmopv1.VirtualMachineImageSpec{
+				OCI: &vmopv1.VirtualMachineImageOCISource{
+					Reference:             registry.Host() + "/project/ttylinux:v1",
+					InsecureSkipTLSVerify: true,
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		initObjects = append(initObjects, vmi)
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachineimage.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		registry.Close()
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcileNormal := func() error {
+		return reconciler.ReconcileNormal(ctx, vmi, *vmi.Spec.OCI, &vmi.Status, vmi.Namespace)
+	}
+
+	Context("ReconcileNormal", func() {
+		It("syncs the status from the OVF descriptor", func() {
+			Expect(reconcileNormal()).To(Succeed())
+
+			Expect(conditions.IsTrue(vmi, vmopv1.ReadyConditionType)).To(BeTrue())
+			Expect(vmi.Status.Name).To(Equal("ttylinux"))
+			Expect(vmi.Status.Type).To(Equal("OVF"))
+			Expect(vmi.Status.ProviderItemID).To(Equal(digest))
+			Expect(vmi.Status.ProviderContentVersion).To(Equal(digest))
+			Expect(vmi.Status.Firmware).To(Equal("efi"))
+			Expect(vmi.Status.Disks).To(HaveLen(1))
+			Expect(vmi.Annotations).To(HaveKeyWithValue(pkgconst.DisplayNameAnnotationKey, "ttylinux"))
+		})
+
+		When("the reference includes the digest", func() {
+			BeforeEach(func() {
+				vmi.Spec.OCI.Reference = registry.Host() + "/project/ttylinux@" + digest
+			})
+
+			It("syncs the status from the OVF descriptor", func() {
+				Expect(reconcileNormal()).To(Succeed())
+				Expect(conditions.IsTrue(vmi, vmopv1.ReadyConditionType)).To(BeTrue())
+				Expect(vmi.Status.ProviderContentVersion).To(Equal(digest))
+			})
+		})
+
+		When("the reference is invalid", func() {
+			BeforeEach(func() {
+				vmi.Spec.OCI.Reference = "not a reference"
+			})
+
+			It("marks the image not ready and does not requeue", func() {
+				err := reconcileNormal()
+				Expect(pkgerr.IsNoRequeueError(err)).To(BeTrue())
+
+				c := conditions.Get(vmi, vmopv1.ReadyConditionType)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineImageOCIArtifactUnavailableReason))
+			})
+		})
+
+		When("the artifact does not exist", func() {
+			BeforeEach(func() {
+				vmi.Spec.OCI.Reference = registry.Host() + "/project/ttylinux:v2"
+			})
+
+			It("marks the image not ready and returns an error", func() {
+				Expect(reconcileNormal()).ToNot(Succeed())
+				Expect(conditions.IsFalse(vmi, vmopv1.ReadyConditionType)).To(BeTrue())
+			})
+		})
+
+		When("the registry requires credentials", func() {
+			BeforeEach(func() {
+				registry.Username = "user"
+				registry.Password = "pass"
+				vmi.Spec.OCI.SecretName = "my-secret"
+			})
+
+			When("the Secret does not exist", func() {
+				It("marks the image not ready and returns an error", func() {
+					Expect(reconcileNormal()).ToNot(Succeed())
+					Expect(conditions.IsFalse(vmi, vmopv1.ReadyConditionType)).To(BeTrue())
+				})
+			})
+
+			When("the Secret exists", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "my-secret",
+							Namespace: vmi.Namespace,
+						},
+						Type: corev1.SecretTypeBasicAuth,
+						Data: map[string][]byte{
+							corev1.BasicAuthUsernameKey: []byte("user"),
+							corev1.BasicAuthPasswordKey: []byte("pass"),
+						},
+					})
+				})
+
+				It("syncs the status from the OVF descriptor", func() {
+					Expect(reconcileNormal()).To(Succeed())
+					Expect(conditions.IsTrue(vmi, vmopv1.ReadyConditionType)).To(BeTrue())
+				})
+			})
+		})
+	})
+}
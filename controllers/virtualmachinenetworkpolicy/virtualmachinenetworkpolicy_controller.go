@@ -0,0 +1,306 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinenetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	ncpv1alpha1 "github.com/vmware-tanzu/vm-operator/external/ncp/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineNetworkPolicy{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.VMToNetworkPolicies(ctx)),
+		)
+
+	// Requeue the policy when the network provider updates the realization
+	// status of the SecurityPolicies the policy was translated into, or of the
+	// network interfaces of the VMs the policy selects.
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeNSXT:
+		builder = builder.Owns(&nsxv1alpha1.SecurityPolicy{}).
+			Watches(&ncpv1alpha1.VirtualNetworkInterface{},
+				handler.EnqueueRequestsFromMapFunc(r.NetworkInterfaceToNetworkPolicies(ctx)))
+	case pkgcfg.NetworkProviderTypeVPC:
+		builder = builder.Owns(&vpcv1alpha1.SecurityPolicy{}).
+			Watches(&vpcv1alpha1.SubnetPort{},
+				handler.EnqueueRequestsFromMapFunc(r.NetworkInterfaceToNetworkPolicies(ctx)))
+	}
+
+	return builder.
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// VMToNetworkPolicies is a mapper function to be used to enqueue requests for
+// reconciliation for the VirtualMachineNetworkPolicies that select a VM.
+func (r *Reconciler) VMToNetworkPolicies(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vm, ok := o.(*vmopv1.VirtualMachine)
+		if !ok {
+			panic(fmt.Sprintf("Expected a VirtualMachine, but got a %T", o))
+		}
+
+		var policyList vmopv1.VirtualMachineNetworkPolicyList
+		if err := r.List(ctx, &policyList, client.InNamespace(vm.Namespace)); err != nil {
+			ctx.Logger.Error(err, "Failed listing VirtualMachineNetworkPolicies for VM")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, policy := range policyList.Items {
+			// A VM that no longer matches the selector must still be removed
+			// from the status of the policy.
+			selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.VMSelector)
+			if err != nil || (!selector.Matches(labels.Set(vm.Labels)) && !hasVMStatus(policy, vm.Name)) {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&policy),
+			})
+		}
+
+		return requests
+	}
+}
+
+// NetworkInterfaceToNetworkPolicies is a mapper function to be used to enqueue
+// requests for reconciliation for the VirtualMachineNetworkPolicies that select
+// the VM of a network interface.
+func (r *Reconciler) NetworkInterfaceToNetworkPolicies(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	vmToNetworkPolicies := r.VMToNetworkPolicies(ctx)
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vmName := o.GetLabels()[network.VMNameLabel]
+		if vmName == "" {
+			return nil
+		}
+
+		vm := &vmopv1.VirtualMachine{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: o.GetNamespace(), Name: vmName}, vm); err != nil {
+			if !apierrors.IsNotFound(err) {
+				ctx.Logger.Error(err, "Failed to get VM for network interface")
+			}
+			return nil
+		}
+
+		return vmToNetworkPolicies(ctx, vm)
+	}
+}
+
+func hasVMStatus(policy vmopv1.VirtualMachineNetworkPolicy, vmName string) bool {
+	for _, s := range policy.Status.VirtualMachines {
+		if s.Name == vmName {
+			return true
+		}
+	}
+	return false
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineNetworkPolicy object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinenetworkpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinenetworkpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=nsx.vmware.com,resources=securitypolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nsx.vmware.com,resources=securitypolicies/status,verbs=get
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=securitypolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=securitypolicies/status,verbs=get
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmware.com,resources=virtualnetworkinterfaces,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	policy := &vmopv1.VirtualMachineNetworkPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The SecurityPolicies are garbage collected via their owner reference.
+	if !policy.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	npCtx := &pkgctx.VirtualMachineNetworkPolicyContext{
+		Context:       ctx,
+		Logger:        pkglog.FromContextOrDefault(ctx),
+		NetworkPolicy: policy,
+	}
+
+	patchHelper, err := patch.NewHelper(policy, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", npCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, policy); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			npCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	if err := r.ReconcileNormal(npCtx); err != nil {
+		npCtx.Logger.Error(err, "Failed to reconcile VirtualMachineNetworkPolicy")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcileNormal translates the policy into the network provider's security
+// constructs, and updates the status of the policy with the selected VMs. The
+// policy is enforced for a VM once the SecurityPolicies and the VM's own
+// network interfaces have been realized.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineNetworkPolicyContext) error {
+	policy := ctx.NetworkPolicy
+	policy.Status.ObservedGeneration = policy.Generation
+
+	selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.VMSelector)
+	if err != nil {
+		conditions.MarkError(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced, "InvalidSelector", err)
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+
+	var (
+		enforced bool
+		message  string
+	)
+
+	realized, realizedMsg, err := network.ReconcileNetworkPolicy(ctx, r.Client, policy)
+	switch {
+	case errors.Is(err, network.ErrNetworkPolicyNotSupported):
+		// There is nothing to retry until the network provider changes.
+		message = err.Error()
+		conditions.MarkFalse(
+			policy,
+			vmopv1.VirtualMachineNetworkPolicyConditionEnforced,
+			vmopv1.VirtualMachineNetworkPolicyNotSupportedReason,
+			"%s", message)
+	case err != nil:
+		conditions.MarkError(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced, "ReconcileError", err)
+		return err
+	case !realized:
+		message = realizedMsg
+		conditions.MarkFalse(
+			policy,
+			vmopv1.VirtualMachineNetworkPolicyConditionEnforced,
+			vmopv1.VirtualMachineNetworkPolicyNotRealizedReason,
+			"%s", message)
+	default:
+		enforced = true
+		conditions.MarkTrue(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced)
+	}
+
+	var vmList vmopv1.VirtualMachineList
+	if err := r.List(
+		ctx,
+		&vmList,
+		client.InNamespace(policy.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+
+		return fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	vmStatuses := make([]vmopv1.VirtualMachineNetworkPolicyVMStatus, 0, len(vmList.Items))
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		status := vmopv1.VirtualMachineNetworkPolicyVMStatus{
+			Name:    vm.Name,
+			Message: message,
+		}
+		switch {
+		case !enforced:
+		case vm.Spec.Network != nil && vm.Spec.Network.Disabled:
+			status.Message = "VM networking is disabled"
+		default:
+			vmEnforced, vmMessage, err := network.IsNetworkPolicyEnforcedForVM(ctx, r.Client, vm)
+			if err != nil {
+				return err
+			}
+			status.Enforced, status.Message = vmEnforced, vmMessage
+		}
+		vmStatuses = append(vmStatuses, status)
+	}
+	slices.SortFunc(vmStatuses, func(a, b vmopv1.VirtualMachineNetworkPolicyVMStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	policy.Status.VirtualMachines = vmStatuses
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinenetworkpolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx    *builder.IntegrationTestContext
+		policy *vmopv1.VirtualMachineNetworkPolicy
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		policy = &vmopv1.VirtualMachineNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-policy",
+				Namespace: ctx.Namespace,
+			},
+			Spec: vmopv1.VirtualMachineNetworkPolicySpec{
+				VMSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"appname": "db"},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should update the status as selected VMs are created", func() {
+		Expect(ctx.Client.Create(ctx, policy)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			g.Expect(policy.Status.VirtualMachines).To(BeEmpty())
+			g.Expect(conditions.IsFalse(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced)).To(BeTrue())
+		}).Should(Succeed())
+
+		vm := builder.DummyBasicVirtualMachine("dummy-vm", ctx.Namespace)
+		vm.Labels = map[string]string{"appname": "db"}
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			g.Expect(policy.Status.VirtualMachines).To(HaveLen(1))
+			g.Expect(policy.Status.VirtualMachines[0].Name).To(Equal(vm.Name))
+		}).Should(Succeed())
+	})
+}
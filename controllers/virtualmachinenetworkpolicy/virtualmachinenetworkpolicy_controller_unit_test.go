@@ -0,0 +1,266 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinenetworkpolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinenetworkpolicy"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace  = "test-namespace"
+		policyName = "test-policy"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinenetworkpolicy.Reconciler
+		policy     *vmopv1.VirtualMachineNetworkPolicy
+	)
+
+	newVM := func(name, app string) *vmopv1.VirtualMachine {
+		vm := builder.DummyBasicVirtualMachine(name, namespace)
+		vm.Labels = map[string]string{"app": app}
+		return vm
+	}
+
+	newSubnetPort := func(vmName string, ready corev1.ConditionStatus, message string) *vpcv1alpha1.SubnetPort {
+		return &vpcv1alpha1.SubnetPort{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      network.VPCCRName(vmName, "", "eth0"),
+				Namespace: namespace,
+				Labels: map[string]string{
+					network.VMNameLabel: vmName,
+				},
+			},
+			Status: vpcv1alpha1.SubnetPortStatus{
+				Conditions: []vpcv1alpha1.Condition{
+					{
+						Type:    vpcv1alpha1.Ready,
+						Status:  ready,
+						Message: message,
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		policy = &vmopv1.VirtualMachineNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      policyName,
+				Namespace: namespace,
+				UID:       "test-policy-uid",
+			},
+			Spec: vmopv1.VirtualMachineNetworkPolicySpec{
+				VMSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+				Ingress: []vmopv1.VirtualMachineNetworkPolicyIngressRule{{}},
+			},
+		}
+
+		initObjects = []client.Object{
+			policy,
+			newVM("vm-2", "db"),
+			newVM("vm-1", "db"),
+			newVM("vm-web", "web"),
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinenetworkpolicy.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcilePolicy := func() error {
+		_, err := reconciler.Reconcile(
+			cource.WithContext(ctx),
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: policyName}})
+		return err
+	}
+
+	When("the network provider does not support network policies", func() {
+		It("marks the policy as not enforced", func() {
+			Expect(reconcilePolicy()).To(Succeed())
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+
+			c := conditions.Get(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineNetworkPolicyNotSupportedReason))
+
+			Expect(policy.Status.VirtualMachines).To(HaveLen(2))
+			Expect(policy.Status.VirtualMachines[0].Name).To(Equal("vm-1"))
+			Expect(policy.Status.VirtualMachines[0].Enforced).To(BeFalse())
+			Expect(policy.Status.VirtualMachines[1].Name).To(Equal("vm-2"))
+		})
+	})
+
+	When("the network provider is VPC", func() {
+		JustBeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+			})
+		})
+
+		It("creates the SecurityPolicies and marks the policy as not realized", func() {
+			Expect(reconcilePolicy()).To(Succeed())
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+
+			for _, name := range []string{
+				network.NetworkPolicyAllowName(policyName),
+				network.NetworkPolicyIsolationName(policyName),
+			} {
+				sp := &vpcv1alpha1.SecurityPolicy{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, sp)).To(Succeed())
+			}
+
+			c := conditions.Get(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineNetworkPolicyNotRealizedReason))
+		})
+
+		When("the SecurityPolicies are realized", func() {
+			BeforeEach(func() {
+				for _, name := range []string{
+					network.NetworkPolicyAllowName(policyName),
+					network.NetworkPolicyIsolationName(policyName),
+				} {
+					initObjects = append(initObjects, &vpcv1alpha1.SecurityPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: namespace,
+							OwnerReferences: []metav1.OwnerReference{
+								{
+									APIVersion: vmopv1.GroupVersion.String(),
+									Kind:       "VirtualMachineNetworkPolicy",
+									Name:       policy.Name,
+									UID:        policy.UID,
+									Controller: ptr.To(true),
+								},
+							},
+						},
+						Status: vpcv1alpha1.SecurityPolicyStatus{
+							Conditions: []vpcv1alpha1.Condition{
+								{
+									Type:   vpcv1alpha1.Ready,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+					})
+				}
+
+				vm := newVM("vm-3", "db")
+				vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{Disabled: true}
+				initObjects = append(initObjects,
+					vm,
+					newVM("vm-4", "db"),
+					newSubnetPort("vm-1", corev1.ConditionTrue, ""),
+					newSubnetPort("vm-2", corev1.ConditionFalse, "port is being created"))
+			})
+
+			It("marks the policy as enforced and reports the enforcement of each VM from its network interfaces", func() {
+				Expect(reconcilePolicy()).To(Succeed())
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+
+				Expect(conditions.IsTrue(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced)).To(BeTrue())
+				Expect(policy.Status.VirtualMachines).To(Equal([]vmopv1.VirtualMachineNetworkPolicyVMStatus{
+					{
+						Name:     "vm-1",
+						Enforced: true,
+					},
+					{
+						Name:    "vm-2",
+						Message: "network interface vm-2-eth0: port is being created",
+					},
+					{
+						Name:    "vm-3",
+						Message: "VM networking is disabled",
+					},
+					{
+						Name:    "vm-4",
+						Message: "VM has no network interfaces",
+					},
+				}))
+			})
+		})
+
+		When("a SecurityPolicy with the same name is not owned by the policy", func() {
+			BeforeEach(func() {
+				initObjects = append(initObjects, &vpcv1alpha1.SecurityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      network.NetworkPolicyIsolationName(policyName),
+						Namespace: namespace,
+					},
+				})
+			})
+
+			It("does not modify the SecurityPolicy and marks the policy with a ReconcileError", func() {
+				Expect(reconcilePolicy()).To(MatchError(network.ErrSecurityPolicyNotOwned))
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+
+				c := conditions.Get(policy, vmopv1.VirtualMachineNetworkPolicyConditionEnforced)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal("ReconcileError"))
+
+				sp := &vpcv1alpha1.SecurityPolicy{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{
+					Namespace: namespace,
+					Name:      network.NetworkPolicyIsolationName(policyName),
+				}, sp)).To(Succeed())
+				Expect(sp.OwnerReferences).To(BeEmpty())
+				Expect(sp.Spec.Rules).To(BeEmpty())
+			})
+		})
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -172,6 +173,10 @@ type Reconciler struct {
 	Recorder   record.Recorder
 	VMProvider providers.VirtualMachineProviderInterface
 	Metrics    *metrics.VMPublishMetrics
+
+	// ociPublishes records the result of each push to an OCI repository,
+	// keyed by the publish request's activation ID.
+	ociPublishes sync.Map
 }
 
 func requeueResult(ctx *pkgctx.VirtualMachinePublishRequestContext) ctrl.Result {
@@ -210,6 +215,8 @@ func requeueResult(ctx *pkgctx.VirtualMachinePublishRequestContext) ctrl.Result
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list
 // +kubebuilder:rbac:groups=imageregistry.vmware.com,resources=contentlibraries,verbs=get;list;watch
 // +kubebuilder:rbac:groups=imageregistry.vmware.com,resources=contentlibraries/status,verbs=get;
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx = pkgcfg.JoinContext(ctx, r.Context)
@@ -284,6 +291,7 @@ func (r *Reconciler) updateSourceAndTargetRef(ctx *pkgctx.VirtualMachinePublishR
 				Description: vmPubReq.Spec.Target.Item.Description,
 			},
 			Location: vmPubReq.Spec.Target.Location,
+			OCI:      vmPubReq.Spec.Target.OCI.DeepCopy(),
 		}
 	}
 }
@@ -338,6 +346,11 @@ func (r *Reconciler) publishVirtualMachine(ctx *pkgctx.VirtualMachinePublishRequ
 			return err
 		}
 
+		if isOCITarget(vmPublishReq) {
+			r.publishVirtualMachineToOCI(ctx)
+			return nil
+		}
+
 		go func() {
 			actID := getPublishRequestActID(vmPublishReq)
 			itemID, pubErr := r.VMProvider.PublishVirtualMachine(ctx, ctx.VM, vmPublishReq, ctx.ContentLibrary, actID)
@@ -442,6 +455,10 @@ func (r *Reconciler) checkIsSourceValid(ctx *pkgctx.VirtualMachinePublishRequest
 func (r *Reconciler) checkIsTargetValid(ctx *pkgctx.VirtualMachinePublishRequestContext) error {
 	vmPubReq := ctx.VMPublishRequest
 
+	if isOCITarget(vmPubReq) {
+		return r.checkIsOCITargetValid(ctx)
+	}
+
 	if ctx.ContentLibrary == nil {
 		if err := r.getTargetLibrary(ctx); err != nil {
 			return err
@@ -688,6 +705,10 @@ func (r *Reconciler) checkIsImageAvailable(ctx *pkgctx.VirtualMachinePublishRequ
 		return nil
 	}
 
+	if isOCITarget(ctx.VMPublishRequest) {
+		return r.checkIsOCIImageAvailable(ctx)
+	}
+
 	if ctx.ItemID == "" {
 		id, err := r.getUploadedItemID(ctx)
 		if err != nil {
@@ -832,6 +853,10 @@ func (r *Reconciler) checkPubReqStatusAndShouldRepublish(ctx *pkgctx.VirtualMach
 		return false, nil
 	}
 
+	if isOCITarget(ctx.VMPublishRequest) {
+		return r.checkOCIPublishStatusAndShouldRepublish(ctx)
+	}
+
 	actID := getPublishRequestActID(ctx.VMPublishRequest)
 	logger := ctx.Logger.WithValues("actID", actID, "descriptionID", OVFCaptureTaskDescriptionID)
 
@@ -1176,8 +1201,11 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachinePublishRequestCon
 	// There are two types of target Content Libraries that we can publish to. The full processing
 	// of the request is dependent on the type of Content Library. So, we fetch the Content Library
 	// here, instead of waiting until later to do so during target validation.
-	if err := r.getTargetLibrary(ctx); err != nil {
-		return ctrl.Result{}, err
+	// There is no Content Library when publishing to an OCI repository.
+	if !isOCITarget(vmPublishReq) {
+		if err := r.getTargetLibrary(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 
 	shouldPublish, err := r.checkPubReqStatusAndShouldRepublish(ctx)
@@ -1220,6 +1248,10 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachinePublishRequestCon
 }
 
 func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachinePublishRequestContext) (ctrl.Result, error) {
+	if isOCITarget(ctx.VMPublishRequest) {
+		r.cancelOCIPublish(ctx)
+	}
+
 	if controllerutil.ContainsFinalizer(ctx.VMPublishRequest, finalizerName) ||
 		controllerutil.ContainsFinalizer(ctx.VMPublishRequest, deprecatedFinalizerName) {
 		r.Metrics.DeleteMetrics(ctx.Logger, ctx.VMPublishRequest.Name, ctx.VMPublishRequest.Namespace)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -37,6 +38,8 @@ import (
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci/ocitest"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -856,6 +859,253 @@ func unitTestsReconcile() {
 			})
 		})
 
+		When("target is an OCI repository", func() {
+			var (
+				registry *ocitest.Registry
+			)
+
+			BeforeEach(func() {
+				registry = ocitest.NewRegistry()
+
+				vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOff
+				vmpub.UID = "dummy-vmpub-uid"
+				vmpub.Spec.Target.Location = vmopv1.VirtualMachinePublishRequestTargetLocation{}
+				vmpub.Spec.Target.OCI = &vmopv1.VirtualMachinePublishRequestTargetOCI{
+					Repository:            registry.Host() + "/project/my-image",
+					Tag:                   "v1",
+					InsecureSkipTLSVerify: true,
+				}
+				initObjects = []client.Object{vm, vmpub}
+			})
+
+			AfterEach(func() {
+				registry.Close()
+			})
+
+			When("the repository is invalid", func() {
+				BeforeEach(func() {
+					vmpub.Spec.Target.OCI.Repository += ":v1"
+				})
+
+				It("marks the target invalid and does not requeue", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(pkgerr.IsNoRequeueError(err)).To(BeTrue())
+
+					c := conditions.Get(vmpub, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.TargetOCIRepositoryInvalidReason))
+				})
+			})
+
+			When("the Secret does not exist", func() {
+				BeforeEach(func() {
+					vmpub.Spec.Target.OCI.SecretName = "my-secret"
+				})
+
+				It("marks the target invalid and returns an error", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(err).To(HaveOccurred())
+
+					c := conditions.Get(vmpub, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.TargetOCISecretNotFoundReason))
+				})
+			})
+
+			When("the VM is powered on", func() {
+				BeforeEach(func() {
+					vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+				})
+
+				It("marks the target invalid and returns an error", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(err).To(MatchError(ContainSubstring("VM must be powered off")))
+
+					c := conditions.Get(vmpub, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.TargetOCISourceNotPoweredOffReason))
+					Expect(fakeVMProvider.IsPublishVMCalled()).To(BeFalse())
+				})
+			})
+
+			When("an image with the target item name already exists", func() {
+				BeforeEach(func() {
+					vmi := builder.DummyVirtualMachineImage("dummy-item")
+					vmi.Namespace = vmpub.Namespace
+					initObjects = append(initObjects, vmi)
+				})
+
+				It("marks the target invalid and does not return an error", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(err).ToNot(HaveOccurred())
+
+					c := conditions.Get(vmpub, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+					Expect(c).ToNot(BeNil())
+					Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					Expect(c.Reason).To(Equal(vmopv1.TargetItemAlreadyExistsReason))
+				})
+			})
+
+			When("the push succeeds", func() {
+				const digest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+
+				JustBeforeEach(func() {
+					fakeVMProvider.PublishVirtualMachineToOCIFn = func(ctx context.Context, vm *vmopv1.VirtualMachine,
+						vmPub *vmopv1.VirtualMachinePublishRequest, actID string) (string, error) {
+						return digest, nil
+					}
+				})
+
+				It("creates an image for the pushed artifact", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(func() bool {
+						return fakeVMProvider.IsPublishVMCalled()
+					}).Should(BeTrue())
+
+					Expect(conditions.IsTrue(vmpub,
+						vmopv1.VirtualMachinePublishRequestConditionTargetValid)).To(BeTrue())
+					Expect(vmpub.Status.TargetRef.OCI).To(Equal(vmpub.Spec.Target.OCI))
+
+					By("recording the digest of the pushed artifact", func() {
+						Eventually(func(g Gomega) {
+							_, err := reconciler.ReconcileNormal(vmpubCtx)
+							g.Expect(err).ToNot(HaveOccurred())
+							g.Expect(conditions.IsTrue(vmpub,
+								vmopv1.VirtualMachinePublishRequestConditionUploaded)).To(BeTrue())
+						}).Should(Succeed())
+						Expect(vmpub.Status.ArtifactDigest).To(Equal(digest))
+					})
+
+					vmi := &vmopv1.VirtualMachineImage{}
+					Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: vmpub.Namespace, Name: "dummy-item"}, vmi)).To(Succeed())
+					Expect(vmi.Spec.OCI).ToNot(BeNil())
+					Expect(vmi.Spec.OCI.Reference).To(Equal(registry.Host() + "/project/my-image@" + digest))
+					Expect(vmi.Spec.OCI.InsecureSkipTLSVerify).To(BeTrue())
+					Expect(vmi.Annotations).To(HaveKeyWithValue(oci.AnnotationPublishRequestUID, string(vmpub.UID)))
+
+					By("waiting for the image to be ready", func() {
+						Expect(conditions.IsTrue(vmpub,
+							vmopv1.VirtualMachinePublishRequestConditionImageAvailable)).To(BeFalse())
+
+						conditions.MarkTrue(vmi, vmopv1.ReadyConditionType)
+						Expect(ctx.Client.Status().Update(ctx, vmi)).To(Succeed())
+
+						_, err := reconciler.ReconcileNormal(vmpubCtx)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(conditions.IsTrue(vmpub,
+							vmopv1.VirtualMachinePublishRequestConditionImageAvailable)).To(BeTrue())
+						Expect(vmpub.Status.ImageName).To(Equal(vmi.Name))
+						Expect(conditions.IsTrue(vmpub,
+							vmopv1.VirtualMachinePublishRequestConditionComplete)).To(BeTrue())
+					})
+				})
+			})
+
+			When("the push fails", func() {
+				JustBeforeEach(func() {
+					fakeVMProvider.PublishVirtualMachineToOCIFn = func(ctx context.Context, vm *vmopv1.VirtualMachine,
+						vmPub *vmopv1.VirtualMachinePublishRequest, actID string) (string, error) {
+						return "", errors.New("dummy error")
+					}
+				})
+
+				It("marks the upload failed and publishes again", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(func(g Gomega) {
+						_, err := reconciler.ReconcileNormal(vmpubCtx)
+						g.Expect(err).ToNot(HaveOccurred())
+						c := conditions.Get(vmpub, vmopv1.VirtualMachinePublishRequestConditionUploaded)
+						g.Expect(c).ToNot(BeNil())
+						g.Expect(c.Reason).To(Equal(vmopv1.UploadFailureReason))
+					}).Should(Succeed())
+
+					Expect(vmpub.Status.Attempts).To(BeEquivalentTo(2))
+				})
+			})
+
+			When("the request is deleted during the push", func() {
+				var started, canceled chan struct{}
+
+				JustBeforeEach(func() {
+					started = make(chan struct{})
+					canceled = make(chan struct{})
+					fakeVMProvider.PublishVirtualMachineToOCIFn = func(ctx context.Context, vm *vmopv1.VirtualMachine,
+						vmPub *vmopv1.VirtualMachinePublishRequest, actID string) (string, error) {
+						close(started)
+						<-ctx.Done()
+						close(canceled)
+						return "", ctx.Err()
+					}
+				})
+
+				It("cancels the push", func() {
+					_, err := reconciler.ReconcileNormal(vmpubCtx)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(started).Should(BeClosed())
+					Consistently(canceled).ShouldNot(BeClosed())
+
+					vmpub.DeletionTimestamp = ptr.To(metav1.Now())
+					_, err = reconciler.ReconcileDelete(vmpubCtx)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(canceled).Should(BeClosed())
+				})
+			})
+
+			When("a previous push was lost track of", func() {
+				BeforeEach(func() {
+					vmpub.Status.Attempts = 1
+					vmpub.Status.LastAttemptTime = metav1.NewTime(time.Now().Add(-time.Minute))
+					conditions.MarkTrue(vmpub, vmopv1.VirtualMachinePublishRequestConditionSourceValid)
+					conditions.MarkTrue(vmpub, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+				})
+
+				When("the artifact was pushed by this request", func() {
+					var digest string
+
+					BeforeEach(func() {
+						digest = pushOCIArtifact(registry, vmpub.Spec.Target.OCI.Repository+":v1",
+							map[string]string{oci.AnnotationPublishRequestUID: string(vmpub.UID)})
+					})
+
+					It("marks the upload complete and does not publish again", func() {
+						_, err := reconciler.ReconcileNormal(vmpubCtx)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(conditions.IsTrue(vmpub,
+							vmopv1.VirtualMachinePublishRequestConditionUploaded)).To(BeTrue())
+						Expect(vmpub.Status.ArtifactDigest).To(Equal(digest))
+						Expect(fakeVMProvider.IsPublishVMCalled()).To(BeFalse())
+					})
+				})
+
+				When("the artifact was pushed by another request", func() {
+					BeforeEach(func() {
+						pushOCIArtifact(registry, vmpub.Spec.Target.OCI.Repository+":v1",
+							map[string]string{oci.AnnotationPublishRequestUID: "another-uid"})
+					})
+
+					It("publishes again", func() {
+						_, err := reconciler.ReconcileNormal(vmpubCtx)
+						Expect(err).ToNot(HaveOccurred())
+
+						Eventually(func() bool {
+							return fakeVMProvider.IsPublishVMCalled()
+						}).Should(BeTrue())
+						Expect(vmpub.Status.Attempts).To(BeEquivalentTo(2))
+					})
+				})
+			})
+		})
+
 		Context("A previous publish request has been sent", func() {
 			BeforeEach(func() {
 				vmpub.Status.Attempts = 1
@@ -1365,3 +1615,28 @@ func unitTestsReconcile() {
 		})
 	})
 }
+
+// pushOCIArtifact pushes a VM artifact with the given manifest annotations to
+// the registry and returns the digest of its manifest.
+func pushOCIArtifact(registry *ocitest.Registry, reference string, annotations map[string]string) string {
+	GinkgoHelper()
+
+	ref, err := oci.ParseReference(reference)
+	Expect(err).ToNot(HaveOccurred())
+
+	client := oci.NewClient(oci.Options{InsecureSkipVerify: true})
+
+	configDesc, err := client.PushBlob(context.Background(), ref, oci.MediaTypeConfig, strings.NewReader("{}"))
+	Expect(err).ToNot(HaveOccurred())
+
+	digest, err := client.PushManifest(context.Background(), ref, oci.Manifest{
+		SchemaVersion: 2,
+		MediaType:     oci.MediaTypeImageManifest,
+		ArtifactType:  oci.ArtifactType,
+		Config:        configDesc,
+		Annotations:   annotations,
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	return digest
+}
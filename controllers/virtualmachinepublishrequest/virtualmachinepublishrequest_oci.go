@@ -0,0 +1,312 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinepublishrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+)
+
+// ociPublishResult is the result of pushing a VM to an OCI repository. A push
+// that is still in progress is not done, and may be canceled with cancel.
+type ociPublishResult struct {
+	done   bool
+	digest string
+	err    error
+	cancel context.CancelFunc
+}
+
+// isOCITarget returns true if the VM is published to an OCI repository rather
+// than a content library.
+func isOCITarget(vmPub *vmopv1.VirtualMachinePublishRequest) bool {
+	return vmPub.Spec.Target.OCI != nil
+}
+
+// checkIsOCITargetValid checks if the target OCI repository is valid. It is
+// invalid if the repository cannot be parsed, the Secret with the registry
+// credentials does not exist, a VirtualMachineImage with the target item name
+// already exists, or the source VM is not powered off and so cannot be
+// exported.
+func (r *Reconciler) checkIsOCITargetValid(ctx *pkgctx.VirtualMachinePublishRequestContext) error {
+	vmPubReq := ctx.VMPublishRequest
+	target := vmPubReq.Spec.Target.OCI
+	targetItemName := vmPubReq.Status.TargetRef.Item.Name
+
+	if _, err := oci.ParseRepository(target.Repository, target.Tag); err != nil {
+		err := pkgerr.NoRequeueError{Message: err.Error()}
+		conditions.MarkError(vmPubReq,
+			vmopv1.VirtualMachinePublishRequestConditionTargetValid,
+			vmopv1.TargetOCIRepositoryInvalidReason,
+			err)
+		return err
+	}
+
+	if target.SecretName != "" {
+		objKey := client.ObjectKey{Name: target.SecretName, Namespace: vmPubReq.Namespace}
+		if err := r.Get(ctx, objKey, &corev1.Secret{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				conditions.MarkError(vmPubReq,
+					vmopv1.VirtualMachinePublishRequestConditionTargetValid,
+					vmopv1.TargetOCISecretNotFoundReason,
+					err)
+			}
+			return fmt.Errorf("failed to get Secret %v: %w", objKey, err)
+		}
+	}
+
+	vmi := &vmopv1.VirtualMachineImage{}
+	objKey := client.ObjectKey{Name: targetItemName, Namespace: vmPubReq.Namespace}
+	if err := r.Get(ctx, objKey, vmi); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get VirtualMachineImage %v: %w", objKey, err)
+		}
+	} else {
+		// The image may have been created by this request if a previous
+		// status update failed.
+		if vmi.Annotations[oci.AnnotationPublishRequestUID] == string(vmPubReq.UID) {
+			ctx.Logger.Info("existing VirtualMachineImage is published by this VMPubReq")
+			conditions.MarkTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+			conditions.MarkTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionUploaded)
+			return nil
+		}
+
+		conditions.MarkFalse(vmPubReq,
+			vmopv1.VirtualMachinePublishRequestConditionTargetValid,
+			vmopv1.TargetItemAlreadyExistsReason,
+			"VirtualMachineImage with name %s already exists", targetItemName)
+		return nil
+	}
+
+	if ps := ctx.VM.Status.PowerState; ps != vmopv1.VirtualMachinePowerStateOff {
+		err := fmt.Errorf("VM must be powered off to be published to an OCI repository, power state is %q", ps)
+		conditions.MarkError(vmPubReq,
+			vmopv1.VirtualMachinePublishRequestConditionTargetValid,
+			vmopv1.TargetOCISourceNotPoweredOffReason,
+			err)
+		return err
+	}
+
+	conditions.MarkTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionTargetValid)
+	return nil
+}
+
+// publishVirtualMachineToOCI pushes the VM to the target OCI repository in a
+// background goroutine. The result of the push is recorded so it may be
+// checked by checkOCIPublishStatusAndShouldRepublish.
+func (r *Reconciler) publishVirtualMachineToOCI(ctx *pkgctx.VirtualMachinePublishRequestContext) {
+	vmPublishReq := ctx.VMPublishRequest
+	actID := getPublishRequestActID(vmPublishReq)
+
+	pushCtx, cancel := context.WithCancel(ctx)
+	inProgress := &ociPublishResult{cancel: cancel}
+	r.ociPublishes.Store(actID, inProgress)
+
+	go func() {
+		defer cancel()
+
+		digest, pubErr := r.VMProvider.PublishVirtualMachineToOCI(pushCtx, ctx.VM, vmPublishReq, actID)
+		if pubErr != nil {
+			ctx.Logger.Error(pubErr, "failed to publish vm to OCI repository")
+		} else {
+			ctx.Logger.Info("published vm to OCI repository", "digest", digest)
+		}
+
+		// The result is not recorded if the request was deleted during the
+		// push.
+		result := &ociPublishResult{done: true, digest: digest, err: pubErr}
+		if r.ociPublishes.CompareAndSwap(actID, inProgress, result) {
+			r.Recorder.EmitEvent(vmPublishReq, "Publish", pubErr, false)
+		}
+	}()
+}
+
+// cancelOCIPublish cancels the push of the VM to the target OCI repository if
+// it is in progress, and forgets its result.
+func (r *Reconciler) cancelOCIPublish(ctx *pkgctx.VirtualMachinePublishRequestContext) {
+	actID := getPublishRequestActID(ctx.VMPublishRequest)
+
+	obj, ok := r.ociPublishes.LoadAndDelete(actID)
+	if !ok {
+		return
+	}
+	if result := obj.(*ociPublishResult); !result.done {
+		ctx.Logger.Info("Canceling VM Publish to OCI repository", "actID", actID)
+		result.cancel()
+	}
+}
+
+// checkOCIPublishStatusAndShouldRepublish checks the status of the push of
+// the VM to the target OCI repository.
+// - If the push is in progress, then Uploaded is marked false and the VM is
+// not published again.
+// - If the push succeeded, then Uploaded is marked true.
+// - If the push failed, then Uploaded is marked false and the VM is
+// published again.
+// - If there is no record of the push, ex. because the controller restarted,
+// then the repository is checked for an artifact pushed by this request. If
+// there is none, then the VM is published again.
+func (r *Reconciler) checkOCIPublishStatusAndShouldRepublish(ctx *pkgctx.VirtualMachinePublishRequestContext) (bool, error) {
+	vmPubReq := ctx.VMPublishRequest
+	actID := getPublishRequestActID(vmPubReq)
+
+	obj, ok := r.ociPublishes.Load(actID)
+	if !ok {
+		digest, err := r.getOCIArtifactDigest(ctx)
+		if err != nil {
+			return false, err
+		}
+		if digest == "" {
+			ctx.Logger.Info("no OCI artifact published by this VMPubReq, retry publishing this VM",
+				"actID", actID)
+			return true, nil
+		}
+
+		vmPubReq.Status.ArtifactDigest = digest
+		conditions.MarkTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionUploaded)
+		return false, nil
+	}
+
+	result := obj.(*ociPublishResult)
+	switch {
+	case !result.done:
+		ctx.Logger.V(5).Info("VM Publish is still in progress", "actID", actID)
+		conditions.MarkFalse(vmPubReq,
+			vmopv1.VirtualMachinePublishRequestConditionUploaded,
+			vmopv1.UploadingReason,
+			"Uploading item to OCI repository.")
+		return false, nil
+	case result.err != nil:
+		r.ociPublishes.Delete(actID)
+		ctx.Logger.Info("VM Publish failed, will retry this operation", "actID", actID)
+		conditions.MarkFalse(vmPubReq,
+			vmopv1.VirtualMachinePublishRequestConditionUploaded,
+			vmopv1.UploadFailureReason,
+			"%s", result.err.Error())
+		return true, nil
+	default:
+		r.ociPublishes.Delete(actID)
+		ctx.Logger.Info("VM Publish succeeded", "digest", result.digest)
+		vmPubReq.Status.ArtifactDigest = result.digest
+		conditions.MarkTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionUploaded)
+		return false, nil
+	}
+}
+
+// getOCIArtifactDigest returns the digest of the manifest with the target tag
+// if the manifest was pushed by this request. An empty string is returned if
+// the manifest does not exist or was pushed by another request.
+func (r *Reconciler) getOCIArtifactDigest(ctx *pkgctx.VirtualMachinePublishRequestContext) (string, error) {
+	vmPubReq := ctx.VMPublishRequest
+	target := vmPubReq.Spec.Target.OCI
+
+	ref, err := oci.ParseRepository(target.Repository, target.Tag)
+	if err != nil {
+		return "", err
+	}
+
+	creds, err := oci.GetCredentials(ctx, r.Client, vmPubReq.Namespace, target.SecretName, ref.Registry)
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+
+	ociClient := oci.NewClient(oci.Options{
+		Credentials:        creds,
+		InsecureSkipVerify: target.InsecureSkipTLSVerify,
+	})
+
+	manifest, digest, err := ociClient.GetManifest(ctx, ref)
+	if err != nil {
+		if errors.Is(err, oci.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get manifest %s: %w", ref, err)
+	}
+
+	if manifest.Annotations[oci.AnnotationPublishRequestUID] != string(vmPubReq.UID) {
+		return "", nil
+	}
+
+	return digest, nil
+}
+
+// checkIsOCIImageAvailable ensures the VirtualMachineImage that refers to the
+// pushed OCI artifact exists, and checks if it is ready.
+func (r *Reconciler) checkIsOCIImageAvailable(ctx *pkgctx.VirtualMachinePublishRequestContext) error {
+	vmPubReq := ctx.VMPublishRequest
+	target := vmPubReq.Spec.Target.OCI
+
+	vmi := &vmopv1.VirtualMachineImage{}
+	objKey := client.ObjectKey{Name: vmPubReq.Status.TargetRef.Item.Name, Namespace: vmPubReq.Namespace}
+	if err := r.Get(ctx, objKey, vmi); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get VirtualMachineImage %v: %w", objKey, err)
+		}
+
+		if vmPubReq.Status.ArtifactDigest == "" {
+			// The artifact was pushed, but the status update that recorded
+			// its digest failed.
+			digest, err := r.getOCIArtifactDigest(ctx)
+			if err != nil {
+				return err
+			}
+			if digest == "" {
+				return fmt.Errorf("no OCI artifact published by this request")
+			}
+			vmPubReq.Status.ArtifactDigest = digest
+		}
+
+		ref, err := oci.ParseRepository(target.Repository, target.Tag)
+		if err != nil {
+			return err
+		}
+
+		vmi = &vmopv1.VirtualMachineImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      objKey.Name,
+				Namespace: objKey.Namespace,
+				Annotations: map[string]string{
+					oci.AnnotationPublishRequestUID: string(vmPubReq.UID),
+				},
+			},
+			Spec: vmopv1.VirtualMachineImageSpec{
+				OCI: &vmopv1.VirtualMachineImageOCISource{
+					Reference:             ref.WithDigest(vmPubReq.Status.ArtifactDigest).String(),
+					SecretName:            target.SecretName,
+					InsecureSkipTLSVerify: target.InsecureSkipTLSVerify,
+				},
+			},
+		}
+		if err := r.Create(ctx, vmi); err != nil {
+			return fmt.Errorf("failed to create VirtualMachineImage %v: %w", objKey, err)
+		}
+		ctx.Logger.Info("Created VirtualMachineImage for OCI artifact", "vmiName", vmi.Name)
+	}
+
+	if !conditions.IsTrue(vmi, vmopv1.ReadyConditionType) {
+		conditions.MarkFalse(vmPubReq,
+			vmopv1.VirtualMachinePublishRequestConditionImageAvailable,
+			vmopv1.ImageUnavailableReason,
+			"VirtualMachineImage %s is not ready", vmi.Name)
+		return nil
+	}
+
+	vmPubReq.Status.ImageName = vmi.Name
+	conditions.MarkTrue(vmPubReq, vmopv1.VirtualMachinePublishRequestConditionImageAvailable)
+	ctx.Logger.Info("VirtualMachineImage is available", "vmiName", vmi.Name)
+
+	return nil
+}
@@ -22,6 +22,7 @@ package virtualmachinereplicaset
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"reflect"
@@ -33,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	apierrorsutil "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,7 +52,9 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/prober"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 var (
@@ -374,9 +378,6 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineReplicaSetContext
 			"vm", vm,
 			"key", vmopv1.VirtualMachineReplicaSetNameLabel,
 			"value", pkgutil.MustFormatValue(ctx.ReplicaSet.Name))
-
-		// TODO: Propagate the Deployment label from Deployment to replica set to VirtualMachine if
-		// it is set on the replica set.
 	}
 
 	// TODO: Handle in place propagation of fields to machines before syncing replicas.
@@ -388,6 +389,14 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineReplicaSetContext
 	r.updateStatus(ctx, ctx.ReplicaSet, filteredVMs)
 
 	if syncErr != nil {
+		if errors.As(syncErr, &vmopv1util.DisruptionBudgetExceededError{}) {
+			conditions.MarkError(
+				ctx.ReplicaSet,
+				vmopv1.ResizedCondition,
+				vmopv1.DisruptionBudgetExceededReason,
+				syncErr,
+			)
+		}
 		return ctrl.Result{}, fmt.Errorf("failed to sync VirtualMachineReplicaSet replicas: %w", syncErr)
 	}
 
@@ -432,8 +441,6 @@ func (r *Reconciler) getNewVirtualMachine(rs *vmopv1.VirtualMachineReplicaSet) *
 
 	vm.Annotations = getAnnotationsFromReplicaSet(rs)
 
-	// TODO: Propagate the VirtualMachineDeploymentNameLabel from VirtualMachineReplicaSet to VirtualMachines if it exists.
-
 	return vm
 }
 
@@ -446,6 +453,12 @@ func getLabelsFromVMReplicaSet(rs *vmopv1.VirtualMachineReplicaSet) map[string]s
 	// Ensure that the ReplicaSetLabel is always present.
 	labels[vmopv1.VirtualMachineReplicaSetNameLabel] = pkgutil.MustFormatValue(rs.Name)
 
+	// Propagate the VirtualMachineDeployment name label if the replica set is
+	// owned by a VirtualMachineDeployment.
+	if v, ok := rs.Labels[vmopv1.VirtualMachineDeploymentNameLabel]; ok {
+		labels[vmopv1.VirtualMachineDeploymentNameLabel] = v
+	}
+
 	return labels
 }
 
@@ -514,8 +527,8 @@ func (r *Reconciler) syncReplicas(
 		ctx.Logger.Info("ReplicaSet is scaling down",
 			"currentReplicas", len(vms),
 			"desiredReplicas", *(rs.Spec.Replicas),
-			"vmsToBeCreated", diff,
-			"deletePolicy", "oldestFirst",
+			"vmsToBeDeleted", diff,
+			"deletePolicy", rs.Spec.DeletePolicy,
 		)
 
 		deletePriorityFunc, err := getDeletePriorityFunc(rs)
@@ -523,11 +536,39 @@ func (r *Reconciler) syncReplicas(
 			return err
 		}
 
-		var errs []error
-		vmsToDelete := getMachinesToDeletePrioritized(vms, diff, deletePriorityFunc)
+		var zoneNames sets.Set[string]
+		if len(rs.Spec.Template.TopologySpreadConstraints) > 0 {
+			names, err := topology.GetNamespaceZoneNames(ctx, r.Client, rs.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to get zones for namespace %s: %w", rs.Namespace, err)
+			}
+			zoneNames = sets.New(names...)
+		}
+
+		disruptionChecker, err := vmopv1util.NewDisruptionChecker(ctx, r.Client, rs.Namespace)
+		if err != nil {
+			return err
+		}
+
+		var (
+			errs          []error
+			disruptionErr error
+			deletedVMs    []*vmopv1.VirtualMachine
+		)
+
+		vmsToDelete := getMachinesToDeletePrioritized(vms, diff, deletePriorityFunc, zoneNames)
 		for i, vm := range vmsToDelete {
 			log := ctx.Logger.WithValues("vm", vm.Name)
 			if vm.GetDeletionTimestamp().IsZero() {
+				if err := disruptionChecker.Disrupt(vm); err != nil {
+					log.Info("Not deleting VM to scale down replicaset", "reason", err.Error())
+					r.Recorder.Warnf(rs, vmopv1.DisruptionBudgetExceededReason, "Not deleting VM %q: %v", vm.Name, err)
+					if disruptionErr == nil {
+						disruptionErr = err
+					}
+					continue
+				}
+
 				log.Info("Deleting VM to scale down replicaset", "index", i+1, "totalVMsToBeDeleted", diff)
 
 				if err := r.Client.Delete(ctx, vm); err != nil {
@@ -541,12 +582,18 @@ func (r *Reconciler) syncReplicas(
 			} else {
 				log.Info("Waiting for VM to be deleted", "index", i+1, "totalVMsToBeDeleted", diff)
 			}
+			deletedVMs = append(deletedVMs, vm)
 		}
 
 		if len(errs) > 0 {
 			return apierrorsutil.NewAggregate(errs)
 		}
-		return r.waitForVMDeletion(ctx, vmsToDelete)
+		if err := r.waitForVMDeletion(ctx, deletedVMs); err != nil {
+			return err
+		}
+
+		// The remaining VMs are deleted once the disruption budgets allow it.
+		return disruptionErr
 	}
 
 	return nil
@@ -618,21 +665,26 @@ func (r *Reconciler) updateStatus(
 			fullyLabeledReplicasCount++
 		}
 
-		// TODO: Figure out an equivalent of Ready condition on the VirtualMachine
-		// resource so we can populate the ready and available replicas in the Status.
-		// For now, we count all replicas as ready and available.
-		readyReplicasCount++
-
+		if vmopv1util.IsVirtualMachineHealthy(vm) {
+			readyReplicasCount++
+		}
 	}
 
 	newStatus.Replicas = int32(len(filteredVMs))                      //nolint:gosec // disable G115
 	newStatus.FullyLabeledReplicas = int32(fullyLabeledReplicasCount) //nolint:gosec // disable G115
 	newStatus.ReadyReplicas = int32(readyReplicasCount)               //nolint:gosec // disable G115
 
+	// The selector is published in string form for the scale subresource so
+	// it may be consumed by the HorizontalPodAutoscaler.
+	if selector, err := metav1.LabelSelectorAsSelector(rs.Spec.Selector); err == nil {
+		newStatus.Selector = selector.String()
+	}
+
 	// Copy the newly calculated status into the VirtualMachineReplicaSet.
 	if rs.Status.Replicas != newStatus.Replicas ||
 		rs.Status.FullyLabeledReplicas != newStatus.FullyLabeledReplicas ||
 		rs.Status.ReadyReplicas != newStatus.ReadyReplicas ||
+		rs.Status.Selector != newStatus.Selector ||
 		rs.Generation != rs.Status.ObservedGeneration {
 
 		ctx.Logger.Info("Updating status",
@@ -644,7 +696,8 @@ func (r *Reconciler) updateStatus(
 			"readyReplicasOld", rs.Status.ReadyReplicas,
 			"readyReplicasNew", newStatus.ReadyReplicas,
 			"observedGenerationOld", rs.Status.ObservedGeneration,
-			"observedGenerationNew", newStatus.ObservedGeneration)
+			"observedGenerationNew", newStatus.ObservedGeneration,
+			"selector", newStatus.Selector)
 
 		// Save the generation number we acted on, otherwise we might wrongfully indicate
 		// that we've seen a spec update when we retry.
@@ -688,5 +741,16 @@ func (r *Reconciler) updateStatus(
 		// This means that we have sufficient number of VirtualMachine objects.
 		conditions.MarkTrue(rs, vmopv1.VirtualMachinesCreatedCondition)
 	}
-	// TODO: Set aggregate condition based on the condition of the individual Virtual Machines
+
+	if newStatus.ReadyReplicas == newStatus.Replicas {
+		conditions.MarkTrue(rs, vmopv1.VirtualMachinesReadyCondition)
+	} else {
+		conditions.MarkFalse(
+			rs,
+			vmopv1.VirtualMachinesReadyCondition,
+			vmopv1.VirtualMachinesNotReadyReason,
+			"%d of %d VirtualMachines are not ready",
+			newStatus.Replicas-newStatus.ReadyReplicas,
+			newStatus.Replicas)
+	}
 }
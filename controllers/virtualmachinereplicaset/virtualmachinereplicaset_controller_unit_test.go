@@ -0,0 +1,380 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinereplicaset_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinereplicaset"
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace = "test-namespace"
+		rsName    = "test-rs"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinereplicaset.Reconciler
+		rs         *vmopv1.VirtualMachineReplicaSet
+		now        time.Time
+	)
+
+	newReplica := func(name, zone string, age time.Duration, ready bool) *vmopv1.VirtualMachine {
+		vm := builder.DummyBasicVirtualMachine(name, namespace)
+		vm.Labels = map[string]string{
+			"app":                                    rsName,
+			vmopv1.VirtualMachineReplicaSetNameLabel: rsName,
+		}
+		vm.CreationTimestamp = metav1.NewTime(now.Add(-age))
+		vm.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(rs, vmopv1.GroupVersion.WithKind("VirtualMachineReplicaSet")),
+		}
+		vm.Status.Zone = zone
+		if ready {
+			conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+		} else {
+			conditions.MarkFalse(vm, vmopv1.ReadyConditionType, "NotReady", "")
+		}
+		return vm
+	}
+
+	remainingVMNames := func() []string {
+		list := &vmopv1.VirtualMachineList{}
+		Expect(ctx.Client.List(ctx, list, client.InNamespace(namespace))).To(Succeed())
+		var names []string
+		for _, vm := range list.Items {
+			names = append(names, vm.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+
+		rs = builder.DummyVirtualMachineReplicaSet()
+		rs.GenerateName = ""
+		rs.Name = rsName
+		rs.Namespace = namespace
+		rs.UID = types.UID(rsName + "-uid")
+		rs.Finalizers = []string{"virtualmachinereplicaset.vmoperator.vmware.com"}
+		rs.Spec.Selector.MatchLabels = map[string]string{"app": rsName}
+		rs.Spec.Template.Labels = map[string]string{"app": rsName}
+
+		initObjects = []client.Object{rs}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinereplicaset.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcileRS := func() error {
+		_, err := reconciler.Reconcile(
+			cource.WithContext(ctx),
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: rsName}})
+		return err
+	}
+
+	Context("Scale up", func() {
+		When("the replica set is owned by a VirtualMachineDeployment", func() {
+			BeforeEach(func() {
+				rs.Labels = map[string]string{vmopv1.VirtualMachineDeploymentNameLabel: "test-deployment"}
+			})
+
+			It("propagates the deployment name label to the replicas", func() {
+				Expect(reconcileRS()).To(Succeed())
+
+				list := &vmopv1.VirtualMachineList{}
+				Expect(ctx.Client.List(ctx, list, client.InNamespace(namespace))).To(Succeed())
+				Expect(list.Items).To(HaveLen(1))
+				Expect(list.Items[0].Labels).To(HaveKeyWithValue(vmopv1.VirtualMachineDeploymentNameLabel, "test-deployment"))
+				Expect(list.Items[0].Labels).To(HaveKeyWithValue(vmopv1.VirtualMachineReplicaSetNameLabel, rsName))
+			})
+		})
+
+		It("publishes the selector in the status for the scale subresource", func() {
+			Expect(reconcileRS()).To(Succeed())
+
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+			Expect(rs.Status.Selector).To(Equal("app=" + rsName))
+		})
+	})
+
+	Context("Scale down", func() {
+		BeforeEach(func() {
+			rs.Spec.Replicas = ptr.To(int32(2))
+		})
+
+		When("there are more replicas than desired", func() {
+			BeforeEach(func() {
+				initObjects = append(initObjects,
+					newReplica("vm-old", "zone-a", 48*time.Hour, true),
+					newReplica("vm-new", "zone-b", time.Hour, true),
+					newReplica("vm-mid", "zone-c", 24*time.Hour, true))
+			})
+
+			DescribeTable("deletes the replica selected by the delete policy",
+				func(policy string, expectedRemaining []string) {
+					rs.Spec.DeletePolicy = policy
+					Expect(ctx.Client.Update(ctx, rs)).To(Succeed())
+					Expect(reconcileRS()).To(Succeed())
+					Expect(remainingVMNames()).To(ConsistOf(expectedRemaining))
+				},
+				Entry("Oldest", vmopv1.VirtualMachineReplicaSetDeletePolicyOldest, []string{"vm-new", "vm-mid"}),
+				Entry("Newest", vmopv1.VirtualMachineReplicaSetDeletePolicyNewest, []string{"vm-old", "vm-mid"}),
+			)
+		})
+
+		When("a replica does not have a creation timestamp", func() {
+			BeforeEach(func() {
+				rs.Spec.Replicas = ptr.To(int32(1))
+				rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyNewest
+				vm := newReplica("vm-1", "zone-a", 0, true)
+				vm.CreationTimestamp = metav1.Time{}
+				initObjects = append(initObjects,
+					vm,
+					newReplica("vm-2", "zone-b", 48*time.Hour, false),
+					newReplica("vm-3", "zone-c", time.Hour, true))
+			})
+
+			It("deletes the replica without a creation timestamp last", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(remainingVMNames()).To(ConsistOf("vm-1"))
+			})
+		})
+
+		When("a replica is annotated for deletion", func() {
+			BeforeEach(func() {
+				rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyOldest
+				vm := newReplica("vm-new", "zone-b", time.Hour, true)
+				vm.Annotations = map[string]string{vmopv1.VirtualMachineReplicaSetDeleteAnnotation: ""}
+				initObjects = append(initObjects,
+					newReplica("vm-old", "zone-a", 48*time.Hour, false),
+					newReplica("vm-mid", "zone-c", 24*time.Hour, true),
+					vm)
+			})
+
+			It("deletes the annotated replica first", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(remainingVMNames()).To(ConsistOf("vm-old", "vm-mid"))
+			})
+		})
+
+		When("a replica is not ready", func() {
+			BeforeEach(func() {
+				rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyNotReadyFirst
+				initObjects = append(initObjects,
+					newReplica("vm-1", "zone-a", 48*time.Hour, true),
+					newReplica("vm-2", "zone-b", time.Hour, false),
+					newReplica("vm-3", "zone-c", 24*time.Hour, true))
+			})
+
+			It("deletes the replica that is not ready", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(remainingVMNames()).To(ConsistOf("vm-1", "vm-3"))
+			})
+
+			It("only counts the ready replicas as ready", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+				Expect(rs.Status.Replicas).To(Equal(int32(3)))
+				Expect(rs.Status.ReadyReplicas).To(Equal(int32(2)))
+			})
+		})
+
+		When("the replicas share the same priority", func() {
+			BeforeEach(func() {
+				rs.Spec.Replicas = ptr.To(int32(3))
+				rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyRandom
+				for i, zone := range []string{"zone-a", "zone-a", "zone-a", "zone-b", "zone-c"} {
+					initObjects = append(initObjects,
+						newReplica(fmt.Sprintf("vm-%d", i), zone, time.Hour, true))
+				}
+			})
+
+			It("deletes replicas from the most populated zone", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(remainingVMNames()).To(ConsistOf("vm-2", "vm-3", "vm-4"))
+			})
+		})
+
+		When("deleting a replica would violate a disruption budget", func() {
+			BeforeEach(func() {
+				rs.Spec.Replicas = ptr.To(int32(1))
+				rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyOldest
+				initObjects = append(initObjects,
+					&vmopv1.VirtualMachineDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-budget",
+							Namespace: namespace,
+						},
+						Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": rsName},
+							},
+							MinAvailable: ptr.To(intstr.FromInt32(2)),
+						},
+					},
+					newReplica("vm-old", "zone-a", 48*time.Hour, false),
+					newReplica("vm-mid", "zone-b", 24*time.Hour, true),
+					newReplica("vm-new", "zone-c", time.Hour, true))
+			})
+
+			JustBeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.K8sWorkloadMgmtAPI = true
+				})
+			})
+
+			It("only deletes the replicas the budget allows", func() {
+				Expect(reconcileRS()).ToNot(Succeed())
+				Expect(remainingVMNames()).To(ConsistOf("vm-mid", "vm-new"))
+
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+				c := conditions.Get(rs, vmopv1.ResizedCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.DisruptionBudgetExceededReason))
+			})
+		})
+
+		When("the replicas must be spread across zones", func() {
+			BeforeEach(func() {
+				rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyRandom
+				rs.Spec.Template.TopologySpreadConstraints = []vmopv1.VirtualMachineTopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						WhenUnsatisfiable: vmopv1.DoNotSchedule,
+					},
+				}
+
+				for _, zone := range []string{"zone-a", "zone-b"} {
+					az := builder.DummyNamedAvailabilityZone(zone)
+					az.Spec.Namespaces[namespace] = topologyv1.NamespaceInfo{}
+					initObjects = append(initObjects, az)
+				}
+
+				initObjects = append(initObjects,
+					newReplica("vm-a-old", "zone-a", 72*time.Hour, true),
+					newReplica("vm-a-new", "zone-a", time.Hour, true),
+					newReplica("vm-b", "zone-b", 96*time.Hour, true),
+					newReplica("vm-c", "zone-c", 2*time.Hour, true))
+			})
+
+			It("deletes replicas from unavailable and then the most populated zones", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(remainingVMNames()).To(ConsistOf("vm-a-old", "vm-b"))
+			})
+
+			When("the delete policy prefers a replica in a less populated zone", func() {
+				BeforeEach(func() {
+					rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyNotReadyFirst
+					for _, obj := range initObjects {
+						if vm, ok := obj.(*vmopv1.VirtualMachine); ok && vm.Name == "vm-b" {
+							conditions.MarkFalse(vm, vmopv1.ReadyConditionType, "NotReady", "")
+						}
+					}
+				})
+
+				It("honors the delete policy before the zones", func() {
+					Expect(reconcileRS()).To(Succeed())
+					Expect(remainingVMNames()).To(ConsistOf("vm-a-old", "vm-a-new"))
+				})
+			})
+
+			When("the delete policy prefers the oldest replica in a less populated zone", func() {
+				BeforeEach(func() {
+					rs.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyOldest
+				})
+
+				It("rebalances the zones before honoring the age of the replicas", func() {
+					Expect(reconcileRS()).To(Succeed())
+					Expect(remainingVMNames()).To(ConsistOf("vm-a-new", "vm-b"))
+				})
+			})
+		})
+	})
+
+	Context("Status", func() {
+		BeforeEach(func() {
+			rs.Spec.Replicas = ptr.To(int32(2))
+			initObjects = append(initObjects,
+				newReplica("vm-1", "zone-a", time.Hour, true),
+				newReplica("vm-2", "zone-b", time.Hour, false))
+		})
+
+		It("marks the VirtualMachinesReady condition false when a replica is not ready", func() {
+			Expect(reconcileRS()).To(Succeed())
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+			Expect(rs.Status.ReadyReplicas).To(Equal(int32(1)))
+			c := conditions.Get(rs, vmopv1.VirtualMachinesReadyCondition)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachinesNotReadyReason))
+		})
+
+		When("all replicas are ready", func() {
+			BeforeEach(func() {
+				initObjects = []client.Object{
+					rs,
+					newReplica("vm-1", "zone-a", time.Hour, true),
+					newReplica("vm-2", "zone-b", time.Hour, true),
+				}
+			})
+
+			It("marks the VirtualMachinesReady condition true", func() {
+				Expect(reconcileRS()).To(Succeed())
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+				Expect(rs.Status.ReadyReplicas).To(Equal(int32(2)))
+				Expect(conditions.IsTrue(rs, vmopv1.VirtualMachinesReadyCondition)).To(BeTrue())
+			})
+		})
+	})
+}
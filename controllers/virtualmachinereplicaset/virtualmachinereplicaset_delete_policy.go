@@ -21,9 +21,16 @@ limitations under the License.
 package virtualmachinereplicaset
 
 import (
+	"fmt"
+	"math"
+	"slices"
 	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 type (
@@ -33,57 +40,191 @@ type (
 
 const (
 	mustDelete    deletePriority = 100.0
-	couldDelete   deletePriority = 50.0
+	shouldDelete  deletePriority = 75.0
+	betterDelete  deletePriority = 50.0
+	couldDelete   deletePriority = 20.0
 	mustNotDelete deletePriority = 0.0
+
+	secondsPerTenDays float64 = 864000
 )
 
+// hasDeleteAnnotation returns true if the VM has been marked as the preferred
+// candidate for deletion.
+func hasDeleteAnnotation(vm *vmopv1.VirtualMachine) bool {
+	_, ok := vm.Annotations[vmopv1.VirtualMachineReplicaSetDeleteAnnotation]
+	return ok
+}
+
+// randomDeletePolicy only prioritizes VMs that are already being deleted or
+// have been annotated for deletion.
 func randomDeletePolicy(vm *vmopv1.VirtualMachine) deletePriority {
 	if !vm.DeletionTimestamp.IsZero() {
 		return mustDelete
 	}
+	if hasDeleteAnnotation(vm) {
+		return shouldDelete
+	}
+	return couldDelete
+}
 
-	// TODO: when we support a Ready/healthy condition, that should have a
-	// higher priority for deletion.  For now, all VMs that are not marked
-	// for deletion get the same priority.
+// notReadyFirstDeletePolicy prioritizes VMs that are not ready after the ones
+// that are being deleted or have been annotated for deletion.
+func notReadyFirstDeletePolicy(vm *vmopv1.VirtualMachine) deletePriority {
+	if p := randomDeletePolicy(vm); p != couldDelete {
+		return p
+	}
+	if !vmopv1util.IsVirtualMachineHealthy(vm) {
+		return betterDelete
+	}
+	return couldDelete
+}
+
+// oldestDeletePolicy prioritizes the VMs that have been around the longest
+// after the ones that are being deleted, annotated for deletion, or not ready.
+func oldestDeletePolicy(vm *vmopv1.VirtualMachine) deletePriority {
+	if p := notReadyFirstDeletePolicy(vm); p != couldDelete {
+		return p
+	}
+	if vm.CreationTimestamp.IsZero() {
+		return mustNotDelete
+	}
+	d := time.Since(vm.CreationTimestamp.Time)
+	if d.Seconds() < 0 {
+		return mustNotDelete
+	}
+	// The priority asymptotically approaches, but never reaches, betterDelete
+	// as the VM ages.
+	return deletePriority(float64(betterDelete) * (1.0 - math.Exp(-d.Seconds()/secondsPerTenDays)))
+}
+
+// newestDeletePolicy prioritizes the most recently created VMs after the ones
+// that are being deleted, annotated for deletion, or not ready.
+func newestDeletePolicy(vm *vmopv1.VirtualMachine) deletePriority {
+	if p := notReadyFirstDeletePolicy(vm); p != couldDelete {
+		return p
+	}
+	// A VM without a creation timestamp has an unknown age, so it is ranked
+	// last, just like the oldest policy does, instead of as the newest VM,
+	// which would tie it with the VMs that are not ready.
+	if vm.CreationTimestamp.IsZero() {
+		return mustNotDelete
+	}
+	return betterDelete - oldestDeletePolicy(vm)
+}
+
+// bucket returns the discrete priority the given priority belongs to. The
+// age-based priorities between mustNotDelete and betterDelete all belong to
+// couldDelete.
+func (p deletePriority) bucket() deletePriority {
+	if p >= betterDelete || p == mustNotDelete {
+		return p
+	}
 	return couldDelete
 }
 
 type sortableMachines struct {
-	machines []*vmopv1.VirtualMachine
-	priority deletePriorityFunc
+	machines   []*vmopv1.VirtualMachine
+	priority   deletePriorityFunc
+	zoneCounts map[string]int
+
+	// zoneNames is the set of zones available to the namespace. It is only
+	// non-nil when the replicas must be spread across zones.
+	zoneNames sets.Set[string]
 }
 
 func (m sortableMachines) Len() int      { return len(m.machines) }
 func (m sortableMachines) Swap(i, j int) { m.machines[i], m.machines[j] = m.machines[j], m.machines[i] }
 func (m sortableMachines) Less(i, j int) bool {
 	priorityI, priorityJ := m.priority(m.machines[i]), m.priority(m.machines[j])
-	if priorityI == priorityJ {
-		// In cases where the priority is identical, it should be ensured that
-		// the same machine order is returned each time.
-		// Ordering by name is a simple way to do this.
+	zoneI, zoneJ := m.machines[i].Status.Zone, m.machines[j].Status.Zone
+
+	if m.zoneNames != nil {
+		// When the replicas must be spread across zones, only the discrete
+		// priorities, ex. VMs that are being deleted, are annotated for
+		// deletion, or are not ready, are honored before the zones. The
+		// age-based priorities of the oldest and newest policies are
+		// continuous and would otherwise never tie, so the zones are used
+		// before them to keep the replicas balanced.
+		if bucketI, bucketJ := priorityI.bucket(), priorityJ.bucket(); bucketI != bucketJ {
+			return bucketJ < bucketI // high to low
+		}
+		// Replicas in zones that are no longer available are deleted first.
+		if hasI, hasJ := m.zoneNames.Has(zoneI), m.zoneNames.Has(zoneJ); hasI != hasJ {
+			return hasJ
+		}
+		if countI, countJ := m.zoneCounts[zoneI], m.zoneCounts[zoneJ]; countI != countJ {
+			return countJ < countI // high to low
+		}
+		if priorityI != priorityJ {
+			return priorityJ < priorityI // high to low
+		}
 		return m.machines[i].Name < m.machines[j].Name
 	}
-	return priorityJ < priorityI // high to low
+
+	if priorityI != priorityJ {
+		return priorityJ < priorityI // high to low
+	}
+	// The zones are only used to break ties so the delete policy is always
+	// honored.
+	// Prefer deleting machines from the zone with the most replicas so the
+	// remaining replicas stay spread across zones.
+	if countI, countJ := m.zoneCounts[zoneI], m.zoneCounts[zoneJ]; countI != countJ {
+		return countJ < countI // high to low
+	}
+	// In cases where the priority is identical, it should be ensured that
+	// the same machine order is returned each time.
+	// Ordering by name is a simple way to do this.
+	return m.machines[i].Name < m.machines[j].Name
 }
 
-func getMachinesToDeletePrioritized(filteredMachines []*vmopv1.VirtualMachine, diff int, fun deletePriorityFunc) []*vmopv1.VirtualMachine {
+func getMachinesToDeletePrioritized(
+	filteredMachines []*vmopv1.VirtualMachine,
+	diff int,
+	fun deletePriorityFunc,
+	zoneNames sets.Set[string]) []*vmopv1.VirtualMachine {
+
 	if diff >= len(filteredMachines) {
 		return filteredMachines
 	} else if diff <= 0 {
 		return []*vmopv1.VirtualMachine{}
 	}
 
+	zoneCounts := map[string]int{}
+	for _, vm := range filteredMachines {
+		zoneCounts[vm.Status.Zone]++
+	}
+
+	// Pick the machines one at a time since every selection changes the number
+	// of replicas left in the selected machine's zone.
 	sortable := sortableMachines{
-		machines: filteredMachines,
-		priority: fun,
+		machines:   slices.Clone(filteredMachines),
+		priority:   fun,
+		zoneCounts: zoneCounts,
+		zoneNames:  zoneNames,
+	}
+	machinesToDelete := make([]*vmopv1.VirtualMachine, 0, diff)
+	for len(machinesToDelete) < diff {
+		sort.Sort(sortable)
+		vm := sortable.machines[0]
+		machinesToDelete = append(machinesToDelete, vm)
+		zoneCounts[vm.Status.Zone]--
+		sortable.machines = sortable.machines[1:]
 	}
-	sort.Sort(sortable)
 
-	return sortable.machines[:diff]
+	return machinesToDelete
 }
 
-// TODO(muchhals): Needed when we add delete priority field.
-func getDeletePriorityFunc(_ *vmopv1.VirtualMachineReplicaSet) (deletePriorityFunc, error) {
-	// TODO: Expand when we start supporting other delete policies.
-	return randomDeletePolicy, nil
+func getDeletePriorityFunc(rs *vmopv1.VirtualMachineReplicaSet) (deletePriorityFunc, error) {
+	switch rs.Spec.DeletePolicy {
+	case "", vmopv1.VirtualMachineReplicaSetDeletePolicyRandom:
+		return randomDeletePolicy, nil
+	case vmopv1.VirtualMachineReplicaSetDeletePolicyNewest:
+		return newestDeletePolicy, nil
+	case vmopv1.VirtualMachineReplicaSetDeletePolicyOldest:
+		return oldestDeletePolicy, nil
+	case vmopv1.VirtualMachineReplicaSetDeletePolicyNotReadyFirst:
+		return notReadyFirstDeletePolicy, nil
+	default:
+		return nil, fmt.Errorf("unsupported delete policy %q", rs.Spec.DeletePolicy)
+	}
 }
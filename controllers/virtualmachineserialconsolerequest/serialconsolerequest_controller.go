@@ -0,0 +1,236 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineserialconsolerequest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/proxyaddr"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
+)
+
+const (
+	DefaultExpiryTime = time.Second * 300
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineSerialConsoleRequest{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, 1),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		VMProvider: vmProvider,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineSerialConsoleRequest object.
+type Reconciler struct {
+	client.Client
+	Context    context.Context
+	Logger     logr.Logger
+	Recorder   record.Recorder
+	VMProvider providers.VirtualMachineProviderInterface
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineserialconsolerequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineserialconsolerequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services/status,verbs=get
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	serialConsoleRequest := &vmopv1.VirtualMachineSerialConsoleRequest{}
+	if err := r.Get(ctx, req.NamespacedName, serialConsoleRequest); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	serialConsoleRequestCtx := &pkgctx.SerialConsoleRequestContext{
+		Context:              ctx,
+		Logger:               pkglog.FromContextOrDefault(ctx),
+		SerialConsoleRequest: serialConsoleRequest,
+		VM:                   &vmopv1.VirtualMachine{},
+	}
+
+	done, err := r.ReconcileEarlyNormal(serialConsoleRequestCtx)
+	if err != nil {
+		serialConsoleRequestCtx.Logger.Error(err, "failed to expire SerialConsoleRequest")
+		return ctrl.Result{}, err
+	}
+	if done {
+		return requeueForExpiry(serialConsoleRequest), nil
+	}
+
+	patchHelper, err := patch.NewHelper(serialConsoleRequest, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", serialConsoleRequestCtx, err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, serialConsoleRequest); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			serialConsoleRequestCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	if err := r.Get(ctx, client.ObjectKey{Name: serialConsoleRequest.Spec.Name, Namespace: serialConsoleRequest.Namespace}, serialConsoleRequestCtx.VM); err != nil {
+		r.Recorder.Warn(serialConsoleRequest, "VirtualMachine Not Found", "")
+		return ctrl.Result{}, fmt.Errorf("failed to get subject vm %s: %w", serialConsoleRequest.Spec.Name, err)
+	}
+
+	if err := r.ReconcileNormal(serialConsoleRequestCtx); err != nil {
+		serialConsoleRequestCtx.Logger.Error(err, "failed to reconcile SerialConsoleRequest")
+		return ctrl.Result{}, err
+	}
+
+	return requeueForExpiry(serialConsoleRequest), nil
+}
+
+// requeueForExpiry returns a result that requeues the request when it
+// expires so it may be deleted.
+func requeueForExpiry(scr *vmopv1.VirtualMachineSerialConsoleRequest) ctrl.Result {
+	expiryTime := scr.Status.ExpiryTime
+	if expiryTime.IsZero() {
+		return ctrl.Result{}
+	}
+	// Requeue slightly after the expiry time so the request is expired when
+	// it is reconciled again.
+	return ctrl.Result{RequeueAfter: time.Until(expiryTime.Time) + time.Second}
+}
+
+// ReconcileEarlyNormal deletes the request if it is expired. It returns true
+// if the request is expired or already ready.
+func (r *Reconciler) ReconcileEarlyNormal(ctx *pkgctx.SerialConsoleRequestContext) (bool, error) {
+	expiryTime := ctx.SerialConsoleRequest.Status.ExpiryTime
+	nowTime := metav1.Now()
+	if !expiryTime.IsZero() && !nowTime.Before(&expiryTime) {
+		err := r.Delete(ctx, ctx.SerialConsoleRequest)
+		if client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete serialconsolerequest: %w", err)
+		}
+		ctx.Logger.Info("Deleted expired SerialConsoleRequest")
+		return true, nil
+	}
+
+	if ctx.SerialConsoleRequest.Status.Endpoint != "" &&
+		ctx.SerialConsoleRequest.Status.ProxyAddr != "" {
+		// If the endpoint and proxy address are already set, no need to
+		// reconcile anymore.
+		ctx.Logger.Info("Endpoint and proxy address already set, skip reconciling")
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.SerialConsoleRequestContext) error {
+	ctx.Logger.Info("Reconciling SerialConsoleRequest")
+	defer func() {
+		ctx.Logger.Info("Finished reconciling SerialConsoleRequest")
+	}()
+
+	scr := ctx.SerialConsoleRequest
+
+	if !serialport.IsEnabled(ctx.VM) {
+		r.Recorder.Warnf(scr, "SerialConsoleNotEnabled",
+			"The serial console is not enabled on VirtualMachine %s", ctx.VM.Name)
+		return fmt.Errorf("serial console is not enabled on vm %s", ctx.VM.Name)
+	}
+
+	endpoint, err := r.VMProvider.GetVirtualMachineSerialConsoleEndpoint(ctx, ctx.VM)
+	if err != nil {
+		return fmt.Errorf("failed to get serial console endpoint: %w", err)
+	}
+
+	proxyAddr, err := proxyaddr.ProxyAddress(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	ttl := DefaultExpiryTime
+	if v := scr.Spec.TTLSeconds; v != nil {
+		ttl = time.Duration(*v) * time.Second
+	}
+
+	scr.Status.Endpoint = endpoint
+	scr.Status.ProxyAddr = proxyAddr
+	scr.Status.ExpiryTime = metav1.NewTime(metav1.Now().Add(ttl))
+
+	// Add UUID as a Label to the request once it is ready. This is used by
+	// the serial console proxy to authenticate connections to the serial
+	// console.
+	if scr.Labels == nil {
+		scr.Labels = make(map[string]string)
+	}
+	scr.Labels[vmopv1.SerialConsoleRequestUUIDLabelKey] = string(scr.UID)
+
+	scr.SetOwnerReferences([]metav1.OwnerReference{
+		{
+			APIVersion: vmopv1.GroupVersion.String(),
+			Kind:       "VirtualMachine",
+			Name:       ctx.VM.Name,
+			UID:        ctx.VM.UID,
+			Controller: ptr.To(true),
+		},
+	})
+
+	r.Recorder.Eventf(scr, "Ready",
+		"Serial console access to VirtualMachine %s is ready", ctx.VM.Name)
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineserialconsolerequest_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	proxyaddr "github.com/vmware-tanzu/vm-operator/pkg/util/kube/proxyaddr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	const endpoint = "10.0.0.1:51234"
+
+	var (
+		ctx      *builder.IntegrationTestContext
+		scr      *vmopv1.VirtualMachineSerialConsoleRequest
+		vm       *vmopv1.VirtualMachine
+		proxySvc *corev1.Service
+	)
+
+	getSerialConsoleRequest := func(ctx *builder.IntegrationTestContext, objKey types.NamespacedName) *vmopv1.VirtualMachineSerialConsoleRequest {
+		scr := &vmopv1.VirtualMachineSerialConsoleRequest{}
+		if err := ctx.Client.Get(ctx, objKey, scr); err != nil {
+			return nil
+		}
+		return scr
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-vm",
+				Namespace: ctx.Namespace,
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				ImageName:  "dummy-image",
+				PowerState: vmopv1.VirtualMachinePowerStateOn,
+				Hardware: &vmopv1.VirtualMachineHardwareSpec{
+					SerialConsole: &vmopv1.VirtualMachineSerialConsoleSpec{
+						Enabled: true,
+					},
+				},
+			},
+		}
+
+		scr = builder.DummyVirtualMachineSerialConsoleRequest(ctx.Namespace, "dummy-scr", vm.Name)
+
+		proxySvc = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      proxyaddr.ProxyAddrServiceName,
+				Namespace: proxyaddr.ProxyAddrServiceNamespace,
+			},
+			Spec: corev1.ServiceSpec{
+				Type: corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{
+					{
+						Name: "dummy-proxy-port",
+						Port: 443,
+					},
+				},
+			},
+		}
+
+		intgFakeVMProvider.Lock()
+		defer intgFakeVMProvider.Unlock()
+		intgFakeVMProvider.GetVirtualMachineSerialConsoleEndpointFn = func(_ context.Context, _ *vmopv1.VirtualMachine) (string, error) {
+			return endpoint, nil
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		intgFakeVMProvider.Reset()
+	})
+
+	Context("Reconcile", func() {
+		BeforeEach(func() {
+			Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+			Expect(ctx.Client.Create(ctx, scr)).To(Succeed())
+			Expect(ctx.Client.Create(ctx, proxySvc)).To(Succeed())
+			proxySvc.Status = corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{
+						{
+							IP: "192.168.0.1",
+						},
+					},
+				},
+			}
+			Expect(ctx.Client.Status().Update(ctx, proxySvc)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			err := ctx.Client.Delete(ctx, scr)
+			Expect(err == nil || apierrors.IsNotFound(err)).To(BeTrue())
+			err = ctx.Client.Delete(ctx, vm)
+			Expect(err == nil || apierrors.IsNotFound(err)).To(BeTrue())
+			err = ctx.Client.Delete(ctx, proxySvc)
+			Expect(err == nil || apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("resource successfully created", func() {
+			objKey := types.NamespacedName{Name: scr.Name, Namespace: scr.Namespace}
+
+			Eventually(func(g Gomega) {
+				scr = getSerialConsoleRequest(ctx, objKey)
+				g.Expect(scr).ToNot(BeNil())
+				g.Expect(scr.Status.Endpoint).ToNot(BeEmpty())
+			}).Should(Succeed(), "waiting endpoint to be set")
+
+			Expect(scr.Status.Endpoint).To(Equal(endpoint))
+			Expect(scr.Status.ProxyAddr).To(Equal("192.168.0.1"))
+			Expect(scr.Status.ExpiryTime.IsZero()).To(BeFalse())
+			Expect(scr.Labels).To(HaveKeyWithValue(vmopv1.SerialConsoleRequestUUIDLabelKey, string(scr.UID)))
+		})
+	})
+}
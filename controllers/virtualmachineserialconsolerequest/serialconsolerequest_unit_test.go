@@ -0,0 +1,208 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineserialconsolerequest_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineserialconsolerequest"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	proxyaddr "github.com/vmware-tanzu/vm-operator/pkg/util/kube/proxyaddr"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(testlabels.Controller),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const endpoint = "10.0.0.1:51234"
+
+	var (
+		initObjects    []client.Object
+		ctx            *builder.UnitTestContextForController
+		fakeVMProvider *providerfake.VMProvider
+
+		reconciler *virtualmachineserialconsolerequest.Reconciler
+		scrCtx     *pkgctx.SerialConsoleRequestContext
+		scr        *vmopv1.VirtualMachineSerialConsoleRequest
+		vm         *vmopv1.VirtualMachine
+		proxySvc   *corev1.Service
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "dummy-vm",
+				UID:  "dummy-vm-uid",
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				Hardware: &vmopv1.VirtualMachineHardwareSpec{
+					SerialConsole: &vmopv1.VirtualMachineSerialConsoleSpec{
+						Enabled: true,
+					},
+				},
+			},
+		}
+
+		scr = builder.DummyVirtualMachineSerialConsoleRequest("", "dummy-scr", vm.Name)
+
+		proxySvc = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      proxyaddr.ProxyAddrServiceName,
+				Namespace: proxyaddr.ProxyAddrServiceNamespace,
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{
+						{
+							IP: "dummy-proxy-ip",
+						},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachineserialconsolerequest.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+			ctx.VMProvider,
+		)
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.GetVirtualMachineSerialConsoleEndpointFn = func(_ context.Context, _ *vmopv1.VirtualMachine) (string, error) {
+			return endpoint, nil
+		}
+
+		scrCtx = &pkgctx.SerialConsoleRequestContext{
+			Context:              ctx,
+			Logger:               ctx.Logger.WithName(scr.Name),
+			SerialConsoleRequest: scr,
+			VM:                   vm,
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+		fakeVMProvider.Reset()
+	})
+
+	Context("ReconcileNormal", func() {
+		BeforeEach(func() {
+			initObjects = append(initObjects, scr, vm, proxySvc)
+		})
+
+		It("returns success", func() {
+			Expect(reconciler.ReconcileNormal(scrCtx)).To(Succeed())
+
+			Expect(scr.Status.Endpoint).To(Equal(endpoint))
+			Expect(scr.Status.ProxyAddr).To(Equal("dummy-proxy-ip"))
+			Expect(scr.Status.ExpiryTime.Time).To(BeTemporally("~", time.Now().Add(virtualmachineserialconsolerequest.DefaultExpiryTime), 5*time.Second))
+			// Checking the label key only because UID will not be set to a resource during unit test.
+			Expect(scr.Labels).To(HaveKey(vmopv1.SerialConsoleRequestUUIDLabelKey))
+			Expect(scr.OwnerReferences).To(HaveLen(1))
+			Expect(scr.OwnerReferences[0].Kind).To(Equal("VirtualMachine"))
+			Expect(scr.OwnerReferences[0].UID).To(Equal(vm.UID))
+
+			var event string
+			Expect(ctx.Events).To(Receive(&event))
+			Expect(event).To(HavePrefix("Normal Ready"))
+
+			done, err := reconciler.ReconcileEarlyNormal(scrCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+
+		When("the request has a TTL", func() {
+			BeforeEach(func() {
+				scr.Spec.TTLSeconds = ptr.To[int64](30)
+			})
+
+			It("expires after the TTL", func() {
+				Expect(reconciler.ReconcileNormal(scrCtx)).To(Succeed())
+				Expect(scr.Status.ExpiryTime.Time).To(BeTemporally("~", time.Now().Add(30*time.Second), 5*time.Second))
+			})
+		})
+
+		When("the VM does not have the serial console enabled", func() {
+			BeforeEach(func() {
+				vm.Spec.Hardware = nil
+			})
+
+			It("returns an error", func() {
+				err := reconciler.ReconcileNormal(scrCtx)
+				Expect(err).To(MatchError("serial console is not enabled on vm dummy-vm"))
+				Expect(scr.Status.Endpoint).To(BeEmpty())
+
+				var event string
+				Expect(ctx.Events).To(Receive(&event))
+				Expect(event).To(HavePrefix("Warning SerialConsoleNotEnabled"))
+			})
+		})
+
+		When("the provider fails to get the endpoint", func() {
+			JustBeforeEach(func() {
+				fakeVMProvider.GetVirtualMachineSerialConsoleEndpointFn = func(_ context.Context, _ *vmopv1.VirtualMachine) (string, error) {
+					return "", errors.New("fake")
+				}
+			})
+
+			It("returns an error", func() {
+				err := reconciler.ReconcileNormal(scrCtx)
+				Expect(err).To(MatchError("failed to get serial console endpoint: fake"))
+				Expect(scr.Status.Endpoint).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("ReconcileEarlyNormal", func() {
+		BeforeEach(func() {
+			initObjects = append(initObjects, scr)
+		})
+
+		It("is not done for a new request", func() {
+			done, err := reconciler.ReconcileEarlyNormal(scrCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+
+		When("the request is expired", func() {
+			BeforeEach(func() {
+				scr.Status.ExpiryTime = metav1.NewTime(time.Now().Add(-time.Second))
+			})
+
+			It("deletes the request", func() {
+				done, err := reconciler.ReconcileEarlyNormal(scrCtx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(done).To(BeTrue())
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(scr), &vmopv1.VirtualMachineSerialConsoleRequest{})).ToNot(Succeed())
+			})
+		})
+	})
+}
@@ -113,7 +113,7 @@ func (nl *NsxtLoadbalancerProvider) GetServiceLabels(ctx context.Context, vmServ
 
 	// When externalTrafficPolicy is set to Local, skip kube-proxy for the
 	// target Service
-	if etp := vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey]; corev1.ServiceExternalTrafficPolicyType(etp) == corev1.ServiceExternalTrafficPolicyTypeLocal {
+	if utils.GetExternalTrafficPolicy(vmService) == corev1.ServiceExternalTrafficPolicyTypeLocal {
 		res[LabelServiceProxyName] = NSXTServiceProxy
 	}
 
@@ -127,7 +127,7 @@ func (nl *NsxtLoadbalancerProvider) GetToBeRemovedServiceLabels(ctx context.Cont
 
 	// When there is no externalTrafficPolicy configured or it's not Local,
 	// remove the service-proxy label
-	if etp := vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey]; corev1.ServiceExternalTrafficPolicyType(etp) != corev1.ServiceExternalTrafficPolicyTypeLocal {
+	if utils.GetExternalTrafficPolicy(vmService) != corev1.ServiceExternalTrafficPolicyTypeLocal {
 		res[LabelServiceProxyName] = NSXTServiceProxy
 	}
 
@@ -139,7 +139,7 @@ func (nl *NsxtLoadbalancerProvider) GetToBeRemovedServiceLabels(ctx context.Cont
 func (nl *NsxtLoadbalancerProvider) GetServiceAnnotations(ctx context.Context, vmService *vmopv1.VirtualMachineService) (map[string]string, error) {
 	res := make(map[string]string)
 
-	if healthCheckNodePortString, ok := vmService.Annotations[utils.AnnotationServiceHealthCheckNodePortKey]; ok {
+	if healthCheckNodePortString, ok := utils.GetHealthCheckNodePort(vmService); ok {
 		res[ServiceLoadBalancerHealthCheckNodePortTagKey] = healthCheckNodePortString
 	}
 
@@ -153,7 +153,7 @@ func (nl *NsxtLoadbalancerProvider) GetToBeRemovedServiceAnnotations(ctx context
 
 	// When healthCheckNodePort is NOT present, the corresponding NSX-T
 	// annotation should be cleared as well
-	if _, ok := vmService.Annotations[utils.AnnotationServiceHealthCheckNodePortKey]; !ok {
+	if _, ok := utils.GetHealthCheckNodePort(vmService); !ok {
 		res[ServiceLoadBalancerHealthCheckNodePortTagKey] = ""
 	}
 
@@ -106,6 +106,37 @@ var _ = Describe(
 			})
 		})
 
+		Context("GetServiceAnnotations when VMService has healthCheckNodePort field defined", func() {
+			BeforeEach(func() {
+				vmService = &vmopv1.VirtualMachineService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "dummy-vmservice",
+						Namespace:   dummyNamespace,
+						Annotations: make(map[string]string),
+					},
+					Spec: vmopv1.VirtualMachineServiceSpec{
+						Type:                  vmopv1.VirtualMachineServiceTypeLoadBalancer,
+						ExternalTrafficPolicy: vmopv1.VirtualMachineServiceExternalTrafficPolicyLocal,
+						HealthCheckNodePort:   30013,
+					},
+				}
+				vmService.Annotations[utils.AnnotationServiceHealthCheckNodePortKey] = "30012"
+				lbProvider = NsxtLoadBalancerProvider()
+			})
+
+			It("should get health check node port from the field in the annotation", func() {
+				vmServiceAnnotations, err := lbProvider.GetServiceAnnotations(ctx, vmService)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmServiceAnnotations).To(HaveKeyWithValue(ServiceLoadBalancerHealthCheckNodePortTagKey, "30013"))
+			})
+
+			It("should not get health check node port in the to be removed annotation", func() {
+				vmServiceAnnotations, err := lbProvider.GetToBeRemovedServiceAnnotations(ctx, vmService)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmServiceAnnotations).ToNot(HaveKey(ServiceLoadBalancerHealthCheckNodePortTagKey))
+			})
+		})
+
 		Context("GetToBeRemovedServiceAnnotations when VMService does not have healthCheckNodePort defined", func() {
 			BeforeEach(func() {
 				vmService = &vmopv1.VirtualMachineService{
@@ -176,6 +207,19 @@ var _ = Describe(
 					Expect(labels[LabelServiceProxyName]).To(Equal(NSXTServiceProxy))
 				})
 			})
+
+			Context("etp field is Local", func() {
+				BeforeEach(func() {
+					vmService.Spec.ExternalTrafficPolicy = vmopv1.VirtualMachineServiceExternalTrafficPolicyLocal
+				})
+
+				It("should create one label for ServiceProxyName", func() {
+					labels, err := lbProvider.GetServiceLabels(ctx, vmService)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(labels).To(HaveLen(1))
+					Expect(labels[LabelServiceProxyName]).To(Equal(NSXTServiceProxy))
+				})
+			})
 		})
 
 		Context("GetToBeRemovedServiceLabels", func() {
@@ -224,6 +268,19 @@ var _ = Describe(
 				})
 			})
 
+			Context("etp field is Cluster and annotation is Local", func() {
+				BeforeEach(func() {
+					vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey] = string(corev1.ServiceExternalTrafficPolicyTypeLocal)
+					vmService.Spec.ExternalTrafficPolicy = vmopv1.VirtualMachineServiceExternalTrafficPolicyCluster
+				})
+
+				It("should remove ServiceProxyName label", func() {
+					Expect(err).ToNot(HaveOccurred())
+					_, exists := labels[LabelServiceProxyName]
+					Expect(exists).To(BeTrue())
+				})
+			})
+
 			Context("etp is Cluster", func() {
 				BeforeEach(func() {
 					vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey] = string(corev1.ServiceExternalTrafficPolicyTypeCluster)
@@ -0,0 +1,48 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// GetExternalTrafficPolicy returns the external traffic policy of the
+// VirtualMachineService. The spec field takes precedence over the
+// AnnotationServiceExternalTrafficPolicyKey annotation. An empty string is
+// returned when neither is set to a supported value.
+func GetExternalTrafficPolicy(
+	vmService *vmopv1.VirtualMachineService) corev1.ServiceExternalTrafficPolicyType {
+
+	if etp := vmService.Spec.ExternalTrafficPolicy; etp != "" {
+		return corev1.ServiceExternalTrafficPolicyType(etp)
+	}
+
+	// Note that this annotation is only set (and makes sense) from the GC cloud provider.
+	etp := corev1.ServiceExternalTrafficPolicyType(
+		vmService.Annotations[AnnotationServiceExternalTrafficPolicyKey])
+	switch etp {
+	case corev1.ServiceExternalTrafficPolicyTypeLocal, corev1.ServiceExternalTrafficPolicyTypeCluster:
+		return etp
+	}
+
+	return ""
+}
+
+// GetHealthCheckNodePort returns the health check node port of the
+// VirtualMachineService as a string. The spec field takes precedence over the
+// AnnotationServiceHealthCheckNodePortKey annotation. The boolean is false
+// when neither is set.
+func GetHealthCheckNodePort(vmService *vmopv1.VirtualMachineService) (string, bool) {
+	if hcnp := vmService.Spec.HealthCheckNodePort; hcnp != 0 {
+		return strconv.Itoa(int(hcnp)), true
+	}
+
+	hcnp, ok := vmService.Annotations[AnnotationServiceHealthCheckNodePortKey]
+	return hcnp, ok
+}
@@ -6,12 +6,16 @@ package virtualmachineservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -33,7 +37,9 @@ import (
 	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 const (
@@ -43,6 +49,20 @@ const (
 	OpCreate = "CreateK8sService"
 	OpDelete = "DeleteK8sService"
 	OpUpdate = "UpdateK8sService"
+
+	// endpointSliceManagedBy is the value of the EndpointSlice managed-by label for
+	// the EndpointSlices created by this controller. It must be different from the
+	// value used by the k8s EndpointSlice controller so that controller ignores them.
+	endpointSliceManagedBy = "vmoperator.vmware.com/virtualmachineservice-controller"
+
+	// topologyModeAuto is the value of the Service's topology mode annotation
+	// that enables topology aware routing.
+	topologyModeAuto = "Auto"
+
+	// topologyOverloadThreshold is how much more than its expected share of the
+	// traffic a zone's endpoints may receive before topology hints are removed.
+	// This matches the threshold used by the upstream EndpointSlice controller.
+	topologyOverloadThreshold = 0.2
 )
 
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
@@ -82,7 +102,7 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		}).
 		Watches(&corev1.Service{},
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &vmopv1.VirtualMachineService{})).
-		Watches(&corev1.Endpoints{},
+		Watches(&discoveryv1.EndpointSlice{},
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &vmopv1.VirtualMachineService{})).
 		Watches(&vmopv1.VirtualMachine{},
 			handler.EnqueueRequestsFromMapFunc(r.virtualMachineToVirtualMachineServiceMapper())).
@@ -123,6 +143,7 @@ type ReconcileVirtualMachineService struct {
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;update;patch;delete
 
 func (r *ReconcileVirtualMachineService) Reconcile(ctx context.Context, request reconcile.Request) (_ reconcile.Result, reterr error) {
 	ctx = pkgcfg.JoinContext(ctx, r.Context)
@@ -166,6 +187,11 @@ func (r *ReconcileVirtualMachineService) ReconcileDelete(ctx *pkgctx.VirtualMach
 			Namespace: ctx.VMService.Namespace,
 		}
 
+		if err := r.deleteEndpointSlices(ctx, nil); err != nil {
+			ctx.Logger.Error(err, "Failed to delete EndpointSlices")
+			return err
+		}
+
 		endpoint := &corev1.Endpoints{ObjectMeta: objectMeta}
 		if err := r.Client.Delete(ctx, endpoint); client.IgnoreNotFound(err) != nil {
 			ctx.Logger.Error(err, "Failed to delete Endpoints")
@@ -195,7 +221,7 @@ func (r *ReconcileVirtualMachineService) ReconcileNormal(ctx *pkgctx.VirtualMach
 		}
 
 		controllerutil.AddFinalizer(ctx.VMService, finalizerName)
-		// NOTE: The VirtualMachineService is set as the OwnerReference of the Service and Endpoints.
+		// NOTE: The VirtualMachineService is set as the OwnerReference of the Service and EndpointSlices.
 		// So while ReconcileDelete() does delete them when our finalizer is set, the k8s GC will
 		// delete them if they still exist if the VirtualMachineService is deleted so we do not have
 		// to return here. The explicit delete in ReconcileDelete() just speeds up the ultimate removal
@@ -271,9 +297,9 @@ func (r *ReconcileVirtualMachineService) reconcileVMService(ctx *pkgctx.VirtualM
 		return err
 	}
 
-	err = r.createOrUpdateEndpoints(ctx, service)
+	err = r.createOrUpdateEndpointSlices(ctx, service)
 	if err != nil {
-		ctx.Logger.Error(err, "Failed to update VirtualMachineService Endpoints")
+		ctx.Logger.Error(err, "Failed to update VirtualMachineService EndpointSlices")
 		return err
 	}
 
@@ -449,18 +475,60 @@ func (r *ReconcileVirtualMachineService) createOrUpdateService(ctx *pkgctx.Virtu
 			service.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeCluster
 		}
 
-		if externalTrafficPolicy, ok := service.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey]; ok {
-			// Note that this annotation is only set (and makes sense) from the GC cloud provider.
-			trafficPolicy := corev1.ServiceExternalTrafficPolicyType(externalTrafficPolicy)
-			switch trafficPolicy {
-			case corev1.ServiceExternalTrafficPolicyTypeLocal, corev1.ServiceExternalTrafficPolicyTypeCluster:
-				service.Spec.ExternalTrafficPolicy = trafficPolicy
-			default:
-				ctx.Logger.V(5).Info("Unknown externalTrafficPolicy VirtualMachineService annotation",
-					"externalTrafficPolicy", externalTrafficPolicy)
+		// The ExternalTrafficPolicy field takes precedence over the annotation.
+		if trafficPolicy := utils.GetExternalTrafficPolicy(vmService); trafficPolicy != "" {
+			service.Spec.ExternalTrafficPolicy = trafficPolicy
+		} else if externalTrafficPolicy, ok := vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey]; ok {
+			ctx.Logger.V(5).Info("Unknown externalTrafficPolicy VirtualMachineService annotation",
+				"externalTrafficPolicy", externalTrafficPolicy)
+		}
+
+		// The HealthCheckNodePort is allocated by k8s when not specified, so only
+		// set it when specified, or clear it when the Service no longer needs one.
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer &&
+			service.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal {
+			if vmService.Spec.HealthCheckNodePort != 0 {
+				service.Spec.HealthCheckNodePort = vmService.Spec.HealthCheckNodePort
+			}
+		} else {
+			service.Spec.HealthCheckNodePort = 0
+		}
+
+		service.Spec.SessionAffinity = corev1.ServiceAffinityNone
+		service.Spec.SessionAffinityConfig = nil
+		if vmService.Spec.SessionAffinity == vmopv1.VirtualMachineServiceSessionAffinityClientIP &&
+			service.Spec.Type != corev1.ServiceTypeExternalName {
+
+			// Set the timeout k8s would otherwise default to avoid patching the Service
+			// on every reconcile.
+			timeoutSeconds := corev1.DefaultClientIPServiceAffinitySeconds
+			if c := vmService.Spec.SessionAffinityConfig; c != nil && c.ClientIP != nil && c.ClientIP.TimeoutSeconds != nil {
+				timeoutSeconds = *c.ClientIP.TimeoutSeconds
+			}
+
+			service.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+			service.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+				ClientIP: &corev1.ClientIPConfig{
+					TimeoutSeconds: &timeoutSeconds,
+				},
 			}
 		}
 
+		// The IP families and policy are assigned by k8s when not specified, so only
+		// set them when specified.
+		if len(vmService.Spec.IPFamilies) > 0 {
+			ipFamilies := make([]corev1.IPFamily, 0, len(vmService.Spec.IPFamilies))
+			for _, ipFamily := range vmService.Spec.IPFamilies {
+				ipFamilies = append(ipFamilies, corev1.IPFamily(ipFamily))
+			}
+			service.Spec.IPFamilies = ipFamilies
+		}
+		if vmService.Spec.IPFamilyPolicy != nil {
+			service.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicy(*vmService.Spec.IPFamilyPolicy))
+		}
+
+		service.Spec.PublishNotReadyAddresses = vmService.Spec.PublishNotReadyAddresses
+
 		return nil
 	})
 
@@ -490,25 +558,47 @@ func (r *ReconcileVirtualMachineService) getVirtualMachinesSelectedByVMService(
 	return vmList, err
 }
 
-// getVMsReferencedByServiceEndpoints gets all VMs that are referenced by service endpoints.
-func (r *ReconcileVirtualMachineService) getVMsReferencedByServiceEndpoints(
+// getVMsReferencedByEndpointSlices gets all VMs that are ready endpoints of the Service's
+// EndpointSlices. The legacy Endpoints, if they still exist, are checked as well since those
+// were written by this controller prior to EndpointSlices.
+func (r *ReconcileVirtualMachineService) getVMsReferencedByEndpointSlices(
 	ctx *pkgctx.VirtualMachineServiceContext,
 	service *corev1.Service) map[types.UID]struct{} {
+
+	vmToEndpointsMap := make(map[types.UID]struct{})
+
+	endpointSlices, err := r.getEndpointSlices(ctx)
+	if err != nil {
+		ctx.Logger.Error(err, "Failed to list EndpointSlices")
+		return nil
+	}
+
+	for _, endpointSlice := range endpointSlices {
+		for _, ep := range endpointSlice.Endpoints {
+			// A nil Ready condition means the readiness is unknown, which should be
+			// interpreted as ready.
+			if ep.TargetRef != nil && ptr.DerefWithDefault(ep.Conditions.Ready, true) {
+				vmToEndpointsMap[ep.TargetRef.UID] = struct{}{}
+			}
+		}
+	}
+
 	endpoints := &corev1.Endpoints{}
 	if err := r.Get(ctx, client.ObjectKey{Name: service.Name, Namespace: service.Namespace}, endpoints); err != nil {
-		ctx.Logger.Error(err, "Failed to get Endpoints")
-		return nil
+		if !apierrors.IsNotFound(err) {
+			ctx.Logger.Error(err, "Failed to get Endpoints")
+		}
+		return vmToEndpointsMap
 	}
 
-	vmToSubsetsMap := make(map[types.UID]struct{})
 	for _, subset := range endpoints.Subsets {
 		for _, epa := range subset.Addresses {
 			if epa.TargetRef != nil {
-				vmToSubsetsMap[epa.TargetRef.UID] = struct{}{}
+				vmToEndpointsMap[epa.TargetRef.UID] = struct{}{}
 			}
 		}
 	}
-	return vmToSubsetsMap
+	return vmToEndpointsMap
 }
 
 func (r *ReconcileVirtualMachineService) getVirtualMachineServicesSelectingVirtualMachine(
@@ -543,38 +633,81 @@ func (r *ReconcileVirtualMachineService) getVirtualMachineServicesSelectingVirtu
 }
 
 // createOrUpdateEndpoints updates the Endpoints for VirtualMachineService.
-func (r *ReconcileVirtualMachineService) createOrUpdateEndpoints(ctx *pkgctx.VirtualMachineServiceContext, service *corev1.Service) error {
-	ctx.Logger.V(5).Info("Updating VirtualMachineService Endpoints")
-	defer ctx.Logger.V(5).Info("Finished updating VirtualMachineService Endpoints")
+func (r *ReconcileVirtualMachineService) createOrUpdateEndpointSlices(ctx *pkgctx.VirtualMachineServiceContext, service *corev1.Service) error {
+	ctx.Logger.V(5).Info("Updating VirtualMachineService EndpointSlices")
+	defer ctx.Logger.V(5).Info("Finished updating VirtualMachineService EndpointSlices")
 
 	if len(ctx.VMService.Spec.Selector) == 0 {
-		ctx.Logger.V(5).Info("Selectorless VirtualMachineService so skipping Endpoints reconciliation")
+		ctx.Logger.V(5).Info("Selectorless VirtualMachineService so skipping EndpointSlices reconciliation")
 		return nil
 	}
 
-	unpackedSubsets, err := r.generateSubsetsForService(ctx, service)
+	endpoints, err := r.generateEndpointsForService(ctx, service)
 	if err != nil {
 		return err
 	}
-	subsets := utils.RepackSubsets(unpackedSubsets)
+	ports := generateEndpointPortsForService(ctx, service)
+
+	if isTopologyAwareRoutingEnabled(service) {
+		// Without any zones, only the zones of the endpoints are considered.
+		zones, err := topology.GetNamespaceZoneNames(ctx, r.Client, service.Namespace)
+		if err != nil &&
+			!errors.Is(err, topology.ErrNoAvailabilityZones) &&
+			!errors.Is(err, topology.ErrNoZones) {
+			return err
+		}
+		for _, eps := range endpoints {
+			setEndpointZoneHints(ctx, eps, zones)
+		}
+	}
+
+	addressTypes := getEndpointSliceAddressTypes(service)
+	for _, addressType := range addressTypes {
+		if err := r.createOrUpdateEndpointSlice(ctx, service, addressType, endpoints[addressType], ports); err != nil {
+			return err
+		}
+	}
+
+	// Remove the EndpointSlices of the address types no longer used by the Service.
+	if err := r.deleteEndpointSlices(ctx, addressTypes); err != nil {
+		return err
+	}
+
+	return r.deleteLegacyEndpoints(ctx, service)
+}
+
+func (r *ReconcileVirtualMachineService) createOrUpdateEndpointSlice(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	service *corev1.Service,
+	addressType discoveryv1.AddressType,
+	endpoints []discoveryv1.Endpoint,
+	ports []discoveryv1.EndpointPort) error {
 
-	endpoints := &corev1.Endpoints{
+	endpointSlice := &discoveryv1.EndpointSlice{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      service.Name,
+			Name:      getEndpointSliceName(service, addressType),
 			Namespace: service.Namespace,
 		},
 	}
 
-	result, err := controllerutil.CreateOrPatch(ctx, r.Client, endpoints, func() error {
-		if err := controllerutil.SetControllerReference(ctx.VMService, endpoints, r.Client.Scheme()); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, r.Client, endpointSlice, func() error {
+		if err := controllerutil.SetControllerReference(ctx.VMService, endpointSlice, r.Client.Scheme()); err != nil {
 			return err
 		}
 
 		// NCP apparently needs the same Labels as what is present on the Service, and I'm not aware
 		// of anything else setting Labels, so just sync the Labels (and Annotations) with the Service.
-		endpoints.Labels = service.Labels
-		endpoints.Annotations = service.Annotations
-		endpoints.Subsets = subsets
+		endpointSlice.Labels = make(map[string]string, len(service.Labels)+2)
+		for k, v := range service.Labels {
+			endpointSlice.Labels[k] = v
+		}
+		endpointSlice.Labels[discoveryv1.LabelServiceName] = service.Name
+		endpointSlice.Labels[discoveryv1.LabelManagedBy] = endpointSliceManagedBy
+		endpointSlice.Annotations = service.Annotations
+
+		endpointSlice.AddressType = addressType
+		endpointSlice.Endpoints = endpoints
+		endpointSlice.Ports = ports
 		return nil
 	})
 
@@ -584,15 +717,106 @@ func (r *ReconcileVirtualMachineService) createOrUpdateEndpoints(ctx *pkgctx.Vir
 
 	switch result {
 	case controllerutil.OperationResultCreated:
-		ctx.Logger.Info("Creating Service Endpoints", "endpoints", endpoints)
+		ctx.Logger.Info("Creating Service EndpointSlice", "endpointSlice", endpointSlice)
 	case controllerutil.OperationResultUpdated:
-		ctx.Logger.Info("Updating Service Endpoints", "endpoints", endpoints)
+		ctx.Logger.Info("Updating Service EndpointSlice", "endpointSlice", endpointSlice)
+	}
+
+	return nil
+}
+
+// getEndpointSlices returns the EndpointSlices of the VirtualMachineService that are
+// managed by this controller.
+func (r *ReconcileVirtualMachineService) getEndpointSlices(
+	ctx *pkgctx.VirtualMachineServiceContext) ([]discoveryv1.EndpointSlice, error) {
+
+	endpointSliceList := &discoveryv1.EndpointSliceList{}
+	if err := r.List(ctx, endpointSliceList,
+		client.InNamespace(ctx.VMService.Namespace),
+		client.MatchingLabels{
+			discoveryv1.LabelServiceName: ctx.VMService.Name,
+			discoveryv1.LabelManagedBy:   endpointSliceManagedBy,
+		}); err != nil {
+		return nil, err
+	}
+
+	endpointSlices := make([]discoveryv1.EndpointSlice, 0, len(endpointSliceList.Items))
+	for i := range endpointSliceList.Items {
+		if metav1.IsControlledBy(&endpointSliceList.Items[i], ctx.VMService) {
+			endpointSlices = append(endpointSlices, endpointSliceList.Items[i])
+		}
+	}
+
+	return endpointSlices, nil
+}
+
+// deleteEndpointSlices deletes the EndpointSlices of the VirtualMachineService whose
+// address type is not in keepAddressTypes.
+func (r *ReconcileVirtualMachineService) deleteEndpointSlices(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	keepAddressTypes []discoveryv1.AddressType) error {
+
+	endpointSlices, err := r.getEndpointSlices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range endpointSlices {
+		endpointSlice := &endpointSlices[i]
+		if slices.Contains(keepAddressTypes, endpointSlice.AddressType) {
+			continue
+		}
+
+		ctx.Logger.Info("Deleting Service EndpointSlice", "endpointSlice", endpointSlice.Name)
+		if err := r.Delete(ctx, endpointSlice); client.IgnoreNotFound(err) != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func findVMPortNum(_ *vmopv1.VirtualMachine, port intstr.IntOrString, _ corev1.Protocol) (int, error) {
+// deleteLegacyEndpoints deletes the Endpoints that were written by this controller prior
+// to EndpointSlices. Otherwise, the k8s EndpointSlice mirroring controller would mirror
+// the stale Endpoints into EndpointSlices.
+func (r *ReconcileVirtualMachineService) deleteLegacyEndpoints(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	service *corev1.Service) error {
+
+	endpoints := &corev1.Endpoints{}
+	if err := r.Get(ctx, client.ObjectKey{Name: service.Name, Namespace: service.Namespace}, endpoints); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !metav1.IsControlledBy(endpoints, ctx.VMService) {
+		return nil
+	}
+
+	ctx.Logger.Info("Deleting legacy Service Endpoints")
+	return client.IgnoreNotFound(r.Delete(ctx, endpoints))
+}
+
+// getEndpointSliceName returns the name of the Service's EndpointSlice for the address type.
+func getEndpointSliceName(service *corev1.Service, addressType discoveryv1.AddressType) string {
+	return fmt.Sprintf("%s-%s", service.Name, strings.ToLower(string(addressType)))
+}
+
+// getEndpointSliceAddressTypes returns the address types of the Service's EndpointSlices.
+// The Service's IP families are assigned by k8s, but if they have not been assigned yet
+// EndpointSlices of both address types are used.
+func getEndpointSliceAddressTypes(service *corev1.Service) []discoveryv1.AddressType {
+	if len(service.Spec.IPFamilies) == 0 {
+		return []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6}
+	}
+
+	addressTypes := make([]discoveryv1.AddressType, 0, len(service.Spec.IPFamilies))
+	for _, ipFamily := range service.Spec.IPFamilies {
+		addressTypes = append(addressTypes, discoveryv1.AddressType(ipFamily))
+	}
+	return addressTypes
+}
+
+func findTargetPortNum(port intstr.IntOrString) (int, error) {
 	switch port.Type {
 	case intstr.String:
 		// Not supported.
@@ -603,117 +827,198 @@ func findVMPortNum(_ *vmopv1.VirtualMachine, port intstr.IntOrString, _ corev1.P
 	return 0, fmt.Errorf("no matching port on VM")
 }
 
-// generateSubsetsForService generates Endpoints subsets for a given Service.
-func (r *ReconcileVirtualMachineService) generateSubsetsForService(
+// generateEndpointPortsForService generates the EndpointSlice ports for a given Service.
+func generateEndpointPortsForService(
 	ctx *pkgctx.VirtualMachineServiceContext,
-	service *corev1.Service) ([]corev1.EndpointSubset, error) {
+	service *corev1.Service) []discoveryv1.EndpointPort {
+
+	// TODO: Headless support
+	ports := make([]discoveryv1.EndpointPort, 0, len(service.Spec.Ports))
+	for _, servicePort := range service.Spec.Ports {
+		portName := servicePort.Name
+		portProto := servicePort.Protocol
+
+		portNum, err := findTargetPortNum(servicePort.TargetPort)
+		if err != nil {
+			ctx.Logger.Info("Failed to find port for service",
+				"name", portName, "protocol", portProto, "error", err)
+			continue
+		}
+
+		ports = append(ports,
+			discoveryv1.EndpointPort{
+				Name:     ptr.To(portName),
+				Port:     ptr.To(int32(portNum)), //nolint:gosec // disable G115
+				Protocol: ptr.To(portProto),
+			})
+	}
+
+	return ports
+}
+
+// generateEndpointsForService generates the EndpointSlice endpoints, by address type,
+// for a given Service.
+func (r *ReconcileVirtualMachineService) generateEndpointsForService(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	service *corev1.Service) (map[discoveryv1.AddressType][]discoveryv1.Endpoint, error) {
 
 	vmList, err := r.getVirtualMachinesSelectedByVMService(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var subsets = make([]corev1.EndpointSubset, 0, len(vmList.Items))
-	var vmInSubsetsMap map[types.UID]struct{}
+	endpoints := make(map[discoveryv1.AddressType][]discoveryv1.Endpoint, 2)
+	var vmInEndpointsMap map[types.UID]struct{}
 
 	for i := range vmList.Items {
 		vm := vmList.Items[i]
 		logger := ctx.Logger.WithValues("virtualMachine", vm.NamespacedName())
 
-		if !vm.DeletionTimestamp.IsZero() {
-			logger.Info("Skipping VM marked for deletion")
-			continue
-		}
-
-		var vmIP string
+		vmIPs := make(map[discoveryv1.AddressType]string, 2)
 		if vm.Status.Network != nil {
-			vmIP = vm.Status.Network.PrimaryIP4
-			if vmIP == "" {
-				vmIP = vm.Status.Network.PrimaryIP6
-			}
+			vmIPs[discoveryv1.AddressTypeIPv4] = vm.Status.Network.PrimaryIP4
+			vmIPs[discoveryv1.AddressTypeIPv6] = vm.Status.Network.PrimaryIP6
 		}
 
-		if vmIP == "" {
-			// The EndpointAddress must have a valid IP so we cannot include this VM in the
-			// NotReadyAddresses.
+		if vmIPs[discoveryv1.AddressTypeIPv4] == "" && vmIPs[discoveryv1.AddressTypeIPv6] == "" {
+			// The Endpoint must have a valid IP so we cannot include this VM as not ready.
 			// TODO: When we more fully support multiple NICs, we'll need someway to select which IP.
 			logger.Info("Skipping VM without primary IP assigned")
 			continue
 		}
 
-		// If the VM has a ReadinessProbe and Ready condition, ready is a reflection of the condition
-		// status. If the VM has a ReadinessProbe but no condition, we assume that the prober just
-		// hasn't run against the VM yet, so infer the VM's readiness if it was previously in the EP;
-		// this is to handle upgrade scenarios.
-		// Otherwise, a VM that does not have a ReadinessProbe is implicitly ready.
-		ready := true
+		// If the VM has a ReadinessProbe and Ready condition, serving is a reflection of the
+		// condition status. If the VM has a ReadinessProbe but no condition, we assume that the
+		// prober just hasn't run against the VM yet, so infer the VM's readiness if it was
+		// previously a ready endpoint; this is to handle upgrade scenarios.
+		// Otherwise, a VM that does not have a ReadinessProbe is implicitly serving.
+		serving := true
 
-		if probe := vm.Spec.ReadinessProbe; probe != nil && (probe.TCPSocket != nil || probe.GuestHeartbeat != nil || len(probe.GuestInfo) != 0) {
+		if vmopv1util.HasReadinessProbe(vm) {
 			if condition := conditions.Get(&vm, vmopv1.ReadyConditionType); condition == nil {
-				if vmInSubsetsMap == nil {
-					vmInSubsetsMap = r.getVMsReferencedByServiceEndpoints(ctx, service)
+				if vmInEndpointsMap == nil {
+					vmInEndpointsMap = r.getVMsReferencedByEndpointSlices(ctx, service)
 				}
 
-				// If this VM was previously in the EP subset, preserve its readiness until prober
+				// If this VM was previously a ready endpoint, preserve its readiness until prober
 				// updates the condition (the probe used to be done inline here before we had a
 				// Ready condition).
-				_, ready = vmInSubsetsMap[vm.UID]
+				_, serving = vmInEndpointsMap[vm.UID]
 			} else {
-				ready = condition.Status == metav1.ConditionTrue
+				serving = condition.Status == metav1.ConditionTrue
 			}
 		}
 
-		epa := corev1.EndpointAddress{
-			IP: vmIP,
-			TargetRef: &corev1.ObjectReference{
-				APIVersion: vm.APIVersion,
-				Kind:       vm.Kind,
-				Namespace:  vm.Namespace,
-				Name:       vm.Name,
-				UID:        vm.UID,
-				// NOTE: This currently isn't set to limit downstream reconcile churn in things
-				// watching these Endpoints but isn't ideal. We should be smarter and only update
-				// this when something relevant to the service, e.g. the VM's IP, changes.
-				// ResourceVersion: vm.ResourceVersion,
-			},
-		}
-
-		// Populate the EP subset for this VM. We create one subset for each VM, and then our
-		// caller will repack the subsets that have identical ports.
-		subset := corev1.EndpointSubset{}
-		if ready {
-			subset.Addresses = []corev1.EndpointAddress{epa}
-		} else {
-			subset.NotReadyAddresses = []corev1.EndpointAddress{epa}
-		}
+		// A VM marked for deletion is terminating and is not ready, unless the Service
+		// publishes not ready addresses in which case every VM is treated as ready.
+		terminating := !vm.DeletionTimestamp.IsZero()
+		ready := service.Spec.PublishNotReadyAddresses || (serving && !terminating)
 
-		// TODO: Headless support
-		for _, servicePort := range service.Spec.Ports {
-			portName := servicePort.Name
-			portProto := servicePort.Protocol
+		for _, addressType := range []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6} {
+			vmIP := vmIPs[addressType]
+			if vmIP == "" {
+				continue
+			}
 
-			logger.V(5).Info("ServicePort for VirtualMachine",
-				"port name", portName, "port proto", portProto)
+			ep := discoveryv1.Endpoint{
+				Addresses: []string{vmIP},
+				Conditions: discoveryv1.EndpointConditions{
+					Ready:       ptr.To(ready),
+					Serving:     ptr.To(serving),
+					Terminating: ptr.To(terminating),
+				},
+				TargetRef: &corev1.ObjectReference{
+					APIVersion: vm.APIVersion,
+					Kind:       vm.Kind,
+					Namespace:  vm.Namespace,
+					Name:       vm.Name,
+					UID:        vm.UID,
+					// NOTE: This currently isn't set to limit downstream reconcile churn in things
+					// watching these EndpointSlices but isn't ideal. We should be smarter and only
+					// update this when something relevant to the service, e.g. the VM's IP, changes.
+					// ResourceVersion: vm.ResourceVersion,
+				},
+			}
 
-			portNum, err := findVMPortNum(&vm, servicePort.TargetPort, portProto)
-			if err != nil {
-				logger.Info("Failed to find port for service",
-					"name", portName, "protocol", portProto, "error", err)
-				continue
+			if zone := vm.Status.Zone; zone != "" {
+				ep.Zone = ptr.To(zone)
 			}
 
-			subset.Ports = append(subset.Ports,
-				corev1.EndpointPort{
-					Name:     portName,
-					Port:     int32(portNum), //nolint:gosec // disable G115
-					Protocol: portProto,
-				})
+			endpoints[addressType] = append(endpoints[addressType], ep)
 		}
+	}
+
+	return endpoints, nil
+}
 
-		subsets = append(subsets, subset)
+// isTopologyAwareRoutingEnabled returns true if the Service opts into topology
+// aware routing with the same annotations that are used by the upstream
+// EndpointSlice controller.
+func isTopologyAwareRoutingEnabled(service *corev1.Service) bool {
+	mode, ok := service.Annotations[corev1.AnnotationTopologyMode]
+	if !ok {
+		mode = service.Annotations[corev1.DeprecatedAnnotationTopologyAwareHints]
 	}
+	return strings.EqualFold(mode, topologyModeAuto)
+}
+
+// setEndpointZoneHints hints that each ready endpoint should be consumed from
+// its own zone, but only when doing so keeps the ready endpoints balanced
+// across the zones. Otherwise, the hints are removed so that traffic is
+// distributed across all of the endpoints.
+//
+// Unlike the upstream EndpointSlice controller, the zones do not have nodes
+// whose allocatable CPU can be used to weigh the expected traffic from each
+// zone, so each of the namespace's zones is expected to receive the same
+// amount of traffic.
+func setEndpointZoneHints(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	endpoints []discoveryv1.Endpoint,
+	namespaceZones []string) {
 
-	return subsets, nil
+	for i := range endpoints {
+		endpoints[i].Hints = nil
+	}
+
+	zoneCounts := make(map[string]int, len(namespaceZones))
+	for _, zone := range namespaceZones {
+		zoneCounts[zone] = 0
+	}
+
+	readyCount := 0
+	for _, ep := range endpoints {
+		if !ptr.Deref(ep.Conditions.Ready) {
+			continue
+		}
+		if ep.Zone == nil || *ep.Zone == "" {
+			ctx.Logger.V(4).Info("Not setting topology hints because an endpoint has no zone")
+			return
+		}
+		zoneCounts[*ep.Zone]++
+		readyCount++
+	}
+
+	if readyCount == 0 || readyCount < len(zoneCounts) {
+		ctx.Logger.V(4).Info("Not setting topology hints because there are fewer ready endpoints than zones")
+		return
+	}
+
+	expected := float64(readyCount) / float64(len(zoneCounts))
+	for zone, count := range zoneCounts {
+		if count == 0 || expected/float64(count) > 1+topologyOverloadThreshold {
+			ctx.Logger.V(4).Info("Not setting topology hints because the endpoints are not balanced",
+				"zone", zone, "endpoints", count, "expected", expected)
+			return
+		}
+	}
+
+	for i := range endpoints {
+		if ptr.Deref(endpoints[i].Conditions.Ready) {
+			endpoints[i].Hints = &discoveryv1.EndpointHints{
+				ForZones: []discoveryv1.ForZone{{Name: *endpoints[i].Zone}},
+			}
+		}
+	}
 }
 
 // updateVMService syncs the VirtualMachineService Status from the Service status.
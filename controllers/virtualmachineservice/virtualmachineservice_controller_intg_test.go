@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -73,8 +75,8 @@ func intgTestsReconcile() {
 			err = ctx.Client.Delete(ctx, service)
 			Expect(client.IgnoreNotFound(err)).ToNot(HaveOccurred())
 
-			endpoints := &corev1.Endpoints{ObjectMeta: objMeta}
-			err = ctx.Client.Delete(ctx, endpoints)
+			endpointSlice := &discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{Name: vmServiceName + "-ipv4", Namespace: ctx.Namespace}}
+			err = ctx.Client.Delete(ctx, endpointSlice)
 			Expect(client.IgnoreNotFound(err)).ToNot(HaveOccurred())
 		})
 
@@ -132,6 +134,7 @@ func intgTestsReconcile() {
 				}
 
 				objKey := client.ObjectKey{Namespace: vmService.Namespace, Name: vmService.Name}
+				sliceKey := client.ObjectKey{Namespace: vmService.Namespace, Name: vmService.Name + "-ipv4"}
 
 				By("Create VirtualMachineService", func() {
 					Expect(ctx.Client.Create(ctx, vmService)).To(Succeed())
@@ -161,32 +164,31 @@ func intgTestsReconcile() {
 					Expect(service.Status.LoadBalancer.Ingress).To(BeEmpty())
 				})
 
-				By("Endpoints should be created", func() {
-					endpoints := &corev1.Endpoints{}
+				By("EndpointSlice should be created", func() {
+					endpointSlice := &discoveryv1.EndpointSlice{}
 					Eventually(func() error {
-						return ctx.Client.Get(ctx, objKey, endpoints)
+						return ctx.Client.Get(ctx, sliceKey, endpointSlice)
 					}).Should(Succeed())
 
-					Expect(endpoints.Labels).To(HaveKeyWithValue(dummyLabelKey, dummyLabelVal))
-					Expect(endpoints.Annotations).To(HaveKeyWithValue(dummyAnnotationKey, dummyAnnotationVal))
+					Expect(endpointSlice.Labels).To(HaveKeyWithValue(dummyLabelKey, dummyLabelVal))
+					Expect(endpointSlice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, vmService.Name))
+					Expect(endpointSlice.Annotations).To(HaveKeyWithValue(dummyAnnotationKey, dummyAnnotationVal))
+					Expect(endpointSlice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
 
-					Expect(endpoints.Subsets).To(HaveLen(1))
-					subset := endpoints.Subsets[0]
-					Expect(subset.Addresses).To(BeEmpty())
-
-					By("Not ready VM should be included to Endpoints", func() {
+					By("Not ready VM should be included to EndpointSlice", func() {
 						Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(notReadyVM), notReadyVM)).To(Succeed())
 
-						Expect(subset.NotReadyAddresses).To(HaveLen(1))
-						address := subset.NotReadyAddresses[0]
+						Expect(endpointSlice.Endpoints).To(HaveLen(1))
+						ep := endpointSlice.Endpoints[0]
+						Expect(ep.Conditions.Ready).To(HaveValue(BeFalse()))
 						Expect(notReadyVM.Status.Network).ToNot(BeNil())
-						Expect(address.IP).To(Equal(notReadyVM.Status.Network.PrimaryIP4))
-						Expect(address.TargetRef).ToNot(BeNil())
-						Expect(address.TargetRef.Name).To(Equal(notReadyVM.Name))
-						Expect(address.TargetRef.Namespace).To(Equal(notReadyVM.Namespace))
-						Expect(address.TargetRef.UID).To(Equal(notReadyVM.UID))
-						Expect(address.TargetRef.Kind).ToNot(BeEmpty())
-						Expect(address.TargetRef.APIVersion).ToNot(BeEmpty())
+						Expect(ep.Addresses).To(Equal([]string{notReadyVM.Status.Network.PrimaryIP4}))
+						Expect(ep.TargetRef).ToNot(BeNil())
+						Expect(ep.TargetRef.Name).To(Equal(notReadyVM.Name))
+						Expect(ep.TargetRef.Namespace).To(Equal(notReadyVM.Namespace))
+						Expect(ep.TargetRef.UID).To(Equal(notReadyVM.UID))
+						Expect(ep.TargetRef.Kind).ToNot(BeEmpty())
+						Expect(ep.TargetRef.APIVersion).ToNot(BeEmpty())
 					})
 				})
 
@@ -212,72 +214,57 @@ func intgTestsReconcile() {
 					Expect(ctx.Client.Status().Update(ctx, readyVM)).To(Succeed())
 				})
 
-				By("Ready VM should be added to Endpoints", func() {
-					endpoints := &corev1.Endpoints{}
+				By("Ready VM should be added to EndpointSlice", func() {
+					endpointSlice := &discoveryv1.EndpointSlice{}
 					Eventually(func() bool {
-						if err := ctx.Client.Get(ctx, objKey, endpoints); err == nil && len(endpoints.Subsets) == 1 {
-							subset := endpoints.Subsets[0]
-							return len(subset.Addresses) != 0 && len(subset.NotReadyAddresses) != 0
+						if err := ctx.Client.Get(ctx, sliceKey, endpointSlice); err == nil {
+							return len(endpointSlice.Endpoints) == 2
 						}
 						return false
 					}).Should(BeTrue())
 
-					subsets := endpoints.Subsets
-					Expect(subsets).To(HaveLen(1))
-
-					subset := subsets[0]
-					Expect(subset.Addresses).To(HaveLen(1))
-					Expect(subset.NotReadyAddresses).To(HaveLen(1))
-
-					address := subset.Addresses[0]
+					var ep *discoveryv1.Endpoint
+					for i := range endpointSlice.Endpoints {
+						if endpointSlice.Endpoints[i].TargetRef.Name == readyVM.Name {
+							ep = &endpointSlice.Endpoints[i]
+						}
+					}
+					Expect(ep).ToNot(BeNil())
+					Expect(ep.Conditions.Ready).To(HaveValue(BeTrue()))
 					Expect(readyVM.Status.Network).ToNot(BeNil())
-					Expect(address.IP).To(Equal(readyVM.Status.Network.PrimaryIP4))
-					Expect(address.TargetRef).ToNot(BeNil())
-					Expect(address.TargetRef.Name).To(Equal(readyVM.Name))
-					Expect(address.TargetRef.Namespace).To(Equal(readyVM.Namespace))
-					Expect(address.TargetRef.UID).To(Equal(readyVM.UID))
-					Expect(address.TargetRef.Kind).ToNot(BeEmpty())
-					Expect(address.TargetRef.APIVersion).ToNot(BeEmpty())
-
-					Expect(subset.Ports).To(HaveLen(1))
-					port := subset.Ports[0]
-					Expect(port.Name).To(Equal(port.Name))
-					Expect(port.Port).To(BeEquivalentTo(vmServicePort.TargetPort))
-					Expect(port.Protocol).To(BeEquivalentTo(corev1.ProtocolTCP))
+					Expect(ep.Addresses).To(Equal([]string{readyVM.Status.Network.PrimaryIP4}))
+					Expect(ep.TargetRef.Namespace).To(Equal(readyVM.Namespace))
+					Expect(ep.TargetRef.UID).To(Equal(readyVM.UID))
+					Expect(ep.TargetRef.Kind).ToNot(BeEmpty())
+					Expect(ep.TargetRef.APIVersion).ToNot(BeEmpty())
+
+					Expect(endpointSlice.Ports).To(HaveLen(1))
+					port := endpointSlice.Ports[0]
+					Expect(port.Name).To(HaveValue(Equal(vmServicePort.Name)))
+					Expect(port.Port).To(HaveValue(BeEquivalentTo(vmServicePort.TargetPort)))
+					Expect(port.Protocol).To(HaveValue(Equal(corev1.ProtocolTCP)))
 				})
 
-				By("Deleted VM should be removed from Endpoints", func() {
+				By("Deleted VM should be terminating in EndpointSlice", func() {
 					// Must add finalizer here so that the VM does not get deleted immediately, as our
 					// VM mapping function assumes that the VM exists. This is a bug, and should have
 					// a similar solution as the XIt() test below. In practice, this should be hard to
 					// hit because of the VirtualMachine controller finalizer.
-					notReadyVM.Finalizers = append(notReadyVM.Finalizers, "dummy.test.finalizer")
-					Expect(ctx.Client.Update(ctx, notReadyVM)).To(Succeed())
-					Expect(ctx.Client.Delete(ctx, notReadyVM)).To(Succeed())
-
-					Eventually(func() bool {
-						endpoints := &corev1.Endpoints{}
-						if err := ctx.Client.Get(ctx, objKey, endpoints); err == nil {
-							return len(endpoints.Subsets) == 1 && len(endpoints.Subsets[0].NotReadyAddresses) == 0
-						}
-						return false
-					}).Should(BeTrue(), "not ready VM should be removed from Endpoints")
-
 					readyVM.Finalizers = append(readyVM.Finalizers, "dummy.test.finalizer")
 					Expect(ctx.Client.Update(ctx, readyVM)).To(Succeed())
 					Expect(ctx.Client.Delete(ctx, readyVM)).To(Succeed())
 
 					Eventually(func() bool {
-						endpoints := &corev1.Endpoints{}
-						if err := ctx.Client.Get(ctx, objKey, endpoints); err == nil {
-							return len(endpoints.Subsets) == 0
+						endpointSlice := &discoveryv1.EndpointSlice{}
+						if err := ctx.Client.Get(ctx, sliceKey, endpointSlice); err == nil {
+							for _, ep := range endpointSlice.Endpoints {
+								if ep.TargetRef.Name == readyVM.Name {
+									return ptr.Deref(ep.Conditions.Terminating) && !ptr.Deref(ep.Conditions.Ready)
+								}
+							}
 						}
 						return false
-					}).Should(BeTrue())
-
-					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(notReadyVM), notReadyVM)).To(Succeed())
-					notReadyVM.Finalizers = nil
-					Expect(ctx.Client.Update(ctx, notReadyVM)).To(Succeed())
+					}).Should(BeTrue(), "deleted VM should be terminating in EndpointSlice")
 
 					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(readyVM), readyVM)).To(Succeed())
 					readyVM.Finalizers = nil
@@ -338,35 +325,28 @@ func intgTestsReconcile() {
 					},
 				}
 
-				objKey := client.ObjectKey{Namespace: vmService.Namespace, Name: vmService.Name}
+				sliceKey := client.ObjectKey{Namespace: vmService.Namespace, Name: vmService.Name + "-ipv4"}
 
 				By("Create VirtualMachineService", func() {
 					Expect(ctx.Client.Create(ctx, vmService)).To(Succeed())
 				})
 
-				By("Ready VM should be added to Endpoints", func() {
-					endpoints := &corev1.Endpoints{}
+				By("Ready VM should be added to EndpointSlice", func() {
+					endpointSlice := &discoveryv1.EndpointSlice{}
 					Eventually(func() bool {
-						if err := ctx.Client.Get(ctx, objKey, endpoints); err == nil {
-							return len(endpoints.Subsets) != 0
+						if err := ctx.Client.Get(ctx, sliceKey, endpointSlice); err == nil {
+							return len(endpointSlice.Endpoints) != 0
 						}
 						return false
 					}).Should(BeTrue())
 
-					subsets := endpoints.Subsets
-					Expect(subsets).To(HaveLen(1))
-
-					subset := subsets[0]
-					Expect(subset.Addresses).To(HaveLen(1))
-
-					address := subset.Addresses[0]
-					Expect(address.IP).To(Equal(readyVM.Status.Network.PrimaryIP4))
+					Expect(endpointSlice.Endpoints).To(HaveLen(1))
+					Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{readyVM.Status.Network.PrimaryIP4}))
 
-					Expect(subset.Ports).To(HaveLen(1))
-					port := subset.Ports[0]
-					Expect(port.Name).To(Equal(port.Name))
-					Expect(port.Port).To(BeEquivalentTo(vmServicePort.TargetPort))
-					Expect(port.Protocol).To(BeEquivalentTo(corev1.ProtocolTCP))
+					Expect(endpointSlice.Ports).To(HaveLen(1))
+					port := endpointSlice.Ports[0]
+					Expect(port.Port).To(HaveValue(BeEquivalentTo(vmServicePort.TargetPort)))
+					Expect(port.Protocol).To(HaveValue(Equal(corev1.ProtocolTCP)))
 				})
 
 				By("Change VM Labels so it no longer matches selector", func() {
@@ -374,11 +354,11 @@ func intgTestsReconcile() {
 					Expect(ctx.Client.Update(ctx, readyVM)).To(Succeed())
 				})
 
-				By("VM should be removed from Endpoints", func() {
+				By("VM should be removed from EndpointSlice", func() {
 					Eventually(func() bool {
-						endpoints := &corev1.Endpoints{}
-						if err := ctx.Client.Get(ctx, objKey, endpoints); err == nil {
-							return len(endpoints.Subsets) == 0
+						endpointSlice := &discoveryv1.EndpointSlice{}
+						if err := ctx.Client.Get(ctx, sliceKey, endpointSlice); err == nil {
+							return len(endpointSlice.Endpoints) == 0
 						}
 						return false
 					}).Should(BeTrue())
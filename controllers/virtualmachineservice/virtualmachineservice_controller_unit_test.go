@@ -11,6 +11,7 @@ import (
 	"github.com/onsi/gomega/types"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apiEquality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +21,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice/providers"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineservice/utils"
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
@@ -255,6 +257,80 @@ func unitTestsReconcile() {
 					Expect(service.Annotations).To(HaveKeyWithValue(utils.AnnotationServiceHealthCheckNodePortKey, "99"))
 				})
 			})
+
+			Context("ExternalTrafficPolicy and HealthCheckNodePort", func() {
+				BeforeEach(func() {
+					vmService.Annotations[utils.AnnotationServiceExternalTrafficPolicyKey] = string(corev1.ServiceExternalTrafficPolicyTypeCluster)
+					vmService.Spec.ExternalTrafficPolicy = vmopv1.VirtualMachineServiceExternalTrafficPolicyLocal
+					vmService.Spec.HealthCheckNodePort = 30042
+				})
+
+				It("Expected values take precedence over the annotation", func() {
+					Expect(service.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyTypeLocal))
+					Expect(service.Spec.HealthCheckNodePort).To(BeEquivalentTo(30042))
+				})
+			})
+
+			Context("SessionAffinity", func() {
+				It("Defaults to None", func() {
+					Expect(service.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityNone))
+					Expect(service.Spec.SessionAffinityConfig).To(BeNil())
+				})
+
+				When("ClientIP without timeout", func() {
+					BeforeEach(func() {
+						vmService.Spec.SessionAffinity = vmopv1.VirtualMachineServiceSessionAffinityClientIP
+					})
+
+					It("Expected values", func() {
+						Expect(service.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+						Expect(service.Spec.SessionAffinityConfig).ToNot(BeNil())
+						Expect(service.Spec.SessionAffinityConfig.ClientIP).ToNot(BeNil())
+						Expect(service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds).To(HaveValue(Equal(corev1.DefaultClientIPServiceAffinitySeconds)))
+					})
+				})
+
+				When("ClientIP with timeout", func() {
+					BeforeEach(func() {
+						vmService.Spec.SessionAffinity = vmopv1.VirtualMachineServiceSessionAffinityClientIP
+						vmService.Spec.SessionAffinityConfig = &vmopv1.VirtualMachineServiceSessionAffinityConfig{
+							ClientIP: &vmopv1.VirtualMachineServiceClientIPConfig{
+								TimeoutSeconds: ptr.To[int32](60),
+							},
+						}
+					})
+
+					It("Expected values", func() {
+						Expect(service.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+						Expect(service.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds).To(HaveValue(BeEquivalentTo(60)))
+					})
+				})
+			})
+
+			Context("IPFamilies and IPFamilyPolicy", func() {
+				BeforeEach(func() {
+					vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+						vmopv1.VirtualMachineServiceIPFamilyIPv6,
+						vmopv1.VirtualMachineServiceIPFamilyIPv4,
+					}
+					vmService.Spec.IPFamilyPolicy = ptr.To(vmopv1.VirtualMachineServiceIPFamilyPolicyRequireDualStack)
+				})
+
+				It("Expected values", func() {
+					Expect(service.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}))
+					Expect(service.Spec.IPFamilyPolicy).To(HaveValue(Equal(corev1.IPFamilyPolicyRequireDualStack)))
+				})
+			})
+
+			Context("PublishNotReadyAddresses", func() {
+				BeforeEach(func() {
+					vmService.Spec.PublishNotReadyAddresses = true
+				})
+
+				It("Expected value", func() {
+					Expect(service.Spec.PublishNotReadyAddresses).To(BeTrue())
+				})
+			})
 		})
 
 		Context("Service Exists", func() {
@@ -425,13 +501,13 @@ func unitTestsReconcile() {
 			})
 		})
 
-		Context("Creates expected Endpoints", func() {
-			var endpoints *corev1.Endpoints
+		Context("Creates expected EndpointSlices", func() {
+			var endpointSlice *discoveryv1.EndpointSlice
 			var labelSelector, vmLabels map[string]string
 			var vm1, vm2, vm3 *vmopv1.VirtualMachine
 
 			BeforeEach(func() {
-				endpoints = &corev1.Endpoints{}
+				endpointSlice = &discoveryv1.EndpointSlice{}
 				labelSelector = map[string]string{"my-app": "dummy-label"}
 				vmLabels = map[string]string{"my-app": "dummy-label", "other": "label"}
 
@@ -487,11 +563,11 @@ func unitTestsReconcile() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(ctx.Events).Should(Receive(ContainSubstring(virtualmachineservice.OpCreate)))
-				Expect(ctx.Client.Get(ctx, objKey, endpoints)).To(Succeed())
+				Expect(ctx.Client.Get(ctx, ipv4SliceKey(objKey), endpointSlice)).To(Succeed())
 			})
 
 			It("With Expected OwnerReference", func() {
-				ownerRefs := endpoints.GetOwnerReferences()
+				ownerRefs := endpointSlice.GetOwnerReferences()
 				Expect(ownerRefs).To(HaveLen(1))
 				ownerRef := ownerRefs[0]
 				Expect(ownerRef.Name).To(Equal(vmService.Name))
@@ -499,29 +575,57 @@ func unitTestsReconcile() {
 			})
 
 			It("With Expected Annotations and Labels", func() {
-				Expect(endpoints.Annotations).To(HaveKeyWithValue(annotationName1, "bar1"))
-				Expect(endpoints.Labels).To(HaveKeyWithValue(labelName1, "bar2"))
+				Expect(endpointSlice.Annotations).To(HaveKeyWithValue(annotationName1, "bar1"))
+				Expect(endpointSlice.Labels).To(HaveKeyWithValue(labelName1, "bar2"))
+				Expect(endpointSlice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, vmService.Name))
+				Expect(endpointSlice.Labels).To(HaveKey(discoveryv1.LabelManagedBy))
+				Expect(endpointSlice.Labels[discoveryv1.LabelManagedBy]).ToNot(Equal("endpointslice-controller.k8s.io"))
 			})
 
-			It("Empty Subsets when no VM matches", func() {
-				Expect(endpoints.Subsets).To(BeEmpty())
+			It("With Expected AddressType and Ports", func() {
+				Expect(endpointSlice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+				Expect(endpointSlice.Ports).To(HaveLen(1))
+				assertEPPortFromVMServicePort(endpointSlice.Ports[0], vmServicePort1)
 			})
 
-			Context("When one VM matches label selector", func() {
+			It("Empty Endpoints when no VM matches", func() {
+				Expect(endpointSlice.Endpoints).To(BeEmpty())
+			})
+
+			Context("When legacy Endpoints exist", func() {
 				BeforeEach(func() {
-					initObjects = append(initObjects, vm1, vm3)
+					endpoints := &corev1.Endpoints{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      vmService.Name,
+							Namespace: vmService.Namespace,
+							OwnerReferences: []metav1.OwnerReference{
+								{
+									APIVersion: vmopv1.GroupVersion.String(),
+									Kind:       "VirtualMachineService",
+									Name:       vmService.Name,
+									UID:        vmService.UID,
+									Controller: ptr.To(true),
+								},
+							},
+						},
+					}
+					initObjects = append(initObjects, endpoints)
 				})
 
-				It("With Expected Subsets", func() {
-					Expect(endpoints.Subsets).To(HaveLen(1))
-					subset := endpoints.Subsets[0]
+				It("Deletes the legacy Endpoints", func() {
+					err := ctx.Client.Get(ctx, objKey, &corev1.Endpoints{})
+					Expect(errors.IsNotFound(err)).To(BeTrue())
+				})
+			})
 
-					Expect(subset.Ports).To(HaveLen(1))
-					assertEPPortFromVMServicePort(subset.Ports[0], vmServicePort1)
+			Context("When one VM matches label selector", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, vm1, vm3)
+				})
 
-					Expect(subset.Addresses).To(HaveLen(1))
-					assertEPAddrFromVM(subset.Addresses[0], vm1)
-					Expect(subset.NotReadyAddresses).To(BeEmpty())
+				It("With Expected Endpoints", func() {
+					Expect(endpointSlice.Endpoints).To(HaveLen(1))
+					assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
 				})
 
 				Context("When VM does not have IP", func() {
@@ -529,8 +633,51 @@ func unitTestsReconcile() {
 						vm1.Status.Network.PrimaryIP4 = ""
 					})
 
-					It("Not included in Subsets", func() {
-						Expect(endpoints.Subsets).To(BeEmpty())
+					It("Not included in Endpoints", func() {
+						Expect(endpointSlice.Endpoints).To(BeEmpty())
+					})
+				})
+
+				Context("When VM has a zone", func() {
+					BeforeEach(func() {
+						vm1.Status.Zone = "zone-a"
+					})
+
+					It("With Expected topology", func() {
+						Expect(endpointSlice.Endpoints).To(HaveLen(1))
+						ep := endpointSlice.Endpoints[0]
+						Expect(ep.Zone).To(HaveValue(Equal("zone-a")))
+						Expect(ep.Hints).To(BeNil())
+					})
+				})
+
+				Context("When VM has dual-stack addresses", func() {
+					BeforeEach(func() {
+						vm1.Status.Network.PrimaryIP6 = "fd00::1"
+					})
+
+					It("With Expected Endpoints in both EndpointSlices", func() {
+						Expect(endpointSlice.Endpoints).To(HaveLen(1))
+						Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{"1.1.1.1"}))
+
+						ipv6Slice := &discoveryv1.EndpointSlice{}
+						Expect(ctx.Client.Get(ctx, ipv6SliceKey(objKey), ipv6Slice)).To(Succeed())
+						Expect(ipv6Slice.AddressType).To(Equal(discoveryv1.AddressTypeIPv6))
+						Expect(ipv6Slice.Endpoints).To(HaveLen(1))
+						Expect(ipv6Slice.Endpoints[0].Addresses).To(Equal([]string{"fd00::1"}))
+					})
+
+					When("Service is single-stack IPv4", func() {
+						BeforeEach(func() {
+							vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+								vmopv1.VirtualMachineServiceIPFamilyIPv4,
+							}
+						})
+
+						It("Does not have an IPv6 EndpointSlice", func() {
+							err := ctx.Client.Get(ctx, ipv6SliceKey(objKey), &discoveryv1.EndpointSlice{})
+							Expect(errors.IsNotFound(err)).To(BeTrue())
+						})
 					})
 				})
 			})
@@ -540,17 +687,70 @@ func unitTestsReconcile() {
 					initObjects = append(initObjects, vm1, vm2, vm3)
 				})
 
-				It("With Expected Subsets", func() {
-					Expect(endpoints.Subsets).To(HaveLen(1))
-					subset := endpoints.Subsets[0]
+				Context("When topology aware routing is enabled", func() {
+					BeforeEach(func() {
+						vmService.Annotations[corev1.AnnotationTopologyMode] = "Auto"
+						vm1.Status.Zone = "zone-a"
+						vm2.Status.Zone = "zone-b"
+					})
+
+					assertHints := func(expected bool) {
+						GinkgoHelper()
+						Expect(endpointSlice.Endpoints).To(HaveLen(2))
+						for _, ep := range endpointSlice.Endpoints {
+							if expected {
+								Expect(ep.Hints).ToNot(BeNil())
+								Expect(ep.Hints.ForZones).To(ConsistOf(discoveryv1.ForZone{Name: *ep.Zone}))
+							} else {
+								Expect(ep.Hints).To(BeNil())
+							}
+						}
+					}
+
+					It("Hints each endpoint for its own zone", func() {
+						assertHints(true)
+					})
+
+					When("An endpoint does not have a zone", func() {
+						BeforeEach(func() {
+							vm2.Status.Zone = ""
+						})
+
+						It("Does not set hints", func() {
+							assertHints(false)
+						})
+					})
+
+					When("The endpoints are not balanced across the zones", func() {
+						BeforeEach(func() {
+							vm2.Status.Zone = "zone-a"
+							for _, name := range []string{"zone-a", "zone-b"} {
+								az := builder.DummyNamedAvailabilityZone(name)
+								az.Spec.Namespaces[vmService.Namespace] = topologyv1.NamespaceInfo{}
+								initObjects = append(initObjects, az)
+							}
+						})
+
+						It("Does not set hints", func() {
+							assertHints(false)
+						})
+					})
 
-					Expect(subset.Ports).To(HaveLen(1))
-					assertEPPortFromVMServicePort(subset.Ports[0], vmServicePort1)
+					When("The topology mode is not Auto", func() {
+						BeforeEach(func() {
+							vmService.Annotations[corev1.AnnotationTopologyMode] = "Disabled"
+						})
 
-					Expect(subset.Addresses).To(HaveLen(2))
-					assertEPAddrFromVM(subset.Addresses[0], vm1)
-					assertEPAddrFromVM(subset.Addresses[1], vm2)
-					Expect(subset.NotReadyAddresses).To(BeEmpty())
+						It("Does not set hints", func() {
+							assertHints(false)
+						})
+					})
+				})
+
+				It("With Expected Endpoints", func() {
+					Expect(endpointSlice.Endpoints).To(HaveLen(2))
+					assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
+					assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, true)
 				})
 
 				When("Service has multiple ports", func() {
@@ -559,18 +759,29 @@ func unitTestsReconcile() {
 						Expect(vmService.Spec.Ports).To(HaveLen(2))
 					})
 
-					It("Expected subsets should be packed", func() {
-						Expect(endpoints.Subsets).To(HaveLen(1))
-						subset := endpoints.Subsets[0]
+					It("With Expected Ports", func() {
+						Expect(endpointSlice.Ports).To(HaveLen(2))
+						assertEPPortFromVMServicePort(endpointSlice.Ports[0], vmServicePort1)
+						assertEPPortFromVMServicePort(endpointSlice.Ports[1], vmServicePort2)
+
+						Expect(endpointSlice.Endpoints).To(HaveLen(2))
+					})
+				})
+
+				When("VM is marked for deletion", func() {
+					BeforeEach(func() {
+						vm2.DeletionTimestamp = ptr.To(metav1.Now())
+						vm2.Finalizers = []string{"dummy-finalizer"}
+					})
 
-						Expect(subset.Ports).To(HaveLen(2))
-						assertEPPortFromVMServicePort(subset.Ports[0], vmServicePort1)
-						assertEPPortFromVMServicePort(subset.Ports[1], vmServicePort2)
+					It("Endpoint is terminating and not ready", func() {
+						Expect(endpointSlice.Endpoints).To(HaveLen(2))
+						assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
+						assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, false)
 
-						Expect(subset.Addresses).To(HaveLen(2))
-						assertEPAddrFromVM(subset.Addresses[0], vm1)
-						assertEPAddrFromVM(subset.Addresses[1], vm2)
-						Expect(subset.NotReadyAddresses).To(BeEmpty())
+						conds := endpointSlice.Endpoints[1].Conditions
+						Expect(conds.Serving).To(HaveValue(BeTrue()))
+						Expect(conds.Terminating).To(HaveValue(BeTrue()))
 					})
 				})
 			})
@@ -590,17 +801,10 @@ func unitTestsReconcile() {
 					initObjects = append(initObjects, vm1, vm2, vm3)
 				})
 
-				It("VMs without Ready Condition are included in NotReadyAddresses", func() {
-					Expect(endpoints.Subsets).To(HaveLen(1))
-					subset := endpoints.Subsets[0]
-
-					Expect(subset.Ports).To(HaveLen(1))
-					assertEPPortFromVMServicePort(subset.Ports[0], vmServicePort1)
-
-					Expect(subset.Addresses).To(BeEmpty())
-					Expect(subset.NotReadyAddresses).To(HaveLen(2))
-					assertEPAddrFromVM(subset.NotReadyAddresses[0], vm1)
-					assertEPAddrFromVM(subset.NotReadyAddresses[1], vm2)
+				It("VMs without Ready Condition are not ready", func() {
+					Expect(endpointSlice.Endpoints).To(HaveLen(2))
+					assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, false)
+					assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, false)
 				})
 
 				Context("Unready VM with false Ready condition", func() {
@@ -608,17 +812,25 @@ func unitTestsReconcile() {
 						conditions.MarkFalse(vm1, vmopv1.ReadyConditionType, "reason", "")
 					})
 
-					It("With expected Subsets", func() {
-						Expect(endpoints.Subsets).To(HaveLen(1))
-						subset := endpoints.Subsets[0]
+					It("With expected Endpoints", func() {
+						Expect(endpointSlice.Endpoints).To(HaveLen(2))
+						assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, false)
+						assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, false)
+						Expect(endpointSlice.Endpoints[0].Conditions.Serving).To(HaveValue(BeFalse()))
+					})
+				})
 
-						Expect(subset.Ports).To(HaveLen(1))
-						assertEPPortFromVMServicePort(subset.Ports[0], vmServicePort1)
+				Context("PublishNotReadyAddresses is true", func() {
+					BeforeEach(func() {
+						conditions.MarkFalse(vm1, vmopv1.ReadyConditionType, "reason", "")
+						vmService.Spec.PublishNotReadyAddresses = true
+					})
 
-						Expect(subset.Addresses).To(BeEmpty())
-						Expect(subset.NotReadyAddresses).To(HaveLen(2))
-						assertEPAddrFromVM(subset.NotReadyAddresses[0], vm1)
-						assertEPAddrFromVM(subset.NotReadyAddresses[1], vm2)
+					It("With expected Endpoints", func() {
+						Expect(endpointSlice.Endpoints).To(HaveLen(2))
+						assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
+						assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, true)
+						Expect(endpointSlice.Endpoints[0].Conditions.Serving).To(HaveValue(BeFalse()))
 					})
 				})
 
@@ -627,22 +839,15 @@ func unitTestsReconcile() {
 						conditions.MarkTrue(vm1, vmopv1.ReadyConditionType)
 					})
 
-					It("With expected Subsets", func() {
-						Expect(endpoints.Subsets).To(HaveLen(1))
-						subset := endpoints.Subsets[0]
-
-						Expect(subset.Ports).To(HaveLen(1))
-						assertEPPortFromVMServicePort(subset.Ports[0], vmServicePort1)
-
-						Expect(subset.Addresses).To(HaveLen(1))
-						assertEPAddrFromVM(subset.Addresses[0], vm1)
-						Expect(subset.NotReadyAddresses).To(HaveLen(1))
-						assertEPAddrFromVM(subset.NotReadyAddresses[0], vm2)
+					It("With expected Endpoints", func() {
+						Expect(endpointSlice.Endpoints).To(HaveLen(2))
+						assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
+						assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, false)
 					})
 				})
 			})
 
-			Context("Preserve VMs in Endpoints that have Probe but hasn't run yet", func() {
+			Context("Preserve VMs in EndpointSlices that have Probe but hasn't run yet", func() {
 				BeforeEach(func() {
 					vm1.UID = "abc"
 					vm1.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
@@ -657,14 +862,10 @@ func unitTestsReconcile() {
 					initObjects = append(initObjects, vm1, vm2)
 				})
 
-				It("VM is kept in Endpoints", func() {
-					subsets := endpoints.Subsets
-					Expect(subsets).To(HaveLen(1))
-					subset := subsets[0]
-					Expect(subset.Addresses).To(HaveLen(1))
-					assertEPAddrFromVM(subset.Addresses[0], vm1)
-					Expect(subset.NotReadyAddresses).To(HaveLen(1))
-					assertEPAddrFromVM(subset.NotReadyAddresses[0], vm2)
+				It("VM is kept ready in EndpointSlices", func() {
+					Expect(endpointSlice.Endpoints).To(HaveLen(2))
+					assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
+					assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, false)
 
 					// Remove Ready condition but keep the ReadinessProbe. This simulates the probe not
 					// being run yet.
@@ -674,16 +875,42 @@ func unitTestsReconcile() {
 					err := reconciler.ReconcileNormal(vmServiceCtx)
 					Expect(err).NotTo(HaveOccurred())
 
-					Expect(ctx.Client.Get(ctx, objKey, endpoints)).To(Succeed())
+					Expect(ctx.Client.Get(ctx, ipv4SliceKey(objKey), endpointSlice)).To(Succeed())
 
-					// VM1 should still be present in the Endpoints.
-					subsets = endpoints.Subsets
-					Expect(subsets).To(HaveLen(1))
-					subset = subsets[0]
-					Expect(subset.Addresses).To(HaveLen(1))
-					assertEPAddrFromVM(subset.Addresses[0], vm1)
-					Expect(subset.NotReadyAddresses).To(HaveLen(1))
-					assertEPAddrFromVM(subset.NotReadyAddresses[0], vm2)
+					// VM1 should still be ready in the EndpointSlice.
+					Expect(endpointSlice.Endpoints).To(HaveLen(2))
+					assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
+					assertEndpointFromVM(endpointSlice.Endpoints[1], vm2, false)
+				})
+			})
+
+			Context("Preserve VMs in legacy Endpoints that have Probe but hasn't run yet", func() {
+				BeforeEach(func() {
+					vm1.UID = "abc"
+					vm1.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+						TCPSocket: &vmopv1.TCPSocketAction{},
+					}
+					initObjects = append(initObjects, vm1, &corev1.Endpoints{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      vmService.Name,
+							Namespace: vmService.Namespace,
+						},
+						Subsets: []corev1.EndpointSubset{
+							{
+								Addresses: []corev1.EndpointAddress{
+									{
+										IP:        "1.1.1.1",
+										TargetRef: &corev1.ObjectReference{UID: vm1.UID},
+									},
+								},
+							},
+						},
+					})
+				})
+
+				It("VM is kept ready in EndpointSlices", func() {
+					Expect(endpointSlice.Endpoints).To(HaveLen(1))
+					assertEndpointFromVM(endpointSlice.Endpoints[0], vm1, true)
 				})
 			})
 		})
@@ -725,12 +952,12 @@ func unitTestsReconcile() {
 				Expect(ctx.Events).Should(Receive(ContainSubstring(virtualmachineservice.OpCreate)))
 			})
 
-			It("Creates Service but not Endpoints", func() {
+			It("Creates Service but not EndpointSlices", func() {
 				service := &corev1.Service{}
 				Expect(ctx.Client.Get(ctx, objKey, service)).To(Succeed())
 
-				endpoints := &corev1.Endpoints{}
-				err := ctx.Client.Get(ctx, objKey, endpoints)
+				endpointSlice := &discoveryv1.EndpointSlice{}
+				err := ctx.Client.Get(ctx, ipv4SliceKey(objKey), endpointSlice)
 				Expect(err).To(HaveOccurred())
 				Expect(errors.IsNotFound(err)).To(BeTrue())
 			})
@@ -741,14 +968,14 @@ func unitTestsReconcile() {
 				})
 
 				/* This is to match the k8s Service behavior when it changes to selectorless. */
-				It("Does not delete existing Endpoints", func() {
-					endpoints := &corev1.Endpoints{}
-					Expect(ctx.Client.Get(ctx, objKey, endpoints)).To(Succeed())
+				It("Does not delete existing EndpointSlices", func() {
+					endpointSlice := &discoveryv1.EndpointSlice{}
+					Expect(ctx.Client.Get(ctx, ipv4SliceKey(objKey), endpointSlice)).To(Succeed())
 
 					vmServiceCtx.VMService.Spec.Selector = nil
 					Expect(reconciler.ReconcileNormal(vmServiceCtx)).To(Succeed())
-					Expect(ctx.Client.Get(ctx, objKey, endpoints)).To(Succeed())
-					Expect(endpoints.Subsets).ToNot(BeEmpty())
+					Expect(ctx.Client.Get(ctx, ipv4SliceKey(objKey), endpointSlice)).To(Succeed())
+					Expect(endpointSlice.Endpoints).ToNot(BeEmpty())
 				})
 			})
 		})
@@ -765,7 +992,7 @@ func unitTestsReconcile() {
 			Expect(vmServiceCtx.VMService.GetFinalizers()).ToNot(ContainElement(finalizerName))
 		})
 
-		Context("When Endpoint and Service exists", func() {
+		Context("When EndpointSlice, Endpoint and Service exists", func() {
 
 			BeforeEach(func() {
 				objectMeta := metav1.ObjectMeta{
@@ -774,13 +1001,37 @@ func unitTestsReconcile() {
 				}
 				endpoint := &corev1.Endpoints{ObjectMeta: objectMeta}
 				service := &corev1.Service{ObjectMeta: objectMeta}
-				initObjects = append(initObjects, endpoint, service)
+				endpointSlice := &discoveryv1.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      ipv4SliceKey(objKey).Name,
+						Namespace: vmService.Namespace,
+						Labels: map[string]string{
+							discoveryv1.LabelServiceName: vmService.Name,
+							discoveryv1.LabelManagedBy:   "vmoperator.vmware.com/virtualmachineservice-controller",
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: vmopv1.GroupVersion.String(),
+								Kind:       "VirtualMachineService",
+								Name:       vmService.Name,
+								UID:        vmService.UID,
+								Controller: ptr.To(true),
+							},
+						},
+					},
+					AddressType: discoveryv1.AddressTypeIPv4,
+				}
+				initObjects = append(initObjects, endpoint, service, endpointSlice)
 			})
 
-			It("Deletes Endpoint and Service", func() {
+			It("Deletes EndpointSlice, Endpoint and Service", func() {
 				err := reconciler.ReconcileDelete(vmServiceCtx)
 				Expect(err).ToNot(HaveOccurred())
 
+				endpointSlice := &discoveryv1.EndpointSlice{}
+				err = ctx.Client.Get(ctx, ipv4SliceKey(objKey), endpointSlice)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+
 				endpoint := &corev1.Endpoints{}
 				err = ctx.Client.Get(ctx, objKey, endpoint)
 				Expect(errors.IsNotFound(err)).To(BeTrue())
@@ -1000,22 +1251,32 @@ func expectEvent(ctx *builder.UnitTestContextForController, matcher types.Gomega
 	ExpectWithOffset(1, event).To(matcher)
 }
 
+func ipv4SliceKey(objKey client.ObjectKey) client.ObjectKey {
+	return client.ObjectKey{Namespace: objKey.Namespace, Name: objKey.Name + "-ipv4"}
+}
+
+func ipv6SliceKey(objKey client.ObjectKey) client.ObjectKey {
+	return client.ObjectKey{Namespace: objKey.Namespace, Name: objKey.Name + "-ipv6"}
+}
+
 func assertEPPortFromVMServicePort(
-	port corev1.EndpointPort,
+	port discoveryv1.EndpointPort,
 	vmServicePort vmopv1.VirtualMachineServicePort) {
 
-	ExpectWithOffset(1, port.Name).To(Equal(vmServicePort.Name))
-	ExpectWithOffset(1, port.Protocol).To(BeEquivalentTo(vmServicePort.Protocol))
-	ExpectWithOffset(1, port.Port).To(Equal(vmServicePort.TargetPort))
+	ExpectWithOffset(1, port.Name).To(HaveValue(Equal(vmServicePort.Name)))
+	ExpectWithOffset(1, port.Protocol).To(HaveValue(BeEquivalentTo(vmServicePort.Protocol)))
+	ExpectWithOffset(1, port.Port).To(HaveValue(Equal(vmServicePort.TargetPort)))
 }
 
-func assertEPAddrFromVM(
-	addr corev1.EndpointAddress,
-	vm *vmopv1.VirtualMachine) {
+func assertEndpointFromVM(
+	ep discoveryv1.Endpoint,
+	vm *vmopv1.VirtualMachine,
+	ready bool) {
 
 	ExpectWithOffset(1, vm.Status.Network).ToNot(BeNil())
-	ExpectWithOffset(1, addr.IP).To(Equal(vm.Status.Network.PrimaryIP4))
-	ExpectWithOffset(1, addr.TargetRef).ToNot(BeNil())
-	ExpectWithOffset(1, addr.TargetRef.Name).To(Equal(vm.Name))
-	ExpectWithOffset(1, addr.TargetRef.Namespace).To(Equal(vm.Namespace))
+	ExpectWithOffset(1, ep.Addresses).To(Equal([]string{vm.Status.Network.PrimaryIP4}))
+	ExpectWithOffset(1, ep.Conditions.Ready).To(HaveValue(Equal(ready)))
+	ExpectWithOffset(1, ep.TargetRef).ToNot(BeNil())
+	ExpectWithOffset(1, ep.TargetRef.Name).To(Equal(vm.Name))
+	ExpectWithOffset(1, ep.TargetRef.Namespace).To(Equal(vm.Namespace))
 }
@@ -0,0 +1,346 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotexport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineSnapshotExport{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachineSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.SnapshotToExports(ctx))).
+		Watches(&corev1.PersistentVolumeClaim{},
+			handler.EnqueueRequestsFromMapFunc(PVCToExport)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		VMProvider: vmProvider,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineSnapshotExport object.
+type Reconciler struct {
+	client.Client
+	Context    context.Context
+	Logger     logr.Logger
+	Recorder   record.Recorder
+	VMProvider providers.VirtualMachineProviderInterface
+}
+
+// SnapshotToExports returns a mapper function that enqueues the exports of a
+// VirtualMachineSnapshot.
+func (r *Reconciler) SnapshotToExports(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		var exportList vmopv1.VirtualMachineSnapshotExportList
+		if err := r.List(ctx, &exportList, client.InNamespace(o.GetNamespace())); err != nil {
+			ctx.Logger.Error(err, "Failed to list VirtualMachineSnapshotExports")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, e := range exportList.Items {
+			if e.Spec.SnapshotName == o.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKey{Namespace: e.Namespace, Name: e.Name},
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+// PVCToExport enqueues the export whose archive contains a
+// PersistentVolumeClaim.
+func PVCToExport(_ context.Context, o client.Object) []reconcile.Request {
+	name := o.GetLabels()[vmopv1.SnapshotExportNameLabel]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Namespace: o.GetNamespace(), Name: name}},
+	}
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotexports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotexports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshots,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=imageregistry.vmware.com,resources=contentlibraries,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=cns.vmware.com,resources=cnsregistervolumes,verbs=get;list;watch;create;delete
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	snapshotExport := &vmopv1.VirtualMachineSnapshotExport{}
+	if err := r.Get(ctx, req.NamespacedName, snapshotExport); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The archive of an export is intentionally kept after the export is
+	// deleted.
+	if !snapshotExport.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	snapshotExportCtx := &pkgctx.VirtualMachineSnapshotExportContext{
+		Context:        ctx,
+		Logger:         pkglog.FromContextOrDefault(ctx),
+		SnapshotExport: snapshotExport,
+	}
+
+	patchHelper, err := patch.NewHelper(snapshotExport, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", snapshotExportCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, snapshotExport); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			snapshotExportCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	return pkgerr.ResultFromError(r.ReconcileNormal(snapshotExportCtx))
+}
+
+// ReconcileNormal exports the snapshot to the export's target once the
+// snapshot is ready, and marks the export as ready once the target is
+// available.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineSnapshotExportContext) error {
+	snapshotExport := ctx.SnapshotExport
+
+	if conditions.IsTrue(snapshotExport, vmopv1.ReadyConditionType) {
+		return nil
+	}
+
+	ctx.Logger.Info("Reconciling VirtualMachineSnapshotExport")
+
+	if !isExported(snapshotExport) {
+		if err := r.reconcileExport(ctx); err != nil {
+			return err
+		}
+		if !isExported(snapshotExport) {
+			return nil
+		}
+	}
+
+	if len(snapshotExport.Status.Disks) > 0 {
+		bound, err := r.reconcileClaims(ctx)
+		if err != nil || !bound {
+			return err
+		}
+	}
+
+	snapshotExport.Status.CompletionTime = ptr.To(metav1.Now())
+	conditions.MarkTrue(snapshotExport, vmopv1.ReadyConditionType)
+
+	return nil
+}
+
+// reconcileExport writes the data of the snapshot to the export's target.
+func (r *Reconciler) reconcileExport(ctx *pkgctx.VirtualMachineSnapshotExportContext) error {
+	snapshotExport := ctx.SnapshotExport
+
+	snapshot := &vmopv1.VirtualMachineSnapshot{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: snapshotExport.Namespace,
+		Name:      snapshotExport.Spec.SnapshotName,
+	}, snapshot); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(
+				snapshotExport,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineSnapshotExportSnapshotNotFoundReason,
+				"%s",
+				err)
+			return nil
+		}
+		return fmt.Errorf("failed to get VirtualMachineSnapshot %q: %w",
+			snapshotExport.Spec.SnapshotName, err)
+	}
+
+	if !conditions.IsTrue(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition) {
+		conditions.MarkFalse(
+			snapshotExport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotExportSnapshotNotReadyReason,
+			"VirtualMachineSnapshot %q is not ready",
+			snapshot.Name)
+		return nil
+	}
+
+	vmName := snapshot.Spec.VMName
+	if vmName == "" {
+		vmName = snapshot.Labels[vmopv1.VMNameForSnapshotLabel]
+	}
+	if vmName == "" {
+		return errors.New("VirtualMachineSnapshot does not refer to a VM")
+	}
+
+	vm := &vmopv1.VirtualMachine{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: snapshotExport.Namespace,
+		Name:      vmName,
+	}, vm); err != nil {
+		return fmt.Errorf("failed to get VirtualMachine %q: %w", vmName, err)
+	}
+
+	snapshotExport.Status.VMName = vm.Name
+
+	if err := r.VMProvider.ExportVirtualMachineSnapshot(ctx, snapshotExport, snapshot, vm); err != nil {
+		if pkgerr.IsRequeueError(err) {
+			conditions.MarkFalse(
+				snapshotExport,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineSnapshotExportInProgressReason,
+				"copying the disks of VirtualMachineSnapshot %q",
+				snapshot.Name)
+			return err
+		}
+		conditions.MarkFalse(
+			snapshotExport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotExportFailedReason,
+			"%s",
+			err)
+		return fmt.Errorf("failed to export VirtualMachineSnapshot %q: %w", snapshot.Name, err)
+	}
+
+	ctx.Logger.Info("Exported VirtualMachineSnapshot",
+		"snapshotName", snapshot.Name,
+		"disks", len(snapshotExport.Status.Disks),
+		"itemID", snapshotExport.Status.ItemID)
+
+	return nil
+}
+
+// isExported returns true once the snapshot has been written to the export's
+// target, either as a library item or as completely copied disks.
+func isExported(snapshotExport *vmopv1.VirtualMachineSnapshotExport) bool {
+	if snapshotExport.Status.ItemID != "" {
+		return true
+	}
+	if len(snapshotExport.Status.Disks) == 0 {
+		return false
+	}
+	for _, d := range snapshotExport.Status.Disks {
+		if !d.Copied {
+			return false
+		}
+	}
+	return true
+}
+
+// reconcileClaims returns true once all of the PVCs of the archive are bound,
+// and then deletes the CnsRegisterVolumes that registered them.
+func (r *Reconciler) reconcileClaims(ctx *pkgctx.VirtualMachineSnapshotExportContext) (bool, error) {
+	snapshotExport := ctx.SnapshotExport
+
+	for _, d := range snapshotExport.Status.Disks {
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := r.Get(ctx, client.ObjectKey{
+			Namespace: snapshotExport.Namespace,
+			Name:      d.ClaimName,
+		}, pvc); err != nil {
+			return false, fmt.Errorf("failed to get PersistentVolumeClaim %q: %w", d.ClaimName, err)
+		}
+
+		if pvc.Status.Phase != corev1.ClaimBound {
+			conditions.MarkFalse(
+				snapshotExport,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineSnapshotExportInProgressReason,
+				"PersistentVolumeClaim %q is not bound",
+				pvc.Name)
+			return false, nil
+		}
+	}
+
+	var crvList cnsv1alpha1.CnsRegisterVolumeList
+	if err := r.List(
+		ctx,
+		&crvList,
+		client.InNamespace(snapshotExport.Namespace),
+		client.MatchingLabels{vmopv1.SnapshotExportNameLabel: snapshotExport.Name}); err != nil {
+
+		return false, fmt.Errorf("failed to list CnsRegisterVolumes: %w", err)
+	}
+
+	for i := range crvList.Items {
+		if err := r.Delete(ctx, &crvList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete CnsRegisterVolume %q: %w", crvList.Items[i].Name, err)
+		}
+	}
+
+	return true, nil
+}
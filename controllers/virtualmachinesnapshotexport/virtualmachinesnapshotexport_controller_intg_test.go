@@ -0,0 +1,172 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotexport_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	const (
+		vmName       = "dummy-vm"
+		snapshotName = "dummy-snapshot"
+		exportName   = "dummy-snapshot-export"
+		claimName    = exportName + "-0"
+	)
+
+	var (
+		ctx            *builder.IntegrationTestContext
+		snapshotExport *vmopv1.VirtualMachineSnapshotExport
+	)
+
+	getReadyCondition := func(g Gomega) *metav1.Condition {
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(snapshotExport), snapshotExport)).To(Succeed())
+		c := conditions.Get(snapshotExport, vmopv1.ReadyConditionType)
+		g.Expect(c).ToNot(BeNil())
+		return c
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		snapshotExport = builder.DummyVirtualMachineSnapshotExport(ctx.Namespace, exportName, snapshotName)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		intgFakeVMProvider.Reset()
+	})
+
+	When("the snapshot does not exist", func() {
+		It("should report that the snapshot does not exist", func() {
+			Expect(ctx.Client.Create(ctx, snapshotExport)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Reason).To(Equal(vmopv1.VirtualMachineSnapshotExportSnapshotNotFoundReason))
+			}).Should(Succeed())
+		})
+	})
+
+	When("the snapshot is ready", func() {
+		var copied bool
+
+		BeforeEach(func() {
+			copied = false
+
+			vm := builder.DummyBasicVirtualMachine(vmName, ctx.Namespace)
+			Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+
+			snapshot := builder.DummyVirtualMachineSnapshot(ctx.Namespace, snapshotName, vmName)
+			snapshot.Finalizers = nil
+			Expect(ctx.Client.Create(ctx, snapshot)).To(Succeed())
+			conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition)
+			Expect(ctx.Client.Status().Update(ctx, snapshot)).To(Succeed())
+
+			intgFakeVMProvider.Lock()
+			defer intgFakeVMProvider.Unlock()
+			intgFakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				_ context.Context,
+				vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+				_ *vmopv1.VirtualMachineSnapshot,
+				_ *vmopv1.VirtualMachine) error {
+
+				vmSnapshotExport.Status.VMResourceYAML = "vm-yaml"
+				vmSnapshotExport.Status.Disks = []vmopv1.VirtualMachineSnapshotExportDiskStatus{
+					{
+						FileName:   "[ds] exports/disk-0.vmdk",
+						ClaimName:  claimName,
+						CopyTaskID: "task-1",
+					},
+				}
+
+				if !copied {
+					return pkgerr.RequeueError{After: 100 * time.Millisecond, Message: "copying"}
+				}
+
+				pvc := &corev1.PersistentVolumeClaim{}
+				pvc.Namespace = vmSnapshotExport.Namespace
+				pvc.Name = claimName
+				pvc.Labels = map[string]string{vmopv1.SnapshotExportNameLabel: exportName}
+				pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+				pvc.Spec.Resources.Requests = corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("1Gi"),
+				}
+				if err := ctx.Client.Create(ctx, pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+					return err
+				}
+
+				vmSnapshotExport.Status.Disks[0].CopyTaskID = ""
+				vmSnapshotExport.Status.Disks[0].Copied = true
+				return nil
+			}
+		})
+
+		It("should mark the export as ready once the disks are copied and the claims are bound", func() {
+			Expect(ctx.Client.Create(ctx, snapshotExport)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Reason).To(Equal(vmopv1.VirtualMachineSnapshotExportInProgressReason))
+				g.Expect(snapshotExport.Status.VMName).To(Equal(vmName))
+				g.Expect(snapshotExport.Status.Disks).To(HaveLen(1))
+				g.Expect(snapshotExport.Status.Disks[0].CopyTaskID).To(Equal("task-1"))
+			}).Should(Succeed())
+
+			By("the disks are copied", func() {
+				intgFakeVMProvider.Lock()
+				copied = true
+				intgFakeVMProvider.Unlock()
+			})
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Eventually(func(g Gomega) {
+				g.Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: ctx.Namespace, Name: claimName}, pvc)).To(Succeed())
+			}).Should(Succeed())
+
+			Consistently(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Status).ToNot(Equal(metav1.ConditionTrue))
+			}, time.Second).Should(Succeed())
+
+			By("the claim is bound", func() {
+				pvc.Status.Phase = corev1.ClaimBound
+				Expect(ctx.Client.Status().Update(ctx, pvc)).To(Succeed())
+			})
+
+			Eventually(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(snapshotExport.Status.Disks[0].Copied).To(BeTrue())
+				g.Expect(snapshotExport.Status.CompletionTime).ToNot(BeNil())
+			}).Should(Succeed())
+		})
+	})
+}
@@ -0,0 +1,306 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotexport_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshotexport"
+	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace    = "test-namespace"
+		vmName       = "test-vm"
+		snapshotName = "test-snapshot"
+		exportName   = "test-export"
+		claimName    = exportName + "-0"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler     *virtualmachinesnapshotexport.Reconciler
+		fakeVMProvider *providerfake.VMProvider
+		snapshot       *vmopv1.VirtualMachineSnapshot
+		snapshotExport *vmopv1.VirtualMachineSnapshotExport
+		err            error
+	)
+
+	reconcileNormal := func() error {
+		return reconciler.ReconcileNormal(&pkgctx.VirtualMachineSnapshotExportContext{
+			Context:        ctx,
+			Logger:         ctx.Logger,
+			SnapshotExport: snapshotExport,
+		})
+	}
+
+	readyReason := func() string {
+		c := conditions.Get(snapshotExport, vmopv1.ReadyConditionType)
+		Expect(c).ToNot(BeNil())
+		return c.Reason
+	}
+
+	BeforeEach(func() {
+		snapshot = builder.DummyVirtualMachineSnapshot(namespace, snapshotName, vmName)
+		conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition)
+
+		snapshotExport = builder.DummyVirtualMachineSnapshotExport(namespace, exportName, snapshotName)
+
+		initObjects = []client.Object{
+			builder.DummyBasicVirtualMachine(vmName, namespace),
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinesnapshotexport.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+			ctx.VMProvider,
+		)
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.Reset()
+
+		if snapshot != nil {
+			Expect(ctx.Client.Create(ctx, snapshot)).To(Succeed())
+			Expect(ctx.Client.Status().Update(ctx, snapshot)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+		snapshot = nil
+	})
+
+	When("the snapshot does not exist", func() {
+		BeforeEach(func() {
+			snapshot = nil
+		})
+
+		It("marks the export as not ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotExportSnapshotNotFoundReason))
+		})
+	})
+
+	When("the snapshot is not ready", func() {
+		BeforeEach(func() {
+			conditions.MarkFalse(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition, "NotReady", "")
+		})
+
+		It("marks the export as not ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotExportSnapshotNotReadyReason))
+		})
+	})
+
+	When("the export fails", func() {
+		JustBeforeEach(func() {
+			fakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				_ context.Context,
+				_ *vmopv1.VirtualMachineSnapshotExport,
+				_ *vmopv1.VirtualMachineSnapshot,
+				_ *vmopv1.VirtualMachine) error {
+
+				return errors.New("fubar")
+			}
+		})
+
+		It("returns an error", func() {
+			err = reconcileNormal()
+			Expect(err).To(MatchError(ContainSubstring("fubar")))
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotExportFailedReason))
+			Expect(snapshotExport.Status.VMName).To(Equal(vmName))
+		})
+	})
+
+	When("the target is a PersistentVolumeClaim", func() {
+		JustBeforeEach(func() {
+			fakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				ctx context.Context,
+				vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+				vmSnapshot *vmopv1.VirtualMachineSnapshot,
+				vm *vmopv1.VirtualMachine) error {
+
+				Expect(vmSnapshot.Name).To(Equal(snapshotName))
+				Expect(vm.Name).To(Equal(vmName))
+
+				labels := map[string]string{vmopv1.SnapshotExportNameLabel: exportName}
+
+				pvc := &corev1.PersistentVolumeClaim{}
+				pvc.Namespace = namespace
+				pvc.Name = claimName
+				pvc.Labels = labels
+				Expect(reconciler.Create(ctx, pvc)).To(Succeed())
+
+				crv := &cnsv1alpha1.CnsRegisterVolume{}
+				crv.Namespace = namespace
+				crv.Name = claimName
+				crv.Labels = labels
+				Expect(reconciler.Create(ctx, crv)).To(Succeed())
+
+				vmSnapshotExport.Status.VMResourceYAML = "vm-yaml"
+				vmSnapshotExport.Status.Disks = []vmopv1.VirtualMachineSnapshotExportDiskStatus{
+					{
+						FileName:  "[ds] exports/disk-0.vmdk",
+						ClaimName: claimName,
+						Copied:    true,
+					},
+				}
+				return nil
+			}
+		})
+
+		It("waits for the claims to be bound before marking the export as ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotExportInProgressReason))
+			Expect(snapshotExport.Status.Disks).To(HaveLen(1))
+			Expect(snapshotExport.Status.CompletionTime).To(BeNil())
+
+			By("the claim is bound", func() {
+				pvc := &corev1.PersistentVolumeClaim{}
+				Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: claimName}, pvc)).To(Succeed())
+				pvc.Status.Phase = corev1.ClaimBound
+				Expect(ctx.Client.Status().Update(ctx, pvc)).To(Succeed())
+			})
+
+			fakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				_ context.Context,
+				_ *vmopv1.VirtualMachineSnapshotExport,
+				_ *vmopv1.VirtualMachineSnapshot,
+				_ *vmopv1.VirtualMachine) error {
+
+				return errors.New("export should not be repeated")
+			}
+
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(conditions.IsTrue(snapshotExport, vmopv1.ReadyConditionType)).To(BeTrue())
+			Expect(snapshotExport.Status.CompletionTime).ToNot(BeNil())
+
+			crv := &cnsv1alpha1.CnsRegisterVolume{}
+			err = ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: claimName}, crv)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	When("the disks are being copied", func() {
+		JustBeforeEach(func() {
+			fakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				_ context.Context,
+				vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+				_ *vmopv1.VirtualMachineSnapshot,
+				_ *vmopv1.VirtualMachine) error {
+
+				vmSnapshotExport.Status.Disks = []vmopv1.VirtualMachineSnapshotExportDiskStatus{
+					{
+						FileName:   "[ds] exports/disk-0.vmdk",
+						ClaimName:  claimName,
+						CopyTaskID: "task-1",
+					},
+				}
+				return pkgerr.RequeueError{After: time.Minute, Message: "copying"}
+			}
+		})
+
+		It("marks the export as in progress and requeues until the disks are copied", func() {
+			err = reconcileNormal()
+			Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotExportInProgressReason))
+			Expect(snapshotExport.Status.Disks).To(HaveLen(1))
+			Expect(snapshotExport.Status.Disks[0].CopyTaskID).To(Equal("task-1"))
+			Expect(snapshotExport.Status.CompletionTime).To(BeNil())
+
+			fakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				_ context.Context,
+				vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+				_ *vmopv1.VirtualMachineSnapshot,
+				_ *vmopv1.VirtualMachine) error {
+
+				Expect(vmSnapshotExport.Status.Disks[0].CopyTaskID).To(Equal("task-1"))
+				return errors.New("fubar")
+			}
+
+			Expect(reconcileNormal()).To(MatchError(ContainSubstring("fubar")))
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotExportFailedReason))
+		})
+	})
+
+	When("the target is an OVA", func() {
+		BeforeEach(func() {
+			snapshotExport.Spec.Target = vmopv1.VirtualMachineSnapshotExportTarget{
+				OVA: &vmopv1.VirtualMachineSnapshotExportOVATarget{
+					ContentLibraryName: "my-library",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			fakeVMProvider.ExportVirtualMachineSnapshotFn = func(
+				_ context.Context,
+				vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+				_ *vmopv1.VirtualMachineSnapshot,
+				_ *vmopv1.VirtualMachine) error {
+
+				vmSnapshotExport.Status.ItemID = "item-id"
+				return nil
+			}
+		})
+
+		It("marks the export as ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(conditions.IsTrue(snapshotExport, vmopv1.ReadyConditionType)).To(BeTrue())
+			Expect(snapshotExport.Status.ItemID).To(Equal("item-id"))
+			Expect(snapshotExport.Status.CompletionTime).ToNot(BeNil())
+		})
+	})
+
+	When("the export is ready", func() {
+		BeforeEach(func() {
+			conditions.MarkTrue(snapshotExport, vmopv1.ReadyConditionType)
+			snapshotExport.Status.CompletionTime = &metav1.Time{}
+		})
+
+		It("does nothing", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(snapshotExport.Status.VMName).To(BeEmpty())
+		})
+	})
+}
@@ -0,0 +1,528 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotimport
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/api/v1alpha1"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+// imageNotFoundRequeueDelay is how long to wait before checking again for the
+// VirtualMachineImage of an exported OVA.
+const imageNotFoundRequeueDelay = time.Minute
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineSnapshotImport{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachineSnapshotExport{},
+			handler.EnqueueRequestsFromMapFunc(r.ExportToImports(ctx))).
+		Watches(&corev1.PersistentVolumeClaim{},
+			handler.EnqueueRequestsFromMapFunc(PVCToImport)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		VMProvider: vmProvider,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineSnapshotImport object.
+type Reconciler struct {
+	client.Client
+	Context    context.Context
+	Logger     logr.Logger
+	Recorder   record.Recorder
+	VMProvider providers.VirtualMachineProviderInterface
+}
+
+// ExportToImports returns a mapper function that enqueues the imports of a
+// VirtualMachineSnapshotExport. The imports may be in any namespace.
+func (r *Reconciler) ExportToImports(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		var importList vmopv1.VirtualMachineSnapshotImportList
+		if err := r.List(ctx, &importList); err != nil {
+			ctx.Logger.Error(err, "Failed to list VirtualMachineSnapshotImports")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, i := range importList.Items {
+			if i.Spec.Source.Namespace == o.GetNamespace() && i.Spec.Source.Name == o.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKey{Namespace: i.Namespace, Name: i.Name},
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+// PVCToImport enqueues the import that created a PersistentVolumeClaim.
+func PVCToImport(_ context.Context, o client.Object) []reconcile.Request {
+	name := o.GetLabels()[vmopv1.SnapshotImportNameLabel]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Namespace: o.GetNamespace(), Name: name}},
+	}
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotimports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotimports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotexports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=cns.vmware.com,resources=cnsregistervolumes,verbs=get;list;watch;create;delete
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	snapshotImport := &vmopv1.VirtualMachineSnapshotImport{}
+	if err := r.Get(ctx, req.NamespacedName, snapshotImport); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The imported VirtualMachine and PVCs are intentionally kept after the
+	// import is deleted.
+	if !snapshotImport.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	snapshotImportCtx := &pkgctx.VirtualMachineSnapshotImportContext{
+		Context:        ctx,
+		Logger:         pkglog.FromContextOrDefault(ctx),
+		SnapshotImport: snapshotImport,
+	}
+
+	patchHelper, err := patch.NewHelper(snapshotImport, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", snapshotImportCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, snapshotImport); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			snapshotImportCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	return pkgerr.ResultFromError(r.ReconcileNormal(snapshotImportCtx))
+}
+
+// ReconcileNormal creates a VirtualMachine in the import's namespace from the
+// VM YAML of the export, once the export is ready and, for an archive of
+// PVCs, once the disks of the VM's PVC volumes have been imported.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineSnapshotImportContext) error {
+	snapshotImport := ctx.SnapshotImport
+
+	if conditions.IsTrue(snapshotImport, vmopv1.ReadyConditionType) {
+		return nil
+	}
+
+	ctx.Logger.Info("Reconciling VirtualMachineSnapshotImport")
+
+	snapshotExport := &vmopv1.VirtualMachineSnapshotExport{}
+	if err := r.Get(ctx, client.ObjectKey{
+		Namespace: snapshotImport.Spec.Source.Namespace,
+		Name:      snapshotImport.Spec.Source.Name,
+	}, snapshotExport); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(
+				snapshotImport,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineSnapshotImportExportNotFoundReason,
+				"%s",
+				err)
+			return nil
+		}
+		return fmt.Errorf("failed to get VirtualMachineSnapshotExport: %w", err)
+	}
+
+	if snapshotExport.Namespace != snapshotImport.Namespace &&
+		!slices.Contains(snapshotExport.Spec.ImportNamespaces, snapshotImport.Namespace) {
+
+		conditions.MarkFalse(
+			snapshotImport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotImportNotAllowedReason,
+			"VirtualMachineSnapshotExport %s/%s may not be imported into namespace %s",
+			snapshotExport.Namespace, snapshotExport.Name, snapshotImport.Namespace)
+		return nil
+	}
+
+	if !conditions.IsTrue(snapshotExport, vmopv1.ReadyConditionType) {
+		conditions.MarkFalse(
+			snapshotImport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotImportExportNotReadyReason,
+			"VirtualMachineSnapshotExport %s/%s is not ready",
+			snapshotExport.Namespace, snapshotExport.Name)
+		return nil
+	}
+
+	vm, err := r.newVirtualMachine(snapshotImport, snapshotExport)
+	if err != nil {
+		conditions.MarkFalse(
+			snapshotImport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotImportFailedReason,
+			"%s",
+			err)
+		return err
+	}
+
+	if snapshotExport.Spec.Target.OVA != nil {
+		if ok, err := r.reconcileImage(ctx, snapshotExport, vm); err != nil || !ok {
+			return err
+		}
+	} else {
+		if ok, err := r.reconcileVolumes(ctx, snapshotExport, vm); err != nil || !ok {
+			return err
+		}
+	}
+
+	if err := r.Create(ctx, vm); err != nil && !apierrors.IsAlreadyExists(err) {
+		conditions.MarkFalse(
+			snapshotImport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotImportFailedReason,
+			"%s",
+			err)
+		return fmt.Errorf("failed to create VirtualMachine %q: %w", vm.Name, err)
+	}
+
+	ctx.Logger.Info("Imported VirtualMachine", "vmName", vm.Name)
+
+	snapshotImport.Status.VMName = vm.Name
+	snapshotImport.Status.CompletionTime = ptr.To(metav1.Now())
+	conditions.MarkTrue(snapshotImport, vmopv1.ReadyConditionType)
+
+	return nil
+}
+
+// newVirtualMachine returns the VirtualMachine to create for the import from
+// the VM YAML of the export.
+func (r *Reconciler) newVirtualMachine(
+	snapshotImport *vmopv1.VirtualMachineSnapshotImport,
+	snapshotExport *vmopv1.VirtualMachineSnapshotExport) (*vmopv1.VirtualMachine, error) {
+
+	vmYAML, err := pkgutil.TryToDecodeBase64Gzip([]byte(snapshotExport.Status.VMResourceYAML))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode VM YAML from export: %w", err)
+	}
+
+	decUnstructured := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+
+	unstructuredObj := &unstructured.Unstructured{}
+	if _, _, err := decUnstructured.Decode([]byte(vmYAML), nil, unstructuredObj); err != nil {
+		return nil, fmt.Errorf("failed to decode VM YAML in to Unstructured object: %w", err)
+	}
+
+	// Convert it to newest version of VirtualMachine.
+	srcVM := &vmopv1.VirtualMachine{}
+	if err := r.Scheme().Convert(unstructuredObj, srcVM, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert VM YAML to VirtualMachine: %w", err)
+	}
+
+	vmName := snapshotImport.Spec.VMName
+	if vmName == "" {
+		vmName = snapshotImport.Name
+	}
+
+	vm := &vmopv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   snapshotImport.Namespace,
+			Name:        vmName,
+			Labels:      filterMetadata(srcVM.Labels),
+			Annotations: filterMetadata(srcVM.Annotations),
+		},
+		Spec: *srcVM.Spec.DeepCopy(),
+	}
+
+	if vm.Labels == nil {
+		vm.Labels = map[string]string{}
+	}
+	vm.Labels[vmopv1.SnapshotImportNameLabel] = snapshotImport.Name
+
+	// The identifiers of the source VM must not be reused by the new VM.
+	vm.Spec.InstanceUUID = ""
+	vm.Spec.BiosUUID = ""
+	vm.Spec.CurrentSnapshotName = ""
+
+	if snapshotImport.Spec.ClassName != "" {
+		vm.Spec.ClassName = snapshotImport.Spec.ClassName
+	}
+	if snapshotImport.Spec.StorageClass != "" {
+		vm.Spec.StorageClass = snapshotImport.Spec.StorageClass
+	}
+
+	return vm, nil
+}
+
+// reconcileImage sets the VM's image to the VirtualMachineImage, in the
+// import's namespace, for the OVA of the export. The data of the VM's PVC
+// volumes is included in the OVA's disks, so the volumes are removed from the
+// VM.
+func (r *Reconciler) reconcileImage(
+	ctx *pkgctx.VirtualMachineSnapshotImportContext,
+	snapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	vm *vmopv1.VirtualMachine) (bool, error) {
+
+	vmiList := &vmopv1.VirtualMachineImageList{}
+	if err := r.List(ctx, vmiList, client.InNamespace(vm.Namespace)); err != nil {
+		return false, fmt.Errorf("failed to list VirtualMachineImages: %w", err)
+	}
+
+	idx := slices.IndexFunc(vmiList.Items, func(vmi vmopv1.VirtualMachineImage) bool {
+		return vmi.Status.ProviderItemID == snapshotExport.Status.ItemID
+	})
+	if idx < 0 {
+		conditions.MarkFalse(
+			ctx.SnapshotImport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotImportImageNotFoundReason,
+			"VirtualMachineImage for library item %q not found",
+			snapshotExport.Status.ItemID)
+		return false, pkgerr.RequeueError{After: imageNotFoundRequeueDelay}
+	}
+
+	vm.Spec.Image = &vmopv1.VirtualMachineImageRef{
+		Kind: "VirtualMachineImage",
+		Name: vmiList.Items[idx].Name,
+	}
+	vm.Spec.ImageName = vmiList.Items[idx].Name
+
+	vm.Spec.Volumes = slices.DeleteFunc(vm.Spec.Volumes, func(v vmopv1.VirtualMachineVolume) bool {
+		return v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.InstanceVolumeClaim == nil
+	})
+
+	return true, nil
+}
+
+// reconcileVolumes imports the disks of the VM's PVC volumes from the archive
+// of the export as new PVCs in the import's namespace, and updates the VM's
+// volumes to refer to the new PVCs. It returns true once all of the new PVCs
+// are bound.
+func (r *Reconciler) reconcileVolumes(
+	ctx *pkgctx.VirtualMachineSnapshotImportContext,
+	snapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	vm *vmopv1.VirtualMachine) (bool, error) {
+
+	snapshotImport := ctx.SnapshotImport
+	bound := true
+
+	for i := range vm.Spec.Volumes {
+		claim := vm.Spec.Volumes[i].PersistentVolumeClaim
+		if claim == nil || claim.InstanceVolumeClaim != nil {
+			continue
+		}
+
+		volName := vm.Spec.Volumes[i].Name
+
+		diskIdx := slices.IndexFunc(snapshotExport.Status.Disks, func(d vmopv1.VirtualMachineSnapshotExportDiskStatus) bool {
+			return d.SourceClaimName == claim.ClaimName
+		})
+		if diskIdx < 0 {
+			err := fmt.Errorf("export does not contain the disk of volume %q", volName)
+			conditions.MarkFalse(
+				snapshotImport,
+				vmopv1.ReadyConditionType,
+				vmopv1.VirtualMachineSnapshotImportFailedReason,
+				"%s",
+				err)
+			return false, err
+		}
+		disk := snapshotExport.Status.Disks[diskIdx]
+
+		volStatus := vmopv1.VirtualMachineSnapshotImportVolumeStatus{
+			Name:      volName,
+			ClaimName: vm.Name + "-" + volName,
+		}
+		if idx := slices.IndexFunc(snapshotImport.Status.Volumes, func(v vmopv1.VirtualMachineSnapshotImportVolumeStatus) bool {
+			return v.Name == volName
+		}); idx >= 0 {
+			volStatus = snapshotImport.Status.Volumes[idx]
+		}
+
+		if volStatus.FileName == "" {
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: snapshotImport.Namespace,
+					Name:      volStatus.ClaimName,
+					Labels: map[string]string{
+						vmopv1.SnapshotImportNameLabel: snapshotImport.Name,
+					},
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: ptr.To(vm.Spec.StorageClass),
+					AccessModes: []corev1.PersistentVolumeAccessMode{
+						corev1.ReadWriteOnce,
+					},
+					VolumeMode: ptr.To(corev1.PersistentVolumeFilesystem),
+				},
+			}
+			if disk.Capacity != nil {
+				pvc.Spec.Resources.Requests = corev1.ResourceList{
+					corev1.ResourceStorage: *disk.Capacity,
+				}
+			}
+
+			err := r.VMProvider.ImportVirtualMachineSnapshotVolume(
+				ctx, snapshotImport, &volStatus, disk.FileName, pvc)
+			setVolumeStatus(snapshotImport, volStatus)
+			if err != nil {
+				if pkgerr.IsRequeueError(err) {
+					conditions.MarkFalse(
+						snapshotImport,
+						vmopv1.ReadyConditionType,
+						vmopv1.VirtualMachineSnapshotImportInProgressReason,
+						"copying the disk of volume %q",
+						volName)
+					return false, err
+				}
+				conditions.MarkFalse(
+					snapshotImport,
+					vmopv1.ReadyConditionType,
+					vmopv1.VirtualMachineSnapshotImportFailedReason,
+					"%s",
+					err)
+				return false, fmt.Errorf("failed to import disk of volume %q: %w", volName, err)
+			}
+		}
+
+		claim.ClaimName = volStatus.ClaimName
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := r.Get(ctx, client.ObjectKey{
+			Namespace: snapshotImport.Namespace,
+			Name:      volStatus.ClaimName,
+		}, pvc); err != nil {
+			return false, fmt.Errorf("failed to get PersistentVolumeClaim %q: %w", volStatus.ClaimName, err)
+		}
+		if pvc.Status.Phase != corev1.ClaimBound {
+			bound = false
+		}
+	}
+
+	if !bound {
+		conditions.MarkFalse(
+			snapshotImport,
+			vmopv1.ReadyConditionType,
+			vmopv1.VirtualMachineSnapshotImportInProgressReason,
+			"waiting for the imported PersistentVolumeClaims to be bound")
+		return false, nil
+	}
+
+	var crvList cnsv1alpha1.CnsRegisterVolumeList
+	if err := r.List(
+		ctx,
+		&crvList,
+		client.InNamespace(snapshotImport.Namespace),
+		client.MatchingLabels{vmopv1.SnapshotImportNameLabel: snapshotImport.Name}); err != nil {
+
+		return false, fmt.Errorf("failed to list CnsRegisterVolumes: %w", err)
+	}
+
+	for i := range crvList.Items {
+		if err := r.Delete(ctx, &crvList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete CnsRegisterVolume %q: %w", crvList.Items[i].Name, err)
+		}
+	}
+
+	return true, nil
+}
+
+func setVolumeStatus(
+	snapshotImport *vmopv1.VirtualMachineSnapshotImport,
+	volStatus vmopv1.VirtualMachineSnapshotImportVolumeStatus) {
+
+	for i := range snapshotImport.Status.Volumes {
+		if snapshotImport.Status.Volumes[i].Name == volStatus.Name {
+			snapshotImport.Status.Volumes[i] = volStatus
+			return
+		}
+	}
+	snapshotImport.Status.Volumes = append(snapshotImport.Status.Volumes, volStatus)
+}
+
+// filterMetadata returns a copy of the labels or annotations of the source VM
+// without the ones in the VM Operator domain, since those describe the state
+// of the source VM.
+func filterMetadata(in map[string]string) map[string]string {
+	out := maps.Clone(in)
+	maps.DeleteFunc(out, func(k, _ string) bool {
+		return strings.Contains(k, vmopv1.GroupName)
+	})
+	return out
+}
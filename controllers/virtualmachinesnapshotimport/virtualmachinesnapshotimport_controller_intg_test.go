@@ -0,0 +1,196 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotimport_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	const (
+		exportName     = "dummy-snapshot-export"
+		importName     = "dummy-snapshot-import"
+		srcClaimName   = "src-claim"
+		importedVMName = "imported-vm"
+		importedClaim  = importedVMName + "-data"
+	)
+
+	var (
+		ctx            *builder.IntegrationTestContext
+		snapshotImport *vmopv1.VirtualMachineSnapshotImport
+	)
+
+	getReadyCondition := func(g Gomega) *metav1.Condition {
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(snapshotImport), snapshotImport)).To(Succeed())
+		c := conditions.Get(snapshotImport, vmopv1.ReadyConditionType)
+		g.Expect(c).ToNot(BeNil())
+		return c
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		snapshotImport = builder.DummyVirtualMachineSnapshotImport(ctx.Namespace, importName, ctx.Namespace, exportName)
+		snapshotImport.Spec.VMName = importedVMName
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		intgFakeVMProvider.Reset()
+	})
+
+	When("the export does not exist", func() {
+		It("should report that the export does not exist", func() {
+			Expect(ctx.Client.Create(ctx, snapshotImport)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Reason).To(Equal(vmopv1.VirtualMachineSnapshotImportExportNotFoundReason))
+			}).Should(Succeed())
+		})
+	})
+
+	When("the export is an archive of PersistentVolumeClaims", func() {
+		var copied bool
+
+		BeforeEach(func() {
+			copied = false
+
+			srcVM := builder.DummyVirtualMachine()
+			srcVM.APIVersion = vmopv1.GroupVersion.String()
+			srcVM.Kind = "VirtualMachine"
+			srcVM.Namespace = ctx.Namespace
+			srcVM.Name = "src-vm"
+			srcVM.Spec.Volumes = []vmopv1.VirtualMachineVolume{
+				{
+					Name: "data",
+					VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+						PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: srcClaimName,
+							},
+						},
+					},
+				},
+			}
+			vmYAML, err := yaml.Marshal(srcVM)
+			Expect(err).ToNot(HaveOccurred())
+			encodedVMYAML, err := pkgutil.EncodeGzipBase64(string(vmYAML))
+			Expect(err).ToNot(HaveOccurred())
+
+			snapshotExport := builder.DummyVirtualMachineSnapshotExport(ctx.Namespace, exportName, "dummy-snapshot")
+			Expect(ctx.Client.Create(ctx, snapshotExport)).To(Succeed())
+			snapshotExport.Status.VMName = srcVM.Name
+			snapshotExport.Status.VMResourceYAML = encodedVMYAML
+			snapshotExport.Status.Disks = []vmopv1.VirtualMachineSnapshotExportDiskStatus{
+				{
+					FileName:        "[ds] exports/disk-0.vmdk",
+					SourceClaimName: srcClaimName,
+					ClaimName:       exportName + "-0",
+					Capacity:        ptr.To(resource.MustParse("1Gi")),
+					Copied:          true,
+				},
+			}
+			conditions.MarkTrue(snapshotExport, vmopv1.ReadyConditionType)
+			Expect(ctx.Client.Status().Update(ctx, snapshotExport)).To(Succeed())
+
+			intgFakeVMProvider.Lock()
+			defer intgFakeVMProvider.Unlock()
+			intgFakeVMProvider.ImportVirtualMachineSnapshotVolumeFn = func(
+				_ context.Context,
+				_ *vmopv1.VirtualMachineSnapshotImport,
+				volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus,
+				_ string,
+				pvc *corev1.PersistentVolumeClaim) error {
+
+				if !copied {
+					volStatus.CopyTaskID = "task-1"
+					return pkgerr.RequeueError{After: 100 * time.Millisecond, Message: "copying"}
+				}
+
+				if err := ctx.Client.Create(ctx, pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+					return err
+				}
+
+				volStatus.CopyTaskID = ""
+				volStatus.FileName = "[ds] imports/" + pvc.Name + ".vmdk"
+				return nil
+			}
+		})
+
+		It("should create the VirtualMachine once the disks are copied and the claims are bound", func() {
+			Expect(ctx.Client.Create(ctx, snapshotImport)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Reason).To(Equal(vmopv1.VirtualMachineSnapshotImportInProgressReason))
+				g.Expect(snapshotImport.Status.Volumes).To(ConsistOf(vmopv1.VirtualMachineSnapshotImportVolumeStatus{
+					Name:       "data",
+					ClaimName:  importedClaim,
+					CopyTaskID: "task-1",
+				}))
+			}).Should(Succeed())
+
+			By("the disks are copied", func() {
+				intgFakeVMProvider.Lock()
+				copied = true
+				intgFakeVMProvider.Unlock()
+			})
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Eventually(func(g Gomega) {
+				g.Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: ctx.Namespace, Name: importedClaim}, pvc)).To(Succeed())
+				g.Expect(getReadyCondition(g).Status).ToNot(Equal(metav1.ConditionTrue))
+			}).Should(Succeed())
+
+			By("the claim is bound", func() {
+				pvc.Status.Phase = corev1.ClaimBound
+				Expect(ctx.Client.Status().Update(ctx, pvc)).To(Succeed())
+			})
+
+			Eventually(func(g Gomega) {
+				g.Expect(getReadyCondition(g).Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(snapshotImport.Status.VMName).To(Equal(importedVMName))
+				g.Expect(snapshotImport.Status.Volumes).To(HaveLen(1))
+				g.Expect(snapshotImport.Status.Volumes[0].FileName).To(Equal("[ds] imports/" + importedClaim + ".vmdk"))
+			}).Should(Succeed())
+
+			vm := &vmopv1.VirtualMachine{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: ctx.Namespace, Name: importedVMName}, vm)).To(Succeed())
+			Expect(vm.Spec.Volumes).To(HaveLen(1))
+			Expect(vm.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(importedClaim))
+		})
+	})
+}
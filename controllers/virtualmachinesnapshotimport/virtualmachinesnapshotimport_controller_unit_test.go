@@ -0,0 +1,324 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotimport_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshotimport"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		exportNamespace = "export-namespace"
+		importNamespace = "import-namespace"
+		exportName      = "test-export"
+		importName      = "test-import"
+		srcVMName       = "src-vm"
+		srcClaimName    = "src-claim"
+		importedVMName  = "imported-vm"
+		importedClaim   = importedVMName + "-data"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler     *virtualmachinesnapshotimport.Reconciler
+		fakeVMProvider *providerfake.VMProvider
+		snapshotExport *vmopv1.VirtualMachineSnapshotExport
+		snapshotImport *vmopv1.VirtualMachineSnapshotImport
+	)
+
+	reconcileNormal := func() error {
+		return reconciler.ReconcileNormal(&pkgctx.VirtualMachineSnapshotImportContext{
+			Context:        ctx,
+			Logger:         ctx.Logger,
+			SnapshotImport: snapshotImport,
+		})
+	}
+
+	readyReason := func() string {
+		c := conditions.Get(snapshotImport, vmopv1.ReadyConditionType)
+		Expect(c).ToNot(BeNil())
+		return c.Reason
+	}
+
+	getImportedVM := func() *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{}
+		Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: importNamespace, Name: importedVMName}, vm)).To(Succeed())
+		return vm
+	}
+
+	BeforeEach(func() {
+		srcVM := builder.DummyVirtualMachine()
+		srcVM.APIVersion = vmopv1.GroupVersion.String()
+		srcVM.Kind = "VirtualMachine"
+		srcVM.Namespace = exportNamespace
+		srcVM.Name = srcVMName
+		srcVM.Labels = map[string]string{
+			"app":                         "db",
+			vmopv1.VMNameForSnapshotLabel: srcVMName,
+		}
+		srcVM.Spec.InstanceUUID = "instance-uuid"
+		srcVM.Spec.BiosUUID = "bios-uuid"
+		srcVM.Spec.Volumes = []vmopv1.VirtualMachineVolume{
+			{
+				Name: "data",
+				VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+					PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: srcClaimName,
+						},
+					},
+				},
+			},
+		}
+
+		vmYAML, err := yaml.Marshal(srcVM)
+		Expect(err).ToNot(HaveOccurred())
+		encodedVMYAML, err := pkgutil.EncodeGzipBase64(string(vmYAML))
+		Expect(err).ToNot(HaveOccurred())
+
+		snapshotExport = builder.DummyVirtualMachineSnapshotExport(exportNamespace, exportName, "test-snapshot")
+		snapshotExport.Spec.ImportNamespaces = []string{importNamespace}
+		snapshotExport.Status.VMName = srcVMName
+		snapshotExport.Status.VMResourceYAML = encodedVMYAML
+		snapshotExport.Status.Disks = []vmopv1.VirtualMachineSnapshotExportDiskStatus{
+			{
+				FileName:  "[ds] exports/disk-0.vmdk",
+				ClaimName: exportName + "-0",
+			},
+			{
+				FileName:        "[ds] exports/disk-1.vmdk",
+				SourceClaimName: srcClaimName,
+				ClaimName:       exportName + "-1",
+			},
+		}
+		conditions.MarkTrue(snapshotExport, vmopv1.ReadyConditionType)
+
+		snapshotImport = builder.DummyVirtualMachineSnapshotImport(importNamespace, importName, exportNamespace, exportName)
+		snapshotImport.Spec.VMName = importedVMName
+		snapshotImport.Spec.ClassName = "other-class"
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinesnapshotimport.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+			ctx.VMProvider,
+		)
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.Reset()
+
+		if snapshotExport != nil {
+			Expect(ctx.Client.Create(ctx, snapshotExport)).To(Succeed())
+			Expect(ctx.Client.Status().Update(ctx, snapshotExport)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+		snapshotExport = nil
+	})
+
+	When("the export does not exist", func() {
+		BeforeEach(func() {
+			snapshotExport = nil
+		})
+
+		It("marks the import as not ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportExportNotFoundReason))
+		})
+	})
+
+	When("the export may not be imported into the namespace", func() {
+		BeforeEach(func() {
+			snapshotExport.Spec.ImportNamespaces = nil
+		})
+
+		It("marks the import as not ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportNotAllowedReason))
+		})
+	})
+
+	When("the export is not ready", func() {
+		BeforeEach(func() {
+			conditions.MarkFalse(snapshotExport, vmopv1.ReadyConditionType, "NotReady", "")
+		})
+
+		It("marks the import as not ready", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportExportNotReadyReason))
+		})
+	})
+
+	When("the export is an archive of PersistentVolumeClaims", func() {
+		var importedFileNames []string
+
+		BeforeEach(func() {
+			importedFileNames = nil
+		})
+
+		JustBeforeEach(func() {
+			fakeVMProvider.ImportVirtualMachineSnapshotVolumeFn = func(
+				ctx context.Context,
+				_ *vmopv1.VirtualMachineSnapshotImport,
+				volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus,
+				fileName string,
+				pvc *corev1.PersistentVolumeClaim) error {
+
+				importedFileNames = append(importedFileNames, fileName)
+				Expect(reconciler.Create(ctx, pvc)).To(Succeed())
+				volStatus.FileName = "[ds] imports/" + pvc.Name + ".vmdk"
+				return nil
+			}
+		})
+
+		It("creates the VirtualMachine once the imported claims are bound", func() {
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportInProgressReason))
+			Expect(importedFileNames).To(ConsistOf("[ds] exports/disk-1.vmdk"))
+			Expect(snapshotImport.Status.Volumes).To(ConsistOf(vmopv1.VirtualMachineSnapshotImportVolumeStatus{
+				Name:      "data",
+				FileName:  "[ds] imports/" + importedClaim + ".vmdk",
+				ClaimName: importedClaim,
+			}))
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: importNamespace, Name: importedClaim}, pvc)).To(Succeed())
+			Expect(pvc.Labels).To(HaveKeyWithValue(vmopv1.SnapshotImportNameLabel, importName))
+
+			By("the claim is bound", func() {
+				pvc.Status.Phase = corev1.ClaimBound
+				Expect(ctx.Client.Status().Update(ctx, pvc)).To(Succeed())
+			})
+
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(conditions.IsTrue(snapshotImport, vmopv1.ReadyConditionType)).To(BeTrue())
+			Expect(snapshotImport.Status.VMName).To(Equal(importedVMName))
+			Expect(snapshotImport.Status.CompletionTime).ToNot(BeNil())
+			Expect(importedFileNames).To(HaveLen(1))
+
+			vm := getImportedVM()
+			Expect(vm.Labels).To(HaveKeyWithValue("app", "db"))
+			Expect(vm.Labels).To(HaveKeyWithValue(vmopv1.SnapshotImportNameLabel, importName))
+			Expect(vm.Labels).ToNot(HaveKey(vmopv1.VMNameForSnapshotLabel))
+			Expect(vm.Spec.ClassName).To(Equal("other-class"))
+			Expect(vm.Spec.InstanceUUID).To(BeEmpty())
+			Expect(vm.Spec.BiosUUID).To(BeEmpty())
+			Expect(vm.Spec.Volumes).To(HaveLen(1))
+			Expect(vm.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(importedClaim))
+		})
+
+		When("the disk of a volume is being copied", func() {
+			JustBeforeEach(func() {
+				fakeVMProvider.ImportVirtualMachineSnapshotVolumeFn = func(
+					_ context.Context,
+					_ *vmopv1.VirtualMachineSnapshotImport,
+					volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus,
+					_ string,
+					_ *corev1.PersistentVolumeClaim) error {
+
+					volStatus.CopyTaskID = "task-1"
+					return pkgerr.RequeueError{After: time.Minute, Message: "copying"}
+				}
+			})
+
+			It("records the copy and requeues", func() {
+				err := reconcileNormal()
+				Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportInProgressReason))
+				Expect(snapshotImport.Status.Volumes).To(ConsistOf(vmopv1.VirtualMachineSnapshotImportVolumeStatus{
+					Name:       "data",
+					ClaimName:  importedClaim,
+					CopyTaskID: "task-1",
+				}))
+				Expect(snapshotImport.Status.VMName).To(BeEmpty())
+			})
+		})
+
+		When("the export does not contain the disk of a volume", func() {
+			BeforeEach(func() {
+				snapshotExport.Status.Disks = snapshotExport.Status.Disks[:1]
+			})
+
+			It("returns an error", func() {
+				Expect(reconcileNormal()).To(MatchError(ContainSubstring(`volume "data"`)))
+				Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportFailedReason))
+			})
+		})
+	})
+
+	When("the export is an OVA", func() {
+		BeforeEach(func() {
+			snapshotExport.Spec.Target = vmopv1.VirtualMachineSnapshotExportTarget{
+				OVA: &vmopv1.VirtualMachineSnapshotExportOVATarget{
+					ContentLibraryName: "my-library",
+				},
+			}
+			snapshotExport.Status.Disks = nil
+			snapshotExport.Status.ItemID = "item-id"
+		})
+
+		It("creates the VirtualMachine once the image is available", func() {
+			err := reconcileNormal()
+			Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+			Expect(readyReason()).To(Equal(vmopv1.VirtualMachineSnapshotImportImageNotFoundReason))
+
+			By("the image is available", func() {
+				vmi := builder.DummyVirtualMachineImage("vmi-0123456789")
+				vmi.Namespace = importNamespace
+				Expect(ctx.Client.Create(ctx, vmi)).To(Succeed())
+				vmi.Status.ProviderItemID = "item-id"
+				Expect(ctx.Client.Status().Update(ctx, vmi)).To(Succeed())
+			})
+
+			Expect(reconcileNormal()).To(Succeed())
+			Expect(conditions.IsTrue(snapshotImport, vmopv1.ReadyConditionType)).To(BeTrue())
+
+			vm := getImportedVM()
+			Expect(vm.Spec.Image).ToNot(BeNil())
+			Expect(vm.Spec.Image.Name).To(Equal("vmi-0123456789"))
+			Expect(vm.Spec.ImageName).To(Equal("vmi-0123456789"))
+			Expect(vm.Spec.Volumes).To(BeEmpty())
+		})
+	})
+}
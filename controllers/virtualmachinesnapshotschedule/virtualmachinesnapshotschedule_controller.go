@@ -0,0 +1,502 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotschedule
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+)
+
+// maxMissedSchedules is the maximum number of missed activations of a
+// schedule that are iterated over to find the most recent one.
+const maxMissedSchedules = 100
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineSnapshotSchedule{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachineSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(SnapshotToSnapshotSchedule),
+		).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.GetMaxConcurrentReconciles(controllerNameShort, ctx.MaxConcurrentReconciles),
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// SnapshotToSnapshotSchedule is a mapper function to be used to enqueue
+// requests for reconciliation for the VirtualMachineSnapshotSchedule that
+// created a VirtualMachineSnapshot.
+func SnapshotToSnapshotSchedule(_ context.Context, o client.Object) []reconcile.Request {
+	name := o.GetLabels()[vmopv1.SnapshotScheduleNameLabel]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Namespace: o.GetNamespace(),
+				Name:      name,
+			},
+		},
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineSnapshotSchedule object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotschedules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshotschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinesnapshots,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	schedule := &vmopv1.VirtualMachineSnapshotSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, schedule); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !schedule.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	schedCtx := &pkgctx.VirtualMachineSnapshotScheduleContext{
+		Context:          ctx,
+		Logger:           pkglog.FromContextOrDefault(ctx),
+		SnapshotSchedule: schedule,
+	}
+
+	patchHelper, err := patch.NewHelper(schedule, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", schedCtx.String(), err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, schedule); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			schedCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	result, err := r.ReconcileNormal(schedCtx, time.Now())
+	if err != nil {
+		schedCtx.Logger.Error(err, "Failed to reconcile VirtualMachineSnapshotSchedule")
+		return ctrl.Result{}, err
+	}
+
+	return result, nil
+}
+
+// ReconcileNormal prunes the snapshots that exceed the schedule's retention
+// policy and, if the schedule was activated since it last ran, creates a
+// snapshot for each of the selected VMs. The returned result requeues the
+// schedule for its next activation.
+func (r *Reconciler) ReconcileNormal(
+	ctx *pkgctx.VirtualMachineSnapshotScheduleContext,
+	now time.Time) (ctrl.Result, error) {
+
+	schedule := ctx.SnapshotSchedule
+	schedule.Status.ObservedGeneration = schedule.Generation
+
+	requeueAfter, err := r.pruneSnapshots(ctx, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	cronSchedule, loc, err := parseSchedule(schedule)
+	if err != nil {
+		schedule.Status.NextScheduleTime = nil
+		conditions.MarkFalse(
+			schedule,
+			vmopv1.VirtualMachineSnapshotScheduleConditionScheduled,
+			vmopv1.VirtualMachineSnapshotScheduleInvalidScheduleReason,
+			"%s", err)
+		// Do not return the error since the schedule will not become valid
+		// until the spec is updated.
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if schedule.Spec.Suspend {
+		schedule.Status.NextScheduleTime = nil
+		conditions.MarkFalse(
+			schedule,
+			vmopv1.VirtualMachineSnapshotScheduleConditionScheduled,
+			vmopv1.VirtualMachineSnapshotScheduleSuspendedReason,
+			"")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	earliestTime := schedule.CreationTimestamp.Time
+	if t := schedule.Status.LastScheduleTime; t != nil {
+		earliestTime = t.Time
+	}
+	if d := schedule.Spec.StartingDeadlineSeconds; d != nil {
+		if deadline := now.Add(-time.Duration(*d) * time.Second); deadline.After(earliestTime) {
+			earliestTime = deadline
+		}
+	}
+
+	// Only the most recent of any missed activations is run.
+	scheduledTime, next, tooManyMissed := mostRecentScheduleTime(cronSchedule, earliestTime.In(loc), now.In(loc))
+	if tooManyMissed {
+		r.Recorder.Warnf(schedule, "TooManyMissedSchedules",
+			"More than %d activations were missed. Set or decrease spec.startingDeadlineSeconds or check for clock skew",
+			maxMissedSchedules)
+	}
+
+	if !scheduledTime.IsZero() {
+		if err := r.createSnapshots(ctx, scheduledTime); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if next.IsZero() {
+		schedule.Status.NextScheduleTime = nil
+		conditions.MarkFalse(
+			schedule,
+			vmopv1.VirtualMachineSnapshotScheduleConditionScheduled,
+			vmopv1.VirtualMachineSnapshotScheduleInvalidScheduleReason,
+			"schedule is never activated")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	schedule.Status.NextScheduleTime = &metav1.Time{Time: next}
+	conditions.MarkTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionScheduled)
+
+	if d := next.Sub(now); requeueAfter == 0 || d < requeueAfter {
+		requeueAfter = d
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// createSnapshots creates a VirtualMachineSnapshot of each of the VMs selected
+// by the schedule for the given scheduled time.
+func (r *Reconciler) createSnapshots(
+	ctx *pkgctx.VirtualMachineSnapshotScheduleContext,
+	scheduledTime time.Time) error {
+
+	schedule := ctx.SnapshotSchedule
+
+	selector := labels.Nothing()
+	if schedule.Spec.Selector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(schedule.Spec.Selector); err != nil {
+			conditions.MarkError(
+				schedule,
+				vmopv1.VirtualMachineSnapshotScheduleConditionLastRunSucceeded,
+				"InvalidSelector",
+				err)
+			return fmt.Errorf("invalid selector: %w", err)
+		}
+	}
+
+	var vmList vmopv1.VirtualMachineList
+	if err := r.List(
+		ctx,
+		&vmList,
+		client.InNamespace(schedule.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+
+		return fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	var failures []vmopv1.VirtualMachineSnapshotScheduleFailure
+
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if !vm.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		snapshot := &vmopv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: schedule.Namespace,
+				Name:      GetSnapshotName(schedule.Name, vm.Name, scheduledTime),
+				Labels: map[string]string{
+					vmopv1.SnapshotScheduleNameLabel: schedule.Name,
+					vmopv1.VMNameForSnapshotLabel:    vm.Name,
+				},
+			},
+			Spec: vmopv1.VirtualMachineSnapshotSpec{
+				VMName:      vm.Name,
+				Memory:      schedule.Spec.SnapshotTemplate.Memory,
+				Quiesce:     schedule.Spec.SnapshotTemplate.Quiesce.DeepCopy(),
+				Description: schedule.Spec.SnapshotTemplate.Description,
+			},
+		}
+
+		if err := r.Create(ctx, snapshot); err != nil && !apierrors.IsAlreadyExists(err) {
+			ctx.Logger.Error(err, "Failed to create VirtualMachineSnapshot",
+				"vmName", vm.Name, "snapshotName", snapshot.Name)
+			failures = append(failures, vmopv1.VirtualMachineSnapshotScheduleFailure{
+				VMName:       vm.Name,
+				SnapshotName: snapshot.Name,
+				Message:      err.Error(),
+			})
+			continue
+		}
+	}
+
+	schedule.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+	schedule.Status.Failures = failures
+
+	if len(failures) > 0 {
+		conditions.MarkFalse(
+			schedule,
+			vmopv1.VirtualMachineSnapshotScheduleConditionLastRunSucceeded,
+			vmopv1.VirtualMachineSnapshotScheduleSnapshotFailedReason,
+			"failed to create snapshots for %d of %d virtual machines",
+			len(failures), len(vmList.Items))
+		r.Recorder.Warnf(schedule, "SnapshotFailed",
+			"Failed to create snapshots for %d virtual machines", len(failures))
+		return nil
+	}
+
+	schedule.Status.LastSuccessfulTime = &metav1.Time{Time: scheduledTime}
+	conditions.MarkTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionLastRunSucceeded)
+
+	return nil
+}
+
+// pruneSnapshots deletes the snapshots created by the schedule that exceed its
+// retention policy. Deleting a VirtualMachineSnapshot deletes the underlying
+// snapshot with the provider's DeleteSnapshot method. The returned duration is
+// how long until the oldest of the remaining snapshots exceeds the policy's
+// maximum age, or zero if there is no maximum age.
+func (r *Reconciler) pruneSnapshots(
+	ctx *pkgctx.VirtualMachineSnapshotScheduleContext,
+	now time.Time) (time.Duration, error) {
+
+	schedule := ctx.SnapshotSchedule
+
+	var snapshotList vmopv1.VirtualMachineSnapshotList
+	if err := r.List(
+		ctx,
+		&snapshotList,
+		client.InNamespace(schedule.Namespace),
+		client.MatchingLabels{vmopv1.SnapshotScheduleNameLabel: schedule.Name}); err != nil {
+
+		return 0, fmt.Errorf("failed to list VirtualMachineSnapshots: %w", err)
+	}
+
+	snapshotsByVM := map[string][]*vmopv1.VirtualMachineSnapshot{}
+	for i := range snapshotList.Items {
+		s := &snapshotList.Items[i]
+		if !s.DeletionTimestamp.IsZero() {
+			continue
+		}
+		vmName := s.Spec.VMName
+		snapshotsByVM[vmName] = append(snapshotsByVM[vmName], s)
+	}
+
+	var (
+		count        int32
+		requeueAfter time.Duration
+		pruneErrs    []string
+	)
+
+	retention := schedule.Spec.Retention
+	if retention == nil {
+		retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{}
+	}
+
+	for _, snapshots := range snapshotsByVM {
+		// Sort the snapshots from newest to oldest.
+		slices.SortFunc(snapshots, func(a, b *vmopv1.VirtualMachineSnapshot) int {
+			return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+		})
+
+		for i, s := range snapshots {
+			var expired bool
+			if retention.MaxAge != nil {
+				expiry := s.CreationTimestamp.Add(retention.MaxAge.Duration)
+				if !expiry.After(now) {
+					expired = true
+				} else if d := expiry.Sub(now); requeueAfter == 0 || d < requeueAfter {
+					requeueAfter = d
+				}
+			}
+
+			exceedsCount := retention.MaxCount != nil && i >= int(*retention.MaxCount)
+
+			if !expired && !exceedsCount {
+				count++
+				continue
+			}
+
+			if err := r.Delete(ctx, s); client.IgnoreNotFound(err) != nil {
+				ctx.Logger.Error(err, "Failed to delete VirtualMachineSnapshot",
+					"snapshotName", s.Name)
+				pruneErrs = append(pruneErrs, fmt.Sprintf("%s: %s", s.Name, err))
+				count++
+				continue
+			}
+			ctx.Logger.Info("Deleted VirtualMachineSnapshot that exceeded retention policy",
+				"snapshotName", s.Name)
+		}
+	}
+
+	schedule.Status.SnapshotCount = count
+
+	if len(pruneErrs) > 0 {
+		conditions.MarkFalse(
+			schedule,
+			vmopv1.VirtualMachineSnapshotScheduleConditionPruned,
+			vmopv1.VirtualMachineSnapshotSchedulePruneFailedReason,
+			"failed to delete snapshots: %s",
+			strings.Join(pruneErrs, "; "))
+	} else {
+		conditions.MarkTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionPruned)
+	}
+
+	return requeueAfter, nil
+}
+
+// GetSnapshotName returns the name of the VirtualMachineSnapshot created by
+// the schedule for a VM at the given scheduled time. If the name would exceed
+// the maximum length of an object name, the names of the schedule and VM are
+// truncated and a hash of them is added so the name remains unique.
+func GetSnapshotName(scheduleName, vmName string, scheduledTime time.Time) string {
+	prefix := scheduleName + "-" + vmName
+	suffix := fmt.Sprintf("-%d", scheduledTime.Unix())
+
+	if len(prefix)+len(suffix) <= validation.DNS1123SubdomainMaxLength {
+		return prefix + suffix
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(prefix))
+	suffix = fmt.Sprintf("-%08x%s", h.Sum32(), suffix)
+
+	prefix = prefix[:validation.DNS1123SubdomainMaxLength-len(suffix)]
+	return strings.TrimRight(prefix, "-.") + suffix
+}
+
+// mostRecentScheduleTime returns the most recent activation of the schedule
+// after earliestTime that is not after now, or a zero time if there is none,
+// and the next activation after now. Both times must be in the location of the
+// schedule.
+//
+// Like the CronJob controller, at most maxMissedSchedules missed activations
+// are iterated over. When more were missed, tooManyMissed is true, and the
+// iteration is repeated from a point in time before now that is as far from
+// now as the iterated activations span, skipping the older activations.
+func mostRecentScheduleTime(
+	s cron.Schedule,
+	earliestTime, now time.Time) (scheduledTime, next time.Time, tooManyMissed bool) {
+
+	scheduledTime, next = missedScheduleTimes(s, earliestTime, now)
+	if next.IsZero() || next.After(now) {
+		return scheduledTime, next, false
+	}
+
+	if t, n := missedScheduleTimes(s, now.Add(-scheduledTime.Sub(earliestTime)), now); !t.IsZero() {
+		scheduledTime, next = t, n
+	}
+	if !next.IsZero() && !next.After(now) {
+		next = s.Next(now)
+	}
+
+	return scheduledTime, next, true
+}
+
+// missedScheduleTimes iterates over at most maxMissedSchedules activations of
+// the schedule after earliestTime that are not after now. It returns the last
+// of those activations and the activation that follows it.
+func missedScheduleTimes(
+	s cron.Schedule,
+	earliestTime, now time.Time) (scheduledTime, next time.Time) {
+
+	next = s.Next(earliestTime)
+	for i := 0; i < maxMissedSchedules && !next.IsZero() && !next.After(now); i++ {
+		scheduledTime = next
+		next = s.Next(next)
+	}
+	return scheduledTime, next
+}
+
+func parseSchedule(
+	schedule *vmopv1.VirtualMachineSnapshotSchedule) (cron.Schedule, *time.Location, error) {
+
+	loc := time.UTC
+	if tz := schedule.Spec.TimeZone; tz != nil && *tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(*tz); err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone %q: %w", *tz, err)
+		}
+	}
+
+	s, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", schedule.Spec.Schedule, err)
+	}
+
+	return s, loc, nil
+}
@@ -0,0 +1,150 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotschedule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	const (
+		scheduleName = "dummy-schedule"
+		vmName       = "dummy-vm"
+	)
+
+	var (
+		ctx      *builder.IntegrationTestContext
+		schedule *vmopv1.VirtualMachineSnapshotSchedule
+	)
+
+	getScheduledCondition := func(g Gomega) *metav1.Condition {
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(schedule), schedule)).To(Succeed())
+		c := conditions.Get(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionScheduled)
+		g.Expect(c).ToNot(BeNil())
+		return c
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		schedule = builder.DummyVirtualMachineSnapshotSchedule(ctx.Namespace, scheduleName)
+		schedule.Spec.Selector.MatchLabels = map[string]string{"appname": "db"}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("the schedule is valid", func() {
+		It("should schedule the next activation", func() {
+			Expect(ctx.Client.Create(ctx, schedule)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(getScheduledCondition(g).Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(schedule.Status.ObservedGeneration).To(Equal(schedule.Generation))
+				g.Expect(schedule.Status.NextScheduleTime).ToNot(BeNil())
+				g.Expect(schedule.Status.NextScheduleTime.Time).To(BeTemporally("~", time.Now(), time.Hour))
+				g.Expect(schedule.Status.NextScheduleTime.Minute()).To(BeZero())
+			}).Should(Succeed())
+		})
+
+		When("the schedule is suspended", func() {
+			It("should not schedule an activation", func() {
+				schedule.Spec.Suspend = true
+				Expect(ctx.Client.Create(ctx, schedule)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					c := getScheduledCondition(g)
+					g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+					g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineSnapshotScheduleSuspendedReason))
+					g.Expect(schedule.Status.NextScheduleTime).To(BeNil())
+				}).Should(Succeed())
+
+				By("resuming the schedule", func() {
+					schedule.Spec.Suspend = false
+					Expect(ctx.Client.Update(ctx, schedule)).To(Succeed())
+				})
+
+				Eventually(func(g Gomega) {
+					g.Expect(getScheduledCondition(g).Status).To(Equal(metav1.ConditionTrue))
+					g.Expect(schedule.Status.NextScheduleTime).ToNot(BeNil())
+				}).Should(Succeed())
+			})
+		})
+	})
+
+	When("the schedule is invalid", func() {
+		It("should report that the schedule is invalid", func() {
+			schedule.Spec.Schedule = "not a schedule"
+			Expect(ctx.Client.Create(ctx, schedule)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				c := getScheduledCondition(g)
+				g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(vmopv1.VirtualMachineSnapshotScheduleInvalidScheduleReason))
+				g.Expect(schedule.Status.NextScheduleTime).To(BeNil())
+			}).Should(Succeed())
+		})
+	})
+
+	When("the snapshots created by the schedule exceed the retention policy", func() {
+		BeforeEach(func() {
+			for _, name := range []string{"snapshot-1", "snapshot-2"} {
+				snapshot := builder.DummyVirtualMachineSnapshot(ctx.Namespace, name, vmName)
+				snapshot.Finalizers = nil
+				snapshot.Labels = map[string]string{
+					vmopv1.SnapshotScheduleNameLabel: scheduleName,
+					vmopv1.VMNameForSnapshotLabel:    vmName,
+				}
+				Expect(ctx.Client.Create(ctx, snapshot)).To(Succeed())
+			}
+
+			schedule.Spec.Retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{
+				MaxCount: ptr.To[int32](1),
+			}
+		})
+
+		It("should delete the oldest snapshots", func() {
+			Expect(ctx.Client.Create(ctx, schedule)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				var snapshotList vmopv1.VirtualMachineSnapshotList
+				g.Expect(ctx.Client.List(ctx, &snapshotList,
+					client.InNamespace(ctx.Namespace),
+					client.MatchingLabels{vmopv1.SnapshotScheduleNameLabel: scheduleName})).To(Succeed())
+				g.Expect(snapshotList.Items).To(HaveLen(1))
+
+				g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(schedule), schedule)).To(Succeed())
+				g.Expect(schedule.Status.SnapshotCount).To(BeEquivalentTo(1))
+				g.Expect(conditions.IsTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionPruned)).To(BeTrue())
+			}).Should(Succeed())
+		})
+	})
+}
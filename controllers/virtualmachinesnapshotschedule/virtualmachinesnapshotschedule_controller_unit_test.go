@@ -0,0 +1,392 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinesnapshotschedule_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinesnapshotschedule"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace    = "test-namespace"
+		scheduleName = "test-schedule"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinesnapshotschedule.Reconciler
+		schedule   *vmopv1.VirtualMachineSnapshotSchedule
+		createTime time.Time
+	)
+
+	newVM := func(name string) *vmopv1.VirtualMachine {
+		vm := builder.DummyBasicVirtualMachine(name, namespace)
+		vm.Labels = map[string]string{"app": "db"}
+		return vm
+	}
+
+	newSnapshot := func(vmName string, created time.Time) *vmopv1.VirtualMachineSnapshot {
+		snapshot := builder.DummyVirtualMachineSnapshot(
+			namespace,
+			virtualmachinesnapshotschedule.GetSnapshotName(scheduleName, vmName, created),
+			vmName)
+		snapshot.Labels = map[string]string{
+			vmopv1.SnapshotScheduleNameLabel: scheduleName,
+			vmopv1.VMNameForSnapshotLabel:    vmName,
+		}
+		snapshot.CreationTimestamp = metav1.NewTime(created)
+		// The VirtualMachineSnapshot controller is not running to remove the
+		// finalizer once the snapshot is deleted.
+		snapshot.Finalizers = nil
+		return snapshot
+	}
+
+	listSnapshots := func() []vmopv1.VirtualMachineSnapshot {
+		var list vmopv1.VirtualMachineSnapshotList
+		Expect(ctx.Client.List(
+			ctx,
+			&list,
+			client.InNamespace(namespace),
+			client.MatchingLabels{vmopv1.SnapshotScheduleNameLabel: scheduleName})).To(Succeed())
+		return list.Items
+	}
+
+	BeforeEach(func() {
+		createTime = time.Date(2024, time.January, 1, 0, 30, 0, 0, time.UTC)
+
+		schedule = builder.DummyVirtualMachineSnapshotSchedule(namespace, scheduleName)
+		schedule.CreationTimestamp = metav1.NewTime(createTime)
+		schedule.Spec.Selector.MatchLabels = map[string]string{"app": "db"}
+		schedule.Spec.SnapshotTemplate = vmopv1.VirtualMachineSnapshotTemplateSpec{
+			Memory:      true,
+			Description: "hourly",
+		}
+
+		unselected := newVM("vm-web")
+		unselected.Labels = map[string]string{"app": "web"}
+
+		initObjects = []client.Object{
+			schedule,
+			newVM("vm-1"),
+			newVM("vm-2"),
+			unselected,
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinesnapshotschedule.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	reconcileAt := func(now time.Time) (reconcile.Result, error) {
+		return reconciler.ReconcileNormal(&pkgctx.VirtualMachineSnapshotScheduleContext{
+			Context:          ctx,
+			Logger:           ctx.Logger,
+			SnapshotSchedule: schedule,
+		}, now)
+	}
+
+	When("the schedule has not been activated", func() {
+		It("requeues for the next activation", func() {
+			result, err := reconcileAt(createTime.Add(10 * time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(20 * time.Minute))
+
+			Expect(listSnapshots()).To(BeEmpty())
+			Expect(schedule.Status.LastScheduleTime).To(BeNil())
+			Expect(schedule.Status.NextScheduleTime.Time).To(Equal(createTime.Add(30 * time.Minute)))
+			Expect(conditions.IsTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionScheduled)).To(BeTrue())
+		})
+	})
+
+	When("the schedule has been activated", func() {
+		It("creates a snapshot of each selected VM", func() {
+			now := createTime.Add(45 * time.Minute)
+			scheduledTime := createTime.Add(30 * time.Minute)
+
+			result, err := reconcileAt(now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(45 * time.Minute))
+
+			snapshots := listSnapshots()
+			Expect(snapshots).To(HaveLen(2))
+			for _, s := range snapshots {
+				Expect(s.Spec.VMName).To(BeElementOf("vm-1", "vm-2"))
+				Expect(s.Name).To(Equal(virtualmachinesnapshotschedule.GetSnapshotName(scheduleName, s.Spec.VMName, scheduledTime)))
+				Expect(s.Spec.Memory).To(BeTrue())
+				Expect(s.Spec.Description).To(Equal("hourly"))
+				Expect(s.Labels).To(HaveKeyWithValue(vmopv1.VMNameForSnapshotLabel, s.Spec.VMName))
+			}
+
+			Expect(schedule.Status.LastScheduleTime.Time).To(Equal(scheduledTime))
+			Expect(schedule.Status.LastSuccessfulTime.Time).To(Equal(scheduledTime))
+			Expect(schedule.Status.NextScheduleTime.Time).To(Equal(scheduledTime.Add(time.Hour)))
+			Expect(schedule.Status.Failures).To(BeEmpty())
+			Expect(conditions.IsTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionLastRunSucceeded)).To(BeTrue())
+
+			By("not creating snapshots again for the same activation", func() {
+				_, err := reconcileAt(now.Add(time.Minute))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(listSnapshots()).To(HaveLen(2))
+			})
+		})
+
+		It("only runs the most recent of the missed activations", func() {
+			_, err := reconcileAt(createTime.Add(5 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(listSnapshots()).To(HaveLen(2))
+			Expect(schedule.Status.LastScheduleTime.Time).To(Equal(createTime.Add(4*time.Hour + 30*time.Minute)))
+			Expect(ctx.Events).ShouldNot(Receive(ContainSubstring("TooManyMissedSchedules")))
+		})
+
+		It("skips ahead when too many activations were missed", func() {
+			now := createTime.Add(1000 * time.Hour)
+
+			result, err := reconcileAt(now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Minute))
+
+			Expect(listSnapshots()).To(HaveLen(2))
+			Expect(schedule.Status.LastScheduleTime.Time).To(Equal(now.Add(-30 * time.Minute)))
+			Expect(schedule.Status.NextScheduleTime.Time).To(Equal(now.Add(30 * time.Minute)))
+			Expect(ctx.Events).Should(Receive(ContainSubstring("TooManyMissedSchedules")))
+		})
+
+		When("the schedule has a starting deadline", func() {
+			BeforeEach(func() {
+				schedule.Spec.StartingDeadlineSeconds = ptr.To[int64](600)
+			})
+
+			It("creates the snapshots within the deadline", func() {
+				_, err := reconcileAt(createTime.Add(35 * time.Minute))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(listSnapshots()).To(HaveLen(2))
+				Expect(schedule.Status.LastScheduleTime.Time).To(Equal(createTime.Add(30 * time.Minute)))
+			})
+
+			It("skips the activations that missed the deadline", func() {
+				result, err := reconcileAt(createTime.Add(45 * time.Minute))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(45 * time.Minute))
+
+				Expect(listSnapshots()).To(BeEmpty())
+				Expect(schedule.Status.LastScheduleTime).To(BeNil())
+				Expect(schedule.Status.NextScheduleTime.Time).To(Equal(createTime.Add(90 * time.Minute)))
+			})
+		})
+	})
+
+	When("the schedule is suspended", func() {
+		BeforeEach(func() {
+			schedule.Spec.Suspend = true
+		})
+
+		It("does not create snapshots", func() {
+			_, err := reconcileAt(createTime.Add(2 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(listSnapshots()).To(BeEmpty())
+			Expect(schedule.Status.NextScheduleTime).To(BeNil())
+
+			c := conditions.Get(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionScheduled)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineSnapshotScheduleSuspendedReason))
+		})
+	})
+
+	When("the schedule is invalid", func() {
+		BeforeEach(func() {
+			schedule.Spec.Schedule = "not a schedule"
+		})
+
+		It("marks the schedule as invalid", func() {
+			_, err := reconcileAt(createTime.Add(2 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(listSnapshots()).To(BeEmpty())
+
+			c := conditions.Get(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionScheduled)
+			Expect(c).ToNot(BeNil())
+			Expect(c.Status).To(Equal(metav1.ConditionFalse))
+			Expect(c.Reason).To(Equal(vmopv1.VirtualMachineSnapshotScheduleInvalidScheduleReason))
+		})
+	})
+
+	When("the schedule uses a time zone", func() {
+		BeforeEach(func() {
+			schedule.Spec.Schedule = "0 2 * * *"
+			schedule.Spec.TimeZone = ptr.To("Asia/Tokyo")
+		})
+
+		It("interprets the schedule in the time zone", func() {
+			_, err := reconcileAt(createTime)
+			Expect(err).ToNot(HaveOccurred())
+
+			// 02:00 in Tokyo is 17:00 UTC on the previous day.
+			Expect(schedule.Status.NextScheduleTime.UTC()).To(Equal(
+				time.Date(2024, time.January, 1, 17, 0, 0, 0, time.UTC)))
+		})
+
+		It("interprets the schedule in the time zone when too many activations were missed", func() {
+			_, err := reconcileAt(createTime.Add(200 * 24 * time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ctx.Events).Should(Receive(ContainSubstring("TooManyMissedSchedules")))
+
+			Expect(schedule.Status.LastScheduleTime.UTC()).To(Equal(
+				time.Date(2024, time.July, 18, 17, 0, 0, 0, time.UTC)))
+			Expect(schedule.Status.NextScheduleTime.UTC()).To(Equal(
+				time.Date(2024, time.July, 19, 17, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("retention", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = createTime.Add(10 * time.Minute)
+
+			initObjects = append(initObjects,
+				newSnapshot("vm-1", now.Add(-3*time.Hour)),
+				newSnapshot("vm-1", now.Add(-2*time.Hour)),
+				newSnapshot("vm-1", now.Add(-1*time.Hour)),
+				newSnapshot("vm-2", now.Add(-3*time.Hour)),
+			)
+		})
+
+		When("there is no retention policy", func() {
+			It("keeps all the snapshots", func() {
+				_, err := reconcileAt(now)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(listSnapshots()).To(HaveLen(4))
+				Expect(schedule.Status.SnapshotCount).To(Equal(int32(4)))
+			})
+		})
+
+		When("the retention policy has a max count", func() {
+			BeforeEach(func() {
+				schedule.Spec.Retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{
+					MaxCount: ptr.To[int32](2),
+				}
+			})
+
+			It("deletes the oldest snapshots of each VM", func() {
+				_, err := reconcileAt(now)
+				Expect(err).ToNot(HaveOccurred())
+
+				snapshots := listSnapshots()
+				Expect(snapshots).To(HaveLen(3))
+				for _, s := range snapshots {
+					if s.Spec.VMName == "vm-1" {
+						Expect(s.CreationTimestamp.Time).To(BeTemporally(">", now.Add(-3*time.Hour)))
+					}
+				}
+				Expect(schedule.Status.SnapshotCount).To(Equal(int32(3)))
+				Expect(conditions.IsTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionPruned)).To(BeTrue())
+			})
+		})
+
+		When("the retention policy has a max age", func() {
+			BeforeEach(func() {
+				schedule.Spec.Retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{
+					MaxAge: &metav1.Duration{Duration: 150 * time.Minute},
+				}
+			})
+
+			It("deletes the expired snapshots and requeues for the next expiry", func() {
+				result, err := reconcileAt(now)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(listSnapshots()).To(HaveLen(2))
+				Expect(schedule.Status.SnapshotCount).To(Equal(int32(2)))
+
+				// The snapshot created two hours ago expires in 30 minutes,
+				// while the schedule is next activated in 20 minutes.
+				Expect(result.RequeueAfter).To(Equal(20 * time.Minute))
+			})
+		})
+	})
+
+	Context("GetSnapshotName", func() {
+		scheduledTime := time.Date(2024, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+		It("returns the names of the schedule and VM and the scheduled time", func() {
+			Expect(virtualmachinesnapshotschedule.GetSnapshotName(scheduleName, "vm-1", scheduledTime)).To(
+				Equal(scheduleName + "-vm-1-1704070800"))
+		})
+
+		It("returns a name that is not too long when the names are long", func() {
+			longScheduleName := strings.Repeat("s", 200)
+			longVMName := strings.Repeat("v", 62)
+
+			name1 := virtualmachinesnapshotschedule.GetSnapshotName(longScheduleName, longVMName+"1", scheduledTime)
+			name2 := virtualmachinesnapshotschedule.GetSnapshotName(longScheduleName, longVMName+"2", scheduledTime)
+
+			for _, name := range []string{name1, name2} {
+				Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+				Expect(name).To(HaveSuffix("-1704070800"))
+			}
+			Expect(name1).ToNot(Equal(name2))
+			Expect(virtualmachinesnapshotschedule.GetSnapshotName(longScheduleName, longVMName+"1", scheduledTime)).To(Equal(name1))
+		})
+	})
+
+	It("reconciles the schedule", func() {
+		_, err := reconciler.Reconcile(
+			cource.WithContext(ctx),
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: scheduleName}})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(schedule), schedule)).To(Succeed())
+		Expect(schedule.Status.NextScheduleTime).ToNot(BeNil())
+		Expect(conditions.IsTrue(schedule, vmopv1.VirtualMachineSnapshotScheduleConditionScheduled)).To(BeTrue())
+	})
+}
@@ -8,10 +8,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -89,6 +91,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=get
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx = pkgcfg.JoinContext(ctx, r.Context)
@@ -112,14 +115,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, err
 	}
 	if done {
-		return ctrl.Result{}, nil
-	}
-
-	err = r.Get(ctx, client.ObjectKey{Name: webconsolerequest.Spec.Name, Namespace: webconsolerequest.Namespace}, webConsoleRequestCtx.VM)
-	if err != nil {
-		r.Recorder.Warn(webConsoleRequestCtx.WebConsoleRequest, "VirtualMachine Not Found", "")
-		webConsoleRequestCtx.Logger.Error(err, "failed to get subject vm %s", webconsolerequest.Spec.Name)
-		return ctrl.Result{}, fmt.Errorf("failed to get subject vm %s: %w", webconsolerequest.Spec.Name, err)
+		return requeueForExpiry(webconsolerequest), nil
 	}
 
 	patchHelper, err := patch.NewHelper(webconsolerequest, r.Client)
@@ -135,12 +131,36 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		}
 	}()
 
+	if webconsolerequest.Spec.Revoked {
+		r.ReconcileRevoked(webConsoleRequestCtx)
+		return requeueForExpiry(webconsolerequest), nil
+	}
+
+	err = r.Get(ctx, client.ObjectKey{Name: webconsolerequest.Spec.Name, Namespace: webconsolerequest.Namespace}, webConsoleRequestCtx.VM)
+	if err != nil {
+		r.Recorder.Warn(webConsoleRequestCtx.WebConsoleRequest, "VirtualMachine Not Found", "")
+		webConsoleRequestCtx.Logger.Error(err, "failed to get subject vm %s", webconsolerequest.Spec.Name)
+		return ctrl.Result{}, fmt.Errorf("failed to get subject vm %s: %w", webconsolerequest.Spec.Name, err)
+	}
+
 	if err := r.ReconcileNormal(webConsoleRequestCtx); err != nil {
 		webConsoleRequestCtx.Logger.Error(err, "failed to reconcile WebConsoleRequest")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{Requeue: true, RequeueAfter: DefaultExpiryTime}, nil
+	return requeueForExpiry(webconsolerequest), nil
+}
+
+// requeueForExpiry returns a result that requeues the request when it
+// expires so it may be deleted.
+func requeueForExpiry(wcr *vmopv1.VirtualMachineWebConsoleRequest) ctrl.Result {
+	expiryTime := wcr.Status.ExpiryTime
+	if expiryTime.IsZero() {
+		return ctrl.Result{}
+	}
+	// Requeue slightly after the expiry time so the request is expired when
+	// it is reconciled again.
+	return ctrl.Result{RequeueAfter: time.Until(expiryTime.Time) + time.Second}
 }
 
 func (r *Reconciler) ReconcileEarlyNormal(ctx *pkgctx.WebConsoleRequestContextV1) (bool, error) {
@@ -155,6 +175,12 @@ func (r *Reconciler) ReconcileEarlyNormal(ctx *pkgctx.WebConsoleRequestContextV1
 		return true, nil
 	}
 
+	if ctx.WebConsoleRequest.Spec.Revoked {
+		// A revoked request is done once its response is cleared and it has
+		// an expiry time.
+		return ctx.WebConsoleRequest.Status.Response == "" && !expiryTime.IsZero(), nil
+	}
+
 	if ctx.WebConsoleRequest.Status.Response != "" &&
 		ctx.WebConsoleRequest.Status.ProxyAddr != "" {
 		// If the response and proxy address are already set, no need to reconcile anymore
@@ -177,8 +203,13 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.WebConsoleRequestContextV1) err
 	}
 	r.Recorder.EmitEvent(ctx.WebConsoleRequest, "Acquired Ticket", nil, false)
 
+	ttl, err := r.getTTL(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctx.WebConsoleRequest.Status.Response = ticket
-	ctx.WebConsoleRequest.Status.ExpiryTime = metav1.NewTime(metav1.Now().Add(DefaultExpiryTime))
+	ctx.WebConsoleRequest.Status.ExpiryTime = metav1.NewTime(metav1.Now().Add(ttl))
 
 	proxyAddr, err := proxyaddr.ProxyAddress(ctx, r)
 	if err != nil {
@@ -201,6 +232,71 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.WebConsoleRequestContextV1) err
 	return nil
 }
 
+// ReconcileRevoked clears the response of a revoked request. The request is
+// kept until it expires so its revocation is visible, and a revoked request
+// that never acquired a ticket expires immediately.
+func (r *Reconciler) ReconcileRevoked(ctx *pkgctx.WebConsoleRequestContextV1) {
+	wcr := ctx.WebConsoleRequest
+
+	wcr.Status.Response = ""
+	if wcr.Status.ExpiryTime.IsZero() {
+		wcr.Status.ExpiryTime = metav1.Now()
+	}
+
+	ctx.Logger.Info("Revoked WebConsoleRequest")
+	r.Recorder.Eventf(wcr, "Revoked",
+		"Web console access to VirtualMachine %s requested by %q is revoked",
+		wcr.Spec.Name, wcr.Annotations[vmopv1.WebConsoleRequestRequesterAnnotation])
+}
+
+// getTTL returns how long the request is valid. This is the request's TTL, or
+// the default TTL if it is not set, bounded by the maximum TTL of the request's
+// namespace.
+func (r *Reconciler) getTTL(ctx *pkgctx.WebConsoleRequestContextV1) (time.Duration, error) {
+	maxTTL, err := GetMaxTTL(ctx, r.Client, ctx.WebConsoleRequest.Namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	ttl := DefaultExpiryTime
+	if v := ctx.WebConsoleRequest.Spec.TTLSeconds; v != nil {
+		ttl = time.Duration(*v) * time.Second
+	}
+
+	return min(ttl, maxTTL), nil
+}
+
+// GetMaxTTL returns the maximum TTL of web console requests in the namespace
+// from the namespace's WebConsoleRequestMaxTTLSecondsAnnotation. The default
+// TTL is returned if the annotation is not set or the namespace does not
+// exist.
+func GetMaxTTL(
+	ctx context.Context,
+	k8sClient client.Client,
+	namespace string) (time.Duration, error) {
+
+	var ns corev1.Namespace
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return DefaultExpiryTime, nil
+		}
+		return 0, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+
+	v, ok := ns.Annotations[vmopv1.WebConsoleRequestMaxTTLSecondsAnnotation]
+	if !ok {
+		return DefaultExpiryTime, nil
+	}
+
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf("invalid value %q for annotation %s on namespace %s",
+			v, vmopv1.WebConsoleRequestMaxTTLSecondsAnnotation, namespace)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
 func (r *Reconciler) ReconcileOwnerReferences(ctx *pkgctx.WebConsoleRequestContextV1) error {
 	isController := true
 	ownerRef := metav1.OwnerReference{
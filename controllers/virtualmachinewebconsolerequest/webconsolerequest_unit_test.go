@@ -22,6 +22,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
 	proxyaddr "github.com/vmware-tanzu/vm-operator/pkg/util/kube/proxyaddr"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -134,6 +135,37 @@ func unitTestsReconcile() {
 				// Checking the label key only because UID will not be set to a resource during unit test.
 				Expect(wcrCtx.WebConsoleRequest.Labels).To(HaveKey(virtualmachinewebconsolerequest.UUIDLabelKey))
 			})
+
+			When("the request has a TTL", func() {
+				BeforeEach(func() {
+					wcr.Spec.TTLSeconds = ptr.To[int64](30)
+				})
+
+				It("expires after the TTL", func() {
+					Expect(reconciler.ReconcileNormal(wcrCtx)).To(Succeed())
+					Expect(wcrCtx.WebConsoleRequest.Status.ExpiryTime.Time).To(BeTemporally("~", time.Now().Add(30*time.Second), 5*time.Second))
+				})
+			})
+
+			When("the request has a TTL greater than the namespace max", func() {
+				BeforeEach(func() {
+					wcr.Namespace = "dummy-ns"
+					wcr.Spec.TTLSeconds = ptr.To[int64](3600)
+					initObjects = append(initObjects, &corev1.Namespace{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: wcr.Namespace,
+							Annotations: map[string]string{
+								vmopv1.WebConsoleRequestMaxTTLSecondsAnnotation: "600",
+							},
+						},
+					})
+				})
+
+				It("expires after the namespace max", func() {
+					Expect(reconciler.ReconcileNormal(wcrCtx)).To(Succeed())
+					Expect(wcrCtx.WebConsoleRequest.Status.ExpiryTime.Time).To(BeTemporally("~", time.Now().Add(600*time.Second), 5*time.Second))
+				})
+			})
 		})
 
 		When("Web Console returns correct proxy address", func() {
@@ -159,4 +191,53 @@ func unitTestsReconcile() {
 			)
 		})
 	})
+
+	Context("ReconcileRevoked", func() {
+		const requester = "sso:jdoe@vsphere.local"
+
+		BeforeEach(func() {
+			wcr.Spec.Revoked = true
+			wcr.Annotations = map[string]string{
+				vmopv1.WebConsoleRequestRequesterAnnotation: requester,
+			}
+			wcr.Status.Response = "my-fake-webmksticket"
+			wcr.Status.ExpiryTime = metav1.NewTime(time.Now().Add(time.Minute))
+			initObjects = append(initObjects, wcr, vm)
+		})
+
+		It("clears the response and keeps the expiry time", func() {
+			expiryTime := wcr.Status.ExpiryTime
+			reconciler.ReconcileRevoked(wcrCtx)
+			Expect(wcrCtx.WebConsoleRequest.Status.Response).To(BeEmpty())
+			Expect(wcrCtx.WebConsoleRequest.Status.ExpiryTime).To(Equal(expiryTime))
+
+			done, err := reconciler.ReconcileEarlyNormal(wcrCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+
+		It("records an event with the requester", func() {
+			reconciler.ReconcileRevoked(wcrCtx)
+			var event string
+			Expect(ctx.Events).To(Receive(&event))
+			Expect(event).To(HavePrefix("Normal Revoked"))
+			Expect(event).To(ContainSubstring(requester))
+		})
+
+		When("the request never acquired a ticket", func() {
+			BeforeEach(func() {
+				wcr.Status = vmopv1.VirtualMachineWebConsoleRequestStatus{}
+			})
+
+			It("expires immediately", func() {
+				reconciler.ReconcileRevoked(wcrCtx)
+				Expect(wcrCtx.WebConsoleRequest.Status.ExpiryTime.IsZero()).To(BeFalse())
+
+				done, err := reconciler.ReconcileEarlyNormal(wcrCtx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(done).To(BeTrue())
+				Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(wcr), &vmopv1.VirtualMachineWebConsoleRequest{})).ToNot(Succeed())
+			})
+		})
+	})
 }
@@ -246,7 +246,8 @@ function sv_update_deployment_image() {
     ip=${SV_VIP:-${SV_IPS[0]}}
 
     cmd="kubectl set image -n vmware-system-vmop deployment/vmware-system-vmop-controller-manager manager='$image' && \
-         kubectl set image -n vmware-system-vmop deployment/vmware-system-vmop-web-console-validator web-console-validator='$image'"
+         kubectl set image -n vmware-system-vmop deployment/vmware-system-vmop-web-console-validator web-console-validator='$image' && \
+         kubectl set image -n vmware-system-vmop deployment/vmware-system-vmop-serial-console-proxy serial-console-proxy='$image'"
 
     sv_cp_ssh_cmd "$ip" "$cmd"
 }
@@ -161,6 +161,15 @@ type Config struct {
 	// Please note, this field has no effect if a CRD is being installed for the
 	// first time.
 	CRDCleanupEnabled bool
+
+	// SerialConsoleAllowedNetworks is a comma-delimited list of IP addresses
+	// and networks in CIDR notation from which the serial console proxy
+	// connects to the serial consoles of VMs. The firewall of the ESXi hosts
+	// only allows connections to the serial consoles from these addresses.
+	//
+	// For information as to why this field is not a []string, please see the
+	// GoDocs for the Config type.
+	SerialConsoleAllowedNetworks string
 }
 
 // GetMaxDeployThreadsOnProvider returns MaxDeployThreadsOnProvider if it is >0
@@ -188,6 +197,9 @@ type FeatureStates struct {
 	BringYourOwnEncryptionKey   bool // FSS_WCP_VMSERVICE_BYOK
 	SVAsyncUpgrade              bool // FSS_WCP_SUPERVISOR_ASYNC_UPGRADE
 	FastDeploy                  bool // FSS_WCP_VMSERVICE_FAST_DEPLOY
+	VMNetworkPolicies           bool // FSS_WCP_VMSERVICE_NETWORK_POLICIES
+	VMSerialConsole             bool // FSS_WCP_VMSERVICE_SERIAL_CONSOLE
+	VMGuestFileTransferPVC      bool // FSS_WCP_VMSERVICE_GUEST_FILE_TRANSFER_PVC
 	MutableNetworks             bool
 	VMGroups                    bool
 	ImmutableClasses            bool
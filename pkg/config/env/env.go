@@ -51,6 +51,7 @@ const (
 	WebhookSecretName
 	WebhookSecretNamespace
 	CRDCleanupEnabled
+	SerialConsoleAllowedNetworks
 	FSSInstanceStorage
 	FSSK8sWorkloadMgmtAPI
 	FSSPodVMOnStretchedSupervisor
@@ -62,6 +63,9 @@ const (
 	FSSBringYourOwnEncryptionKey
 	FSSSVAsyncUpgrade
 	FSSFastDeploy
+	FSSVMNetworkPolicies
+	FSSVMSerialConsole
+	FSSVMGuestFileTransferPVC
 	_varNameEnd
 )
 
@@ -169,6 +173,8 @@ func (n VarName) String() string {
 		return "WEBHOOK_SECRET_NAMESPACE"
 	case CRDCleanupEnabled:
 		return "CRD_CLEANUP_ENABLED"
+	case SerialConsoleAllowedNetworks:
+		return "SERIAL_CONSOLE_ALLOWED_NETWORKS"
 
 	//
 	// Features/Capabilities
@@ -195,6 +201,12 @@ func (n VarName) String() string {
 		return "FSS_WCP_SUPERVISOR_ASYNC_UPGRADE"
 	case FSSFastDeploy:
 		return "FSS_WCP_VMSERVICE_FAST_DEPLOY"
+	case FSSVMNetworkPolicies:
+		return "FSS_WCP_VMSERVICE_NETWORK_POLICIES"
+	case FSSVMSerialConsole:
+		return "FSS_WCP_VMSERVICE_SERIAL_CONSOLE"
+	case FSSVMGuestFileTransferPVC:
+		return "FSS_WCP_VMSERVICE_GUEST_FILE_TRANSFER_PVC"
 	}
 	panic("unknown environment variable")
 }
@@ -104,6 +104,9 @@ var _ = Describe(
 					Expect(os.Setenv("FSS_WCP_VMSERVICE_BYOK", "true")).To(Succeed())
 					Expect(os.Setenv("FSS_WCP_SUPERVISOR_ASYNC_UPGRADE", "false")).To(Succeed())
 					Expect(os.Setenv("FSS_WCP_VMSERVICE_FAST_DEPLOY", "true")).To(Succeed())
+					Expect(os.Setenv("FSS_WCP_VMSERVICE_NETWORK_POLICIES", "true")).To(Succeed())
+					Expect(os.Setenv("FSS_WCP_VMSERVICE_SERIAL_CONSOLE", "true")).To(Succeed())
+					Expect(os.Setenv("FSS_WCP_VMSERVICE_GUEST_FILE_TRANSFER_PVC", "true")).To(Succeed())
 					Expect(os.Setenv("FSS_PODVMONSTRETCHEDSUPERVISOR", "false")).To(Succeed())
 					Expect(os.Setenv("CREATE_VM_REQUEUE_DELAY", "125h")).To(Succeed())
 					Expect(os.Setenv("POWERED_ON_VM_HAS_IP_REQUEUE_DELAY", "126h")).To(Succeed())
@@ -112,6 +115,7 @@ var _ = Describe(
 					Expect(os.Setenv("DEPLOYMENT_NAME", "129")).To(Succeed())
 					Expect(os.Setenv("SIGUSR2_RESTART_ENABLED", "true")).To(Succeed())
 					Expect(os.Setenv("CRD_CLEANUP_ENABLED", "true")).To(Succeed())
+					Expect(os.Setenv("SERIAL_CONSOLE_ALLOWED_NETWORKS", "130.0.0.0/24, 131.0.0.1")).To(Succeed())
 				})
 				It("Should return a default config overridden by the environment", func() {
 					Expect(config).To(BeComparableTo(pkgcfg.Config{
@@ -150,6 +154,7 @@ var _ = Describe(
 						WebhookSecretNamespace:       "124",
 						WebhookSecretVolumeMountPath: pkgcfg.Default().WebhookSecretVolumeMountPath,
 						CRDCleanupEnabled:            true,
+						SerialConsoleAllowedNetworks: "130.0.0.0/24,131.0.0.1",
 						Features: pkgcfg.FeatureStates{
 							InstanceStorage:           false,
 							K8sWorkloadMgmtAPI:        true,
@@ -161,6 +166,9 @@ var _ = Describe(
 							SVAsyncUpgrade:            false, // Capability gate so tested below
 							WorkloadDomainIsolation:   true,
 							FastDeploy:                true,
+							VMNetworkPolicies:         true,
+							VMSerialConsole:           true,
+							VMGuestFileTransferPVC:    true,
 						},
 						CreateVMRequeueDelay:         125 * time.Hour,
 						PoweredOnVMHasIPRequeueDelay: 126 * time.Hour,
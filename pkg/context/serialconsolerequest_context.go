@@ -0,0 +1,27 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// SerialConsoleRequestContext is the context used for
+// VirtualMachineSerialConsoleRequest reconciliation.
+type SerialConsoleRequestContext struct {
+	context.Context
+	Logger               logr.Logger
+	SerialConsoleRequest *vmopv1.VirtualMachineSerialConsoleRequest
+	VM                   *vmopv1.VirtualMachine
+}
+
+func (v *SerialConsoleRequestContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.SerialConsoleRequest.GroupVersionKind(), v.SerialConsoleRequest.Namespace, v.SerialConsoleRequest.Name)
+}
@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineDisruptionBudgetContext is the context used for
+// VirtualMachineDisruptionBudget reconciliation.
+type VirtualMachineDisruptionBudgetContext struct {
+	context.Context
+	Logger           logr.Logger
+	DisruptionBudget *vmopv1.VirtualMachineDisruptionBudget
+}
+
+func (v *VirtualMachineDisruptionBudgetContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.DisruptionBudget.GroupVersionKind(), v.DisruptionBudget.Namespace, v.DisruptionBudget.Name)
+}
@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineGroupSnapshotContext is the context used for
+// VirtualMachineGroupSnapshot reconciliation.
+type VirtualMachineGroupSnapshotContext struct {
+	context.Context
+	Logger        logr.Logger
+	GroupSnapshot *vmopv1.VirtualMachineGroupSnapshot
+}
+
+func (v *VirtualMachineGroupSnapshotContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.GroupSnapshot.GroupVersionKind(), v.GroupSnapshot.Namespace, v.GroupSnapshot.Name)
+}
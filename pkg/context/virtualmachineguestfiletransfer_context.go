@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineGuestFileTransferContext is the context used for
+// VirtualMachineGuestFileTransfer reconciliation.
+type VirtualMachineGuestFileTransferContext struct {
+	context.Context
+	Logger       logr.Logger
+	FileTransfer *vmopv1.VirtualMachineGuestFileTransfer
+}
+
+func (v *VirtualMachineGuestFileTransferContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.FileTransfer.GroupVersionKind(), v.FileTransfer.Namespace, v.FileTransfer.Name)
+}
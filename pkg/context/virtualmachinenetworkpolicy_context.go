@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineNetworkPolicyContext is the context used for
+// VirtualMachineNetworkPolicy reconciliation.
+type VirtualMachineNetworkPolicyContext struct {
+	context.Context
+	Logger        logr.Logger
+	NetworkPolicy *vmopv1.VirtualMachineNetworkPolicy
+}
+
+func (v *VirtualMachineNetworkPolicyContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.NetworkPolicy.GroupVersionKind(), v.NetworkPolicy.Namespace, v.NetworkPolicy.Name)
+}
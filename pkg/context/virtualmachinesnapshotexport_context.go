@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineSnapshotExportContext is the context used for
+// VirtualMachineSnapshotExport reconciliation.
+type VirtualMachineSnapshotExportContext struct {
+	context.Context
+	Logger         logr.Logger
+	SnapshotExport *vmopv1.VirtualMachineSnapshotExport
+}
+
+func (v *VirtualMachineSnapshotExportContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.SnapshotExport.GroupVersionKind(), v.SnapshotExport.Namespace, v.SnapshotExport.Name)
+}
@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineSnapshotImportContext is the context used for
+// VirtualMachineSnapshotImport reconciliation.
+type VirtualMachineSnapshotImportContext struct {
+	context.Context
+	Logger         logr.Logger
+	SnapshotImport *vmopv1.VirtualMachineSnapshotImport
+}
+
+func (v *VirtualMachineSnapshotImportContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.SnapshotImport.GroupVersionKind(), v.SnapshotImport.Namespace, v.SnapshotImport.Name)
+}
@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// VirtualMachineSnapshotScheduleContext is the context used for
+// VirtualMachineSnapshotSchedule reconciliation.
+type VirtualMachineSnapshotScheduleContext struct {
+	context.Context
+	Logger           logr.Logger
+	SnapshotSchedule *vmopv1.VirtualMachineSnapshotSchedule
+}
+
+func (v *VirtualMachineSnapshotScheduleContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.SnapshotSchedule.GroupVersionKind(), v.SnapshotSchedule.Namespace, v.SnapshotSchedule.Name)
+}
@@ -185,6 +185,17 @@ func Install( //nolint:gocyclo
 				k,
 				nil); err != nil {
 
+				return err
+			}
+		case "VirtualMachineGroupSnapshot":
+			if err := updateOrDeleteUnstructured(
+				ctx,
+				k8sClient,
+				features.VMGroups && features.VMSnapshots,
+				c,
+				k,
+				nil); err != nil {
+
 				return err
 			}
 		case "VirtualMachineImageCache":
@@ -201,6 +212,22 @@ func Install( //nolint:gocyclo
 		// case "VirtualMachineImage":
 		// case "VirtualMachinePublishRequest":
 		// case "VirtualMachineReplicaSet":
+		// case "VirtualMachineDeployment":
+		// case "VirtualMachineDisruptionBudget":
+		// case "VirtualMachineGuestFileTransfer":
+		// case "VirtualMachineIPAddressClaim":
+		// case "VirtualMachineIPPool":
+		case "VirtualMachineNetworkPolicy":
+			if err := updateOrDeleteUnstructured(
+				ctx,
+				k8sClient,
+				features.VMNetworkPolicies,
+				c,
+				k,
+				nil); err != nil {
+
+				return err
+			}
 		case "VirtualMachine":
 			if err := updateOrDeleteUnstructured(
 				ctx,
@@ -347,9 +374,23 @@ func Install( //nolint:gocyclo
 				return err
 			}
 
+		case "VirtualMachineSerialConsoleRequest":
+			if err := updateOrDeleteUnstructured(
+				ctx,
+				k8sClient,
+				features.VMSerialConsole,
+				c,
+				k,
+				nil); err != nil {
+
+				return err
+			}
 		// case "VirtualMachineService":
 		// case "VirtualMachineSetResourcePolicy":
-		case "VirtualMachineSnapshot":
+		case "VirtualMachineSnapshot",
+			"VirtualMachineSnapshotExport",
+			"VirtualMachineSnapshotImport",
+			"VirtualMachineSnapshotSchedule":
 			if err := updateOrDeleteUnstructured(
 				ctx,
 				k8sClient,
@@ -35,7 +35,12 @@ var (
 		"contentsources.vmoperator.vmware.com",
 		"virtualmachineclassbindings.vmoperator.vmware.com",
 		"virtualmachineclasses.vmoperator.vmware.com",
+		"virtualmachinedeployments.vmoperator.vmware.com",
+		"virtualmachinedisruptionbudgets.vmoperator.vmware.com",
+		"virtualmachineguestfiletransfers.vmoperator.vmware.com",
 		"virtualmachineimages.vmoperator.vmware.com",
+		"virtualmachineipaddressclaims.vmoperator.vmware.com",
+		"virtualmachineippools.vmoperator.vmware.com",
 		"virtualmachinepublishrequests.vmoperator.vmware.com",
 		"virtualmachinereplicasets.vmoperator.vmware.com",
 		"virtualmachines.vmoperator.vmware.com",
@@ -51,7 +56,14 @@ var (
 	}
 
 	basesSnapshots = []string{
+		"virtualmachinesnapshotexports.vmoperator.vmware.com",
+		"virtualmachinesnapshotimports.vmoperator.vmware.com",
 		"virtualmachinesnapshots.vmoperator.vmware.com",
+		"virtualmachinesnapshotschedules.vmoperator.vmware.com",
+	}
+
+	basesVMGroupsAndSnapshots = []string{
+		"virtualmachinegroupsnapshots.vmoperator.vmware.com",
 	}
 
 	basesFastDeploy = []string{
@@ -62,12 +74,23 @@ var (
 		"virtualmachineclassinstances.vmoperator.vmware.com",
 	}
 
+	basesVMNetworkPolicies = []string{
+		"virtualmachinenetworkpolicies.vmoperator.vmware.com",
+	}
+
+	basesVMSerialConsole = []string{
+		"virtualmachineserialconsolerequests.vmoperator.vmware.com",
+	}
+
 	basesAll = slices.Concat(
 		basesNonGated,
 		basesFastDeploy,
 		basesImmutableClasses,
 		basesSnapshots,
 		basesVMGroups,
+		basesVMGroupsAndSnapshots,
+		basesVMNetworkPolicies,
+		basesVMSerialConsole,
 	)
 
 	externalBYOK = []string{
@@ -544,6 +567,32 @@ var _ = Describe("Install", func() {
 			})
 		})
 
+		When("VM network policies are enabled", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.VMNetworkPolicies = true
+				})
+			})
+			It("should get the expected crds", func() {
+				var obj apiextensionsv1.CustomResourceDefinitionList
+				Expect(client.List(ctx, &obj)).To(Succeed())
+				assertCRDsConsistOf(obj.Items, slices.Concat(basesNonGated, basesVMNetworkPolicies)...)
+			})
+		})
+
+		When("VM serial console is enabled", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.VMSerialConsole = true
+				})
+			})
+			It("should get the expected crds", func() {
+				var obj apiextensionsv1.CustomResourceDefinitionList
+				Expect(client.List(ctx, &obj)).To(Succeed())
+				assertCRDsConsistOf(obj.Items, slices.Concat(basesNonGated, basesVMSerialConsole)...)
+			})
+		})
+
 		When("all features are enabled", func() {
 			BeforeEach(func() {
 				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
@@ -555,6 +604,8 @@ var _ = Describe("Install", func() {
 					config.Features.BringYourOwnEncryptionKey = true
 					config.Features.GuestCustomizationVCDParity = true
 					config.Features.VMExtraConfig = true
+					config.Features.VMNetworkPolicies = true
+					config.Features.VMSerialConsole = true
 				})
 			})
 			It("should get the expected crds", func() {
@@ -597,6 +648,8 @@ var _ = Describe("Install", func() {
 						VMSnapshots:               true,
 						VSpherePolicies:           true,
 						BringYourOwnEncryptionKey: true,
+						VMNetworkPolicies:         true,
+						VMSerialConsole:           true,
 					},
 				}),
 				client,
@@ -0,0 +1,143 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package guestfiletransfer implements the helper that runs in the Pod that
+// mounts the PersistentVolumeClaim of a VirtualMachineGuestFileTransfer. The
+// controller runs the helper's commands with the pods/exec subresource to
+// read and write the file in the claim's volume.
+package guestfiletransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// HelperPath is the path of the helper binary in the VM Operator image.
+	HelperPath = "/guest-file-transfer-helper"
+
+	// CommandWait waits until the helper is terminated. It is the command
+	// of the helper Pod's container.
+	CommandWait = "wait"
+
+	// CommandSize writes the size of a file to stdout.
+	CommandSize = "size"
+
+	// CommandRead copies a file to stdout.
+	CommandRead = "read"
+
+	// CommandWrite replaces a file with the content read from stdin,
+	// creating the file's parent directories if they do not exist.
+	CommandWrite = "write"
+
+	// ExitCodeNotFound is the exit code of the helper when the file of a size
+	// or read command does not exist.
+	ExitCodeNotFound = 2
+)
+
+// ErrNotFound is returned by Run when the file of a size or read command does
+// not exist.
+var ErrNotFound = errors.New("file not found")
+
+// Run runs the helper command in args, which are the helper's arguments
+// without the program name. Run blocks until ctx is done for the wait
+// command.
+func Run(
+	ctx context.Context,
+	args []string,
+	stdin io.Reader,
+	stdout io.Writer) error {
+
+	if len(args) == 0 {
+		return fmt.Errorf("a command is required")
+	}
+
+	command, args := args[0], args[1:]
+
+	if command == CommandWait {
+		<-ctx.Done()
+		return nil
+	}
+
+	if len(args) != 1 {
+		return fmt.Errorf("the %s command requires a path", command)
+	}
+	filePath := args[0]
+
+	switch command {
+	case CommandSize:
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return wrapNotFound(err)
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s is not a regular file", filePath)
+		}
+		_, err = fmt.Fprintln(stdout, strconv.FormatInt(info.Size(), 10))
+		return err
+
+	case CommandRead:
+		f, err := os.Open(filePath)
+		if err != nil {
+			return wrapNotFound(err)
+		}
+		defer f.Close()
+		_, err = io.Copy(stdout, f)
+		return err
+
+	case CommandWrite:
+		return writeFile(filePath, stdin)
+	}
+
+	return fmt.Errorf("unknown command %q", command)
+}
+
+// writeFile writes the content read from r to a temporary file in the same
+// directory as filePath and renames it to filePath, so the file is replaced
+// only once all of the content has been written.
+func writeFile(filePath string, r io.Reader) (retErr error) {
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, "."+filepath.Base(filePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filePath)
+}
+
+func wrapNotFound(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
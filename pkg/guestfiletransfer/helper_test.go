@@ -0,0 +1,107 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package guestfiletransfer_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/guestfiletransfer"
+)
+
+func helperUnitTests() {
+	const fileData = "-----BEGIN CERTIFICATE-----"
+
+	var (
+		ctx    context.Context
+		dir    string
+		stdout *bytes.Buffer
+	)
+
+	run := func(stdin string, args ...string) error {
+		return guestfiletransfer.Run(ctx, args, strings.NewReader(stdin), stdout)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		stdout = &bytes.Buffer{}
+
+		Expect(os.WriteFile(filepath.Join(dir, "file"), []byte(fileData), 0o600)).To(Succeed())
+	})
+
+	It("returns an error without a command", func() {
+		Expect(run("")).To(MatchError("a command is required"))
+	})
+
+	It("returns an error for an unknown command", func() {
+		Expect(run("", "delete", filepath.Join(dir, "file"))).To(MatchError(`unknown command "delete"`))
+	})
+
+	It("returns an error without a path", func() {
+		Expect(run("", guestfiletransfer.CommandRead)).To(MatchError("the read command requires a path"))
+	})
+
+	Context("wait", func() {
+		It("returns when the context is done", func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			cancel()
+			Expect(run("", guestfiletransfer.CommandWait)).To(Succeed())
+		})
+	})
+
+	Context("size", func() {
+		It("writes the size of the file", func() {
+			Expect(run("", guestfiletransfer.CommandSize, filepath.Join(dir, "file"))).To(Succeed())
+			Expect(stdout.String()).To(Equal("27\n"))
+		})
+
+		It("returns ErrNotFound if the file does not exist", func() {
+			err := run("", guestfiletransfer.CommandSize, filepath.Join(dir, "missing"))
+			Expect(err).To(MatchError(guestfiletransfer.ErrNotFound))
+		})
+
+		It("returns an error if the path is a directory", func() {
+			Expect(run("", guestfiletransfer.CommandSize, dir)).To(MatchError(ContainSubstring("is not a regular file")))
+		})
+	})
+
+	Context("read", func() {
+		It("writes the content of the file", func() {
+			Expect(run("", guestfiletransfer.CommandRead, filepath.Join(dir, "file"))).To(Succeed())
+			Expect(stdout.String()).To(Equal(fileData))
+		})
+
+		It("returns ErrNotFound if the file does not exist", func() {
+			err := run("", guestfiletransfer.CommandRead, filepath.Join(dir, "missing"))
+			Expect(err).To(MatchError(guestfiletransfer.ErrNotFound))
+		})
+	})
+
+	Context("write", func() {
+		It("replaces the file", func() {
+			filePath := filepath.Join(dir, "file")
+			Expect(run("new data", guestfiletransfer.CommandWrite, filePath)).To(Succeed())
+			Expect(os.ReadFile(filePath)).To(BeEquivalentTo("new data"))
+
+			entries, err := os.ReadDir(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+		})
+
+		It("creates the parent directories of the file", func() {
+			filePath := filepath.Join(dir, "certs", "ca", "ca.crt")
+			Expect(run(fileData, guestfiletransfer.CommandWrite, filePath)).To(Succeed())
+			Expect(os.ReadFile(filePath)).To(BeEquivalentTo(fileData))
+		})
+	})
+}
@@ -10,6 +10,7 @@ import (
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 )
@@ -21,5 +22,11 @@ func InitializeProviders(
 	vmProviderName := fmt.Sprintf("%s/%s/vmProvider", ctx.Namespace, ctx.Name)
 	recorder := record.New(mgr.GetEventRecorderFor(vmProviderName))
 	ctx.VMProvider = vsphere.NewVSphereVMProviderFromClient(ctx, mgr.GetClient(), recorder)
+
+	metrics.RegisterVMResourceUsageCollector(
+		ctx,
+		ctx.Logger.WithName("vm-resource-usage"),
+		ctx.VMProvider.GetVirtualMachinesResourceUsage)
+
 	return nil
 }
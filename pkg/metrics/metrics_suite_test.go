@@ -0,0 +1,27 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var suite = builder.NewTestSuite()
+
+var _ = BeforeSuite(suite.BeforeSuite)
+
+var _ = AfterSuite(suite.AfterSuite)
+
+func TestMetrics(t *testing.T) {
+	suite.Register(t, "metrics test suite", nil, unitTests)
+}
+
+func unitTests() {
+	Describe("VMResourceUsageCollector", vmResourceUsageCollectorTests)
+}
@@ -0,0 +1,149 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+const (
+	// VMResourceUsageRefreshInterval is how often the resource usage of the
+	// VMs is refreshed in the background.
+	VMResourceUsageRefreshInterval = 15 * time.Second
+
+	// VMResourceUsageTimeout is how long refreshing the resource usage of the
+	// VMs may take before the refresh is abandoned.
+	VMResourceUsageTimeout = 10 * time.Second
+)
+
+var vmResourceUsageCollectorOnce sync.Once
+
+// VMResourceUsageFunc returns the current resource usage of the powered on
+// VMs.
+type VMResourceUsageFunc func(context.Context) ([]providers.VirtualMachineResourceUsage, error)
+
+// RegisterVMResourceUsageCollector registers a collector for the CPU and memory
+// usage of VMs with the controller-runtime metrics registry once.
+func RegisterVMResourceUsageCollector(
+	ctx context.Context,
+	logger logr.Logger,
+	getUsage VMResourceUsageFunc) {
+
+	vmResourceUsageCollectorOnce.Do(func() {
+		metrics.Registry.MustRegister(NewVMResourceUsageCollector(
+			ctx, logger, getUsage, VMResourceUsageRefreshInterval))
+	})
+}
+
+// NewVMResourceUsageCollector returns a collector for the CPU and memory usage
+// of the VMs. The usage is refreshed in the background every refreshInterval
+// until the context is done, so scraping the metrics never queries vSphere.
+// The metrics are labeled with the name of the VirtualMachineReplicaSet that
+// owns the VM, if any, so a metrics adapter may aggregate them per replica
+// set.
+func NewVMResourceUsageCollector(
+	ctx context.Context,
+	logger logr.Logger,
+	getUsage VMResourceUsageFunc,
+	refreshInterval time.Duration) prometheus.Collector {
+
+	labels := []string{vmNameLabel, vmNamespaceLabel, replicaSetNameLabel}
+
+	c := &vmResourceUsageCollector{
+		logger:   logger,
+		getUsage: getUsage,
+		cpuUsage: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "vm_cpu_usage_mhz"),
+			"CPU usage of a VM resource in MHz as reported by the hypervisor",
+			labels, nil),
+		memoryUsage: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "vm_memory_usage_bytes"),
+			"Guest memory usage of a VM resource in bytes as reported by the hypervisor",
+			labels, nil),
+	}
+
+	go c.run(ctx, refreshInterval)
+
+	return c
+}
+
+type vmResourceUsageCollector struct {
+	logger      logr.Logger
+	getUsage    VMResourceUsageFunc
+	cpuUsage    *prometheus.Desc
+	memoryUsage *prometheus.Desc
+
+	mu    sync.RWMutex
+	usage []providers.VirtualMachineResourceUsage
+}
+
+// run refreshes the resource usage of the VMs every interval until the
+// context is done.
+func (c *vmResourceUsageCollector) run(
+	ctx context.Context,
+	interval time.Duration) {
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		c.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (c *vmResourceUsageCollector) refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, VMResourceUsageTimeout)
+	defer cancel()
+
+	// A failure is logged rather than reported to the registry so the other
+	// metrics are still scraped. The previous usage is dropped so a
+	// HorizontalPodAutoscaler never scales on stale values.
+	usage, err := c.getUsage(ctx)
+	if err != nil {
+		c.logger.Error(err, "Failed to get VM resource usage")
+		usage = nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.usage = usage
+}
+
+func (c *vmResourceUsageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cpuUsage
+	ch <- c.memoryUsage
+}
+
+func (c *vmResourceUsageCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, u := range c.usage {
+		labels := []string{
+			u.VM.Name,
+			u.VM.Namespace,
+			u.VM.Labels[vmopv1.VirtualMachineReplicaSetNameLabel],
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.cpuUsage, prometheus.GaugeValue, float64(u.CPUUsageMHz), labels...)
+		ch <- prometheus.MustNewConstMetric(
+			c.memoryUsage, prometheus.GaugeValue, float64(u.MemoryUsageBytes), labels...)
+	}
+}
@@ -0,0 +1,153 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+)
+
+func vmResourceUsageCollectorTests() {
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		mu        sync.Mutex
+		usage     []providers.VirtualMachineResourceUsage
+		usageErr  error
+		calls     int
+		collector prometheus.Collector
+	)
+
+	setUsage := func(fn func()) {
+		mu.Lock()
+		defer mu.Unlock()
+		fn()
+	}
+
+	getCalls := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+
+	BeforeEach(func() {
+		calls = 0
+		usageErr = nil
+		usage = []providers.VirtualMachineResourceUsage{
+			{
+				VM: &vmopv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-namespace",
+						Name:      "my-vm-1",
+						Labels: map[string]string{
+							vmopv1.VirtualMachineReplicaSetNameLabel: "my-replicaset",
+						},
+					},
+				},
+				CPUUsageMHz:      1500,
+				MemoryUsageBytes: 512 * 1024 * 1024,
+			},
+			{
+				VM: &vmopv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-namespace",
+						Name:      "my-vm-2",
+					},
+				},
+				CPUUsageMHz:      100,
+				MemoryUsageBytes: 1024,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		collector = metrics.NewVMResourceUsageCollector(
+			ctx,
+			logr.Discard(),
+			func(ctx context.Context) ([]providers.VirtualMachineResourceUsage, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				_, ok := ctx.Deadline()
+				Expect(ok).To(BeTrue())
+				return slices.Clone(usage), usageErr
+			},
+			10*time.Millisecond)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("collects the resource usage of the VMs refreshed in the background", func() {
+		Eventually(getCalls).Should(BeNumerically(">=", 1))
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP vmservice_vm_cpu_usage_mhz CPU usage of a VM resource in MHz as reported by the hypervisor
+# TYPE vmservice_vm_cpu_usage_mhz gauge
+vmservice_vm_cpu_usage_mhz{replicaset_name="my-replicaset",vm_name="my-vm-1",vm_namespace="my-namespace"} 1500
+vmservice_vm_cpu_usage_mhz{replicaset_name="",vm_name="my-vm-2",vm_namespace="my-namespace"} 100
+# HELP vmservice_vm_memory_usage_bytes Guest memory usage of a VM resource in bytes as reported by the hypervisor
+# TYPE vmservice_vm_memory_usage_bytes gauge
+vmservice_vm_memory_usage_bytes{replicaset_name="my-replicaset",vm_name="my-vm-1",vm_namespace="my-namespace"} 5.36870912e+08
+vmservice_vm_memory_usage_bytes{replicaset_name="",vm_name="my-vm-2",vm_namespace="my-namespace"} 1024
+`))).To(Succeed())
+	})
+
+	It("serves the refreshed resource usage", func() {
+		Eventually(func() int { return testutil.CollectAndCount(collector) }).Should(Equal(4))
+
+		setUsage(func() {
+			usage = []providers.VirtualMachineResourceUsage{usage[0]}
+			usage[0].CPUUsageMHz = 3000
+		})
+
+		Eventually(func() error {
+			return testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP vmservice_vm_cpu_usage_mhz CPU usage of a VM resource in MHz as reported by the hypervisor
+# TYPE vmservice_vm_cpu_usage_mhz gauge
+vmservice_vm_cpu_usage_mhz{replicaset_name="my-replicaset",vm_name="my-vm-1",vm_namespace="my-namespace"} 3000
+`), "vmservice_vm_cpu_usage_mhz")
+		}).Should(Succeed())
+	})
+
+	It("does not get the resource usage when scraped", func() {
+		Eventually(getCalls).Should(BeNumerically(">=", 1))
+		cancel()
+
+		n := getCalls()
+		Consistently(func() int {
+			testutil.CollectAndCount(collector)
+			return getCalls()
+		}, 50*time.Millisecond).Should(BeNumerically("<=", n+1))
+	})
+
+	When("the resource usage cannot be retrieved", func() {
+		BeforeEach(func() {
+			usageErr = errors.New("fubar")
+		})
+
+		It("does not collect any metrics", func() {
+			Eventually(getCalls).Should(BeNumerically(">=", 1))
+			Expect(testutil.CollectAndCount(collector)).To(BeZero())
+		})
+	})
+}
@@ -0,0 +1,65 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// WebConsoleValidationResult is the result of validating a connection to a
+// VM's web console.
+type WebConsoleValidationResult string
+
+const (
+	WebConsoleValidationAllowed WebConsoleValidationResult = "allowed"
+	WebConsoleValidationDenied  WebConsoleValidationResult = "denied"
+	WebConsoleValidationError   WebConsoleValidationResult = "error"
+)
+
+var (
+	webConsoleMetricsOnce sync.Once
+	webConsoleMetrics     *WebConsoleMetrics
+)
+
+type WebConsoleMetrics struct {
+	validation *prometheus.CounterVec
+}
+
+// NewWebConsoleMetrics initializes a singleton and registers all the defined
+// metrics.
+func NewWebConsoleMetrics() *WebConsoleMetrics {
+	webConsoleMetricsOnce.Do(func() {
+		webConsoleMetrics = &WebConsoleMetrics{
+			validation: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "webconsole",
+				Name:      "validation_total",
+				Help:      "Total number of web console connection validations by result",
+			}, []string{
+				"namespace",
+				"result",
+			}),
+		}
+
+		metrics.Registry.MustRegister(
+			webConsoleMetrics.validation,
+		)
+	})
+
+	return webConsoleMetrics
+}
+
+// RecordValidation increments the number of web console connection
+// validations in the namespace with the given result.
+func (m *WebConsoleMetrics) RecordValidation(ns string, result WebConsoleValidationResult) {
+	m.validation.With(prometheus.Labels{
+		"namespace": ns,
+		"result":    string(result),
+	}).Inc()
+}
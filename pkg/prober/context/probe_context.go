@@ -21,6 +21,18 @@ type ProbeContext struct {
 	VM            *vmopv1.VirtualMachine
 	ProbeType     string
 	PeriodSeconds int32
+
+	// ProbeSpec is the spec of the probe being run. If nil, the VM's
+	// readiness probe is run.
+	ProbeSpec *vmopv1.VirtualMachineReadinessProbeSpec
+}
+
+// GetProbeSpec returns the spec of the probe being run.
+func (p *ProbeContext) GetProbeSpec() *vmopv1.VirtualMachineReadinessProbeSpec {
+	if p.ProbeSpec != nil {
+		return p.ProbeSpec
+	}
+	return p.VM.Spec.ReadinessProbe
 }
 
 // String returns probe type.
@@ -0,0 +1,144 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+type execProber struct {
+	client ctrlclient.Reader
+	prober vmProviderGuestProgramProber
+}
+
+// NewExecProber creates a probe that runs a command in the guest using
+// VMware Tools guest operations.
+func NewExecProber(client ctrlclient.Reader, prober vmProviderGuestProgramProber) Probe {
+	return &execProber{
+		client: client,
+		prober: prober,
+	}
+}
+
+func (ep execProber) Probe(ctx *proberctx.ProbeContext) (Result, error) {
+	p := ctx.GetProbeSpec()
+	exec := p.Exec
+	if exec == nil || len(exec.Command) == 0 {
+		return Unknown, nil
+	}
+
+	auth, err := pkgutil.GetGuestCredentials(ctx, ep.client, ctx.VM.Namespace, exec.SecretName)
+	if err != nil {
+		return Unknown, err
+	}
+
+	spec := vimtypes.GuestProgramSpec{
+		ProgramPath:      exec.Command[0],
+		Arguments:        guestProgramArguments(exec.Command[1:], isWindowsGuest(ctx.VM)),
+		WorkingDirectory: exec.WorkingDirectory,
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, getTimeout(p))
+	defer cancel()
+
+	exitCode, err := ep.prober.RunVirtualMachineGuestProgram(timeoutCtx, ctx.VM, auth, spec)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return Failure, fmt.Errorf("command %q timed out", exec.Command[0])
+		}
+		return Unknown, err
+	}
+
+	if exitCode != 0 {
+		return Failure, fmt.Errorf("command %q exited with code %d", exec.Command[0], exitCode)
+	}
+
+	return Success, nil
+}
+
+// isWindowsGuest returns true if the VM's guest is, or is expected to be,
+// Windows.
+func isWindowsGuest(vm *vmopv1.VirtualMachine) bool {
+	if vm.Status.Guest != nil && vm.Status.Guest.GuestID != "" {
+		return strings.HasPrefix(vm.Status.Guest.GuestID, "win")
+	}
+	return strings.HasPrefix(vm.Spec.GuestID, "win") ||
+		(vm.Spec.Bootstrap != nil && vm.Spec.Bootstrap.Sysprep != nil)
+}
+
+// guestProgramArguments returns the arguments as a single command line that
+// the guest splits back into the original arguments. VMware Tools passes the
+// arguments to a POSIX shell on Linux guests and to CreateProcess on Windows
+// guests, so each argument is quoted according to the guest's rules.
+func guestProgramArguments(args []string, windows bool) string {
+	quoted := make([]string, len(args))
+	for i := range args {
+		if windows {
+			quoted[i] = quoteWindowsArg(args[i])
+		} else {
+			quoted[i] = quotePOSIXArg(args[i])
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quotePOSIXArg quotes s for a POSIX shell. Arguments that contain only
+// characters that are never special to the shell are returned as-is.
+func quotePOSIXArg(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' ||
+			r >= '0' && r <= '9' || strings.ContainsRune("@%+=:,./_-", r))
+	}) == -1 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// quoteWindowsArg quotes s so that CommandLineToArgvW parses it back into s.
+func quoteWindowsArg(s string) string {
+	if s == "" {
+		return `""`
+	}
+	if !strings.ContainsAny(s, " \t\n\v\"") {
+		return s
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	slashes := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			slashes++
+		case '"':
+			// Backslashes that precede a quote are escaped, and then so is
+			// the quote itself.
+			b.WriteString(strings.Repeat(`\`, 2*slashes+1))
+			b.WriteByte(c)
+			slashes = 0
+		default:
+			b.WriteString(strings.Repeat(`\`, slashes))
+			b.WriteByte(c)
+			slashes = 0
+		}
+	}
+	// Backslashes that precede the closing quote are escaped.
+	b.WriteString(strings.Repeat(`\`, 2*slashes))
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,207 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+type fakeGuestProgramProvider struct {
+	auth     vimtypes.NamePasswordAuthentication
+	spec     vimtypes.GuestProgramSpec
+	exitCode int32
+	err      error
+}
+
+func (f *fakeGuestProgramProvider) RunVirtualMachineGuestProgram(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	spec vimtypes.GuestProgramSpec) (int32, error) {
+
+	f.auth = auth
+	f.spec = spec
+	return f.exitCode, f.err
+}
+
+var _ = Describe("Exec probe", func() {
+	var (
+		fakeProvider *fakeGuestProgramProvider
+		initObjects  []ctrlclient.Object
+		execAction   *vmopv1.ExecAction
+		guestID      string
+
+		err error
+		res Result
+	)
+
+	BeforeEach(func() {
+		fakeProvider = &fakeGuestProgramProvider{}
+		initObjects = []ctrlclient.Object{
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "guest-creds",
+					Namespace: "dummy-ns",
+				},
+				Data: map[string][]byte{
+					"username": []byte("root"),
+					"password": []byte("secret"),
+				},
+			},
+		}
+		guestID = ""
+		execAction = &vmopv1.ExecAction{
+			Command:          []string{"/usr/local/bin/check-ready", "--quiet", "--port=80"},
+			WorkingDirectory: "/tmp",
+			SecretName:       "guest-creds",
+		}
+	})
+
+	JustBeforeEach(func() {
+		prober := NewExecProber(builder.NewFakeClient(initObjects...), fakeProvider)
+
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-vm",
+				Namespace: "dummy-ns",
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				GuestID: guestID,
+				ReadinessProbe: &vmopv1.VirtualMachineReadinessProbeSpec{
+					Exec: execAction,
+				},
+			},
+		}
+
+		probeCtx := &proberctx.ProbeContext{
+			Context: context.Background(),
+			Logger:  ctrl.Log.WithName("Probe").WithValues("name", vm.NamespacedName()),
+			VM:      vm,
+		}
+
+		res, err = prober.Probe(probeCtx)
+	})
+
+	When("the command exits with a zero status code", func() {
+		It("returns Success", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(Success))
+
+			Expect(fakeProvider.auth.Username).To(Equal("root"))
+			Expect(fakeProvider.auth.Password).To(Equal("secret"))
+			Expect(fakeProvider.spec.ProgramPath).To(Equal("/usr/local/bin/check-ready"))
+			Expect(fakeProvider.spec.Arguments).To(Equal("--quiet --port=80"))
+			Expect(fakeProvider.spec.WorkingDirectory).To(Equal("/tmp"))
+		})
+	})
+
+	When("the arguments contain spaces and quotes", func() {
+		BeforeEach(func() {
+			execAction.Command = []string{
+				"/usr/local/bin/check-ready", "--name", "my app", "it's", "",
+			}
+		})
+
+		It("quotes each argument for a POSIX shell", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeProvider.spec.Arguments).To(Equal(`--name 'my app' 'it'\''s' ''`))
+		})
+
+		When("the guest is Windows", func() {
+			BeforeEach(func() {
+				guestID = "windows2019srv_64Guest"
+				execAction.Command = []string{
+					`C:\check.exe`, "--name", "my app", `say "hi"`, `C:\dir with space\`, "",
+				}
+			})
+
+			It("quotes each argument for CommandLineToArgvW", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeProvider.spec.Arguments).To(Equal(
+					`--name "my app" "say \"hi\"" "C:\dir with space\\" ""`))
+			})
+		})
+	})
+
+	When("the command exits with a non-zero status code", func() {
+		BeforeEach(func() {
+			fakeProvider.exitCode = 1
+		})
+
+		It("returns Failure", func() {
+			Expect(err).To(MatchError(ContainSubstring("exited with code 1")))
+			Expect(res).To(Equal(Failure))
+		})
+	})
+
+	When("the command times out", func() {
+		BeforeEach(func() {
+			fakeProvider.err = fmt.Errorf("wrapped: %w", context.DeadlineExceeded)
+		})
+
+		It("returns Failure", func() {
+			Expect(err).To(MatchError(ContainSubstring("timed out")))
+			Expect(res).To(Equal(Failure))
+		})
+	})
+
+	When("the provider returns an error", func() {
+		BeforeEach(func() {
+			fakeProvider.err = fmt.Errorf("fake error")
+		})
+
+		It("returns Unknown", func() {
+			Expect(err).To(MatchError("fake error"))
+			Expect(res).To(Equal(Unknown))
+		})
+	})
+
+	When("the secret does not exist", func() {
+		BeforeEach(func() {
+			initObjects = nil
+		})
+
+		It("returns Unknown", func() {
+			Expect(err).To(MatchError(ContainSubstring("failed to get guest credentials")))
+			Expect(res).To(Equal(Unknown))
+		})
+	})
+
+	When("the secret is missing the password", func() {
+		BeforeEach(func() {
+			delete(initObjects[0].(*corev1.Secret).Data, "password")
+		})
+
+		It("returns Unknown", func() {
+			Expect(err).To(MatchError(ContainSubstring(`must contain the keys "username" and "password"`)))
+			Expect(res).To(Equal(Unknown))
+		})
+	})
+
+	When("there is no exec action", func() {
+		BeforeEach(func() {
+			execAction = nil
+		})
+
+		It("returns Unknown", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(Unknown))
+		})
+	})
+})
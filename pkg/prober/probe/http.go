@@ -0,0 +1,156 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
+)
+
+const (
+	// maxRespBodyLength is the maximum number of bytes read from the response
+	// body before the connection is closed.
+	maxRespBodyLength = 10 * 1 << 10
+)
+
+// httpProber implements the Probe interface.
+type httpProber struct {
+	transport *http.Transport
+}
+
+// NewHTTPProber creates a new http prober which implements the Probe interface to execute http probes.
+func NewHTTPProber() Probe {
+	return &httpProber{
+		transport: &http.Transport{
+			// The probe is used to determine if the workload in the VM is
+			// ready, not whether its certificate is trusted.
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true, //nolint:gosec
+			},
+			DisableKeepAlives: true,
+		},
+	}
+}
+
+func (pr httpProber) Probe(ctx *proberctx.ProbeContext) (Result, error) {
+	vm := ctx.VM
+	p := ctx.GetProbeSpec()
+
+	portProto := corev1.ProtocolTCP
+	portNum, err := findPort(vm, p.HTTPGet.Port, portProto)
+	if err != nil {
+		return Failure, err
+	}
+
+	host := p.HTTPGet.Host
+	if host == "" {
+		ctx.Logger.V(4).Info("HTTPGet Host not specified, using VM IP", "probe", ctx.String())
+		if host, err = findIP(vm); err != nil {
+			return Failure, err
+		}
+	}
+
+	req, err := newHTTPGetRequest(ctx, p.HTTPGet, host, portNum)
+	if err != nil {
+		return Failure, err
+	}
+
+	client := &http.Client{
+		Timeout:   getTimeout(p),
+		Transport: pr.transport,
+		// Redirects are not followed so the status of the probed endpoint
+		// itself is compared against the expected statuses.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return Failure, err
+	}
+	defer res.Body.Close()
+
+	// Drain a bounded amount of the body so the request completes cleanly.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxRespBodyLength))
+
+	if !isExpectedStatus(p.HTTPGet.ExpectedStatuses, res.StatusCode) {
+		return Failure, fmt.Errorf("HTTP probe failed with statuscode: %d", res.StatusCode)
+	}
+
+	return Success, nil
+}
+
+func newHTTPGetRequest(
+	ctx context.Context,
+	action *vmopv1.HTTPGetAction,
+	host string,
+	port int) (*http.Request, error) {
+
+	scheme := strings.ToLower(string(action.Scheme))
+	if scheme == "" {
+		scheme = "http"
+	}
+
+	path := action.Path
+	if path == "" {
+		path = "/"
+	} else if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s://%s%s",
+		scheme, net.JoinHostPort(host, strconv.Itoa(port)), path))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", "vm-operator-probe")
+	req.Header.Set("Accept", "*/*")
+	for _, h := range action.HTTPHeaders {
+		if http.CanonicalHeaderKey(h.Name) == "Host" {
+			req.Host = h.Value
+			continue
+		}
+		req.Header.Add(h.Name, h.Value)
+	}
+
+	return req, nil
+}
+
+func isExpectedStatus(expected []vmopv1.HTTPStatusRange, code int) bool {
+	if len(expected) == 0 {
+		return code >= http.StatusOK && code < http.StatusBadRequest
+	}
+
+	for _, r := range expected {
+		maxCode := r.Max
+		if maxCode == 0 {
+			maxCode = r.Min
+		}
+		if code >= int(r.Min) && code <= int(maxCode) {
+			return true
+		}
+	}
+
+	return false
+}
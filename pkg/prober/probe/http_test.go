@@ -0,0 +1,198 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
+)
+
+var _ = Describe("HTTP probe", func() {
+	var (
+		vm            *vmopv1.VirtualMachine
+		testHTTPProbe Probe
+
+		testServer  *httptest.Server
+		testHost    string
+		testPort    int
+		lastRequest *http.Request
+		statusCode  int
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-vm",
+				Namespace: "dummy-ns",
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				ClassName: "dummy-vmclass",
+			},
+			Status: vmopv1.VirtualMachineStatus{
+				Network: &vmopv1.VirtualMachineNetworkStatus{},
+			},
+		}
+
+		statusCode = http.StatusOK
+		testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lastRequest = r
+			w.WriteHeader(statusCode)
+		}))
+		host, port, err := net.SplitHostPort(testServer.Listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		testHost = host
+		testPort, err = strconv.Atoi(port)
+		Expect(err).NotTo(HaveOccurred())
+
+		testHTTPProbe = NewHTTPProber()
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+		lastRequest = nil
+	})
+
+	var probeCtx context.Context
+
+	BeforeEach(func() {
+		probeCtx = context.Background()
+	})
+
+	newProbeContext := func() *proberctx.ProbeContext {
+		return &proberctx.ProbeContext{
+			Context: probeCtx,
+			VM:      vm,
+			Logger:  ctrl.Log.WithName("Probe").WithValues("name", vm.NamespacedName()),
+		}
+	}
+
+	It("HTTP probe succeeds, with HTTP host set in VM spec", func() {
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res).To(Equal(Success))
+		Expect(lastRequest).ToNot(BeNil())
+		Expect(lastRequest.URL.Path).To(Equal("/"))
+	})
+
+	It("HTTP probe succeeds, with empty HTTP host", func() {
+		vm.Status.Network.PrimaryIP4 = testHost
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe("", testPort)
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res).To(Equal(Success))
+	})
+
+	It("HTTP probe fails, with empty HTTP host and no VM IP", func() {
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe("", testPort)
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).Should(HaveOccurred())
+		Expect(res).To(Equal(Failure))
+	})
+
+	It("HTTP probe sends the path and headers", func() {
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+		vm.Spec.ReadinessProbe.HTTPGet.Path = "/healthz"
+		vm.Spec.ReadinessProbe.HTTPGet.HTTPHeaders = []vmopv1.HTTPHeader{
+			{Name: "X-Probe", Value: "ready"},
+			{Name: "host", Value: "example.com"},
+		}
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res).To(Equal(Success))
+		Expect(lastRequest).ToNot(BeNil())
+		Expect(lastRequest.URL.Path).To(Equal("/healthz"))
+		Expect(lastRequest.Header.Get("X-Probe")).To(Equal("ready"))
+		Expect(lastRequest.Host).To(Equal("example.com"))
+	})
+
+	It("HTTP probe fails when the status code is not expected", func() {
+		statusCode = http.StatusServiceUnavailable
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).Should(HaveOccurred())
+		Expect(res).To(Equal(Failure))
+	})
+
+	When("expected statuses are specified", func() {
+		BeforeEach(func() {
+			statusCode = http.StatusNoContent
+		})
+
+		It("HTTP probe fails when the status code is not in a range", func() {
+			vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+			vm.Spec.ReadinessProbe.HTTPGet.ExpectedStatuses = []vmopv1.HTTPStatusRange{
+				{Min: http.StatusOK},
+			}
+
+			res, err := testHTTPProbe.Probe(newProbeContext())
+			Expect(err).Should(HaveOccurred())
+			Expect(res).To(Equal(Failure))
+		})
+
+		It("HTTP probe succeeds when the status code is in a range", func() {
+			vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+			vm.Spec.ReadinessProbe.HTTPGet.ExpectedStatuses = []vmopv1.HTTPStatusRange{
+				{Min: http.StatusOK},
+				{Min: http.StatusCreated, Max: http.StatusNoContent},
+			}
+
+			res, err := testHTTPProbe.Probe(newProbeContext())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res).To(Equal(Success))
+		})
+	})
+
+	It("HTTP probe fails when the context is canceled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		probeCtx = ctx
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(res).To(Equal(Failure))
+		Expect(lastRequest).To(BeNil())
+	})
+
+	It("HTTP probe fails when the connection fails", func() {
+		// Close the server so nothing is listening on its port.
+		testServer.Close()
+		vm.Spec.ReadinessProbe = getVirtualMachineReadinessHTTPProbe(testHost, testPort)
+
+		res, err := testHTTPProbe.Probe(newProbeContext())
+		Expect(err).Should(HaveOccurred())
+		Expect(res).To(Equal(Failure))
+	})
+})
+
+func getVirtualMachineReadinessHTTPProbe(host string, port int) *vmopv1.VirtualMachineReadinessProbeSpec {
+	return &vmopv1.VirtualMachineReadinessProbeSpec{
+		HTTPGet: &vmopv1.HTTPGetAction{
+			Host: host,
+			Port: intstr.FromInt(port),
+		},
+		PeriodSeconds: 1,
+	}
+}
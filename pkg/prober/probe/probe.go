@@ -8,6 +8,9 @@ import (
 	"context"
 	"time"
 
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
 )
@@ -37,23 +40,31 @@ type vmProviderGuestHeartbeatProber interface {
 type vmProviderGuestInfoProber interface {
 	GetVirtualMachineProperties(ctx context.Context, vm *vmopv1.VirtualMachine, propertyPaths []string) (map[string]any, error)
 }
+type vmProviderGuestProgramProber interface {
+	RunVirtualMachineGuestProgram(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, spec vimtypes.GuestProgramSpec) (int32, error)
+}
 type vmProviderProber interface {
 	vmProviderGuestHeartbeatProber
 	vmProviderGuestInfoProber
+	vmProviderGuestProgramProber
 }
 
 // Prober contains the different type of probes.
 type Prober struct {
 	TCPProbe       Probe
+	HTTPProbe      Probe
 	GuestHeartbeat Probe
 	GuestInfo      Probe
+	Exec           Probe
 }
 
 // NewProber creates a new Prober.
-func NewProber(vmProvider vmProviderProber) *Prober {
+func NewProber(client ctrlclient.Reader, vmProvider vmProviderProber) *Prober {
 	return &Prober{
 		TCPProbe:       NewTCPProber(),
+		HTTPProbe:      NewHTTPProber(),
 		GuestHeartbeat: NewGuestHeartbeatProber(vmProvider),
 		GuestInfo:      NewGuestInfoProber(vmProvider),
+		Exec:           NewExecProber(client, vmProvider),
 	}
 }
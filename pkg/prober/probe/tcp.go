@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -27,7 +28,7 @@ func NewTCPProber() Probe {
 
 func (pr tcpProber) Probe(ctx *context.ProbeContext) (Result, error) {
 	vm := ctx.VM
-	p := ctx.VM.Spec.ReadinessProbe
+	p := ctx.GetProbeSpec()
 
 	portProto := corev1.ProtocolTCP
 	portNum, err := findPort(vm, p.TCPSocket.Port, portProto)
@@ -38,23 +39,12 @@ func (pr tcpProber) Probe(ctx *context.ProbeContext) (Result, error) {
 	ip := p.TCPSocket.Host
 	if ip == "" {
 		ctx.Logger.V(4).Info("TCPSocket Host not specified, using VM IP", "probe", ctx.String())
-		if vm.Status.Network != nil {
-			ip = vm.Status.Network.PrimaryIP4
-			if ip == "" {
-				ip = vm.Status.Network.PrimaryIP6
-			}
-		}
-		if ip == "" {
-			return Failure, fmt.Errorf("VM %s doesn't have an IP assigned", vm.NamespacedName())
+		if ip, err = findIP(vm); err != nil {
+			return Failure, err
 		}
 	}
 
-	var timeout time.Duration
-	if p.TimeoutSeconds <= 0 {
-		timeout = defaultConnectTimeout
-	} else {
-		timeout = time.Duration(p.TimeoutSeconds) * time.Second
-	}
+	timeout := getTimeout(p)
 
 	if err := checkConnection("tcp", ip, strconv.Itoa(portNum), timeout); err != nil {
 		return Failure, err
@@ -63,10 +53,17 @@ func (pr tcpProber) Probe(ctx *context.ProbeContext) (Result, error) {
 	return Success, nil
 }
 
-func findPort(vm *vmopv1.VirtualMachine, portName intstr.IntOrString, _ corev1.Protocol) (int, error) {
+// findPort returns the number of the port. A named port is resolved as an IANA
+// service name for the protocol since, unlike a container, a VM does not
+// declare its ports.
+func findPort(vm *vmopv1.VirtualMachine, portName intstr.IntOrString, protocol corev1.Protocol) (int, error) {
 	switch portName.Type {
 	case intstr.String:
-		// Not supported.
+		port, err := net.LookupPort(strings.ToLower(string(protocol)), portName.StrVal)
+		if err != nil {
+			return 0, fmt.Errorf("failed to resolve port %q for manifest %s: %w", portName.StrVal, vm.UID, err)
+		}
+		return port, nil
 	case intstr.Int:
 		return portName.IntValue(), nil
 	}
@@ -74,6 +71,28 @@ func findPort(vm *vmopv1.VirtualMachine, portName intstr.IntOrString, _ corev1.P
 	return 0, fmt.Errorf("no suitable port for manifest: %s", vm.UID)
 }
 
+func findIP(vm *vmopv1.VirtualMachine) (string, error) {
+	var ip string
+	if vm.Status.Network != nil {
+		ip = vm.Status.Network.PrimaryIP4
+		if ip == "" {
+			ip = vm.Status.Network.PrimaryIP6
+		}
+	}
+	if ip == "" {
+		return "", fmt.Errorf("VM %s doesn't have an IP assigned", vm.NamespacedName())
+	}
+
+	return ip, nil
+}
+
+func getTimeout(p *vmopv1.VirtualMachineReadinessProbeSpec) time.Duration {
+	if p.TimeoutSeconds <= 0 {
+		return defaultConnectTimeout
+	}
+	return time.Duration(p.TimeoutSeconds) * time.Second
+}
+
 func checkConnection(proto, host, port string, timeout time.Duration) error {
 	address := net.JoinHostPort(host, port)
 	conn, err := net.DialTimeout(proto, address, timeout)
@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -92,6 +93,31 @@ var _ = Describe("TCP probe", func() {
 	})
 })
 
+var _ = Describe("findPort", func() {
+	var vm *vmopv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{}
+	})
+
+	It("returns a numeric port", func() {
+		port, err := findPort(vm, intstr.FromInt(8080), corev1.ProtocolTCP)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(8080))
+	})
+
+	It("resolves a named port", func() {
+		port, err := findPort(vm, intstr.FromString("https"), corev1.ProtocolTCP)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(443))
+	})
+
+	It("fails to resolve an unknown named port", func() {
+		_, err := findPort(vm, intstr.FromString("no-such-service"), corev1.ProtocolTCP)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 func TestTCPProbe(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "TCP probe")
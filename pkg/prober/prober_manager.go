@@ -26,11 +26,13 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/prober/worker"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 const (
 	proberManagerName       = "virtualmachine-prober-manager"
 	readinessProbeQueueName = "readinessProbeQueue"
+	livenessProbeQueueName  = "livenessProbeQueue"
 
 	// defaultPeriodSeconds represents the default value for the frequency (in seconds) to perform the probe.
 	// We use the same default value as the kubernetes container probe.
@@ -39,6 +41,9 @@ const (
 	// the number of readiness workers.
 	// TODO: find a way to calibrate it.
 	numberOfReadinessWorkers = 5
+
+	// the number of liveness workers.
+	numberOfLivenessWorkers = 5
 )
 
 // Manager represents a prober manager interface.
@@ -53,6 +58,7 @@ type manager struct {
 	context        context.Context
 	client         client.Client
 	readinessQueue worker.DelayingInterface
+	livenessQueue  worker.DelayingInterface
 	prober         *probe.Prober
 	log            logr.Logger
 	recorder       vmoprecord.Recorder
@@ -63,7 +69,17 @@ type manager struct {
 	// if the time duration set in the AddAfter is not zero. vmReadinessProbeList can be used to avoid
 	// adding VMs to the readiness queue when this VM is already in the heap but not in the queue.
 	readinessMutex       sync.Mutex
-	vmReadinessProbeList map[string]vmopv1.VirtualMachineReadinessProbeSpec
+	vmReadinessProbeList map[string]readinessProbeSpec
+
+	// vmLivenessProbeList serves the same purpose for the liveness queue.
+	livenessMutex       sync.Mutex
+	vmLivenessProbeList map[string]vmopv1.VirtualMachineLivenessProbeSpec
+}
+
+// readinessProbeSpec is the spec of the probes run by the readiness worker.
+type readinessProbeSpec struct {
+	readiness *vmopv1.VirtualMachineReadinessProbeSpec
+	startup   *vmopv1.VirtualMachineStartupProbeSpec
 }
 
 // NewManager initializes a prober manager.
@@ -77,10 +93,12 @@ func NewManager(
 		context:              ctx,
 		client:               client,
 		readinessQueue:       workqueue.NewNamedDelayingQueue(readinessProbeQueueName),
-		prober:               probe.NewProber(vmProvider),
+		livenessQueue:        workqueue.NewNamedDelayingQueue(livenessProbeQueueName),
+		prober:               probe.NewProber(client, vmProvider),
 		log:                  ctrl.Log.WithName(proberManagerName),
 		recorder:             record,
-		vmReadinessProbeList: make(map[string]vmopv1.VirtualMachineReadinessProbeSpec),
+		vmReadinessProbeList: make(map[string]readinessProbeSpec),
+		vmLivenessProbeList:  make(map[string]vmopv1.VirtualMachineLivenessProbeSpec),
 	}
 	return probeManager
 }
@@ -104,38 +122,91 @@ func AddToManager(
 
 // AddToProberManager adds a VM to the prober manager.
 func (m *manager) AddToProberManager(vm *vmopv1.VirtualMachine) {
+	m.log.V(4).Info("Add to prober manager", "vm", vm.NamespacedName())
+
+	m.addToReadinessQueue(vm)
+	m.addToLivenessQueue(vm)
+}
+
+func (m *manager) addToReadinessQueue(vm *vmopv1.VirtualMachine) {
 	vmName := vm.NamespacedName()
-	m.log.V(4).Info("Add to prober manager", "vm", vmName)
 
 	m.readinessMutex.Lock()
 	defer m.readinessMutex.Unlock()
 
-	if vm.Spec.ReadinessProbe != nil &&
-		(vm.Spec.ReadinessProbe.TCPSocket != nil || vm.Spec.ReadinessProbe.GuestHeartbeat != nil || len(vm.Spec.ReadinessProbe.GuestInfo) != 0) {
+	if m.hasManagedReadinessProbe(vm) {
 		// if the VM is not in the list, or its readiness probe spec has been updated, immediately add it to the queue
 		// otherwise, ignore it.
-		if oldProbe, ok := m.vmReadinessProbeList[vmName]; ok && reflect.DeepEqual(oldProbe, vm.Spec.ReadinessProbe) {
+		newProbe := readinessProbeSpec{
+			readiness: vm.Spec.ReadinessProbe.DeepCopy(),
+			startup:   vm.Spec.StartupProbe.DeepCopy(),
+		}
+		if oldProbe, ok := m.vmReadinessProbeList[vmName]; ok && reflect.DeepEqual(oldProbe, newProbe) {
 			m.log.V(4).Info("VM is already in the readiness probe list and its probe spec is not updated, skip it", "vm", vmName)
 			return
 		}
 
 		m.readinessQueue.Add(client.ObjectKey{Name: vm.Name, Namespace: vm.Namespace})
-		m.vmReadinessProbeList[vmName] = *vm.Spec.ReadinessProbe
+		m.vmReadinessProbeList[vmName] = newProbe
 	} else {
 		delete(m.vmReadinessProbeList, vmName)
 	}
 }
 
+// hasManagedReadinessProbe returns true if the VM's readiness probe is run by
+// the prober manager. When async signal is enabled, only the TCP and HTTP
+// readiness probes and probes that use thresholds or a startup probe are run by
+// the prober manager since the other probes are reconciled as part of the VM's
+// status.
+func (m *manager) hasManagedReadinessProbe(vm *vmopv1.VirtualMachine) bool {
+	if !vmopv1util.HasReadinessProbe(*vm) {
+		return false
+	}
+	if vmopv1util.RequiresPeriodicReadinessProbe(*vm) {
+		return true
+	}
+	return !pkgcfg.FromContext(m.context).AsyncSignalEnabled
+}
+
+func (m *manager) addToLivenessQueue(vm *vmopv1.VirtualMachine) {
+	vmName := vm.NamespacedName()
+
+	m.livenessMutex.Lock()
+	defer m.livenessMutex.Unlock()
+
+	if p := vm.Spec.LivenessProbe; p != nil &&
+		(p.TCPSocket != nil || p.HTTPGet != nil || p.Exec != nil || p.GuestHeartbeat != nil || len(p.GuestInfo) != 0) {
+		// if the VM is not in the list, or its liveness probe spec has been updated, immediately add it to the queue
+		// otherwise, ignore it.
+		if oldProbe, ok := m.vmLivenessProbeList[vmName]; ok && reflect.DeepEqual(oldProbe, *p) {
+			m.log.V(4).Info("VM is already in the liveness probe list and its probe spec is not updated, skip it", "vm", vmName)
+			return
+		}
+
+		m.livenessQueue.Add(client.ObjectKey{Name: vm.Name, Namespace: vm.Namespace})
+		m.vmLivenessProbeList[vmName] = *p
+	} else {
+		delete(m.vmLivenessProbeList, vmName)
+	}
+}
+
 // RemoveFromProberManager removes a VM from the prober manager.
 func (m *manager) RemoveFromProberManager(vm *vmopv1.VirtualMachine) {
 	vmName := vm.NamespacedName()
 
 	m.readinessMutex.Lock()
-	defer m.readinessMutex.Unlock()
 	if _, ok := m.vmReadinessProbeList[vmName]; ok {
 		m.log.V(4).Info("Remove from prober manager", "vm", vmName)
 		delete(m.vmReadinessProbeList, vmName)
 	}
+	m.readinessMutex.Unlock()
+
+	m.livenessMutex.Lock()
+	if _, ok := m.vmLivenessProbeList[vmName]; ok {
+		m.log.V(4).Info("Remove from prober manager", "vm", vmName)
+		delete(m.vmLivenessProbeList, vmName)
+	}
+	m.livenessMutex.Unlock()
 }
 
 // Start starts the probe manager.
@@ -152,9 +223,17 @@ func (m *manager) Start(ctx context.Context) error {
 		m.worker(readinessWorker)
 	}
 
+	m.log.Info("Starting liveness workers", "count", numberOfLivenessWorkers)
+	m.workersWG.Add(numberOfLivenessWorkers)
+	for i := 0; i < numberOfLivenessWorkers; i++ {
+		livenessWorker := worker.NewLivenessWorker(ctx, m.livenessQueue, m.prober, m.client, m.recorder)
+		m.worker(livenessWorker)
+	}
+
 	<-ctx.Done()
 
 	m.readinessQueue.ShutDown()
+	m.livenessQueue.ShutDown()
 	m.workersWG.Wait()
 	return nil
 }
@@ -296,6 +296,73 @@ var _ = Describe("VirtualMachine probes", func() {
 				testManager.readinessMutex.Unlock()
 			})
 		})
+
+		When("VM only has a startup probe", func() {
+			BeforeEach(func() {
+				vm.Spec.ReadinessProbe = nil
+				vm.Spec.StartupProbe = &vmopv1.VirtualMachineStartupProbeSpec{
+					GuestHeartbeat: &vmopv1.GuestHeartbeatAction{},
+					PeriodSeconds:  periodSeconds,
+				}
+			})
+
+			It("Should add to the readiness queue and list", func() {
+				testManager.AddToProberManager(vm)
+
+				Expect(testManager.readinessQueue.Len()).To(Equal(1))
+				testManager.readinessMutex.Lock()
+				Expect(testManager.vmReadinessProbeList).Should(HaveKey(vm.NamespacedName()))
+				testManager.readinessMutex.Unlock()
+
+				By("Should do nothing if the VM's startup probe is not updated", func() {
+					item, _ := testManager.readinessQueue.Get()
+					testManager.readinessQueue.Done(item)
+
+					testManager.AddToProberManager(vm)
+					Expect(testManager.readinessQueue.Len()).To(Equal(0))
+				})
+
+				By("Should add to the queue immediately if the VM's startup probe is updated", func() {
+					vm.Spec.StartupProbe.FailureThreshold = 10
+					testManager.AddToProberManager(vm)
+					Expect(testManager.readinessQueue.Len()).To(Equal(1))
+				})
+			})
+		})
+
+		When("VM has a liveness probe", func() {
+			BeforeEach(func() {
+				vm.Spec.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeSpec{
+					GuestHeartbeat: &vmopv1.GuestHeartbeatAction{},
+					PeriodSeconds:  periodSeconds,
+				}
+			})
+
+			It("Should add to the liveness queue and list", func() {
+				testManager.AddToProberManager(vm)
+
+				Expect(testManager.livenessQueue.Len()).To(Equal(1))
+				testManager.livenessMutex.Lock()
+				Expect(testManager.vmLivenessProbeList).Should(HaveKey(vm.NamespacedName()))
+				testManager.livenessMutex.Unlock()
+
+				By("Should do nothing if the VM's liveness probe is not updated", func() {
+					Expect(testManager.livenessQueue.Len()).To(Equal(1))
+					item, _ := testManager.livenessQueue.Get()
+					testManager.livenessQueue.Done(item)
+
+					testManager.AddToProberManager(vm)
+					Expect(testManager.livenessQueue.Len()).To(Equal(0))
+				})
+
+				By("Should remove from the list when the VM is removed", func() {
+					testManager.RemoveFromProberManager(vm)
+					testManager.livenessMutex.Lock()
+					Expect(testManager.vmLivenessProbeList).ShouldNot(HaveKey(vm.NamespacedName()))
+					testManager.livenessMutex.Unlock()
+				})
+			})
+		})
 	})
 })
 
@@ -0,0 +1,271 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/prober/probe"
+	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+const (
+	// defaultFailureThreshold is the number of consecutive failures of a
+	// liveness probe after which the VM is restarted when the probe does not
+	// specify a threshold.
+	defaultFailureThreshold = 3
+)
+
+// livenessWorker implements Worker interface.
+type livenessWorker struct {
+	context  context.Context
+	queue    DelayingInterface
+	prober   *probe.Prober
+	client   client.Client
+	recorder vmoprecord.Recorder
+}
+
+// NewLivenessWorker creates a new liveness worker to run liveness probes.
+func NewLivenessWorker(
+	context context.Context,
+	queue DelayingInterface,
+	prober *probe.Prober,
+	client client.Client,
+	recorder vmoprecord.Recorder,
+) Worker {
+	return &livenessWorker{
+		context:  context,
+		queue:    queue,
+		prober:   prober,
+		client:   client,
+		recorder: recorder,
+	}
+}
+
+func (w *livenessWorker) GetQueue() DelayingInterface {
+	return w.queue
+}
+
+// CreateProbeContext creates a probe context for liveness probe.
+func (w *livenessWorker) CreateProbeContext(vm *vmopv1.VirtualMachine) (*proberctx.ProbeContext, error) {
+	p := vm.Spec.LivenessProbe
+
+	if p == nil || (p.TCPSocket == nil && p.HTTPGet == nil && p.Exec == nil && p.GuestHeartbeat == nil && len(p.GuestInfo) == 0) {
+		return nil, nil
+	}
+
+	patchHelper, err := patch.NewHelper(vm, w.client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proberctx.ProbeContext{
+		Context:       pkgcfg.JoinContext(context.Background(), w.context),
+		Logger:        ctrl.Log.WithName("liveness-probe").WithValues("vmName", vm.NamespacedName()),
+		PatchHelper:   patchHelper,
+		VM:            vm,
+		ProbeType:     "liveness",
+		PeriodSeconds: p.PeriodSeconds,
+		ProbeSpec: &vmopv1.VirtualMachineReadinessProbeSpec{
+			TCPSocket:      p.TCPSocket,
+			HTTPGet:        p.HTTPGet,
+			Exec:           p.Exec,
+			GuestHeartbeat: p.GuestHeartbeat,
+			GuestInfo:      p.GuestInfo,
+			TimeoutSeconds: p.TimeoutSeconds,
+			PeriodSeconds:  p.PeriodSeconds,
+		},
+	}, nil
+}
+
+// ProcessProbeResult processes probe results to update the VM's liveness
+// probe status. The VM is restarted when the probe has failed
+// FailureThreshold times in a row and the restart is allowed by the VM's
+// disruption budgets.
+func (w *livenessWorker) ProcessProbeResult(ctx *proberctx.ProbeContext, res probe.Result, resErr error) error {
+	vm := ctx.VM
+
+	status := &vmopv1.VirtualMachineLivenessProbeStatus{}
+	if vm.Status.LivenessProbe != nil {
+		status = vm.Status.LivenessProbe.DeepCopy()
+	}
+
+	if !isVMRunning(vm) {
+		// Failures are not counted while the VM is not powered on or is
+		// being restarted, and the initial delay starts over once it is.
+		status.ConsecutiveFailures = 0
+		status.StartTime = nil
+	} else {
+		if status.StartTime == nil {
+			now := metav1.Now()
+			status.StartTime = &now
+		}
+
+		switch res {
+		case probe.Success:
+			status.ConsecutiveFailures = 0
+		case probe.Failure:
+			w.processProbeFailure(ctx, status, resErr)
+		}
+	}
+
+	if vm.Status.LivenessProbe == nil && apiequality.Semantic.DeepEqual(*status, vmopv1.VirtualMachineLivenessProbeStatus{}) {
+		return nil
+	}
+	vm.Status.LivenessProbe = status
+
+	if err := ctx.PatchHelper.Patch(ctx, vm); err != nil {
+		return fmt.Errorf("patched failed: %w", err)
+	}
+
+	return nil
+}
+
+func (w *livenessWorker) processProbeFailure(
+	ctx *proberctx.ProbeContext,
+	status *vmopv1.VirtualMachineLivenessProbeStatus,
+	resErr error) {
+
+	vm := ctx.VM
+
+	failureThreshold := vm.Spec.LivenessProbe.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+
+	msg := ""
+	if resErr != nil {
+		msg = resErr.Error()
+	}
+
+	status.ConsecutiveFailures++
+	w.recorder.Warnf(vm, vmopv1.VirtualMachineLivenessProbeFailedReason,
+		"Liveness probe failed (%d/%d): %s", status.ConsecutiveFailures, failureThreshold, msg)
+
+	if status.ConsecutiveFailures < failureThreshold {
+		return
+	}
+
+	// Restarting the VM disrupts it, so only restart the VM if the restart is
+	// allowed by the VirtualMachineDisruptionBudgets that select the VM.
+	// Otherwise the failures keep being counted and the restart is retried
+	// when the probe fails again.
+	disruptionChecker, err := vmopv1util.NewDisruptionChecker(ctx, w.client, vm.Namespace)
+	if err == nil {
+		err = disruptionChecker.Disrupt(vm)
+	}
+	if err != nil {
+		w.recorder.Warnf(vm, vmopv1.DisruptionBudgetExceededReason,
+			"Not restarting VM after %d consecutive liveness probe failures: %v",
+			status.ConsecutiveFailures, err)
+		ctx.Logger.Info("Not restarting VM due to failed liveness probe",
+			"consecutiveFailures", status.ConsecutiveFailures, "reason", err.Error())
+		return
+	}
+
+	// Restart the VM the same way as a user would, by setting
+	// spec.nextRestartTime to "now". The mutation webhook replaces the value
+	// with the current time, and the VM is restarted in accordance with
+	// spec.restartMode.
+	vm.Spec.NextRestartTime = "now"
+
+	now := metav1.Now()
+	status.ConsecutiveFailures = 0
+	status.StartTime = nil
+	status.RestartCount++
+	status.LastRestartTime = &now
+
+	w.recorder.Warnf(vm, vmopv1.VirtualMachineLivenessRestartReason,
+		"Restarting VM after %d consecutive liveness probe failures", failureThreshold)
+	ctx.Logger.Info("Restarting VM due to failed liveness probe",
+		"failureThreshold", failureThreshold, "restartCount", status.RestartCount)
+}
+
+func (w *livenessWorker) DoProbe(ctx *proberctx.ProbeContext) error {
+	if !w.shouldProbe(ctx.VM) {
+		return w.ProcessProbeResult(ctx, probe.Unknown, nil)
+	}
+
+	res, err := w.runProbe(ctx)
+	if err != nil {
+		ctx.Logger.Error(err, "liveness probe fails", "result", res)
+	}
+	return w.ProcessProbeResult(ctx, res, err)
+}
+
+// shouldProbe returns true if the VM is running, its startup probe, if any,
+// has succeeded, and the probe's initial delay has elapsed.
+func (w *livenessWorker) shouldProbe(vm *vmopv1.VirtualMachine) bool {
+	if !isVMRunning(vm) {
+		return false
+	}
+
+	if vmopv1util.HasStartupProbe(*vm) && !isVMStarted(vm) {
+		return false
+	}
+
+	status := vm.Status.LivenessProbe
+	if status == nil || status.StartTime == nil {
+		return false
+	}
+
+	initialDelay := time.Duration(vm.Spec.LivenessProbe.InitialDelaySeconds) * time.Second
+	return !time.Now().Before(status.StartTime.Add(initialDelay))
+}
+
+// runProbe runs a specific type of probe based on the VM probe spec.
+func (w *livenessWorker) runProbe(ctx *proberctx.ProbeContext) (probe.Result, error) {
+	p := ctx.GetProbeSpec()
+
+	switch {
+	case p.TCPSocket != nil:
+		return w.prober.TCPProbe.Probe(ctx)
+	case p.HTTPGet != nil:
+		return w.prober.HTTPProbe.Probe(ctx)
+	case p.Exec != nil:
+		return w.prober.Exec.Probe(ctx)
+	case p.GuestHeartbeat != nil:
+		return w.prober.GuestHeartbeat.Probe(ctx)
+	case len(p.GuestInfo) != 0:
+		return w.prober.GuestInfo.Probe(ctx)
+	}
+
+	return probe.Unknown, fmt.Errorf("unknown action specified for VM %s liveness probe", ctx.VM.NamespacedName())
+}
+
+// isVMRunning returns true if the VM is powered on and is not waiting to be
+// restarted.
+func isVMRunning(vm *vmopv1.VirtualMachine) bool {
+	if vm.Spec.PowerState != vmopv1.VirtualMachinePowerStateOn ||
+		vm.Status.PowerState != vmopv1.VirtualMachinePowerStateOn {
+		return false
+	}
+
+	if vm.Spec.NextRestartTime == "" {
+		return true
+	}
+
+	nextRestartTime, err := time.Parse(time.RFC3339Nano, vm.Spec.NextRestartTime)
+	if err != nil {
+		// The value has not yet been replaced by the mutation webhook.
+		return false
+	}
+
+	// The status is serialized with a precision of seconds.
+	return vm.Status.LastRestartTime != nil &&
+		!nextRestartTime.Truncate(time.Second).After(vm.Status.LastRestartTime.Time)
+}
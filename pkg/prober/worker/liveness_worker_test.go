@@ -0,0 +1,231 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgorecord "k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+
+	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
+	fakeprobe "github.com/vmware-tanzu/vm-operator/pkg/prober/fake/probe"
+	"github.com/vmware-tanzu/vm-operator/pkg/prober/probe"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("VirtualMachine liveness probes", func() {
+	var (
+		testWorker Worker
+
+		vm          *vmopv1.VirtualMachine
+		vmKey       client.ObjectKey
+		workerCtx   context.Context
+		initObjects []client.Object
+
+		fakeClient         client.Client
+		fakeEvents         chan string
+		fakeHeartbeatProbe *fakeprobe.FakeProbe
+		probeResult        probe.Result
+	)
+
+	BeforeEach(func() {
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dummy-vm",
+				Namespace: "dummy-ns",
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				ClassName:  "dummy-vmclass",
+				PowerState: vmopv1.VirtualMachinePowerStateOn,
+				LivenessProbe: &vmopv1.VirtualMachineLivenessProbeSpec{
+					GuestHeartbeat:   &vmopv1.GuestHeartbeatAction{},
+					PeriodSeconds:    1,
+					FailureThreshold: 2,
+				},
+			},
+			Status: vmopv1.VirtualMachineStatus{
+				PowerState: vmopv1.VirtualMachinePowerStateOn,
+			},
+		}
+		vmKey = client.ObjectKey{Name: vm.Name, Namespace: vm.Namespace}
+		workerCtx = pkgcfg.NewContext()
+		initObjects = nil
+		probeResult = probe.Failure
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = builder.NewFakeClient(append(initObjects, vm)...)
+		eventRecorder := clientgorecord.NewFakeRecorder(1024)
+		fakeEvents = eventRecorder.Events
+
+		fakeHeartbeatProbe = fakeprobe.NewFakeProbe().(*fakeprobe.FakeProbe)
+		fakeHeartbeatProbe.ProbeFn = func(ctx *proberctx.ProbeContext) (probe.Result, error) {
+			return probeResult, fmt.Errorf("heartbeat error")
+		}
+		prober := &probe.Prober{
+			GuestHeartbeat: fakeHeartbeatProbe,
+		}
+		queue := workqueue.NewNamedDelayingQueue("test")
+		testWorker = NewLivenessWorker(workerCtx, queue, prober, fakeClient, record.New(eventRecorder))
+	})
+
+	doProbe := func() {
+		GinkgoHelper()
+		Expect(fakeClient.Get(context.Background(), vmKey, vm)).To(Succeed())
+		ctx, err := testWorker.CreateProbeContext(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctx).ToNot(BeNil())
+		Expect(testWorker.DoProbe(ctx)).To(Succeed())
+		Expect(fakeClient.Get(context.Background(), vmKey, vm)).To(Succeed())
+	}
+
+	It("does not create a probe context without a liveness probe", func() {
+		vm.Spec.LivenessProbe = nil
+		ctx, err := testWorker.CreateProbeContext(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctx).To(BeNil())
+	})
+
+	It("restarts the VM after the failure threshold is reached", func() {
+		By("starting the probe", func() {
+			doProbe()
+			Expect(vm.Status.LivenessProbe).ToNot(BeNil())
+			Expect(vm.Status.LivenessProbe.StartTime).ToNot(BeNil())
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(BeZero())
+		})
+
+		By("counting the first failure", func() {
+			doProbe()
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(Equal(int32(1)))
+			Expect(vm.Spec.NextRestartTime).To(BeEmpty())
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.VirtualMachineLivenessProbeFailedReason)))
+		})
+
+		By("restarting the VM on the second failure", func() {
+			doProbe()
+			Expect(vm.Spec.NextRestartTime).To(Equal("now"))
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(BeZero())
+			Expect(vm.Status.LivenessProbe.RestartCount).To(Equal(int32(1)))
+			Expect(vm.Status.LivenessProbe.LastRestartTime).ToNot(BeNil())
+			Expect(vm.Status.LivenessProbe.StartTime).To(BeNil())
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.VirtualMachineLivenessProbeFailedReason)))
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.VirtualMachineLivenessRestartReason)))
+		})
+
+		By("not probing the VM while the restart is pending", func() {
+			doProbe()
+			Expect(vm.Status.LivenessProbe.StartTime).To(BeNil())
+			Expect(vm.Status.LivenessProbe.RestartCount).To(Equal(int32(1)))
+		})
+	})
+
+	When("restarting the VM would violate a disruption budget", func() {
+		BeforeEach(func() {
+			workerCtx = pkgcfg.NewContextWithDefaultConfig()
+			pkgcfg.SetContext(workerCtx, func(config *pkgcfg.Config) {
+				config.Features.K8sWorkloadMgmtAPI = true
+			})
+
+			vm.Labels = map[string]string{"app": "db"}
+			conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+
+			initObjects = append(initObjects, &vmopv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db-budget",
+					Namespace: vm.Namespace,
+				},
+				Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "db"},
+					},
+					MinAvailable: ptr.To(intstr.FromInt32(1)),
+				},
+			})
+		})
+
+		It("does not restart the VM and retries on the next failure", func() {
+			for range 3 {
+				doProbe()
+			}
+
+			Expect(vm.Spec.NextRestartTime).To(BeEmpty())
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(Equal(int32(2)))
+			Expect(vm.Status.LivenessProbe.RestartCount).To(BeZero())
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.VirtualMachineLivenessProbeFailedReason)))
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.VirtualMachineLivenessProbeFailedReason)))
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.DisruptionBudgetExceededReason)))
+
+			doProbe()
+			Expect(vm.Spec.NextRestartTime).To(BeEmpty())
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(Equal(int32(3)))
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.VirtualMachineLivenessProbeFailedReason)))
+			Expect(fakeEvents).To(Receive(ContainSubstring(vmopv1.DisruptionBudgetExceededReason)))
+		})
+	})
+
+	When("the probe succeeds", func() {
+		BeforeEach(func() {
+			probeResult = probe.Success
+			vm.Status.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeStatus{
+				ConsecutiveFailures: 1,
+				StartTime:           &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			}
+		})
+
+		It("resets the consecutive failures", func() {
+			doProbe()
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(BeZero())
+			Expect(vm.Spec.NextRestartTime).To(BeEmpty())
+		})
+	})
+
+	When("the initial delay has not elapsed", func() {
+		BeforeEach(func() {
+			vm.Spec.LivenessProbe.InitialDelaySeconds = 300
+			vm.Status.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeStatus{
+				StartTime: &metav1.Time{Time: time.Now()},
+			}
+		})
+
+		It("does not count failures", func() {
+			doProbe()
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(BeZero())
+			Expect(fakeEvents).ToNot(Receive())
+		})
+	})
+
+	When("the VM is powered off", func() {
+		BeforeEach(func() {
+			vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+			vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOff
+			vm.Status.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeStatus{
+				ConsecutiveFailures: 1,
+				StartTime:           &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			}
+		})
+
+		It("resets the probe status", func() {
+			doProbe()
+			Expect(vm.Status.LivenessProbe.ConsecutiveFailures).To(BeZero())
+			Expect(vm.Status.LivenessProbe.StartTime).To(BeNil())
+			Expect(vm.Spec.NextRestartTime).To(BeEmpty())
+		})
+	})
+})
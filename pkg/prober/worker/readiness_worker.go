@@ -7,6 +7,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -19,6 +20,7 @@ import (
 	proberctx "github.com/vmware-tanzu/vm-operator/pkg/prober/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/prober/probe"
 	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 const (
@@ -26,6 +28,11 @@ const (
 	readyReason    string = "Ready"
 	notReadyReason string = "NotReady"
 	unknownReason  string = "Unknown"
+
+	// defaultStartupFailureThreshold is the number of consecutive failures of
+	// a startup probe after which the VM is restarted when the probe does not
+	// specify a threshold.
+	defaultStartupFailureThreshold = 30
 )
 
 // readinessWorker implements Worker interface.
@@ -60,9 +67,7 @@ func (w *readinessWorker) GetQueue() DelayingInterface {
 
 // CreateProbeContext creates a probe context for readiness probe.
 func (w *readinessWorker) CreateProbeContext(vm *vmopv1.VirtualMachine) (*proberctx.ProbeContext, error) {
-	p := vm.Spec.ReadinessProbe
-
-	if p.TCPSocket == nil && p.GuestHeartbeat == nil && len(p.GuestInfo) == 0 {
+	if !vmopv1util.HasReadinessProbe(*vm) {
 		return nil, nil
 	}
 
@@ -71,13 +76,20 @@ func (w *readinessWorker) CreateProbeContext(vm *vmopv1.VirtualMachine) (*prober
 		return nil, err
 	}
 
+	var periodSeconds int32
+	if vmopv1util.HasStartupProbe(*vm) && !isVMStarted(vm) {
+		periodSeconds = vm.Spec.StartupProbe.PeriodSeconds
+	} else if p := vm.Spec.ReadinessProbe; p != nil {
+		periodSeconds = p.PeriodSeconds
+	}
+
 	return &proberctx.ProbeContext{
 		Context:       pkgcfg.JoinContext(context.Background(), w.context),
 		Logger:        ctrl.Log.WithName("readiness-probe").WithValues("vmName", vm.NamespacedName()),
 		PatchHelper:   patchHelper,
 		VM:            vm,
 		ProbeType:     "readiness",
-		PeriodSeconds: p.PeriodSeconds,
+		PeriodSeconds: periodSeconds,
 	}, nil
 }
 
@@ -85,7 +97,21 @@ func (w *readinessWorker) CreateProbeContext(vm *vmopv1.VirtualMachine) (*prober
 // sets the ReadyCondition in vm status if the new condition status is a transition.
 func (w *readinessWorker) ProcessProbeResult(ctx *proberctx.ProbeContext, res probe.Result, resErr error) error {
 	vm := ctx.VM
-	condition := w.getCondition(res, resErr)
+
+	if !isVMRunning(vm) {
+		// The startup probe is run again, and the initial delay of the
+		// readiness probe starts over, once the VM is powered on.
+		vm.Status.StartupProbe = nil
+		vm.Status.ReadinessProbe = nil
+	}
+
+	return w.updateReadyCondition(ctx, w.getCondition(res, resErr))
+}
+
+// updateReadyCondition sets the ReadyCondition in vm status and patches the
+// VM.
+func (w *readinessWorker) updateReadyCondition(ctx *proberctx.ProbeContext, condition *metav1.Condition) error {
+	vm := ctx.VM
 
 	// We only send event when either the condition type is added or its status changes, not
 	// if either its reason, severity, or message changes.
@@ -114,22 +140,200 @@ func (w *readinessWorker) ProcessProbeResult(ctx *proberctx.ProbeContext, res pr
 }
 
 func (w *readinessWorker) DoProbe(ctx *proberctx.ProbeContext) error {
+	vm := ctx.VM
+
+	if vmopv1util.HasStartupProbe(*vm) {
+		resetProbeStatusAfterRestart(vm)
+
+		if !isVMStarted(vm) && !w.doStartupProbe(ctx) {
+			return w.updateReadyCondition(ctx, conditions.FalseCondition(
+				vmopv1.ReadyConditionType, vmopv1.VirtualMachineNotStartedReason,
+				"startup probe has not succeeded"))
+		}
+
+		if !hasProbeAction(vm.Spec.ReadinessProbe) {
+			// The VM is ready as soon as it has started when there is only a
+			// startup probe.
+			return w.updateReadyCondition(ctx, conditions.TrueCondition(vmopv1.ReadyConditionType))
+		}
+	}
+
+	return w.updateReadyCondition(ctx, w.doReadinessProbe(ctx))
+}
+
+// doReadinessProbe runs the readiness probe and returns the resulting
+// ReadyCondition. The condition only changes once the probe has succeeded or
+// failed the number of times in a row specified by its thresholds.
+func (w *readinessWorker) doReadinessProbe(ctx *proberctx.ProbeContext) *metav1.Condition {
+	vm := ctx.VM
+	p := vm.Spec.ReadinessProbe
+
+	status := &vmopv1.VirtualMachineReadinessProbeStatus{}
+	if vm.Status.ReadinessProbe != nil {
+		status = vm.Status.ReadinessProbe.DeepCopy()
+	}
+	defer func() {
+		vm.Status.ReadinessProbe = status
+	}()
+
+	if status.StartTime == nil {
+		now := metav1.Now()
+		status.StartTime = &now
+	}
+
+	initialDelay := time.Duration(p.InitialDelaySeconds) * time.Second
+	if time.Now().Before(status.StartTime.Add(initialDelay)) {
+		return getCurrentCondition(vm, "waiting for the initial delay to elapse")
+	}
+
 	res, err := w.runProbe(ctx)
 	if err != nil {
 		ctx.Logger.Error(err, "readiness probe fails", "result", res)
 	}
-	return w.ProcessProbeResult(ctx, res, err)
+
+	switch res {
+	case probe.Success:
+		successThreshold := max(p.SuccessThreshold, 1)
+		status.ConsecutiveFailures = 0
+		if status.ConsecutiveSuccesses < successThreshold {
+			status.ConsecutiveSuccesses++
+		}
+		if status.ConsecutiveSuccesses >= successThreshold {
+			return w.getCondition(res, err)
+		}
+		return getCurrentCondition(vm, fmt.Sprintf("readiness probe succeeded %d/%d times",
+			status.ConsecutiveSuccesses, successThreshold))
+	case probe.Failure:
+		failureThreshold := max(p.FailureThreshold, 1)
+		status.ConsecutiveSuccesses = 0
+		if status.ConsecutiveFailures < failureThreshold {
+			status.ConsecutiveFailures++
+		}
+		if status.ConsecutiveFailures >= failureThreshold {
+			return w.getCondition(res, err)
+		}
+		return getCurrentCondition(vm, fmt.Sprintf("readiness probe failed %d/%d times",
+			status.ConsecutiveFailures, failureThreshold))
+	default: // probe.Unknown
+		return w.getCondition(res, err)
+	}
+}
+
+// doStartupProbe runs the startup probe and returns true once it has
+// succeeded. The VM is restarted when the probe has failed FailureThreshold
+// times in a row.
+func (w *readinessWorker) doStartupProbe(ctx *proberctx.ProbeContext) bool {
+	vm := ctx.VM
+	p := vm.Spec.StartupProbe
+
+	status := &vmopv1.VirtualMachineStartupProbeStatus{}
+	if vm.Status.StartupProbe != nil {
+		status = vm.Status.StartupProbe.DeepCopy()
+	}
+	defer func() {
+		vm.Status.StartupProbe = status
+	}()
+
+	if !isVMRunning(vm) {
+		// Failures are not counted while the VM is being restarted, and the
+		// initial delay starts over once it is.
+		status.ConsecutiveFailures = 0
+		status.StartTime = nil
+		return false
+	}
+
+	if status.StartTime == nil {
+		now := metav1.Now()
+		status.StartTime = &now
+	}
+
+	initialDelay := time.Duration(p.InitialDelaySeconds) * time.Second
+	if time.Now().Before(status.StartTime.Add(initialDelay)) {
+		return false
+	}
+
+	startupCtx := *ctx
+	startupCtx.ProbeType = "startup"
+	startupCtx.ProbeSpec = &vmopv1.VirtualMachineReadinessProbeSpec{
+		TCPSocket:      p.TCPSocket,
+		HTTPGet:        p.HTTPGet,
+		Exec:           p.Exec,
+		GuestHeartbeat: p.GuestHeartbeat,
+		GuestInfo:      p.GuestInfo,
+		TimeoutSeconds: p.TimeoutSeconds,
+		PeriodSeconds:  p.PeriodSeconds,
+	}
+
+	res, err := w.runProbe(&startupCtx)
+	switch res {
+	case probe.Success:
+		status.Started = true
+		status.ConsecutiveFailures = 0
+		ctx.Logger.Info("VM startup probe succeeded")
+		return true
+	case probe.Failure:
+		w.processStartupProbeFailure(ctx, status, err)
+	default: // probe.Unknown
+		if err != nil {
+			ctx.Logger.Error(err, "startup probe fails", "result", res)
+		}
+	}
+
+	return false
+}
+
+func (w *readinessWorker) processStartupProbeFailure(
+	ctx *proberctx.ProbeContext,
+	status *vmopv1.VirtualMachineStartupProbeStatus,
+	resErr error) {
+
+	vm := ctx.VM
+
+	failureThreshold := vm.Spec.StartupProbe.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = defaultStartupFailureThreshold
+	}
+
+	msg := ""
+	if resErr != nil {
+		msg = resErr.Error()
+	}
+
+	status.ConsecutiveFailures++
+	w.recorder.Warnf(vm, vmopv1.VirtualMachineStartupProbeFailedReason,
+		"Startup probe failed (%d/%d): %s", status.ConsecutiveFailures, failureThreshold, msg)
+
+	if status.ConsecutiveFailures < failureThreshold {
+		return
+	}
+
+	// Restart the VM the same way the liveness probe does.
+	vm.Spec.NextRestartTime = "now"
+
+	status.ConsecutiveFailures = 0
+	status.StartTime = nil
+
+	w.recorder.Warnf(vm, vmopv1.VirtualMachineStartupRestartReason,
+		"Restarting VM after %d consecutive startup probe failures", failureThreshold)
+	ctx.Logger.Info("Restarting VM due to failed startup probe",
+		"failureThreshold", failureThreshold)
 }
 
 // getProbe returns a specific type of probe method.
 func (w *readinessWorker) getProbe(probeSpec *vmopv1.VirtualMachineReadinessProbeSpec) probe.Probe {
-	if probeSpec == nil {
+	if !hasProbeAction(probeSpec) {
 		return nil
 	}
 
 	if probeSpec.TCPSocket != nil {
 		return w.prober.TCPProbe
 	}
+	if probeSpec.HTTPGet != nil {
+		return w.prober.HTTPProbe
+	}
+	if probeSpec.Exec != nil {
+		return w.prober.Exec
+	}
 	if probeSpec.GuestHeartbeat != nil {
 		return w.prober.GuestHeartbeat
 	}
@@ -142,11 +346,11 @@ func (w *readinessWorker) getProbe(probeSpec *vmopv1.VirtualMachineReadinessProb
 
 // runProbe runs a specific type of probe based on the VM probe spec.
 func (w *readinessWorker) runProbe(ctx *proberctx.ProbeContext) (probe.Result, error) {
-	if p := w.getProbe(ctx.VM.Spec.ReadinessProbe); p != nil {
+	if p := w.getProbe(ctx.GetProbeSpec()); p != nil {
 		return p.Probe(ctx)
 	}
 
-	return probe.Unknown, fmt.Errorf("unknown action specified for VM %s readiness probe", ctx.VM.NamespacedName())
+	return probe.Unknown, fmt.Errorf("unknown action specified for VM %s %s probe", ctx.VM.NamespacedName(), ctx.ProbeType)
 }
 
 // getCondition returns condition based on VM probe results.
@@ -165,3 +369,35 @@ func (w *readinessWorker) getCondition(res probe.Result, err error) *metav1.Cond
 		return conditions.UnknownCondition(vmopv1.ReadyConditionType, unknownReason, "%s", msg)
 	}
 }
+
+// getCurrentCondition returns the VM's current ReadyCondition, or a false
+// condition with the provided message if the VM does not have one yet.
+func getCurrentCondition(vm *vmopv1.VirtualMachine, msg string) *metav1.Condition {
+	if c := conditions.Get(vm, vmopv1.ReadyConditionType); c != nil {
+		return c.DeepCopy()
+	}
+	return conditions.FalseCondition(vmopv1.ReadyConditionType, notReadyReason, "%s", msg)
+}
+
+// hasProbeAction returns true if the probe specifies an action.
+func hasProbeAction(p *vmopv1.VirtualMachineReadinessProbeSpec) bool {
+	return p != nil && (p.TCPSocket != nil || p.HTTPGet != nil || p.Exec != nil || p.GuestHeartbeat != nil || len(p.GuestInfo) != 0)
+}
+
+// isVMStarted returns true if the VM's startup probe has succeeded.
+func isVMStarted(vm *vmopv1.VirtualMachine) bool {
+	return vm.Status.StartupProbe != nil && vm.Status.StartupProbe.Started
+}
+
+// resetProbeStatusAfterRestart resets the status of the startup and readiness
+// probes if the VM has been restarted since the startup probe was started.
+func resetProbeStatusAfterRestart(vm *vmopv1.VirtualMachine) {
+	s := vm.Status.StartupProbe
+	if s == nil || s.StartTime == nil || vm.Status.LastRestartTime == nil {
+		return
+	}
+	if vm.Status.LastRestartTime.After(s.StartTime.Time) {
+		vm.Status.StartupProbe = nil
+		vm.Status.ReadinessProbe = nil
+	}
+}
@@ -186,6 +186,224 @@ var _ = Describe("VirtualMachine readiness probes", func() {
 			Expect(condition.Message).To(ContainSubstring("heartbeat error"))
 		})
 	})
+
+	Context("Readiness probe thresholds", func() {
+		var (
+			probeResult    probe.Result
+			probeCallCount int
+		)
+
+		BeforeEach(func() {
+			probeCallCount = 0
+			vm.Spec.ReadinessProbe = getVirtualMachineHeartbeatProbe()
+			vm.Spec.ReadinessProbe.SuccessThreshold = 2
+			vm.Spec.ReadinessProbe.FailureThreshold = 2
+			Expect(fakeClient.Create(context.Background(), vm)).Should(Succeed())
+
+			fakeHeartbeatProbe.ProbeFn = func(ctx *proberctx.ProbeContext) (probe.Result, error) {
+				probeCallCount++
+				return probeResult, nil
+			}
+		})
+
+		doProbe := func(res probe.Result) {
+			GinkgoHelper()
+			probeResult = res
+			Expect(fakeClient.Get(context.Background(), vmKey, vm)).Should(Succeed())
+			var err error
+			ctx, err = testWorker.CreateProbeContext(vm)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(testWorker.DoProbe(ctx)).Should(Succeed())
+			Expect(fakeClient.Get(context.Background(), vmKey, vm)).Should(Succeed())
+		}
+
+		It("Should only update ReadyCondition after consecutive results reach the thresholds", func() {
+			By("not becoming ready after a single success", func() {
+				doProbe(probe.Success)
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionFalse)
+				Expect(vm.Status.ReadinessProbe).ToNot(BeNil())
+				Expect(vm.Status.ReadinessProbe.ConsecutiveSuccesses).To(Equal(int32(1)))
+			})
+
+			By("becoming ready after the second success", func() {
+				doProbe(probe.Success)
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionTrue)
+				Expect(vm.Status.ReadinessProbe.ConsecutiveSuccesses).To(Equal(int32(2)))
+			})
+
+			By("not counting successes past the threshold", func() {
+				doProbe(probe.Success)
+				Expect(vm.Status.ReadinessProbe.ConsecutiveSuccesses).To(Equal(int32(2)))
+			})
+
+			By("remaining ready after a single failure", func() {
+				doProbe(probe.Failure)
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionTrue)
+				Expect(vm.Status.ReadinessProbe.ConsecutiveSuccesses).To(BeZero())
+				Expect(vm.Status.ReadinessProbe.ConsecutiveFailures).To(Equal(int32(1)))
+			})
+
+			By("remaining ready when the probe recovers", func() {
+				doProbe(probe.Success)
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionTrue)
+				Expect(vm.Status.ReadinessProbe.ConsecutiveFailures).To(BeZero())
+			})
+
+			By("becoming not ready after the second consecutive failure", func() {
+				doProbe(probe.Failure)
+				doProbe(probe.Failure)
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionFalse)
+				Expect(vm.Status.ReadinessProbe.ConsecutiveFailures).To(Equal(int32(2)))
+			})
+		})
+
+		When("the initial delay has not elapsed", func() {
+			BeforeEach(func() {
+				vm.Spec.ReadinessProbe.InitialDelaySeconds = 300
+				Expect(fakeClient.Update(context.Background(), vm)).Should(Succeed())
+			})
+
+			It("Should not run the probe", func() {
+				doProbe(probe.Success)
+				doProbe(probe.Success)
+				Expect(probeCallCount).To(BeZero())
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionFalse)
+				Expect(vm.Status.ReadinessProbe.StartTime).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("Startup probe", func() {
+		var (
+			startupResult         probe.Result
+			readinessResult       probe.Result
+			startupProbeCallCount int
+			tcpProbeCallCount     int
+		)
+
+		BeforeEach(func() {
+			vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+			vm.Spec.StartupProbe = &vmopv1.VirtualMachineStartupProbeSpec{
+				GuestHeartbeat:   &vmopv1.GuestHeartbeatAction{},
+				PeriodSeconds:    5,
+				FailureThreshold: 2,
+			}
+			vm.Spec.ReadinessProbe = getVirtualMachineReadinessTCPProbe(10001)
+			vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+			Expect(fakeClient.Create(context.Background(), vm)).Should(Succeed())
+			Expect(fakeClient.Status().Update(context.Background(), vm)).Should(Succeed())
+
+			startupResult = probe.Failure
+			readinessResult = probe.Success
+			startupProbeCallCount = 0
+			tcpProbeCallCount = 0
+			fakeHeartbeatProbe.ProbeFn = func(ctx *proberctx.ProbeContext) (probe.Result, error) {
+				startupProbeCallCount++
+				return startupResult, fmt.Errorf("heartbeat error")
+			}
+			fakeTCPProbe.ProbeFn = func(ctx *proberctx.ProbeContext) (probe.Result, error) {
+				tcpProbeCallCount++
+				return readinessResult, nil
+			}
+		})
+
+		doProbe := func() {
+			GinkgoHelper()
+			Expect(fakeClient.Get(context.Background(), vmKey, vm)).Should(Succeed())
+			var err error
+			ctx, err = testWorker.CreateProbeContext(vm)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(testWorker.DoProbe(ctx)).Should(Succeed())
+			Expect(fakeClient.Get(context.Background(), vmKey, vm)).Should(Succeed())
+		}
+
+		It("Should use the startup probe's period until the VM has started", func() {
+			Expect(fakeClient.Get(context.Background(), vmKey, vm)).Should(Succeed())
+			ctx, err := testWorker.CreateProbeContext(vm)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ctx.PeriodSeconds).To(Equal(int32(5)))
+		})
+
+		It("Should not run the readiness probe until the startup probe succeeds", func() {
+			By("failing the startup probe", func() {
+				doProbe()
+				Expect(tcpProbeCallCount).To(BeZero())
+				condition := conditions.Get(vm, vmopv1.ReadyConditionType)
+				Expect(condition).ToNot(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal(vmopv1.VirtualMachineNotStartedReason))
+				Expect(vm.Status.StartupProbe).ToNot(BeNil())
+				Expect(vm.Status.StartupProbe.Started).To(BeFalse())
+				Expect(vm.Status.StartupProbe.ConsecutiveFailures).To(Equal(int32(1)))
+				Expect(fakeEvents).Should(Receive(ContainSubstring(vmopv1.VirtualMachineStartupProbeFailedReason)))
+			})
+
+			By("succeeding the startup probe", func() {
+				startupResult = probe.Success
+				doProbe()
+				Expect(vm.Status.StartupProbe.Started).To(BeTrue())
+				Expect(vm.Status.StartupProbe.ConsecutiveFailures).To(BeZero())
+				Expect(tcpProbeCallCount).To(Equal(1))
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionTrue)
+			})
+
+			By("not running the startup probe again", func() {
+				doProbe()
+				Expect(startupProbeCallCount).To(Equal(2))
+				Expect(tcpProbeCallCount).To(Equal(2))
+			})
+		})
+
+		It("Should restart the VM after the startup probe failure threshold is reached", func() {
+			doProbe()
+			Expect(vm.Spec.NextRestartTime).To(BeEmpty())
+			doProbe()
+			Expect(vm.Spec.NextRestartTime).To(Equal("now"))
+			Expect(vm.Status.StartupProbe.ConsecutiveFailures).To(BeZero())
+			Expect(vm.Status.StartupProbe.StartTime).To(BeNil())
+			Expect(fakeEvents).Should(Receive(ContainSubstring(vmopv1.VirtualMachineStartupProbeFailedReason)))
+			Expect(fakeEvents).Should(Receive(ContainSubstring(vmopv1.VirtualMachineNotStartedReason)))
+			Expect(fakeEvents).Should(Receive(ContainSubstring(vmopv1.VirtualMachineStartupProbeFailedReason)))
+			Expect(fakeEvents).Should(Receive(ContainSubstring(vmopv1.VirtualMachineStartupRestartReason)))
+
+			By("not running the startup probe while the restart is pending", func() {
+				doProbe()
+				Expect(startupProbeCallCount).To(Equal(2))
+			})
+		})
+
+		When("there is no readiness probe", func() {
+			BeforeEach(func() {
+				startupResult = probe.Success
+				vm.Spec.ReadinessProbe = nil
+				Expect(fakeClient.Update(context.Background(), vm)).Should(Succeed())
+			})
+
+			It("Should mark the VM ready once the startup probe succeeds", func() {
+				doProbe()
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionTrue)
+			})
+		})
+
+		When("the VM is powered off", func() {
+			It("Should reset the probe status", func() {
+				startupResult = probe.Success
+				doProbe()
+				Expect(vm.Status.StartupProbe.Started).To(BeTrue())
+
+				vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOff
+				Expect(fakeClient.Status().Update(context.Background(), vm)).Should(Succeed())
+				var err error
+				ctx, err = testWorker.CreateProbeContext(vm)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(testWorker.ProcessProbeResult(ctx, probe.Failure, nil)).Should(Succeed())
+				Expect(fakeClient.Get(context.Background(), vmKey, vm)).Should(Succeed())
+				Expect(vm.Status.StartupProbe).To(BeNil())
+				Expect(vm.Status.ReadinessProbe).To(BeNil())
+				checkReadyCondition(fakeClient, vmKey, metav1.ConditionFalse)
+			})
+		})
+	})
 })
 
 func TestReadinessProbeWorker(t *testing.T) {
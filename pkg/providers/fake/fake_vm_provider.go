@@ -7,11 +7,14 @@ package fake
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"sync"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vapi/library"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,12 +41,19 @@ type funcs struct {
 	CleanupVirtualMachineFn             func(ctx context.Context, vm *vmopv1.VirtualMachine) error
 	PublishVirtualMachineFn             func(ctx context.Context, vm *vmopv1.VirtualMachine,
 		vmPub *vmopv1.VirtualMachinePublishRequest, cl *imgregv1a1.ContentLibrary, actID string) (string, error)
-	GetVirtualMachineGuestHeartbeatFn  func(ctx context.Context, vm *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error)
-	GetVirtualMachinePropertiesFn      func(ctx context.Context, vm *vmopv1.VirtualMachine, propertyPaths []string) (map[string]any, error)
-	GetVirtualMachineFilesFn           func(ctx context.Context, vm *vmopv1.VirtualMachine) ([]vimtypes.VirtualMachineFileLayoutExFileInfo, error)
-	GetVirtualMachineWebMKSTicketFn    func(ctx context.Context, vm *vmopv1.VirtualMachine, pubKey string) (string, error)
-	GetVirtualMachineHardwareVersionFn func(ctx context.Context, vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error)
-	PlaceVirtualMachineGroupFn         func(ctx context.Context, group *vmopv1.VirtualMachineGroup, groupPlacement []providers.VMGroupPlacement) error
+	PublishVirtualMachineToOCIFn func(ctx context.Context, vm *vmopv1.VirtualMachine,
+		vmPub *vmopv1.VirtualMachinePublishRequest, actID string) (string, error)
+	GetVirtualMachineGuestHeartbeatFn        func(ctx context.Context, vm *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error)
+	GetVirtualMachinesResourceUsageFn        func(ctx context.Context) ([]providers.VirtualMachineResourceUsage, error)
+	GetVirtualMachinePropertiesFn            func(ctx context.Context, vm *vmopv1.VirtualMachine, propertyPaths []string) (map[string]any, error)
+	RunVirtualMachineGuestProgramFn          func(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, spec vimtypes.GuestProgramSpec) (int32, error)
+	PutVirtualMachineGuestFileFn             func(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, guestPath string, r io.Reader, size int64, overwrite bool) error
+	GetVirtualMachineGuestFileFn             func(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, guestPath string, maxSize int64) (io.ReadCloser, int64, error)
+	GetVirtualMachineFilesFn                 func(ctx context.Context, vm *vmopv1.VirtualMachine) ([]vimtypes.VirtualMachineFileLayoutExFileInfo, error)
+	GetVirtualMachineWebMKSTicketFn          func(ctx context.Context, vm *vmopv1.VirtualMachine, pubKey string) (string, error)
+	GetVirtualMachineSerialConsoleEndpointFn func(ctx context.Context, vm *vmopv1.VirtualMachine) (string, error)
+	GetVirtualMachineHardwareVersionFn       func(ctx context.Context, vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error)
+	PlaceVirtualMachineGroupFn               func(ctx context.Context, group *vmopv1.VirtualMachineGroup, groupPlacement []providers.VMGroupPlacement) error
 
 	GetItemFromLibraryByNameFn   func(ctx context.Context, contentLibrary, itemName string) (*library.Item, error)
 	GetItemFromInventoryByNameFn func(ctx context.Context, contentLibrary, itemName string) (object.Reference, error)
@@ -67,6 +77,9 @@ type funcs struct {
 	DeleteSnapshotFn           func(ctx context.Context, vmSnapshot *vmopv1.VirtualMachineSnapshot, vm *vmopv1.VirtualMachine, removeChildren bool, consolidate *bool) (bool, error)
 	GetSnapshotSizeFn          func(ctx context.Context, vmSnapshotName string, vm *vmopv1.VirtualMachine) (int64, error)
 	SyncVMSnapshotTreeStatusFn func(ctx context.Context, vm *vmopv1.VirtualMachine) error
+
+	ExportVirtualMachineSnapshotFn       func(ctx context.Context, vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport, vmSnapshot *vmopv1.VirtualMachineSnapshot, vm *vmopv1.VirtualMachine) error
+	ImportVirtualMachineSnapshotVolumeFn func(ctx context.Context, vmSnapshotImport *vmopv1.VirtualMachineSnapshotImport, volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus, fileName string, pvc *corev1.PersistentVolumeClaim) error
 }
 
 type VMProvider struct {
@@ -156,6 +169,23 @@ func (s *VMProvider) PublishVirtualMachine(
 	return "dummy-id", nil
 }
 
+func (s *VMProvider) PublishVirtualMachineToOCI(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vmPub *vmopv1.VirtualMachinePublishRequest,
+	actID string) (string, error) {
+
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	s.isPublishVMCalled = true
+	if s.PublishVirtualMachineToOCIFn != nil {
+		return s.PublishVirtualMachineToOCIFn(ctx, vm, vmPub, actID)
+	}
+	return "sha256:" + strings.Repeat("0", 64), nil
+}
+
 func (s *VMProvider) GetVirtualMachineGuestHeartbeat(ctx context.Context, vm *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error) {
 	_ = pkgcfg.FromContext(ctx)
 
@@ -167,6 +197,17 @@ func (s *VMProvider) GetVirtualMachineGuestHeartbeat(ctx context.Context, vm *vm
 	return "", nil
 }
 
+func (s *VMProvider) GetVirtualMachinesResourceUsage(ctx context.Context) ([]providers.VirtualMachineResourceUsage, error) {
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.GetVirtualMachinesResourceUsageFn != nil {
+		return s.GetVirtualMachinesResourceUsageFn(ctx)
+	}
+	return nil, nil
+}
+
 func (s *VMProvider) GetVirtualMachineProperties(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine,
@@ -182,6 +223,59 @@ func (s *VMProvider) GetVirtualMachineProperties(
 	return nil, nil
 }
 
+func (s *VMProvider) RunVirtualMachineGuestProgram(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	spec vimtypes.GuestProgramSpec) (int32, error) {
+
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.RunVirtualMachineGuestProgramFn != nil {
+		return s.RunVirtualMachineGuestProgramFn(ctx, vm, auth, spec)
+	}
+	return 0, nil
+}
+
+func (s *VMProvider) PutVirtualMachineGuestFile(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	guestPath string,
+	r io.Reader,
+	size int64,
+	overwrite bool) error {
+
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.PutVirtualMachineGuestFileFn != nil {
+		return s.PutVirtualMachineGuestFileFn(ctx, vm, auth, guestPath, r, size, overwrite)
+	}
+	_, err := io.Copy(io.Discard, io.LimitReader(r, size))
+	return err
+}
+
+func (s *VMProvider) GetVirtualMachineGuestFile(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	guestPath string,
+	maxSize int64) (io.ReadCloser, int64, error) {
+
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.GetVirtualMachineGuestFileFn != nil {
+		return s.GetVirtualMachineGuestFileFn(ctx, vm, auth, guestPath, maxSize)
+	}
+	return io.NopCloser(strings.NewReader("")), 0, nil
+}
+
 func (s *VMProvider) GetVirtualMachineFiles(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine) ([]vimtypes.VirtualMachineFileLayoutExFileInfo, error) {
@@ -207,6 +301,17 @@ func (s *VMProvider) GetVirtualMachineWebMKSTicket(ctx context.Context, vm *vmop
 	return "", nil
 }
 
+func (s *VMProvider) GetVirtualMachineSerialConsoleEndpoint(ctx context.Context, vm *vmopv1.VirtualMachine) (string, error) {
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.GetVirtualMachineSerialConsoleEndpointFn != nil {
+		return s.GetVirtualMachineSerialConsoleEndpointFn(ctx, vm)
+	}
+	return "", nil
+}
+
 func (s *VMProvider) GetVirtualMachineHardwareVersion(ctx context.Context, vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error) {
 	_ = pkgcfg.FromContext(ctx)
 
@@ -484,6 +589,36 @@ func (s *VMProvider) SyncVMSnapshotTreeStatus(ctx context.Context, vm *vmopv1.Vi
 	return nil
 }
 
+func (s *VMProvider) ExportVirtualMachineSnapshot(
+	ctx context.Context,
+	vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	vmSnapshot *vmopv1.VirtualMachineSnapshot,
+	vm *vmopv1.VirtualMachine) error {
+
+	s.Lock()
+	defer s.Unlock()
+	if s.ExportVirtualMachineSnapshotFn != nil {
+		return s.ExportVirtualMachineSnapshotFn(ctx, vmSnapshotExport, vmSnapshot, vm)
+	}
+	return nil
+}
+
+func (s *VMProvider) ImportVirtualMachineSnapshotVolume(
+	ctx context.Context,
+	vmSnapshotImport *vmopv1.VirtualMachineSnapshotImport,
+	volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus,
+	fileName string,
+	pvc *corev1.PersistentVolumeClaim) error {
+
+	s.Lock()
+	defer s.Unlock()
+	if s.ImportVirtualMachineSnapshotVolumeFn != nil {
+		return s.ImportVirtualMachineSnapshotVolumeFn(ctx, vmSnapshotImport, volStatus, fileName, pvc)
+	}
+	volStatus.FileName = fileName
+	return nil
+}
+
 func NewVMProvider() *VMProvider {
 	provider := VMProvider{
 		vmMap:    map[client.ObjectKey]*vmopv1.VirtualMachine{},
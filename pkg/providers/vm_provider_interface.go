@@ -7,10 +7,12 @@ package providers
 import (
 	"context"
 	"errors"
+	"io"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vapi/library"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
@@ -37,6 +39,14 @@ type VMGroupPlacement struct {
 	VMMembers []*vmopv1.VirtualMachine
 }
 
+// VirtualMachineResourceUsage is the CPU and memory usage of a VM as reported
+// by the hypervisor.
+type VirtualMachineResourceUsage struct {
+	VM               *vmopv1.VirtualMachine
+	CPUUsageMHz      int64
+	MemoryUsageBytes int64
+}
+
 // VirtualMachineProviderInterface is a pluggable interface for VM Providers.
 type VirtualMachineProviderInterface interface {
 	CreateOrUpdateVirtualMachine(ctx context.Context, vm *vmopv1.VirtualMachine) error
@@ -48,10 +58,29 @@ type VirtualMachineProviderInterface interface {
 	CleanupVirtualMachine(ctx context.Context, vm *vmopv1.VirtualMachine) error
 	PublishVirtualMachine(ctx context.Context, vm *vmopv1.VirtualMachine,
 		vmPub *vmopv1.VirtualMachinePublishRequest, cl *imgregv1a1.ContentLibrary, actID string) (string, error)
+	// PublishVirtualMachineToOCI pushes the VM to the OCI repository in the
+	// publish request's target and returns the digest of the pushed manifest.
+	PublishVirtualMachineToOCI(ctx context.Context, vm *vmopv1.VirtualMachine,
+		vmPub *vmopv1.VirtualMachinePublishRequest, actID string) (string, error)
 	GetVirtualMachineGuestHeartbeat(ctx context.Context, vm *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error)
 	GetVirtualMachineProperties(ctx context.Context, vm *vmopv1.VirtualMachine, propertyPaths []string) (map[string]any, error)
+	// GetVirtualMachinesResourceUsage returns the current CPU and memory usage
+	// of the powered on VMs.
+	GetVirtualMachinesResourceUsage(ctx context.Context) ([]VirtualMachineResourceUsage, error)
+	RunVirtualMachineGuestProgram(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, spec vimtypes.GuestProgramSpec) (int32, error)
+	// PutVirtualMachineGuestFile writes size bytes read from r to a file in
+	// the VM's guest using VMware Tools guest operations.
+	PutVirtualMachineGuestFile(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, guestPath string, r io.Reader, size int64, overwrite bool) error
+	// GetVirtualMachineGuestFile returns a reader for a file in the VM's guest
+	// using VMware Tools guest operations, and the size of the file. An error
+	// is returned if the file is larger than maxSize bytes. The caller must
+	// close the reader.
+	GetVirtualMachineGuestFile(ctx context.Context, vm *vmopv1.VirtualMachine, auth vimtypes.NamePasswordAuthentication, guestPath string, maxSize int64) (io.ReadCloser, int64, error)
 	GetVirtualMachineFiles(ctx context.Context, vm *vmopv1.VirtualMachine) ([]vimtypes.VirtualMachineFileLayoutExFileInfo, error)
 	GetVirtualMachineWebMKSTicket(ctx context.Context, vm *vmopv1.VirtualMachine, pubKey string) (string, error)
+	// GetVirtualMachineSerialConsoleEndpoint returns the address and port of
+	// the VM's network-backed serial port on the host on which the VM runs.
+	GetVirtualMachineSerialConsoleEndpoint(ctx context.Context, vm *vmopv1.VirtualMachine) (string, error)
 	GetVirtualMachineHardwareVersion(ctx context.Context, vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error)
 	PlaceVirtualMachineGroup(ctx context.Context, group *vmopv1.VirtualMachineGroup, groupPlacements []VMGroupPlacement) error
 
@@ -91,4 +120,13 @@ type VirtualMachineProviderInterface interface {
 	GetSnapshotSize(ctx context.Context, vmSnapshotName string, vm *vmopv1.VirtualMachine) (int64, error)
 	// SyncVMSnapshotTreeStatus syncs the VM's current and root snapshots status.
 	SyncVMSnapshotTreeStatus(ctx context.Context, vm *vmopv1.VirtualMachine) error
+	// ExportVirtualMachineSnapshot writes the disks and VM YAML of a snapshot
+	// to the target of the export, and records the result in its status.
+	ExportVirtualMachineSnapshot(ctx context.Context, vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+		vmSnapshot *vmopv1.VirtualMachineSnapshot, vm *vmopv1.VirtualMachine) error
+	// ImportVirtualMachineSnapshotVolume copies an exported disk for an import
+	// and registers the copy as the PVC. The progress of the copy is recorded
+	// in the volume's status, and a RequeueError is returned until it is done.
+	ImportVirtualMachineSnapshotVolume(ctx context.Context, vmSnapshotImport *vmopv1.VirtualMachineSnapshotImport,
+		volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus, fileName string, pvc *corev1.PersistentVolumeClaim) error
 }
@@ -69,6 +69,11 @@ const (
 	CloudInitGuestInfoUserdata         = "guestinfo.userdata"
 	CloudInitGuestInfoUserdataEncoding = "guestinfo.userdata.encoding"
 
+	// IgnitionGuestInfoConfigData and IgnitionGuestInfoConfigDataEncoding are
+	// the keys from which Ignition's VMware provider reads the config.
+	IgnitionGuestInfoConfigData         = "guestinfo.ignition.config.data"
+	IgnitionGuestInfoConfigDataEncoding = "guestinfo.ignition.config.data.encoding"
+
 	// CloudInitGuestInfoLocalIPv4Key and CloudInitGuestInfoLocalIPv6Key are the local IPs
 	// reported by the VMware datasource: https://bit.ly/3NJB534.
 	CloudInitGuestInfoLocalIPv4Key = "guestinfo.local-ipv4"
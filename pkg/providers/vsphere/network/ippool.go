@@ -0,0 +1,309 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+// IPAddressClaimName returns the name of the VirtualMachineIPAddressClaim
+// that records the allocation of the address from the pool. Since the name
+// is derived from just the pool name and the address, the API server ensures
+// an address is only ever claimed once.
+func IPAddressClaimName(poolName string, addr netip.Addr) string {
+	if addr.Is4() {
+		return poolName + "-" + addr.String()
+	}
+	// IP6 addresses are expanded since their colons are not valid in an
+	// object name, and "::" could otherwise result in a trailing dash.
+	b := addr.As16()
+	return poolName + "-" + hex.EncodeToString(b[:])
+}
+
+// ParseIPPoolSpec returns the parsed CIDRs of the pool, and the networks
+// within them that may not be allocated, which includes the exclusions and
+// the gateways.
+func ParseIPPoolSpec(
+	spec vmopv1.VirtualMachineIPPoolSpec) ([]netip.Prefix, []netip.Prefix, error) {
+
+	cidrs := make([]netip.Prefix, 0, len(spec.CIDRs))
+	for _, c := range spec.CIDRs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid CIDR %q: %w", c, err)
+		}
+		cidrs = append(cidrs, p.Masked())
+	}
+
+	excluded := make([]netip.Prefix, 0, len(spec.Exclusions)+2)
+	for _, e := range spec.Exclusions {
+		p, err := parseAddrOrPrefix(e)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid exclusion %q: %w", e, err)
+		}
+		excluded = append(excluded, p)
+	}
+
+	for _, gw := range []string{spec.Gateway4, spec.Gateway6} {
+		if gw == "" {
+			continue
+		}
+		a, err := netip.ParseAddr(gw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid gateway %q: %w", gw, err)
+		}
+		excluded = append(excluded, netip.PrefixFrom(a, a.BitLen()))
+	}
+
+	return cidrs, excluded, nil
+}
+
+func parseAddrOrPrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return p.Masked(), nil
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(a, a.BitLen()), nil
+}
+
+// allocateIPPoolAddresses allocates one address from the interface's IP pool
+// for each IP family of the pool's CIDRs, and applies the addresses, the
+// pool's gateways and nameservers to the result. Addresses already claimed
+// for this VM's interface are reused.
+func allocateIPPoolAddresses(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	interfaceSpec *vmopv1.VirtualMachineNetworkInterfaceSpec,
+	result *NetworkInterfaceResult) error {
+
+	pool := &vmopv1.VirtualMachineIPPool{}
+	poolKey := ctrlclient.ObjectKey{
+		Namespace: vmCtx.VM.Namespace,
+		Name:      interfaceSpec.IPPoolName,
+	}
+	if err := client.Get(vmCtx, poolKey, pool); err != nil {
+		return fmt.Errorf("failed to get IP pool %q: %w", poolKey.Name, err)
+	}
+
+	cidrs, excluded, err := ParseIPPoolSpec(pool.Spec)
+	if err != nil {
+		return fmt.Errorf("IP pool %q is invalid: %w", pool.Name, err)
+	}
+
+	claimList := &vmopv1.VirtualMachineIPAddressClaimList{}
+	if err := client.List(
+		vmCtx,
+		claimList,
+		ctrlclient.InNamespace(vmCtx.VM.Namespace)); err != nil {
+
+		return fmt.Errorf("failed to list IP address claims: %w", err)
+	}
+
+	var (
+		allocated = map[netip.Addr]struct{}{}
+		owned     = map[bool]netip.Prefix{}
+	)
+
+	for i := range claimList.Items {
+		claim := &claimList.Items[i]
+		if claim.Spec.IPPoolName != pool.Name {
+			continue
+		}
+		p, err := netip.ParsePrefix(claim.Spec.Address)
+		if err != nil {
+			continue
+		}
+		allocated[p.Addr()] = struct{}{}
+
+		if claim.Spec.VMName == vmCtx.VM.Name &&
+			claim.Spec.InterfaceName == interfaceSpec.Name &&
+			isOwnedBy(vmCtx.VM, claim) {
+
+			owned[p.Addr().Is4()] = p
+		}
+	}
+
+	for _, isIPv4 := range []bool{true, false} {
+		var familyCIDRs []netip.Prefix
+		for _, p := range cidrs {
+			if p.Addr().Is4() == isIPv4 {
+				familyCIDRs = append(familyCIDRs, p)
+			}
+		}
+		if len(familyCIDRs) == 0 {
+			continue
+		}
+
+		addr, ok := owned[isIPv4]
+		if !ok {
+			addr, err = claimIPPoolAddress(
+				vmCtx, client, pool, interfaceSpec.Name,
+				familyCIDRs, excluded, allocated)
+			if err != nil {
+				return err
+			}
+		}
+
+		gateway := pool.Spec.Gateway4
+		if !isIPv4 {
+			gateway = pool.Spec.Gateway6
+		}
+
+		result.IPConfigs = append(result.IPConfigs, NetworkInterfaceIPConfig{
+			IPCIDR:  addr.String(),
+			IsIPv4:  isIPv4,
+			Gateway: gateway,
+		})
+		result.IPAddressClaimNames = append(
+			result.IPAddressClaimNames,
+			IPAddressClaimName(pool.Name, addr.Addr()))
+	}
+
+	result.Nameservers = pool.Spec.Nameservers
+
+	return nil
+}
+
+// maxIPPoolClaimConflicts is the number of addresses that may be claimed by
+// other VMs while claimIPPoolAddress is trying to claim them before it gives
+// up, so the VM is reconciled again with an up-to-date list of the claims.
+const maxIPPoolClaimConflicts = 10
+
+// claimIPPoolAddress creates a claim for the first free address in the CIDRs.
+// Two VMs racing for the same address both try to create the claim of the
+// same name, so only one of them will succeed, and the other moves on to the
+// next address.
+//
+// The search is bounded since each of the excluded networks is skipped as a
+// whole, each of the allocated addresses is skipped once, and the search is
+// stopped after maxIPPoolClaimConflicts addresses were claimed by other VMs.
+func claimIPPoolAddress(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	pool *vmopv1.VirtualMachineIPPool,
+	interfaceName string,
+	cidrs []netip.Prefix,
+	excluded []netip.Prefix,
+	allocated map[netip.Addr]struct{}) (netip.Prefix, error) {
+
+	var conflicts int
+
+	for _, cidr := range cidrs {
+		// Skip the network address of IP4 networks and the Subnet-Router
+		// anycast address of IP6 networks.
+		for a := cidr.Addr().Next(); a.IsValid() && cidr.Contains(a); a = a.Next() {
+			if a.Is4() && !cidr.Contains(a.Next()) {
+				// Skip the broadcast address.
+				break
+			}
+			if _, ok := allocated[a]; ok {
+				continue
+			}
+			if last, ok := lastExcludedAddr(excluded, a); ok {
+				// Skip the rest of the excluded network.
+				a = last
+				continue
+			}
+
+			addr := netip.PrefixFrom(a, cidr.Bits())
+			claim := &vmopv1.VirtualMachineIPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      IPAddressClaimName(pool.Name, a),
+					Namespace: vmCtx.VM.Namespace,
+					Labels: map[string]string{
+						VMNameLabel:          vmCtx.VM.Name,
+						VMInterfaceNameLabel: interfaceName,
+					},
+				},
+				Spec: vmopv1.VirtualMachineIPAddressClaimSpec{
+					IPPoolName:    pool.Name,
+					Address:       addr.String(),
+					VMName:        vmCtx.VM.Name,
+					InterfaceName: interfaceName,
+				},
+			}
+
+			if err := controllerutil.SetControllerReference(
+				vmCtx.VM, claim, client.Scheme()); err != nil {
+
+				return netip.Prefix{}, err
+			}
+
+			if err := client.Create(vmCtx, claim); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					allocated[a] = struct{}{}
+					if conflicts++; conflicts >= maxIPPoolClaimConflicts {
+						return netip.Prefix{}, fmt.Errorf(
+							"IP pool %q addresses were claimed by other VMs %d times, will retry",
+							pool.Name, conflicts)
+					}
+					continue
+				}
+				return netip.Prefix{},
+					fmt.Errorf("failed to create IP address claim %q: %w", claim.Name, err)
+			}
+
+			vmCtx.Logger.Info("Allocated address from IP pool",
+				"pool", pool.Name, "address", addr.String(), "interface", interfaceName)
+
+			allocated[a] = struct{}{}
+			return addr, nil
+		}
+	}
+
+	return netip.Prefix{}, fmt.Errorf("IP pool %q has no free addresses", pool.Name)
+}
+
+// lastExcludedAddr returns the last address of the excluded networks that
+// contain the address. When the excluded networks overlap, the last address
+// of the largest network is returned.
+func lastExcludedAddr(excluded []netip.Prefix, a netip.Addr) (netip.Addr, bool) {
+	var (
+		last netip.Addr
+		ok   bool
+	)
+	for _, p := range excluded {
+		if !p.Contains(a) {
+			continue
+		}
+		if l := lastAddr(p); !ok || last.Less(l) {
+			last, ok = l, true
+		}
+	}
+	return last, ok
+}
+
+// lastAddr returns the last address of the network.
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Masked().Addr().As16()
+	hostBits := p.Addr().BitLen() - p.Bits()
+	for i := len(b) - 1; i >= 0 && hostBits > 0; i-- {
+		n := min(hostBits, 8)
+		b[i] |= byte(1<<n - 1)
+		hostBits -= n
+	}
+	if p.Addr().Is4() {
+		return netip.AddrFrom16(b).Unmap()
+	}
+	return netip.AddrFrom16(b)
+}
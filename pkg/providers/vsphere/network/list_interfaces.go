@@ -37,6 +37,7 @@ func ListOrphanedNetworkInterfaces(
 	expectedInterfaceNames := sets.Set[string]{}
 	for idx := range results.Results {
 		expectedInterfaceNames.Insert(results.Results[idx].ObjectName)
+		expectedInterfaceNames.Insert(results.Results[idx].IPAddressClaimNames...)
 	}
 
 	interfaces, err := listInterfacesWithIgnore(vmCtx, client, expectedInterfaceNames)
@@ -106,7 +107,19 @@ func listInterfacesWithIgnore(
 		}
 
 	case pkgcfg.NetworkProviderTypeNamed:
-		// No objects.
+		// The only objects are the claims for addresses allocated from IP pools.
+		var list vmopv1.VirtualMachineIPAddressClaimList
+		if err := client.List(vmCtx, &list, ctrlclient.InNamespace(vmCtx.VM.Namespace)); err != nil {
+			return nil, err
+		}
+
+		for i := range list.Items {
+			if list.Items[i].Spec.VMName == vmCtx.VM.Name &&
+				!ignore.Has(list.Items[i].Name) && isOwnedBy(vmCtx.VM, &list.Items[i]) {
+
+				objList = append(objList, &list.Items[i])
+			}
+		}
 
 	default:
 		return nil, fmt.Errorf("unsupported network provider envvar value: %q", networkType)
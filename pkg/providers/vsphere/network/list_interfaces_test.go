@@ -146,4 +146,49 @@ var _ = Describe("ListOrphanedNetworkInterfaces", func() {
 		Entry("NSX-T", builder.NetworkEnvNSXT),
 		Entry("VPC", builder.NetworkEnvVPC),
 	)
+
+	Context("Network Env Named", func() {
+		createClaim := func(name, vmName string, makeOwner bool) ctrlclient.Object {
+			claim := &vmopv1.VirtualMachineIPAddressClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: vm.Namespace,
+				},
+				Spec: vmopv1.VirtualMachineIPAddressClaimSpec{
+					IPPoolName:    "my-pool",
+					Address:       "192.168.10.10/24",
+					VMName:        vmName,
+					InterfaceName: "eth0",
+				},
+			}
+			if makeOwner {
+				Expect(ctrlutil.SetOwnerReference(vm, claim, ctx.Client.Scheme())).To(Succeed())
+			}
+			return claim
+		}
+
+		BeforeEach(func() {
+			testConfig.WithNetworkEnv = builder.NetworkEnvNamed
+		})
+
+		It("Returns just orphaned owned IP address claims", func() {
+			ownedClaim1 := createClaim("my-pool-192.168.10.10", vm.Name, true)
+			Expect(ctx.Client.Create(ctx, ownedClaim1)).To(Succeed())
+			ownedClaim2 := createClaim("my-pool-192.168.10.11", vm.Name, true)
+			Expect(ctx.Client.Create(ctx, ownedClaim2)).To(Succeed())
+			unownedClaim := createClaim("my-pool-192.168.10.12", "other-vm", false)
+			Expect(ctx.Client.Create(ctx, unownedClaim)).To(Succeed())
+
+			results.Results = []network.NetworkInterfaceResult{
+				{
+					IPAddressClaimNames: []string{ownedClaim2.GetName()},
+				},
+			}
+
+			err := network.ListOrphanedNetworkInterfaces(vmCtx, ctx.Client, &results)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.OrphanedNetworkInterfaces).To(HaveLen(1))
+			Expect(results.OrphanedNetworkInterfaces[0].GetName()).To(Equal(ownedClaim1.GetName()))
+		})
+	})
 })
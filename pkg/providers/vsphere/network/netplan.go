@@ -5,6 +5,7 @@
 package network
 
 import (
+	"strconv"
 	"strings"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
@@ -13,7 +14,12 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
-func NetPlanCustomization(result NetworkInterfaceResults, vlans []vmopv1.VirtualMachineNetworkVLANSpec) (*netplan.Network, error) {
+func NetPlanCustomization(
+	result NetworkInterfaceResults,
+	vlans []vmopv1.VirtualMachineNetworkVLANSpec,
+	bonds []vmopv1.VirtualMachineNetworkBondSpec,
+	bridges []vmopv1.VirtualMachineNetworkBridgeSpec) (*netplan.Network, error) {
+
 	netPlan := &netplan.Network{
 		Version:   constants.NetPlanVersion,
 		Ethernets: make(map[string]netplan.Ethernet),
@@ -99,6 +105,100 @@ func NetPlanCustomization(result NetworkInterfaceResults, vlans []vmopv1.Virtual
 		netPlan.Ethernets[r.Name] = npEth
 	}
 
+	// The IP configuration of a bond or bridge is taken from one of its
+	// members, so the bonds and bridges must be rendered before any member
+	// is reset to an L2-only configuration.
+	l3Configs := make(map[string]netPlanL3Config, len(netPlan.Ethernets))
+	for name, eth := range netPlan.Ethernets {
+		l3Configs[name] = netPlanL3Config{
+			Addresses:   eth.Addresses,
+			Dhcp4:       eth.Dhcp4,
+			Dhcp6:       eth.Dhcp6,
+			AcceptRa:    eth.AcceptRa,
+			Gateway4:    eth.Gateway4,
+			Gateway6:    eth.Gateway6,
+			MTU:         eth.MTU,
+			Nameservers: eth.Nameservers,
+			Routes:      eth.Routes,
+		}
+	}
+
+	if len(bonds) > 0 {
+		netPlan.Bonds = make(map[string]netplan.Bond, len(bonds))
+		for _, bond := range bonds {
+			src := bond.Interfaces[0]
+			if p := bond.Parameters; p != nil && p.Primary != "" {
+				src = p.Primary
+			}
+
+			l3 := l3Configs[src]
+			npBond := netplan.Bond{
+				Interfaces:  bond.Interfaces,
+				Parameters:  netPlanBondParameters(bond.Parameters),
+				Addresses:   l3.Addresses,
+				Dhcp4:       l3.Dhcp4,
+				Dhcp6:       l3.Dhcp6,
+				AcceptRa:    l3.AcceptRa,
+				Gateway4:    l3.Gateway4,
+				Gateway6:    l3.Gateway6,
+				MTU:         l3.MTU,
+				Nameservers: l3.Nameservers,
+				Routes:      l3.Routes,
+			}
+
+			netPlan.Bonds[bond.Name] = npBond
+			l3Configs[bond.Name] = l3
+		}
+	}
+
+	if len(bridges) > 0 {
+		netPlan.Bridges = make(map[string]netplan.Bridge, len(bridges))
+		for _, bridge := range bridges {
+			l3 := l3Configs[bridge.Interfaces[0]]
+			npBridge := netplan.Bridge{
+				Interfaces:  bridge.Interfaces,
+				Parameters:  netPlanBridgeParameters(bridge),
+				Addresses:   l3.Addresses,
+				Dhcp4:       l3.Dhcp4,
+				Dhcp6:       l3.Dhcp6,
+				AcceptRa:    l3.AcceptRa,
+				Gateway4:    l3.Gateway4,
+				Gateway6:    l3.Gateway6,
+				MTU:         l3.MTU,
+				Nameservers: l3.Nameservers,
+				Routes:      l3.Routes,
+			}
+
+			netPlan.Bridges[bridge.Name] = npBridge
+		}
+	}
+
+	// Reset the members of bonds and bridges to an L2-only configuration.
+	for _, bond := range bonds {
+		for _, name := range bond.Interfaces {
+			if eth, ok := netPlan.Ethernets[name]; ok {
+				netPlan.Ethernets[name] = netPlanL2OnlyEthernet(eth)
+			}
+		}
+	}
+	for _, bridge := range bridges {
+		for _, name := range bridge.Interfaces {
+			if eth, ok := netPlan.Ethernets[name]; ok {
+				netPlan.Ethernets[name] = netPlanL2OnlyEthernet(eth)
+			} else if bond, ok := netPlan.Bonds[name]; ok {
+				bond.Addresses = nil
+				bond.Dhcp4 = ptr.To(false)
+				bond.Dhcp6 = ptr.To(false)
+				bond.AcceptRa = ptr.To(false)
+				bond.Gateway4 = nil
+				bond.Gateway6 = nil
+				bond.Nameservers = nil
+				bond.Routes = nil
+				netPlan.Bonds[name] = bond
+			}
+		}
+	}
+
 	if len(vlans) > 0 {
 		netPlan.Vlans = make(map[string]netplan.VLAN, len(vlans))
 		for _, vlan := range vlans {
@@ -119,6 +219,89 @@ func NetPlanCustomization(result NetworkInterfaceResults, vlans []vmopv1.Virtual
 	return netPlan, nil
 }
 
+// netPlanL3Config is the IP configuration that is moved from a member to the
+// bond or bridge that aggregates it.
+type netPlanL3Config struct {
+	Addresses   []netplan.Address
+	Dhcp4       *bool
+	Dhcp6       *bool
+	AcceptRa    *bool
+	Gateway4    *string
+	Gateway6    *string
+	MTU         *int64
+	Nameservers *netplan.Nameserver
+	Routes      []netplan.Route
+}
+
+// netPlanL2OnlyEthernet returns a copy of the provided ethernet without any IP
+// configuration. The MTU is retained since it must be set on the members of a
+// bond or bridge as well.
+func netPlanL2OnlyEthernet(eth netplan.Ethernet) netplan.Ethernet {
+	eth.Addresses = nil
+	eth.Dhcp4 = ptr.To(false)
+	eth.Dhcp6 = ptr.To(false)
+	eth.AcceptRa = ptr.To(false)
+	eth.Gateway4 = nil
+	eth.Gateway6 = nil
+	eth.Nameservers = nil
+	eth.Routes = nil
+	return eth
+}
+
+func netPlanBondParameters(
+	p *vmopv1.VirtualMachineNetworkBondParameters) *netplan.BondParameters {
+
+	if p == nil {
+		return nil
+	}
+
+	var np netplan.BondParameters
+	if p.Mode != "" {
+		np.Mode = ptr.To(netplan.BondMode(p.Mode))
+	}
+	if p.Primary != "" {
+		np.Primary = ptr.To(p.Primary)
+	}
+	if p.LACPRate != "" {
+		np.LACPRate = ptr.To(netplan.LACPRate(p.LACPRate))
+	}
+	if p.MIIMonitorIntervalMilliseconds > 0 {
+		np.MiiMonitorInterval = ptr.To(strconv.FormatInt(p.MIIMonitorIntervalMilliseconds, 10))
+	}
+	if p.UpDelayMilliseconds > 0 {
+		np.UpDelay = ptr.To(strconv.FormatInt(p.UpDelayMilliseconds, 10))
+	}
+	if p.DownDelayMilliseconds > 0 {
+		np.DownDelay = ptr.To(strconv.FormatInt(p.DownDelayMilliseconds, 10))
+	}
+	if p.TransmitHashPolicy != "" {
+		np.TransmitHashPolicy = ptr.To(netplan.TransmitHashPolicy(p.TransmitHashPolicy))
+	}
+	if p.MinLinks > 0 {
+		np.MinLinks = ptr.To(p.MinLinks)
+	}
+
+	return &np
+}
+
+func netPlanBridgeParameters(
+	bridge vmopv1.VirtualMachineNetworkBridgeSpec) *netplan.BridgeParameters {
+
+	if bridge.STP == nil && bridge.ForwardDelaySeconds == 0 {
+		return nil
+	}
+
+	var np netplan.BridgeParameters
+	if bridge.STP != nil {
+		np.Stp = ptr.To(*bridge.STP)
+	}
+	if bridge.ForwardDelaySeconds > 0 {
+		np.ForwardDelay = ptr.To(strconv.FormatInt(bridge.ForwardDelaySeconds, 10))
+	}
+
+	return &np
+}
+
 // NormalizeNetplanMac normalizes the mac address format to one compatible with netplan.
 func NormalizeNetplanMac(mac string) string {
 	mac = strings.ReplaceAll(mac, "-", ":")
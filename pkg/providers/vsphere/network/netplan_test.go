@@ -38,6 +38,8 @@ var _ = Describe("Netplan", func() {
 		var (
 			results network.NetworkInterfaceResults
 			vlans   []vmopv1.VirtualMachineNetworkVLANSpec
+			bonds   []vmopv1.VirtualMachineNetworkBondSpec
+			bridges []vmopv1.VirtualMachineNetworkBridgeSpec
 			config  *netplan.Network
 			err     error
 		)
@@ -45,11 +47,13 @@ var _ = Describe("Netplan", func() {
 		BeforeEach(func() {
 			results = network.NetworkInterfaceResults{}
 			vlans = nil
+			bonds = nil
+			bridges = nil
 			config = nil
 		})
 
 		JustBeforeEach(func() {
-			config, err = network.NetPlanCustomization(results, vlans)
+			config, err = network.NetPlanCustomization(results, vlans, bonds, bridges)
 		})
 
 		Context("IPv4/6 Static adapter", func() {
@@ -515,5 +519,163 @@ var _ = Describe("Netplan", func() {
 				})
 			})
 		})
+
+		Context("Bonds and Bridges", func() {
+			const (
+				ifName2       = "my-interface-2"
+				guestDevName2 = "eth43"
+				macAddr2      = "50-8A-80-9D-28-23"
+				bondName      = "bond0"
+				bridgeName    = "br0"
+			)
+
+			BeforeEach(func() {
+				results.Results = []network.NetworkInterfaceResult{
+					{
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  ipv4CIDR,
+								IsIPv4:  true,
+								Gateway: ipv4Gateway,
+							},
+						},
+						MacAddress:      macAddr1,
+						Name:            ifName,
+						GuestDeviceName: guestDevName,
+						MTU:             9000,
+						Nameservers:     []string{dnsServer1},
+						SearchDomains:   []string{searchDomain1},
+					},
+					{
+						MacAddress:      macAddr2,
+						Name:            ifName2,
+						GuestDeviceName: guestDevName2,
+						DHCP4:           true,
+					},
+				}
+			})
+
+			assertL2Only := func(eth netplan.Ethernet) {
+				ExpectWithOffset(1, eth.Match).ToNot(BeNil())
+				ExpectWithOffset(1, eth.Addresses).To(BeEmpty())
+				ExpectWithOffset(1, eth.Dhcp4).To(HaveValue(BeFalse()))
+				ExpectWithOffset(1, eth.Dhcp6).To(HaveValue(BeFalse()))
+				ExpectWithOffset(1, eth.AcceptRa).To(HaveValue(BeFalse()))
+				ExpectWithOffset(1, eth.Gateway4).To(BeNil())
+				ExpectWithOffset(1, eth.Nameservers).To(BeNil())
+			}
+
+			Context("Active-backup bond", func() {
+				BeforeEach(func() {
+					bonds = []vmopv1.VirtualMachineNetworkBondSpec{
+						{
+							Name:       bondName,
+							Interfaces: []string{ifName, ifName2},
+							Parameters: &vmopv1.VirtualMachineNetworkBondParameters{
+								Mode:                           vmopv1.VirtualMachineNetworkBondModeActiveBackup,
+								Primary:                        ifName,
+								MIIMonitorIntervalMilliseconds: 100,
+							},
+						},
+					}
+				})
+
+				It("returns success with the bond configured", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config).ToNot(BeNil())
+
+					Expect(config.Ethernets).To(HaveLen(2))
+					assertL2Only(config.Ethernets[ifName])
+					assertL2Only(config.Ethernets[ifName2])
+					Expect(config.Ethernets[ifName].MTU).To(HaveValue(BeEquivalentTo(9000)))
+
+					Expect(config.Bonds).To(HaveLen(1))
+					Expect(config.Bonds).To(HaveKey(bondName))
+					bond := config.Bonds[bondName]
+					Expect(bond.Interfaces).To(Equal([]string{ifName, ifName2}))
+					Expect(bond.Addresses).To(HaveLen(1))
+					Expect(bond.Addresses[0].String).To(HaveValue(Equal(ipv4CIDR)))
+					Expect(bond.Gateway4).To(HaveValue(Equal(ipv4Gateway)))
+					Expect(bond.Dhcp4).To(HaveValue(BeFalse()))
+					Expect(bond.MTU).To(HaveValue(BeEquivalentTo(9000)))
+					Expect(bond.Nameservers).ToNot(BeNil())
+					Expect(bond.Nameservers.Addresses).To(Equal([]string{dnsServer1}))
+
+					Expect(bond.Parameters).ToNot(BeNil())
+					Expect(bond.Parameters.Mode).To(HaveValue(BeEquivalentTo("active-backup")))
+					Expect(bond.Parameters.Primary).To(HaveValue(Equal(ifName)))
+					Expect(bond.Parameters.MiiMonitorInterval).To(HaveValue(Equal("100")))
+					Expect(bond.Parameters.LACPRate).To(BeNil())
+
+					Expect(config.Bridges).To(BeNil())
+				})
+			})
+
+			Context("802.3ad bond without a primary", func() {
+				BeforeEach(func() {
+					bonds = []vmopv1.VirtualMachineNetworkBondSpec{
+						{
+							Name:       bondName,
+							Interfaces: []string{ifName2, ifName},
+							Parameters: &vmopv1.VirtualMachineNetworkBondParameters{
+								Mode:               vmopv1.VirtualMachineNetworkBondMode8023AD,
+								LACPRate:           vmopv1.VirtualMachineNetworkBondLACPRateFast,
+								TransmitHashPolicy: vmopv1.VirtualMachineNetworkBondTransmitHashPolicyLayer34,
+							},
+						},
+					}
+				})
+
+				It("uses the IP configuration of the first member", func() {
+					Expect(err).ToNot(HaveOccurred())
+					bond := config.Bonds[bondName]
+					Expect(bond.Dhcp4).To(HaveValue(BeTrue()))
+					Expect(bond.Addresses).To(BeEmpty())
+					Expect(bond.Parameters.LACPRate).To(HaveValue(BeEquivalentTo("fast")))
+					Expect(bond.Parameters.TransmitHashPolicy).To(HaveValue(BeEquivalentTo("layer3+4")))
+				})
+			})
+
+			Context("Bridge over a bond", func() {
+				BeforeEach(func() {
+					bonds = []vmopv1.VirtualMachineNetworkBondSpec{
+						{
+							Name:       bondName,
+							Interfaces: []string{ifName},
+						},
+					}
+					bridges = []vmopv1.VirtualMachineNetworkBridgeSpec{
+						{
+							Name:                bridgeName,
+							Interfaces:          []string{bondName, ifName2},
+							STP:                 ptr.To(false),
+							ForwardDelaySeconds: 4,
+						},
+					}
+				})
+
+				It("returns success with the bridge configured", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					assertL2Only(config.Ethernets[ifName])
+					assertL2Only(config.Ethernets[ifName2])
+
+					bond := config.Bonds[bondName]
+					Expect(bond.Parameters).To(BeNil())
+					Expect(bond.Addresses).To(BeEmpty())
+					Expect(bond.Dhcp4).To(HaveValue(BeFalse()))
+
+					Expect(config.Bridges).To(HaveKey(bridgeName))
+					bridge := config.Bridges[bridgeName]
+					Expect(bridge.Interfaces).To(Equal([]string{bondName, ifName2}))
+					Expect(bridge.Addresses).To(HaveLen(1))
+					Expect(bridge.Addresses[0].String).To(HaveValue(Equal(ipv4CIDR)))
+					Expect(bridge.Gateway4).To(HaveValue(Equal(ipv4Gateway)))
+					Expect(bridge.Parameters).ToNot(BeNil())
+					Expect(bridge.Parameters.Stp).To(HaveValue(BeFalse()))
+					Expect(bridge.Parameters.ForwardDelay).To(HaveValue(Equal("4")))
+				})
+			})
+		})
 	})
 })
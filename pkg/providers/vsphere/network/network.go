@@ -58,6 +58,10 @@ type NetworkInterfaceResult struct {
 	Device    vimtypes.BaseVirtualDevice
 	DeviceKey int32
 
+	// IPAddressClaimNames are the names of the VirtualMachineIPAddressClaims
+	// for the addresses allocated from the interface's IP pool.
+	IPAddressClaimNames []string
+
 	// Fields from the InterfaceSpec used later during customization.
 	Name            string
 	GuestDeviceName string
@@ -158,7 +162,7 @@ func CreateAndWaitForNetworkInterfaces(
 		case pkgcfg.NetworkProviderTypeVPC:
 			result, err = createVPCNetworkInterface(vmCtx, client, vimClient, clusterMoRef, &interfaceSpec)
 		case pkgcfg.NetworkProviderTypeNamed:
-			result, err = createNamedNetworkInterface(vmCtx, finder, &interfaceSpec)
+			result, err = createNamedNetworkInterface(vmCtx, client, finder, &interfaceSpec)
 		default:
 			err = fmt.Errorf("unsupported network provider envvar value: %q", networkType)
 		}
@@ -290,7 +294,8 @@ func applyInterfaceSpecToResult(
 
 	if n := interfaceSpec.Nameservers; len(n) > 0 {
 		result.Nameservers = n
-	} else if defaultToGlobalNameservers {
+	} else if len(result.Nameservers) == 0 && defaultToGlobalNameservers {
+		// Nameservers from the interface's IP pool take precedence.
 		result.Nameservers = networkSpec.Nameservers
 	}
 
@@ -303,6 +308,7 @@ func applyInterfaceSpecToResult(
 
 func createNamedNetworkInterface(
 	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
 	finder *find.Finder,
 	interfaceSpec *vmopv1.VirtualMachineNetworkInterfaceSpec) (*NetworkInterfaceResult, error) {
 
@@ -328,11 +334,19 @@ func createNamedNetworkInterface(
 		return nil, fmt.Errorf("unable to find named network %q: %w", networkRefName, err)
 	}
 
-	return &NetworkInterfaceResult{
+	result := &NetworkInterfaceResult{
 		NetworkID:  networkRefName,
 		Backing:    backing,
 		MacAddress: interfaceSpec.MACAddr,
-	}, nil
+	}
+
+	if interfaceSpec.IPPoolName != "" {
+		if err := allocateIPPoolAddresses(vmCtx, client, interfaceSpec, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
 // NetOPCRName returns the name to be used for the NetOP NetworkInterface CR.
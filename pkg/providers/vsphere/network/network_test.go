@@ -5,6 +5,7 @@
 package network_test
 
 import (
+	"net/netip"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -277,6 +278,178 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 				Expect(results.Results).To(BeEmpty())
 			})
 		})
+
+		Context("IP pool", func() {
+			const poolName = "my-pool"
+
+			var pool *vmopv1.VirtualMachineIPPool
+
+			newClaim := func(vmName, interfaceName, address string, owned bool) *vmopv1.VirtualMachineIPAddressClaim {
+				prefix := netip.MustParsePrefix(address)
+				claim := &vmopv1.VirtualMachineIPAddressClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      network.IPAddressClaimName(poolName, prefix.Addr()),
+						Namespace: vm.Namespace,
+					},
+					Spec: vmopv1.VirtualMachineIPAddressClaimSpec{
+						IPPoolName:    poolName,
+						Address:       address,
+						VMName:        vmName,
+						InterfaceName: interfaceName,
+					},
+				}
+				if owned {
+					claim.OwnerReferences = []metav1.OwnerReference{
+						{
+							APIVersion: vmopv1.GroupVersion.String(),
+							Kind:       "VirtualMachine",
+							Name:       vm.Name,
+							UID:        vm.UID,
+							Controller: ptr.To(true),
+						},
+					}
+				}
+				return claim
+			}
+
+			BeforeEach(func() {
+				pool = &vmopv1.VirtualMachineIPPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      poolName,
+						Namespace: vm.Namespace,
+					},
+					Spec: vmopv1.VirtualMachineIPPoolSpec{
+						CIDRs:       []string{"192.168.10.0/29", "2001:db8:101::/64"},
+						Exclusions:  []string{"192.168.10.2"},
+						Gateway4:    "192.168.10.1",
+						Gateway6:    "2001:db8:101::1",
+						Nameservers: []string{"192.168.10.53"},
+					},
+				}
+				initObjects = append(initObjects, pool)
+
+				networkSpec.Interfaces = []vmopv1.VirtualMachineNetworkInterfaceSpec{
+					{
+						Name:       "eth0",
+						Network:    &common.PartialObjectRef{Name: networkName},
+						IPPoolName: poolName,
+					},
+				}
+			})
+
+			It("allocates an address for each IP family", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results.Results).To(HaveLen(1))
+
+				result := results.Results[0]
+				Expect(result.IPConfigs).To(HaveExactElements(
+					network.NetworkInterfaceIPConfig{
+						IPCIDR:  "192.168.10.3/29",
+						IsIPv4:  true,
+						Gateway: "192.168.10.1",
+					},
+					network.NetworkInterfaceIPConfig{
+						IPCIDR:  "2001:db8:101::2/64",
+						IsIPv4:  false,
+						Gateway: "2001:db8:101::1",
+					},
+				))
+				Expect(result.Nameservers).To(HaveExactElements("192.168.10.53"))
+				Expect(result.IPAddressClaimNames).To(HaveExactElements(
+					poolName+"-192.168.10.3",
+					poolName+"-20010db8010100000000000000000002",
+				))
+
+				By("creates claims owned by the VM", func() {
+					claim := &vmopv1.VirtualMachineIPAddressClaim{}
+					key := client.ObjectKey{Namespace: vm.Namespace, Name: poolName + "-192.168.10.3"}
+					Expect(ctx.Client.Get(ctx, key, claim)).To(Succeed())
+					Expect(claim.Spec.IPPoolName).To(Equal(poolName))
+					Expect(claim.Spec.Address).To(Equal("192.168.10.3/29"))
+					Expect(claim.Spec.VMName).To(Equal(vm.Name))
+					Expect(claim.Spec.InterfaceName).To(Equal("eth0"))
+					Expect(claim.OwnerReferences).To(HaveLen(1))
+					Expect(claim.OwnerReferences[0].UID).To(Equal(vm.UID))
+				})
+			})
+
+			When("the VM already has a claim", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, newClaim(vm.Name, "eth0", "192.168.10.5/29", true))
+				})
+
+				It("reuses the claimed address", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results.Results).To(HaveLen(1))
+					Expect(results.Results[0].IPConfigs[0].IPCIDR).To(Equal("192.168.10.5/29"))
+				})
+			})
+
+			When("an address is claimed by another VM", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, newClaim("other-vm", "eth0", "192.168.10.3/29", false))
+				})
+
+				It("allocates the next free address", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results.Results).To(HaveLen(1))
+					Expect(results.Results[0].IPConfigs[0].IPCIDR).To(Equal("192.168.10.4/29"))
+				})
+			})
+
+			When("the pool excludes networks", func() {
+				BeforeEach(func() {
+					pool.Spec.Exclusions = []string{
+						"192.168.10.0/30",
+						"192.168.10.4",
+						"2001:db8:101::/65",
+						"2001:db8:101:0:8000::/96",
+					}
+				})
+
+				It("skips the excluded networks", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results.Results).To(HaveLen(1))
+					Expect(results.Results[0].IPConfigs).To(HaveLen(2))
+					Expect(results.Results[0].IPConfigs[0].IPCIDR).To(Equal("192.168.10.5/29"))
+					Expect(results.Results[0].IPConfigs[1].IPCIDR).To(Equal("2001:db8:101:0:8000:1::/64"))
+				})
+			})
+
+			When("the interface specifies nameservers", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].Nameservers = []string{"9.9.9.9"}
+				})
+
+				It("uses the interface nameservers", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results.Results).To(HaveLen(1))
+					Expect(results.Results[0].Nameservers).To(HaveExactElements("9.9.9.9"))
+				})
+			})
+
+			When("the pool has no free addresses", func() {
+				BeforeEach(func() {
+					pool.Spec.CIDRs = []string{"192.168.10.0/30"}
+				})
+
+				It("returns error", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(`IP pool "my-pool" has no free addresses`))
+				})
+			})
+
+			When("the pool does not exist", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].IPPoolName = "bogus"
+				})
+
+				It("returns error", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(`failed to get IP pool "bogus"`))
+				})
+			})
+		})
 	})
 
 	Context("VDS", func() {
@@ -0,0 +1,158 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NetworkdFile is a systemd-networkd configuration file.
+type NetworkdFile struct {
+	// Name is the name of the file in the /etc/systemd/network directory.
+	Name string
+
+	// Contents are the contents of the file.
+	Contents string
+}
+
+// NetworkdCustomization returns the systemd-networkd configuration files for
+// the network interfaces. Each interface is matched by its MAC address, and a
+// .link file renames the interface to its guest device name when one is set.
+//
+// The global DNS servers and search domains are used for the interfaces that
+// neither use DHCP nor specify their own.
+//
+// Please note that bonds, bridges, and VLANs are not included.
+func NetworkdCustomization(
+	result NetworkInterfaceResults,
+	dnsServers, searchDomains []string) []NetworkdFile {
+
+	files := make([]NetworkdFile, 0, 2*len(result.Results))
+
+	for _, r := range result.Results {
+		name := r.GuestDeviceName
+		if name == "" {
+			name = r.Name
+		}
+		mac := NormalizeNetplanMac(r.MacAddress)
+
+		if r.GuestDeviceName != "" {
+			files = append(files, NetworkdFile{
+				Name:     fmt.Sprintf("10-%s.link", name),
+				Contents: networkdLink(mac, r.GuestDeviceName),
+			})
+		}
+
+		nameservers, domains := r.Nameservers, r.SearchDomains
+		if !r.DHCP4 && !r.DHCP6 {
+			if len(nameservers) == 0 {
+				nameservers = dnsServers
+			}
+			if len(domains) == 0 {
+				domains = searchDomains
+			}
+		}
+
+		files = append(files, NetworkdFile{
+			Name:     fmt.Sprintf("10-%s.network", name),
+			Contents: networkdNetwork(mac, r, nameservers, domains),
+		})
+	}
+
+	return files
+}
+
+func networkdLink(mac, name string) string {
+	var sb strings.Builder
+	sb.WriteString("[Match]\n")
+	fmt.Fprintf(&sb, "MACAddress=%s\n", mac)
+	sb.WriteString("\n[Link]\n")
+	fmt.Fprintf(&sb, "Name=%s\n", name)
+	return sb.String()
+}
+
+func networkdNetwork(
+	mac string,
+	r NetworkInterfaceResult,
+	nameservers, domains []string) string {
+
+	var sb strings.Builder
+
+	sb.WriteString("[Match]\n")
+	fmt.Fprintf(&sb, "MACAddress=%s\n", mac)
+
+	if r.MTU > 0 {
+		sb.WriteString("\n[Link]\n")
+		fmt.Fprintf(&sb, "MTUBytes=%d\n", r.MTU)
+	}
+
+	sb.WriteString("\n[Network]\n")
+	switch {
+	case r.DHCP4 && r.DHCP6:
+		sb.WriteString("DHCP=yes\n")
+	case r.DHCP4:
+		sb.WriteString("DHCP=ipv4\n")
+	case r.DHCP6:
+		sb.WriteString("DHCP=ipv6\n")
+	default:
+		sb.WriteString("DHCP=no\n")
+	}
+	// Right now we can set the same value as the DHCPv6 configuration, which
+	// is what is done for the NetPlan customization as well.
+	fmt.Fprintf(&sb, "IPv6AcceptRA=%s\n", networkdBool(r.DHCP6))
+
+	var gateway4, gateway6 string
+	for _, ipConfig := range r.IPConfigs {
+		if ipConfig.IsIPv4 {
+			if r.DHCP4 {
+				continue
+			}
+			if gateway4 == "" {
+				gateway4 = ipConfig.Gateway
+			}
+		} else {
+			if r.DHCP6 {
+				continue
+			}
+			if gateway6 == "" {
+				gateway6 = ipConfig.Gateway
+			}
+		}
+		fmt.Fprintf(&sb, "Address=%s\n", ipConfig.IPCIDR)
+	}
+	for _, gw := range []string{gateway4, gateway6} {
+		if gw != "" {
+			fmt.Fprintf(&sb, "Gateway=%s\n", gw)
+		}
+	}
+
+	for _, ns := range nameservers {
+		fmt.Fprintf(&sb, "DNS=%s\n", ns)
+	}
+	if len(domains) > 0 {
+		fmt.Fprintf(&sb, "Domains=%s\n", strings.Join(domains, " "))
+	}
+
+	for _, route := range r.Routes {
+		sb.WriteString("\n[Route]\n")
+		fmt.Fprintf(&sb, "Destination=%s\n", route.To)
+		if route.Via != "" {
+			fmt.Fprintf(&sb, "Gateway=%s\n", route.Via)
+		}
+		if route.Metric != 0 {
+			fmt.Fprintf(&sb, "Metric=%d\n", route.Metric)
+		}
+	}
+
+	return sb.String()
+}
+
+func networkdBool(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
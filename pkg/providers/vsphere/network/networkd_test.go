@@ -0,0 +1,161 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+)
+
+var _ = Describe("Networkd", func() {
+	const (
+		ifName        = "my-interface"
+		guestDevName  = "eth42"
+		macAddr1      = "50-8A-80-9D-28-22"
+		macAddr1Norm  = "50:8a:80:9d:28:22"
+		ipv4Gateway   = "192.168.1.1"
+		ipv4CIDR      = "192.168.1.10/24"
+		ipv6Gateway   = "fd8e:b5a0:f172:123::1"
+		ipv6CIDR      = "fd8e:b5a0:f172:123::f/48"
+		dnsServer1    = "9.9.9.9"
+		dnsServer2    = "1.1.1.1"
+		searchDomain1 = "foobar.local"
+		searchDomain2 = "global.local"
+	)
+
+	Context("NetworkdCustomization", func() {
+
+		var (
+			results       network.NetworkInterfaceResults
+			dnsServers    []string
+			searchDomains []string
+			files         []network.NetworkdFile
+		)
+
+		BeforeEach(func() {
+			results = network.NetworkInterfaceResults{}
+			dnsServers = []string{dnsServer2}
+			searchDomains = []string{searchDomain2}
+		})
+
+		JustBeforeEach(func() {
+			files = network.NetworkdCustomization(results, dnsServers, searchDomains)
+		})
+
+		Context("Static adapter", func() {
+			BeforeEach(func() {
+				results.Results = []network.NetworkInterfaceResult{
+					{
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  ipv4CIDR,
+								IsIPv4:  true,
+								Gateway: ipv4Gateway,
+							},
+							{
+								IPCIDR:  ipv6CIDR,
+								IsIPv4:  false,
+								Gateway: ipv6Gateway,
+							},
+						},
+						MacAddress:      macAddr1,
+						Name:            ifName,
+						GuestDeviceName: guestDevName,
+						MTU:             1500,
+						Nameservers:     []string{dnsServer1},
+						SearchDomains:   []string{searchDomain1},
+						Routes: []network.NetworkInterfaceRoute{
+							{
+								To:     "185.107.56.59",
+								Via:    "10.1.1.1",
+								Metric: 42,
+							},
+						},
+					},
+				}
+			})
+
+			It("returns the link and network files", func() {
+				Expect(files).To(Equal([]network.NetworkdFile{
+					{
+						Name: "10-" + guestDevName + ".link",
+						Contents: "[Match]\n" +
+							"MACAddress=" + macAddr1Norm + "\n" +
+							"\n[Link]\n" +
+							"Name=" + guestDevName + "\n",
+					},
+					{
+						Name: "10-" + guestDevName + ".network",
+						Contents: "[Match]\n" +
+							"MACAddress=" + macAddr1Norm + "\n" +
+							"\n[Link]\n" +
+							"MTUBytes=1500\n" +
+							"\n[Network]\n" +
+							"DHCP=no\n" +
+							"IPv6AcceptRA=no\n" +
+							"Address=" + ipv4CIDR + "\n" +
+							"Address=" + ipv6CIDR + "\n" +
+							"Gateway=" + ipv4Gateway + "\n" +
+							"Gateway=" + ipv6Gateway + "\n" +
+							"DNS=" + dnsServer1 + "\n" +
+							"Domains=" + searchDomain1 + "\n" +
+							"\n[Route]\n" +
+							"Destination=185.107.56.59\n" +
+							"Gateway=10.1.1.1\n" +
+							"Metric=42\n",
+					},
+				}))
+			})
+
+			When("the interface does not specify DNS", func() {
+				BeforeEach(func() {
+					results.Results[0].Nameservers = nil
+					results.Results[0].SearchDomains = nil
+				})
+
+				It("uses the global DNS servers and search domains", func() {
+					Expect(files).To(HaveLen(2))
+					Expect(files[1].Contents).To(ContainSubstring("DNS=" + dnsServer2 + "\n"))
+					Expect(files[1].Contents).To(ContainSubstring("Domains=" + searchDomain2 + "\n"))
+				})
+			})
+		})
+
+		Context("DHCP adapter without a guest device name", func() {
+			BeforeEach(func() {
+				results.Results = []network.NetworkInterfaceResult{
+					{
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  ipv4CIDR,
+								IsIPv4:  true,
+								Gateway: ipv4Gateway,
+							},
+						},
+						MacAddress: macAddr1,
+						Name:       ifName,
+						DHCP4:      true,
+						DHCP6:      true,
+					},
+				}
+			})
+
+			It("returns only the network file", func() {
+				Expect(files).To(Equal([]network.NetworkdFile{
+					{
+						Name: "10-" + ifName + ".network",
+						Contents: "[Match]\n" +
+							"MACAddress=" + macAddr1Norm + "\n" +
+							"\n[Network]\n" +
+							"DHCP=yes\n" +
+							"IPv6AcceptRA=yes\n",
+					},
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,401 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	ncpv1alpha1 "github.com/vmware-tanzu/vm-operator/external/ncp/api/v1alpha1"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+const (
+	// NetworkPolicyAllowPriority is the priority of the SecurityPolicy with
+	// the rules that allow traffic. It takes precedence over the isolation
+	// SecurityPolicy of every VirtualMachineNetworkPolicy, so traffic allowed
+	// by any policy that selects a VM is allowed.
+	NetworkPolicyAllowPriority = 10
+
+	// NetworkPolicyIsolationPriority is the priority of the SecurityPolicy
+	// that drops the traffic to and from the selected VMs that no
+	// SecurityPolicy allows.
+	NetworkPolicyIsolationPriority = 1000
+)
+
+var (
+	// ErrNetworkPolicyNotSupported is returned when the network provider does
+	// not support VirtualMachineNetworkPolicy.
+	ErrNetworkPolicyNotSupported = errors.New(
+		"network provider does not support VirtualMachineNetworkPolicy")
+
+	// ErrSecurityPolicyNotOwned is returned when a SecurityPolicy with the
+	// name of one of the SecurityPolicies the policy is translated into
+	// already exists and is not controlled by the policy.
+	ErrSecurityPolicyNotOwned = errors.New(
+		"SecurityPolicy exists and is not owned by the VirtualMachineNetworkPolicy")
+)
+
+// NetworkPolicyAllowName returns the name of the SecurityPolicy with the
+// rules that allow traffic.
+func NetworkPolicyAllowName(policyName string) string {
+	return policyName + "-allow"
+}
+
+// NetworkPolicyIsolationName returns the name of the SecurityPolicy that
+// isolates the selected VMs.
+func NetworkPolicyIsolationName(policyName string) string {
+	return policyName + "-isolation"
+}
+
+// NetworkPolicyToSecurityPolicySpecs translates the policy into the specs of
+// the SecurityPolicies, keyed by name. The policy is translated into two
+// SecurityPolicies: one that allows the traffic of the policy's rules, and
+// one with a lower priority that drops all other traffic to or from the
+// selected VMs in the directions of the policy types. This is how the rules
+// of all the policies that select a VM are combined into the traffic allowed
+// for the VM.
+func NetworkPolicyToSecurityPolicySpecs(
+	policy *vmopv1.VirtualMachineNetworkPolicy) map[string]vpcv1alpha1.SecurityPolicySpec {
+
+	appliedTo := []vpcv1alpha1.SecurityPolicyTarget{
+		{
+			VMSelector: policy.Spec.VMSelector.DeepCopy(),
+		},
+	}
+
+	var allowRules, isolationRules []vpcv1alpha1.SecurityPolicyRule
+
+	for _, policyType := range vmopv1util.GetNetworkPolicyTypes(*policy) {
+		switch policyType {
+		case vmopv1.VirtualMachineNetworkPolicyTypeIngress:
+			for i, r := range policy.Spec.Ingress {
+				allowRules = append(allowRules, vpcv1alpha1.SecurityPolicyRule{
+					Name:      fmt.Sprintf("ingress-%d", i),
+					Action:    ruleAction(vpcv1alpha1.RuleActionAllow),
+					Direction: ruleDirection(vpcv1alpha1.RuleDirectionIn),
+					Sources:   toSecurityPolicyPeers(r.From),
+					Ports:     toSecurityPolicyPorts(r.Ports),
+				})
+			}
+			isolationRules = append(isolationRules, vpcv1alpha1.SecurityPolicyRule{
+				Name:      "isolation-ingress",
+				Action:    ruleAction(vpcv1alpha1.RuleActionDrop),
+				Direction: ruleDirection(vpcv1alpha1.RuleDirectionIn),
+			})
+
+		case vmopv1.VirtualMachineNetworkPolicyTypeEgress:
+			for i, r := range policy.Spec.Egress {
+				allowRules = append(allowRules, vpcv1alpha1.SecurityPolicyRule{
+					Name:         fmt.Sprintf("egress-%d", i),
+					Action:       ruleAction(vpcv1alpha1.RuleActionAllow),
+					Direction:    ruleDirection(vpcv1alpha1.RuleDirectionOut),
+					Destinations: toSecurityPolicyPeers(r.To),
+					Ports:        toSecurityPolicyPorts(r.Ports),
+				})
+			}
+			isolationRules = append(isolationRules, vpcv1alpha1.SecurityPolicyRule{
+				Name:      "isolation-egress",
+				Action:    ruleAction(vpcv1alpha1.RuleActionDrop),
+				Direction: ruleDirection(vpcv1alpha1.RuleDirectionOut),
+			})
+		}
+	}
+
+	specs := map[string]vpcv1alpha1.SecurityPolicySpec{
+		NetworkPolicyIsolationName(policy.Name): {
+			Priority:  NetworkPolicyIsolationPriority,
+			AppliedTo: appliedTo,
+			Rules:     isolationRules,
+		},
+	}
+
+	if len(allowRules) > 0 {
+		specs[NetworkPolicyAllowName(policy.Name)] = vpcv1alpha1.SecurityPolicySpec{
+			Priority:  NetworkPolicyAllowPriority,
+			AppliedTo: appliedTo,
+			Rules:     allowRules,
+		}
+	}
+
+	return specs
+}
+
+func ruleAction(a vpcv1alpha1.RuleAction) *vpcv1alpha1.RuleAction {
+	return &a
+}
+
+func ruleDirection(d vpcv1alpha1.RuleDirection) *vpcv1alpha1.RuleDirection {
+	return &d
+}
+
+func toSecurityPolicyPeers(
+	peers []vmopv1.VirtualMachineNetworkPolicyPeer) []vpcv1alpha1.SecurityPolicyPeer {
+
+	if len(peers) == 0 {
+		return nil
+	}
+
+	out := make([]vpcv1alpha1.SecurityPolicyPeer, 0, len(peers))
+	for _, p := range peers {
+		peer := vpcv1alpha1.SecurityPolicyPeer{
+			VMSelector:        p.VMSelector.DeepCopy(),
+			NamespaceSelector: p.NamespaceSelector.DeepCopy(),
+		}
+		if p.IPBlock != nil {
+			peer.IPBlocks = []vpcv1alpha1.IPBlock{{CIDR: p.IPBlock.CIDR}}
+		}
+		out = append(out, peer)
+	}
+	return out
+}
+
+func toSecurityPolicyPorts(
+	ports []vmopv1.VirtualMachineNetworkPolicyPort) []vpcv1alpha1.SecurityPolicyPort {
+
+	if len(ports) == 0 {
+		return nil
+	}
+
+	out := make([]vpcv1alpha1.SecurityPolicyPort, 0, len(ports))
+	for _, p := range ports {
+		port := vpcv1alpha1.SecurityPolicyPort{
+			Protocol: p.Protocol,
+		}
+		if port.Protocol == "" {
+			port.Protocol = corev1.ProtocolTCP
+		}
+		if p.Port != nil {
+			port.Port = intstr.FromInt32(*p.Port)
+		}
+		if p.EndPort != nil {
+			port.EndPort = int(*p.EndPort)
+		}
+		out = append(out, port)
+	}
+	return out
+}
+
+// ReconcileNetworkPolicy creates or updates the network provider's
+// SecurityPolicies that the policy is translated into, and deletes the ones
+// that are no longer needed. The SecurityPolicies are owned by the policy so
+// they are garbage collected when the policy is deleted. A SecurityPolicy
+// that is not controlled by the policy is never updated or deleted, and
+// ErrSecurityPolicyNotOwned is returned instead.
+//
+// It returns true when the network provider has realized all of the
+// SecurityPolicies. Otherwise, the returned message describes the ones that
+// have not been realized.
+func ReconcileNetworkPolicy(
+	ctx context.Context,
+	client ctrlclient.Client,
+	policy *vmopv1.VirtualMachineNetworkPolicy) (bool, string, error) {
+
+	var newObj func() ctrlclient.Object
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeNSXT:
+		newObj = func() ctrlclient.Object { return &nsxv1alpha1.SecurityPolicy{} }
+	case pkgcfg.NetworkProviderTypeVPC:
+		newObj = func() ctrlclient.Object { return &vpcv1alpha1.SecurityPolicy{} }
+	default:
+		return false, "", ErrNetworkPolicyNotSupported
+	}
+
+	specs := NetworkPolicyToSecurityPolicySpecs(policy)
+
+	var notRealized []string
+
+	for _, name := range []string{
+		NetworkPolicyAllowName(policy.Name),
+		NetworkPolicyIsolationName(policy.Name),
+	} {
+		obj := newObj()
+		obj.SetName(name)
+		obj.SetNamespace(policy.Namespace)
+
+		spec, ok := specs[name]
+		if !ok {
+			if err := client.Get(ctx, ctrlclient.ObjectKeyFromObject(obj), obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return false, "", fmt.Errorf("failed to get SecurityPolicy %q: %w", name, err)
+			}
+			if !metav1.IsControlledBy(obj, policy) {
+				// The SecurityPolicy was not created for this policy.
+				continue
+			}
+			if err := client.Delete(
+				ctx,
+				obj,
+				ctrlclient.Preconditions{UID: ptr.To(obj.GetUID())}); err != nil && !apierrors.IsNotFound(err) {
+
+				return false, "", fmt.Errorf("failed to delete SecurityPolicy %q: %w", name, err)
+			}
+			continue
+		}
+
+		if _, err := controllerutil.CreateOrPatch(ctx, client, obj, func() error {
+			if obj.GetResourceVersion() != "" && !metav1.IsControlledBy(obj, policy) {
+				return fmt.Errorf("%w: %s", ErrSecurityPolicyNotOwned, name)
+			}
+			if err := setSecurityPolicySpec(obj, spec); err != nil {
+				return err
+			}
+			return controllerutil.SetControllerReference(policy, obj, client.Scheme())
+		}); err != nil {
+			return false, "", fmt.Errorf("failed to create or patch SecurityPolicy %q: %w", name, err)
+		}
+
+		if ready, msg := isSecurityPolicyReady(obj); !ready {
+			if msg == "" {
+				msg = "not yet realized"
+			}
+			notRealized = append(notRealized, fmt.Sprintf("SecurityPolicy %s: %s", name, msg))
+		}
+	}
+
+	if len(notRealized) > 0 {
+		return false, strings.Join(notRealized, "; "), nil
+	}
+
+	return true, "", nil
+}
+
+// setSecurityPolicySpec sets the spec of the SecurityPolicy. The NSX-T
+// SecurityPolicy type is identical to the VPC one other than its API group,
+// so the spec is converted by way of its JSON representation.
+func setSecurityPolicySpec(
+	obj ctrlclient.Object,
+	spec vpcv1alpha1.SecurityPolicySpec) error {
+
+	switch sp := obj.(type) {
+	case *vpcv1alpha1.SecurityPolicy:
+		sp.Spec = spec
+	case *nsxv1alpha1.SecurityPolicy:
+		data, err := json.Marshal(spec)
+		if err != nil {
+			return err
+		}
+		sp.Spec = nsxv1alpha1.SecurityPolicySpec{}
+		if err := json.Unmarshal(data, &sp.Spec); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unexpected SecurityPolicy type %T", obj)
+	}
+	return nil
+}
+
+// IsNetworkPolicyEnforcedForVM returns true when the network provider has
+// realized all of the VM's network interfaces. A SecurityPolicy is enforced on
+// the ports of the network interfaces of the VMs it selects, so a realized
+// SecurityPolicy is not enforced for a VM until its ports are realized.
+// Otherwise, the returned message describes why the policy is not enforced for
+// the VM.
+func IsNetworkPolicyEnforcedForVM(
+	ctx context.Context,
+	client ctrlclient.Client,
+	vm *vmopv1.VirtualMachine) (bool, string, error) {
+
+	listOpts := []ctrlclient.ListOption{
+		ctrlclient.InNamespace(vm.Namespace),
+		ctrlclient.MatchingLabels{VMNameLabel: vm.Name},
+	}
+
+	var (
+		names       []string
+		notRealized []string
+	)
+
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeNSXT:
+		var list ncpv1alpha1.VirtualNetworkInterfaceList
+		if err := client.List(ctx, &list, listOpts...); err != nil {
+			return false, "", fmt.Errorf("failed to list VirtualNetworkInterfaces: %w", err)
+		}
+		for _, netIf := range list.Items {
+			names = append(names, netIf.Name)
+			ready, msg := false, ""
+			for _, c := range netIf.Status.Conditions {
+				if strings.Contains(c.Type, "Ready") {
+					ready, msg = strings.Contains(c.Status, "True"), c.Message
+				}
+			}
+			if !ready {
+				notRealized = append(notRealized, networkInterfaceNotRealized(netIf.Name, msg))
+			}
+		}
+	case pkgcfg.NetworkProviderTypeVPC:
+		var list vpcv1alpha1.SubnetPortList
+		if err := client.List(ctx, &list, listOpts...); err != nil {
+			return false, "", fmt.Errorf("failed to list SubnetPorts: %w", err)
+		}
+		for _, port := range list.Items {
+			names = append(names, port.Name)
+			ready, msg := false, ""
+			for _, c := range port.Status.Conditions {
+				if c.Type == vpcv1alpha1.Ready {
+					ready, msg = c.Status == corev1.ConditionTrue, c.Message
+				}
+			}
+			if !ready {
+				notRealized = append(notRealized, networkInterfaceNotRealized(port.Name, msg))
+			}
+		}
+	default:
+		return false, "", ErrNetworkPolicyNotSupported
+	}
+
+	if len(names) == 0 {
+		return false, "VM has no network interfaces", nil
+	}
+	if len(notRealized) > 0 {
+		return false, strings.Join(notRealized, "; "), nil
+	}
+
+	return true, "", nil
+}
+
+func networkInterfaceNotRealized(name, msg string) string {
+	if msg == "" {
+		msg = "not yet realized"
+	}
+	return fmt.Sprintf("network interface %s: %s", name, msg)
+}
+
+func isSecurityPolicyReady(obj ctrlclient.Object) (bool, string) {
+	switch sp := obj.(type) {
+	case *vpcv1alpha1.SecurityPolicy:
+		for _, c := range sp.Status.Conditions {
+			if c.Type == vpcv1alpha1.Ready {
+				return c.Status == corev1.ConditionTrue, c.Message
+			}
+		}
+	case *nsxv1alpha1.SecurityPolicy:
+		for _, c := range sp.Status.Conditions {
+			if c.Type == nsxv1alpha1.Ready {
+				return c.Status == corev1.ConditionTrue, c.Message
+			}
+		}
+	}
+	return false, ""
+}
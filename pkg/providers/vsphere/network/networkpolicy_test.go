@@ -0,0 +1,476 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	ncpv1alpha1 "github.com/vmware-tanzu/vm-operator/external/ncp/api/v1alpha1"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("NetworkPolicyToSecurityPolicySpecs", func() {
+	var (
+		policy *vmopv1.VirtualMachineNetworkPolicy
+		specs  map[string]vpcv1alpha1.SecurityPolicySpec
+	)
+
+	BeforeEach(func() {
+		policy = &vmopv1.VirtualMachineNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-policy",
+				Namespace: "my-ns",
+			},
+			Spec: vmopv1.VirtualMachineNetworkPolicySpec{
+				VMSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		specs = network.NetworkPolicyToSecurityPolicySpecs(policy)
+	})
+
+	When("the policy has no rules", func() {
+		It("only isolates the VMs from ingress traffic", func() {
+			Expect(specs).To(HaveLen(1))
+			spec, ok := specs[network.NetworkPolicyIsolationName(policy.Name)]
+			Expect(ok).To(BeTrue())
+			Expect(spec.Priority).To(Equal(network.NetworkPolicyIsolationPriority))
+			Expect(spec.AppliedTo).To(HaveLen(1))
+			Expect(spec.AppliedTo[0].VMSelector).To(Equal(&policy.Spec.VMSelector))
+			Expect(spec.Rules).To(HaveLen(1))
+			Expect(*spec.Rules[0].Action).To(Equal(vpcv1alpha1.RuleActionDrop))
+			Expect(*spec.Rules[0].Direction).To(Equal(vpcv1alpha1.RuleDirectionIn))
+		})
+	})
+
+	When("the policy has ingress and egress rules", func() {
+		BeforeEach(func() {
+			policy.Spec.Ingress = []vmopv1.VirtualMachineNetworkPolicyIngressRule{
+				{
+					From: []vmopv1.VirtualMachineNetworkPolicyPeer{
+						{
+							VMSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "web"},
+							},
+						},
+					},
+					Ports: []vmopv1.VirtualMachineNetworkPolicyPort{
+						{
+							Port: ptr.To[int32](5432),
+						},
+					},
+				},
+			}
+			policy.Spec.Egress = []vmopv1.VirtualMachineNetworkPolicyEgressRule{
+				{
+					To: []vmopv1.VirtualMachineNetworkPolicyPeer{
+						{
+							IPBlock: &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+								CIDR: "10.0.0.0/8",
+							},
+						},
+					},
+					Ports: []vmopv1.VirtualMachineNetworkPolicyPort{
+						{
+							Protocol: corev1.ProtocolUDP,
+							Port:     ptr.To[int32](8000),
+							EndPort:  ptr.To[int32](9000),
+						},
+					},
+				},
+			}
+		})
+
+		It("allows the traffic of the rules", func() {
+			Expect(specs).To(HaveLen(2))
+
+			spec, ok := specs[network.NetworkPolicyAllowName(policy.Name)]
+			Expect(ok).To(BeTrue())
+			Expect(spec.Priority).To(Equal(network.NetworkPolicyAllowPriority))
+			Expect(spec.Rules).To(HaveLen(2))
+
+			ingress := spec.Rules[0]
+			Expect(ingress.Name).To(Equal("ingress-0"))
+			Expect(*ingress.Action).To(Equal(vpcv1alpha1.RuleActionAllow))
+			Expect(*ingress.Direction).To(Equal(vpcv1alpha1.RuleDirectionIn))
+			Expect(ingress.Sources).To(HaveLen(1))
+			Expect(ingress.Sources[0].VMSelector.MatchLabels).To(HaveKeyWithValue("app", "web"))
+			Expect(ingress.Ports).To(Equal([]vpcv1alpha1.SecurityPolicyPort{
+				{
+					Protocol: corev1.ProtocolTCP,
+					Port:     intstr.FromInt32(5432),
+				},
+			}))
+
+			egress := spec.Rules[1]
+			Expect(egress.Name).To(Equal("egress-0"))
+			Expect(*egress.Action).To(Equal(vpcv1alpha1.RuleActionAllow))
+			Expect(*egress.Direction).To(Equal(vpcv1alpha1.RuleDirectionOut))
+			Expect(egress.Destinations).To(Equal([]vpcv1alpha1.SecurityPolicyPeer{
+				{
+					IPBlocks: []vpcv1alpha1.IPBlock{{CIDR: "10.0.0.0/8"}},
+				},
+			}))
+			Expect(egress.Ports).To(Equal([]vpcv1alpha1.SecurityPolicyPort{
+				{
+					Protocol: corev1.ProtocolUDP,
+					Port:     intstr.FromInt32(8000),
+					EndPort:  9000,
+				},
+			}))
+		})
+
+		It("isolates the VMs from ingress and egress traffic", func() {
+			spec, ok := specs[network.NetworkPolicyIsolationName(policy.Name)]
+			Expect(ok).To(BeTrue())
+			Expect(spec.Rules).To(HaveLen(2))
+			Expect(*spec.Rules[0].Direction).To(Equal(vpcv1alpha1.RuleDirectionIn))
+			Expect(*spec.Rules[1].Direction).To(Equal(vpcv1alpha1.RuleDirectionOut))
+		})
+
+		When("the policy types only include Egress", func() {
+			BeforeEach(func() {
+				policy.Spec.PolicyTypes = []vmopv1.VirtualMachineNetworkPolicyType{
+					vmopv1.VirtualMachineNetworkPolicyTypeEgress,
+				}
+			})
+
+			It("ignores the ingress rules", func() {
+				spec := specs[network.NetworkPolicyAllowName(policy.Name)]
+				Expect(spec.Rules).To(HaveLen(1))
+				Expect(spec.Rules[0].Name).To(Equal("egress-0"))
+
+				spec = specs[network.NetworkPolicyIsolationName(policy.Name)]
+				Expect(spec.Rules).To(HaveLen(1))
+				Expect(*spec.Rules[0].Direction).To(Equal(vpcv1alpha1.RuleDirectionOut))
+			})
+		})
+	})
+})
+
+var _ = Describe("ReconcileNetworkPolicy", func() {
+	var (
+		ctx         context.Context
+		client      ctrlclient.Client
+		initObjects []ctrlclient.Object
+		policy      *vmopv1.VirtualMachineNetworkPolicy
+
+		realized bool
+		message  string
+		err      error
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		policy = &vmopv1.VirtualMachineNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-policy",
+				Namespace: "my-ns",
+				UID:       "my-policy-uid",
+			},
+			Spec: vmopv1.VirtualMachineNetworkPolicySpec{
+				Ingress: []vmopv1.VirtualMachineNetworkPolicyIngressRule{{}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		client = builder.NewFakeClient(initObjects...)
+		realized, message, err = network.ReconcileNetworkPolicy(ctx, client, policy)
+	})
+
+	AfterEach(func() {
+		initObjects = nil
+	})
+
+	When("the network provider is Named", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeNamed
+			})
+		})
+
+		It("returns not supported", func() {
+			Expect(err).To(MatchError(network.ErrNetworkPolicyNotSupported))
+		})
+	})
+
+	When("the network provider is VPC", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+			})
+		})
+
+		It("creates the SecurityPolicies", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(realized).To(BeFalse())
+			Expect(message).To(ContainSubstring("not yet realized"))
+
+			for _, name := range []string{
+				network.NetworkPolicyAllowName(policy.Name),
+				network.NetworkPolicyIsolationName(policy.Name),
+			} {
+				sp := &vpcv1alpha1.SecurityPolicy{}
+				Expect(client.Get(ctx, ctrlclient.ObjectKey{Namespace: policy.Namespace, Name: name}, sp)).To(Succeed())
+				Expect(sp.OwnerReferences).To(HaveLen(1))
+				Expect(sp.OwnerReferences[0].UID).To(Equal(policy.UID))
+			}
+		})
+
+		When("the SecurityPolicies are ready", func() {
+			BeforeEach(func() {
+				for _, name := range []string{
+					network.NetworkPolicyAllowName(policy.Name),
+					network.NetworkPolicyIsolationName(policy.Name),
+				} {
+					initObjects = append(initObjects, &vpcv1alpha1.SecurityPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            name,
+							Namespace:       policy.Namespace,
+							OwnerReferences: policyOwnerRefs(policy),
+						},
+						Status: vpcv1alpha1.SecurityPolicyStatus{
+							Conditions: []vpcv1alpha1.Condition{
+								{
+									Type:   vpcv1alpha1.Ready,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+					})
+				}
+			})
+
+			It("returns realized", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(realized).To(BeTrue())
+				Expect(message).To(BeEmpty())
+			})
+		})
+
+		When("the policy no longer has any rules", func() {
+			BeforeEach(func() {
+				policy.Spec.Ingress = nil
+				initObjects = append(initObjects, &vpcv1alpha1.SecurityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            network.NetworkPolicyAllowName(policy.Name),
+						Namespace:       policy.Namespace,
+						OwnerReferences: policyOwnerRefs(policy),
+					},
+				})
+			})
+
+			It("deletes the allow SecurityPolicy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				sp := &vpcv1alpha1.SecurityPolicy{}
+				err := client.Get(ctx, ctrlclient.ObjectKey{
+					Namespace: policy.Namespace,
+					Name:      network.NetworkPolicyAllowName(policy.Name),
+				}, sp)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			When("the allow SecurityPolicy is not owned by the policy", func() {
+				BeforeEach(func() {
+					initObjects[0].SetOwnerReferences(nil)
+				})
+
+				It("does not delete the SecurityPolicy", func() {
+					Expect(err).ToNot(HaveOccurred())
+					sp := &vpcv1alpha1.SecurityPolicy{}
+					Expect(client.Get(ctx, ctrlclient.ObjectKey{
+						Namespace: policy.Namespace,
+						Name:      network.NetworkPolicyAllowName(policy.Name),
+					}, sp)).To(Succeed())
+				})
+			})
+		})
+
+		When("a SecurityPolicy with the same name is not owned by the policy", func() {
+			BeforeEach(func() {
+				initObjects = append(initObjects, &vpcv1alpha1.SecurityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      network.NetworkPolicyAllowName(policy.Name),
+						Namespace: policy.Namespace,
+					},
+					Spec: vpcv1alpha1.SecurityPolicySpec{
+						Priority: 1,
+					},
+				})
+			})
+
+			It("returns an error and does not update the SecurityPolicy", func() {
+				Expect(err).To(MatchError(network.ErrSecurityPolicyNotOwned))
+
+				sp := &vpcv1alpha1.SecurityPolicy{}
+				Expect(client.Get(ctx, ctrlclient.ObjectKey{
+					Namespace: policy.Namespace,
+					Name:      network.NetworkPolicyAllowName(policy.Name),
+				}, sp)).To(Succeed())
+				Expect(sp.OwnerReferences).To(BeEmpty())
+				Expect(sp.Spec.Priority).To(Equal(1))
+				Expect(sp.Spec.Rules).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the network provider is NSX-T", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeNSXT
+			})
+		})
+
+		It("creates the SecurityPolicies", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(realized).To(BeFalse())
+
+			sp := &nsxv1alpha1.SecurityPolicy{}
+			Expect(client.Get(ctx, ctrlclient.ObjectKey{
+				Namespace: policy.Namespace,
+				Name:      network.NetworkPolicyAllowName(policy.Name),
+			}, sp)).To(Succeed())
+			Expect(sp.Spec.Priority).To(Equal(network.NetworkPolicyAllowPriority))
+			Expect(sp.Spec.Rules).To(HaveLen(1))
+			Expect(*sp.Spec.Rules[0].Action).To(Equal(nsxv1alpha1.RuleActionAllow))
+			Expect(*sp.Spec.Rules[0].Direction).To(Equal(nsxv1alpha1.RuleDirectionIn))
+		})
+	})
+})
+
+var _ = Describe("IsNetworkPolicyEnforcedForVM", func() {
+	var (
+		ctx         context.Context
+		initObjects []ctrlclient.Object
+		vm          *vmopv1.VirtualMachine
+
+		enforced bool
+		message  string
+		err      error
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		vm = builder.DummyBasicVirtualMachine("my-vm", "my-ns")
+	})
+
+	JustBeforeEach(func() {
+		client := builder.NewFakeClient(initObjects...)
+		enforced, message, err = network.IsNetworkPolicyEnforcedForVM(ctx, client, vm)
+	})
+
+	AfterEach(func() {
+		initObjects = nil
+	})
+
+	When("the network provider is Named", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeNamed
+			})
+		})
+
+		It("returns not supported", func() {
+			Expect(err).To(MatchError(network.ErrNetworkPolicyNotSupported))
+		})
+	})
+
+	When("the network provider is VPC", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+			})
+		})
+
+		It("returns not enforced when the VM has no network interfaces", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enforced).To(BeFalse())
+			Expect(message).To(Equal("VM has no network interfaces"))
+		})
+
+		When("the VM's SubnetPort is not realized", func() {
+			BeforeEach(func() {
+				initObjects = append(initObjects, &vpcv1alpha1.SubnetPort{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-vm-eth0",
+						Namespace: vm.Namespace,
+						Labels:    map[string]string{network.VMNameLabel: vm.Name},
+					},
+				})
+			})
+
+			It("returns not enforced", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(enforced).To(BeFalse())
+				Expect(message).To(Equal("network interface my-vm-eth0: not yet realized"))
+			})
+		})
+	})
+
+	When("the network provider is NSX-T", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeNSXT
+			})
+
+			initObjects = append(initObjects,
+				&ncpv1alpha1.VirtualNetworkInterface{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-vm-eth0",
+						Namespace: vm.Namespace,
+						Labels:    map[string]string{network.VMNameLabel: vm.Name},
+					},
+					Status: ncpv1alpha1.VirtualNetworkInterfaceStatus{
+						Conditions: []ncpv1alpha1.VirtualNetworkCondition{
+							{
+								Type:   "Ready",
+								Status: "True",
+							},
+						},
+					},
+				},
+				&ncpv1alpha1.VirtualNetworkInterface{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-vm-eth0",
+						Namespace: vm.Namespace,
+						Labels:    map[string]string{network.VMNameLabel: "other-vm"},
+					},
+				})
+		})
+
+		It("returns enforced when the VM's network interfaces are realized", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enforced).To(BeTrue())
+			Expect(message).To(BeEmpty())
+		})
+	})
+})
+
+func policyOwnerRefs(policy *vmopv1.VirtualMachineNetworkPolicy) []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(policy, vmopv1.GroupVersion.WithKind("VirtualMachineNetworkPolicy")),
+	}
+}
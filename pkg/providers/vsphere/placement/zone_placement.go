@@ -406,6 +406,10 @@ func Placement(
 		return nil, ErrNoPlacementCandidates
 	}
 
+	// Save the names of all the candidate zones since the spread of a
+	// replicated VM is computed across every zone available to it.
+	zoneNames := maps.Keys(candidates)
+
 	if constraints.Zones.Len() > 0 {
 		// The VM's candidates may be limited due to external constraints, such as the
 		// requested zones of its PVCs. Apply those constraints here.
@@ -433,6 +437,16 @@ func Placement(
 		candidates = allowedCandidates
 	}
 
+	recordZone := noopRecordZone
+	if curResult.ZoneName == "" {
+		// The VM may be a replica whose VirtualMachineReplicaSet requires its
+		// replicas to be spread across zones.
+		candidates, recordZone, err = applyZoneSpreadConstraints(vmCtx, client, zoneNames, candidates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	recommendation, err := getPlacementRecommendation(
 		vmCtx,
 		vcClient,
@@ -470,6 +484,10 @@ func Placement(
 		}
 	}
 
+	if err := recordZone(zoneName); err != nil {
+		return nil, err
+	}
+
 	result := Result{
 		InstanceStoragePlacement: curResult.InstanceStoragePlacement,
 		ZoneName:                 zoneName,
@@ -246,6 +246,88 @@ func vcSimPlacement() {
 				})
 			})
 
+			Context("Zone Spread Constraints", func() {
+				var (
+					rs      *vmopv1.VirtualMachineReplicaSet
+					replica *vmopv1.VirtualMachine
+				)
+
+				BeforeEach(func() {
+					pkgcfg.UpdateContext(parentCtx, func(config *pkgcfg.Config) {
+						config.Features.K8sWorkloadMgmtAPI = true
+					})
+					testConfig.NumFaultDomains = 2
+				})
+
+				JustBeforeEach(func() {
+					rs = builder.DummyVirtualMachineReplicaSet()
+					rs.Namespace = vm.Namespace
+					rs.Spec.Template.TopologySpreadConstraints = []vmopv1.VirtualMachineTopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							WhenUnsatisfiable: vmopv1.DoNotSchedule,
+						},
+					}
+					Expect(ctx.Client.Create(ctx, rs)).To(Succeed())
+
+					replica = builder.DummyBasicVirtualMachine("replica-0", vm.Namespace)
+					replica.Labels = map[string]string{
+						vmopv1.VirtualMachineReplicaSetNameLabel: rs.Name,
+						corev1.LabelTopologyZone:                 ctx.ZoneNames[0],
+					}
+					replica.OwnerReferences = []metav1.OwnerReference{
+						*metav1.NewControllerRef(rs, vmopv1.GroupVersion.WithKind("VirtualMachineReplicaSet")),
+					}
+					Expect(ctx.Client.Create(ctx, replica)).To(Succeed())
+
+					vm.Labels = map[string]string{
+						vmopv1.VirtualMachineReplicaSetNameLabel: rs.Name,
+					}
+					vm.OwnerReferences = replica.OwnerReferences
+				})
+
+				It("returns success in the zone with the fewest replicas", func() {
+					result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.ZoneName).To(Equal(ctx.ZoneNames[1]))
+				})
+
+				Context("Only allowed zone would violate the constraint", func() {
+					It("returns error", func() {
+						constraints.Zones = sets.New(ctx.ZoneNames[0])
+						_, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+						Expect(err).To(MatchError("no candidates remaining after applying zone spread constraints: no placement candidates"))
+					})
+				})
+
+				Context("Replicas are placed before their zones are reflected", func() {
+					JustBeforeEach(func() {
+						delete(replica.Labels, corev1.LabelTopologyZone)
+						Expect(ctx.Client.Update(ctx, replica)).To(Succeed())
+						Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+					})
+
+					It("counts the zones chosen for the pending replicas", func() {
+						result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(result.ZoneName).ToNot(BeEmpty())
+
+						replicaCtx := pkgctx.VirtualMachineContext{
+							Context: ctx,
+							Logger:  suite.GetLogger().WithValues("vmName", replica.Name),
+							VM:      replica,
+						}
+						replicaConfigSpec := configSpec
+						replicaConfigSpec.Name = replica.Name
+
+						replicaResult, err := placement.Placement(replicaCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, replicaConfigSpec, constraints)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(replicaResult.ZoneName).ToNot(BeEmpty())
+						Expect(replicaResult.ZoneName).ToNot(Equal(result.ZoneName))
+					})
+				})
+			})
+
 			Context("Instance Storage Placement", func() {
 
 				BeforeEach(func() {
@@ -0,0 +1,289 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package placement
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+// pendingZonePlacementTTL is how long a zone chosen for a replica is counted
+// while the replica's VM does not yet reflect the zone.
+const pendingZonePlacementTTL = 10 * time.Minute
+
+type pendingZonePlacement struct {
+	zoneName string
+	expires  time.Time
+}
+
+var (
+	// zonePlacementsMu guards zonePlacements. It is only held while the
+	// replicas are counted and while the zone chosen for a replica is
+	// recorded, and not while the replica is placed.
+	zonePlacementsMu sync.Mutex
+
+	// zonePlacements maps the UIDs of VirtualMachineReplicaSets to the names
+	// of their replicas and the zones chosen for them that are not yet
+	// reflected by the zone label or status of the replicas' VMs.
+	zonePlacements = map[types.UID]map[string]pendingZonePlacement{}
+)
+
+// prunePendingZonePlacements removes the expired pending placements, and the
+// replica sets that no longer have any pending placements, which includes
+// the replica sets that were deleted. zonePlacementsMu must be held.
+func prunePendingZonePlacements(now time.Time) {
+	for uid, pending := range zonePlacements {
+		for vmName, p := range pending {
+			if !now.Before(p.expires) {
+				delete(pending, vmName)
+			}
+		}
+		if len(pending) == 0 {
+			delete(zonePlacements, uid)
+		}
+	}
+}
+
+func noopRecordZone(string) error { return nil }
+
+// applyZoneSpreadConstraints filters the candidate zones by the topology
+// spread constraints of the VirtualMachineReplicaSet that owns the VM, if any.
+// The skew is computed against all of the zones in zoneNames, which are the
+// zones available to the namespace before any other constraints are applied.
+//
+// The returned function must be called with the name of the zone the VM is
+// placed in so that the zone is counted by the placement of the other
+// replicas. Since other replicas may be placed at the same time, it returns an
+// error if the zone no longer satisfies the constraints.
+func applyZoneSpreadConstraints(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	zoneNames []string,
+	candidates map[string][]string) (map[string][]string, func(string) error, error) {
+
+	if !pkgcfg.FromContext(vmCtx).Features.K8sWorkloadMgmtAPI {
+		return candidates, noopRecordZone, nil
+	}
+
+	ownerRef := metav1.GetControllerOf(vmCtx.VM)
+	if ownerRef == nil ||
+		ownerRef.Kind != "VirtualMachineReplicaSet" ||
+		ownerRef.APIVersion != vmopv1.GroupVersion.String() {
+
+		return candidates, noopRecordZone, nil
+	}
+
+	rs := &vmopv1.VirtualMachineReplicaSet{}
+	if err := client.Get(
+		vmCtx,
+		ctrlclient.ObjectKey{Namespace: vmCtx.VM.Namespace, Name: ownerRef.Name},
+		rs); err != nil {
+
+		if apierrors.IsNotFound(err) {
+			return candidates, noopRecordZone, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get VirtualMachineReplicaSet %s: %w", ownerRef.Name, err)
+	}
+
+	constraints := rs.Spec.Template.TopologySpreadConstraints
+	if len(constraints) == 0 {
+		return candidates, noopRecordZone, nil
+	}
+
+	vmList := &vmopv1.VirtualMachineList{}
+	if err := client.List(
+		vmCtx,
+		vmList,
+		ctrlclient.InNamespace(vmCtx.VM.Namespace),
+		ctrlclient.MatchingLabels{
+			vmopv1.VirtualMachineReplicaSetNameLabel: vmCtx.VM.Labels[vmopv1.VirtualMachineReplicaSetNameLabel],
+		}); err != nil {
+
+		return nil, nil, fmt.Errorf("failed to list replicas of VirtualMachineReplicaSet %s: %w", rs.Name, err)
+	}
+
+	countReplicas := func() map[string]int {
+		now := time.Now()
+		prunePendingZonePlacements(now)
+		return countReplicasByZone(vmCtx.VM.Name, rs.UID, vmList.Items, zoneNames, zonePlacements[rs.UID], now)
+	}
+
+	zonePlacementsMu.Lock()
+	zoneCounts := countReplicas()
+	zonePlacementsMu.Unlock()
+
+	allowedCandidates, err := filterZonesBySpreadConstraints(constraints, zoneCounts, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmCtx.Logger.V(4).Info("Applied zone spread constraints",
+		"zoneCounts", zoneCounts, "allowedZones", len(allowedCandidates))
+
+	recordZone := func(zoneName string) error {
+		zonePlacementsMu.Lock()
+		defer zonePlacementsMu.Unlock()
+
+		// The replicas are counted again to include the replicas that were
+		// placed while this VM was placed.
+		zoneCounts := countReplicas()
+		if _, err := filterZonesBySpreadConstraints(
+			constraints,
+			zoneCounts,
+			map[string][]string{zoneName: candidates[zoneName]}); err != nil {
+
+			return fmt.Errorf("zone %s no longer satisfies the zone spread constraints "+
+				"after other replicas were placed: %w", zoneName, err)
+		}
+
+		pending, ok := zonePlacements[rs.UID]
+		if !ok {
+			pending = map[string]pendingZonePlacement{}
+			zonePlacements[rs.UID] = pending
+		}
+		pending[vmCtx.VM.Name] = pendingZonePlacement{
+			zoneName: zoneName,
+			expires:  time.Now().Add(pendingZonePlacementTTL),
+		}
+
+		return nil
+	}
+
+	return allowedCandidates, recordZone, nil
+}
+
+// countReplicasByZone returns the number of replicas in each of the zones,
+// excluding the replica being placed. Replicas whose VMs do not yet reflect
+// their zone, including replicas whose VMs were not yet listed, are counted
+// in the zone chosen for them by an earlier placement. The pending placements
+// that are reflected by their VMs are removed.
+func countReplicasByZone(
+	vmName string,
+	rsUID types.UID,
+	vms []vmopv1.VirtualMachine,
+	zoneNames []string,
+	pending map[string]pendingZonePlacement,
+	now time.Time) map[string]int {
+
+	zoneCounts := make(map[string]int, len(zoneNames))
+	for _, zoneName := range zoneNames {
+		zoneCounts[zoneName] = 0
+	}
+
+	listed := make(map[string]struct{}, len(vms))
+
+	for i := range vms {
+		vm := &vms[i]
+		listed[vm.Name] = struct{}{}
+
+		if vm.Name == vmName || !vm.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if ref := metav1.GetControllerOf(vm); ref == nil || ref.UID != rsUID {
+			continue
+		}
+
+		zoneName := vm.Labels[corev1.LabelTopologyZone]
+		if zoneName == "" {
+			zoneName = vm.Status.Zone
+		}
+		if zoneName != "" {
+			delete(pending, vm.Name)
+		} else if p, ok := pending[vm.Name]; ok && now.Before(p.expires) {
+			zoneName = p.zoneName
+		}
+		if _, ok := zoneCounts[zoneName]; ok {
+			zoneCounts[zoneName]++
+		}
+	}
+
+	for name, p := range pending {
+		if _, ok := listed[name]; ok || name == vmName || !now.Before(p.expires) {
+			continue
+		}
+		if _, ok := zoneCounts[p.zoneName]; ok {
+			zoneCounts[p.zoneName]++
+		}
+	}
+
+	return zoneCounts
+}
+
+// filterZonesBySpreadConstraints returns the candidates whose zones satisfy the
+// spread constraints given the number of replicas already in each zone.
+//
+// A zone satisfies a constraint when placing one more replica in it results in
+// a skew no greater than MaxSkew. Constraints with the ScheduleAnyway action
+// are relaxed when they cannot be satisfied, in which case the zones with the
+// fewest replicas are returned.
+func filterZonesBySpreadConstraints(
+	constraints []vmopv1.VirtualMachineTopologySpreadConstraint,
+	zoneCounts map[string]int,
+	candidates map[string][]string) (map[string][]string, error) {
+
+	minCount := math.MaxInt
+	for _, count := range zoneCounts {
+		minCount = min(minCount, count)
+	}
+	if minCount == math.MaxInt {
+		minCount = 0
+	}
+
+	var (
+		allowed  = map[string][]string{}
+		relaxed  = map[string][]string{}
+		minRelax = math.MaxInt
+	)
+
+	for zoneName, rpMoIDs := range candidates {
+		skew := zoneCounts[zoneName] + 1 - minCount
+
+		satisfiesRequired, satisfiesAll := true, true
+		for _, c := range constraints {
+			if skew <= int(c.MaxSkew) {
+				continue
+			}
+			satisfiesAll = false
+			if c.WhenUnsatisfiable != vmopv1.ScheduleAnyway {
+				satisfiesRequired = false
+			}
+		}
+
+		switch {
+		case satisfiesAll:
+			allowed[zoneName] = rpMoIDs
+		case satisfiesRequired:
+			// Only keep the zones with the fewest replicas.
+			if count := zoneCounts[zoneName]; count < minRelax {
+				minRelax = count
+				relaxed = map[string][]string{zoneName: rpMoIDs}
+			} else if count == minRelax {
+				relaxed[zoneName] = rpMoIDs
+			}
+		}
+	}
+
+	if len(allowed) > 0 {
+		return allowed, nil
+	}
+	if len(relaxed) > 0 {
+		return relaxed, nil
+	}
+
+	return nil, fmt.Errorf("no candidates remaining after applying zone spread constraints: %w",
+		ErrNoPlacementCandidates)
+}
@@ -0,0 +1,179 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package placement
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+)
+
+var _ = Describe("Zone spread constraints", func() {
+	const (
+		namespace = "dummy-ns"
+		rsName    = "dummy-rs"
+		zone1     = "zone-1"
+		zone2     = "zone-2"
+	)
+
+	var (
+		rs         *vmopv1.VirtualMachineReplicaSet
+		client     ctrlclient.Client
+		zoneNames  []string
+		candidates map[string][]string
+	)
+
+	newReplica := func(name string) *vmopv1.VirtualMachine {
+		return &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					vmopv1.VirtualMachineReplicaSetNameLabel: rsName,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(rs, vmopv1.GroupVersion.WithKind("VirtualMachineReplicaSet")),
+				},
+			},
+		}
+	}
+
+	newVMContext := func(vm *vmopv1.VirtualMachine) pkgctx.VirtualMachineContext {
+		return pkgctx.VirtualMachineContext{
+			Context: pkgcfg.UpdateContext(pkgcfg.NewContextWithDefaultConfig(), func(config *pkgcfg.Config) {
+				config.Features.K8sWorkloadMgmtAPI = true
+			}),
+			Logger: logr.Discard(),
+			VM:     vm,
+		}
+	}
+
+	BeforeEach(func() {
+		rs = &vmopv1.VirtualMachineReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      rsName,
+				Namespace: namespace,
+				UID:       "dummy-rs-uid",
+			},
+			Spec: vmopv1.VirtualMachineReplicaSetSpec{
+				Template: vmopv1.VirtualMachineTemplateSpec{
+					TopologySpreadConstraints: []vmopv1.VirtualMachineTopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							WhenUnsatisfiable: vmopv1.DoNotSchedule,
+						},
+					},
+				},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(vmopv1.AddToScheme(scheme)).To(Succeed())
+		client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(rs).Build()
+
+		zoneNames = []string{zone1, zone2}
+		candidates = map[string][]string{
+			zone1: {"rp-1"},
+			zone2: {"rp-2"},
+		}
+	})
+
+	AfterEach(func() {
+		zonePlacementsMu.Lock()
+		delete(zonePlacements, rs.UID)
+		zonePlacementsMu.Unlock()
+	})
+
+	When("replicas are placed at the same time", func() {
+		It("rejects a zone that no longer satisfies the constraints", func() {
+			vm1, vm2 := newReplica("replica-1"), newReplica("replica-2")
+			Expect(client.Create(newVMContext(vm1), vm1)).To(Succeed())
+			Expect(client.Create(newVMContext(vm2), vm2)).To(Succeed())
+
+			allowed1, recordZone1, err := applyZoneSpreadConstraints(newVMContext(vm1), client, zoneNames, candidates)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed1).To(HaveLen(2))
+
+			allowed2, recordZone2, err := applyZoneSpreadConstraints(newVMContext(vm2), client, zoneNames, candidates)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed2).To(HaveLen(2))
+
+			Expect(recordZone1(zone1)).To(Succeed())
+			Expect(recordZone2(zone1)).To(MatchError(ContainSubstring("zone zone-1 no longer satisfies")))
+			Expect(recordZone2(zone2)).To(Succeed())
+
+			zonePlacementsMu.Lock()
+			defer zonePlacementsMu.Unlock()
+			Expect(zonePlacements[rs.UID]).To(HaveLen(2))
+			Expect(zonePlacements[rs.UID]["replica-1"].zoneName).To(Equal(zone1))
+			Expect(zonePlacements[rs.UID]["replica-2"].zoneName).To(Equal(zone2))
+		})
+	})
+
+	Describe("countReplicasByZone", func() {
+		It("counts the pending placements of replicas that are not reflected or listed", func() {
+			now := time.Now()
+
+			reflected := newReplica("reflected")
+			reflected.Labels[corev1.LabelTopologyZone] = zone1
+			unreflected := newReplica("unreflected")
+
+			pending := map[string]pendingZonePlacement{
+				"reflected":   {zoneName: zone2, expires: now.Add(time.Minute)},
+				"unreflected": {zoneName: zone2, expires: now.Add(time.Minute)},
+				"unlisted":    {zoneName: zone1, expires: now.Add(time.Minute)},
+				"expired":     {zoneName: zone2, expires: now.Add(-time.Minute)},
+				"self":        {zoneName: zone2, expires: now.Add(time.Minute)},
+			}
+
+			zoneCounts := countReplicasByZone(
+				"self",
+				rs.UID,
+				[]vmopv1.VirtualMachine{*reflected, *unreflected},
+				zoneNames,
+				pending,
+				now)
+			Expect(zoneCounts).To(Equal(map[string]int{zone1: 2, zone2: 1}))
+			Expect(pending).ToNot(HaveKey("reflected"))
+		})
+	})
+
+	Describe("prunePendingZonePlacements", func() {
+		It("removes the expired placements and the replica sets without placements", func() {
+			now := time.Now()
+
+			zonePlacementsMu.Lock()
+			defer zonePlacementsMu.Unlock()
+
+			zonePlacements[rs.UID] = map[string]pendingZonePlacement{
+				"expired": {zoneName: zone1, expires: now.Add(-time.Minute)},
+				"pending": {zoneName: zone1, expires: now.Add(time.Minute)},
+			}
+			deletedUID := types.UID("deleted-rs-uid")
+			zonePlacements[deletedUID] = map[string]pendingZonePlacement{
+				"expired": {zoneName: zone1, expires: now.Add(-time.Minute)},
+			}
+
+			prunePendingZonePlacements(now)
+
+			Expect(zonePlacements).ToNot(HaveKey(deletedUID))
+			Expect(zonePlacements[rs.UID]).To(HaveLen(1))
+			Expect(zonePlacements[rs.UID]).To(HaveKey("pending"))
+		})
+	})
+})
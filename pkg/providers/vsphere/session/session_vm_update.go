@@ -46,6 +46,7 @@ import (
 	vmconfcrypto "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/crypto"
 	vmconfdiskpromo "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/diskpromo"
 	vmconfpolicy "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/policy"
+	vmconfserialport "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
 	vmconfvirtualcontroller "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/virtualcontroller"
 	vmconfunmanagedvolsreg "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/volumes/unmanaged/register"
 )
@@ -1390,6 +1391,25 @@ func reconcileCdrom(
 		configSpec)
 }
 
+func reconcileSerialPort(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine,
+	vcVM *object.VirtualMachine,
+	moVM mo.VirtualMachine,
+	configSpec *vimtypes.VirtualMachineConfigSpec) error {
+
+	pkglog.FromContextOrDefault(ctx).V(4).Info("Reconciling serial port")
+
+	return vmconfserialport.Reconcile(
+		ctx,
+		k8sClient,
+		vcVM.Client(),
+		vm,
+		moVM,
+		configSpec)
+}
+
 func doReconfigure(
 	ctx context.Context,
 	k8sClient ctrlclient.Client,
@@ -1459,6 +1479,17 @@ func doReconfigure(
 		return err
 	}
 
+	if err := reconcileSerialPort(
+		ctx,
+		k8sClient,
+		vm,
+		vcVM,
+		moVM,
+		&configSpec); err != nil {
+
+		return err
+	}
+
 	if pkgcfg.FromContext(ctx).Features.VSpherePolicies {
 		if err := reconcileVSpherePolicies(
 			ctx,
@@ -0,0 +1,118 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/vmware/govmomi/guest"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/soap"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// PutGuestFile writes size bytes read from r to a file in the VM's guest
+// using VMware Tools guest operations.
+func PutGuestFile(
+	ctx context.Context,
+	vm *object.VirtualMachine,
+	auth vimtypes.BaseGuestAuthentication,
+	guestPath string,
+	r io.Reader,
+	size int64,
+	overwrite bool) error {
+
+	fileMgr, err := guest.NewOperationsManager(vm.Client(), vm.Reference()).FileManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	rawURL, err := fileMgr.InitiateFileTransferToGuest(
+		ctx,
+		auth,
+		guestPath,
+		&vimtypes.GuestFileAttributes{},
+		size,
+		overwrite)
+	if err != nil {
+		return err
+	}
+
+	u, err := fileMgr.TransferURL(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+
+	p := soap.DefaultUpload
+	p.ContentLength = size
+
+	return vm.Client().Upload(ctx, io.LimitReader(r, size), u, &p)
+}
+
+// GetGuestFile returns a reader for a file in the VM's guest using VMware
+// Tools guest operations, and the size of the file. An error is returned if
+// the file is larger than maxSize bytes. The caller must close the reader.
+func GetGuestFile(
+	ctx context.Context,
+	vm *object.VirtualMachine,
+	auth vimtypes.BaseGuestAuthentication,
+	guestPath string,
+	maxSize int64) (io.ReadCloser, int64, error) {
+
+	fileMgr, err := guest.NewOperationsManager(vm.Client(), vm.Reference()).FileManager(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := fileMgr.InitiateFileTransferFromGuest(ctx, auth, guestPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if info.Size > maxSize {
+		return nil, 0, fmt.Errorf("guest file %q is %d bytes, which exceeds the maximum of %d bytes",
+			guestPath, info.Size, maxSize)
+	}
+
+	u, err := fileMgr.TransferURL(ctx, info.Url)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r, _, err := vm.Client().Download(ctx, u, &soap.DefaultDownload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &guestFileReader{
+		ReadCloser: r,
+		guestPath:  guestPath,
+		remaining:  info.Size,
+	}, info.Size, nil
+}
+
+// guestFileReader reads a file downloaded from a guest and returns an error
+// if the file has changed size since the transfer was initiated.
+type guestFileReader struct {
+	io.ReadCloser
+	guestPath string
+	remaining int64
+}
+
+func (r *guestFileReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, fmt.Errorf("guest file %q is larger than when the transfer was initiated", r.guestPath)
+	}
+	if errors.Is(err, io.EOF) && r.remaining > 0 {
+		return n, fmt.Errorf("guest file %q is smaller than when the transfer was initiated: %w",
+			r.guestPath, io.ErrUnexpectedEOF)
+	}
+	return n, err
+}
@@ -0,0 +1,69 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"time"
+
+	"github.com/vmware/govmomi/guest"
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// guestProgramPollInterval is how often the guest is queried to determine
+// whether a program started with RunGuestProgram has exited.
+const guestProgramPollInterval = 500 * time.Millisecond
+
+// RunGuestProgram starts a program in the VM's guest using VMware Tools guest
+// operations and waits for it to exit. The program's exit code is returned.
+//
+// If the context is canceled before the program exits, the program is
+// terminated.
+func RunGuestProgram(
+	ctx context.Context,
+	vm *object.VirtualMachine,
+	auth vimtypes.BaseGuestAuthentication,
+	spec vimtypes.GuestProgramSpec) (int32, error) {
+
+	procMgr, err := guest.NewOperationsManager(vm.Client(), vm.Reference()).ProcessManager(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	pid, err := procMgr.StartProgram(ctx, auth, &spec)
+	if err != nil {
+		return 0, err
+	}
+
+	// Do not leave the program running in the guest once the context is done.
+	terminate := func() error {
+		_ = procMgr.TerminateProcess(context.Background(), auth, pid)
+		return ctx.Err()
+	}
+
+	ticker := time.NewTicker(guestProgramPollInterval)
+	defer ticker.Stop()
+
+	for {
+		procs, err := procMgr.ListProcesses(ctx, auth, []int64{pid})
+		if err != nil {
+			// The context may be done while the processes are listed.
+			if ctx.Err() != nil {
+				return 0, terminate()
+			}
+			return 0, err
+		}
+		if len(procs) == 1 && procs[0].EndTime != nil {
+			return procs[0].ExitCode, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, terminate()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/guest"
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func guestProgramTests() {
+
+	var (
+		ctx  *builder.TestContextForVCSim
+		vcVM *object.VirtualMachine
+		auth *vimtypes.NamePasswordAuthentication
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(builder.VCSimTestConfig{})
+		ctx.UseHostGuestProcessManager()
+
+		var err error
+		vcVM, err = ctx.Finder.VirtualMachine(ctx, "DC0_C0_RP0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+
+		auth = &vimtypes.NamePasswordAuthentication{
+			Username: "root",
+			Password: "secret",
+		}
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("returns the exit code of the program", func() {
+		exitCode, err := virtualmachine.RunGuestProgram(ctx, vcVM, auth, vimtypes.GuestProgramSpec{
+			ProgramPath: "/bin/sh",
+			Arguments:   `-c 'exit 3'`,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exitCode).To(BeEquivalentTo(3))
+	})
+
+	It("runs the program in the working directory", func() {
+		exitCode, err := virtualmachine.RunGuestProgram(ctx, vcVM, auth, vimtypes.GuestProgramSpec{
+			ProgramPath:      "/bin/sh",
+			Arguments:        `-c 'test "$(pwd)" = /'`,
+			WorkingDirectory: "/",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exitCode).To(BeZero())
+	})
+
+	It("returns an error when the program does not exist", func() {
+		_, err := virtualmachine.RunGuestProgram(ctx, vcVM, auth, vimtypes.GuestProgramSpec{
+			ProgramPath: "/does/not/exist",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("terminates the program when the context is done", func() {
+		timeoutCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		_, err := virtualmachine.RunGuestProgram(timeoutCtx, vcVM, auth, vimtypes.GuestProgramSpec{
+			ProgramPath: "/bin/sleep",
+			Arguments:   "60",
+		})
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		procMgr, err := guest.NewOperationsManager(vcVM.Client(), vcVM.Reference()).ProcessManager(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(func(g Gomega) {
+			procs, err := procMgr.ListProcesses(ctx, auth, nil)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(procs).To(HaveLen(1))
+			g.Expect(procs[0].EndTime).ToNot(BeNil())
+		}).Should(Succeed())
+	})
+}
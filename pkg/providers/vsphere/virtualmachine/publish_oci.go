@@ -0,0 +1,161 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/ovf"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/progress"
+	"github.com/vmware/govmomi/vim25/soap"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+)
+
+// PushOCIArtifact exports the VM and pushes its disks and OVF descriptor to
+// the OCI repository referred to by ref. The digest of the pushed manifest is
+// returned.
+func PushOCIArtifact(
+	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
+	vcVM *object.VirtualMachine,
+	vmPubReq *vmopv1.VirtualMachinePublishRequest,
+	ociClient *oci.Client,
+	ref oci.Reference) (string, error) {
+
+	item := vmPubReq.Status.TargetRef.Item
+
+	lease, err := vcVM.Export(vmCtx)
+	if err != nil {
+		return "", fmt.Errorf("failed to export VM: %w", err)
+	}
+
+	info, err := lease.Wait(vmCtx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to wait for export lease: %w", err)
+	}
+
+	layers, ovfFiles, err := func() ([]oci.Descriptor, []vimtypes.OvfFile, error) {
+		updater := lease.StartUpdater(vmCtx, info)
+		defer updater.Done()
+
+		var (
+			layers   = make([]oci.Descriptor, 0, len(info.Items)+1)
+			ovfFiles = make([]vimtypes.OvfFile, 0, len(info.Items))
+		)
+
+		for _, fi := range info.Items {
+			vmCtx.Logger.V(4).Info("Pushing exported file",
+				"path", fi.Path, "size", fi.Size)
+
+			rc, size, err := vimClient.Download(vmCtx, fi.URL, &soap.DefaultDownload)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to download %q: %w", fi.Path, err)
+			}
+
+			pr := progress.NewReader(vmCtx, fi, rc, size)
+			desc, err := ociClient.PushBlob(vmCtx, ref, oci.MediaTypeDisk, pr)
+			pr.Done(err)
+			_ = rc.Close()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to push %q: %w", fi.Path, err)
+			}
+
+			desc.Annotations = map[string]string{
+				oci.AnnotationTitle: fi.Path,
+			}
+			layers = append(layers, desc)
+
+			ovfFile := fi.File()
+			ovfFile.Size = desc.Size
+			ovfFiles = append(ovfFiles, ovfFile)
+		}
+
+		return layers, ovfFiles, nil
+	}()
+	if err != nil {
+		if abortErr := lease.Abort(vmCtx, nil); abortErr != nil {
+			vmCtx.Logger.Error(abortErr, "Failed to abort export lease")
+		}
+		return "", err
+	}
+
+	desc, err := ovf.NewManager(vimClient).CreateDescriptor(
+		vmCtx,
+		vcVM,
+		vimtypes.OvfCreateDescriptorParams{
+			Name:     item.Name,
+			OvfFiles: ovfFiles,
+		})
+	if err != nil {
+		_ = lease.Abort(vmCtx, nil)
+		return "", fmt.Errorf("failed to create OVF descriptor: %w", err)
+	}
+	if desc.Error != nil {
+		_ = lease.Abort(vmCtx, nil)
+		return "", fmt.Errorf("failed to create OVF descriptor: %s",
+			desc.Error[0].LocalizedMessage)
+	}
+
+	if err := lease.Complete(vmCtx); err != nil {
+		return "", fmt.Errorf("failed to complete export lease: %w", err)
+	}
+
+	ovfDesc, err := ociClient.PushBlob(
+		vmCtx,
+		ref,
+		oci.MediaTypeOVF,
+		bytes.NewReader([]byte(desc.OvfDescriptor)))
+	if err != nil {
+		return "", fmt.Errorf("failed to push OVF descriptor: %w", err)
+	}
+	ovfDesc.Annotations = map[string]string{
+		oci.AnnotationTitle: item.Name + ".ovf",
+	}
+	layers = append([]oci.Descriptor{ovfDesc}, layers...)
+
+	config, err := json.Marshal(oci.Config{
+		Name:        item.Name,
+		Description: item.Description,
+		Source:      vmCtx.VM.Namespace + "/" + vmCtx.VM.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	configDesc, err := ociClient.PushBlob(
+		vmCtx,
+		ref,
+		oci.MediaTypeConfig,
+		bytes.NewReader(config))
+	if err != nil {
+		return "", fmt.Errorf("failed to push config: %w", err)
+	}
+
+	manifest := oci.Manifest{
+		SchemaVersion: 2,
+		MediaType:     oci.MediaTypeImageManifest,
+		ArtifactType:  oci.ArtifactType,
+		Config:        configDesc,
+		Layers:        layers,
+		Annotations: map[string]string{
+			oci.AnnotationPublishRequestUID: string(vmPubReq.UID),
+		},
+	}
+	if item.Description != "" {
+		manifest.Annotations[oci.AnnotationDescription] = item.Description
+	}
+
+	vmCtx.Logger.Info("Pushing OCI manifest", "reference", ref.String())
+
+	return ociClient.PushManifest(vmCtx, ref, manifest)
+}
@@ -0,0 +1,132 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
+)
+
+// GetSerialConsoleEndpoint returns the address and port of the VM's
+// network-backed serial port on the ESXi host on which the VM is running.
+//
+// The firewall of the host is configured so connections to the serial port
+// are only allowed from the networks used by the serial console proxy.
+func GetSerialConsoleEndpoint(
+	vmCtx pkgctx.VirtualMachineContext,
+	vm *object.VirtualMachine) (string, error) {
+
+	vmCtx.Logger.V(5).Info("GetSerialConsoleEndpoint")
+
+	var o mo.VirtualMachine
+	if err := vm.Properties(
+		vmCtx,
+		vm.Reference(),
+		[]string{"config.hardware.device", "runtime.host"},
+		&o); err != nil {
+
+		return "", err
+	}
+
+	if o.Config == nil {
+		return "", errors.New("vm does not have a serial console")
+	}
+	sp := serialport.FindDevice(o.Config.Hardware.Device)
+	if sp == nil {
+		return "", errors.New("vm does not have a serial console")
+	}
+	port, _ := serialport.DevicePort(sp)
+
+	if o.Runtime.Host == nil {
+		return "", errors.New("vm is not running on a host")
+	}
+	host := object.NewHostSystem(vm.Client(), *o.Runtime.Host)
+
+	allowedHosts, err := serialport.AllowedHosts(
+		pkgcfg.FromContext(vmCtx).SerialConsoleAllowedNetworks)
+	if err != nil {
+		return "", err
+	}
+	if err := restrictSerialPortFirewall(vmCtx, host, *allowedHosts); err != nil {
+		return "", err
+	}
+
+	ips, err := host.ManagementIPs(vmCtx)
+	if err != nil {
+		return "", err
+	}
+	if len(ips) == 0 {
+		return "", errors.New("host does not have a management ip")
+	}
+
+	return net.JoinHostPort(ips[0].String(), strconv.Itoa(int(port))), nil
+}
+
+// restrictSerialPortFirewall enables the firewall ruleset of the host that
+// allows connections to the network-backed serial ports of VMs, and only
+// allows connections from the provided hosts.
+func restrictSerialPortFirewall(
+	ctx context.Context,
+	host *object.HostSystem,
+	allowedHosts vimtypes.HostFirewallRulesetIpList) error {
+
+	fw, err := host.ConfigManager().FirewallSystem(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get host firewall system: %w", err)
+	}
+	info, err := fw.Info(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get host firewall info: %w", err)
+	}
+
+	i := slices.IndexFunc(info.Ruleset, func(r vimtypes.HostFirewallRuleset) bool {
+		return r.Key == serialport.FirewallRulesetID
+	})
+	if i < 0 {
+		return fmt.Errorf("host does not have firewall ruleset %q", serialport.FirewallRulesetID)
+	}
+	ruleset := info.Ruleset[i]
+
+	if ruleset.AllowedHosts == nil || !equalAllowedHosts(*ruleset.AllowedHosts, allowedHosts) {
+		if _, err := methods.UpdateRuleset(ctx, host.Client(), &vimtypes.UpdateRuleset{
+			This: fw.Reference(),
+			Id:   serialport.FirewallRulesetID,
+			Spec: vimtypes.HostFirewallRulesetRulesetSpec{
+				AllowedHosts: allowedHosts,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to update host firewall ruleset %q: %w",
+				serialport.FirewallRulesetID, err)
+		}
+	}
+
+	if !ruleset.Enabled {
+		if err := fw.EnableRuleset(ctx, serialport.FirewallRulesetID); err != nil {
+			return fmt.Errorf("failed to enable host firewall ruleset %q: %w",
+				serialport.FirewallRulesetID, err)
+		}
+	}
+
+	return nil
+}
+
+func equalAllowedHosts(a, b vimtypes.HostFirewallRulesetIpList) bool {
+	return a.AllIp == b.AllIp &&
+		slices.Equal(a.IpAddress, b.IpAddress) &&
+		slices.Equal(a.IpNetwork, b.IpNetwork)
+}
@@ -19,6 +19,7 @@ func vcSimTests() {
 	Describe("Publish", Label(testlabels.VCSim), publishTests)
 	Describe("Backup", Label(testlabels.VCSim), backupTests)
 	Describe("GuestInfo", Label(testlabels.VCSim), guestInfoTests)
+	Describe("GuestProgram", Label(testlabels.VCSim), guestProgramTests)
 	Describe("CD-ROM", Label(testlabels.VCSim), cdromTests)
 	Describe("Snapshot", Label(testlabels.VCSim), snapShotTests)
 	Describe("ExtraConfig", Label(testlabels.VCSim), extraConfigTests)
@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/linuxprep"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/sysprep"
 )
 
@@ -68,6 +69,8 @@ type BootstrapArgs struct {
 	DNSServers       []string
 	SearchSuffixes   []string
 	VLANs            []vmopv1.VirtualMachineNetworkVLANSpec
+	Bonds            []vmopv1.VirtualMachineNetworkBondSpec
+	Bridges          []vmopv1.VirtualMachineNetworkBridgeSpec
 }
 
 var (
@@ -83,6 +86,21 @@ func DoBootstrap( //nolint:gocyclo
 
 	vmCtx.Logger.V(4).Info("Reconciling bootstrap state")
 
+	var generation int64
+	if bs := vmCtx.VM.Spec.Bootstrap; bs != nil {
+		generation = bs.Generation
+	}
+
+	rerun := IsBootstrapRerunPending(vmCtx.VM)
+	if rerun && vmCtx.MoVM.Runtime.PowerState != vimtypes.VirtualMachinePowerStatePoweredOff {
+		// Bootstrap is re-run once the VM has been powered off as part of
+		// reconciling its power state.
+		vmCtx.Logger.Info("Skipping bootstrap until VM is powered off to re-run it",
+			"generation", generation,
+			"bootstrapGeneration", *vmCtx.VM.Status.BootstrapGeneration)
+		return nil
+	}
+
 	bootstrap := vmCtx.VM.Spec.Bootstrap
 	if bootstrap == nil {
 		var cdRomSpecs []vmopv1.VirtualMachineCdromSpec
@@ -97,6 +115,7 @@ func DoBootstrap( //nolint:gocyclo
 		if len(cdRomSpecs) > 0 ||
 			vimtypes.GuestIDToFamily(config.GuestId) != vimtypes.VirtualMachineGuestOsFamilyLinuxGuest {
 			vmCtx.Logger.V(6).Info("no bootstrap provider specified")
+			vmCtx.VM.Status.BootstrapGeneration = &generation
 			return nil
 		}
 
@@ -109,6 +128,7 @@ func DoBootstrap( //nolint:gocyclo
 
 	if bootstrap.Disabled {
 		vmCtx.Logger.V(4).Info("Skipping bootstrap since disabled")
+		vmCtx.VM.Status.BootstrapGeneration = &generation
 		return nil
 	}
 
@@ -117,12 +137,29 @@ func DoBootstrap( //nolint:gocyclo
 		linuxPrep  = bootstrap.LinuxPrep
 		sysPrep    = bootstrap.Sysprep
 		vAppConfig = bootstrap.VAppConfig
+		ign        = bootstrap.Ignition
 	)
 
 	if sysPrep != nil || vAppConfig != nil {
 		bootstrapArgs.TemplateRenderFn = GetTemplateRenderFunc(vmCtx, &bootstrapArgs)
 	}
 
+	if rerun {
+		// Forget the previously applied bootstrap state so the bootstrap
+		// provider is applied again as if the VM were being deployed.
+		vmCtx.Logger.Info("Re-running bootstrap",
+			"generation", generation,
+			"bootstrapGeneration", *vmCtx.VM.Status.BootstrapGeneration)
+		delete(vmCtx.VM.Annotations, pkgconst.BootstrapHashConfigSpecAnnotationKey)
+		delete(vmCtx.VM.Annotations, pkgconst.BootstrapHashCustomSpecAnnotationKey)
+		if linuxPrep != nil && linuxPrep.CustomizeAtNextPowerOn != nil {
+			linuxPrep.CustomizeAtNextPowerOn = ptr.To(true)
+		}
+		if sysPrep != nil && sysPrep.CustomizeAtNextPowerOn != nil {
+			sysPrep.CustomizeAtNextPowerOn = ptr.To(true)
+		}
+	}
+
 	var (
 		configSpec     *vimtypes.VirtualMachineConfigSpec
 		customSpec     *vimtypes.CustomizationSpec
@@ -141,6 +178,8 @@ func DoBootstrap( //nolint:gocyclo
 			vmCtx, config, sysPrep, vAppConfig, &bootstrapArgs)
 	case vAppConfig != nil:
 		configSpec, customSpec, err = BootstrapVAppConfig(vmCtx, config, vAppConfig, &bootstrapArgs)
+	case ign != nil:
+		configSpec, customSpec, err = BootstrapIgnition(vmCtx, config, ign, &bootstrapArgs)
 	}
 
 	if err != nil {
@@ -238,9 +277,28 @@ func DoBootstrap( //nolint:gocyclo
 		*customizeLatch = false
 	}
 
+	vmCtx.VM.Status.BootstrapGeneration = &generation
+
 	return retErr
 }
 
+// IsBootstrapRerunPending returns true if spec.bootstrap.generation differs
+// from the generation recorded the last time bootstrap was applied to the VM.
+func IsBootstrapRerunPending(vm *vmopv1.VirtualMachine) bool {
+	if vm.Status.BootstrapGeneration == nil {
+		// Bootstrap has not yet been applied since the introduction of
+		// spec.bootstrap.generation, so there is nothing to re-run.
+		return false
+	}
+
+	var generation int64
+	if bs := vm.Spec.Bootstrap; bs != nil {
+		generation = bs.Generation
+	}
+
+	return generation != *vm.Status.BootstrapGeneration
+}
+
 // GetBootstrapArgs returns the information used to bootstrap the VM via
 // one of the many, possible bootstrap engines.
 func GetBootstrapArgs(
@@ -255,6 +313,7 @@ func GetBootstrapArgs(
 	}
 
 	isCloudInit := bootstrap.CloudInit != nil
+	isIgnition := bootstrap.Ignition != nil
 	isGOSC := bootstrap.LinuxPrep != nil || bootstrap.Sysprep != nil
 
 	bsa := BootstrapArgs{
@@ -273,6 +332,8 @@ func GetBootstrapArgs(
 		bsa.DNSServers = networkSpec.Nameservers
 		bsa.SearchSuffixes = networkSpec.SearchDomains
 		bsa.VLANs = networkSpec.VLANs
+		bsa.Bonds = networkSpec.Bonds
+		bsa.Bridges = networkSpec.Bridges
 	}
 
 	// If the VM is missing DNS info - that is, it did not specify DNS for the
@@ -320,7 +381,7 @@ func GetBootstrapArgs(
 			bsa.SearchSuffixes = ss
 		}
 
-		if isCloudInit {
+		if isCloudInit || isIgnition {
 			// Previously we would apply the global DNS config to every
 			// interface so do that here too.
 			for i := range networkResults.Results {
@@ -431,7 +492,8 @@ func SanitizeConfigSpec(cs vimtypes.VirtualMachineConfigSpec) vimtypes.VirtualMa
 
 		// This is what is likely to contain any sensitive. We can expand this to vendor
 		// and metadata later if needed.
-		if optVal.Key == constants.CloudInitGuestInfoUserdata {
+		if optVal.Key == constants.CloudInitGuestInfoUserdata ||
+			optVal.Key == constants.IgnitionGuestInfoConfigData {
 			optValCopy := *optVal
 			optValCopy.Value = redacted
 			cs.ExtraConfig[i] = &optValCopy
@@ -122,7 +122,8 @@ func BootStrapCloudInit(
 		}
 	}
 
-	netPlan, err := network.NetPlanCustomization(bsArgs.NetworkResults, bsArgs.VLANs)
+	netPlan, err := network.NetPlanCustomization(
+		bsArgs.NetworkResults, bsArgs.VLANs, bsArgs.Bonds, bsArgs.Bridges)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create NetPlan customization: %w", err)
 	}
@@ -134,6 +135,13 @@ func BootStrapCloudInit(
 
 	iid := BootStrapCloudInitInstanceID(vmCtx.VM, cloudInitSpec)
 
+	// Derive a new instance ID from spec.bootstrap.generation so Cloud-Init
+	// treats the boot after bootstrap is re-run as the first boot of a new
+	// instance.
+	if bs := vmCtx.VM.Spec.Bootstrap; bs != nil && bs.Generation != 0 {
+		iid = fmt.Sprintf("%s-%d", iid, bs.Generation)
+	}
+
 	metadata, err := GetCloudInitMetadata(
 		iid, bsArgs.HostName, bsArgs.DomainName, netPlan, sshPublicKeys,
 		cloudInitSpec.WaitOnNetwork4, cloudInitSpec.WaitOnNetwork6)
@@ -194,6 +194,29 @@ var _ = Describe("CloudInit Bootstrap", func() {
 				})
 			})
 
+			Context("With bootstrap generation", func() {
+				BeforeEach(func() {
+					vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+						CloudInit:  cloudInitSpec,
+						Generation: 2,
+					}
+				})
+				It("Should derive instance ID from generation", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec).ToNot(BeNil())
+
+					extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+					Expect(extraConfig).To(HaveKey(constants.CloudInitGuestInfoMetadata))
+					md, err := pkgutil.TryToDecodeBase64Gzip([]byte(extraConfig[constants.CloudInitGuestInfoMetadata]))
+					Expect(err).ToNot(HaveOccurred())
+
+					ciMetadata := &vmlifecycle.CloudInitMetadata{}
+					Expect(yaml.Unmarshal([]byte(md), ciMetadata)).To(Succeed())
+					Expect(ciMetadata.InstanceID).To(Equal("my-vm-uuid-2"))
+					Expect(cloudInitSpec.InstanceID).To(Equal("my-vm-uuid"))
+				})
+			})
+
 			Context("With runcmds", func() {
 				BeforeEach(func() {
 					cloudInitSpec.CloudConfig.RunCmd = []byte(`["ls /",["ls","-a","-l","/"],["echo","hello, world."]]`)
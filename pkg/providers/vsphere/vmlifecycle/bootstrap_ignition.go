@@ -0,0 +1,150 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ignition"
+)
+
+const (
+	// IgnitionNetworkdDir is the directory in the guest to which the
+	// systemd-networkd configuration files are written.
+	IgnitionNetworkdDir = "/etc/systemd/network"
+
+	// IgnitionHostnamePath is the path in the guest to which the host name is
+	// written.
+	IgnitionHostnamePath = "/etc/hostname"
+)
+
+func BootstrapIgnition(
+	vmCtx pkgctx.VirtualMachineContext,
+	config *vimtypes.VirtualMachineConfigInfo,
+	ignitionSpec *vmopv1.VirtualMachineBootstrapIgnitionSpec,
+	bsArgs *BootstrapArgs) (*vimtypes.VirtualMachineConfigSpec, *vimtypes.CustomizationSpec, error) {
+
+	logger := pkglog.FromContextOrDefault(vmCtx)
+	logger.V(4).Info("Reconciling Ignition bootstrap state")
+
+	if bsArgs.NetworkResults.UpdatedEthCards {
+		// We're not yet doing hot-plug of ethernet devices for a powered on VM. Therefore, if this
+		// VM is on and there were network device related changes, don't apply a new config
+		// since the VM does not have the expected ethernet devices.
+		if vmCtx.MoVM.Runtime.PowerState == vimtypes.VirtualMachinePowerStatePoweredOn {
+			vmCtx.Logger.V(4).Info("Skipping Ignition bootstrap with pending network changes because VM is powered on")
+			return nil, nil, nil
+		}
+	}
+
+	var data string
+	if v := ignitionSpec.Config.Value; v != nil {
+		data = *v
+	} else if from := ignitionSpec.Config.From; from != nil {
+		data = bsArgs.BootstrapData.Data[from.Key]
+	}
+	if data == "" {
+		return nil, nil, fmt.Errorf("ignition config is empty")
+	}
+
+	// Ensure the data is normalized first to plain-text.
+	plainText, err := pkgutil.TryToDecodeBase64Gzip([]byte(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding ignition config failed: %w", err)
+	}
+
+	version, err := ignition.ValidateConfig([]byte(plainText))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !ignitionSpec.DisableNetworkConfig {
+		networkConfig, err := GetIgnitionNetworkConfig(version, bsArgs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The user's config is merged last so it takes precedence over the
+		// network config.
+		merged, err := ignition.MergeConfigs(version, networkConfig, []byte(plainText))
+		if err != nil {
+			return nil, nil, fmt.Errorf("merging ignition config failed: %w", err)
+		}
+		plainText = string(merged)
+	}
+
+	configSpec, err := GetIgnitionGuestInfoConfigSpec(config, plainText)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return configSpec, nil, nil
+}
+
+// GetIgnitionNetworkConfig returns an Ignition config with the provided
+// version that writes the guest's host name and the systemd-networkd
+// configuration for the VM's network interfaces.
+func GetIgnitionNetworkConfig(version string, bsArgs *BootstrapArgs) ([]byte, error) {
+	var files []ignition.File
+
+	if bsArgs.HostName != "" {
+		files = append(files, ignition.NewFile(IgnitionHostnamePath, 0644, bsArgs.HostName+"\n"))
+	}
+
+	for _, f := range network.NetworkdCustomization(
+		bsArgs.NetworkResults, bsArgs.DNSServers, bsArgs.SearchSuffixes) {
+
+		files = append(files, ignition.NewFile(path.Join(IgnitionNetworkdDir, f.Name), 0644, f.Contents))
+	}
+
+	data, err := json.Marshal(ignition.Config{
+		Ignition: ignition.Ignition{
+			Version: version,
+		},
+		Storage: &ignition.Storage{
+			Files: files,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("json marshalling of ignition network config failed: %w", err)
+	}
+
+	return data, nil
+}
+
+func GetIgnitionGuestInfoConfigSpec(
+	config *vimtypes.VirtualMachineConfigInfo,
+	data string) (*vimtypes.VirtualMachineConfigSpec, error) {
+
+	encodedData, err := pkgutil.EncodeGzipBase64(data)
+	if err != nil {
+		return nil, fmt.Errorf("encoding ignition config failed: %w", err)
+	}
+
+	extraConfig := pkgutil.OptionValues{
+		&vimtypes.OptionValue{
+			Key:   constants.IgnitionGuestInfoConfigData,
+			Value: encodedData,
+		},
+		&vimtypes.OptionValue{
+			Key:   constants.IgnitionGuestInfoConfigDataEncoding,
+			Value: "gzip+base64",
+		},
+	}
+
+	return &vimtypes.VirtualMachineConfigSpec{
+		ExtraConfig: pkgutil.OptionValues(config.ExtraConfig).Diff(extraConfig...),
+	}, nil
+}
@@ -0,0 +1,271 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ignition"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+var _ = Describe("Ignition Bootstrap", func() {
+	const (
+		ignitionConfig = `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core"}]}}`
+		macAddr        = "50-8A-80-9D-28-22"
+	)
+
+	var (
+		bsArgs     vmlifecycle.BootstrapArgs
+		configInfo *vimtypes.VirtualMachineConfigInfo
+	)
+
+	BeforeEach(func() {
+		configInfo = &vimtypes.VirtualMachineConfigInfo{}
+		bsArgs.Data = map[string]string{}
+	})
+
+	AfterEach(func() {
+		bsArgs = vmlifecycle.BootstrapArgs{}
+	})
+
+	// decodeExtraConfig returns the decoded Ignition config from the
+	// ExtraConfig of the ConfigSpec.
+	decodeExtraConfig := func(configSpec *vimtypes.VirtualMachineConfigSpec) string {
+		GinkgoHelper()
+		extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+		Expect(extraConfig).To(HaveKeyWithValue(constants.IgnitionGuestInfoConfigDataEncoding, "gzip+base64"))
+		Expect(extraConfig).To(HaveKey(constants.IgnitionGuestInfoConfigData))
+		data, err := pkgutil.TryToDecodeBase64Gzip([]byte(extraConfig[constants.IgnitionGuestInfoConfigData]))
+		Expect(err).ToNot(HaveOccurred())
+		return data
+	}
+
+	// decodeDataURL returns the data from a base64 data URL.
+	decodeDataURL := func(source *string) string {
+		GinkgoHelper()
+		Expect(source).ToNot(BeNil())
+		Expect(*source).To(HavePrefix("data:;base64,"))
+		data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(*source, "data:;base64,"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(data)
+	}
+
+	Context("BootstrapIgnition", func() {
+		var (
+			configSpec *vimtypes.VirtualMachineConfigSpec
+			custSpec   *vimtypes.CustomizationSpec
+			err        error
+
+			vmCtx        pkgctx.VirtualMachineContext
+			vm           *vmopv1.VirtualMachine
+			ignitionSpec *vmopv1.VirtualMachineBootstrapIgnitionSpec
+		)
+
+		BeforeEach(func() {
+			ignitionSpec = &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+				Config: common.ValueOrSecretKeySelector{
+					Value: ptr.To(ignitionConfig),
+				},
+			}
+
+			vm = &vmopv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ignition-bootstrap-test",
+					Namespace: "test-ns",
+				},
+			}
+
+			vmCtx = pkgctx.VirtualMachineContext{
+				Context: context.Background(),
+				Logger:  suite.GetLogger(),
+				VM:      vm,
+			}
+
+			bsArgs.HostName = "my-vm"
+			bsArgs.NetworkResults.Results = []network.NetworkInterfaceResult{
+				{
+					Name:            "eth0",
+					GuestDeviceName: "eth0",
+					MacAddress:      macAddr,
+					DHCP4:           true,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			configSpec, custSpec, err = vmlifecycle.BootstrapIgnition(
+				vmCtx,
+				configInfo,
+				ignitionSpec,
+				&bsArgs,
+			)
+		})
+
+		Context("Pending network changes because VM is powered on", func() {
+			BeforeEach(func() {
+				bsArgs.NetworkResults.UpdatedEthCards = true
+				vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOn
+			})
+
+			It("returns no error", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec).To(BeNil())
+				Expect(custSpec).To(BeNil())
+			})
+		})
+
+		Context("Inline config", func() {
+			It("merges the network config into the config", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(custSpec).To(BeNil())
+				Expect(configSpec).ToNot(BeNil())
+
+				var merged ignition.Config
+				Expect(json.Unmarshal([]byte(decodeExtraConfig(configSpec)), &merged)).To(Succeed())
+				Expect(merged.Ignition.Version).To(Equal("3.4.0"))
+				Expect(merged.Ignition.Config).ToNot(BeNil())
+				Expect(merged.Ignition.Config.Merge).To(HaveLen(2))
+
+				By("network config", func() {
+					var networkConfig ignition.Config
+					Expect(json.Unmarshal(
+						[]byte(decodeDataURL(merged.Ignition.Config.Merge[0].Source)),
+						&networkConfig)).To(Succeed())
+					Expect(networkConfig.Ignition.Version).To(Equal("3.4.0"))
+					Expect(networkConfig.Storage).ToNot(BeNil())
+
+					files := map[string]string{}
+					for _, f := range networkConfig.Storage.Files {
+						Expect(f.Overwrite).To(HaveValue(BeTrue()))
+						files[f.Path] = decodeDataURL(f.Contents.Source)
+					}
+					Expect(files).To(HaveLen(3))
+					Expect(files).To(HaveKeyWithValue(vmlifecycle.IgnitionHostnamePath, "my-vm\n"))
+					Expect(files).To(HaveKey("/etc/systemd/network/10-eth0.link"))
+					Expect(files).To(HaveKey("/etc/systemd/network/10-eth0.network"))
+					Expect(files["/etc/systemd/network/10-eth0.network"]).To(ContainSubstring("DHCP=ipv4\n"))
+				})
+
+				By("user config", func() {
+					Expect(decodeDataURL(merged.Ignition.Config.Merge[1].Source)).To(Equal(ignitionConfig))
+				})
+			})
+
+			When("network config is disabled", func() {
+				BeforeEach(func() {
+					ignitionSpec.DisableNetworkConfig = true
+				})
+
+				It("uses the config as-is", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec).ToNot(BeNil())
+					Expect(decodeExtraConfig(configSpec)).To(Equal(ignitionConfig))
+				})
+			})
+
+			When("config is gzipped and base64-encoded", func() {
+				BeforeEach(func() {
+					ignitionSpec.DisableNetworkConfig = true
+					data, err := pkgutil.EncodeGzipBase64(ignitionConfig)
+					Expect(err).ToNot(HaveOccurred())
+					ignitionSpec.Config.Value = &data
+				})
+
+				It("decodes the config", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec).ToNot(BeNil())
+					Expect(decodeExtraConfig(configSpec)).To(Equal(ignitionConfig))
+				})
+			})
+
+			When("config has an unsupported version", func() {
+				BeforeEach(func() {
+					ignitionSpec.Config.Value = ptr.To(`{"ignition":{"version":"2.3.0"}}`)
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(ContainSubstring(`unsupported ignition config version "2.3.0"`)))
+					Expect(configSpec).To(BeNil())
+				})
+			})
+
+			When("config is not JSON", func() {
+				BeforeEach(func() {
+					ignitionSpec.Config.Value = ptr.To("#cloud-config\n")
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(ignition.ErrInvalidConfig))
+					Expect(configSpec).To(BeNil())
+				})
+			})
+		})
+
+		Context("Config from Secret", func() {
+			BeforeEach(func() {
+				ignitionSpec.DisableNetworkConfig = true
+				ignitionSpec.Config = common.ValueOrSecretKeySelector{
+					From: &common.SecretKeySelector{
+						Name: "my-secret",
+						Key:  "config.ign",
+					},
+				}
+				bsArgs.Data["config.ign"] = ignitionConfig
+			})
+
+			It("uses the config from the Secret", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec).ToNot(BeNil())
+				Expect(decodeExtraConfig(configSpec)).To(Equal(ignitionConfig))
+			})
+
+			When("key is not in the Secret", func() {
+				BeforeEach(func() {
+					delete(bsArgs.Data, "config.ign")
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError("ignition config is empty"))
+					Expect(configSpec).To(BeNil())
+				})
+			})
+		})
+
+		Context("ExtraConfig is already up-to-date", func() {
+			BeforeEach(func() {
+				ignitionSpec.DisableNetworkConfig = true
+				data, err := pkgutil.EncodeGzipBase64(ignitionConfig)
+				Expect(err).ToNot(HaveOccurred())
+				configInfo.ExtraConfig = []vimtypes.BaseOptionValue{
+					&vimtypes.OptionValue{Key: constants.IgnitionGuestInfoConfigData, Value: data},
+					&vimtypes.OptionValue{Key: constants.IgnitionGuestInfoConfigDataEncoding, Value: "gzip+base64"},
+				}
+			})
+
+			It("returns a ConfigSpec without ExtraConfig", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec).ToNot(BeNil())
+				Expect(configSpec.ExtraConfig).To(BeEmpty())
+			})
+		})
+	})
+})
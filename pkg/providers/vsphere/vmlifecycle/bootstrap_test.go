@@ -56,6 +56,41 @@ var _ = Describe("Customization utils", func() {
 	})
 })
 
+var _ = Describe("IsBootstrapRerunPending", func() {
+	var vm *vmopv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = builder.DummyVirtualMachine()
+		vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+			Generation: 1,
+		}
+	})
+
+	When("status generation is unset", func() {
+		It("is not pending", func() {
+			Expect(vmlifecycle.IsBootstrapRerunPending(vm)).To(BeFalse())
+		})
+	})
+
+	When("status generation matches spec", func() {
+		BeforeEach(func() {
+			vm.Status.BootstrapGeneration = ptr.To[int64](1)
+		})
+		It("is not pending", func() {
+			Expect(vmlifecycle.IsBootstrapRerunPending(vm)).To(BeFalse())
+		})
+	})
+
+	When("status generation is less than spec", func() {
+		BeforeEach(func() {
+			vm.Status.BootstrapGeneration = ptr.To[int64](0)
+		})
+		It("is pending", func() {
+			Expect(vmlifecycle.IsBootstrapRerunPending(vm)).To(BeTrue())
+		})
+	})
+})
+
 var _ = Describe("SanitizeConfigSpec", func() {
 	var (
 		inConfigSpec, outConfigSpec vimtypes.VirtualMachineConfigSpec
@@ -88,6 +123,24 @@ var _ = Describe("SanitizeConfigSpec", func() {
 		})
 	})
 
+	When("EC IgnitionGuestInfoConfigData", func() {
+		BeforeEach(func() {
+			inConfigSpec.ExtraConfig = append(inConfigSpec.ExtraConfig, &vimtypes.OptionValue{
+				Key:   constants.IgnitionGuestInfoConfigData,
+				Value: "value",
+			})
+		})
+
+		It("redacts value", func() {
+			Expect(inConfigSpec.ExtraConfig).To(HaveLen(1))
+			Expect(inConfigSpec.ExtraConfig[0].GetOptionValue().Value).To(Equal("value"))
+
+			Expect(outConfigSpec.ExtraConfig).To(HaveLen(1))
+			Expect(outConfigSpec.ExtraConfig[0].GetOptionValue().Key).To(Equal(constants.IgnitionGuestInfoConfigData))
+			Expect(outConfigSpec.ExtraConfig[0].GetOptionValue().Value).To(Equal("***"))
+		})
+	})
+
 	When("vAppConfig user property", func() {
 		BeforeEach(func() {
 			inConfigSpec.VAppConfig = &vimtypes.VmConfigSpec{
@@ -369,6 +422,37 @@ var _ = Describe("DoBootstrap", func() {
 				Expect(vmCtx.VM.Spec.Bootstrap.LinuxPrep.CustomizeAtNextPowerOn).To(HaveValue(BeFalse()))
 			})
 		})
+
+		When("Generation is increased", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap.LinuxPrep.CustomizeAtNextPowerOn = ptr.To(false)
+				vmCtx.VM.Spec.Bootstrap.Generation = 2
+				vmCtx.VM.Status.BootstrapGeneration = ptr.To[int64](1)
+			})
+
+			When("VM is powered on", func() {
+				BeforeEach(func() {
+					vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOn
+				})
+
+				It("Does not customize", func() {
+					Expect(bsErr).ToNot(HaveOccurred())
+					Expect(vmCtx.VM.Status.BootstrapGeneration).To(HaveValue(BeEquivalentTo(1)))
+				})
+			})
+
+			When("VM is powered off", func() {
+				BeforeEach(func() {
+					vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOff
+				})
+
+				It("Customizes and updates status", func() {
+					Expect(bsErr).To(MatchError(vmlifecycle.ErrBootstrapCustomize))
+					Expect(vmCtx.VM.Spec.Bootstrap.LinuxPrep.CustomizeAtNextPowerOn).To(HaveValue(BeFalse()))
+					Expect(vmCtx.VM.Status.BootstrapGeneration).To(HaveValue(BeEquivalentTo(2)))
+				})
+			})
+		})
 	})
 
 	Context("Sysprep", func() {
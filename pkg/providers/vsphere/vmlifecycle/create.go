@@ -21,6 +21,17 @@ type CreateArgs struct {
 	UseContentLibrary bool
 	ProviderItemID    string
 
+	// CloneSourceVMMoID is the managed object ID of the VM that is cloned to
+	// create the new VM. CloneSnapshotName, if set, is the name of the source
+	// VM's snapshot from which the new VM is cloned.
+	CloneSourceVMMoID string
+	CloneSnapshotName string
+	LinkedClone       bool
+
+	// OCIImage is set when the VM is deployed from an image whose content is
+	// an OCI artifact.
+	OCIImage *OCIImage
+
 	ConfigSpec                vimtypes.VirtualMachineConfigSpec
 	StorageProvisioning       string
 	DatacenterMoID            string
@@ -58,6 +69,17 @@ func CreateVirtualMachine(
 	finder *find.Finder,
 	createArgs *CreateArgs) (*vimtypes.ManagedObjectReference, error) {
 
+	if createArgs.CloneSourceVMMoID != "" {
+		// This VM is cloned from another VM or VM snapshot.
+		return cloneVMFromInventory(vmCtx, vimClient, finder, createArgs)
+	}
+
+	if createArgs.OCIImage != nil {
+		// This VM is imported from the OVF descriptor and disks of an OCI
+		// artifact.
+		return deployFromOCI(vmCtx, k8sClient, vimClient, createArgs)
+	}
+
 	if strings.HasPrefix(createArgs.ProviderItemID, "vm-") {
 		// This is a VM-backed image, and it can only be provisioned via fast
 		// deploy.
@@ -67,5 +89,5 @@ func CreateVirtualMachine(
 	if createArgs.UseContentLibrary {
 		return deployFromContentLibrary(vmCtx, restClient, vimClient, createArgs)
 	}
-	return cloneVMFromInventory(vmCtx, finder, createArgs)
+	return cloneVMFromInventory(vmCtx, vimClient, finder, createArgs)
 }
@@ -5,30 +5,47 @@
 package vmlifecycle
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/placement"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
-// CloneVMFromInventory creates a new VM by cloning the source VM. This is not reachable/used
-// in production because we only really support deploying an OVF via content library.
-// Maybe someday we'll use clone to speed up VM deployment so keep this code around and unit tested.
+// cloneVMFromInventory creates a new VM by cloning the source VM. In
+// production, the source VM is the VM referenced by a VirtualMachine or
+// VirtualMachineSnapshot resource in spec.image. Otherwise, for testing only,
+// the source VM is the inventory VM with the same name as the image.
 func cloneVMFromInventory(
 	vmCtx pkgctx.VirtualMachineContext,
+	vimClient *vim25.Client,
 	finder *find.Finder,
 	createArgs *CreateArgs) (*vimtypes.ManagedObjectReference, error) {
 
-	srcVMName := createArgs.ProviderItemID // AKA: vmCtx.VM.Spec.Image.Name
+	var srcVM *object.VirtualMachine
 
-	srcVM, err := finder.VirtualMachine(vmCtx, srcVMName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find clone source VM: %s: %w", srcVMName, err)
+	if srcVMMoID := createArgs.CloneSourceVMMoID; srcVMMoID != "" {
+		srcVM = object.NewVirtualMachine(vimClient, vimtypes.ManagedObjectReference{
+			Type:  "VirtualMachine",
+			Value: srcVMMoID,
+		})
+	} else {
+		srcVMName := createArgs.ProviderItemID // AKA: vmCtx.VM.Spec.Image.Name
+
+		vm, err := finder.VirtualMachine(vmCtx, srcVMName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find clone source VM: %s: %w", srcVMName, err)
+		}
+		srcVM = vm
 	}
 
 	cloneSpec, err := createCloneSpec(vmCtx, createArgs, srcVM)
@@ -63,11 +80,62 @@ func createCloneSpec(
 		Memory: ptr.To(false), // No full memory clones.
 	}
 
+	diskMoveType := vimtypes.VirtualMachineRelocateDiskMoveOptionsMoveChildMostDiskBacking
+
 	virtualDevices, err := srcVM.Device(vmCtx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get clone source VM devices: %w", err)
 	}
 
+	if createArgs.CloneSourceVMMoID != "" {
+		snapshotRef, err := cloneSourceSnapshot(vmCtx, srcVM, createArgs)
+		if err != nil {
+			return nil, err
+		}
+
+		if snapshotRef != nil {
+			cloneSpec.Snapshot = snapshotRef
+
+			// The clone has the devices of the snapshot, not those of the
+			// source VM's current state.
+			var moSnap mo.VirtualMachineSnapshot
+			if err := srcVM.Properties(
+				vmCtx,
+				*snapshotRef,
+				[]string{"config.hardware.device"},
+				&moSnap); err != nil {
+
+				return nil, fmt.Errorf("failed to get clone source snapshot devices: %w", err)
+			}
+			virtualDevices = moSnap.Config.Hardware.Device
+		}
+
+		if createArgs.LinkedClone {
+			diskMoveType = vimtypes.VirtualMachineRelocateDiskMoveOptionsCreateNewChildDiskBacking
+		} else {
+			diskMoveType = vimtypes.VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndDisallowSharing
+		}
+
+		// The clone's network interfaces are described by the VM's spec, and
+		// its PVC volumes are copied to new claims and attached after the VM
+		// is created, so do not clone the source's NICs and FCDs.
+		var removed object.VirtualDeviceList
+		virtualDevices, removed = cloneSourceDevicesToRemove(virtualDevices)
+		for _, dev := range removed {
+			cloneSpec.Config.DeviceChange = append(cloneSpec.Config.DeviceChange,
+				&vimtypes.VirtualDeviceConfigSpec{
+					Operation: vimtypes.VirtualDeviceConfigSpecOperationRemove,
+					Device:    dev,
+				})
+		}
+
+		ec, err := cloneBootstrapExtraConfig(vmCtx, srcVM)
+		if err != nil {
+			return nil, err
+		}
+		cloneSpec.Config.ExtraConfig = pkgutil.OptionValues(cloneSpec.Config.ExtraConfig).Append(ec...)
+	}
+
 	virtualDisks := virtualDevices.SelectByType((*vimtypes.VirtualDisk)(nil))
 
 	for _, deviceChange := range resizeBootDiskDeviceChange(vmCtx, virtualDisks) {
@@ -116,15 +184,101 @@ func createCloneSpec(
 
 	cloneSpec.Location.Host = relocateSpec.Host
 	cloneSpec.Location.Datastore = relocateSpec.Datastore
-	cloneSpec.Location.Disk = cloneVMDiskLocators(virtualDisks, createArgs, cloneSpec.Location)
+	cloneSpec.Location.Disk = cloneVMDiskLocators(virtualDisks, createArgs, cloneSpec.Location, diskMoveType)
 
 	return cloneSpec, nil
 }
 
+// cloneSourceSnapshot returns the reference to the source VM's snapshot from
+// which the VM is cloned, if any. A linked clone of a VM that is not cloned
+// from a specific snapshot is linked to the source VM's current snapshot.
+func cloneSourceSnapshot(
+	vmCtx pkgctx.VirtualMachineContext,
+	srcVM *object.VirtualMachine,
+	createArgs *CreateArgs) (*vimtypes.ManagedObjectReference, error) {
+
+	if createArgs.CloneSnapshotName == "" && !createArgs.LinkedClone {
+		return nil, nil
+	}
+
+	var moVM mo.VirtualMachine
+	if err := srcVM.Properties(vmCtx, srcVM.Reference(), []string{"snapshot"}, &moVM); err != nil {
+		return nil, fmt.Errorf("failed to get clone source VM snapshots: %w", err)
+	}
+
+	if name := createArgs.CloneSnapshotName; name != "" {
+		snapshot, err := virtualmachine.FindSnapshot(moVM, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find clone source snapshot: %w", err)
+		}
+		return &snapshot.Snapshot, nil
+	}
+
+	if moVM.Snapshot == nil || moVM.Snapshot.CurrentSnapshot == nil {
+		return nil, errors.New("linked clone requires the source VM to have a current snapshot")
+	}
+
+	return moVM.Snapshot.CurrentSnapshot, nil
+}
+
+// cloneSourceDevicesToRemove splits the source VM's devices into those that
+// are cloned and those that are removed from the clone: the network interfaces
+// and the first class disks that back PVC volumes.
+func cloneSourceDevicesToRemove(
+	devices object.VirtualDeviceList) (object.VirtualDeviceList, object.VirtualDeviceList) {
+
+	var keep, remove object.VirtualDeviceList
+
+	for _, dev := range devices {
+		switch tdev := dev.(type) {
+		case vimtypes.BaseVirtualEthernetCard:
+			remove = append(remove, dev)
+		case *vimtypes.VirtualDisk:
+			if tdev.VDiskId != nil && tdev.VDiskId.Id != "" {
+				remove = append(remove, dev)
+			} else {
+				keep = append(keep, dev)
+			}
+		default:
+			keep = append(keep, dev)
+		}
+	}
+
+	return keep, remove
+}
+
+// cloneBootstrapExtraConfig returns the source VM's extra config keys that
+// carry its identity and bootstrap data, such as its cloud-init metadata,
+// with their values reset so the clone is bootstrapped as a new VM.
+func cloneBootstrapExtraConfig(
+	vmCtx pkgctx.VirtualMachineContext,
+	srcVM *object.VirtualMachine) (pkgutil.OptionValues, error) {
+
+	srcEC, err := virtualmachine.GetExtraConfigFromObject(vmCtx, srcVM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clone source VM extra config: %w", err)
+	}
+
+	filtered, err := virtualmachine.FilteredExtraConfig(srcEC, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var reset pkgutil.OptionValues
+	for _, ov := range filtered {
+		if v, ok := ov.GetOptionValue().Value.(string); ok && v == "" {
+			reset = append(reset, ov)
+		}
+	}
+
+	return reset, nil
+}
+
 func cloneVMDiskLocators(
 	disks object.VirtualDeviceList,
 	createArgs *CreateArgs,
-	location vimtypes.VirtualMachineRelocateSpec) []vimtypes.VirtualMachineRelocateSpecDiskLocator {
+	location vimtypes.VirtualMachineRelocateSpec,
+	diskMoveType vimtypes.VirtualMachineRelocateDiskMoveOptions) []vimtypes.VirtualMachineRelocateSpecDiskLocator {
 
 	diskLocators := make([]vimtypes.VirtualMachineRelocateSpecDiskLocator, 0, len(disks))
 
@@ -134,7 +288,7 @@ func cloneVMDiskLocators(
 			Datastore: *location.Datastore,
 			Profile:   location.Profile,
 			// TODO: Check if policy is encrypted and use correct DiskMoveType
-			DiskMoveType: string(vimtypes.VirtualMachineRelocateDiskMoveOptionsMoveChildMostDiskBacking),
+			DiskMoveType: string(diskMoveType),
 		}
 
 		if backing, ok := disk.(*vimtypes.VirtualDisk).Backing.(*vimtypes.VirtualDiskFlatVer2BackingInfo); ok {
@@ -0,0 +1,255 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/ovf"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+)
+
+// OCIImage describes an image whose content is an OCI artifact.
+type OCIImage struct {
+	Source vmopv1.VirtualMachineImageOCISource
+
+	// SecretNamespace is the namespace of the Secret with the credentials for
+	// the registry.
+	SecretNamespace string
+}
+
+// deployFromOCI creates the VM by importing the OVF descriptor and disks of an
+// OCI artifact. The ConfigSpec from the create args is merged into the import
+// spec generated from the OVF descriptor.
+func deployFromOCI(
+	vmCtx pkgctx.VirtualMachineContext,
+	k8sClient ctrlclient.Client,
+	vimClient *vim25.Client,
+	createArgs *CreateArgs) (*vimtypes.ManagedObjectReference, error) {
+
+	src := createArgs.OCIImage.Source
+
+	ref, err := oci.ParseReference(src.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := oci.GetCredentials(
+		vmCtx,
+		k8sClient,
+		createArgs.OCIImage.SecretNamespace,
+		src.SecretName,
+		ref.Registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+
+	ociClient := oci.NewClient(oci.Options{
+		Credentials:        creds,
+		InsecureSkipVerify: src.InsecureSkipTLSVerify,
+	})
+
+	manifest, _, err := ociClient.GetManifest(vmCtx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest %s: %w", ref, err)
+	}
+
+	ovfLayer, ok := manifest.LayerByMediaType(oci.MediaTypeOVF)
+	if !ok {
+		return nil, fmt.Errorf("%s does not have an OVF descriptor", ref)
+	}
+
+	ovfDescriptor, err := ociClient.GetBlobBytes(vmCtx, ref, ovfLayer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OVF descriptor: %w", err)
+	}
+
+	datastoreMoID := createArgs.DatastoreMoID
+	if datastoreMoID == "" && len(createArgs.Datastores) > 0 {
+		datastoreMoID = createArgs.Datastores[0].MoRef.Value
+	}
+	if datastoreMoID == "" {
+		return nil, fmt.Errorf("no datastore for OCI image import")
+	}
+
+	var (
+		folder = object.NewFolder(vimClient, vimtypes.ManagedObjectReference{
+			Type: "Folder", Value: createArgs.FolderMoID})
+		resourcePool = object.NewResourcePool(vimClient, vimtypes.ManagedObjectReference{
+			Type: "ResourcePool", Value: createArgs.ResourcePoolMoID})
+		datastore = object.NewDatastore(vimClient, vimtypes.ManagedObjectReference{
+			Type: "Datastore", Value: datastoreMoID})
+		host *object.HostSystem
+	)
+	if createArgs.HostMoID != "" {
+		host = object.NewHostSystem(vimClient, vimtypes.ManagedObjectReference{
+			Type: "HostSystem", Value: createArgs.HostMoID})
+	}
+
+	importSpecResult, err := ovf.NewManager(vimClient).CreateImportSpec(
+		vmCtx,
+		string(ovfDescriptor),
+		resourcePool,
+		datastore,
+		&vimtypes.OvfCreateImportSpecParams{
+			OvfManagerCommonParams: vimtypes.OvfManagerCommonParams{
+				Locale: "US",
+			},
+			EntityName:       vmCtx.VM.Name,
+			DiskProvisioning: createArgs.StorageProvisioning,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import spec: %w", err)
+	}
+	if len(importSpecResult.Error) > 0 {
+		return nil, fmt.Errorf("failed to create import spec: %s",
+			importSpecResult.Error[0].LocalizedMessage)
+	}
+
+	importSpec, ok := importSpecResult.ImportSpec.(*vimtypes.VirtualMachineImportSpec)
+	if !ok {
+		return nil, fmt.Errorf("unexpected import spec type %T", importSpecResult.ImportSpec)
+	}
+	mergeImportConfigSpec(&importSpec.ConfigSpec, createArgs.ConfigSpec, createArgs.StorageProfileID)
+
+	vmCtx.Logger.Info("Importing OCI image",
+		"reference", ref.String(), "datastore", datastoreMoID,
+		"k8sVMCreateTimestamp", vmCtx.VM.CreationTimestamp.Format(time.RFC3339))
+
+	lease, err := resourcePool.ImportVApp(vmCtx, importSpec, folder, host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import OCI image: %w", err)
+	}
+
+	info, err := lease.Wait(vmCtx, importSpecResult.FileItem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for import lease: %w", err)
+	}
+
+	if err := func() error {
+		updater := lease.StartUpdater(vmCtx, info)
+		defer updater.Done()
+
+		for _, item := range info.Items {
+			layer, ok := manifest.LayerByTitle(item.Path)
+			if !ok {
+				return fmt.Errorf("%s does not have file %q", ref, item.Path)
+			}
+
+			rc, size, err := ociClient.GetBlob(vmCtx, ref, layer.Digest)
+			if err != nil {
+				return fmt.Errorf("failed to get file %q: %w", item.Path, err)
+			}
+
+			err = lease.Upload(vmCtx, item, rc, soap.Upload{ContentLength: size})
+			_ = rc.Close()
+			if err != nil {
+				return fmt.Errorf("failed to upload file %q: %w", item.Path, err)
+			}
+		}
+
+		return nil
+	}(); err != nil {
+		if abortErr := lease.Abort(vmCtx, nil); abortErr != nil {
+			vmCtx.Logger.Error(abortErr, "Failed to abort import lease")
+		}
+		return nil, err
+	}
+
+	if err := lease.Complete(vmCtx); err != nil {
+		return nil, fmt.Errorf("failed to complete import lease: %w", err)
+	}
+
+	vmCtx.Logger.Info("Successfully imported OCI image", "vm", info.Entity)
+
+	return &info.Entity, nil
+}
+
+// mergeImportConfigSpec merges the ConfigSpec generated for the VM into the
+// ConfigSpec of an import spec. The VM's hardware, policy, and ExtraConfig
+// take precedence over the OVF descriptor's. The OVF descriptor's network
+// devices are replaced by the VM's, and its disks and controllers are kept.
+func mergeImportConfigSpec(
+	dst *vimtypes.VirtualMachineConfigSpec,
+	src vimtypes.VirtualMachineConfigSpec,
+	storageProfileID string) {
+
+	dst.Name = src.Name
+	if src.NumCPUs != 0 {
+		dst.NumCPUs = src.NumCPUs
+	}
+	if src.NumCoresPerSocket != nil {
+		dst.NumCoresPerSocket = src.NumCoresPerSocket
+	}
+	if src.MemoryMB != 0 {
+		dst.MemoryMB = src.MemoryMB
+	}
+	if src.CpuAllocation != nil {
+		dst.CpuAllocation = src.CpuAllocation
+	}
+	if src.MemoryAllocation != nil {
+		dst.MemoryAllocation = src.MemoryAllocation
+	}
+	if src.GuestId != "" {
+		dst.GuestId = src.GuestId
+	}
+	if src.Firmware != "" {
+		dst.Firmware = src.Firmware
+	}
+	if src.InstanceUuid != "" {
+		dst.InstanceUuid = src.InstanceUuid
+	}
+	if src.Files != nil && src.Files.VmPathName != "" {
+		dst.Files = src.Files
+	}
+	if src.Crypto != nil {
+		dst.Crypto = src.Crypto
+	}
+	if len(src.VmProfile) > 0 {
+		dst.VmProfile = src.VmProfile
+	}
+	if len(src.ExtraConfig) > 0 {
+		dst.ExtraConfig = object.OptionValueList(src.ExtraConfig).
+			Join(dst.ExtraConfig...)
+	}
+
+	deviceChange := make([]vimtypes.BaseVirtualDeviceConfigSpec, 0,
+		len(dst.DeviceChange)+len(src.DeviceChange))
+
+	for _, bdc := range dst.DeviceChange {
+		dc := bdc.GetVirtualDeviceConfigSpec()
+		if _, ok := dc.Device.(vimtypes.BaseVirtualEthernetCard); ok {
+			continue
+		}
+		if _, ok := dc.Device.(*vimtypes.VirtualDisk); ok && storageProfileID != "" {
+			dc.Profile = []vimtypes.BaseVirtualMachineProfileSpec{
+				&vimtypes.VirtualMachineDefinedProfileSpec{
+					ProfileId: storageProfileID,
+				},
+			}
+		}
+		deviceChange = append(deviceChange, bdc)
+	}
+
+	for _, bdc := range src.DeviceChange {
+		switch bdc.GetVirtualDeviceConfigSpec().Device.(type) {
+		case *vimtypes.VirtualDisk, vimtypes.BaseVirtualController:
+			// The disks and their controllers are from the OVF descriptor.
+			continue
+		}
+		deviceChange = append(deviceChange, bdc)
+	}
+
+	dst.DeviceChange = deviceChange
+}
@@ -495,7 +495,8 @@ func reconcileStatusNodeName(
 
 // updateProbeStatus updates a VM's status with the results of the configured
 // readiness probes.
-// Please note, this function returns early if the configured probe is TCP.
+// Please note, this function returns early if the configured probe must be run
+// periodically by the prober manager, ex. a TCP probe.
 func reconcileStatusProbe(
 	vmCtx pkgctx.VirtualMachineContext,
 	_ ctrlclient.Client,
@@ -503,7 +504,7 @@ func reconcileStatusProbe(
 	_ ReconcileStatusData) []error { //nolint:unparam
 
 	p := vmCtx.VM.Spec.ReadinessProbe
-	if p == nil || p.TCPSocket != nil {
+	if p == nil || vmopv1util.RequiresPeriodicReadinessProbe(*vmCtx.VM) {
 		return nil
 	}
 
@@ -750,6 +751,12 @@ func MarkVMToolsRunningStatusCondition(
 }
 
 func MarkCustomizationInfoCondition(vm *vmopv1.VirtualMachine, guestInfo *vimtypes.GuestInfo) {
+	if IsBootstrapRerunPending(vm) {
+		conditions.MarkFalse(vm, vmopv1.GuestCustomizationCondition, vmopv1.GuestCustomizationRerunPendingReason,
+			"Bootstrap will be re-run once the VM is powered off")
+		return
+	}
+
 	if guestInfo == nil || guestInfo.CustomizationInfo == nil {
 		conditions.MarkUnknown(vm, vmopv1.GuestCustomizationCondition, "NoGuestInfo", "")
 		return
@@ -1009,6 +1016,9 @@ func UpdateNetworkStatusConfig(vm *vmopv1.VirtualMachine, args BootstrapArgs) {
 		}
 	}
 
+	// Update the bond and bridge information.
+	updateNetworkStatusConfigAggregates(&nc, args.Bonds, args.Bridges)
+
 	// If the network config ended up empty, then ensure the VM's field
 	// status.network.config is nil IFF status.network is non-nil.
 	// Otherwise, assign the network config to the VM's status.network.config
@@ -1025,6 +1035,85 @@ func UpdateNetworkStatusConfig(vm *vmopv1.VirtualMachine, args BootstrapArgs) {
 	}
 }
 
+// updateNetworkStatusConfigAggregates adds the bonds and bridges to the
+// provided network config. The IP and DNS information of the members of a bond
+// or bridge is moved to the bond or bridge, since that is how the guest's
+// network is configured.
+func updateNetworkStatusConfigAggregates(
+	nc *vmopv1.VirtualMachineNetworkConfigStatus,
+	bonds []vmopv1.VirtualMachineNetworkBondSpec,
+	bridges []vmopv1.VirtualMachineNetworkBridgeSpec) {
+
+	if len(bonds) == 0 && len(bridges) == 0 {
+		return
+	}
+
+	type l3Config struct {
+		ip  *vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus
+		dns *vmopv1.VirtualMachineNetworkConfigDNSStatus
+	}
+
+	var (
+		l3Configs = map[string]l3Config{}
+		bondIdx   = map[string]int{}
+		members   = sets.New[string]()
+	)
+
+	for _, ifc := range nc.Interfaces {
+		l3Configs[ifc.Name] = l3Config{ip: ifc.IP, dns: ifc.DNS}
+	}
+
+	for _, bond := range bonds {
+		src := bond.Interfaces[0]
+		if p := bond.Parameters; p != nil && p.Primary != "" {
+			src = p.Primary
+		}
+		l3 := l3Configs[src]
+		l3Configs[bond.Name] = l3
+		bondIdx[bond.Name] = len(nc.Bonds)
+		members.Insert(bond.Interfaces...)
+
+		nc.Bonds = append(nc.Bonds, vmopv1.VirtualMachineNetworkConfigAggregateStatus{
+			Name:       bond.Name,
+			Interfaces: bond.Interfaces,
+			IP:         l3.ip,
+			DNS:        l3.dns,
+		})
+	}
+
+	for _, bridge := range bridges {
+		l3 := l3Configs[bridge.Interfaces[0]]
+		members.Insert(bridge.Interfaces...)
+
+		nc.Bridges = append(nc.Bridges, vmopv1.VirtualMachineNetworkConfigAggregateStatus{
+			Name:       bridge.Name,
+			Interfaces: bridge.Interfaces,
+			IP:         l3.ip,
+			DNS:        l3.dns,
+		})
+
+		// A bond that is a member of a bridge is not assigned any IP
+		// configuration.
+		for _, name := range bridge.Interfaces {
+			if i, ok := bondIdx[name]; ok {
+				nc.Bonds[i].IP = nil
+				nc.Bonds[i].DNS = nil
+			}
+		}
+	}
+
+	// Remove the member interfaces since their IP and DNS information is now
+	// reported by the bonds and bridges.
+	var interfaces []vmopv1.VirtualMachineNetworkConfigInterfaceStatus
+	for _, ifc := range nc.Interfaces {
+		if members.Has(ifc.Name) {
+			continue
+		}
+		interfaces = append(interfaces, ifc)
+	}
+	nc.Interfaces = interfaces
+}
+
 // updateGuestNetworkStatus updates the provided VM's status.network
 // field with information from the guestInfo.
 //
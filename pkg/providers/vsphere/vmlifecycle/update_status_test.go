@@ -4033,6 +4033,21 @@ var _ = Describe("VSphere Customization Status to VM Status Condition", func() {
 				Expect(vm.Status.Conditions).To(conditions.MatchConditions(expectedConditions))
 			})
 		})
+		Context("bootstrap rerun pending", func() {
+			BeforeEach(func() {
+				guestInfo.CustomizationInfo.CustomizationStatus = string(vimtypes.GuestInfoCustomizationStatusTOOLSDEPLOYPKG_SUCCEEDED)
+				vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					Generation: 2,
+				}
+				vm.Status.BootstrapGeneration = ptr.To[int64](1)
+			})
+			It("sets condition false", func() {
+				expectedConditions := []metav1.Condition{
+					*conditions.FalseCondition(vmopv1.GuestCustomizationCondition, vmopv1.GuestCustomizationRerunPendingReason, "Bootstrap will be re-run once the VM is powered off"),
+				}
+				Expect(vm.Status.Conditions).To(conditions.MatchConditions(expectedConditions))
+			})
+		})
 	})
 })
 
@@ -4483,6 +4498,53 @@ var _ = Describe("UpdateNetworkStatusConfig", func() {
 					ExpectWithOffset(1, ic.IP.Gateway4).To(Equal("192.168.0.1"))
 					ExpectWithOffset(1, ic.IP.Gateway6).To(Equal("FD00:F500::::"))
 				})
+
+				When("the interfaces are members of a bond", func() {
+					BeforeEach(func() {
+						args.Bonds = []vmopv1.VirtualMachineNetworkBondSpec{
+							{
+								Name:       "bond0",
+								Interfaces: []string{"eth0", "eth1"},
+								Parameters: &vmopv1.VirtualMachineNetworkBondParameters{
+									Primary: "eth1",
+								},
+							},
+						}
+					})
+					Specify("status.network.config.bonds should have the primary's IP config", func() {
+						Expect(config.Interfaces).To(BeNil())
+						Expect(config.Bridges).To(BeNil())
+						Expect(config.Bonds).To(HaveLen(1))
+						bc := config.Bonds[0]
+						Expect(bc.Name).To(Equal("bond0"))
+						Expect(bc.Interfaces).To(Equal([]string{"eth0", "eth1"}))
+						Expect(bc.IP).ToNot(BeNil())
+						Expect(bc.IP.Addresses).To(Equal([]string{"192.168.0.3/24", "FD00:F53B:82E4::54/24"}))
+						Expect(bc.IP.Gateway4).To(Equal("192.168.0.1"))
+					})
+
+					When("the bond is a member of a bridge", func() {
+						BeforeEach(func() {
+							args.Bridges = []vmopv1.VirtualMachineNetworkBridgeSpec{
+								{
+									Name:       "br0",
+									Interfaces: []string{"bond0"},
+								},
+							}
+						})
+						Specify("status.network.config.bridges should have the bond's IP config", func() {
+							Expect(config.Interfaces).To(BeNil())
+							Expect(config.Bonds).To(HaveLen(1))
+							Expect(config.Bonds[0].IP).To(BeNil())
+							Expect(config.Bridges).To(HaveLen(1))
+							bc := config.Bridges[0]
+							Expect(bc.Name).To(Equal("br0"))
+							Expect(bc.Interfaces).To(Equal([]string{"bond0"}))
+							Expect(bc.IP).ToNot(BeNil())
+							Expect(bc.IP.Addresses).To(Equal([]string{"192.168.0.3/24", "FD00:F53B:82E4::54/24"}))
+						})
+					})
+				})
 			})
 		})
 	})
@@ -309,6 +309,84 @@ func GetVirtualMachineImageSpecAndStatus(
 	return obj, spec, status, nil
 }
 
+// GetVirtualMachineCloneSource returns the VirtualMachine resource cloned to
+// create the VM when spec.image refers to a VirtualMachine or
+// VirtualMachineSnapshot resource, as well as the VirtualMachineSnapshot
+// resource, if any.
+//
+// Like GetVirtualMachineImageSpecAndStatus, this function is only designed to
+// be invoked as part of the "create" workflow.
+func GetVirtualMachineCloneSource(
+	vmCtx pkgctx.VirtualMachineContext,
+	k8sClient ctrlclient.Client) (*vmopv1.VirtualMachine, *vmopv1.VirtualMachineSnapshot, error) {
+
+	var (
+		srcVM       vmopv1.VirtualMachine
+		srcSnapshot *vmopv1.VirtualMachineSnapshot
+		key         = ctrlclient.ObjectKey{
+			Name:      vmCtx.VM.Spec.Image.Name,
+			Namespace: vmCtx.VM.Namespace,
+		}
+	)
+
+	markNotReady := func(reason string, err error) error {
+		conditions.MarkFalse(
+			vmCtx.VM,
+			vmopv1.VirtualMachineConditionImageReady,
+			reason,
+			"%s", err.Error())
+		return err
+	}
+
+	getErrReason := func(err error) string {
+		if apierrors.IsNotFound(err) {
+			return "NotFound"
+		}
+		return "Unknown"
+	}
+
+	if vmCtx.VM.Spec.Image.Kind == "VirtualMachineSnapshot" {
+		var snapshot vmopv1.VirtualMachineSnapshot
+		if err := k8sClient.Get(vmCtx, key, &snapshot); err != nil {
+			return nil, nil, markNotReady(getErrReason(err), err)
+		}
+		if !conditions.IsTrue(&snapshot, vmopv1.VirtualMachineSnapshotReadyCondition) {
+			return nil, nil, markNotReady(
+				"NotReady",
+				fmt.Errorf("VirtualMachineSnapshot %s is not ready", snapshot.Name))
+		}
+
+		vmName := snapshot.Spec.VMName
+		if vmName == "" {
+			vmName = snapshot.Labels[vmopv1.VMNameForSnapshotLabel]
+		}
+		if vmName == "" {
+			return nil, nil, markNotReady(
+				"NotReady",
+				fmt.Errorf("VirtualMachineSnapshot %s does not refer to a VM", snapshot.Name))
+		}
+
+		srcSnapshot = &snapshot
+		key.Name = vmName
+	}
+
+	if err := k8sClient.Get(vmCtx, key, &srcVM); err != nil {
+		return nil, nil, markNotReady(getErrReason(err), err)
+	}
+
+	if srcVM.Status.UniqueID == "" {
+		return nil, nil, markNotReady(
+			"NotReady",
+			fmt.Errorf("VirtualMachine %s has not been created", srcVM.Name))
+	}
+
+	c := conditions.TrueCondition(vmopv1.VirtualMachineConditionImageReady)
+	c.Message = fmt.Sprintf("VirtualMachine %s is ready to be cloned", srcVM.Name)
+	conditions.Set(vmCtx.VM, c)
+
+	return &srcVM, srcSnapshot, nil
+}
+
 func getSecretData(
 	vmCtx pkgctx.VirtualMachineContext,
 	k8sClient ctrlclient.Client,
@@ -458,6 +536,16 @@ func GetVirtualMachineBootstrap(
 			return vmlifecycle.BootstrapData{}, err
 		}
 		linuxPrepSecretData = &out
+	} else if v := bootstrapSpec.Ignition; v != nil {
+		if from := v.Config.From; from != nil {
+			var err error
+			data, err = getSecretData(vmCtx, k8sClient, from.Name, from.Key, false)
+			if err != nil {
+				reason, msg := errToConditionReasonAndMessage(err)
+				conditions.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady, reason, "%s", msg)
+				return vmlifecycle.BootstrapData{}, err
+			}
+		}
 	}
 
 	// vApp bootstrap can be used alongside LinuxPrep/Sysprep.
@@ -665,6 +665,55 @@ var _ = Describe("Utils", func() {
 			})
 		})
 
+		When("Bootstrap via Ignition", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+						Config: common.ValueOrSecretKeySelector{
+							From: &common.SecretKeySelector{
+								Name: dataName,
+								Key:  "foo1",
+							},
+						},
+					},
+				}
+			})
+
+			It("return an error when resource does not exist", func() {
+				_, err := vsphere.GetVirtualMachineBootstrap(vmCtx, k8sClient)
+				Expect(err).To(HaveOccurred())
+				Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady)).To(BeFalse())
+			})
+
+			When("Secret exists", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, bootstrapSecret)
+				})
+
+				It("returns success", func() {
+					bsData, err := vsphere.GetVirtualMachineBootstrap(vmCtx, k8sClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bsData.Data).To(HaveKeyWithValue("foo1", "bar1"))
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady)).To(BeTrue())
+				})
+			})
+
+			When("config is inline", func() {
+				BeforeEach(func() {
+					vmCtx.VM.Spec.Bootstrap.Ignition.Config = common.ValueOrSecretKeySelector{
+						Value: ptr.To(`{"ignition":{"version":"3.4.0"}}`),
+					}
+				})
+
+				It("returns success", func() {
+					bsData, err := vsphere.GetVirtualMachineBootstrap(vmCtx, k8sClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bsData.Data).To(BeEmpty())
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady)).To(BeTrue())
+				})
+			})
+		})
+
 		Context("Bootstrap via inline Sysprep", func() {
 			anotherKey := "some_other_key"
 
@@ -8,9 +8,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
 	"math/rand"
 	"path"
+	"slices"
 	"strings"
 	"sync"
 	"text/template"
@@ -63,6 +65,7 @@ import (
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/paused"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
@@ -72,6 +75,7 @@ import (
 	vmconfcrypto "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/crypto"
 	vmconfdiskpromo "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/diskpromo"
 	vmconfpolicy "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/policy"
+	vmconfserialport "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
 	vmconfunmanagedvolsreg "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/volumes/unmanaged/register"
 )
 
@@ -84,6 +88,7 @@ var (
 	ErrBootstrapCustomize       = vmlifecycle.ErrBootstrapCustomize
 	ErrReconfigure              = session.ErrReconfigure
 	ErrRestart                  = pkgerr.NoRequeueNoErr("restarted vm")
+	ErrBootstrapRerun           = pkgerr.NoRequeueNoErr("powered off vm to re-run bootstrap")
 	ErrUpgradeHardwareVersion   = session.ErrUpgradeHardwareVersion
 	ErrIsPaused                 = pkgerr.NoRequeueNoErr("is paused")
 	ErrHasTask                  = pkgerr.NoRequeueNoErr("has outstanding task")
@@ -94,6 +99,7 @@ var (
 	ErrPolicyNotReady           = vmconfpolicy.ErrPolicyNotReady
 	ErrRegisterVolumes          = vmconfunmanagedvolsreg.ErrPendingRegister
 	ErrAddedInstanceStorageVols = pkgerr.NoRequeueNoErr("added instance storage volumes")
+	ErrAddedCloneVolumes        = pkgerr.NoRequeueNoErr("added clone source volumes")
 )
 
 // VMCreateArgs contains the arguments needed to create a VM on VC.
@@ -528,6 +534,62 @@ func (vs *vSphereVMProvider) PublishVirtualMachine(
 	return virtualmachine.CreateOVF(vmCtx, client.RestClient(), vmPub, cl, actID)
 }
 
+func (vs *vSphereVMProvider) PublishVirtualMachineToOCI(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	vmPub *vmopv1.VirtualMachinePublishRequest,
+	actID string) (string, error) {
+
+	target := vmPub.Spec.Target.OCI
+	if target == nil {
+		return "", fmt.Errorf("publish request does not specify an OCI target")
+	}
+
+	ref, err := oci.ParseRepository(target.Repository, target.Tag)
+	if err != nil {
+		return "", err
+	}
+
+	logger := pkglog.FromContextOrDefault(ctx).WithValues(
+		"reference", ref.String())
+	ctx = logr.NewContext(ctx, logger)
+
+	vmCtx := pkgctx.NewVirtualMachineContext(
+		pkgctx.WithVCOpID(ctx, vm, "publishVMToOCI"),
+		vm,
+	)
+	ctx = vmCtx.Context
+
+	creds, err := oci.GetCredentials(
+		ctx, vs.k8sClient, vmPub.Namespace, target.SecretName, ref.Registry)
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get vCenter client: %w", err)
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return "", err
+	}
+
+	logger.V(4).Info("Publishing VM to OCI repository", "activationID", actID)
+
+	return virtualmachine.PushOCIArtifact(
+		vmCtx,
+		client.VimClient(),
+		vcVM,
+		vmPub,
+		oci.NewClient(oci.Options{
+			Credentials:        creds,
+			InsecureSkipVerify: target.InsecureSkipTLSVerify,
+		}),
+		ref)
+}
+
 func (vs *vSphereVMProvider) GetVirtualMachineGuestHeartbeat(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine) (vmopv1.GuestHeartbeatStatus, error) {
@@ -556,6 +618,93 @@ func (vs *vSphereVMProvider) GetVirtualMachineGuestHeartbeat(
 	return status, nil
 }
 
+// GetVirtualMachinesResourceUsage returns the CPU and memory usage of the
+// powered on VMs from their quick stats. The quick stats of all VMs are
+// fetched with a single call to the property collector.
+func (vs *vSphereVMProvider) GetVirtualMachinesResourceUsage(
+	ctx context.Context) ([]providers.VirtualMachineResourceUsage, error) {
+
+	var vmList vmopv1.VirtualMachineList
+	if err := vs.k8sClient.List(ctx, &vmList); err != nil {
+		return nil, fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	vms := map[string]*vmopv1.VirtualMachine{}
+	objectSet := make([]vimtypes.ObjectSpec, 0, len(vmList.Items))
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Status.UniqueID == "" {
+			continue
+		}
+		vms[vm.Status.UniqueID] = vm
+		objectSet = append(objectSet, vimtypes.ObjectSpec{
+			Obj: vimtypes.ManagedObjectReference{
+				Type:  string(vimtypes.ManagedObjectTypeVirtualMachine),
+				Value: vm.Status.UniqueID,
+			},
+		})
+	}
+
+	if len(objectSet) == 0 {
+		return nil, nil
+	}
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// A VM may be deleted from vSphere before its status is updated, so
+	// missing VMs are reported in the results instead of failing the request.
+	res, err := property.DefaultCollector(client.VimClient()).RetrieveProperties(
+		ctx,
+		vimtypes.RetrieveProperties{
+			SpecSet: []vimtypes.PropertyFilterSpec{
+				{
+					ObjectSet: objectSet,
+					PropSet: []vimtypes.PropertySpec{
+						{
+							Type: string(vimtypes.ManagedObjectTypeVirtualMachine),
+							PathSet: []string{
+								"summary.quickStats",
+								"summary.runtime.powerState",
+							},
+						},
+					},
+					ReportMissingObjectsInResults: ptr.To(true),
+				},
+			},
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve VM quick stats: %w", err)
+	}
+
+	var moVMs []mo.VirtualMachine
+	if err := mo.LoadObjectContent(res.Returnval, &moVMs); err != nil {
+		return nil, fmt.Errorf("failed to load VM quick stats: %w", err)
+	}
+
+	usage := make([]providers.VirtualMachineResourceUsage, 0, len(moVMs))
+	for i := range moVMs {
+		moVM := &moVMs[i]
+		if moVM.Summary.Runtime.PowerState != vimtypes.VirtualMachinePowerStatePoweredOn {
+			continue
+		}
+		vm, ok := vms[moVM.Self.Value]
+		if !ok {
+			continue
+		}
+		quickStats := moVM.Summary.QuickStats
+		usage = append(usage, providers.VirtualMachineResourceUsage{
+			VM:               vm,
+			CPUUsageMHz:      int64(quickStats.OverallCpuUsage),
+			MemoryUsageBytes: int64(quickStats.GuestMemoryUsage) * 1024 * 1024,
+		})
+	}
+
+	return usage, nil
+}
+
 func (vs *vSphereVMProvider) GetVirtualMachineProperties(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine,
@@ -616,6 +765,85 @@ func (vs *vSphereVMProvider) GetVirtualMachineProperties(
 	return result, nil
 }
 
+func (vs *vSphereVMProvider) RunVirtualMachineGuestProgram(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	spec vimtypes.GuestProgramSpec) (int32, error) {
+
+	vmCtx := pkgctx.NewVirtualMachineContext(
+		pkgctx.WithVCOpID(ctx, vm, "guestProgram"),
+		vm,
+	)
+	ctx = vmCtx.Context
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return virtualmachine.RunGuestProgram(ctx, vcVM, &auth, spec)
+}
+
+func (vs *vSphereVMProvider) PutVirtualMachineGuestFile(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	guestPath string,
+	r io.Reader,
+	size int64,
+	overwrite bool) error {
+
+	vmCtx := pkgctx.NewVirtualMachineContext(
+		pkgctx.WithVCOpID(ctx, vm, "putGuestFile"),
+		vm,
+	)
+	ctx = vmCtx.Context
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return err
+	}
+
+	return virtualmachine.PutGuestFile(ctx, vcVM, &auth, guestPath, r, size, overwrite)
+}
+
+func (vs *vSphereVMProvider) GetVirtualMachineGuestFile(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine,
+	auth vimtypes.NamePasswordAuthentication,
+	guestPath string,
+	maxSize int64) (io.ReadCloser, int64, error) {
+
+	vmCtx := pkgctx.NewVirtualMachineContext(
+		pkgctx.WithVCOpID(ctx, vm, "getGuestFile"),
+		vm,
+	)
+	ctx = vmCtx.Context
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return virtualmachine.GetGuestFile(ctx, vcVM, &auth, guestPath, maxSize)
+}
+
 func (vs *vSphereVMProvider) GetVirtualMachineFiles(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine) ([]vimtypes.VirtualMachineFileLayoutExFileInfo, error) {
@@ -678,6 +906,29 @@ func (vs *vSphereVMProvider) GetVirtualMachineWebMKSTicket(
 	return ticket, nil
 }
 
+func (vs *vSphereVMProvider) GetVirtualMachineSerialConsoleEndpoint(
+	ctx context.Context,
+	vm *vmopv1.VirtualMachine) (string, error) {
+
+	vmCtx := pkgctx.NewVirtualMachineContext(
+		pkgctx.WithVCOpID(ctx, vm, "serialconsole"),
+		vm,
+	)
+	ctx = vmCtx.Context
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return "", err
+	}
+
+	return virtualmachine.GetSerialConsoleEndpoint(vmCtx, vcVM)
+}
+
 func (vs *vSphereVMProvider) GetVirtualMachineHardwareVersion(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine) (vimtypes.HardwareVersion, error) {
@@ -765,6 +1016,62 @@ func (vs *vSphereVMProvider) vmCreatePathName(
 	return nil
 }
 
+// vmCreateDatastoreForOCIImage selects the datastore to which the OVF
+// descriptor and disks of an OCI image are imported.
+func (vs *vSphereVMProvider) vmCreateDatastoreForOCIImage(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
+	createArgs *VMCreateArgs) error {
+
+	if createArgs.DatastoreMoID != "" {
+		return nil
+	}
+
+	if len(createArgs.Datastores) > 0 {
+		createArgs.DatastoreMoID = createArgs.Datastores[0].MoRef.Value
+		return nil
+	}
+
+	if createArgs.StorageProfileID == "" {
+		return errors.New("no storage profile for OCI image import")
+	}
+
+	vc := vcClient.VimClient()
+
+	pc, err := pbm.NewClient(vmCtx, vc)
+	if err != nil {
+		return err
+	}
+
+	ds, err := pc.DatastoreMap(vmCtx, vc, createArgs.ClusterMoRef)
+	if err != nil {
+		return err
+	}
+
+	req := []pbmtypes.BasePbmPlacementRequirement{
+		&pbmtypes.PbmPlacementCapabilityProfileRequirement{
+			ProfileId: pbmtypes.PbmProfileId{UniqueId: createArgs.StorageProfileID},
+		},
+	}
+
+	res, err := pc.CheckRequirements(vmCtx, ds.PlacementHub, nil, req)
+	if err != nil {
+		return err
+	}
+
+	hubs := res.CompatibleDatastores()
+	if len(hubs) == 0 {
+		return errors.New("no compatible datastores")
+	}
+
+	hub := hubs[rand.Intn(len(hubs))] //nolint:gosec
+	createArgs.DatastoreMoID = hub.HubId
+
+	vmCtx.Logger.Info("vmCreateDatastoreForOCIImage", "DatastoreMoID", createArgs.DatastoreMoID)
+
+	return nil
+}
+
 func (vs *vSphereVMProvider) vmCreatePathNameFromDatastoreRecommendation(
 	vmCtx pkgctx.VirtualMachineContext,
 	createArgs *VMCreateArgs) error {
@@ -809,14 +1116,22 @@ func (vs *vSphereVMProvider) getCreateArgs(
 		return nil, err
 	}
 
-	if pkgcfg.FromContext(vmCtx).Features.FastDeploy {
+	switch {
+	case vmopv1util.IsClonedVM(*vmCtx.VM):
+		// A cloned VM's files are copied from the source VM to the datastore
+		// chosen by the clone's relocate spec.
+	case createArgs.OCIImage != nil:
+		if err := vs.vmCreateDatastoreForOCIImage(vmCtx, vcClient, createArgs); err != nil {
+			return nil, err
+		}
+	case pkgcfg.FromContext(vmCtx).Features.FastDeploy:
 		if err := vs.vmCreateGetSourceFilePaths(vmCtx, vcClient, createArgs); err != nil {
 			return nil, err
 		}
 		if err := vs.vmCreatePathNameFromDatastoreRecommendation(vmCtx, createArgs); err != nil {
 			return nil, err
 		}
-	} else {
+	default:
 		if err := vs.vmCreatePathName(vmCtx, vcClient, createArgs); err != nil {
 			return nil, err
 		}
@@ -1407,6 +1722,41 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 	case vmopv1.VirtualMachinePowerStateOn:
 
 		if currentPowerState == vmopv1.VirtualMachinePowerStateOn {
+			// Check to see if bootstrap should be re-run. Bootstrap may only
+			// be applied to a powered off VM, so power off the VM using its
+			// power off mode. Once bootstrap has been re-applied, the VM is
+			// powered on again.
+			if vmlifecycle.IsBootstrapRerunPending(vmCtx.VM) {
+				// Powering off the VM disrupts it, so wait until the power
+				// off is allowed by the VirtualMachineDisruptionBudgets that
+				// select the VM.
+				disruptionChecker, err := vmopv1util.NewDisruptionChecker(
+					vmCtx, vs.k8sClient, vmCtx.VM.Namespace)
+				if err != nil {
+					return err
+				}
+				if err := disruptionChecker.Disrupt(vmCtx.VM); err != nil {
+					pkgcnd.MarkError(vmCtx.VM,
+						vmopv1.GuestCustomizationCondition,
+						vmopv1.DisruptionBudgetExceededReason,
+						err,
+					)
+
+					return err
+				}
+
+				vmCtx.Logger.Info("Powering off VM to re-run bootstrap")
+				if err := res.NewVMFromObject(vcVM).SetPowerState(
+					vmCtx,
+					currentPowerState,
+					vmopv1.VirtualMachinePowerStateOff,
+					vmCtx.VM.Spec.PowerOffMode); err != nil {
+
+					return err
+				}
+				return ErrBootstrapRerun
+			}
+
 			// Check to see if a possible restart is required.
 			// Please note a VM may only be restarted if it is powered on.
 			if vmCtx.VM.Spec.NextRestartTime == "" {
@@ -2167,6 +2517,34 @@ func (vs *vSphereVMProvider) vmCreateGetVirtualMachineImage(
 	vmCtx pkgctx.VirtualMachineContext,
 	createArgs *VMCreateArgs) error {
 
+	if vmopv1util.IsClonedVM(*vmCtx.VM) {
+		srcVM, srcSnapshot, err := GetVirtualMachineCloneSource(vmCtx, vs.k8sClient)
+		if err != nil {
+			return err
+		}
+
+		createArgs.CloneSourceVMMoID = srcVM.Status.UniqueID
+		if srcSnapshot != nil {
+			createArgs.CloneSnapshotName = srcSnapshot.Name
+		}
+		createArgs.LinkedClone = vmCtx.VM.Spec.CloneMode == vmopv1.VirtualMachineCloneModeLinked
+
+		if srcSnapshot != nil && vmopv1util.HasPVCVolumes(*srcVM) {
+			// The source VM's PVCs do not have the data of the snapshot, so
+			// they cannot be copied for the clone.
+			err := fmt.Errorf("cannot clone VirtualMachineSnapshot %s of a VM with PVC volumes",
+				srcSnapshot.Name)
+			pkgcnd.MarkFalse(
+				vmCtx.VM,
+				vmopv1.VirtualMachineConditionImageReady,
+				"SnapshotHasPVCs",
+				"%s", err.Error())
+			return err
+		}
+
+		return vs.vmCreateCopyCloneSourceVolumes(vmCtx, srcVM)
+	}
+
 	imageObj, imageSpec, imageStatus, err := GetVirtualMachineImageSpecAndStatus(vmCtx, vs.k8sClient)
 	if err != nil {
 		return err
@@ -2176,6 +2554,22 @@ func (vs *vSphereVMProvider) vmCreateGetVirtualMachineImage(
 	createArgs.ImageSpec = imageSpec
 	createArgs.ImageStatus = imageStatus
 
+	if imageSpec.OCI != nil {
+		// The image's content is an OCI artifact that is imported when the
+		// VM is created. The Secret for a cluster-scoped image is in the pod's
+		// namespace.
+		secretNamespace := imageObj.GetNamespace()
+		if secretNamespace == "" {
+			secretNamespace = pkgcfg.FromContext(vmCtx).PodNamespace
+		}
+		createArgs.OCIImage = &vmlifecycle.OCIImage{
+			Source:          *imageSpec.OCI,
+			SecretNamespace: secretNamespace,
+		}
+		createArgs.ProviderItemID = imageStatus.ProviderItemID
+		return nil
+	}
+
 	var providerRef common.LocalObjectRef
 	if imageSpec.ProviderRef != nil {
 		providerRef = *imageSpec.ProviderRef
@@ -2201,6 +2595,83 @@ func (vs *vSphereVMProvider) vmCreateGetVirtualMachineImage(
 	return nil
 }
 
+// vmCreateCopyCloneSourceVolumes copies the PVC volumes of the VM being cloned
+// to new PVCs that are owned by the VM, and adds them to the VM's spec. Volumes
+// the VM already specifies by name are not copied.
+func (vs *vSphereVMProvider) vmCreateCopyCloneSourceVolumes(
+	vmCtx pkgctx.VirtualMachineContext,
+	srcVM *vmopv1.VirtualMachine) error {
+
+	var addedVolumes bool
+
+	for i := range srcVM.Spec.Volumes {
+		srcVol := srcVM.Spec.Volumes[i]
+
+		srcClaim := srcVol.PersistentVolumeClaim
+		if srcClaim == nil || srcClaim.InstanceVolumeClaim != nil {
+			// Instance storage volumes are specific to the VM's class and
+			// host, so they are not copied.
+			continue
+		}
+
+		if slices.ContainsFunc(vmCtx.VM.Spec.Volumes, func(v vmopv1.VirtualMachineVolume) bool {
+			return v.Name == srcVol.Name
+		}) {
+			continue
+		}
+
+		var srcPVC corev1.PersistentVolumeClaim
+		if err := vs.k8sClient.Get(
+			vmCtx,
+			ctrlclient.ObjectKey{Namespace: srcVM.Namespace, Name: srcClaim.ClaimName},
+			&srcPVC); err != nil {
+
+			return fmt.Errorf("failed to get clone source PVC %s: %w", srcClaim.ClaimName, err)
+		}
+
+		dstPVC := corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: vmCtx.VM.Namespace,
+				Name:      vmCtx.VM.Name + "-" + srcVol.Name,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:               srcPVC.Spec.AccessModes,
+				Resources:                 srcPVC.Spec.Resources,
+				StorageClassName:          srcPVC.Spec.StorageClassName,
+				VolumeMode:                srcPVC.Spec.VolumeMode,
+				VolumeAttributesClassName: srcPVC.Spec.VolumeAttributesClassName,
+				DataSource: &corev1.TypedLocalObjectReference{
+					Kind: "PersistentVolumeClaim",
+					Name: srcPVC.Name,
+				},
+			},
+		}
+		if err := controllerutil.SetOwnerReference(
+			vmCtx.VM, &dstPVC, vs.k8sClient.Scheme()); err != nil {
+
+			return err
+		}
+		if err := vs.k8sClient.Create(vmCtx, &dstPVC); err != nil &&
+			!apierrors.IsAlreadyExists(err) {
+
+			return fmt.Errorf("failed to create copy of clone source PVC %s: %w", srcPVC.Name, err)
+		}
+
+		dstVol := *srcVol.DeepCopy()
+		dstVol.PersistentVolumeClaim.ClaimName = dstPVC.Name
+		vmCtx.VM.Spec.Volumes = append(vmCtx.VM.Spec.Volumes, dstVol)
+
+		addedVolumes = true
+	}
+
+	if addedVolumes {
+		// Return to update the VM Spec with the just added volumes.
+		return ErrAddedCloneVolumes
+	}
+
+	return nil
+}
+
 func (vs *vSphereVMProvider) vmCreateGetSetResourcePolicy(
 	vmCtx pkgctx.VirtualMachineContext,
 	createArgs *VMCreateArgs) error {
@@ -2388,8 +2859,10 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpec(
 		minCPUFreq)
 
 	if pkgcfg.FromContext(vmCtx).Features.FastDeploy {
-		if err := vs.vmCreateGenConfigSpecImage(vmCtx, createArgs); err != nil {
-			return err
+		if !vmopv1util.IsClonedVM(*vmCtx.VM) {
+			if err := vs.vmCreateGenConfigSpecImage(vmCtx, createArgs); err != nil {
+				return err
+			}
 		}
 		createArgs.ConfigSpec.VmProfile = []vimtypes.BaseVirtualMachineProfileSpec{
 			&vimtypes.VirtualMachineDefinedProfileSpec{
@@ -2431,6 +2904,17 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpec(
 		return err
 	}
 
+	if err := vmconfserialport.Reconcile(
+		vmCtx,
+		vs.k8sClient,
+		vcClient.VimClient(),
+		vmCtx.VM,
+		vmCtx.MoVM,
+		&createArgs.ConfigSpec); err != nil {
+
+		return err
+	}
+
 	if err := vs.vmCreateGenConfigSpecExtraConfig(vmCtx, createArgs); err != nil {
 		return err
 	}
@@ -2481,6 +2965,12 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpecImage(
 		imageTypeVM  = "vm"
 	)
 
+	if createArgs.OCIImage != nil {
+		// The image's disks and vAppConfig are imported from the OCI
+		// artifact's OVF descriptor, so there is no image cache.
+		return nil
+	}
+
 	var (
 		logger    = vmCtx.Logger
 		imageType = strings.ToLower(createArgs.ImageStatus.Type)
@@ -0,0 +1,125 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func vmGuestProgramTests() {
+	var (
+		parentCtx   context.Context
+		initObjects []client.Object
+		testConfig  builder.VCSimTestConfig
+		ctx         *builder.TestContextForVCSim
+		vmProvider  providers.VirtualMachineProviderInterface
+		nsInfo      builder.WorkloadNamespaceInfo
+
+		vm      *vmopv1.VirtualMachine
+		vmClass *vmopv1.VirtualMachineClass
+	)
+
+	BeforeEach(func() {
+		parentCtx = pkgcfg.NewContextWithDefaultConfig()
+		parentCtx = ctxop.WithContext(parentCtx)
+		parentCtx = ovfcache.WithContext(parentCtx)
+		parentCtx = cource.WithContext(parentCtx)
+		pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
+			config.AsyncCreateEnabled = false
+			config.AsyncSignalEnabled = false
+		})
+		testConfig = builder.VCSimTestConfig{}
+
+		vmClass = builder.DummyVirtualMachineClassGenName()
+		vm = builder.DummyBasicVirtualMachine("test-vm", "")
+
+		if vm.Spec.Network == nil {
+			vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+		}
+		vm.Spec.Network.Disabled = true
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewTestContextForVCSimWithParentContext(
+			parentCtx, testConfig, initObjects...)
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.MaxDeployThreadsOnProvider = 1
+		})
+		ctx.UseHostGuestProcessManager()
+		vmProvider = vsphere.NewVSphereVMProviderFromClient(
+			ctx, ctx.Client, ctx.Recorder)
+		nsInfo = ctx.CreateWorkloadNamespace()
+
+		vmClass.Namespace = nsInfo.Namespace
+		Expect(ctx.Client.Create(ctx, vmClass)).To(Succeed())
+
+		vsphere.SkipVMImageCLProviderCheck = true
+		clusterVMI1 := builder.DummyClusterVirtualMachineImage("DC0_C0_RP0_VM0")
+		Expect(ctx.Client.Create(ctx, clusterVMI1)).To(Succeed())
+		conditions.MarkTrue(clusterVMI1, vmopv1.ReadyConditionType)
+		Expect(ctx.Client.Status().Update(ctx, clusterVMI1)).To(Succeed())
+
+		vm.Namespace = nsInfo.Namespace
+		vm.Spec.ClassName = vmClass.Name
+		vm.Spec.ImageName = clusterVMI1.Name
+		vm.Spec.Image.Kind = cvmiKind
+		vm.Spec.Image.Name = clusterVMI1.Name
+		vm.Spec.StorageClass = ctx.StorageClassName
+
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		vsphere.SkipVMImageCLProviderCheck = false
+
+		if vm != nil &&
+			!pkgcfg.FromContext(ctx).Features.BringYourOwnEncryptionKey {
+			By("Assert vm.Status.Crypto is nil when BYOK is disabled", func() {
+				Expect(vm.Status.Crypto).To(BeNil())
+			})
+		}
+
+		vmClass = nil
+		vm = nil
+
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		vmProvider = nil
+		nsInfo = builder.WorkloadNamespaceInfo{}
+	})
+
+	JustBeforeEach(func() {
+		Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+	})
+
+	It("returns the exit code of the guest program", func() {
+		auth := vimtypes.NamePasswordAuthentication{
+			Username: "root",
+			Password: "secret",
+		}
+
+		exitCode, err := vmProvider.RunVirtualMachineGuestProgram(ctx, vm, auth, vimtypes.GuestProgramSpec{
+			ProgramPath: "/bin/sh",
+			Arguments:   `-c 'exit 7'`,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exitCode).To(BeEquivalentTo(7))
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
@@ -289,6 +290,55 @@ func vmPowerStateTests() {
 			})
 		})
 
+		When("re-running bootstrap would violate a disruption budget", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
+					config.Features.K8sWorkloadMgmtAPI = true
+				})
+				if vm.Labels == nil {
+					vm.Labels = map[string]string{}
+				}
+				vm.Labels["app"] = "db"
+			})
+
+			JustBeforeEach(func() {
+				Expect(vm.Status.BootstrapGeneration).ToNot(BeNil())
+
+				budget := &vmopv1.VirtualMachineDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "db-budget",
+						Namespace: nsInfo.Namespace,
+					},
+					Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "db"},
+						},
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				}
+				Expect(ctx.Client.Create(ctx, budget)).To(Succeed())
+
+				conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+				Expect(ctx.Client.Status().Update(ctx, vm)).To(Succeed())
+
+				vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					Generation: *vm.Status.BootstrapGeneration + 1,
+				}
+			})
+
+			It("should not power off the VM", func() {
+				err := createOrUpdateVM(ctx, vmProvider, vm)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("would violate VirtualMachineDisruptionBudget"))
+
+				Expect(conditions.IsFalse(vm, vmopv1.GuestCustomizationCondition)).To(BeTrue())
+				Expect(conditions.GetReason(vm, vmopv1.GuestCustomizationCondition)).To(Equal(vmopv1.DisruptionBudgetExceededReason))
+
+				Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"runtime.powerState"}, &moVM)).To(Succeed())
+				Expect(moVM.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+			})
+		})
+
 		When("restarting the VM", func() {
 			var (
 				oldLastRestartTime string
@@ -15,6 +15,7 @@ import (
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
@@ -22,6 +23,7 @@ import (
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -534,6 +536,53 @@ func vmResizeTests() {
 					Expect(c.Reason).To(Equal("ClassNameChanged"))
 				})
 
+				It("Does not power cycle the VM selected by a disruption budget", func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.Features.K8sWorkloadMgmtAPI = true
+					})
+
+					vm.Labels = map[string]string{"app": "db"}
+					vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+
+					budget := &vmopv1.VirtualMachineDisruptionBudget{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "db-budget",
+							Namespace: nsInfo.Namespace,
+						},
+						Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "db"},
+							},
+							MinAvailable: ptr.To(intstr.FromInt32(1)),
+						},
+					}
+					Expect(ctx.Client.Create(ctx, budget)).To(Succeed())
+
+					conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+					Expect(ctx.Client.Status().Update(ctx, vm)).To(Succeed())
+
+					newCS := configSpec
+					newCS.NumCPUs = 42
+					newCS.MemoryMB = 8192
+					newVMClass := createVMClass(newCS)
+					vm.Spec.ClassName = newVMClass.Name
+
+					vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+
+					var o mo.VirtualMachine
+					Expect(vcVM.Properties(ctx, vcVM.Reference(), nil, &o)).To(Succeed())
+					Expect(o.Summary.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+					Expect(o.Config.Hardware.NumCPU).To(BeEquivalentTo(configSpec.NumCPUs))
+					Expect(o.Config.Hardware.MemoryMB).To(BeEquivalentTo(configSpec.MemoryMB))
+
+					for _, c := range vm.Status.Conditions {
+						Expect(c.Reason).ToNot(Equal(vmopv1.DisruptionBudgetExceededReason))
+					}
+				})
+
 				It("Has Same Class Resize Annotation", func() {
 					vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
 					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
@@ -0,0 +1,184 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func vmResourceUsageTests() {
+	var (
+		parentCtx   context.Context
+		initObjects []client.Object
+		testConfig  builder.VCSimTestConfig
+		ctx         *builder.TestContextForVCSim
+		vmProvider  providers.VirtualMachineProviderInterface
+		nsInfo      builder.WorkloadNamespaceInfo
+
+		vm      *vmopv1.VirtualMachine
+		vmClass *vmopv1.VirtualMachineClass
+		vcVM    *object.VirtualMachine
+	)
+
+	setSummary := func(fn func(summary *vimtypes.VirtualMachineSummary)) {
+		sctx := ctx.SimulatorContext()
+		sctx.WithLock(
+			vcVM.Reference(),
+			func() {
+				fn(&sctx.Map.Get(vcVM.Reference()).(*simulator.VirtualMachine).Summary)
+			})
+	}
+
+	BeforeEach(func() {
+		parentCtx = pkgcfg.NewContextWithDefaultConfig()
+		parentCtx = ctxop.WithContext(parentCtx)
+		parentCtx = ovfcache.WithContext(parentCtx)
+		parentCtx = cource.WithContext(parentCtx)
+		pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
+			config.AsyncCreateEnabled = false
+			config.AsyncSignalEnabled = false
+		})
+		testConfig = builder.VCSimTestConfig{
+			WithContentLibrary: false,
+		}
+
+		vmClass = builder.DummyVirtualMachineClassGenName()
+		vm = builder.DummyBasicVirtualMachine("test-vm", "")
+
+		if vm.Spec.Network == nil {
+			vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+		}
+		vm.Spec.Network.Disabled = true
+	})
+
+	JustBeforeEach(func() {
+		ctx = suite.NewTestContextForVCSimWithParentContext(
+			parentCtx, testConfig, initObjects...)
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.MaxDeployThreadsOnProvider = 1
+		})
+		vmProvider = vsphere.NewVSphereVMProviderFromClient(
+			ctx, ctx.Client, ctx.Recorder)
+		nsInfo = ctx.CreateWorkloadNamespace()
+
+		vmClass.Namespace = nsInfo.Namespace
+		Expect(ctx.Client.Create(ctx, vmClass)).To(Succeed())
+
+		clusterVMI1 := &vmopv1.ClusterVirtualMachineImage{}
+
+		if testConfig.WithContentLibrary {
+			Expect(ctx.Client.Get(
+				ctx, client.ObjectKey{Name: ctx.ContentLibraryItem1Name},
+				clusterVMI1)).To(Succeed())
+		} else {
+			vsphere.SkipVMImageCLProviderCheck = true
+			clusterVMI1 = builder.DummyClusterVirtualMachineImage("DC0_C0_RP0_VM0")
+			Expect(ctx.Client.Create(ctx, clusterVMI1)).To(Succeed())
+			conditions.MarkTrue(clusterVMI1, vmopv1.ReadyConditionType)
+			Expect(ctx.Client.Status().Update(ctx, clusterVMI1)).To(Succeed())
+		}
+
+		vm.Namespace = nsInfo.Namespace
+		vm.Spec.ClassName = vmClass.Name
+		vm.Spec.ImageName = clusterVMI1.Name
+		vm.Spec.Image.Kind = cvmiKind
+		vm.Spec.Image.Name = clusterVMI1.Name
+		vm.Spec.StorageClass = ctx.StorageClassName
+
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		vsphere.SkipVMImageCLProviderCheck = false
+
+		if vm != nil &&
+			!pkgcfg.FromContext(ctx).Features.BringYourOwnEncryptionKey {
+			By("Assert vm.Status.Crypto is nil when BYOK is disabled", func() {
+				Expect(vm.Status.Crypto).To(BeNil())
+			})
+		}
+
+		vmClass = nil
+		vm = nil
+		vcVM = nil
+
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		vmProvider = nil
+		nsInfo = builder.WorkloadNamespaceInfo{}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		vcVM, err = createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctx.Client.Status().Update(ctx, vm)).To(Succeed())
+
+		setSummary(func(summary *vimtypes.VirtualMachineSummary) {
+			summary.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOn
+			summary.QuickStats.OverallCpuUsage = 1500
+			summary.QuickStats.GuestMemoryUsage = 512
+		})
+	})
+
+	It("returns the resource usage of the VM", func() {
+		usage, err := vmProvider.GetVirtualMachinesResourceUsage(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(usage).To(HaveLen(1))
+		Expect(usage[0].VM.Name).To(Equal(vm.Name))
+		Expect(usage[0].VM.Namespace).To(Equal(vm.Namespace))
+		Expect(usage[0].CPUUsageMHz).To(BeEquivalentTo(1500))
+		Expect(usage[0].MemoryUsageBytes).To(BeEquivalentTo(512 * 1024 * 1024))
+	})
+
+	When("the VM is powered off", func() {
+		JustBeforeEach(func() {
+			setSummary(func(summary *vimtypes.VirtualMachineSummary) {
+				summary.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOff
+			})
+		})
+
+		It("does not return the resource usage of the VM", func() {
+			usage, err := vmProvider.GetVirtualMachinesResourceUsage(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(BeEmpty())
+		})
+	})
+
+	When("a VM does not exist in vSphere", func() {
+		JustBeforeEach(func() {
+			missingVM := builder.DummyBasicVirtualMachine("missing-vm", nsInfo.Namespace)
+			Expect(ctx.Client.Create(ctx, missingVM)).To(Succeed())
+			missingVM.Status.UniqueID = "vm-does-not-exist"
+			Expect(ctx.Client.Status().Update(ctx, missingVM)).To(Succeed())
+		})
+
+		It("returns the resource usage of the other VMs", func() {
+			usage, err := vmProvider.GetVirtualMachinesResourceUsage(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(HaveLen(1))
+			Expect(usage[0].VM.Name).To(Equal(vm.Name))
+		})
+	})
+}
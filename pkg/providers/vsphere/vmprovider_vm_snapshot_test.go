@@ -12,6 +12,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -33,6 +35,7 @@ import (
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -771,6 +774,63 @@ func vmSnapshotTests() {
 		})
 	})
 
+	Context("when reverting would violate a disruption budget", func() {
+		BeforeEach(func() {
+			pkgcfg.SetContext(parentCtx, func(config *pkgcfg.Config) {
+				config.Features.K8sWorkloadMgmtAPI = true
+			})
+		})
+
+		It("should not revert the VM", func() {
+			if vm.Labels == nil {
+				vm.Labels = make(map[string]string)
+			}
+			vm.Labels["app"] = "db"
+
+			vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+			Expect(err).ToNot(HaveOccurred())
+
+			task, err := vcVM.CreateSnapshot(ctx, vmSnapshot.Name, "test snapshot", false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(task.Wait(ctx)).To(Succeed())
+
+			conditions.MarkTrue(vmSnapshot, vmopv1.VirtualMachineSnapshotReadyCondition)
+			Expect(ctx.Client.Create(ctx, vmSnapshot)).To(Succeed())
+
+			o := vmopv1.VirtualMachine{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(vm), &o)).To(Succeed())
+			Expect(controllerutil.SetOwnerReference(&o, vmSnapshot, ctx.Scheme)).To(Succeed())
+			Expect(ctx.Client.Update(ctx, vmSnapshot)).To(Succeed())
+
+			budget := &vmopv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db-budget",
+					Namespace: nsInfo.Namespace,
+				},
+				Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "db"},
+					},
+					MinAvailable: ptr.To(intstr.FromInt32(1)),
+				},
+			}
+			Expect(ctx.Client.Create(ctx, budget)).To(Succeed())
+
+			conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+			Expect(ctx.Client.Status().Update(ctx, vm)).To(Succeed())
+
+			vm.Spec.CurrentSnapshotName = vmSnapshot.Name
+
+			err = createOrUpdateVM(ctx, vmProvider, vm)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("would violate VirtualMachineDisruptionBudget"))
+
+			Expect(conditions.IsFalse(vm, vmopv1.VirtualMachineSnapshotRevertSucceeded)).To(BeTrue())
+			Expect(conditions.GetReason(vm, vmopv1.VirtualMachineSnapshotRevertSucceeded)).To(Equal(vmopv1.DisruptionBudgetExceededReason))
+			Expect(vm.Annotations).ToNot(HaveKey(pkgconst.VirtualMachineSnapshotRevertInProgressAnnotationKey))
+		})
+	})
+
 	Context("when snapshot revert annotation is present", func() {
 		It("should skip VM reconciliation when revert annotation exists", func() {
 			// Create VM first
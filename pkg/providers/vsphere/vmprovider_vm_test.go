@@ -30,6 +30,7 @@ var _ = Describe("VirtualMachine", Label(testlabels.VCSim), func() {
 	Describe("Group", Label(testlabels.Group), vmGroupTests)
 	Describe("GuestHeartbeat", vmGuestHeartbeatTests)
 	Describe("GuestID", vmGuestIDTests)
+	Describe("GuestProgram", vmGuestProgramTests)
 	Describe("HardwareVersion", vmHardwareVersionTests)
 	Describe("ISO", vmISOTests)
 	Describe("InstanceStorage", vmInstanceStorageTests)
@@ -41,6 +42,7 @@ var _ = Describe("VirtualMachine", Label(testlabels.VCSim), func() {
 	Describe("Policy", vmPolicyTests)
 	Describe("Power", vmPowerStateTests)
 	Describe("Resize", vmResizeTests)
+	Describe("ResourceUsage", vmResourceUsageTests)
 	Describe("SetResourcePolicy", vmSetResourcePolicyTests)
 	Describe("Snapshot", Label(testlabels.Snapshot), vmSnapshotTests)
 	Describe("Storage", vmStorageTests)
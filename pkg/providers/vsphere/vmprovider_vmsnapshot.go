@@ -378,6 +378,23 @@ func (vs *vSphereVMProvider) reconcileSnapshotRevertDoTask(
 	logger = logger.WithValues("isCurrent", currentRef == *ref)
 	vmCtx.Context = logr.NewContext(vmCtx.Context, logger)
 
+	// Reverting to a snapshot disrupts the VM, so wait until the revert is
+	// allowed by the VirtualMachineDisruptionBudgets that select the VM.
+	disruptionChecker, err := vmopv1util.NewDisruptionChecker(
+		vmCtx, vs.k8sClient, vmCtx.VM.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := disruptionChecker.Disrupt(vmCtx.VM); err != nil {
+		pkgcnd.MarkError(vmCtx.VM,
+			vmopv1.VirtualMachineSnapshotRevertSucceeded,
+			vmopv1.DisruptionBudgetExceededReason,
+			err,
+		)
+
+		return err
+	}
+
 	logger.Info("Starting snapshot revert operation")
 
 	// Set the revert in progress annotation.
@@ -572,8 +589,21 @@ func (vs *vSphereVMProvider) restoreVMSpecFromSnapshot(
 	// TODO: AKP: We need to merge (and skip) some labels so that we
 	// are not simply reverting to the previous labels. Otherwise, we
 	// risk the VM being impacted after a snapshot revert.
+	applyPowerStateTime := vmCtx.VM.Annotations[pkgconst.ApplyPowerStateTimeAnnotation]
 	vmCtx.VM.Labels = maps.Clone(vm.Labels)
 	vmCtx.VM.Annotations = maps.Clone(vm.Annotations)
+
+	// Keep the apply-power-state time from before the revert since it is set
+	// by the VM's group when the group is reverted to a group snapshot, so
+	// the group's VMs are powered on in the group's boot order.
+	if applyPowerStateTime != "" {
+		if vmCtx.VM.Annotations == nil {
+			vmCtx.VM.Annotations = map[string]string{}
+		}
+		vmCtx.VM.Annotations[pkgconst.ApplyPowerStateTimeAnnotation] = applyPowerStateTime
+	} else {
+		delete(vmCtx.VM.Annotations, pkgconst.ApplyPowerStateTimeAnnotation)
+	}
 	// Empty out the status.
 	vmCtx.VM.Status = vmopv1.VirtualMachineStatus{}
 
@@ -0,0 +1,616 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/vcenter"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/api/v1alpha1"
+	backupapi "github.com/vmware-tanzu/vm-operator/pkg/backup/api"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+	vcclient "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/client"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	pkgdatastore "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/datastore"
+)
+
+const (
+	// snapshotExportsDirName is the name of the directory, at the root of a
+	// datastore, to which the disks of exported snapshots are copied.
+	snapshotExportsDirName = "vmoperator-snapshot-exports"
+
+	// snapshotImportsDirName is the name of the directory, at the root of a
+	// datastore, to which the disks of imported snapshots are copied.
+	snapshotImportsDirName = "vmoperator-snapshot-imports"
+
+	// diskCopyRequeueAfter is how long to wait before checking the progress
+	// of a disk that is being copied.
+	diskCopyRequeueAfter = 30 * time.Second
+)
+
+// ExportVirtualMachineSnapshot writes the disks and VM YAML of a snapshot to
+// the target of a VirtualMachineSnapshotExport, and records the result in the
+// export's status.
+func (vs *vSphereVMProvider) ExportVirtualMachineSnapshot(
+	ctx context.Context,
+	vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	vmSnapshot *vmopv1.VirtualMachineSnapshot,
+	vm *vmopv1.VirtualMachine) error {
+
+	vmCtx := pkgctx.NewVirtualMachineContext(
+		pkgctx.WithVCOpID(ctx, vm, "exportSnapshot"),
+		vm,
+	)
+	ctx = vmCtx.Context
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	vcVM, err := vs.getVM(vmCtx, client, true)
+	if err != nil {
+		return fmt.Errorf("failed to get VirtualMachine %q: %w", vm.Name, err)
+	}
+
+	var moVM mo.VirtualMachine
+	if err := vcVM.Properties(
+		ctx,
+		vcVM.Reference(),
+		[]string{"snapshot", "parent", "resourcePool"},
+		&moVM); err != nil {
+
+		return err
+	}
+
+	snapTree, err := virtualmachine.FindSnapshot(moVM, vmSnapshot.Name)
+	if err != nil {
+		return fmt.Errorf("failed to find snapshot %q: %w", vmSnapshot.Name, err)
+	}
+
+	// Fetch the config stored with the snapshot.
+	var moSnap mo.VirtualMachineSnapshot
+	if err := vcVM.Properties(
+		ctx, snapTree.Snapshot, []string{"config"}, &moSnap); err != nil {
+
+		return fmt.Errorf("failed to fetch snapshot config: %w", err)
+	}
+
+	ecList := object.OptionValueList(moSnap.Config.ExtraConfig)
+	vmYAML, _ := ecList.GetString(backupapi.VMResourceYAMLExtraConfigKey)
+	if vmYAML == "" {
+		return fmt.Errorf("no VM YAML in snapshot config")
+	}
+	vmSnapshotExport.Status.VMResourceYAML = vmYAML
+
+	switch target := vmSnapshotExport.Spec.Target; {
+	case target.PersistentVolumeClaim != nil:
+		return vs.exportVirtualMachineSnapshotToPVCs(
+			vmCtx,
+			client,
+			vmSnapshotExport,
+			moSnap)
+	case target.OVA != nil:
+		return vs.exportVirtualMachineSnapshotToOVA(
+			vmCtx,
+			client,
+			vcVM,
+			moVM,
+			vmSnapshotExport,
+			snapTree.Snapshot)
+	default:
+		return errors.New("export does not specify a target")
+	}
+}
+
+// exportVirtualMachineSnapshotToPVCs stores the snapshot's VM YAML and PVC
+// disk data in a ConfigMap, consolidates each of the snapshot's disks into a
+// new disk, and registers the new disk as a PVC in the export's namespace.
+func (vs *vSphereVMProvider) exportVirtualMachineSnapshotToPVCs(
+	vmCtx pkgctx.VirtualMachineContext,
+	client *vcclient.Client,
+	vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	moSnap mo.VirtualMachineSnapshot) error {
+
+	ecList := object.OptionValueList(moSnap.Config.ExtraConfig)
+	pvcDiskDataRaw, _ := ecList.GetString(backupapi.PVCDiskDataExtraConfigKey)
+
+	var pvcDiskData []backupapi.PVCDiskData
+	if pvcDiskDataRaw != "" {
+		data, err := pkgutil.TryToDecodeBase64Gzip([]byte(pvcDiskDataRaw))
+		if err != nil {
+			return fmt.Errorf("failed to decode PVC disk data from snapshot: %w", err)
+		}
+		if err := json.Unmarshal([]byte(data), &pvcDiskData); err != nil {
+			return fmt.Errorf("failed to unmarshal PVC disk data from snapshot: %w", err)
+		}
+	}
+
+	// The VM YAML and PVC disk data may exceed the size limit of a resource's
+	// annotations, so they are stored in a ConfigMap in the archive, and each
+	// PVC is annotated with the name of the ConfigMap.
+	configMap := &corev1.ConfigMap{}
+	configMap.Namespace = vmSnapshotExport.Namespace
+	configMap.Name = vmSnapshotExport.Name
+	configMap.Labels = map[string]string{
+		vmopv1.SnapshotExportNameLabel: vmSnapshotExport.Name,
+	}
+	configMap.Data = map[string]string{
+		backupapi.VMResourceYAMLExtraConfigKey: vmSnapshotExport.Status.VMResourceYAML,
+	}
+	if pvcDiskDataRaw != "" {
+		configMap.Data[backupapi.PVCDiskDataExtraConfigKey] = pvcDiskDataRaw
+	}
+
+	if err := vs.k8sClient.Create(vmCtx, configMap); err != nil &&
+		!apierrors.IsAlreadyExists(err) {
+
+		return fmt.Errorf("failed to create configmap %s: %w", configMap.Name, err)
+	}
+
+	storageClass := vmSnapshotExport.Spec.Target.PersistentVolumeClaim.StorageClass
+	if storageClass == "" {
+		storageClass = vmCtx.VM.Spec.StorageClass
+	}
+
+	var (
+		disks = object.VirtualDeviceList(moSnap.Config.Hardware.Device).
+			SelectByType((*vimtypes.VirtualDisk)(nil))
+		copying []string
+	)
+
+	for i := range disks {
+		disk := disks[i].(*vimtypes.VirtualDisk)
+
+		backing, ok := disk.Backing.(vimtypes.BaseVirtualDeviceFileBackingInfo)
+		if !ok {
+			continue
+		}
+		srcFileName := backing.GetVirtualDeviceFileBackingInfo().FileName
+
+		var srcPath object.DatastorePath
+		if !srcPath.FromString(srcFileName) {
+			return fmt.Errorf("failed to parse datastore path %q", srcFileName)
+		}
+
+		dstPath := object.DatastorePath{
+			Datastore: srcPath.Datastore,
+			Path: path.Join(
+				snapshotExportsDirName,
+				string(vmSnapshotExport.UID),
+				fmt.Sprintf("disk-%d.vmdk", i)),
+		}
+
+		diskStatus := getExportDiskStatus(
+			vmSnapshotExport,
+			fmt.Sprintf("%s-%d", vmSnapshotExport.Name, i))
+		diskStatus.FileName = dstPath.String()
+		diskStatus.Capacity = kubeutil.BytesToResource(disk.CapacityInBytes)
+		for _, d := range pvcDiskData {
+			if d.FileName == srcFileName {
+				diskStatus.SourceClaimName = d.PVCName
+				break
+			}
+		}
+
+		if !diskStatus.Copied {
+			vmCtx.Logger.Info("Exporting snapshot disk",
+				"srcFileName", srcFileName,
+				"dstFileName", diskStatus.FileName,
+				"copyTaskID", diskStatus.CopyTaskID)
+
+			taskID, copied, err := reconcileVirtualDiskCopy(
+				vmCtx, client, srcFileName, diskStatus.FileName, diskStatus.CopyTaskID)
+			diskStatus.CopyTaskID = taskID
+			diskStatus.Copied = copied
+			if err != nil {
+				return err
+			}
+			if !copied {
+				copying = append(copying, diskStatus.FileName)
+				continue
+			}
+		}
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		pvc.Namespace = vmSnapshotExport.Namespace
+		pvc.Name = diskStatus.ClaimName
+		pvc.Labels = map[string]string{
+			vmopv1.SnapshotExportNameLabel: vmSnapshotExport.Name,
+		}
+		pvc.Annotations = map[string]string{
+			vmopv1.SnapshotExportConfigMapAnnotation: configMap.Name,
+		}
+		pvc.Spec.StorageClassName = &storageClass
+		pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{
+			corev1.ReadWriteOnce,
+		}
+		pvc.Spec.VolumeMode = ptr.To(corev1.PersistentVolumeFilesystem)
+		pvc.Spec.Resources.Requests = corev1.ResourceList{
+			corev1.ResourceStorage: ptr.Deref(diskStatus.Capacity),
+		}
+
+		if err := vs.registerVirtualDisk(
+			vmCtx, client, pvc, diskStatus.FileName); err != nil {
+
+			return err
+		}
+	}
+
+	if len(copying) > 0 {
+		return pkgerr.RequeueError{
+			After:   diskCopyRequeueAfter,
+			Message: fmt.Sprintf("copying disks %v", copying),
+		}
+	}
+
+	return nil
+}
+
+// getExportDiskStatus returns the status of the export's disk with the
+// provided claim name, adding the status if it does not exist.
+func getExportDiskStatus(
+	vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	claimName string) *vmopv1.VirtualMachineSnapshotExportDiskStatus {
+
+	disks := vmSnapshotExport.Status.Disks
+	for i := range disks {
+		if disks[i].ClaimName == claimName {
+			return &disks[i]
+		}
+	}
+	vmSnapshotExport.Status.Disks = append(
+		disks,
+		vmopv1.VirtualMachineSnapshotExportDiskStatus{ClaimName: claimName})
+	return &vmSnapshotExport.Status.Disks[len(vmSnapshotExport.Status.Disks)-1]
+}
+
+// exportVirtualMachineSnapshotToOVA creates an OVF library item from the
+// snapshot. Since content library cannot create an OVF from a snapshot, the
+// OVF is created from a temporary linked clone of the VM at the snapshot.
+func (vs *vSphereVMProvider) exportVirtualMachineSnapshotToOVA(
+	vmCtx pkgctx.VirtualMachineContext,
+	client *vcclient.Client,
+	vcVM *object.VirtualMachine,
+	moVM mo.VirtualMachine,
+	vmSnapshotExport *vmopv1.VirtualMachineSnapshotExport,
+	snapRef vimtypes.ManagedObjectReference) error {
+
+	target := vmSnapshotExport.Spec.Target.OVA
+
+	itemName := target.ItemName
+	if itemName == "" {
+		itemName = vmSnapshotExport.Name
+	}
+
+	var cl imgregv1a1.ContentLibrary
+	if err := vs.k8sClient.Get(
+		vmCtx,
+		ctrlclient.ObjectKey{
+			Namespace: vmSnapshotExport.Namespace,
+			Name:      target.ContentLibraryName,
+		},
+		&cl); err != nil {
+
+		return fmt.Errorf("failed to get content library %s: %w",
+			target.ContentLibraryName, err)
+	}
+
+	libMgr := library.NewManager(client.RestClient())
+
+	// The item may have been created by a previous attempt whose status
+	// update failed.
+	itemIDs, err := libMgr.FindLibraryItems(vmCtx, library.FindItem{
+		LibraryID: string(cl.Spec.UUID),
+		Name:      itemName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find library item %q: %w", itemName, err)
+	}
+	if len(itemIDs) > 0 {
+		vmSnapshotExport.Status.ItemID = itemIDs[0]
+		return nil
+	}
+
+	if moVM.Parent == nil {
+		return fmt.Errorf("VirtualMachine %q does not have a folder", vcVM.Name())
+	}
+	folder := object.NewFolder(client.VimClient(), *moVM.Parent)
+
+	cloneName := fmt.Sprintf("%s-export-%s", vmCtx.VM.Name, vmSnapshotExport.UID)
+
+	cloneRef, err := object.NewSearchIndex(client.VimClient()).FindChild(
+		vmCtx, folder, cloneName)
+	if err != nil {
+		return fmt.Errorf("failed to find clone %q: %w", cloneName, err)
+	}
+
+	if cloneRef == nil {
+		vmCtx.Logger.Info("Creating linked clone of snapshot for export",
+			"cloneName", cloneName)
+
+		cloneSpec := vimtypes.VirtualMachineCloneSpec{
+			Location: vimtypes.VirtualMachineRelocateSpec{
+				Pool: moVM.ResourcePool,
+				DiskMoveType: string(
+					vimtypes.VirtualMachineRelocateDiskMoveOptionsCreateNewChildDiskBacking),
+			},
+			Snapshot: &snapRef,
+		}
+
+		task, err := vcVM.Clone(vmCtx, folder, cloneName, cloneSpec)
+		if err != nil {
+			return fmt.Errorf("failed to clone snapshot: %w", err)
+		}
+		info, err := task.WaitForResult(vmCtx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for clone of snapshot: %w", err)
+		}
+		cloneRef = info.Result.(vimtypes.ManagedObjectReference)
+	}
+
+	clone := object.NewVirtualMachine(client.VimClient(), cloneRef.Reference())
+	defer func() {
+		task, err := clone.Destroy(vmCtx)
+		if err == nil {
+			err = task.Wait(vmCtx)
+		}
+		if err != nil {
+			vmCtx.Logger.Error(err, "Failed to destroy clone of snapshot",
+				"cloneName", cloneName)
+		}
+	}()
+
+	ovf := vcenter.OVF{
+		Spec: vcenter.CreateSpec{
+			Name: itemName,
+			Description: fmt.Sprintf("Export of VirtualMachineSnapshot %s/%s",
+				vmSnapshotExport.Namespace, vmSnapshotExport.Spec.SnapshotName),
+			Flags: []string{"EXTRA_CONFIG"}, // Preserve ExtraConfig
+		},
+		Source: vcenter.ResourceID{
+			Type:  "VirtualMachine",
+			Value: clone.Reference().Value,
+		},
+		Target: vcenter.LibraryTarget{
+			LibraryID: string(cl.Spec.UUID),
+		},
+	}
+
+	vmCtx.Logger.Info("Creating OVF from snapshot", "spec", ovf)
+
+	itemID, err := vcenter.NewManager(client.RestClient()).CreateOVF(vmCtx, ovf)
+	if err != nil {
+		return fmt.Errorf("failed to create OVF from snapshot: %w", err)
+	}
+	vmSnapshotExport.Status.ItemID = itemID
+
+	return nil
+}
+
+// ImportVirtualMachineSnapshotVolume copies a disk exported by a
+// VirtualMachineSnapshotExport for a VirtualMachineSnapshotImport, and
+// registers the copy as the provided PVC. The volume's status records the
+// task that copies the disk, and the datastore path of the copy once the copy
+// is complete. A RequeueError is returned while the disk is being copied.
+func (vs *vSphereVMProvider) ImportVirtualMachineSnapshotVolume(
+	ctx context.Context,
+	vmSnapshotImport *vmopv1.VirtualMachineSnapshotImport,
+	volStatus *vmopv1.VirtualMachineSnapshotImportVolumeStatus,
+	fileName string,
+	pvc *corev1.PersistentVolumeClaim) error {
+
+	client, err := vs.getVcClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	var srcPath object.DatastorePath
+	if !srcPath.FromString(fileName) {
+		return fmt.Errorf("failed to parse datastore path %q", fileName)
+	}
+
+	dstPath := object.DatastorePath{
+		Datastore: srcPath.Datastore,
+		Path: path.Join(
+			snapshotImportsDirName,
+			string(vmSnapshotImport.UID),
+			pvc.Name+".vmdk"),
+	}
+
+	taskID, copied, err := reconcileVirtualDiskCopy(
+		ctx, client, fileName, dstPath.String(), volStatus.CopyTaskID)
+	volStatus.CopyTaskID = taskID
+	if err != nil {
+		return err
+	}
+	if !copied {
+		return pkgerr.RequeueError{
+			After:   diskCopyRequeueAfter,
+			Message: fmt.Sprintf("copying disk %s", dstPath.String()),
+		}
+	}
+
+	if err := vs.registerVirtualDisk(
+		ctx, client, pvc, dstPath.String()); err != nil {
+
+		return err
+	}
+
+	volStatus.FileName = dstPath.String()
+
+	return nil
+}
+
+// reconcileVirtualDiskCopy copies a virtual disk, consolidating any of its
+// delta disks, without waiting for the copy to complete. It returns the ID of
+// the task that copies the disk, which is passed back in to check whether the
+// copy is complete, and true once the copy is complete.
+//
+// A destination disk that exists when no copy is in progress may be the
+// result of a copy that was interrupted, so it is deleted and copied again.
+// The same happens if the task can no longer be found, since whether the copy
+// completed is unknown.
+func reconcileVirtualDiskCopy(
+	ctx context.Context,
+	client *vcclient.Client,
+	srcFileName, dstFileName, taskID string) (string, bool, error) {
+
+	var (
+		vimClient  = client.VimClient()
+		datacenter = client.Datacenter()
+		diskMgr    = object.NewVirtualDiskManager(vimClient)
+	)
+
+	if taskID != "" {
+		var moTask mo.Task
+		err := property.DefaultCollector(vimClient).RetrieveOne(
+			ctx,
+			vimtypes.ManagedObjectReference{Type: "Task", Value: taskID},
+			[]string{"info"},
+			&moTask)
+		switch {
+		case fault.Is(err, &vimtypes.ManagedObjectNotFound{}):
+			taskID = ""
+		case err != nil:
+			return taskID, false, fmt.Errorf("failed to get task %q copying disk %q: %w",
+				taskID, dstFileName, err)
+		default:
+			switch moTask.Info.State {
+			case vimtypes.TaskInfoStateSuccess:
+				return "", true, nil
+			case vimtypes.TaskInfoStateError:
+				msg := "unknown error"
+				if moTask.Info.Error != nil {
+					msg = moTask.Info.Error.LocalizedMessage
+				}
+				return "", false, fmt.Errorf("failed to copy disk %q to %q: %s",
+					srcFileName, dstFileName, msg)
+			default:
+				return taskID, false, nil
+			}
+		}
+	}
+
+	if err := deleteVirtualDisk(ctx, diskMgr, datacenter, dstFileName); err != nil {
+		return "", false, err
+	}
+
+	if err := object.NewFileManager(vimClient).MakeDirectory(
+		ctx,
+		path.Dir(dstFileName),
+		datacenter,
+		true); err != nil {
+
+		return "", false, fmt.Errorf("failed to create directory for %q: %w",
+			dstFileName, err)
+	}
+
+	task, err := diskMgr.CopyVirtualDisk(
+		ctx,
+		srcFileName,
+		datacenter,
+		dstFileName,
+		datacenter,
+		nil,
+		false)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to copy disk %q to %q: %w",
+			srcFileName, dstFileName, err)
+	}
+
+	return task.Reference().Value, false, nil
+}
+
+// deleteVirtualDisk deletes a virtual disk if it exists.
+func deleteVirtualDisk(
+	ctx context.Context,
+	diskMgr *object.VirtualDiskManager,
+	datacenter *object.Datacenter,
+	fileName string) error {
+
+	task, err := diskMgr.DeleteVirtualDisk(ctx, fileName, datacenter)
+	if err == nil {
+		err = task.Wait(ctx)
+	}
+	if err != nil && !fault.Is(err, &vimtypes.FileNotFound{}) {
+		return fmt.Errorf("failed to delete disk %q: %w", fileName, err)
+	}
+	return nil
+}
+
+// registerVirtualDisk ensures the provided PVC and a CnsRegisterVolume that
+// registers the disk as the PVC exist. The CnsRegisterVolume has the same name
+// and labels as the PVC. The PVC's DataSourceRef refers to the
+// CnsRegisterVolume so the PVC is not dynamically provisioned with a new,
+// empty volume before the disk is registered.
+func (vs *vSphereVMProvider) registerVirtualDisk(
+	ctx context.Context,
+	client *vcclient.Client,
+	pvc *corev1.PersistentVolumeClaim,
+	fileName string) error {
+
+	pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
+		APIGroup: ptr.To(cnsv1alpha1.GroupVersion.Group),
+		Kind:     "CnsRegisterVolume",
+		Name:     pvc.Name,
+	}
+
+	if err := vs.k8sClient.Create(ctx, pvc); err != nil &&
+		!apierrors.IsAlreadyExists(err) {
+
+		return fmt.Errorf("failed to create pvc %s: %w", pvc.Name, err)
+	}
+
+	diskURLPath, err := pkgdatastore.GetDatastoreURLFromDatastorePath(
+		ctx,
+		client.Finder(),
+		fileName)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get datastore url for %q: %w", fileName, err)
+	}
+
+	crv := &cnsv1alpha1.CnsRegisterVolume{}
+	crv.Namespace = pvc.Namespace
+	crv.Name = pvc.Name
+	crv.Labels = pvc.Labels
+	crv.Spec = cnsv1alpha1.CnsRegisterVolumeSpec{
+		PvcName:     pvc.Name,
+		DiskURLPath: diskURLPath,
+		AccessMode:  corev1.ReadWriteOnce,
+		VolumeMode:  corev1.PersistentVolumeFilesystem,
+	}
+
+	if err := vs.k8sClient.Create(ctx, crv); err != nil &&
+		!apierrors.IsAlreadyExists(err) {
+
+		return fmt.Errorf("failed to create CnsRegisterVolume %s: %w", crv.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package serialconsole
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/websocket"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
+)
+
+const (
+	// DefaultCheckInterval is how often an open connection verifies the
+	// serial console request used to open it still exists.
+	DefaultCheckInterval = 10 * time.Second
+
+	// ConnectionAllowedReason is the reason of the event recorded on a serial
+	// console request when a connection using it is allowed.
+	ConnectionAllowedReason = "SerialConsoleConnectionAllowed"
+
+	// ConnectionDeniedReason is the reason of the event recorded on a serial
+	// console request when a connection using it is denied.
+	ConnectionDeniedReason = "SerialConsoleConnectionDenied"
+
+	dialTimeout = 10 * time.Second
+)
+
+// Server represents a serial console proxy server.
+type Server struct {
+	Addr, Path string
+	KubeClient ctrlclient.Client
+	Recorder   record.Recorder
+
+	// CertFile and KeyFile are the paths to the certificate and private key
+	// used to serve TLS.
+	CertFile, KeyFile string
+
+	// CheckInterval is how often an open connection verifies the serial
+	// console request used to open it still exists.
+	CheckInterval time.Duration
+
+	// Dial connects to the serial console endpoint of a VM.
+	Dial func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// NewServer creates a new serial console proxy server that serves TLS with the
+// provided certificate and private key. The client should be backed by an
+// informer cache so requests are not authenticated by hitting the API server.
+// The recorder is used to audit each connection on the serial console
+// request.
+func NewServer(
+	addr, path, certFile, keyFile string,
+	client ctrlclient.Client,
+	recorder record.Recorder) (*Server, error) {
+
+	if addr == "" || path == "" {
+		return nil, errors.New("server addr and path cannot be empty")
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("server cert and key files cannot be empty")
+	}
+
+	return &Server{
+		Addr:          addr,
+		Path:          path,
+		KubeClient:    client,
+		Recorder:      recorder,
+		CertFile:      certFile,
+		KeyFile:       keyFile,
+		CheckInterval: DefaultCheckInterval,
+		Dial:          (&net.Dialer{Timeout: dialTimeout}).DialContext,
+	}, nil
+}
+
+// Run starts the serial console proxy server.
+func (s *Server) Run() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(s.Path, s.HandleSerialConsole)
+
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+
+	return server.ListenAndServeTLS(s.CertFile, s.KeyFile)
+}
+
+// HandleSerialConsole authenticates a connection by checking if a
+// VirtualMachineSerialConsoleRequest resource exists with the given UUID in
+// query, that the request is not expired, and that the request's endpoint is
+// the serial console of the requested VM. The connection is then upgraded
+// to a websocket and the bytes of the VM's serial console are streamed over
+// it until the request expires or is deleted.
+func (s *Server) HandleSerialConsole(w http.ResponseWriter, r *http.Request) {
+	uuid := r.URL.Query().Get("uuid")
+	if uuid == "" {
+		http.Error(w, "'uuid' param is empty", http.StatusBadRequest)
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		http.Error(w, "'namespace' param is empty", http.StatusBadRequest)
+		return
+	}
+
+	logger := ctrllog.Log.WithName(r.URL.Path).WithValues("uuid", uuid).WithValues("namespace", namespace)
+
+	scr, err := findResource(r.Context(), uuid, namespace, s.KubeClient)
+	if err != nil {
+		logger.Error(err, "Error occurred in finding a serialconsolerequest resource with the given params.")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if scr == nil {
+		logger.Info("Didn't find a serialconsolerequest resource with the given params. Returning 403.")
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	logger = logger.WithValues("name", scr.Name)
+
+	if reason := denyReason(scr); reason != "" {
+		logger.Info("Found a serialconsolerequest resource with the given params that cannot be used. Returning 403.",
+			"reason", reason)
+		s.Recorder.Warnf(scr, ConnectionDeniedReason,
+			"Denied serial console connection to VirtualMachine %s: request is %s",
+			scr.Spec.Name, reason)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	reason, err := s.endpointDenyReason(r.Context(), scr)
+	if err != nil {
+		logger.Error(err, "Error occurred in verifying the endpoint of the serialconsolerequest resource.")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if reason != "" {
+		logger.Info("Found a serialconsolerequest resource whose endpoint cannot be used. Returning 403.",
+			"reason", reason, "endpoint", scr.Status.Endpoint)
+		s.Recorder.Warnf(scr, ConnectionDeniedReason,
+			"Denied serial console connection to VirtualMachine %s: %s",
+			scr.Spec.Name, reason)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	conn, err := s.Dial(r.Context(), "tcp", scr.Status.Endpoint)
+	if err != nil {
+		logger.Error(err, "Failed to connect to the serial console.", "endpoint", scr.Status.Endpoint)
+		http.Error(w, "failed to connect to the serial console", http.StatusBadGateway)
+		return
+	}
+
+	logger.Info("Found a serialconsolerequest resource with the given params. Streaming the serial console.")
+	s.Recorder.Eventf(scr, ConnectionAllowedReason,
+		"Allowed serial console connection to VirtualMachine %s", scr.Spec.Name)
+
+	websocket.Server{
+		// The connection is authenticated by the UUID of the request instead
+		// of the origin of the client, which is typically not a browser.
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			s.stream(ws, conn, scr)
+			logger.Info("Closed the serial console connection.")
+		},
+	}.ServeHTTP(w, r)
+}
+
+// stream copies bytes between the websocket and the serial console until
+// either side is closed, the request expires, or the request is deleted.
+func (s *Server) stream(
+	ws *websocket.Conn,
+	conn net.Conn,
+	scr *vmopv1.VirtualMachineSerialConsoleRequest) {
+
+	defer func() {
+		_ = conn.Close()
+		_ = ws.Close()
+	}()
+
+	ws.PayloadType = websocket.BinaryFrame
+
+	ctx := ws.Request().Context()
+
+	errCh := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(ws, conn)
+		errCh <- err
+	}()
+	go func() {
+		_, err := io.Copy(conn, ws)
+		errCh <- err
+	}()
+
+	expiry := time.NewTimer(time.Until(scr.Status.ExpiryTime.Time))
+	defer expiry.Stop()
+
+	ticker := time.NewTicker(s.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-errCh:
+			return
+		case <-ctx.Done():
+			return
+		case <-expiry.C:
+			return
+		case <-ticker.C:
+			obj := &vmopv1.VirtualMachineSerialConsoleRequest{}
+			if err := s.KubeClient.Get(ctx, ctrlclient.ObjectKeyFromObject(scr), obj); err != nil {
+				return
+			}
+			if obj.UID != scr.UID || denyReason(obj) != "" {
+				return
+			}
+		}
+	}
+}
+
+// findResource returns the serial console request with the given UUID in the
+// namespace, or nil if there is none.
+func findResource(
+	ctx context.Context,
+	uuid, namespace string,
+	kubeClient ctrlclient.Client) (*vmopv1.VirtualMachineSerialConsoleRequest, error) {
+
+	list := &vmopv1.VirtualMachineSerialConsoleRequestList{}
+	if err := kubeClient.List(
+		ctx,
+		list,
+		ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabels{
+			vmopv1.SerialConsoleRequestUUIDLabelKey: uuid,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if len(list.Items) == 0 {
+		return nil, nil
+	}
+
+	return &list.Items[0], nil
+}
+
+// endpointDenyReason returns why the endpoint of a serial console request is
+// not the serial console of the VM the request is for, or an empty string if
+// it is. Since the port of each VM's serial console is unique, the endpoint
+// belongs to the VM if its port is the port recorded on the VM.
+func (s *Server) endpointDenyReason(
+	ctx context.Context,
+	scr *vmopv1.VirtualMachineSerialConsoleRequest) (string, error) {
+
+	vm := &vmopv1.VirtualMachine{}
+	if err := s.KubeClient.Get(
+		ctx,
+		ctrlclient.ObjectKey{Namespace: scr.Namespace, Name: scr.Spec.Name},
+		vm); err != nil {
+
+		if apierrors.IsNotFound(err) {
+			return "virtual machine does not exist", nil
+		}
+		return "", err
+	}
+
+	if !metav1.IsControlledBy(scr, vm) {
+		return "request is not owned by the virtual machine", nil
+	}
+	if !serialport.IsEnabled(vm) {
+		return "serial console is not enabled", nil
+	}
+
+	vmPort, ok := serialport.AnnotatedPort(vm)
+	if !ok {
+		return "serial console port is not recorded", nil
+	}
+	_, port, err := net.SplitHostPort(scr.Status.Endpoint)
+	if err != nil || port != strconv.Itoa(int(vmPort)) {
+		return "endpoint is not the serial console of the virtual machine", nil
+	}
+
+	return "", nil
+}
+
+// denyReason returns why a serial console request may not be used to connect
+// to the serial console, or an empty string if it may be used.
+func denyReason(scr *vmopv1.VirtualMachineSerialConsoleRequest) string {
+	switch {
+	case scr.Status.Endpoint == "" || scr.Status.ExpiryTime.IsZero():
+		return "not ready"
+	case !time.Now().Before(scr.Status.ExpiryTime.Time):
+		return "expired"
+	}
+	return ""
+}
@@ -0,0 +1,359 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package serialconsole_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"golang.org/x/net/websocket"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/serialconsole"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func serverUnitTests() {
+
+	const (
+		serverPath = "/serialconsole"
+		serverAddr = "localhost:8080"
+		certFile   = "/etc/tls/tls.crt"
+		keyFile    = "/etc/tls/tls.key"
+	)
+
+	Context("NewServer", func() {
+
+		When("Server addr or path is empty", func() {
+
+			It("should return an error", func() {
+				_, err := serialconsole.NewServer("", serverPath, certFile, keyFile, nil, nil)
+				Expect(err).To(MatchError("server addr and path cannot be empty"))
+
+				_, err = serialconsole.NewServer(serverAddr, "", certFile, keyFile, nil, nil)
+				Expect(err).To(MatchError("server addr and path cannot be empty"))
+			})
+
+		})
+
+		When("Server cert or key file is empty", func() {
+
+			It("should return an error", func() {
+				_, err := serialconsole.NewServer(serverAddr, serverPath, "", keyFile, nil, nil)
+				Expect(err).To(MatchError("server cert and key files cannot be empty"))
+
+				_, err = serialconsole.NewServer(serverAddr, serverPath, certFile, "", nil, nil)
+				Expect(err).To(MatchError("server cert and key files cannot be empty"))
+			})
+
+		})
+
+		When("All Server parameters are provided", func() {
+
+			It("should initialize a new Server successfully", func() {
+				recorder, _ := builder.NewFakeRecorder()
+				server, err := serialconsole.NewServer(serverAddr, serverPath, certFile, keyFile, fake.NewFakeClient(), recorder)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server).NotTo(BeNil())
+				Expect(server.Addr).To(Equal(serverAddr))
+				Expect(server.Path).To(Equal(serverPath))
+				Expect(server.CertFile).To(Equal(certFile))
+				Expect(server.KeyFile).To(Equal(keyFile))
+				Expect(server.KubeClient).NotTo(BeNil())
+				Expect(server.Recorder).NotTo(BeNil())
+				Expect(server.CheckInterval).To(Equal(serialconsole.DefaultCheckInterval))
+				Expect(server.Dial).NotTo(BeNil())
+			})
+
+		})
+	})
+
+	Context("Run", func() {
+
+		It("should fail to serve TLS without a certificate", func() {
+			server := &serialconsole.Server{
+				Addr:     "localhost:0",
+				Path:     serverPath,
+				CertFile: certFile,
+				KeyFile:  keyFile,
+			}
+			Expect(server.Run()).To(MatchError(ContainSubstring(certFile)))
+		})
+
+	})
+
+	Context("HandleSerialConsole", func() {
+
+		const (
+			uuid      = "test-uuid"
+			namespace = "test-namespace"
+			vmName    = "test-vm"
+			vmPort    = "51234"
+		)
+
+		var (
+			initObjects []ctrlclient.Object
+			server      *serialconsole.Server
+			httpServer  *httptest.Server
+			kubeClient  ctrlclient.Client
+			events      chan string
+			scr         *vmopv1.VirtualMachineSerialConsoleRequest
+			vm          *vmopv1.VirtualMachine
+			listener    net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			// Echo the bytes written to the fake serial console.
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						defer conn.Close()
+						_, _ = io.Copy(conn, conn)
+					}()
+				}
+			}()
+
+			vm = builder.DummyBasicVirtualMachine(vmName, namespace)
+			vm.UID = "test-vm-uid"
+			vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{
+				SerialConsole: &vmopv1.VirtualMachineSerialConsoleSpec{
+					Enabled: true,
+				},
+			}
+			vm.Annotations[vmopv1.SerialConsolePortAnnotation] = vmPort
+			initObjects = append(initObjects, vm)
+
+			scr = builder.DummyVirtualMachineSerialConsoleRequest(namespace, "test-scr", vmName)
+			scr.UID = "test-scr-uid"
+			scr.Labels = map[string]string{
+				vmopv1.SerialConsoleRequestUUIDLabelKey: uuid,
+			}
+			scr.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: vmopv1.GroupVersion.String(),
+					Kind:       "VirtualMachine",
+					Name:       vm.Name,
+					UID:        vm.UID,
+					Controller: ptr.To(true),
+				},
+			}
+			scr.Status.Endpoint = net.JoinHostPort("192.168.0.10", vmPort)
+			scr.Status.ExpiryTime = metav1.NewTime(time.Now().Add(time.Minute))
+			initObjects = append(initObjects, scr)
+		})
+
+		JustBeforeEach(func() {
+			var recorder record.Recorder
+			recorder, events = builder.NewFakeRecorder()
+			kubeClient = builder.NewFakeClient(initObjects...)
+			server = &serialconsole.Server{
+				Path:          serverPath,
+				KubeClient:    kubeClient,
+				Recorder:      recorder,
+				CheckInterval: 50 * time.Millisecond,
+				// Connect to the fake serial console instead of the host.
+				Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, network, listener.Addr().String())
+				},
+			}
+			httpServer = httptest.NewServer(http.HandlerFunc(server.HandleSerialConsole))
+		})
+
+		AfterEach(func() {
+			httpServer.Close()
+			Expect(listener.Close()).To(Succeed())
+			initObjects = nil
+			events = nil
+		})
+
+		fakeRequest := func(query string) int {
+			resp, err := http.Get(httpServer.URL + serverPath + query)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			return resp.StatusCode
+		}
+
+		dial := func() *websocket.Conn {
+			url := fmt.Sprintf("%s%s?uuid=%s&namespace=%s",
+				strings.Replace(httpServer.URL, "http://", "ws://", 1), serverPath, uuid, namespace)
+			ws, err := websocket.Dial(url, "", httpServer.URL)
+			Expect(err).NotTo(HaveOccurred())
+			return ws
+		}
+
+		Context("requests with missing params", func() {
+
+			It("should return http.StatusBadRequest (400)", func() {
+				Expect(fakeRequest("")).To(Equal(http.StatusBadRequest))
+				Expect(fakeRequest("?uuid=123")).To(Equal(http.StatusBadRequest))
+				Expect(fakeRequest("?namespace=dummy")).To(Equal(http.StatusBadRequest))
+			})
+
+		})
+
+		When("UUID doesn't match any VirtualMachineSerialConsoleRequest resource", func() {
+
+			It("should return http.StatusForbidden (403)", func() {
+				Expect(fakeRequest("?uuid=non-existent-uuid&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=non-existent-namespace")).To(Equal(http.StatusForbidden))
+			})
+
+		})
+
+		When("the request is expired", func() {
+			BeforeEach(func() {
+				scr.Status.ExpiryTime = metav1.NewTime(time.Now().Add(-time.Second))
+			})
+
+			It("should return http.StatusForbidden (403) and record an event", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+
+				var event string
+				Expect(events).To(Receive(&event))
+				Expect(event).To(HavePrefix("Warning " + serialconsole.ConnectionDeniedReason))
+				Expect(event).To(ContainSubstring("expired"))
+			})
+		})
+
+		When("the request is not ready", func() {
+			BeforeEach(func() {
+				scr.Status = vmopv1.VirtualMachineSerialConsoleRequestStatus{}
+			})
+
+			It("should return http.StatusForbidden (403)", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the VM does not exist", func() {
+			BeforeEach(func() {
+				initObjects = []ctrlclient.Object{scr}
+			})
+
+			It("should return http.StatusForbidden (403) and record an event", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+
+				var event string
+				Expect(events).To(Receive(&event))
+				Expect(event).To(HavePrefix("Warning " + serialconsole.ConnectionDeniedReason))
+				Expect(event).To(ContainSubstring("virtual machine does not exist"))
+			})
+		})
+
+		When("the request is not owned by the VM", func() {
+			BeforeEach(func() {
+				scr.OwnerReferences[0].UID = "other-vm-uid"
+			})
+
+			It("should return http.StatusForbidden (403)", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+
+				var event string
+				Expect(events).To(Receive(&event))
+				Expect(event).To(ContainSubstring("request is not owned by the virtual machine"))
+			})
+		})
+
+		When("the VM's serial console is not enabled", func() {
+			BeforeEach(func() {
+				vm.Spec.Hardware.SerialConsole.Enabled = false
+			})
+
+			It("should return http.StatusForbidden (403)", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+
+				var event string
+				Expect(events).To(Receive(&event))
+				Expect(event).To(ContainSubstring("serial console is not enabled"))
+			})
+		})
+
+		When("the endpoint is not the VM's serial console", func() {
+			BeforeEach(func() {
+				scr.Status.Endpoint = net.JoinHostPort("192.168.0.10", "51235")
+			})
+
+			It("should return http.StatusForbidden (403)", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusForbidden))
+
+				var event string
+				Expect(events).To(Receive(&event))
+				Expect(event).To(ContainSubstring("endpoint is not the serial console of the virtual machine"))
+			})
+		})
+
+		When("the serial console cannot be reached", func() {
+			BeforeEach(func() {
+				Expect(listener.Close()).To(Succeed())
+			})
+
+			AfterEach(func() {
+				// Re-open the listener so it may be closed again.
+				var err error
+				listener, err = net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return http.StatusBadGateway (502)", func() {
+				Expect(fakeRequest("?uuid=" + uuid + "&namespace=" + namespace)).To(Equal(http.StatusBadGateway))
+			})
+		})
+
+		When("the request is valid", func() {
+
+			It("should stream the serial console and record an event", func() {
+				ws := dial()
+				defer ws.Close()
+
+				_, err := ws.Write([]byte("hello"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ws.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+				buf := make([]byte, 5)
+				_, err = io.ReadFull(ws, buf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(buf)).To(Equal("hello"))
+
+				var event string
+				Expect(events).To(Receive(&event))
+				Expect(event).To(HavePrefix("Normal " + serialconsole.ConnectionAllowedReason))
+				Expect(event).To(ContainSubstring(vmName))
+			})
+
+			It("should close the connection when the request is deleted", func() {
+				ws := dial()
+				defer ws.Close()
+
+				Expect(kubeClient.Delete(context.Background(), scr)).To(Succeed())
+
+				Expect(ws.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+				_, err := io.ReadAll(ws)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+}
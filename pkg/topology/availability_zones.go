@@ -136,6 +136,44 @@ func GetNamespaceFolderAndRPMoIDs(
 	return folderMoID, rpMoIDs, nil
 }
 
+// GetNamespaceZoneNames returns the names of the zones available to the
+// namespace. Zones that are being deleted are not included.
+func GetNamespaceZoneNames(
+	ctx context.Context,
+	client ctrlclient.Client,
+	namespace string) ([]string, error) {
+
+	var zoneNames []string
+
+	if pkgcfg.FromContext(ctx).Features.WorkloadDomainIsolation {
+		zones, err := GetZones(ctx, client, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, zone := range zones {
+			if zone.DeletionTimestamp.IsZero() {
+				zoneNames = append(zoneNames, zone.Name)
+			}
+		}
+
+		return zoneNames, nil
+	}
+
+	availabilityZones, err := GetAvailabilityZones(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, az := range availabilityZones {
+		if _, ok := az.Spec.Namespaces[namespace]; ok {
+			zoneNames = append(zoneNames, az.Name)
+		}
+	}
+
+	return zoneNames, nil
+}
+
 // GetNamespaceFolderMoID returns the FolderMoID for the namespace.
 func GetNamespaceFolderMoID(
 	ctx context.Context,
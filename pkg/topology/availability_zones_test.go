@@ -251,6 +251,25 @@ var _ = Describe("Availability Zones and Zones", func() {
 		}
 	}
 
+	assertGetNamespaceZoneNamesSuccess := func(prefix string, count int) func() {
+		return func() {
+			for i := 0; i < numberOfNamespaces; i++ {
+				zoneNames, err := topology.GetNamespaceZoneNames(ctx, client, fmt.Sprintf("ns-%d", i))
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				ExpectWithOffset(1, zoneNames).To(HaveLen(count))
+				for j := 0; j < count; j++ {
+					ExpectWithOffset(1, zoneNames).To(ContainElement(fmt.Sprintf("%s-%d", prefix, j)))
+				}
+			}
+		}
+	}
+
+	assertGetNamespaceZoneNamesInvalidNamespaceEmpty := func() {
+		zoneNames, err := topology.GetNamespaceZoneNames(ctx, client, "invalid")
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, zoneNames).To(BeEmpty())
+	}
+
 	When("Two AvailabilityZone resources exist", func() {
 		BeforeEach(func() {
 			numberOfAvailabilityZones = 2
@@ -301,6 +320,14 @@ var _ = Describe("Availability Zones and Zones", func() {
 					It("Should return the RP and Folder resources", assertGetNamespaceFolderAndRPMoIDsSuccess)
 				})
 			})
+			Context("GetNamespaceZoneNames", func() {
+				Context("With an invalid Namespace name", func() {
+					It("Should return no zones", assertGetNamespaceZoneNamesInvalidNamespaceEmpty)
+				})
+				Context("With a valid Namespace name", func() {
+					It("Should return the AvailabilityZone names", assertGetNamespaceZoneNamesSuccess("az", 2))
+				})
+			})
 		})
 		When("DevOps Namespaces do not exist", func() {
 			Context("GetAvailabilityZones", func() {
@@ -376,6 +403,11 @@ var _ = Describe("Availability Zones and Zones", func() {
 					It("Should return the RP and Folder resources", assertGetNamespaceFolderAndRPMoIDsSuccess)
 				})
 			})
+			Context("GetNamespaceZoneNames", func() {
+				Context("With a valid Namespace name", func() {
+					It("Should return the Zone names", assertGetNamespaceZoneNamesSuccess("zone", 2))
+				})
+			})
 		})
 	})
 
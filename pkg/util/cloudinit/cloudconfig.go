@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -28,11 +29,23 @@ import (
 // prior to marshalling the structure to YAML and set inline within the struct
 // itself.
 type cloudConfig struct {
-	Timezone   string              `json:"timezone,omitempty" yaml:"timezone,omitempty"`
-	Users      *cloudConfigUsers   `json:"users,omitempty" yaml:"users,omitempty"`
-	RunCmd     []cloudConfigRunCmd `json:"runcmd,omitempty" yaml:"runcmd,omitempty"`
-	WriteFiles []writeFile         `json:"write_files,omitempty" yaml:"write_files,omitempty"`
-	SSHPwdAuth *bool               `json:"ssh_pwauth,omitempty" yaml:"ssh_pwauth,omitempty"`
+	Timezone                string               `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Users                   *cloudConfigUsers    `json:"users,omitempty" yaml:"users,omitempty"`
+	RunCmd                  []cloudConfigRunCmd  `json:"runcmd,omitempty" yaml:"runcmd,omitempty"`
+	WriteFiles              []writeFile          `json:"write_files,omitempty" yaml:"write_files,omitempty"`
+	SSHPwdAuth              *bool                `json:"ssh_pwauth,omitempty" yaml:"ssh_pwauth,omitempty"`
+	BootCmd                 []cloudConfigRunCmd  `json:"bootcmd,omitempty" yaml:"bootcmd,omitempty"`
+	Packages                []cloudConfigPackage `json:"packages,omitempty" yaml:"packages,omitempty"`
+	PackageUpdate           bool                 `json:"package_update,omitempty" yaml:"package_update,omitempty"`
+	PackageUpgrade          bool                 `json:"package_upgrade,omitempty" yaml:"package_upgrade,omitempty"`
+	PackageRebootIfRequired bool                 `json:"package_reboot_if_required,omitempty" yaml:"package_reboot_if_required,omitempty"`
+	Apt                     *apt                 `json:"apt,omitempty" yaml:"apt,omitempty"`
+	YumRepos                map[string]yumRepo   `json:"yum_repos,omitempty" yaml:"yum_repos,omitempty"`
+	NTP                     *ntp                 `json:"ntp,omitempty" yaml:"ntp,omitempty"`
+	Mounts                  [][]*string          `json:"mounts,omitempty" yaml:"mounts,omitempty"`
+	DiskSetup               map[string]diskSetup `json:"disk_setup,omitempty" yaml:"disk_setup,omitempty"`
+	FSSetup                 []fsSetup            `json:"fs_setup,omitempty" yaml:"fs_setup,omitempty"`
+	CACerts                 *caCerts             `json:"ca_certs,omitempty" yaml:"ca_certs,omitempty"`
 }
 
 type cloudConfigUsers struct {
@@ -81,6 +94,79 @@ type writeFile struct {
 	Permissions string `json:"permissions,omitempty" yaml:"permissions,omitempty"`
 }
 
+type cloudConfigPackage struct {
+	name    string
+	version string
+}
+
+type apt struct {
+	PreserveSourcesList *bool                `json:"preserve_sources_list,omitempty" yaml:"preserve_sources_list,omitempty"`
+	Primary             []aptMirror          `json:"primary,omitempty" yaml:"primary,omitempty"`
+	Security            []aptMirror          `json:"security,omitempty" yaml:"security,omitempty"`
+	HTTPProxy           string               `json:"http_proxy,omitempty" yaml:"http_proxy,omitempty"`
+	HTTPSProxy          string               `json:"https_proxy,omitempty" yaml:"https_proxy,omitempty"`
+	Sources             map[string]aptSource `json:"sources,omitempty" yaml:"sources,omitempty"`
+}
+
+type aptMirror struct {
+	Arches []string `json:"arches" yaml:"arches"`
+	URI    string   `json:"uri,omitempty" yaml:"uri,omitempty"`
+	Search []string `json:"search,omitempty" yaml:"search,omitempty"`
+}
+
+type aptSource struct {
+	Source    string `json:"source,omitempty" yaml:"source,omitempty"`
+	KeyID     string `json:"keyid,omitempty" yaml:"keyid,omitempty"`
+	KeyServer string `json:"keyserver,omitempty" yaml:"keyserver,omitempty"`
+	Key       string `json:"key,omitempty" yaml:"key,omitempty"`
+	Filename  string `json:"filename,omitempty" yaml:"filename,omitempty"`
+	Append    *bool  `json:"append,omitempty" yaml:"append,omitempty"`
+}
+
+type yumRepo struct {
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	BaseURL    string `json:"baseurl,omitempty" yaml:"baseurl,omitempty"`
+	MetaLink   string `json:"metalink,omitempty" yaml:"metalink,omitempty"`
+	MirrorList string `json:"mirrorlist,omitempty" yaml:"mirrorlist,omitempty"`
+	Enabled    *bool  `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	GPGCheck   *bool  `json:"gpgcheck,omitempty" yaml:"gpgcheck,omitempty"`
+	GPGKey     string `json:"gpgkey,omitempty" yaml:"gpgkey,omitempty"`
+	Username   string `json:"username,omitempty" yaml:"username,omitempty"`
+	Password   string `json:"password,omitempty" yaml:"password,omitempty"`
+}
+
+type ntp struct {
+	Enabled   *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	NTPClient string   `json:"ntp_client,omitempty" yaml:"ntp_client,omitempty"`
+	Servers   []string `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Pools     []string `json:"pools,omitempty" yaml:"pools,omitempty"`
+}
+
+type diskSetup struct {
+	TableType string          `json:"table_type,omitempty" yaml:"table_type,omitempty"`
+	Layout    diskSetupLayout `json:"layout" yaml:"layout"`
+	Overwrite bool            `json:"overwrite,omitempty" yaml:"overwrite,omitempty"`
+}
+
+type diskSetupLayout struct {
+	partitions []cloudinit.DiskPartition
+}
+
+type fsSetup struct {
+	Label      string   `json:"label,omitempty" yaml:"label,omitempty"`
+	Filesystem string   `json:"filesystem" yaml:"filesystem"`
+	Device     string   `json:"device" yaml:"device"`
+	Partition  string   `json:"partition,omitempty" yaml:"partition,omitempty"`
+	Overwrite  bool     `json:"overwrite,omitempty" yaml:"overwrite,omitempty"`
+	ReplaceFS  string   `json:"replace_fs,omitempty" yaml:"replace_fs,omitempty"`
+	ExtraOpts  []string `json:"extra_opts,omitempty" yaml:"extra_opts,omitempty"`
+}
+
+type caCerts struct {
+	RemoveDefaults bool     `json:"remove_defaults,omitempty" yaml:"remove_defaults,omitempty"`
+	Trusted        []string `json:"trusted,omitempty" yaml:"trusted,omitempty"`
+}
+
 const emptyYAMLObject = "{}\n"
 
 // MarshalYAML marshals the provided CloudConfig and secret data to a valid,
@@ -89,6 +175,120 @@ func MarshalYAML(
 	in cloudinit.CloudConfig,
 	secret CloudConfigSecretData) (string, error) {
 
+	data, err := marshalYAML(in, secret)
+	if err != nil {
+		return "", err
+	}
+
+	if data == "" {
+		return "", nil
+	}
+
+	// Validate the produced CloudConfig YAML using the CloudConfig schema.
+	if err := validate.CloudConfigYAML(data); err != nil {
+		return "", err
+	}
+
+	return data, nil
+}
+
+// ValidateSchema returns any errors encountered when validating the provided
+// CloudConfig according to the CloudConfig schema. The data from Secret
+// resources is replaced with a placeholder, since the Secrets may not exist
+// when the CloudConfig is validated.
+func ValidateSchema(
+	fieldPath *field.Path,
+	in cloudinit.CloudConfig) field.ErrorList {
+
+	data, err := marshalYAML(in, placeholderSecretData(in))
+	if err != nil {
+		// The fields that cannot be marshalled are reported by
+		// validate.CloudConfig.
+		return nil
+	}
+
+	if data == "" {
+		return nil
+	}
+
+	return validate.CloudConfigSchema(fieldPath, data)
+}
+
+// secretDataPlaceholder replaces the data from Secret resources when a
+// CloudConfig is validated.
+const secretDataPlaceholder = "secret"
+
+// placeholderSecretData returns secret data with a placeholder for each of the
+// fields of the provided CloudConfig that may reference a Secret resource.
+func placeholderSecretData(in cloudinit.CloudConfig) CloudConfigSecretData {
+	var out CloudConfigSecretData
+
+	if l := len(in.Users); l > 0 {
+		out.Users = make(map[string]CloudConfigUserSecretData, l)
+		for i := range in.Users {
+			var u CloudConfigUserSecretData
+			if in.Users[i].HashedPasswd != nil {
+				u.HashPasswd = secretDataPlaceholder
+			}
+			if in.Users[i].Passwd != nil {
+				u.Passwd = secretDataPlaceholder
+			}
+			out.Users[in.Users[i].Name] = u
+		}
+	}
+
+	if l := len(in.WriteFiles); l > 0 {
+		out.WriteFiles = make(map[string]string, l)
+		for i := range in.WriteFiles {
+			var content string
+			if c := in.WriteFiles[i].Content; len(c) > 0 && json.Unmarshal(c, &content) != nil {
+				out.WriteFiles[in.WriteFiles[i].Path] = secretDataPlaceholder
+			}
+		}
+	}
+
+	if v := in.Apt; v != nil {
+		if v.HTTPProxy != nil {
+			out.AptHTTPProxy = secretDataPlaceholder
+		}
+		if v.HTTPSProxy != nil {
+			out.AptHTTPSProxy = secretDataPlaceholder
+		}
+		if l := len(v.Sources); l > 0 {
+			out.AptSourceKeys = make(map[string]string, l)
+			for i := range v.Sources {
+				if v.Sources[i].Key != nil {
+					out.AptSourceKeys[v.Sources[i].Name] = secretDataPlaceholder
+				}
+			}
+		}
+	}
+
+	if l := len(in.YumRepos); l > 0 {
+		out.YumRepoPasswords = make(map[string]string, l)
+		for i := range in.YumRepos {
+			if in.YumRepos[i].Password != nil {
+				out.YumRepoPasswords[in.YumRepos[i].ID] = secretDataPlaceholder
+			}
+		}
+	}
+
+	if v := in.CACerts; v != nil && len(v.Trusted) > 0 {
+		out.CACerts = make([]string, len(v.Trusted))
+		for i := range out.CACerts {
+			out.CACerts[i] = secretDataPlaceholder
+		}
+	}
+
+	return out
+}
+
+// marshalYAML marshals the provided CloudConfig and secret data to a YAML
+// CloudConfig document without validating it.
+func marshalYAML(
+	in cloudinit.CloudConfig,
+	secret CloudConfigSecretData) (string, error) {
+
 	out := cloudConfig{Timezone: in.Timezone}
 
 	if l := len(in.Users); l > 0 {
@@ -105,31 +305,11 @@ func MarshalYAML(
 	}
 
 	if l := len(in.RunCmd); l > 0 {
-		var rawCommands []json.RawMessage
-		if err := json.Unmarshal(in.RunCmd, &rawCommands); err != nil {
+		cmds, err := unmarshalRunCmds(in.RunCmd)
+		if err != nil {
 			return "", err
 		}
-
-		out.RunCmd = make([]cloudConfigRunCmd, len(rawCommands))
-		for i := range rawCommands {
-
-			// First try to unmarshal the value into a string. If that does
-			// not work, try unmarshaling the data into a list of strings.
-			if err := json.Unmarshal(
-				rawCommands[i],
-				&out.RunCmd[i].singleString); err != nil {
-
-				out.RunCmd[i].singleString = ""
-
-				if err := json.Unmarshal(
-					rawCommands[i],
-					&out.RunCmd[i].listOfStrings); err != nil {
-
-					return "", err
-
-				}
-			}
-		}
+		out.RunCmd = cmds
 	}
 
 	if l := len(in.WriteFiles); l > 0 {
@@ -161,6 +341,97 @@ func MarshalYAML(
 		out.SSHPwdAuth = in.SSHPwdAuth
 	}
 
+	if l := len(in.BootCmd); l > 0 {
+		cmds, err := unmarshalRunCmds(in.BootCmd)
+		if err != nil {
+			return "", err
+		}
+		out.BootCmd = cmds
+	}
+
+	if l := len(in.Packages); l > 0 {
+		out.Packages = make([]cloudConfigPackage, l)
+		for i := range in.Packages {
+			out.Packages[i].name = in.Packages[i].Name
+			out.Packages[i].version = in.Packages[i].Version
+		}
+	}
+
+	out.PackageUpdate = in.PackageUpdate
+	out.PackageUpgrade = in.PackageUpgrade
+	out.PackageRebootIfRequired = in.PackageRebootIfRequired
+
+	if in.Apt != nil {
+		out.Apt = &apt{}
+		copyApt(*in.Apt, out.Apt, secret)
+	}
+
+	if l := len(in.YumRepos); l > 0 {
+		out.YumRepos = make(map[string]yumRepo, l)
+		for i := range in.YumRepos {
+			var outRepo yumRepo
+			copyYumRepo(
+				in.YumRepos[i],
+				&outRepo,
+				secret.YumRepoPasswords[in.YumRepos[i].ID])
+			out.YumRepos[in.YumRepos[i].ID] = outRepo
+		}
+	}
+
+	if v := in.NTP; v != nil {
+		out.NTP = &ntp{
+			Enabled:   v.Enabled,
+			NTPClient: string(v.NTPClient),
+			Servers:   v.Servers,
+			Pools:     v.Pools,
+		}
+	}
+
+	if l := len(in.Mounts); l > 0 {
+		out.Mounts = make([][]*string, l)
+		for i := range in.Mounts {
+			out.Mounts[i] = mountFields(in.Mounts[i])
+		}
+	}
+
+	if l := len(in.DiskSetup); l > 0 {
+		out.DiskSetup = make(map[string]diskSetup, l)
+		for i := range in.DiskSetup {
+			out.DiskSetup[in.DiskSetup[i].Device] = diskSetup{
+				TableType: string(in.DiskSetup[i].TableType),
+				Layout: diskSetupLayout{
+					partitions: in.DiskSetup[i].Partitions,
+				},
+				Overwrite: in.DiskSetup[i].Overwrite,
+			}
+		}
+	}
+
+	if l := len(in.FSSetup); l > 0 {
+		out.FSSetup = make([]fsSetup, l)
+		for i := range in.FSSetup {
+			copyFSSetup(in.FSSetup[i], &out.FSSetup[i])
+		}
+	}
+
+	if v := in.CACerts; v != nil {
+		out.CACerts = &caCerts{
+			RemoveDefaults: v.RemoveDefaults,
+		}
+		if l := len(v.Trusted); l > 0 {
+			out.CACerts.Trusted = make([]string, l)
+			for i := range v.Trusted {
+				var secretData string
+				if i < len(secret.CACerts) {
+					secretData = secret.CACerts[i]
+				}
+				out.CACerts.Trusted[i] = valueOrSecretData(
+					&v.Trusted[i],
+					secretData)
+			}
+		}
+	}
+
 	var w1 bytes.Buffer
 	fmt.Fprintln(&w1, "## template: jinja")
 	fmt.Fprintln(&w1, "#cloud-config")
@@ -180,14 +451,7 @@ func MarshalYAML(
 		return "", err
 	}
 
-	data = w1.String()
-
-	// Validate the produced CloudConfig YAML using the CloudConfig schema.
-	if err := validate.CloudConfigYAML(data); err != nil {
-		return "", err
-	}
-
-	return data, nil
+	return w1.String(), nil
 }
 
 func copyUser(
@@ -253,6 +517,159 @@ func copyWriteFile(
 	out.Permissions = in.Permissions
 }
 
+func copyApt(
+	in cloudinit.Apt,
+	out *apt,
+	secret CloudConfigSecretData) {
+
+	out.PreserveSourcesList = in.PreserveSourcesList
+	out.Primary = copyAptMirrors(in.Primary)
+	out.Security = copyAptMirrors(in.Security)
+	out.HTTPProxy = valueOrSecretData(in.HTTPProxy, secret.AptHTTPProxy)
+	out.HTTPSProxy = valueOrSecretData(in.HTTPSProxy, secret.AptHTTPSProxy)
+
+	if l := len(in.Sources); l > 0 {
+		out.Sources = make(map[string]aptSource, l)
+		for i := range in.Sources {
+			src := in.Sources[i]
+			out.Sources[src.Name] = aptSource{
+				Source:    src.Source,
+				KeyID:     src.KeyID,
+				KeyServer: src.KeyServer,
+				Key:       valueOrSecretData(src.Key, secret.AptSourceKeys[src.Name]),
+				Filename:  src.Filename,
+				Append:    src.Append,
+			}
+		}
+	}
+}
+
+func copyAptMirrors(in []cloudinit.AptMirror) []aptMirror {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]aptMirror, len(in))
+	for i := range in {
+		out[i] = aptMirror{
+			Arches: in[i].Arches,
+			URI:    in[i].URI,
+			Search: in[i].Search,
+		}
+	}
+	return out
+}
+
+func copyYumRepo(
+	in cloudinit.YumRepo,
+	out *yumRepo,
+	password string) {
+
+	out.Name = in.Name
+	out.BaseURL = in.BaseURL
+	out.MetaLink = in.MetaLink
+	out.MirrorList = in.MirrorList
+	out.Enabled = in.Enabled
+	out.GPGCheck = in.GPGCheck
+	out.GPGKey = in.GPGKey
+	out.Username = in.Username
+	out.Password = password
+}
+
+func copyFSSetup(
+	in cloudinit.FSSetup,
+	out *fsSetup) {
+
+	out.Label = in.Label
+	out.Filesystem = in.Filesystem
+	out.Device = in.Device
+	out.Partition = in.Partition
+	out.Overwrite = in.Overwrite
+	out.ReplaceFS = in.ReplaceFS
+
+	if l := len(in.ExtraOpts); l > 0 {
+		out.ExtraOpts = make([]string, l)
+		copy(out.ExtraOpts, in.ExtraOpts)
+	}
+}
+
+// mountFields returns the /etc/fstab fields for the provided mount. Trailing,
+// unset fields are omitted and other unset fields are null so the guest uses
+// its default values for them.
+func mountFields(in cloudinit.Mount) []*string {
+	var dump, pass string
+	if in.Dump != nil {
+		dump = strconv.Itoa(int(*in.Dump))
+	}
+	if in.Pass != nil {
+		pass = strconv.Itoa(int(*in.Pass))
+	}
+
+	fields := []string{
+		in.Device,
+		in.MountPoint,
+		in.FSType,
+		in.Options,
+		dump,
+		pass,
+	}
+
+	n := len(fields)
+	for n > 0 && fields[n-1] == "" {
+		n--
+	}
+
+	out := make([]*string, n)
+	for i := range out {
+		if fields[i] != "" {
+			out[i] = &fields[i]
+		}
+	}
+	return out
+}
+
+// valueOrSecretData returns the inline value from the provided selector, if
+// one is set, otherwise the data derived from the selector's Secret resource.
+func valueOrSecretData(
+	in *common.ValueOrSecretKeySelector,
+	secretData string) string {
+
+	if in != nil && in.Value != nil {
+		return *in.Value
+	}
+	return secretData
+}
+
+// unmarshalRunCmds returns the commands from a runcmd or bootcmd list.
+func unmarshalRunCmds(in json.RawMessage) ([]cloudConfigRunCmd, error) {
+	var rawCommands []json.RawMessage
+	if err := json.Unmarshal(in, &rawCommands); err != nil {
+		return nil, err
+	}
+
+	out := make([]cloudConfigRunCmd, len(rawCommands))
+	for i := range rawCommands {
+
+		// First try to unmarshal the value into a string. If that does
+		// not work, try unmarshaling the data into a list of strings.
+		if err := json.Unmarshal(
+			rawCommands[i],
+			&out[i].singleString); err != nil {
+
+			out[i].singleString = ""
+
+			if err := json.Unmarshal(
+				rawCommands[i],
+				&out[i].listOfStrings); err != nil {
+
+				return nil, err
+
+			}
+		}
+	}
+
+	return out, nil
+}
+
 func (ccu cloudConfigUsers) MarshalJSON() ([]byte, error) {
 	if len(ccu.users) == 0 {
 		return nil, nil
@@ -276,6 +693,29 @@ func (ccu cloudConfigRunCmd) MarshalJSON() ([]byte, error) {
 	return nil, nil
 }
 
+func (ccp cloudConfigPackage) MarshalJSON() ([]byte, error) {
+	if ccp.version != "" {
+		return json.Marshal([]string{ccp.name, ccp.version})
+	}
+	return json.Marshal(ccp.name)
+}
+
+func (dsl diskSetupLayout) MarshalJSON() ([]byte, error) {
+	// A layout of true creates a single partition that spans the entire disk.
+	if len(dsl.partitions) == 0 {
+		return json.Marshal(true)
+	}
+	result := make([]any, len(dsl.partitions))
+	for i, p := range dsl.partitions {
+		if p.Type != "" {
+			result[i] = []any{p.Percentage, p.Type}
+		} else {
+			result[i] = p.Percentage
+		}
+	}
+	return json.Marshal(result)
+}
+
 // GetSecretResources returns a list of the Secret resources referenced by the
 // provided CloudConfig.
 func GetSecretResources(
@@ -337,5 +777,46 @@ func GetSecretResources(
 		}
 	}
 
+	var selectors []*common.SecretKeySelector
+
+	if v := in.Apt; v != nil {
+		if v.HTTPProxy != nil {
+			selectors = append(selectors, v.HTTPProxy.From)
+		}
+		if v.HTTPSProxy != nil {
+			selectors = append(selectors, v.HTTPSProxy.From)
+		}
+		for i := range v.Sources {
+			if v.Sources[i].Key != nil {
+				selectors = append(selectors, v.Sources[i].Key.From)
+			}
+		}
+	}
+
+	for i := range in.YumRepos {
+		selectors = append(selectors, in.YumRepos[i].Password)
+	}
+
+	if v := in.CACerts; v != nil {
+		for i := range v.Trusted {
+			selectors = append(selectors, v.Trusted[i].From)
+		}
+	}
+
+	for _, sks := range selectors {
+		if sks == nil {
+			continue
+		}
+		s, err := util.GetSecretResource(
+			ctx,
+			k8sClient,
+			secretNamespace,
+			sks.Name)
+		if err != nil {
+			return nil, err
+		}
+		captureSecret(s, sks.Name)
+	}
+
 	return result, nil
 }
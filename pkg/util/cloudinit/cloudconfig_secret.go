@@ -25,6 +25,25 @@ type CloudConfigSecretData struct {
 	// WriteFiles is a map where the key is the file's Path and the value is
 	// the file's contents.
 	WriteFiles map[string]string
+
+	// AptHTTPProxy is the apt HTTP proxy.
+	AptHTTPProxy string
+
+	// AptHTTPSProxy is the apt HTTPS proxy.
+	AptHTTPSProxy string
+
+	// AptSourceKeys is a map where the key is the apt source's Name and the
+	// value is the source's key.
+	AptSourceKeys map[string]string
+
+	// YumRepoPasswords is a map where the key is the yum repository's ID and
+	// the value is the repository's password.
+	YumRepoPasswords map[string]string
+
+	// CACerts is a list of the trusted CA certificates. The index of each
+	// element is the index of the certificate in the CloudConfig, and the
+	// element is empty if the certificate is not from a Secret resource.
+	CACerts []string
 }
 
 type CloudConfigUserSecretData struct {
@@ -77,9 +96,121 @@ func GetCloudConfigSecretData(
 		}
 	}
 
+	if v := in.Apt; v != nil {
+		if err := getSecretDataForApt(
+			ctx,
+			k8sClient,
+			secretNamespace,
+			*v,
+			&result); err != nil {
+			return CloudConfigSecretData{}, err
+		}
+	}
+
+	if l := len(in.YumRepos); l > 0 {
+		result.YumRepoPasswords = map[string]string{}
+		for i := range in.YumRepos {
+			v := in.YumRepos[i].Password
+			if v == nil {
+				continue
+			}
+			var outPassword string
+			if err := util.GetSecretData(
+				ctx, k8sClient,
+				secretNamespace, v.Name, v.Key,
+				&outPassword); err != nil {
+
+				return CloudConfigSecretData{}, err
+			}
+			result.YumRepoPasswords[in.YumRepos[i].ID] = outPassword
+		}
+	}
+
+	if v := in.CACerts; v != nil && len(v.Trusted) > 0 {
+		result.CACerts = make([]string, len(v.Trusted))
+		for i := range v.Trusted {
+			if err := getSecretDataForValueOrSecretKeySelector(
+				ctx,
+				k8sClient,
+				secretNamespace,
+				&v.Trusted[i],
+				&result.CACerts[i]); err != nil {
+				return CloudConfigSecretData{}, err
+			}
+		}
+	}
+
 	return result, nil
 }
 
+func getSecretDataForApt(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	secretNamespace string,
+	in cloudinit.Apt,
+	out *CloudConfigSecretData) error {
+
+	if err := getSecretDataForValueOrSecretKeySelector(
+		ctx,
+		k8sClient,
+		secretNamespace,
+		in.HTTPProxy,
+		&out.AptHTTPProxy); err != nil {
+		return err
+	}
+
+	if err := getSecretDataForValueOrSecretKeySelector(
+		ctx,
+		k8sClient,
+		secretNamespace,
+		in.HTTPSProxy,
+		&out.AptHTTPSProxy); err != nil {
+		return err
+	}
+
+	if l := len(in.Sources); l > 0 {
+		out.AptSourceKeys = map[string]string{}
+		for i := range in.Sources {
+			var outKey string
+			if err := getSecretDataForValueOrSecretKeySelector(
+				ctx,
+				k8sClient,
+				secretNamespace,
+				in.Sources[i].Key,
+				&outKey); err != nil {
+				return err
+			}
+			if outKey != "" {
+				out.AptSourceKeys[in.Sources[i].Name] = outKey
+			}
+		}
+	}
+
+	return nil
+}
+
+// getSecretDataForValueOrSecretKeySelector gets the data for the provided
+// selector if it references a Secret resource. Inline values are not
+// returned as they are read directly from the CloudConfig.
+func getSecretDataForValueOrSecretKeySelector(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	secretNamespace string,
+	in *common.ValueOrSecretKeySelector,
+	out *string) error {
+
+	if in == nil || in.From == nil {
+		return nil
+	}
+
+	return util.GetSecretData(
+		ctx,
+		k8sClient,
+		secretNamespace,
+		in.From.Name, in.From.Key,
+		out)
+}
+
 func getSecretDataForUser(
 	ctx context.Context,
 	k8sClient ctrlclient.Client,
@@ -6,6 +6,11 @@ package validate
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
@@ -19,8 +24,34 @@ const (
 	invalidRunCmd           = "value must be a list"
 	invalidRunCmdElement    = "value must be a string or list of strings"
 	invalidWriteFileContent = "value must be a string, multi-line string, or SecretKeySelector"
+	invalidValueOrSecret    = "from and value are mutually exclusive"
+	requiredValueOrSecret   = "either from or value must be provided"
+	requiredYumRepoURL      = "one of baseurl, metalink, or mirrorlist must be provided"
+	invalidDiskPartitions   = "sum of partition percentages must not exceed 100"
+	invalidSchemaType       = "value must be of type %s"
 )
 
+// CloudConfig returns any errors encountered when validating a CloudConfig.
+func CloudConfig(
+	fieldPath *field.Path,
+	in cloudinit.CloudConfig) field.ErrorList {
+
+	allErrs := CloudConfigJSONRawMessage(fieldPath, in)
+
+	if fieldPath == nil {
+		fieldPath = field.NewPath("cloudConfig")
+	} else {
+		fieldPath = fieldPath.Child("cloudConfig")
+	}
+
+	allErrs = append(allErrs, validateApt(fieldPath, in.Apt)...)
+	allErrs = append(allErrs, validateYumRepos(fieldPath, in.YumRepos)...)
+	allErrs = append(allErrs, validateDiskSetup(fieldPath, in.DiskSetup)...)
+	allErrs = append(allErrs, validateCACerts(fieldPath, in.CACerts)...)
+
+	return allErrs
+}
+
 // CloudConfigJSONRawMessage returns any errors encountered when validating the
 // json.RawMessage portions of a CloudConfig.
 func CloudConfigJSONRawMessage(
@@ -35,8 +66,9 @@ func CloudConfigJSONRawMessage(
 		fieldPath = fieldPath.Child("cloudConfig")
 	}
 
-	allErrs = append(allErrs, validateRunCmds(fieldPath, in.RunCmd)...)
+	allErrs = append(allErrs, validateRunCmds(fieldPath.Child("runcmds"), in.RunCmd)...)
 	allErrs = append(allErrs, validateWriteFiles(fieldPath, in.WriteFiles)...)
+	allErrs = append(allErrs, validateRunCmds(fieldPath.Child("bootcmd"), in.BootCmd)...)
 
 	return allErrs
 }
@@ -51,8 +83,6 @@ func validateRunCmds(
 
 	var allErrs field.ErrorList
 
-	fieldPath = fieldPath.Child("runcmds")
-
 	var rawCommands []json.RawMessage
 	if err := json.Unmarshal(in, &rawCommands); err != nil {
 		allErrs = append(
@@ -133,6 +163,128 @@ func validateWriteFiles(
 	return allErrs
 }
 
+func validateApt(
+	fieldPath *field.Path,
+	in *cloudinit.Apt) field.ErrorList {
+
+	if in == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	fieldPath = fieldPath.Child("apt")
+
+	allErrs = append(allErrs, validateValueOrSecretKeySelector(
+		fieldPath.Child("http_proxy"), in.HTTPProxy)...)
+	allErrs = append(allErrs, validateValueOrSecretKeySelector(
+		fieldPath.Child("https_proxy"), in.HTTPSProxy)...)
+
+	for i := range in.Sources {
+		allErrs = append(allErrs, validateValueOrSecretKeySelector(
+			fieldPath.Child("sources").Key(in.Sources[i].Name).Child("key"),
+			in.Sources[i].Key)...)
+	}
+
+	return allErrs
+}
+
+func validateYumRepos(
+	fieldPath *field.Path,
+	in []cloudinit.YumRepo) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	fieldPath = fieldPath.Child("yum_repos")
+
+	for i := range in {
+		if in[i].BaseURL == "" && in[i].MetaLink == "" && in[i].MirrorList == "" {
+			allErrs = append(
+				allErrs,
+				field.Required(
+					fieldPath.Key(in[i].ID),
+					requiredYumRepoURL))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDiskSetup(
+	fieldPath *field.Path,
+	in []cloudinit.DiskSetup) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	fieldPath = fieldPath.Child("disk_setup")
+
+	for i := range in {
+		var total int32
+		for _, p := range in[i].Partitions {
+			total += p.Percentage
+		}
+		if total > 100 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					fieldPath.Key(in[i].Device).Child("partitions"),
+					total,
+					invalidDiskPartitions))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCACerts(
+	fieldPath *field.Path,
+	in *cloudinit.CACerts) field.ErrorList {
+
+	if in == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	fieldPath = fieldPath.Child("ca_certs").Child("trusted")
+
+	for i := range in.Trusted {
+		allErrs = append(allErrs, validateValueOrSecretKeySelector(
+			fieldPath.Index(i), &in.Trusted[i])...)
+	}
+
+	return allErrs
+}
+
+func validateValueOrSecretKeySelector(
+	fieldPath *field.Path,
+	in *common.ValueOrSecretKeySelector) field.ErrorList {
+
+	if in == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	switch {
+	case in.From != nil && in.Value != nil:
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				fieldPath.Child("value"),
+				"value",
+				invalidValueOrSecret))
+	case in.From == nil && in.Value == nil:
+		allErrs = append(
+			allErrs,
+			field.Required(
+				fieldPath,
+				requiredValueOrSecret))
+	}
+
+	return allErrs
+}
+
 // CloudConfigYAML returns an error if the provided CloudConfig YAML is not
 // valid according to the CloudConfig schema.
 //
@@ -157,3 +309,73 @@ func CloudConfigYAML(in string) error {
 
 	return nil
 }
+
+// CloudConfigSchema returns any errors encountered when validating the
+// provided CloudConfig YAML, rendered from a typed CloudConfig, according to
+// the CloudConfig schema.
+func CloudConfigSchema(
+	fieldPath *field.Path,
+	in string) field.ErrorList {
+
+	if fieldPath == nil {
+		fieldPath = field.NewPath("cloudConfig")
+	} else {
+		fieldPath = fieldPath.Child("cloudConfig")
+	}
+
+	err := CloudConfigYAML(in)
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		for _, name := range strings.Split(typeErr.Field, ".") {
+			if i, err := strconv.Atoi(name); err == nil {
+				fieldPath = fieldPath.Index(i)
+			} else {
+				fieldPath = fieldPath.Child(name)
+			}
+		}
+		return field.ErrorList{
+			field.Invalid(
+				fieldPath,
+				typeErr.Value,
+				fmt.Sprintf(invalidSchemaType, schemaTypeName(typeErr.Type))),
+		}
+	}
+
+	return field.ErrorList{
+		field.Invalid(
+			fieldPath,
+			"cloudConfig",
+			err.Error()),
+	}
+}
+
+// schemaTypeName returns the name of the JSON schema type of the provided Go
+// type from the CloudConfig schema.
+func schemaTypeName(t reflect.Type) string {
+	if t == nil {
+		return "unknown"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Pointer:
+		return schemaTypeName(t.Elem())
+	default:
+		return t.String()
+	}
+}
@@ -15,6 +15,7 @@ import (
 	vmopv1cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha6/cloudinit"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha6/common"
 	cloudinitvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/validate"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
 var _ = Describe("Validate CloudConfigJSONRawMessage", func() {
@@ -118,6 +119,131 @@ var _ = Describe("Validate CloudConfigJSONRawMessage", func() {
 	})
 })
 
+var _ = Describe("Validate CloudConfig", func() {
+	var (
+		cloudConfig vmopv1cloudinit.CloudConfig
+		errs        field.ErrorList
+	)
+
+	BeforeEach(func() {
+		errs = nil
+		cloudConfig = vmopv1cloudinit.CloudConfig{
+			BootCmd: []byte(`["echo hello",["echo","world"]]`),
+			Apt: &vmopv1cloudinit.Apt{
+				HTTPProxy: &common.ValueOrSecretKeySelector{
+					From: &common.SecretKeySelector{
+						Name: "my-bootstrap-data",
+						Key:  "apt-proxy",
+					},
+				},
+				Sources: []vmopv1cloudinit.AptSource{
+					{
+						Name: "my-repo",
+						Key: &common.ValueOrSecretKeySelector{
+							Value: ptr.To("my-key"),
+						},
+					},
+				},
+			},
+			YumRepos: []vmopv1cloudinit.YumRepo{
+				{
+					ID:         "my-repo",
+					MirrorList: "http://example.com/el9/mirrors",
+				},
+			},
+			DiskSetup: []vmopv1cloudinit.DiskSetup{
+				{
+					Device: "/dev/sdb",
+					Partitions: []vmopv1cloudinit.DiskPartition{
+						{
+							Percentage: 60,
+						},
+						{
+							Percentage: 40,
+						},
+					},
+				},
+			},
+			CACerts: &vmopv1cloudinit.CACerts{
+				Trusted: []common.ValueOrSecretKeySelector{
+					{
+						Value: ptr.To("my-cert"),
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		errs = cloudinitvalidate.CloudConfig(
+			field.NewPath("spec").Child("bootstrap").Child("cloudInit"),
+			cloudConfig)
+	})
+
+	When("The CloudConfig is valid", func() {
+		It("Should not return any errors", func() {
+			Expect(errs).To(HaveLen(0))
+		})
+	})
+
+	When("The CloudConfig's second bootcmd is an invalid value", func() {
+		BeforeEach(func() {
+			cloudConfig.BootCmd = []byte(`["ls /",{"foo":"bar"}]`)
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.cloudConfig.bootcmd[1]: Invalid value: "{\"foo\":\"bar\"}": value must be a string or list of strings`))
+		})
+	})
+
+	When("The CloudConfig's apt proxy and source key are invalid", func() {
+		BeforeEach(func() {
+			cloudConfig.Apt.HTTPProxy.Value = ptr.To("http://proxy.example.com:3128/")
+			cloudConfig.Apt.Sources[0].Key.Value = nil
+		})
+		It("Should return two errors", func() {
+			Expect(errs).To(HaveLen(2))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`[spec.bootstrap.cloudInit.cloudConfig.apt.http_proxy.value: Invalid value: "value": from and value are mutually exclusive, ` +
+					`spec.bootstrap.cloudInit.cloudConfig.apt.sources[my-repo].key: Required value: either from or value must be provided]`))
+		})
+	})
+
+	When("The CloudConfig's yum repo does not have a URL", func() {
+		BeforeEach(func() {
+			cloudConfig.YumRepos[0].MirrorList = ""
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.cloudConfig.yum_repos[my-repo]: Required value: one of baseurl, metalink, or mirrorlist must be provided`))
+		})
+	})
+
+	When("The CloudConfig's disk partitions exceed the size of the disk", func() {
+		BeforeEach(func() {
+			cloudConfig.DiskSetup[0].Partitions[1].Percentage = 50
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.cloudConfig.disk_setup[/dev/sdb].partitions: Invalid value: 110: sum of partition percentages must not exceed 100`))
+		})
+	})
+
+	When("The CloudConfig's trusted CA certificate is empty", func() {
+		BeforeEach(func() {
+			cloudConfig.CACerts.Trusted[0].Value = nil
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.cloudConfig.ca_certs.trusted[0]: Required value: either from or value must be provided`))
+		})
+	})
+})
+
 var _ = Describe("Validate CloudConfigYAML", func() {
 	var (
 		err             error
@@ -152,3 +278,49 @@ var _ = Describe("Validate CloudConfigYAML", func() {
 		})
 	})
 })
+
+var _ = Describe("Validate CloudConfigSchema", func() {
+	var (
+		errs            field.ErrorList
+		cloudConfigYAML string
+	)
+
+	JustBeforeEach(func() {
+		errs = cloudinitvalidate.CloudConfigSchema(
+			field.NewPath("spec").Child("bootstrap", "cloudInit"),
+			cloudConfigYAML)
+	})
+
+	When("The CloudConfig is valid", func() {
+		BeforeEach(func() {
+			data, err := os.ReadFile("./testdata/valid-cloud-config-1.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			cloudConfigYAML = string(data)
+		})
+		It("Should not return any errors", func() {
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	When("A field has the wrong type", func() {
+		BeforeEach(func() {
+			cloudConfigYAML = "ntp:\n  servers: pool.ntp.org\n"
+		})
+		It("Should return an error for the field", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.cloudConfig.ntp.servers: Invalid value: "string": value must be of type array`))
+		})
+	})
+
+	When("A field in a list has the wrong type", func() {
+		BeforeEach(func() {
+			cloudConfigYAML = "write_files:\n- path: /etc/motd\n  content: [hello]\n"
+		})
+		It("Should return an error for the field", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.cloudConfig.write_files[0].content: Invalid value: "array": value must be of type string`))
+		})
+	})
+})
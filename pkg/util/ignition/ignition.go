@@ -0,0 +1,182 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package ignition
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	v3_0 "github.com/coreos/ignition/v2/config/v3_0"
+	v3_1 "github.com/coreos/ignition/v2/config/v3_1"
+	v3_2 "github.com/coreos/ignition/v2/config/v3_2"
+	v3_3 "github.com/coreos/ignition/v2/config/v3_3"
+	v3_4 "github.com/coreos/ignition/v2/config/v3_4"
+	v3_5 "github.com/coreos/ignition/v2/config/v3_5"
+	"github.com/coreos/vcontext/report"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+// SupportedVersions are the versions of the Ignition specification that may
+// be used to bootstrap a VM. Experimental versions are not supported.
+var SupportedVersions = []string{
+	"3.0.0",
+	"3.1.0",
+	"3.2.0",
+	"3.3.0",
+	"3.4.0",
+	"3.5.0",
+}
+
+var (
+	// ErrInvalidConfig is returned when the Ignition config is not a JSON
+	// object.
+	ErrInvalidConfig = errors.New("ignition config must be a JSON object")
+
+	// ErrMissingVersion is returned when the Ignition config does not specify
+	// the version of the Ignition specification to which it conforms.
+	ErrMissingVersion = errors.New("ignition config is missing ignition.version")
+)
+
+// Config describes the subset of an Ignition config from
+// https://coreos.github.io/ignition/specs/ used to merge the VM's
+// configuration into the config provided by the user.
+type Config struct {
+	Ignition Ignition `json:"ignition"`
+	Storage  *Storage `json:"storage,omitempty"`
+}
+
+type Ignition struct {
+	Version string          `json:"version"`
+	Config  *IgnitionConfig `json:"config,omitempty"`
+}
+
+type IgnitionConfig struct {
+	Merge []Resource `json:"merge,omitempty"`
+}
+
+type Resource struct {
+	Source *string `json:"source,omitempty"`
+}
+
+type Storage struct {
+	Files []File `json:"files,omitempty"`
+}
+
+type File struct {
+	Path      string   `json:"path"`
+	Overwrite *bool    `json:"overwrite,omitempty"`
+	Mode      *int     `json:"mode,omitempty"`
+	Contents  Resource `json:"contents"`
+}
+
+// ValidateConfig returns the version of the Ignition specification to which
+// the provided config conforms. An error is returned if the config is not a
+// JSON object, if its version is not one of the SupportedVersions, or if it
+// is not valid according to the specification for its version.
+func ValidateConfig(data []byte) (string, error) {
+	var config struct {
+		Ignition *struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	if config.Ignition == nil || config.Ignition.Version == "" {
+		return "", ErrMissingVersion
+	}
+
+	version := config.Ignition.Version
+	parse, ok := parsers[version]
+	if !ok {
+		return "", fmt.Errorf(
+			"unsupported ignition config version %q, must be one of: %s",
+			version, strings.Join(SupportedVersions, ", "))
+	}
+
+	if rpt, err := parse(data); err != nil {
+		if rpt.IsFatal() {
+			return "", fmt.Errorf("invalid ignition config: %w: %s", err, rpt.String())
+		}
+		return "", fmt.Errorf("invalid ignition config: %w", err)
+	}
+
+	return version, nil
+}
+
+// parsers are the functions that parse and validate a config for each of the
+// SupportedVersions.
+var parsers = map[string]func([]byte) (report.Report, error){
+	"3.0.0": func(data []byte) (report.Report, error) {
+		_, rpt, err := v3_0.Parse(data)
+		return rpt, err
+	},
+	"3.1.0": func(data []byte) (report.Report, error) {
+		_, rpt, err := v3_1.Parse(data)
+		return rpt, err
+	},
+	"3.2.0": func(data []byte) (report.Report, error) {
+		_, rpt, err := v3_2.Parse(data)
+		return rpt, err
+	},
+	"3.3.0": func(data []byte) (report.Report, error) {
+		_, rpt, err := v3_3.Parse(data)
+		return rpt, err
+	},
+	"3.4.0": func(data []byte) (report.Report, error) {
+		_, rpt, err := v3_4.Parse(data)
+		return rpt, err
+	},
+	"3.5.0": func(data []byte) (report.Report, error) {
+		_, rpt, err := v3_5.Parse(data)
+		return rpt, err
+	},
+}
+
+// MergeConfigs returns a config with the provided version that merges the
+// provided configs, in order. Each config is embedded as a data URL, so the
+// resulting config does not require the guest to fetch any remote resources.
+// Ignition merges a config after the ones that precede it, so the fields of
+// a latter config take precedence.
+func MergeConfigs(version string, configs ...[]byte) ([]byte, error) {
+	merge := make([]Resource, 0, len(configs))
+	for _, c := range configs {
+		merge = append(merge, Resource{
+			Source: DataURL(c),
+		})
+	}
+
+	return json.Marshal(Config{
+		Ignition: Ignition{
+			Version: version,
+			Config: &IgnitionConfig{
+				Merge: merge,
+			},
+		},
+	})
+}
+
+// NewFile returns a file that is written to the provided path with the
+// provided mode and contents, replacing any existing file.
+func NewFile(path string, mode int, contents string) File {
+	return File{
+		Path:      path,
+		Overwrite: ptr.To(true),
+		Mode:      &mode,
+		Contents: Resource{
+			Source: DataURL([]byte(contents)),
+		},
+	}
+}
+
+// DataURL returns a RFC 2397 data URL with the base64-encoded data.
+func DataURL(data []byte) *string {
+	return ptr.To("data:;base64," + base64.StdEncoding.EncodeToString(data))
+}
@@ -0,0 +1,99 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package ignition_test
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ignition"
+)
+
+var _ = Describe("ValidateConfig", func() {
+
+	DescribeTable("returns the version of a supported config",
+		func(version string) {
+			v, err := ignition.ValidateConfig([]byte(`{"ignition":{"version":"` + version + `"}}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v).To(Equal(version))
+		},
+		Entry("3.0.0", "3.0.0"),
+		Entry("3.3.0", "3.3.0"),
+		Entry("3.5.0", "3.5.0"),
+	)
+
+	It("returns an error when the config is not JSON", func() {
+		_, err := ignition.ValidateConfig([]byte("#cloud-config\n"))
+		Expect(err).To(MatchError(ignition.ErrInvalidConfig))
+	})
+
+	It("returns an error when the version is missing", func() {
+		_, err := ignition.ValidateConfig([]byte(`{"storage":{}}`))
+		Expect(err).To(MatchError(ignition.ErrMissingVersion))
+	})
+
+	DescribeTable("returns an error when the version is not supported",
+		func(version string) {
+			_, err := ignition.ValidateConfig([]byte(`{"ignition":{"version":"` + version + `"}}`))
+			Expect(err).To(MatchError(ContainSubstring(`unsupported ignition config version "` + version + `"`)))
+		},
+		Entry("spec v2", "2.3.0"),
+		Entry("experimental", "3.6.0-experimental"),
+	)
+
+	DescribeTable("returns an error when the config is not valid for its version",
+		func(config, reason string) {
+			_, err := ignition.ValidateConfig([]byte(config))
+			Expect(err).To(MatchError(ContainSubstring("invalid ignition config")))
+			Expect(err).To(MatchError(ContainSubstring(reason)))
+		},
+		Entry("relative file path",
+			`{"ignition":{"version":"3.3.0"},"storage":{"files":[{"path":"etc/hostname"}]}}`,
+			"path not absolute"),
+		Entry("field of the wrong type",
+			`{"ignition":{"version":"3.0.0"},"storage":{"files":"/etc/hostname"}}`,
+			"json: cannot unmarshal"),
+		Entry("unsupported source scheme",
+			`{"ignition":{"version":"3.5.0"},"storage":{"files":[{"path":"/etc/hostname","contents":{"source":"ftp://host/hostname"}}]}}`,
+			"invalid url scheme"),
+	)
+})
+
+var _ = Describe("MergeConfigs", func() {
+
+	It("returns a config that merges the configs in order", func() {
+		data, err := ignition.MergeConfigs("3.4.0", []byte("a"), []byte("b"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(
+			`{"ignition":{"version":"3.4.0","config":{"merge":[` +
+				`{"source":"data:;base64,` + base64.StdEncoding.EncodeToString([]byte("a")) + `"},` +
+				`{"source":"data:;base64,` + base64.StdEncoding.EncodeToString([]byte("b")) + `"}` +
+				`]}}}`))
+
+		v, err := ignition.ValidateConfig(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal("3.4.0"))
+	})
+})
+
+var _ = Describe("NewFile", func() {
+
+	It("returns a file that overwrites the path", func() {
+		f := ignition.NewFile("/etc/hostname", 0644, "my-vm\n")
+		Expect(f.Path).To(Equal("/etc/hostname"))
+		Expect(f.Overwrite).To(HaveValue(BeTrue()))
+		Expect(f.Mode).To(HaveValue(Equal(420)))
+		Expect(f.Contents.Source).ToNot(BeNil())
+
+		source := *f.Contents.Source
+		Expect(source).To(HavePrefix("data:;base64,"))
+		contents, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(source, "data:;base64,"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(contents)).To(Equal("my-vm\n"))
+	})
+})
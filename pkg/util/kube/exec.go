@@ -0,0 +1,104 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package kube
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// PodExecutor runs commands in the containers of Pods.
+type PodExecutor interface {
+	// Exec runs the command in the container of the Pod, copying stdin, if
+	// not nil, to the standard input of the command and the standard output
+	// of the command to stdout. The returned error includes the standard
+	// error of the command, and satisfies the k8s.io/client-go/util/exec
+	// ExitError interface if the command exited with a non-zero code.
+	Exec(
+		ctx context.Context,
+		namespace, name, container string,
+		command []string,
+		stdin io.Reader,
+		stdout io.Writer) error
+}
+
+// NewPodExecutor returns a PodExecutor that runs commands using the
+// pods/exec subresource of the API server described by config.
+func NewPodExecutor(config *rest.Config) (PodExecutor, error) {
+	client, err := corev1client.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &podExecutor{
+		config: config,
+		client: client.RESTClient(),
+	}, nil
+}
+
+type podExecutor struct {
+	config *rest.Config
+	client rest.Interface
+}
+
+func (e *podExecutor) Exec(
+	ctx context.Context,
+	namespace, name, container string,
+	command []string,
+	stdin io.Reader,
+	stdout io.Writer) error {
+
+	u := e.client.Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec).
+		URL()
+
+	// Like kubectl, prefer WebSockets and fall back to SPDY if the API server
+	// does not support them.
+	wsExec, err := remotecommand.NewWebSocketExecutor(e.config, "GET", u.String())
+	if err != nil {
+		return err
+	}
+	spdyExec, err := remotecommand.NewSPDYExecutor(e.config, "POST", u)
+	if err != nil {
+		return err
+	}
+	exec, err := remotecommand.NewFallbackExecutor(wsExec, spdyExec, httpstream.IsUpgradeFailure)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	if err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+
+	return nil
+}
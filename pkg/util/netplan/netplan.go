@@ -30,6 +30,20 @@ type Renderer = schema.Renderer
 
 type VLAN = schema.VLANConfig
 
+type Bond = schema.BondConfig
+
+type BondParameters = schema.BondParameters
+
+type BondMode = schema.BondMode
+
+type LACPRate = schema.LACPRate
+
+type TransmitHashPolicy = schema.TransmitHashPolicy
+
+type Bridge = schema.BridgeConfig
+
+type BridgeParameters = schema.BridgeParameters
+
 func MarshalYAML(in Config) ([]byte, error) {
 	return yaml.Marshal(in)
 }
@@ -0,0 +1,347 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/stream"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	// maxManifestSize is the maximum size of a blob read with GetBlobBytes.
+	maxManifestSize = 4 << 20
+)
+
+// ErrNotFound is returned when the requested manifest or blob does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Credentials are used to authenticate with a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Options are used to create a new Client.
+type Options struct {
+	// Credentials are used to authenticate with the registry, using either
+	// basic or token authentication as requested by the registry.
+	Credentials Credentials
+
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+}
+
+// Client pushes and pulls manifests and blobs using the OCI distribution
+// API.
+type Client struct {
+	auth      authn.Authenticator
+	transport http.RoundTripper
+}
+
+// NewClient returns a new Client.
+func NewClient(opts Options) *Client {
+	transport := remote.DefaultTransport.(*http.Transport).Clone()
+	if opts.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, //nolint:gosec
+		}
+	}
+
+	auth := authn.Anonymous
+	if opts.Credentials.Username != "" {
+		auth = authn.FromConfig(authn.AuthConfig{
+			Username: opts.Credentials.Username,
+			Password: opts.Credentials.Password,
+		})
+	}
+
+	return &Client{
+		auth:      auth,
+		transport: transport,
+	}
+}
+
+// PushBlob uploads the content read from r to the repository of ref and
+// returns its descriptor. The content is streamed to the registry and its
+// digest computed as it is read, so the size of the content does not need to
+// be known in advance.
+func (c *Client) PushBlob(
+	ctx context.Context,
+	ref Reference,
+	mediaType string,
+	r io.Reader) (Descriptor, error) {
+
+	repo, err := name.NewRepository(ref.Registry + "/" + ref.Repository)
+	if err != nil {
+		return Descriptor{}, err
+	}
+
+	layer := &streamLayer{
+		r:         r,
+		mediaType: types.MediaType(mediaType),
+	}
+
+	// The content cannot be read again, so a failed upload is not retried.
+	if err := remote.WriteLayer(
+		repo,
+		layer,
+		c.options(ctx, remote.WithRetryPredicate(func(error) bool { return false }))...); err != nil {
+
+		return Descriptor{}, wrapError(err)
+	}
+
+	digest, err := layer.Digest()
+	if err != nil {
+		return Descriptor{}, err
+	}
+	size, err := layer.Size()
+	if err != nil {
+		return Descriptor{}, err
+	}
+
+	return Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.String(),
+		Size:      size,
+	}, nil
+}
+
+// PushManifest uploads the manifest to the repository of ref, tagging it
+// with the tag of ref if set, and returns the digest of the manifest.
+func (c *Client) PushManifest(
+	ctx context.Context,
+	ref Reference,
+	manifest Manifest) (string, error) {
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	digest := Digest(data)
+
+	if ref.Tag == "" {
+		ref = ref.WithDigest(digest)
+	}
+
+	nameRef, err := name.ParseReference(ref.String())
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.Put(nameRef, rawManifest{
+		data:      data,
+		mediaType: types.MediaType(manifest.MediaType),
+	}, c.options(ctx)...); err != nil {
+		return "", wrapError(err)
+	}
+
+	return digest, nil
+}
+
+// GetManifest returns the manifest referred to by ref and its digest.
+func (c *Client) GetManifest(
+	ctx context.Context,
+	ref Reference) (Manifest, string, error) {
+
+	var manifest Manifest
+
+	nameRef, err := name.ParseReference(ref.String())
+	if err != nil {
+		return manifest, "", err
+	}
+
+	desc, err := remote.Get(nameRef, c.options(ctx)...)
+	if err != nil {
+		return manifest, "", wrapError(err)
+	}
+
+	if err := json.Unmarshal(desc.Manifest, &manifest); err != nil {
+		return manifest, "", fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+
+	return manifest, desc.Digest.String(), nil
+}
+
+// GetBlob returns a reader for the blob with the given digest in the
+// repository of ref, and the size of the blob. The content is verified
+// against the digest as it is read, and the reader returns an error at EOF if
+// the content does not match. The caller must close the reader.
+func (c *Client) GetBlob(
+	ctx context.Context,
+	ref Reference,
+	digest string) (io.ReadCloser, int64, error) {
+
+	if err := ValidateDigest(digest); err != nil {
+		return nil, 0, err
+	}
+
+	nameDigest, err := name.NewDigest(ref.Registry + "/" + ref.Repository + "@" + digest)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	layer, err := remote.Layer(nameDigest, c.options(ctx)...)
+	if err != nil {
+		return nil, 0, wrapError(err)
+	}
+
+	size, err := layer.Size()
+	if err != nil {
+		return nil, 0, wrapError(err)
+	}
+
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, 0, wrapError(err)
+	}
+
+	return rc, size, nil
+}
+
+// GetBlobBytes returns the content of a small blob, such as a config blob.
+func (c *Client) GetBlobBytes(
+	ctx context.Context,
+	ref Reference,
+	desc Descriptor) ([]byte, error) {
+
+	rc, _, err := c.GetBlob(ctx, ref, desc.Digest)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(io.LimitReader(rc, maxManifestSize))
+}
+
+func (c *Client) options(ctx context.Context, opts ...remote.Option) []remote.Option {
+	return append([]remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuth(c.auth),
+		remote.WithTransport(c.transport),
+	}, opts...)
+}
+
+// wrapError wraps the error returned by the registry for a manifest or blob
+// that does not exist with ErrNotFound.
+func wrapError(err error) error {
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
+
+// rawManifest is a manifest that has already been marshaled.
+type rawManifest struct {
+	data      []byte
+	mediaType types.MediaType
+}
+
+func (m rawManifest) RawManifest() ([]byte, error) {
+	return m.data, nil
+}
+
+func (m rawManifest) MediaType() (types.MediaType, error) {
+	return m.mediaType, nil
+}
+
+// streamLayer is a layer whose content is uploaded as it is read, without
+// being compressed. Like the layers of the stream package, its digest and size
+// are not known until its content has been read, and its content may only be
+// read once.
+type streamLayer struct {
+	r         io.Reader
+	mediaType types.MediaType
+
+	mu     sync.Mutex
+	read   bool
+	digest *v1.Hash
+	size   int64
+}
+
+var _ v1.Layer = &streamLayer{}
+
+func (l *streamLayer) Digest() (v1.Hash, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.digest == nil {
+		return v1.Hash{}, stream.ErrNotComputed
+	}
+	return *l.digest, nil
+}
+
+func (l *streamLayer) DiffID() (v1.Hash, error) {
+	return l.Digest()
+}
+
+func (l *streamLayer) Size() (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.digest == nil {
+		return 0, stream.ErrNotComputed
+	}
+	return l.size, nil
+}
+
+func (l *streamLayer) MediaType() (types.MediaType, error) {
+	return l.mediaType, nil
+}
+
+func (l *streamLayer) Compressed() (io.ReadCloser, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.read {
+		return nil, stream.ErrConsumed
+	}
+	l.read = true
+	return &streamLayerReader{l: l, h: sha256.New()}, nil
+}
+
+func (l *streamLayer) Uncompressed() (io.ReadCloser, error) {
+	return l.Compressed()
+}
+
+type streamLayerReader struct {
+	l *streamLayer
+	h hash.Hash
+	n int64
+}
+
+func (r *streamLayerReader) Read(p []byte) (int, error) {
+	n, err := r.l.r.Read(p)
+	r.h.Write(p[:n])
+	r.n += int64(n)
+	if errors.Is(err, io.EOF) {
+		r.l.mu.Lock()
+		r.l.digest = &v1.Hash{
+			Algorithm: "sha256",
+			Hex:       hex.EncodeToString(r.h.Sum(nil)),
+		}
+		r.l.size = r.n
+		r.l.mu.Unlock()
+	}
+	return n, err
+}
+
+func (r *streamLayerReader) Close() error {
+	return nil
+}
@@ -0,0 +1,182 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci/ocitest"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx      context.Context
+		registry *ocitest.Registry
+		opts     oci.Options
+		client   *oci.Client
+		ref      oci.Reference
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		registry = ocitest.NewRegistry()
+		opts = oci.Options{
+			InsecureSkipVerify: true,
+		}
+	})
+
+	JustBeforeEach(func() {
+		client = oci.NewClient(opts)
+
+		var err error
+		ref, err = oci.ParseReference(registry.Host() + "/project/my-image:v1")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		registry.Close()
+	})
+
+	pushAndPull := func() {
+		disk := bytes.Repeat([]byte("disk"), 1024)
+
+		diskDesc, err := client.PushBlob(ctx, ref, oci.MediaTypeDisk, bytes.NewReader(disk))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diskDesc.Digest).To(Equal(oci.Digest(disk)))
+		Expect(diskDesc.Size).To(BeEquivalentTo(len(disk)))
+		Expect(diskDesc.MediaType).To(Equal(oci.MediaTypeDisk))
+
+		configDesc, err := client.PushBlob(ctx, ref, oci.MediaTypeConfig, strings.NewReader(`{"name":"my-image"}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		manifest := oci.Manifest{
+			SchemaVersion: 2,
+			MediaType:     oci.MediaTypeImageManifest,
+			ArtifactType:  oci.ArtifactType,
+			Config:        configDesc,
+			Layers:        []oci.Descriptor{diskDesc},
+		}
+
+		digest, err := client.PushManifest(ctx, ref, manifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(oci.ValidateDigest(digest)).To(Succeed())
+
+		By("getting the manifest by tag", func() {
+			m, d, err := client.GetManifest(ctx, ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d).To(Equal(digest))
+			Expect(m).To(Equal(manifest))
+		})
+
+		By("getting the manifest by digest", func() {
+			m, d, err := client.GetManifest(ctx, ref.WithDigest(digest))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d).To(Equal(digest))
+			Expect(m).To(Equal(manifest))
+		})
+
+		By("getting the blobs", func() {
+			rc, size, err := client.GetBlob(ctx, ref, diskDesc.Digest)
+			Expect(err).ToNot(HaveOccurred())
+			defer rc.Close()
+			Expect(size).To(BeEquivalentTo(len(disk)))
+			Expect(io.ReadAll(rc)).To(Equal(disk))
+
+			data, err := client.GetBlobBytes(ctx, ref, configDesc)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`{"name":"my-image"}`))
+		})
+	}
+
+	When("the registry does not require authentication", func() {
+		It("pushes and pulls an artifact", pushAndPull)
+
+		It("returns ErrNotFound for a manifest that does not exist", func() {
+			_, _, err := client.GetManifest(ctx, ref)
+			Expect(err).To(MatchError(oci.ErrNotFound))
+		})
+
+		It("returns ErrNotFound for a blob that does not exist", func() {
+			_, _, err := client.GetBlob(ctx, ref, oci.Digest([]byte("missing")))
+			Expect(err).To(MatchError(oci.ErrNotFound))
+		})
+
+		It("returns an error if the manifest does not match the digest", func() {
+			_, err := client.PushManifest(ctx, ref, oci.Manifest{SchemaVersion: 2, MediaType: oci.MediaTypeImageManifest})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = client.GetManifest(ctx, ref.WithDigest(oci.Digest([]byte("other"))))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("TLS verification is enabled", func() {
+		BeforeEach(func() {
+			opts.InsecureSkipVerify = false
+		})
+
+		It("returns an error", func() {
+			_, _, err := client.GetManifest(ctx, ref)
+			Expect(err).To(HaveOccurred())
+			Expect(err).ToNot(MatchError(oci.ErrNotFound))
+		})
+	})
+
+	When("the registry requires basic authentication", func() {
+		BeforeEach(func() {
+			registry.Username = "user"
+			registry.Password = "pass"
+		})
+
+		When("the credentials are valid", func() {
+			BeforeEach(func() {
+				opts.Credentials = oci.Credentials{Username: "user", Password: "pass"}
+			})
+
+			It("pushes and pulls an artifact", pushAndPull)
+		})
+
+		When("the credentials are not provided", func() {
+			It("returns an error", func() {
+				_, _, err := client.GetManifest(ctx, ref)
+				Expect(err).To(MatchError(ContainSubstring("401")))
+			})
+		})
+	})
+
+	When("the registry requires token authentication", func() {
+		BeforeEach(func() {
+			registry.Username = "user"
+			registry.Password = "pass"
+			registry.Token = "my-token"
+		})
+
+		When("the credentials are valid", func() {
+			BeforeEach(func() {
+				opts.Credentials = oci.Credentials{Username: "user", Password: "pass"}
+			})
+
+			It("pushes and pulls an artifact", pushAndPull)
+		})
+
+		When("the credentials are invalid", func() {
+			BeforeEach(func() {
+				opts.Credentials = oci.Credentials{Username: "user", Password: "wrong"}
+			})
+
+			It("returns an error", func() {
+				_, _, err := client.GetManifest(ctx, ref)
+				Expect(err).To(MatchError(ContainSubstring("401")))
+			})
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CredentialsFromSecret returns the credentials for the registry from a
+// Secret of type kubernetes.io/dockerconfigjson, or from a Secret with the
+// keys username and password.
+func CredentialsFromSecret(secret corev1.Secret, registry string) (Credentials, error) {
+	if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+		return credentialsFromDockerConfig(data, registry)
+	}
+
+	if username, ok := secret.Data[corev1.BasicAuthUsernameKey]; ok {
+		return Credentials{
+			Username: string(username),
+			Password: string(secret.Data[corev1.BasicAuthPasswordKey]),
+		}, nil
+	}
+
+	return Credentials{}, fmt.Errorf(
+		"secret %s/%s does not contain registry credentials", secret.Namespace, secret.Name)
+}
+
+// GetCredentials returns the credentials for the registry from the named
+// Secret. Empty credentials are returned if name is empty.
+func GetCredentials(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	namespace, name, registry string) (Credentials, error) {
+
+	if name == "" {
+		return Credentials{}, nil
+	}
+
+	var secret corev1.Secret
+	if err := k8sClient.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+		return Credentials{}, err
+	}
+
+	return CredentialsFromSecret(secret, registry)
+}
+
+func credentialsFromDockerConfig(data []byte, registry string) (Credentials, error) {
+	var config struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Credentials{}, fmt.Errorf("failed to unmarshal docker config: %w", err)
+	}
+
+	for host, auth := range config.Auths {
+		host = strings.TrimPrefix(strings.TrimPrefix(host, "https://"), "http://")
+		host, _, _ = strings.Cut(host, "/")
+		if host != registry {
+			continue
+		}
+
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return Credentials{}, fmt.Errorf("failed to decode auth for %s: %w", registry, err)
+			}
+			username, password, _ := strings.Cut(string(decoded), ":")
+			return Credentials{Username: username, Password: password}, nil
+		}
+
+		return Credentials{Username: auth.Username, Password: auth.Password}, nil
+	}
+
+	return Credentials{}, fmt.Errorf("docker config does not contain credentials for %s", registry)
+}
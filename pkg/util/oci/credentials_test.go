@@ -0,0 +1,74 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci_test
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+)
+
+var _ = Describe("CredentialsFromSecret", func() {
+	var secret corev1.Secret
+
+	BeforeEach(func() {
+		secret = corev1.Secret{}
+		secret.Namespace = "my-namespace"
+		secret.Name = "my-secret"
+	})
+
+	When("the secret is a docker config", func() {
+		It("returns the credentials from the username and password", func() {
+			secret.Data = map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://registry.example.com":{"username":"user","password":"pass"}}}`),
+			}
+			creds, err := oci.CredentialsFromSecret(secret, "registry.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creds).To(Equal(oci.Credentials{Username: "user", Password: "pass"}))
+		})
+
+		It("returns the credentials from the auth", func() {
+			auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+			secret.Data = map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"auth":"` + auth + `"}}}`),
+			}
+			creds, err := oci.CredentialsFromSecret(secret, "registry.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creds).To(Equal(oci.Credentials{Username: "user", Password: "pass"}))
+		})
+
+		It("returns an error if the registry is not in the config", func() {
+			secret.Data = map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"other.example.com":{"username":"user"}}}`),
+			}
+			_, err := oci.CredentialsFromSecret(secret, "registry.example.com")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the secret is a basic auth secret", func() {
+		It("returns the credentials", func() {
+			secret.Data = map[string][]byte{
+				corev1.BasicAuthUsernameKey: []byte("user"),
+				corev1.BasicAuthPasswordKey: []byte("pass"),
+			}
+			creds, err := oci.CredentialsFromSecret(secret, "registry.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creds).To(Equal(oci.Credentials{Username: "user", Password: "pass"}))
+		})
+	})
+
+	When("the secret does not contain credentials", func() {
+		It("returns an error", func() {
+			_, err := oci.CredentialsFromSecret(secret, "registry.example.com")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,237 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MediaTypeImageManifest is the media type of an OCI image manifest.
+	MediaTypeImageManifest = "application/vnd.oci.image.manifest.v1+json"
+
+	// ArtifactType is the artifact type of a VM published as an OCI artifact.
+	ArtifactType = "application/vnd.vmware.vm-operator.vm.v1"
+
+	// MediaTypeConfig is the media type of the config blob of a VM published
+	// as an OCI artifact.
+	MediaTypeConfig = "application/vnd.vmware.vm-operator.vm.config.v1+json"
+
+	// MediaTypeOVF is the media type of the layer that contains the OVF
+	// descriptor of a VM published as an OCI artifact.
+	MediaTypeOVF = "application/vnd.vmware.vm-operator.ovf.v1+xml"
+
+	// MediaTypeDisk is the media type of a layer that contains a disk of a
+	// VM published as an OCI artifact. Disks are stored as stream-optimized
+	// VMDK files.
+	MediaTypeDisk = "application/vnd.vmware.vm-operator.disk.vmdk.v1"
+
+	// AnnotationTitle is the annotation used to record the name of the file
+	// stored in a layer.
+	AnnotationTitle = "org.opencontainers.image.title"
+
+	// AnnotationDescription is the annotation used to record the description
+	// of the artifact.
+	AnnotationDescription = "org.opencontainers.image.description"
+
+	// AnnotationPublishRequestUID is the annotation used to record the UID of
+	// the VirtualMachinePublishRequest that published the artifact.
+	AnnotationPublishRequestUID = "vmoperator.vmware.com/publish-request-uid"
+)
+
+// tagRegexp matches a valid tag as defined by the OCI distribution spec.
+var tagRegexp = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+
+// Descriptor describes the content of a blob or manifest.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest.
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// Config is the content of the config blob of a VM published as an OCI
+// artifact.
+type Config struct {
+	// Name is the name of the published image.
+	Name string `json:"name"`
+
+	// Description is the description of the published image.
+	Description string `json:"description,omitempty"`
+
+	// Source is the namespace/name of the VirtualMachine that was published.
+	Source string `json:"source,omitempty"`
+}
+
+// LayerByMediaType returns the first layer with the given media type.
+func (m Manifest) LayerByMediaType(mediaType string) (Descriptor, bool) {
+	for _, l := range m.Layers {
+		if l.MediaType == mediaType {
+			return l, true
+		}
+	}
+	return Descriptor{}, false
+}
+
+// LayerByTitle returns the layer with the given title annotation.
+func (m Manifest) LayerByTitle(title string) (Descriptor, bool) {
+	for _, l := range m.Layers {
+		if l.Annotations[AnnotationTitle] == title {
+			return l, true
+		}
+	}
+	return Descriptor{}, false
+}
+
+// Reference is a reference to a manifest in a registry, ex.
+// registry.example.com/project/image:tag or
+// registry.example.com/project/image@sha256:abc.
+type Reference struct {
+	// Registry is the host, and optionally the port, of the registry.
+	Registry string
+
+	// Repository is the name of the repository in the registry.
+	Repository string
+
+	// Tag is the tag of the manifest. Tag is ignored if Digest is set.
+	Tag string
+
+	// Digest is the digest of the manifest.
+	Digest string
+}
+
+// ParseReference parses a reference to a manifest in a registry. The registry
+// host must be included in the reference.
+func ParseReference(s string) (Reference, error) {
+	var ref Reference
+
+	registry, rest, ok := strings.Cut(s, "/")
+	if !ok || registry == "" || rest == "" {
+		return ref, fmt.Errorf("invalid reference %q: missing registry or repository", s)
+	}
+	if !strings.ContainsAny(registry, ".:") && registry != "localhost" {
+		return ref, fmt.Errorf("invalid reference %q: missing registry host", s)
+	}
+	ref.Registry = registry
+
+	if repo, digest, ok := strings.Cut(rest, "@"); ok {
+		if err := ValidateDigest(digest); err != nil {
+			return ref, fmt.Errorf("invalid reference %q: %w", s, err)
+		}
+		ref.Digest = digest
+		rest = repo
+	}
+
+	// A colon after the last slash separates the repository and the tag.
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "/") {
+		ref.Tag = rest[i+1:]
+		rest = rest[:i]
+		if ref.Tag == "" {
+			return ref, fmt.Errorf("invalid reference %q: empty tag", s)
+		}
+	}
+
+	if rest == "" || strings.HasPrefix(rest, "/") || strings.HasSuffix(rest, "/") {
+		return ref, fmt.Errorf("invalid reference %q: invalid repository", s)
+	}
+	if rest != strings.ToLower(rest) {
+		return ref, fmt.Errorf("invalid reference %q: repository must be lowercase", s)
+	}
+	ref.Repository = rest
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+
+	return ref, nil
+}
+
+// Identifier returns the digest of the reference if set, otherwise its tag.
+func (r Reference) Identifier() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+// WithDigest returns a copy of the reference that refers to the manifest with
+// the given digest.
+func (r Reference) WithDigest(digest string) Reference {
+	r.Tag = ""
+	r.Digest = digest
+	return r
+}
+
+// String returns the reference in its canonical form.
+func (r Reference) String() string {
+	s := r.Registry + "/" + r.Repository
+	if r.Digest != "" {
+		return s + "@" + r.Digest
+	}
+	if r.Tag != "" {
+		return s + ":" + r.Tag
+	}
+	return s
+}
+
+// Digest returns the sha256 digest of the provided data.
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// ValidateDigest returns an error if the provided value is not a valid sha256
+// digest.
+func ValidateDigest(digest string) error {
+	hexPart, ok := strings.CutPrefix(digest, "sha256:")
+	if !ok {
+		return fmt.Errorf("unsupported digest algorithm in %q", digest)
+	}
+	if len(hexPart) != sha256.Size*2 {
+		return fmt.Errorf("invalid digest length in %q", digest)
+	}
+	if _, err := hex.DecodeString(hexPart); err != nil {
+		return fmt.Errorf("invalid digest %q: %w", digest, err)
+	}
+	return nil
+}
+
+// ParseRepository parses a reference to a repository in a registry, ex.
+// registry.example.com/project/image, and returns a reference to the manifest
+// in the repository with the given tag. The tag defaults to "latest".
+func ParseRepository(repository, tag string) (Reference, error) {
+	ref, err := ParseReference(repository)
+	if err != nil {
+		return ref, err
+	}
+	if ref.Digest != "" || strings.HasSuffix(repository, ":"+ref.Tag) {
+		return ref, fmt.Errorf(
+			"invalid repository %q: must not include a tag or digest", repository)
+	}
+
+	if tag == "" {
+		tag = "latest"
+	}
+	if !tagRegexp.MatchString(tag) {
+		return ref, fmt.Errorf("invalid tag %q", tag)
+	}
+	ref.Tag = tag
+
+	return ref, nil
+}
@@ -0,0 +1,124 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package oci_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
+)
+
+var _ = Describe("ParseReference", func() {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	DescribeTable("valid references",
+		func(s string, expected oci.Reference, canonical string) {
+			ref, err := oci.ParseReference(s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ref).To(Equal(expected))
+			Expect(ref.String()).To(Equal(canonical))
+		},
+		Entry("tag",
+			"registry.example.com/project/image:v1",
+			oci.Reference{Registry: "registry.example.com", Repository: "project/image", Tag: "v1"},
+			"registry.example.com/project/image:v1"),
+		Entry("no tag",
+			"registry.example.com/image",
+			oci.Reference{Registry: "registry.example.com", Repository: "image", Tag: "latest"},
+			"registry.example.com/image:latest"),
+		Entry("port and tag",
+			"localhost:5000/image:v1",
+			oci.Reference{Registry: "localhost:5000", Repository: "image", Tag: "v1"},
+			"localhost:5000/image:v1"),
+		Entry("digest",
+			"registry.example.com/image@"+digest,
+			oci.Reference{Registry: "registry.example.com", Repository: "image", Digest: digest},
+			"registry.example.com/image@"+digest),
+		Entry("tag and digest",
+			"registry.example.com/image:v1@"+digest,
+			oci.Reference{Registry: "registry.example.com", Repository: "image", Tag: "v1", Digest: digest},
+			"registry.example.com/image@"+digest),
+	)
+
+	DescribeTable("invalid references",
+		func(s string) {
+			_, err := oci.ParseReference(s)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("no registry", "project/image:v1"),
+		Entry("no repository", "registry.example.com/"),
+		Entry("empty tag", "registry.example.com/image:"),
+		Entry("uppercase repository", "registry.example.com/Image"),
+		Entry("invalid digest", "registry.example.com/image@sha256:abc"),
+		Entry("unsupported digest", "registry.example.com/image@md5:abc"),
+	)
+})
+
+var _ = Describe("ParseRepository", func() {
+	DescribeTable("valid repositories",
+		func(repository, tag string, expected oci.Reference) {
+			ref, err := oci.ParseRepository(repository, tag)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ref).To(Equal(expected))
+		},
+		Entry("tag",
+			"registry.example.com/project/image", "v1",
+			oci.Reference{Registry: "registry.example.com", Repository: "project/image", Tag: "v1"}),
+		Entry("no tag",
+			"localhost:5000/image", "",
+			oci.Reference{Registry: "localhost:5000", Repository: "image", Tag: "latest"}),
+	)
+
+	DescribeTable("invalid repositories",
+		func(repository, tag string) {
+			_, err := oci.ParseRepository(repository, tag)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no registry", "project/image", "v1"),
+		Entry("repository with tag", "registry.example.com/image:v1", ""),
+		Entry("repository with latest tag", "registry.example.com/image:latest", ""),
+		Entry("repository with digest", "registry.example.com/image@sha256:"+strings.Repeat("a", 64), ""),
+		Entry("invalid tag", "registry.example.com/image", "-v1"),
+	)
+})
+
+var _ = Describe("Manifest", func() {
+	manifest := oci.Manifest{
+		Layers: []oci.Descriptor{
+			{
+				MediaType:   oci.MediaTypeOVF,
+				Digest:      "ovf",
+				Annotations: map[string]string{oci.AnnotationTitle: "image.ovf"},
+			},
+			{
+				MediaType:   oci.MediaTypeDisk,
+				Digest:      "disk",
+				Annotations: map[string]string{oci.AnnotationTitle: "disk-0.vmdk"},
+			},
+		},
+	}
+
+	It("returns layers by media type", func() {
+		l, ok := manifest.LayerByMediaType(oci.MediaTypeOVF)
+		Expect(ok).To(BeTrue())
+		Expect(l.Digest).To(Equal("ovf"))
+
+		_, ok = manifest.LayerByMediaType(oci.MediaTypeConfig)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("returns layers by title", func() {
+		l, ok := manifest.LayerByTitle("disk-0.vmdk")
+		Expect(ok).To(BeTrue())
+		Expect(l.Digest).To(Equal("disk"))
+
+		_, ok = manifest.LayerByTitle("disk-1.vmdk")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,94 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ocitest provides an in-memory OCI registry for tests.
+package ocitest
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+)
+
+// Registry is an in-memory registry that optionally requires basic or token
+// authentication.
+type Registry struct {
+	// Server is the TLS server of the registry.
+	Server *httptest.Server
+
+	// Username and Password, if set, are required for basic authentication.
+	// If Token is also set, then the registry requires token authentication
+	// and Username and Password are required to obtain the token.
+	Username string
+	Password string
+	Token    string
+
+	handler http.Handler
+}
+
+// NewRegistry starts and returns a new in-memory registry. The caller must
+// call Close when the registry is no longer needed.
+func NewRegistry() *Registry {
+	r := &Registry{
+		handler: registry.New(registry.Logger(log.New(io.Discard, "", 0))),
+	}
+	r.Server = httptest.NewTLSServer(r)
+	return r
+}
+
+// Host returns the host and port of the registry.
+func (r *Registry) Host() string {
+	u, _ := url.Parse(r.Server.URL)
+	return u.Host
+}
+
+// Close shuts down the registry.
+func (r *Registry) Close() {
+	r.Server.Close()
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		r.serveToken(w, req)
+		return
+	}
+
+	switch {
+	case r.Token != "":
+		if req.Header.Get("Authorization") != "Bearer "+r.Token {
+			w.Header().Set("WWW-Authenticate",
+				fmt.Sprintf(`Bearer realm="%s/token",service="ocitest"`, r.Server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	case r.Username != "":
+		if !r.validBasicAuth(req) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="ocitest"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	r.handler.ServeHTTP(w, req)
+}
+
+func (r *Registry) validBasicAuth(req *http.Request) bool {
+	u, p, ok := req.BasicAuth()
+	return ok && u == r.Username && p == r.Password
+}
+
+func (r *Registry) serveToken(w http.ResponseWriter, req *http.Request) {
+	if r.Token == "" || (r.Username != "" && !r.validBasicAuth(req)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprintf(w, `{"token":%q}`, r.Token)
+}
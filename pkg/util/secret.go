@@ -8,10 +8,19 @@ import (
 	"context"
 	"fmt"
 
+	vimtypes "github.com/vmware/govmomi/vim25/types"
 	corev1 "k8s.io/api/core/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// GuestCredentialsUsernameKey and GuestCredentialsPasswordKey are the keys
+	// of the username and password in a Secret that contains the credentials
+	// used to authenticate with a guest for VMware Tools guest operations.
+	GuestCredentialsUsernameKey = "username"
+	GuestCredentialsPasswordKey = "password"
+)
+
 func GetSecretData(
 	ctx context.Context,
 	k8sClient ctrlclient.Client,
@@ -44,3 +53,30 @@ func GetSecretResource(
 	}
 	return &secret, nil
 }
+
+// GetGuestCredentials returns the credentials used to authenticate with a
+// guest for VMware Tools guest operations from the Secret with the given name.
+// An error is returned if the Secret does not contain a username and password.
+func GetGuestCredentials(
+	ctx context.Context,
+	k8sClient ctrlclient.Reader,
+	secretNamespace, secretName string) (vimtypes.NamePasswordAuthentication, error) {
+
+	var secret corev1.Secret
+	key := ctrlclient.ObjectKey{Name: secretName, Namespace: secretNamespace}
+	if err := k8sClient.Get(ctx, key, &secret); err != nil {
+		return vimtypes.NamePasswordAuthentication{}, fmt.Errorf("failed to get guest credentials: %w", err)
+	}
+
+	auth := vimtypes.NamePasswordAuthentication{
+		Username: string(secret.Data[GuestCredentialsUsernameKey]),
+		Password: string(secret.Data[GuestCredentialsPasswordKey]),
+	}
+	if auth.Username == "" || auth.Password == "" {
+		return vimtypes.NamePasswordAuthentication{}, fmt.Errorf(
+			"secret %s must contain the keys %q and %q",
+			key, GuestCredentialsUsernameKey, GuestCredentialsPasswordKey)
+	}
+
+	return auth, nil
+}
@@ -0,0 +1,79 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+var _ = Describe("GetGuestCredentials", func() {
+	const (
+		namespace  = "my-namespace"
+		secretName = "my-guest-creds"
+	)
+
+	var (
+		ctx    context.Context
+		secret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      secretName,
+			},
+			Data: map[string][]byte{
+				pkgutil.GuestCredentialsUsernameKey: []byte("user"),
+				pkgutil.GuestCredentialsPasswordKey: []byte("pass"),
+			},
+		}
+	})
+
+	getGuestCredentials := func() (string, string, error) {
+		client := fake.NewClientBuilder().WithObjects(secret).Build()
+		auth, err := pkgutil.GetGuestCredentials(ctx, client, namespace, secretName)
+		return auth.Username, auth.Password, err
+	}
+
+	It("returns the username and password", func() {
+		username, password, err := getGuestCredentials()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(username).To(Equal("user"))
+		Expect(password).To(Equal("pass"))
+	})
+
+	When("the secret does not exist", func() {
+		BeforeEach(func() {
+			secret.Name = "other"
+		})
+
+		It("returns an error", func() {
+			_, _, err := getGuestCredentials()
+			Expect(err).To(MatchError(ContainSubstring("failed to get guest credentials")))
+		})
+	})
+
+	When("the secret does not have a password", func() {
+		BeforeEach(func() {
+			delete(secret.Data, pkgutil.GuestCredentialsPasswordKey)
+		})
+
+		It("returns an error", func() {
+			_, _, err := getGuestCredentials()
+			Expect(err).To(MatchError(ContainSubstring(`must contain the keys "username" and "password"`)))
+		})
+	})
+})
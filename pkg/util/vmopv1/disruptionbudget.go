@@ -0,0 +1,224 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+)
+
+// DisruptionBudgetExceededError is returned when disrupting a VM would
+// violate a VirtualMachineDisruptionBudget.
+type DisruptionBudgetExceededError struct {
+	BudgetName     string
+	VMName         string
+	CurrentHealthy int32
+	DesiredHealthy int32
+}
+
+func (e DisruptionBudgetExceededError) Error() string {
+	return fmt.Sprintf(
+		"disrupting vm %q would violate VirtualMachineDisruptionBudget %q "+
+			"which requires %d healthy vms but only %d are healthy",
+		e.VMName, e.BudgetName, e.DesiredHealthy, e.CurrentHealthy)
+}
+
+// IsVirtualMachineHealthy returns true if the VM counts towards the healthy
+// VMs of a VirtualMachineDisruptionBudget. A VM is healthy when it is ready and
+// is neither being deleted nor about to be powered off or suspended.
+func IsVirtualMachineHealthy(vm *vmopv1.VirtualMachine) bool {
+	if !vm.DeletionTimestamp.IsZero() {
+		return false
+	}
+
+	switch vm.Spec.PowerState {
+	case vmopv1.VirtualMachinePowerStateOff, vmopv1.VirtualMachinePowerStateSuspended:
+		return false
+	}
+
+	if c := conditions.Get(vm, vmopv1.ReadyConditionType); c != nil {
+		return c.Status == metav1.ConditionTrue
+	}
+
+	return conditions.IsTrue(vm, vmopv1.VirtualMachineConditionCreated) &&
+		vm.Status.PowerState == vmopv1.VirtualMachinePowerStateOn
+}
+
+// GetDisruptionBudgetSelector returns the label selector for the budget. A nil
+// selector selects nothing.
+func GetDisruptionBudgetSelector(
+	budget vmopv1.VirtualMachineDisruptionBudget) (labels.Selector, error) {
+
+	if budget.Spec.Selector == nil {
+		return labels.Nothing(), nil
+	}
+	return metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+}
+
+// GetDisruptionBudgetDesiredHealthy returns the minimum number of VMs that
+// must remain healthy out of the expected number of VMs selected by the budget.
+func GetDisruptionBudgetDesiredHealthy(
+	budget vmopv1.VirtualMachineDisruptionBudget,
+	expected int32) (int32, error) {
+
+	var desired int32
+
+	switch {
+	case budget.Spec.MaxUnavailable != nil:
+		n, err := intstr.GetScaledValueFromIntOrPercent(
+			budget.Spec.MaxUnavailable, int(expected), true)
+		if err != nil {
+			return 0, fmt.Errorf("invalid maxUnavailable: %w", err)
+		}
+		desired = expected - int32(n) //nolint:gosec // disable G115
+
+	case budget.Spec.MinAvailable != nil:
+		n, err := intstr.GetScaledValueFromIntOrPercent(
+			budget.Spec.MinAvailable, int(expected), true)
+		if err != nil {
+			return 0, fmt.Errorf("invalid minAvailable: %w", err)
+		}
+		desired = int32(n) //nolint:gosec // disable G115
+	}
+
+	return max(desired, 0), nil
+}
+
+type disruptionBudget struct {
+	name           string
+	selector       labels.Selector
+	vms            []*vmopv1.VirtualMachine
+	desiredHealthy int32
+}
+
+// DisruptionChecker checks whether operations initiated by VM Operator may
+// disrupt VMs without violating the VirtualMachineDisruptionBudgets in a
+// namespace. A single checker should be used for all of the VMs disrupted by
+// one operation so that each allowed disruption is accounted for when checking
+// the next VM.
+type DisruptionChecker struct {
+	budgets   []disruptionBudget
+	disrupted sets.Set[string]
+}
+
+// NewDisruptionChecker returns a DisruptionChecker for the budgets and VMs in
+// the provided namespace. The returned checker allows every disruption when
+// the VirtualMachineDisruptionBudget API is not enabled.
+func NewDisruptionChecker(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	namespace string) (*DisruptionChecker, error) {
+
+	c := &DisruptionChecker{
+		disrupted: sets.New[string](),
+	}
+
+	if !pkgcfg.FromContext(ctx).Features.K8sWorkloadMgmtAPI {
+		return c, nil
+	}
+
+	var budgetList vmopv1.VirtualMachineDisruptionBudgetList
+	if err := k8sClient.List(
+		ctx,
+		&budgetList,
+		ctrlclient.InNamespace(namespace)); err != nil {
+
+		return nil, fmt.Errorf("failed to list VirtualMachineDisruptionBudgets: %w", err)
+	}
+
+	if len(budgetList.Items) == 0 {
+		return c, nil
+	}
+
+	var vmList vmopv1.VirtualMachineList
+	if err := k8sClient.List(
+		ctx,
+		&vmList,
+		ctrlclient.InNamespace(namespace)); err != nil {
+
+		return nil, fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	for _, budget := range budgetList.Items {
+		if !budget.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		selector, err := GetDisruptionBudgetSelector(budget)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector for VirtualMachineDisruptionBudget %q: %w",
+				budget.Name, err)
+		}
+
+		b := disruptionBudget{
+			name:     budget.Name,
+			selector: selector,
+		}
+		for i := range vmList.Items {
+			if vm := &vmList.Items[i]; selector.Matches(labels.Set(vm.Labels)) {
+				b.vms = append(b.vms, vm)
+			}
+		}
+
+		b.desiredHealthy, err = GetDisruptionBudgetDesiredHealthy(
+			budget, int32(len(b.vms))) //nolint:gosec // disable G115
+		if err != nil {
+			return nil, fmt.Errorf("invalid VirtualMachineDisruptionBudget %q: %w",
+				budget.Name, err)
+		}
+
+		c.budgets = append(c.budgets, b)
+	}
+
+	return c, nil
+}
+
+// Disrupt returns a DisruptionBudgetExceededError if disrupting the VM would
+// violate any of the budgets that select it. Otherwise the disruption is
+// recorded so it is accounted for by subsequent checks, and nil is returned.
+//
+// Disrupting a VM that is not healthy is always allowed since it does not
+// change the number of healthy VMs.
+func (c *DisruptionChecker) Disrupt(vm *vmopv1.VirtualMachine) error {
+	if c.disrupted.Has(vm.Name) || !IsVirtualMachineHealthy(vm) {
+		return nil
+	}
+
+	for _, b := range c.budgets {
+		if !b.selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+
+		var currentHealthy int32
+		for _, bvm := range b.vms {
+			if !c.disrupted.Has(bvm.Name) && IsVirtualMachineHealthy(bvm) {
+				currentHealthy++
+			}
+		}
+
+		if currentHealthy-1 < b.desiredHealthy {
+			return DisruptionBudgetExceededError{
+				BudgetName:     b.name,
+				VMName:         vm.Name,
+				CurrentHealthy: currentHealthy,
+				DesiredHealthy: b.desiredHealthy,
+			}
+		}
+	}
+
+	c.disrupted.Insert(vm.Name)
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("GetDisruptionBudgetDesiredHealthy", func() {
+	DescribeTable("computes the desired healthy VMs",
+		func(minAvailable, maxUnavailable *intstr.IntOrString, expected, desired int32) {
+			budget := vmopv1.VirtualMachineDisruptionBudget{
+				Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+					MinAvailable:   minAvailable,
+					MaxUnavailable: maxUnavailable,
+				},
+			}
+			n, err := vmopv1util.GetDisruptionBudgetDesiredHealthy(budget, expected)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(desired))
+		},
+		Entry("minAvailable int", ptr.To(intstr.FromInt32(2)), nil, int32(3), int32(2)),
+		Entry("minAvailable percent rounds up", ptr.To(intstr.FromString("50%")), nil, int32(3), int32(2)),
+		Entry("maxUnavailable int", nil, ptr.To(intstr.FromInt32(1)), int32(3), int32(2)),
+		Entry("maxUnavailable percent rounds up", nil, ptr.To(intstr.FromString("50%")), int32(3), int32(1)),
+		Entry("maxUnavailable greater than expected", nil, ptr.To(intstr.FromInt32(5)), int32(3), int32(0)),
+	)
+
+	It("returns an error for an invalid percentage", func() {
+		budget := vmopv1.VirtualMachineDisruptionBudget{
+			Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+				MinAvailable: ptr.To(intstr.FromString("fifty")),
+			},
+		}
+		_, err := vmopv1util.GetDisruptionBudgetDesiredHealthy(budget, 3)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("DisruptionChecker", func() {
+	const namespace = "my-namespace"
+
+	var (
+		ctx         *builder.UnitTestContext
+		initObjects []ctrlclient.Object
+		budget      *vmopv1.VirtualMachineDisruptionBudget
+		vms         []*vmopv1.VirtualMachine
+		enabled     bool
+	)
+
+	newVM := func(name string, ready bool) *vmopv1.VirtualMachine {
+		vm := builder.DummyBasicVirtualMachine(name, namespace)
+		vm.Labels = map[string]string{"app": "db"}
+		if ready {
+			conditions.MarkTrue(vm, vmopv1.ReadyConditionType)
+		} else {
+			conditions.MarkFalse(vm, vmopv1.ReadyConditionType, "NotReady", "")
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		enabled = true
+		budget = &vmopv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-budget",
+				Namespace: namespace,
+			},
+			Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+				MinAvailable: ptr.To(intstr.FromInt32(2)),
+			},
+		}
+		vms = []*vmopv1.VirtualMachine{
+			newVM("vm-1", true),
+			newVM("vm-2", true),
+			newVM("vm-3", true),
+		}
+		initObjects = []ctrlclient.Object{budget}
+	})
+
+	JustBeforeEach(func() {
+		for _, vm := range vms {
+			initObjects = append(initObjects, vm)
+		}
+		ctx = builder.NewUnitTestContext(initObjects...)
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.Features.K8sWorkloadMgmtAPI = enabled
+		})
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		vms = nil
+	})
+
+	newChecker := func() *vmopv1util.DisruptionChecker {
+		c, err := vmopv1util.NewDisruptionChecker(ctx, ctx.Client, namespace)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	It("allows disruptions until the budget is exhausted", func() {
+		c := newChecker()
+		Expect(c.Disrupt(vms[0])).To(Succeed())
+		// Checking the same VM again does not count as another disruption.
+		Expect(c.Disrupt(vms[0])).To(Succeed())
+
+		err := c.Disrupt(vms[1])
+		Expect(err).To(Equal(vmopv1util.DisruptionBudgetExceededError{
+			BudgetName:     "my-budget",
+			VMName:         "vm-2",
+			CurrentHealthy: 2,
+			DesiredHealthy: 2,
+		}))
+	})
+
+	When("a VM is not healthy", func() {
+		BeforeEach(func() {
+			vms[2] = newVM("vm-3", false)
+		})
+
+		It("always allows disrupting the unhealthy VM", func() {
+			c := newChecker()
+			Expect(c.Disrupt(vms[2])).To(Succeed())
+			Expect(c.Disrupt(vms[0])).ToNot(Succeed())
+		})
+	})
+
+	When("the VM is not selected by the budget", func() {
+		BeforeEach(func() {
+			vms[0].Labels = map[string]string{"app": "web"}
+		})
+
+		It("allows the disruption", func() {
+			c := newChecker()
+			Expect(c.Disrupt(vms[0])).To(Succeed())
+		})
+	})
+
+	When("the feature is disabled", func() {
+		BeforeEach(func() {
+			enabled = false
+			budget.Spec.MinAvailable = ptr.To(intstr.FromInt32(3))
+		})
+
+		It("allows every disruption", func() {
+			c := newChecker()
+			for _, vm := range vms {
+				Expect(c.Disrupt(vm)).To(Succeed())
+			}
+		})
+	})
+})
@@ -0,0 +1,30 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"slices"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// GetNetworkPolicyTypes returns the directions of traffic to which the policy
+// applies. When the policy does not specify any, this is Ingress, and Egress
+// as well if the policy has any egress rules.
+func GetNetworkPolicyTypes(
+	policy vmopv1.VirtualMachineNetworkPolicy) []vmopv1.VirtualMachineNetworkPolicyType {
+
+	if len(policy.Spec.PolicyTypes) > 0 {
+		return slices.Clone(policy.Spec.PolicyTypes)
+	}
+
+	policyTypes := []vmopv1.VirtualMachineNetworkPolicyType{
+		vmopv1.VirtualMachineNetworkPolicyTypeIngress,
+	}
+	if len(policy.Spec.Egress) > 0 {
+		policyTypes = append(policyTypes, vmopv1.VirtualMachineNetworkPolicyTypeEgress)
+	}
+	return policyTypes
+}
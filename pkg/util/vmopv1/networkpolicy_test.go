@@ -0,0 +1,52 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+var _ = Describe("GetNetworkPolicyTypes", func() {
+	var policy vmopv1.VirtualMachineNetworkPolicy
+
+	BeforeEach(func() {
+		policy = vmopv1.VirtualMachineNetworkPolicy{}
+	})
+
+	When("the policy has no policy types or egress rules", func() {
+		It("returns Ingress", func() {
+			Expect(vmopv1util.GetNetworkPolicyTypes(policy)).To(HaveExactElements(
+				vmopv1.VirtualMachineNetworkPolicyTypeIngress))
+		})
+	})
+
+	When("the policy has egress rules", func() {
+		BeforeEach(func() {
+			policy.Spec.Egress = []vmopv1.VirtualMachineNetworkPolicyEgressRule{{}}
+		})
+		It("returns Ingress and Egress", func() {
+			Expect(vmopv1util.GetNetworkPolicyTypes(policy)).To(HaveExactElements(
+				vmopv1.VirtualMachineNetworkPolicyTypeIngress,
+				vmopv1.VirtualMachineNetworkPolicyTypeEgress))
+		})
+	})
+
+	When("the policy has policy types", func() {
+		BeforeEach(func() {
+			policy.Spec.PolicyTypes = []vmopv1.VirtualMachineNetworkPolicyType{
+				vmopv1.VirtualMachineNetworkPolicyTypeEgress,
+			}
+			policy.Spec.Ingress = []vmopv1.VirtualMachineNetworkPolicyIngressRule{{}}
+		})
+		It("returns the policy types", func() {
+			Expect(vmopv1util.GetNetworkPolicyTypes(policy)).To(HaveExactElements(
+				vmopv1.VirtualMachineNetworkPolicyTypeEgress))
+		})
+	})
+})
@@ -0,0 +1,45 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+)
+
+// HasReadinessProbe returns true if the VM's Ready condition is determined by
+// a readiness or startup probe.
+func HasReadinessProbe(vm vmopv1.VirtualMachine) bool {
+	if p := vm.Spec.ReadinessProbe; p != nil &&
+		(p.TCPSocket != nil || p.HTTPGet != nil || p.Exec != nil || p.GuestHeartbeat != nil || len(p.GuestInfo) != 0) {
+		return true
+	}
+	return HasStartupProbe(vm)
+}
+
+// HasStartupProbe returns true if the VM has a startup probe.
+func HasStartupProbe(vm vmopv1.VirtualMachine) bool {
+	p := vm.Spec.StartupProbe
+	return p != nil &&
+		(p.TCPSocket != nil || p.HTTPGet != nil || p.Exec != nil || p.GuestHeartbeat != nil || len(p.GuestInfo) != 0)
+}
+
+// RequiresPeriodicReadinessProbe returns true if the VM's readiness probe
+// must be run periodically by the prober manager instead of when the VM's
+// status is reconciled. This is the case for the TCP, HTTP and exec actions, as
+// well as any probe that uses thresholds, an initial delay, or is preceded by
+// a startup probe.
+func RequiresPeriodicReadinessProbe(vm vmopv1.VirtualMachine) bool {
+	if HasStartupProbe(vm) {
+		return true
+	}
+
+	p := vm.Spec.ReadinessProbe
+	if p == nil {
+		return false
+	}
+
+	return p.TCPSocket != nil || p.HTTPGet != nil || p.Exec != nil ||
+		p.InitialDelaySeconds > 0 || p.SuccessThreshold > 1 || p.FailureThreshold > 1
+}
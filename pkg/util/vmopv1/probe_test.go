@@ -0,0 +1,49 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+var _ = DescribeTable("HasReadinessProbe",
+	func(readiness *vmopv1.VirtualMachineReadinessProbeSpec, startup *vmopv1.VirtualMachineStartupProbeSpec, expected bool) {
+		vm := vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				ReadinessProbe: readiness,
+				StartupProbe:   startup,
+			},
+		}
+		Expect(vmopv1util.HasReadinessProbe(vm)).To(Equal(expected))
+	},
+	Entry("no probes", nil, nil, false),
+	Entry("readiness probe without action", &vmopv1.VirtualMachineReadinessProbeSpec{}, nil, false),
+	Entry("readiness probe", &vmopv1.VirtualMachineReadinessProbeSpec{GuestHeartbeat: &vmopv1.GuestHeartbeatAction{}}, nil, true),
+	Entry("startup probe", nil, &vmopv1.VirtualMachineStartupProbeSpec{GuestHeartbeat: &vmopv1.GuestHeartbeatAction{}}, true),
+)
+
+var _ = DescribeTable("RequiresPeriodicReadinessProbe",
+	func(readiness *vmopv1.VirtualMachineReadinessProbeSpec, startup *vmopv1.VirtualMachineStartupProbeSpec, expected bool) {
+		vm := vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				ReadinessProbe: readiness,
+				StartupProbe:   startup,
+			},
+		}
+		Expect(vmopv1util.RequiresPeriodicReadinessProbe(vm)).To(Equal(expected))
+	},
+	Entry("no probes", nil, nil, false),
+	Entry("heartbeat", &vmopv1.VirtualMachineReadinessProbeSpec{GuestHeartbeat: &vmopv1.GuestHeartbeatAction{}}, nil, false),
+	Entry("tcp", &vmopv1.VirtualMachineReadinessProbeSpec{TCPSocket: &vmopv1.TCPSocketAction{}}, nil, true),
+	Entry("http", &vmopv1.VirtualMachineReadinessProbeSpec{HTTPGet: &vmopv1.HTTPGetAction{}}, nil, true),
+	Entry("exec", &vmopv1.VirtualMachineReadinessProbeSpec{Exec: &vmopv1.ExecAction{}}, nil, true),
+	Entry("heartbeat with threshold", &vmopv1.VirtualMachineReadinessProbeSpec{GuestHeartbeat: &vmopv1.GuestHeartbeatAction{}, FailureThreshold: 3}, nil, true),
+	Entry("heartbeat with initial delay", &vmopv1.VirtualMachineReadinessProbeSpec{GuestHeartbeat: &vmopv1.GuestHeartbeatAction{}, InitialDelaySeconds: 10}, nil, true),
+	Entry("startup probe", nil, &vmopv1.VirtualMachineStartupProbeSpec{GuestInfo: []vmopv1.GuestInfoAction{{Key: "ready"}}}, true),
+)
@@ -35,6 +35,7 @@ import (
 const (
 	vmiKind           = "VirtualMachineImage"
 	cvmiKind          = "Cluster" + vmiKind
+	vmSnapshotKind    = "VirtualMachineSnapshot"
 	imgNotFoundFormat = "no VM image exists for %q in namespace or cluster scope"
 )
 
@@ -239,6 +240,30 @@ func IsImagelessVM(vm vmopv1.VirtualMachine) bool {
 	return vm.Spec.Image == nil && vm.Spec.ImageName == ""
 }
 
+// IsClonedVM returns true if the provided VM is deployed by cloning another
+// VM or VM snapshot instead of a VM image.
+func IsClonedVM(vm vmopv1.VirtualMachine) bool {
+	if vm.Spec.Image == nil {
+		return false
+	}
+	switch vm.Spec.Image.Kind {
+	case vmKind, vmSnapshotKind:
+		return true
+	}
+	return false
+}
+
+// HasPVCVolumes returns true if the provided VM has any PVC volumes that are
+// not instance storage volumes.
+func HasPVCVolumes(vm vmopv1.VirtualMachine) bool {
+	for _, vol := range vm.Spec.Volumes {
+		if pvc := vol.PersistentVolumeClaim; pvc != nil && pvc.InstanceVolumeClaim == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ImageRefsEqual returns true if the two image refs match.
 func ImageRefsEqual(ref1, ref2 *vmopv1.VirtualMachineImageRef) bool {
 	if ref1 == nil && ref2 == nil {
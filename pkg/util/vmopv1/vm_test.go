@@ -479,6 +479,95 @@ var _ = DescribeTable("IsClasslessVM",
 	),
 )
 
+var _ = DescribeTable("IsClonedVM",
+	func(
+		vm vmopv1.VirtualMachine,
+		expected bool,
+	) {
+		Ω(vmopv1util.IsClonedVM(vm)).Should(Equal(expected))
+	},
+	Entry(
+		"spec.image is nil",
+		vmopv1.VirtualMachine{},
+		false,
+	),
+	Entry(
+		"spec.image.kind is VirtualMachineImage",
+		vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Image: &vmopv1.VirtualMachineImageRef{Kind: "VirtualMachineImage"},
+			},
+		},
+		false,
+	),
+	Entry(
+		"spec.image.kind is VirtualMachine",
+		vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Image: &vmopv1.VirtualMachineImageRef{Kind: "VirtualMachine"},
+			},
+		},
+		true,
+	),
+	Entry(
+		"spec.image.kind is VirtualMachineSnapshot",
+		vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Image: &vmopv1.VirtualMachineImageRef{Kind: "VirtualMachineSnapshot"},
+			},
+		},
+		true,
+	),
+)
+
+var _ = DescribeTable("HasPVCVolumes",
+	func(
+		vm vmopv1.VirtualMachine,
+		expected bool,
+	) {
+		Ω(vmopv1util.HasPVCVolumes(vm)).Should(Equal(expected))
+	},
+	Entry(
+		"no volumes",
+		vmopv1.VirtualMachine{},
+		false,
+	),
+	Entry(
+		"instance storage volume",
+		vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Volumes: []vmopv1.VirtualMachineVolume{
+					{
+						Name: "instance",
+						VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+							PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{
+								InstanceVolumeClaim: &vmopv1.InstanceVolumeClaimVolumeSource{},
+							},
+						},
+					},
+				},
+			},
+		},
+		false,
+	),
+	Entry(
+		"PVC volume",
+		vmopv1.VirtualMachine{
+			Spec: vmopv1.VirtualMachineSpec{
+				Volumes: []vmopv1.VirtualMachineVolume{
+					{
+						Name: "data",
+						VirtualMachineVolumeSource: vmopv1.VirtualMachineVolumeSource{
+							PersistentVolumeClaim: &vmopv1.PersistentVolumeClaimVolumeSource{},
+						},
+					},
+				},
+			},
+		},
+		true,
+	),
+)
+
 var _ = DescribeTable("IsImageLessVM",
 	func(
 		vm vmopv1.VirtualMachine,
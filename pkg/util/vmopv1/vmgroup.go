@@ -295,6 +295,66 @@ func RetrieveVMGroupMembers(ctx context.Context, c ctrlclient.Client,
 	return vmSet, nil
 }
 
+// RetrieveVMGroupBootOrder retrieves all the group linked VMs under a VM group
+// recursively, in the group's boot order. Each element of the returned slice
+// contains the names of the VMs in a boot order group, with the boot orders
+// of nested groups flattened into the boot order of their parent group, i.e.
+// the VMs in the first boot order group of a nested group are in the same
+// element as the nested group itself.
+// An error is returned if a loop is detected among nested groups.
+func RetrieveVMGroupBootOrder(ctx context.Context, c ctrlclient.Client,
+	vmGroupKey ctrlclient.ObjectKey, visitedGroups *sets.Set[string]) ([][]string, error) {
+	if visitedGroups.Has(vmGroupKey.Name) {
+		return nil, fmt.Errorf("a loop is detected among groups: %q visited", vmGroupKey.Name)
+	}
+	visitedGroups.Insert(vmGroupKey.Name)
+
+	vmGroup := &vmopv1.VirtualMachineGroup{}
+	if err := c.Get(ctx, vmGroupKey, vmGroup); err != nil {
+		return nil, err
+	}
+
+	linked := sets.New[string]()
+	for _, member := range vmGroup.Status.Members {
+		if conditions.IsTrue(&member, vmopv1.VirtualMachineGroupMemberConditionGroupLinked) {
+			linked.Insert(member.Kind + "/" + member.Name)
+		}
+	}
+
+	var bootOrder [][]string
+	add := func(i int, vmNames ...string) {
+		for len(bootOrder) <= i {
+			bootOrder = append(bootOrder, nil)
+		}
+		bootOrder[i] = append(bootOrder[i], vmNames...)
+	}
+
+	for i, bootOrderGroup := range vmGroup.Spec.BootOrder {
+		for _, member := range bootOrderGroup.Members {
+			if !linked.Has(member.Kind + "/" + member.Name) {
+				continue
+			}
+
+			switch member.Kind {
+			case vmKind:
+				add(i, member.Name)
+			case vmgKind:
+				childBootOrder, err := RetrieveVMGroupBootOrder(ctx, c, ctrlclient.ObjectKey{Namespace: vmGroupKey.Namespace, Name: member.Name}, visitedGroups)
+				if err != nil {
+					return nil, err
+				}
+				for j, vmNames := range childBootOrder {
+					add(i+j, vmNames...)
+				}
+			default:
+				return nil, fmt.Errorf("VM group %q spec has a member with unknown kind: %q", vmGroup.Name, member.Kind)
+			}
+		}
+	}
+
+	return bootOrder, nil
+}
+
 // UpdateGroupLinkedCondition updates the group linked condition for a member.
 // If the member has no group name, the group linked condition is deleted.
 func UpdateGroupLinkedCondition(
@@ -211,6 +211,113 @@ var _ = Describe("Validating RetrieveVMGroupMembers",
 
 	})
 
+var _ = Describe("RetrieveVMGroupBootOrder",
+	Label(
+		testlabels.API,
+	),
+	func() {
+		var (
+			ctx           *builder.UnitTestContext
+			vmGroup       *vmopv1.VirtualMachineGroup
+			vmChildGroup  *vmopv1.VirtualMachineGroup
+			visitedGroups *sets.Set[string]
+		)
+
+		linked := func(members ...vmopv1.GroupMember) []vmopv1.VirtualMachineGroupMemberStatus {
+			var statuses []vmopv1.VirtualMachineGroupMemberStatus
+			for _, m := range members {
+				ms := vmopv1.VirtualMachineGroupMemberStatus{Name: m.Name, Kind: m.Kind}
+				conditions.MarkTrue(&ms, vmopv1.VirtualMachineGroupMemberConditionGroupLinked)
+				statuses = append(statuses, ms)
+			}
+			return statuses
+		}
+
+		BeforeEach(func() {
+			vmGroup = &vmopv1.VirtualMachineGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: builder.DummyVMGroupName,
+				},
+			}
+			vmChildGroup = &vmopv1.VirtualMachineGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: builder.DummyVMGroupName + "-child",
+				},
+			}
+			visitedGroups = &sets.Set[string]{}
+		})
+
+		JustBeforeEach(func() {
+			ctx = builder.NewUnitTestContext(vmGroup, vmChildGroup)
+
+			Expect(ctx.Client.Status().Update(ctx, vmGroup)).Should(Succeed())
+			Expect(ctx.Client.Status().Update(ctx, vmChildGroup)).Should(Succeed())
+		})
+
+		AfterEach(func() {
+			ctx = nil
+			vmGroup = nil
+			vmChildGroup = nil
+			visitedGroups = nil
+		})
+
+		When("the group has nested groups", func() {
+			BeforeEach(func() {
+				vm0 := vmopv1.GroupMember{Name: "vm-0", Kind: "VirtualMachine"}
+				vm1 := vmopv1.GroupMember{Name: "vm-1", Kind: "VirtualMachine"}
+				vm2 := vmopv1.GroupMember{Name: "vm-2", Kind: "VirtualMachine"}
+				vm3 := vmopv1.GroupMember{Name: "vm-3", Kind: "VirtualMachine"}
+				vm4 := vmopv1.GroupMember{Name: "vm-4", Kind: "VirtualMachine"}
+				child := vmopv1.GroupMember{Name: vmChildGroup.Name, Kind: "VirtualMachineGroup"}
+
+				vmGroup.Spec.BootOrder = []vmopv1.VirtualMachineGroupBootOrderGroup{
+					{Members: []vmopv1.GroupMember{vm0, child}},
+					{Members: []vmopv1.GroupMember{vm1, vm4}},
+				}
+				vmGroup.Status.Members = linked(vm0, child, vm1)
+
+				vmChildGroup.Spec.BootOrder = []vmopv1.VirtualMachineGroupBootOrderGroup{
+					{Members: []vmopv1.GroupMember{vm2}},
+					{Members: []vmopv1.GroupMember{vm3}},
+				}
+				vmChildGroup.Status.Members = linked(vm2, vm3)
+			})
+
+			It("should return the linked VMs in the flattened boot order", func() {
+				bootOrder, err := vmopv1util.RetrieveVMGroupBootOrder(ctx, ctx.Client,
+					ctrlclient.ObjectKeyFromObject(vmGroup), visitedGroups)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bootOrder).To(HaveLen(2))
+				Expect(bootOrder[0]).To(ConsistOf("vm-0", "vm-2"))
+				Expect(bootOrder[1]).To(ConsistOf("vm-1", "vm-3"))
+			})
+		})
+
+		When("there is a loop among nested groups", func() {
+			BeforeEach(func() {
+				child := vmopv1.GroupMember{Name: vmChildGroup.Name, Kind: "VirtualMachineGroup"}
+				parent := vmopv1.GroupMember{Name: vmGroup.Name, Kind: "VirtualMachineGroup"}
+
+				vmGroup.Spec.BootOrder = []vmopv1.VirtualMachineGroupBootOrderGroup{
+					{Members: []vmopv1.GroupMember{child}},
+				}
+				vmGroup.Status.Members = linked(child)
+
+				vmChildGroup.Spec.BootOrder = []vmopv1.VirtualMachineGroupBootOrderGroup{
+					{Members: []vmopv1.GroupMember{parent}},
+				}
+				vmChildGroup.Status.Members = linked(parent)
+			})
+
+			It("should return an error", func() {
+				_, err := vmopv1util.RetrieveVMGroupBootOrder(ctx, ctx.Client,
+					ctrlclient.ObjectKeyFromObject(vmGroup), visitedGroups)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("a loop is detected"))
+			})
+		})
+	})
+
 var _ = Describe("UpdateGroupLinkedCondition",
 	Label(
 		testlabels.EnvTest,
@@ -48,7 +48,7 @@ func getPolicyEvaluationResults(
 		imageName   string
 		imageLabels map[string]string
 	)
-	if vm.Spec.Image != nil {
+	if vm.Spec.Image != nil && !vmopv1util.IsClonedVM(*vm) {
 		img, err := vmopv1util.GetImage(
 			ctx, k8sClient, *vm.Spec.Image, vm.Namespace)
 		if err != nil {
@@ -0,0 +1,390 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package serialport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig/virtualcontroller"
+)
+
+const (
+	// PortMin is the first port on the ESXi host used by the network-backed
+	// serial ports of VMs.
+	PortMin = 50000
+
+	// PortCount is the number of ports on the ESXi host that may be used by
+	// the network-backed serial ports of VMs.
+	PortCount = 10000
+
+	// serviceURIPrefix is the prefix of the service URI of the serial ports
+	// managed by this reconciler. The ESXi host listens for a connection to
+	// the serial port on the port that follows the prefix.
+	serviceURIPrefix = "tcp://:"
+
+	// FirewallRulesetID is the ID of the firewall ruleset on the ESXi host
+	// that allows connections to the network-backed serial ports of VMs.
+	FirewallRulesetID = "remoteSerialPort"
+
+	// portReservationTTL is how long a port allocated to a VM is reserved
+	// for the VM, giving the annotation that records the port time to be
+	// observed when the ports of the other VMs are listed.
+	portReservationTTL = 10 * time.Minute
+)
+
+// ErrNoPortAvailable is returned when all of the ports that may be used by
+// the serial consoles of VMs are in use.
+var ErrNoPortAvailable = errors.New("no serial console port is available")
+
+type portReservation struct {
+	uid       types.UID
+	expiresAt time.Time
+}
+
+var (
+	portReservationsMu sync.Mutex
+	portReservations   = map[int32]portReservation{}
+)
+
+type reconciler struct{}
+
+var _ vmconfig.Reconciler = reconciler{}
+
+func New() vmconfig.Reconciler {
+	return reconciler{}
+}
+
+func (r reconciler) Name() string {
+	return "serialport"
+}
+
+func (r reconciler) OnResult(
+	_ context.Context,
+	_ *vmopv1.VirtualMachine,
+	_ mo.VirtualMachine,
+	_ error) error {
+
+	return nil
+}
+
+// Reconcile configures the network-backed serial port used as the VM's serial
+// console.
+func Reconcile(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vimClient *vim25.Client,
+	vm *vmopv1.VirtualMachine,
+	moVM mo.VirtualMachine,
+	configSpec *vimtypes.VirtualMachineConfigSpec) error {
+
+	return New().Reconcile(ctx, k8sClient, vimClient, vm, moVM, configSpec)
+}
+
+func (r reconciler) Reconcile(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vimClient *vim25.Client,
+	vm *vmopv1.VirtualMachine,
+	moVM mo.VirtualMachine,
+	configSpec *vimtypes.VirtualMachineConfigSpec) error {
+
+	if ctx == nil {
+		panic("context is nil")
+	}
+	if k8sClient == nil {
+		panic("k8sClient is nil")
+	}
+	if vimClient == nil {
+		panic("vimClient is nil")
+	}
+	if vm == nil {
+		panic("vm is nil")
+	}
+	if configSpec == nil {
+		panic("configSpec is nil")
+	}
+
+	if !pkgcfg.FromContext(ctx).Features.VMSerialConsole {
+		return nil
+	}
+
+	var devices []vimtypes.BaseVirtualDevice
+	if moVM.Config != nil {
+		devices = moVM.Config.Hardware.Device
+	}
+
+	var (
+		enabled = IsEnabled(vm)
+		curDev  = FindDevice(devices)
+		devSpec *vimtypes.VirtualDeviceConfigSpec
+	)
+
+	switch {
+	case enabled && curDev == nil:
+		port, err := AllocatePort(ctx, k8sClient, vm)
+		if err != nil {
+			return err
+		}
+		var newDeviceKey int32
+		virtualcontroller.InitDeviceKey(&newDeviceKey, configSpec.DeviceChange)
+		devSpec = &vimtypes.VirtualDeviceConfigSpec{
+			Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+			Device:    NewDevice(newDeviceKey-1, port),
+		}
+	case !enabled && curDev != nil:
+		devSpec = &vimtypes.VirtualDeviceConfigSpec{
+			Operation: vimtypes.VirtualDeviceConfigSpecOperationRemove,
+			Device:    curDev,
+		}
+	case curDev != nil:
+		// Record the port of a serial console that was added before its
+		// port was recorded on the VM.
+		if port, ok := DevicePort(curDev); ok {
+			vm.SetAnnotation(
+				vmopv1.SerialConsolePortAnnotation,
+				strconv.Itoa(int(port)))
+		}
+		return nil
+	default:
+		// The serial console was removed, so its port may be allocated to
+		// another VM.
+		delete(vm.Annotations, vmopv1.SerialConsolePortAnnotation)
+		return nil
+	}
+
+	// Serial ports may not be added to or removed from a VM that is powered
+	// on or suspended.
+	switch moVM.Runtime.PowerState {
+	case vimtypes.VirtualMachinePowerStatePoweredOn,
+		vimtypes.VirtualMachinePowerStateSuspended:
+
+		pkglog.FromContextOrDefault(ctx).V(4).Info(
+			"Skipping serial port change since VM is not powered off",
+			"operation", devSpec.Operation,
+			"powerState", moVM.Runtime.PowerState)
+		return nil
+	}
+
+	pkglog.FromContextOrDefault(ctx).V(4).Info(
+		"Device change generated by serialport",
+		"operation", devSpec.Operation)
+
+	configSpec.DeviceChange = append(configSpec.DeviceChange, devSpec)
+
+	return nil
+}
+
+// IsEnabled returns true if the VM's serial console is enabled.
+func IsEnabled(vm *vmopv1.VirtualMachine) bool {
+	return vm.Spec.Hardware != nil &&
+		vm.Spec.Hardware.SerialConsole != nil &&
+		vm.Spec.Hardware.SerialConsole.Enabled
+}
+
+// Port returns the preferred port on the ESXi host on which the VM's serial
+// console listens for connections. The port is derived from the VM's UID, and
+// is the first port considered when a port is allocated to the VM.
+func Port(vm *vmopv1.VirtualMachine) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(vm.UID))
+	return PortMin + int32(h.Sum32()%PortCount) //nolint:gosec // disable G115
+}
+
+// AnnotatedPort returns the port recorded on the VM, and false if the VM does
+// not have a valid recorded port.
+func AnnotatedPort(vm *vmopv1.VirtualMachine) (int32, bool) {
+	v, ok := vm.Annotations[vmopv1.SerialConsolePortAnnotation]
+	if !ok {
+		return 0, false
+	}
+	port, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || port < PortMin || port >= PortMin+PortCount {
+		return 0, false
+	}
+	return int32(port), true
+}
+
+// AllocatePort returns the port on the ESXi host on which the VM's serial
+// console listens for connections. The port is not used by the serial
+// console of any other VM, so the port is unique on whichever host the VM
+// runs. The port is recorded on the VM with the
+// vmoperator.vmware.com/serial-console-port annotation.
+func AllocatePort(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm *vmopv1.VirtualMachine) (int32, error) {
+
+	portReservationsMu.Lock()
+	defer portReservationsMu.Unlock()
+
+	var list vmopv1.VirtualMachineList
+	if err := k8sClient.List(ctx, &list); err != nil {
+		return 0, fmt.Errorf("failed to list vms: %w", err)
+	}
+
+	used := map[int32]struct{}{}
+	for i := range list.Items {
+		if list.Items[i].UID == vm.UID {
+			continue
+		}
+		if port, ok := AnnotatedPort(&list.Items[i]); ok {
+			used[port] = struct{}{}
+		}
+	}
+
+	now := time.Now()
+	for port, r := range portReservations {
+		switch {
+		case now.After(r.expiresAt):
+			delete(portReservations, port)
+		case r.uid != vm.UID:
+			used[port] = struct{}{}
+		}
+	}
+
+	// Keep the recorded port unless it was copied from another VM, ex. when
+	// the VM was restored.
+	if port, ok := AnnotatedPort(vm); ok {
+		if _, ok := used[port]; !ok {
+			return reservePort(vm, port, now), nil
+		}
+	}
+
+	start := Port(vm) - PortMin
+	for i := int32(0); i < PortCount; i++ {
+		port := PortMin + (start+i)%PortCount
+		if _, ok := used[port]; !ok {
+			return reservePort(vm, port, now), nil
+		}
+	}
+
+	return 0, ErrNoPortAvailable
+}
+
+// reservePort reserves the port for the VM and records it on the VM. The
+// caller must hold portReservationsMu.
+func reservePort(vm *vmopv1.VirtualMachine, port int32, now time.Time) int32 {
+	portReservations[port] = portReservation{
+		uid:       vm.UID,
+		expiresAt: now.Add(portReservationTTL),
+	}
+	vm.SetAnnotation(vmopv1.SerialConsolePortAnnotation, strconv.Itoa(int(port)))
+	return port
+}
+
+// AllowedHosts returns the hosts that may connect to the serial consoles on
+// an ESXi host from the comma-delimited list of IP addresses and networks in
+// CIDR notation, ex. "192.168.0.10,10.0.0.0/24".
+func AllowedHosts(networks string) (*vimtypes.HostFirewallRulesetIpList, error) {
+	var allowed vimtypes.HostFirewallRulesetIpList
+	for _, s := range strings.Split(networks, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if strings.Contains(s, "/") {
+			ip, ipNet, err := net.ParseCIDR(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid serial console network %q: %w", s, err)
+			}
+			ones, _ := ipNet.Mask.Size()
+			allowed.IpNetwork = append(allowed.IpNetwork, vimtypes.HostFirewallRulesetIpNetwork{
+				Network:      ip.Mask(ipNet.Mask).String(),
+				PrefixLength: int32(ones), //nolint:gosec // disable G115
+			})
+			continue
+		}
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid serial console address %q", s)
+		}
+		allowed.IpAddress = append(allowed.IpAddress, ip.String())
+	}
+	if len(allowed.IpAddress) == 0 && len(allowed.IpNetwork) == 0 {
+		return nil, errors.New("no serial console networks are allowed")
+	}
+	return &allowed, nil
+}
+
+// NewDevice returns a new serial port with the provided device key that is
+// backed by a network server listening on the provided port.
+func NewDevice(key, port int32) *vimtypes.VirtualSerialPort {
+	return &vimtypes.VirtualSerialPort{
+		VirtualDevice: vimtypes.VirtualDevice{
+			Key: key,
+			Backing: &vimtypes.VirtualSerialPortURIBackingInfo{
+				VirtualDeviceURIBackingInfo: vimtypes.VirtualDeviceURIBackingInfo{
+					ServiceURI: fmt.Sprintf("%s%d", serviceURIPrefix, port),
+					Direction:  string(vimtypes.VirtualDeviceURIBackingOptionDirectionServer),
+				},
+			},
+			Connectable: &vimtypes.VirtualDeviceConnectInfo{
+				StartConnected:    true,
+				AllowGuestControl: false,
+				Connected:         true,
+			},
+		},
+		YieldOnPoll: true,
+	}
+}
+
+// FindDevice returns the serial port used as the VM's serial console, or nil
+// if there is none. Serial ports that are not managed by VM Operator are
+// ignored.
+func FindDevice(devices []vimtypes.BaseVirtualDevice) *vimtypes.VirtualSerialPort {
+	for i := range devices {
+		sp, ok := devices[i].(*vimtypes.VirtualSerialPort)
+		if !ok {
+			continue
+		}
+		if _, ok := DevicePort(sp); ok {
+			return sp
+		}
+	}
+	return nil
+}
+
+// DevicePort returns the port on the ESXi host on which the serial port
+// listens for connections, and false if the serial port is not used as a
+// serial console.
+func DevicePort(sp *vimtypes.VirtualSerialPort) (int32, bool) {
+	backing, ok := sp.Backing.(*vimtypes.VirtualSerialPortURIBackingInfo)
+	if !ok {
+		return 0, false
+	}
+	if backing.Direction != string(vimtypes.VirtualDeviceURIBackingOptionDirectionServer) ||
+		!strings.HasPrefix(backing.ServiceURI, serviceURIPrefix) {
+
+		return 0, false
+	}
+	u, err := url.Parse(backing.ServiceURI)
+	if err != nil {
+		return 0, false
+	}
+	port, err := strconv.ParseInt(u.Port(), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(port), true
+}
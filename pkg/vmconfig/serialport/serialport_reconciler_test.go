@@ -0,0 +1,505 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package serialport_test
+
+import (
+	"context"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig"
+	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("New", func() {
+	It("should return a reconciler", func() {
+		Expect(serialport.New()).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Name", func() {
+	It("should return 'serialport'", func() {
+		Expect(serialport.New().Name()).To(Equal("serialport"))
+	})
+})
+
+var _ = Describe("OnResult", func() {
+	It("should return nil", func() {
+		var ctx context.Context
+		Expect(serialport.New().OnResult(ctx, nil, mo.VirtualMachine{}, nil)).To(Succeed())
+	})
+})
+
+var _ = Describe("Port", func() {
+	It("should return a stable port in the expected range", func() {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: "my-vm-uid",
+			},
+		}
+		port := serialport.Port(vm)
+		Expect(port).To(BeNumerically(">=", serialport.PortMin))
+		Expect(port).To(BeNumerically("<", serialport.PortMin+serialport.PortCount))
+		Expect(serialport.Port(vm.DeepCopy())).To(Equal(port))
+	})
+})
+
+var _ = Describe("AnnotatedPort", func() {
+	DescribeTable("should return the recorded port",
+		func(annotations map[string]string, expectedPort int32, expectedOK bool) {
+			vm := &vmopv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+			}
+			port, ok := serialport.AnnotatedPort(vm)
+			Expect(ok).To(Equal(expectedOK))
+			Expect(port).To(Equal(expectedPort))
+		},
+		Entry("no annotation", nil, int32(0), false),
+		Entry("valid port", map[string]string{vmopv1.SerialConsolePortAnnotation: "51234"}, int32(51234), true),
+		Entry("invalid port", map[string]string{vmopv1.SerialConsolePortAnnotation: "abc"}, int32(0), false),
+		Entry("port out of range", map[string]string{vmopv1.SerialConsolePortAnnotation: "443"}, int32(0), false),
+	)
+})
+
+var _ = Describe("AllocatePort", func() {
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+		vm        *vmopv1.VirtualMachine
+		otherVMs  []ctrlclient.Object
+	)
+
+	newVM := func(name string, port int32) *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "other-namespace",
+				Name:      name,
+				UID:       types.UID(name + "-uid"),
+			},
+		}
+		if port > 0 {
+			vm.SetAnnotation(vmopv1.SerialConsolePortAnnotation, strconv.Itoa(int(port)))
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-vm",
+				UID:       "allocate-port-vm-uid",
+			},
+		}
+		otherVMs = nil
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = builder.NewFakeClient(otherVMs...)
+	})
+
+	It("should allocate and record the preferred port", func() {
+		port, err := serialport.AllocatePort(ctx, k8sClient, vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(serialport.Port(vm)))
+		Expect(vm.Annotations).To(HaveKeyWithValue(
+			vmopv1.SerialConsolePortAnnotation, strconv.Itoa(int(port))))
+	})
+
+	When("the preferred port is used by another VM", func() {
+		BeforeEach(func() {
+			otherVMs = append(otherVMs, newVM("other-vm", serialport.Port(vm)))
+		})
+		It("should allocate a different port", func() {
+			port, err := serialport.AllocatePort(ctx, k8sClient, vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(port).ToNot(Equal(serialport.Port(vm)))
+			Expect(port).To(BeNumerically(">=", serialport.PortMin))
+			Expect(port).To(BeNumerically("<", serialport.PortMin+serialport.PortCount))
+		})
+	})
+
+	When("the VM has a recorded port", func() {
+		BeforeEach(func() {
+			vm.SetAnnotation(vmopv1.SerialConsolePortAnnotation, "51234")
+		})
+		It("should keep the recorded port", func() {
+			port, err := serialport.AllocatePort(ctx, k8sClient, vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(port).To(Equal(int32(51234)))
+		})
+
+		When("the recorded port is used by another VM", func() {
+			BeforeEach(func() {
+				otherVMs = append(otherVMs, newVM("restored-from-vm", 51234))
+			})
+			It("should allocate a different port", func() {
+				port, err := serialport.AllocatePort(ctx, k8sClient, vm)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(port).ToNot(Equal(int32(51234)))
+				Expect(vm.Annotations).To(HaveKeyWithValue(
+					vmopv1.SerialConsolePortAnnotation, strconv.Itoa(int(port))))
+			})
+		})
+	})
+
+	When("a port was allocated to another VM that is not yet recorded", func() {
+		It("should not allocate the same port", func() {
+			other := newVM("concurrent-vm", 0)
+			other.UID = vm.UID + "-concurrent"
+			otherPort, err := serialport.AllocatePort(ctx, k8sClient, other)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Record the other VM's port as this VM's port so it is the
+			// first port considered.
+			vm.SetAnnotation(vmopv1.SerialConsolePortAnnotation, strconv.Itoa(int(otherPort)))
+			port, err := serialport.AllocatePort(ctx, k8sClient, vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(port).ToNot(Equal(otherPort))
+		})
+	})
+})
+
+var _ = Describe("AllowedHosts", func() {
+	It("should return the allowed addresses and networks", func() {
+		allowed, err := serialport.AllowedHosts("192.168.0.10, 10.0.0.1/24,fd00::/64")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(Equal(&vimtypes.HostFirewallRulesetIpList{
+			IpAddress: []string{"192.168.0.10"},
+			IpNetwork: []vimtypes.HostFirewallRulesetIpNetwork{
+				{Network: "10.0.0.0", PrefixLength: 24},
+				{Network: "fd00::", PrefixLength: 64},
+			},
+		}))
+	})
+
+	It("should return an error if no networks are allowed", func() {
+		_, err := serialport.AllowedHosts("")
+		Expect(err).To(MatchError("no serial console networks are allowed"))
+	})
+
+	It("should return an error for an invalid address", func() {
+		_, err := serialport.AllowedHosts("10.0.0.1,not-an-ip")
+		Expect(err).To(MatchError(`invalid serial console address "not-an-ip"`))
+	})
+
+	It("should return an error for an invalid network", func() {
+		_, err := serialport.AllowedHosts("10.0.0.0/33")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix(`invalid serial console network "10.0.0.0/33"`))
+	})
+})
+
+var _ = Describe("FindDevice", func() {
+	It("should ignore serial ports that are not used as a serial console", func() {
+		Expect(serialport.FindDevice([]vimtypes.BaseVirtualDevice{
+			&vimtypes.VirtualSerialPort{
+				VirtualDevice: vimtypes.VirtualDevice{
+					Backing: &vimtypes.VirtualSerialPortFileBackingInfo{},
+				},
+			},
+			&vimtypes.VirtualSerialPort{
+				VirtualDevice: vimtypes.VirtualDevice{
+					Backing: &vimtypes.VirtualSerialPortURIBackingInfo{
+						VirtualDeviceURIBackingInfo: vimtypes.VirtualDeviceURIBackingInfo{
+							ServiceURI: "telnet://vspc.local:8080",
+							Direction:  string(vimtypes.VirtualDeviceURIBackingOptionDirectionClient),
+						},
+					},
+				},
+			},
+		})).To(BeNil())
+	})
+
+	It("should return the serial console", func() {
+		dev := serialport.NewDevice(100, 51234)
+		Expect(serialport.FindDevice([]vimtypes.BaseVirtualDevice{
+			&vimtypes.VirtualCdrom{},
+			dev,
+		})).To(Equal(dev))
+
+		port, ok := serialport.DevicePort(dev)
+		Expect(ok).To(BeTrue())
+		Expect(port).To(Equal(int32(51234)))
+	})
+})
+
+var _ = Describe("Reconcile", func() {
+
+	var (
+		r          vmconfig.Reconciler
+		ctx        context.Context
+		k8sClient  ctrlclient.Client
+		vimClient  *vim25.Client
+		moVM       mo.VirtualMachine
+		vm         *vmopv1.VirtualMachine
+		configSpec *vimtypes.VirtualMachineConfigSpec
+	)
+
+	BeforeEach(func() {
+		r = serialport.New()
+
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.Features.VMSerialConsole = true
+		})
+		k8sClient = builder.NewFakeClient()
+		vimClient = &vim25.Client{}
+
+		moVM = mo.VirtualMachine{
+			Config: &vimtypes.VirtualMachineConfigInfo{},
+			Runtime: vimtypes.VirtualMachineRuntimeInfo{
+				PowerState: vimtypes.VirtualMachinePowerStatePoweredOff,
+			},
+		}
+
+		configSpec = &vimtypes.VirtualMachineConfigSpec{}
+
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-vm",
+				UID:       "my-vm-uid",
+			},
+		}
+	})
+
+	Context("a panic is expected", func() {
+		When("ctx is nil", func() {
+			It("should panic", func() {
+				fn := func() {
+					_ = r.Reconcile(nil, k8sClient, vimClient, vm, moVM, configSpec) //nolint:staticcheck
+				}
+				Expect(fn).To(PanicWith("context is nil"))
+			})
+		})
+		When("k8sClient is nil", func() {
+			It("should panic", func() {
+				fn := func() {
+					_ = r.Reconcile(ctx, nil, vimClient, vm, moVM, configSpec)
+				}
+				Expect(fn).To(PanicWith("k8sClient is nil"))
+			})
+		})
+		When("vimClient is nil", func() {
+			It("should panic", func() {
+				fn := func() {
+					_ = r.Reconcile(ctx, k8sClient, nil, vm, moVM, configSpec)
+				}
+				Expect(fn).To(PanicWith("vimClient is nil"))
+			})
+		})
+		When("vm is nil", func() {
+			It("should panic", func() {
+				fn := func() {
+					_ = r.Reconcile(ctx, k8sClient, vimClient, nil, moVM, configSpec)
+				}
+				Expect(fn).To(PanicWith("vm is nil"))
+			})
+		})
+		When("configSpec is nil", func() {
+			It("should panic", func() {
+				fn := func() {
+					_ = r.Reconcile(ctx, k8sClient, vimClient, vm, moVM, nil)
+				}
+				Expect(fn).To(PanicWith("configSpec is nil"))
+			})
+		})
+	})
+
+	When("no panic is expected", func() {
+		var (
+			err error
+		)
+
+		JustBeforeEach(func() {
+			err = serialport.Reconcile(ctx, k8sClient, vimClient, vm, moVM, configSpec)
+		})
+
+		When("the feature is not enabled", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.VMSerialConsole = false
+				})
+				vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{
+					SerialConsole: &vmopv1.VirtualMachineSerialConsoleSpec{
+						Enabled: true,
+					},
+				}
+			})
+			It("should not change the config spec", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec.DeviceChange).To(BeEmpty())
+				Expect(vm.Annotations).ToNot(HaveKey(vmopv1.SerialConsolePortAnnotation))
+			})
+		})
+
+		When("the serial console is not enabled", func() {
+			It("should not change the config spec", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec.DeviceChange).To(BeEmpty())
+			})
+
+			When("the VM has a recorded port", func() {
+				BeforeEach(func() {
+					vm.SetAnnotation(vmopv1.SerialConsolePortAnnotation, "51234")
+				})
+				It("should remove the recorded port", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vm.Annotations).ToNot(HaveKey(vmopv1.SerialConsolePortAnnotation))
+				})
+			})
+
+			When("the VM has a serial console", func() {
+				var dev *vimtypes.VirtualSerialPort
+
+				BeforeEach(func() {
+					dev = serialport.NewDevice(9000, serialport.Port(vm))
+					moVM.Config.Hardware.Device = []vimtypes.BaseVirtualDevice{dev}
+				})
+
+				It("should remove the serial console", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec.DeviceChange).To(HaveLen(1))
+					dc := configSpec.DeviceChange[0].GetVirtualDeviceConfigSpec()
+					Expect(dc.Operation).To(Equal(vimtypes.VirtualDeviceConfigSpecOperationRemove))
+					Expect(dc.Device).To(Equal(dev))
+				})
+
+				When("the VM is powered on", func() {
+					BeforeEach(func() {
+						moVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOn
+					})
+					It("should not change the config spec", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(configSpec.DeviceChange).To(BeEmpty())
+					})
+				})
+			})
+		})
+
+		When("the serial console is enabled", func() {
+			BeforeEach(func() {
+				vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{
+					SerialConsole: &vmopv1.VirtualMachineSerialConsoleSpec{
+						Enabled: true,
+					},
+				}
+			})
+
+			It("should add the serial console", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec.DeviceChange).To(HaveLen(1))
+				dc := configSpec.DeviceChange[0].GetVirtualDeviceConfigSpec()
+				Expect(dc.Operation).To(Equal(vimtypes.VirtualDeviceConfigSpecOperationAdd))
+				sp, ok := dc.Device.(*vimtypes.VirtualSerialPort)
+				Expect(ok).To(BeTrue())
+				Expect(sp.Key).To(BeNumerically("<", 0))
+				port, ok := serialport.DevicePort(sp)
+				Expect(ok).To(BeTrue())
+				Expect(port).To(Equal(serialport.Port(vm)))
+				Expect(vm.Annotations).To(HaveKeyWithValue(
+					vmopv1.SerialConsolePortAnnotation, strconv.Itoa(int(port))))
+			})
+
+			When("the preferred port is used by another VM", func() {
+				BeforeEach(func() {
+					other := &vmopv1.VirtualMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "other-namespace",
+							Name:      "other-vm",
+							UID:       "other-vm-uid",
+							Annotations: map[string]string{
+								vmopv1.SerialConsolePortAnnotation: strconv.Itoa(int(serialport.Port(vm))),
+							},
+						},
+					}
+					k8sClient = builder.NewFakeClient(other)
+				})
+				It("should add the serial console with a different port", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec.DeviceChange).To(HaveLen(1))
+					dc := configSpec.DeviceChange[0].GetVirtualDeviceConfigSpec()
+					port, ok := serialport.DevicePort(dc.Device.(*vimtypes.VirtualSerialPort))
+					Expect(ok).To(BeTrue())
+					Expect(port).ToNot(Equal(serialport.Port(vm)))
+				})
+			})
+
+			When("the config spec already adds devices", func() {
+				BeforeEach(func() {
+					configSpec.DeviceChange = []vimtypes.BaseVirtualDeviceConfigSpec{
+						&vimtypes.VirtualDeviceConfigSpec{
+							Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+							Device: &vimtypes.VirtualCdrom{
+								VirtualDevice: vimtypes.VirtualDevice{
+									Key: -42,
+								},
+							},
+						},
+					}
+				})
+				It("should use a unique device key", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec.DeviceChange).To(HaveLen(2))
+					dc := configSpec.DeviceChange[1].GetVirtualDeviceConfigSpec()
+					Expect(dc.Device.GetVirtualDevice().Key).To(Equal(int32(-43)))
+				})
+			})
+
+			When("the VM already has a serial console", func() {
+				BeforeEach(func() {
+					moVM.Config.Hardware.Device = []vimtypes.BaseVirtualDevice{
+						serialport.NewDevice(9000, 51234),
+					}
+				})
+				It("should not change the config spec", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec.DeviceChange).To(BeEmpty())
+				})
+				It("should record the port of the serial console", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vm.Annotations).To(HaveKeyWithValue(
+						vmopv1.SerialConsolePortAnnotation, "51234"))
+				})
+			})
+
+			When("the VM is powered on", func() {
+				BeforeEach(func() {
+					moVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOn
+				})
+				It("should not change the config spec", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec.DeviceChange).To(BeEmpty())
+				})
+			})
+
+			When("the VM is being created", func() {
+				BeforeEach(func() {
+					moVM = mo.VirtualMachine{}
+				})
+				It("should add the serial console", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(configSpec.DeviceChange).To(HaveLen(1))
+				})
+			})
+		})
+	})
+})
@@ -7,26 +7,61 @@ package webconsolevalidation
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
 )
 
-const UUIDLabelKey = "vmoperator.vmware.com/webconsolerequest-uuid"
+const (
+	UUIDLabelKey = "vmoperator.vmware.com/webconsolerequest-uuid"
+
+	// MetricsPath is the path on which the server exposes its metrics.
+	MetricsPath = "/metrics"
+
+	// ConnectionAllowedReason is the reason of the event recorded on a web
+	// console request when a connection using it is allowed.
+	ConnectionAllowedReason = "WebConsoleConnectionAllowed"
+
+	// ConnectionDeniedReason is the reason of the event recorded on a web
+	// console request when a connection using it is denied.
+	ConnectionDeniedReason = "WebConsoleConnectionDenied"
+
+	// SessionMaxAgeHeader is the header of an allowed validation response
+	// that contains the number of seconds until the web console request
+	// expires. The web console proxy should close the session once this
+	// elapses, as sessions are only validated when they are established. The
+	// header is not set when the request does not expire.
+	SessionMaxAgeHeader = "X-Web-Console-Session-Max-Age"
+)
 
 // Server represents a web console validation server.
 type Server struct {
 	Addr, Path string
 	KubeClient ctrlclient.Client
+	Recorder   record.Recorder
+	Metrics    *metrics.WebConsoleMetrics
 }
 
-// NewServer creates a new web console validation server.
-func NewServer(addr, path string, client ctrlclient.Client) (*Server, error) {
+// NewServer creates a new web console validation server. The client should
+// be backed by an informer cache so requests are not validated by hitting the
+// API server. The recorder is used to audit the result of each validation on
+// the web console request.
+func NewServer(
+	addr, path string,
+	client ctrlclient.Client,
+	recorder record.Recorder) (*Server, error) {
+
 	if addr == "" || path == "" {
 		return nil, errors.New("server addr and path cannot be empty")
 	}
@@ -35,6 +70,8 @@ func NewServer(addr, path string, client ctrlclient.Client) (*Server, error) {
 		Addr:       addr,
 		Path:       path,
 		KubeClient: client,
+		Recorder:   recorder,
+		Metrics:    metrics.NewWebConsoleMetrics(),
 	}, nil
 }
 
@@ -42,6 +79,7 @@ func NewServer(addr, path string, client ctrlclient.Client) (*Server, error) {
 func (s *Server) Run() error {
 	mux := http.NewServeMux()
 	mux.HandleFunc(s.Path, s.HandleWebConsoleValidation)
+	mux.Handle(MetricsPath, promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{}))
 
 	server := &http.Server{
 		Addr:              s.Addr,
@@ -53,7 +91,8 @@ func (s *Server) Run() error {
 }
 
 // HandleWebConsoleValidation verifies a web console validation request by
-// checking if a WebConsoleRequest resource exists with the given UUID in query.
+// checking if a WebConsoleRequest resource exists with the given UUID in
+// query, and that the request is neither revoked nor expired.
 func (s *Server) HandleWebConsoleValidation(w http.ResponseWriter, r *http.Request) {
 	uuid := r.URL.Query().Get("uuid")
 	if uuid == "" {
@@ -69,31 +108,54 @@ func (s *Server) HandleWebConsoleValidation(w http.ResponseWriter, r *http.Reque
 
 	logger := ctrllog.Log.WithName(r.URL.Path).WithValues("uuid", uuid).WithValues("namespace", namespace)
 
-	found, err := isResourceFound(r.Context(), uuid, namespace, s.KubeClient)
+	obj, denyReason, err := findResource(r.Context(), uuid, namespace, s.KubeClient)
 	if err != nil {
 		logger.Error(err, "Error occurred in finding a webconsolerequest resource with the given params.")
+		s.Metrics.RecordValidation(namespace, metrics.WebConsoleValidationError)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	if found {
-		logger.Info("Found a webconsolerequest resource with the given params. Returning 200.")
-		w.WriteHeader(http.StatusOK)
-	} else {
+	switch {
+	case obj == nil:
 		logger.Info("Didn't find a webconsolerequest resource with the given params. Returning 403.")
+		s.Metrics.RecordValidation(namespace, metrics.WebConsoleValidationDenied)
+		w.WriteHeader(http.StatusForbidden)
+	case denyReason != "":
+		logger.Info("Found a webconsolerequest resource with the given params that cannot be used. Returning 403.",
+			"name", obj.GetName(), "reason", denyReason)
+		s.Metrics.RecordValidation(namespace, metrics.WebConsoleValidationDenied)
+		s.Recorder.Warnf(obj, ConnectionDeniedReason,
+			"Denied web console connection to VirtualMachine %s requested by %q: request is %s",
+			vmName(obj), requester(obj), denyReason)
 		w.WriteHeader(http.StatusForbidden)
+	default:
+		logger.Info("Found a webconsolerequest resource with the given params. Returning 200.",
+			"name", obj.GetName())
+		s.Metrics.RecordValidation(namespace, metrics.WebConsoleValidationAllowed)
+		s.Recorder.Eventf(obj, ConnectionAllowedReason,
+			"Allowed web console connection to VirtualMachine %s requested by %q",
+			vmName(obj), requester(obj))
+		if t := expiryTime(obj); !t.IsZero() {
+			maxAge := int64(math.Ceil(time.Until(t).Seconds()))
+			w.Header().Set(SessionMaxAgeHeader, strconv.FormatInt(maxAge, 10))
+		}
+		w.WriteHeader(http.StatusOK)
 	}
 }
 
-func isResourceFound(
+// findResource returns the web console request with the given UUID in the
+// namespace, or nil if there is none. If the request may not be used to
+// connect to the web console, then the reason is also returned.
+func findResource(
 	ctx context.Context,
 	uuid, namespace string,
-	kubeClient ctrlclient.Client) (bool, error) {
+	kubeClient ctrlclient.Client) (ctrlclient.Object, string, error) {
+
 	labelSelector := ctrlclient.MatchingLabels{
 		UUIDLabelKey: uuid,
 	}
 
-	// TODO: Use an Informer to avoid hitting the API server for every request.
 	vmwcrObjectList := &vmopv1.VirtualMachineWebConsoleRequestList{}
 	if err := kubeClient.List(
 		ctx,
@@ -101,11 +163,12 @@ func isResourceFound(
 		ctrlclient.InNamespace(namespace),
 		labelSelector,
 	); err != nil {
-		return false, err
+		return nil, "", err
 	}
 
 	if len(vmwcrObjectList.Items) > 0 {
-		return true, nil
+		vmwcr := &vmwcrObjectList.Items[0]
+		return vmwcr, denyReason(vmwcr.Spec.Revoked, vmwcr.Status.ExpiryTime.Time), nil
 	}
 
 	// NOTE: In v1a1 this CRD has a different name - WebConsoleRequest - so this
@@ -117,8 +180,49 @@ func isResourceFound(
 		ctrlclient.InNamespace(namespace),
 		labelSelector,
 	); err != nil {
-		return false, err
+		return nil, "", err
+	}
+
+	if len(wcrObjectList.Items) > 0 {
+		wcr := &wcrObjectList.Items[0]
+		return wcr, denyReason(false, wcr.Status.ExpiryTime.Time), nil
 	}
 
-	return len(wcrObjectList.Items) > 0, nil
+	return nil, "", nil
+}
+
+// denyReason returns why a web console request may not be used to connect to
+// the web console, or an empty string if it may be used.
+func denyReason(revoked bool, expiryTime time.Time) string {
+	switch {
+	case revoked:
+		return "revoked"
+	case !expiryTime.IsZero() && !time.Now().Before(expiryTime):
+		return "expired"
+	}
+	return ""
+}
+
+func vmName(obj ctrlclient.Object) string {
+	switch o := obj.(type) {
+	case *vmopv1.VirtualMachineWebConsoleRequest:
+		return o.Spec.Name
+	case *vmopv1a1.WebConsoleRequest:
+		return o.Spec.VirtualMachineName
+	}
+	return ""
+}
+
+func expiryTime(obj ctrlclient.Object) time.Time {
+	switch o := obj.(type) {
+	case *vmopv1.VirtualMachineWebConsoleRequest:
+		return o.Status.ExpiryTime.Time
+	case *vmopv1a1.WebConsoleRequest:
+		return o.Status.ExpiryTime.Time
+	}
+	return time.Time{}
+}
+
+func requester(obj ctrlclient.Object) string {
+	return obj.GetAnnotations()[vmopv1.WebConsoleRequestRequesterAnnotation]
 }
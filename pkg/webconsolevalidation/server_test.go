@@ -8,17 +8,21 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/webconsolevalidation"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -35,11 +39,11 @@ func serverUnitTests() {
 		When("Server addr or path is empty", func() {
 
 			It("should return an error", func() {
-				_, err := webconsolevalidation.NewServer("", serverPath, nil)
+				_, err := webconsolevalidation.NewServer("", serverPath, nil, nil)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("server addr and path cannot be empty"))
 
-				_, err = webconsolevalidation.NewServer(serverAddr, "", nil)
+				_, err = webconsolevalidation.NewServer(serverAddr, "", nil, nil)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("server addr and path cannot be empty"))
 			})
@@ -49,12 +53,15 @@ func serverUnitTests() {
 		When("All Server parameters are provided", func() {
 
 			It("should initialize a new Server successfully", func() {
-				server, err := webconsolevalidation.NewServer(serverAddr, serverPath, fake.NewFakeClient())
+				recorder, _ := builder.NewFakeRecorder()
+				server, err := webconsolevalidation.NewServer(serverAddr, serverPath, fake.NewFakeClient(), recorder)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(server).NotTo(BeNil())
 				Expect(server.Addr).To(Equal(serverAddr))
 				Expect(server.Path).To(Equal(serverPath))
 				Expect(server.KubeClient).NotTo(BeNil())
+				Expect(server.Recorder).NotTo(BeNil())
+				Expect(server.Metrics).NotTo(BeNil())
 			})
 
 		})
@@ -64,10 +71,13 @@ func serverUnitTests() {
 
 		It("should start the server at the given address and path", func(done Done) {
 
+			recorder, _ := builder.NewFakeRecorder()
 			server := &webconsolevalidation.Server{
 				Addr:       serverAddr,
 				Path:       serverPath,
 				KubeClient: builder.NewFakeClient(),
+				Recorder:   recorder,
+				Metrics:    metrics.NewWebConsoleMetrics(),
 			}
 
 			go func() {
@@ -85,6 +95,12 @@ func serverUnitTests() {
 			Expect(resp.Body).NotTo(BeNil())
 			Expect(resp.Body.Close()).To(Succeed())
 
+			resp, err = http.Get("http://" + serverAddr + webconsolevalidation.MetricsPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).NotTo(BeNil())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Body.Close()).To(Succeed())
+
 			close(done)
 		}, 1.0) // Time out this after 1 second.
 
@@ -95,16 +111,22 @@ func serverUnitTests() {
 		var (
 			initObjects []ctrlclient.Object
 			server      webconsolevalidation.Server
+			events      chan string
 		)
 
 		JustBeforeEach(func() {
+			var recorder record.Recorder
+			recorder, events = builder.NewFakeRecorder()
 			server = webconsolevalidation.Server{
 				KubeClient: builder.NewFakeClient(initObjects...),
+				Recorder:   recorder,
+				Metrics:    metrics.NewWebConsoleMetrics(),
 			}
 		})
 
 		AfterEach(func() {
 			initObjects = nil
+			events = nil
 		})
 
 		Context("requests with missing params", func() {
@@ -130,21 +152,34 @@ func serverUnitTests() {
 				wcrUUID   = "test-uuid-wcr"
 				vmwcrUUID = "test-uuid-vmwcr"
 				namespace = "test-namespace"
+				requester = "sso:jdoe@vsphere.local"
+				vmName    = "test-vm"
+			)
+
+			var (
+				vmwcr *vmopv1.VirtualMachineWebConsoleRequest
 			)
 
 			BeforeEach(func() {
 				wcr := &vmopv1a1.WebConsoleRequest{}
+				wcr.Name = "test-wcr"
 				wcr.Namespace = namespace
 				wcr.Labels = map[string]string{
 					webconsolevalidation.UUIDLabelKey: wcrUUID,
 				}
 				initObjects = append(initObjects, wcr)
 
-				vmwcr := &vmopv1.VirtualMachineWebConsoleRequest{}
+				vmwcr = &vmopv1.VirtualMachineWebConsoleRequest{}
+				vmwcr.Name = "test-vmwcr"
 				vmwcr.Namespace = namespace
 				vmwcr.Labels = map[string]string{
 					webconsolevalidation.UUIDLabelKey: vmwcrUUID,
 				}
+				vmwcr.Annotations = map[string]string{
+					vmopv1.WebConsoleRequestRequesterAnnotation: requester,
+				}
+				vmwcr.Spec.Name = vmName
+				vmwcr.Status.ExpiryTime = metav1.NewTime(time.Now().Add(time.Minute))
 				initObjects = append(initObjects, vmwcr)
 			})
 
@@ -172,6 +207,63 @@ func serverUnitTests() {
 					Expect(responseCode).To(Equal(http.StatusOK))
 				})
 
+				It("should record an event with the requester and VM", func() {
+					url := fmt.Sprintf("/?uuid=%s&namespace=%s", vmwcrUUID, namespace)
+					Expect(fakeValidationRequest(url, server)).To(Equal(http.StatusOK))
+
+					var event string
+					Expect(events).To(Receive(&event))
+					Expect(event).To(HavePrefix("Normal " + webconsolevalidation.ConnectionAllowedReason))
+					Expect(event).To(ContainSubstring(vmName))
+					Expect(event).To(ContainSubstring(requester))
+				})
+
+				It("should return the number of seconds until the request expires", func() {
+					url := fmt.Sprintf("/?uuid=%s&namespace=%s", vmwcrUUID, namespace)
+					responseRecorder := httptest.NewRecorder()
+					testRequest, err := http.NewRequest("GET", url, nil)
+					Expect(err).NotTo(HaveOccurred())
+					server.HandleWebConsoleValidation(responseRecorder, testRequest)
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+					maxAge, err := strconv.Atoi(responseRecorder.Header().Get(webconsolevalidation.SessionMaxAgeHeader))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(maxAge).To(BeNumerically(">", 0))
+					Expect(maxAge).To(BeNumerically("<=", 60))
+				})
+
+				When("the request is revoked", func() {
+					BeforeEach(func() {
+						vmwcr.Spec.Revoked = true
+					})
+
+					It("should return http.StatusForbidden (403) and record an event", func() {
+						url := fmt.Sprintf("/?uuid=%s&namespace=%s", vmwcrUUID, namespace)
+						Expect(fakeValidationRequest(url, server)).To(Equal(http.StatusForbidden))
+
+						var event string
+						Expect(events).To(Receive(&event))
+						Expect(event).To(HavePrefix("Warning " + webconsolevalidation.ConnectionDeniedReason))
+						Expect(event).To(ContainSubstring(requester))
+						Expect(event).To(ContainSubstring("revoked"))
+					})
+				})
+
+				When("the request is expired", func() {
+					BeforeEach(func() {
+						vmwcr.Status.ExpiryTime = metav1.NewTime(time.Now().Add(-time.Second))
+					})
+
+					It("should return http.StatusForbidden (403) and record an event", func() {
+						url := fmt.Sprintf("/?uuid=%s&namespace=%s", vmwcrUUID, namespace)
+						Expect(fakeValidationRequest(url, server)).To(Equal(http.StatusForbidden))
+
+						var event string
+						Expect(events).To(Receive(&event))
+						Expect(event).To(HavePrefix("Warning " + webconsolevalidation.ConnectionDeniedReason))
+						Expect(event).To(ContainSubstring("expired"))
+					})
+				})
 			})
 
 			When("an error occurs while getting the WebConsoleRequest resource", func() {
@@ -198,6 +290,16 @@ func serverUnitTests() {
 					Expect(responseCode).To(Equal(http.StatusOK))
 				})
 
+				It("should not return a session max age if the request does not expire", func() {
+					url := fmt.Sprintf("/?uuid=%s&namespace=%s", wcrUUID, namespace)
+					responseRecorder := httptest.NewRecorder()
+					testRequest, err := http.NewRequest("GET", url, nil)
+					Expect(err).NotTo(HaveOccurred())
+					server.HandleWebConsoleValidation(responseRecorder, testRequest)
+					Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+					Expect(responseRecorder.Header().Values(webconsolevalidation.SessionMaxAgeHeader)).To(BeEmpty())
+				})
+
 			})
 
 			When("Namespace doesn't match any WebConsoleRequest or VirtualMachineWebConsoleRequest resource", func() {
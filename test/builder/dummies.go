@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	vmopv1a1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
@@ -515,6 +516,108 @@ func DummyVirtualMachineReplicaSet() *vmopv1.VirtualMachineReplicaSet {
 	}
 }
 
+func DummyVirtualMachineDeployment() *vmopv1.VirtualMachineDeployment {
+	rs := DummyVirtualMachineReplicaSet()
+	return &vmopv1.VirtualMachineDeployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "VirtualMachineDeployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineDeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: make(map[string]string),
+			},
+			Template: rs.Spec.Template,
+			Strategy: vmopv1.VirtualMachineDeploymentStrategy{
+				Type: vmopv1.VirtualMachineDeploymentStrategyTypeRollingUpdate,
+			},
+		},
+	}
+}
+
+func DummyVirtualMachineDisruptionBudget() *vmopv1.VirtualMachineDisruptionBudget {
+	return &vmopv1.VirtualMachineDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "VirtualMachineDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: make(map[string]string),
+			},
+			MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+		},
+	}
+}
+
+func DummyVirtualMachineIPPool() *vmopv1.VirtualMachineIPPool {
+	return &vmopv1.VirtualMachineIPPool{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "VirtualMachineIPPool",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineIPPoolSpec{
+			CIDRs:       []string{"192.168.10.0/24"},
+			Exclusions:  []string{"192.168.10.240/28"},
+			Gateway4:    "192.168.10.1",
+			Nameservers: []string{"192.168.10.2"},
+		},
+	}
+}
+
+func DummyVirtualMachineNetworkPolicy() *vmopv1.VirtualMachineNetworkPolicy {
+	return &vmopv1.VirtualMachineNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "VirtualMachineNetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "test-",
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineNetworkPolicySpec{
+			VMSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "db"},
+			},
+			Ingress: []vmopv1.VirtualMachineNetworkPolicyIngressRule{
+				{
+					From: []vmopv1.VirtualMachineNetworkPolicyPeer{
+						{
+							VMSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "web"},
+							},
+						},
+						{
+							IPBlock: &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+								CIDR: "192.168.10.0/24",
+							},
+						},
+					},
+					Ports: []vmopv1.VirtualMachineNetworkPolicyPort{
+						{
+							Protocol: corev1.ProtocolTCP,
+							Port:     ptr.To[int32](5432),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func AddDummyInstanceStorageVolume(vm *vmopv1.VirtualMachine) {
 	vm.Spec.Volumes = append(vm.Spec.Volumes, DummyInstanceStorageVirtualMachineVolumes()...)
 }
@@ -669,6 +772,38 @@ func DummyVirtualMachineWebConsoleRequest(namespace, wcrName, vmName, pubKey str
 	}
 }
 
+func DummyVirtualMachineSerialConsoleRequest(namespace, name, vmName string) *vmopv1.VirtualMachineSerialConsoleRequest {
+	return &vmopv1.VirtualMachineSerialConsoleRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: vmopv1.VirtualMachineSerialConsoleRequestSpec{
+			Name: vmName,
+		},
+	}
+}
+
+func DummyVirtualMachineGuestFileTransfer(namespace, name, vmName string) *vmopv1.VirtualMachineGuestFileTransfer {
+	return &vmopv1.VirtualMachineGuestFileTransfer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: vmopv1.VirtualMachineGuestFileTransferSpec{
+			VMName:    vmName,
+			Direction: vmopv1.VirtualMachineGuestFileTransferDirectionToGuest,
+			GuestPath: "/etc/ssl/certs/dummy.pem",
+			Object: vmopv1.VirtualMachineGuestFileTransferObject{
+				Kind: vmopv1.VirtualMachineGuestFileTransferObjectKindSecret,
+				Name: "dummy-secret",
+				Key:  "tls.crt",
+			},
+			CredentialsSecretName: "dummy-credentials",
+		},
+	}
+}
+
 func DummyVirtualMachineSnapshot(namespace, name, vmName string) *vmopv1.VirtualMachineSnapshot {
 	return &vmopv1.VirtualMachineSnapshot{
 		TypeMeta: metav1.TypeMeta{
@@ -694,6 +829,83 @@ func DummyVirtualMachineSnapshot(namespace, name, vmName string) *vmopv1.Virtual
 	}
 }
 
+func DummyVirtualMachineSnapshotSchedule(namespace, name string) *vmopv1.VirtualMachineSnapshotSchedule {
+	return &vmopv1.VirtualMachineSnapshotSchedule{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineSnapshotSchedule",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineSnapshotScheduleSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: make(map[string]string),
+			},
+			Schedule: "@hourly",
+		},
+	}
+}
+
+func DummyVirtualMachineGroupSnapshot(namespace, name, groupName string) *vmopv1.VirtualMachineGroupSnapshot {
+	return &vmopv1.VirtualMachineGroupSnapshot{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineGroupSnapshot",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineGroupSnapshotSpec{
+			GroupName: groupName,
+		},
+	}
+}
+
+func DummyVirtualMachineSnapshotExport(namespace, name, snapshotName string) *vmopv1.VirtualMachineSnapshotExport {
+	return &vmopv1.VirtualMachineSnapshotExport{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineSnapshotExport",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineSnapshotExportSpec{
+			SnapshotName: snapshotName,
+			Target: vmopv1.VirtualMachineSnapshotExportTarget{
+				PersistentVolumeClaim: &vmopv1.VirtualMachineSnapshotExportPersistentVolumeClaimTarget{},
+			},
+		},
+	}
+}
+
+func DummyVirtualMachineSnapshotImport(namespace, name, exportNamespace, exportName string) *vmopv1.VirtualMachineSnapshotImport {
+	return &vmopv1.VirtualMachineSnapshotImport{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineSnapshotImport",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: map[string]string{},
+		},
+		Spec: vmopv1.VirtualMachineSnapshotImportSpec{
+			Source: vmopv1.VirtualMachineSnapshotImportSource{
+				Namespace: exportNamespace,
+				Name:      exportName,
+			},
+		},
+	}
+}
+
 func DummyVirtualMachineSnapshotWithMemory(namespace, name, vmName string) *vmopv1.VirtualMachineSnapshot {
 	return &vmopv1.VirtualMachineSnapshot{
 		TypeMeta: metav1.TypeMeta{
@@ -15,6 +15,7 @@ import (
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
 	imgregv1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha2"
 	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 
 	appv1a1 "github.com/vmware-tanzu/vm-operator/external/appplatform/api/v1alpha1"
@@ -58,15 +59,27 @@ func KnownObjectTypes() []client.Object {
 	return []client.Object{
 		&vmopv1.VirtualMachine{},
 		&vmopv1.VirtualMachineGroup{},
+		&vmopv1.VirtualMachineGroupSnapshot{},
 		&vmopv1.VirtualMachineService{},
 		&vmopv1.VirtualMachineClass{},
 		&vmopv1.VirtualMachineClassInstance{},
 		&vmopv1.VirtualMachinePublishRequest{},
+		&vmopv1.VirtualMachineReplicaSet{},
+		&vmopv1.VirtualMachineDeployment{},
+		&vmopv1.VirtualMachineDisruptionBudget{},
 		&vmopv1.ClusterVirtualMachineImage{},
 		&vmopv1.VirtualMachineImage{},
 		&vmopv1.VirtualMachineImageCache{},
 		&vmopv1.VirtualMachineWebConsoleRequest{},
+		&vmopv1.VirtualMachineSerialConsoleRequest{},
+		&vmopv1.VirtualMachineGuestFileTransfer{},
+		&vmopv1.VirtualMachineIPPool{},
+		&vmopv1.VirtualMachineIPAddressClaim{},
+		&vmopv1.VirtualMachineNetworkPolicy{},
 		&vmopv1.VirtualMachineSnapshot{},
+		&vmopv1.VirtualMachineSnapshotExport{},
+		&vmopv1.VirtualMachineSnapshotImport{},
+		&vmopv1.VirtualMachineSnapshotSchedule{},
 		&vmopv1a1.WebConsoleRequest{},
 		&cnsv1alpha1.CnsNodeVmAttachment{},
 		&cnsv1alpha1.CnsNodeVMBatchAttachment{},
@@ -78,6 +91,8 @@ func KnownObjectTypes() []client.Object {
 		&vpcv1alpha1.Subnet{},
 		&vpcv1alpha1.SubnetSet{},
 		&vpcv1alpha1.SubnetPort{},
+		&vpcv1alpha1.SecurityPolicy{},
+		&nsxv1alpha1.SecurityPolicy{},
 		&byokv1.EncryptionClass{},
 		&capv1.Capabilities{},
 		&appv1a1.SupervisorProperties{},
@@ -109,6 +124,7 @@ func NewScheme() *runtime.Scheme {
 	_ = imgregv1a1.AddToScheme(scheme)
 	_ = imgregv1.AddToScheme(scheme)
 	_ = vpcv1alpha1.AddToScheme(scheme)
+	_ = nsxv1alpha1.AddToScheme(scheme)
 	_ = vspherepolv1.AddToScheme(scheme)
 	_ = infrav1.AddToScheme(scheme)
 	return scheme
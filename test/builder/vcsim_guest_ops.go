@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/toolbox/process"
+	"github.com/vmware/govmomi/toolbox/vix"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// hostGuestProcessManager is a vC Sim GuestProcessManager that runs guest
+// programs on the test host instead of in a container backing the VM. Like
+// VMware Tools on a Linux guest, the program and its arguments are run with
+// "sh -c".
+type hostGuestProcessManager struct {
+	*simulator.GuestProcessManager
+}
+
+func (m *hostGuestProcessManager) StartProgramInGuest(
+	ctx *simulator.Context,
+	req *vimtypes.StartProgramInGuest) soap.HasFault {
+
+	body := new(methods.StartProgramInGuestBody)
+
+	if _, ok := req.Auth.(*vimtypes.NamePasswordAuthentication); !ok {
+		body.Fault_ = simulator.Fault("", new(vimtypes.InvalidGuestLogin))
+		return body
+	}
+
+	spec := req.Spec.(*vimtypes.GuestProgramSpec)
+	pid, err := m.Start(&vix.StartProgramRequest{
+		ProgramPath: spec.ProgramPath,
+		Arguments:   spec.Arguments,
+		WorkingDir:  spec.WorkingDirectory,
+		EnvVars:     spec.EnvVariables,
+	}, process.New())
+	if err != nil {
+		body.Fault_ = simulator.Fault(err.Error(), &vimtypes.FileNotFound{FileFault: vimtypes.FileFault{File: spec.ProgramPath}})
+		return body
+	}
+
+	body.Res = &vimtypes.StartProgramInGuestResponse{
+		Returnval: pid,
+	}
+
+	return body
+}
+
+// UseHostGuestProcessManager configures vC Sim to run guest programs on the
+// test host. By default, vC Sim requires VMs to be backed by a container to
+// support guest operations.
+func (c *TestContextForVCSim) UseHostGuestProcessManager() {
+	simCtx := c.SimulatorContext()
+
+	gom := simCtx.Map.Get(*c.VCClient.Client.ServiceContent.GuestOperationsManager).(*simulator.GuestOperationsManager)
+	pm := simCtx.Map.Get(*gom.ProcessManager).(*simulator.GuestProcessManager)
+	Expect(pm).ToNot(BeNil())
+
+	simCtx.Map.Put(&hostGuestProcessManager{GuestProcessManager: pm})
+}
@@ -248,6 +248,14 @@ func (h *VMRequestedCapacityHandler) HandleCreate(ctx *pkgctx.WebhookRequestCont
 				)}
 		}
 		imageStatus = cvmi.Status
+	case "VirtualMachine", "VirtualMachineSnapshot":
+		// A cloned VM's disks are copied from the source VM, so the capacity
+		// is that of the source VM's classic disks.
+		capacity, resp := h.getCloneSourceCapacity(ctx, vm)
+		if resp != nil {
+			return *resp
+		}
+		return h.createCapacityResponse(ctx, vm, sc, capacity)
 	default:
 		return CapacityResponse{
 			Response: webhook.Errored(http.StatusBadRequest,
@@ -286,6 +294,84 @@ func (h *VMRequestedCapacityHandler) HandleCreate(ctx *pkgctx.WebhookRequestCont
 		}
 	}
 
+	return h.createCapacityResponse(ctx, vm, sc, capacity)
+}
+
+// getCloneSourceCapacity returns the capacity of the classic disks of the VM
+// cloned by the provided VM, which is the VM referred to by spec.image or, for
+// a VirtualMachineSnapshot, the VM of the snapshot. The capacity of the PVC
+// volumes copied for the clone is requested by the copied PVCs. An error
+// response is returned if the capacity cannot be determined.
+func (h *VMRequestedCapacityHandler) getCloneSourceCapacity(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) (*resource.Quantity, *CapacityResponse) {
+
+	errResponse := func(code int32, err error) (*resource.Quantity, *CapacityResponse) {
+		return nil, &CapacityResponse{Response: webhook.Errored(code, err)}
+	}
+
+	srcKey := client.ObjectKey{Namespace: vm.Namespace, Name: vm.Spec.Image.Name}
+
+	if vm.Spec.Image.Kind == "VirtualMachineSnapshot" {
+		snapshot := &vmopv1.VirtualMachineSnapshot{}
+		if err := h.Client.Get(ctx, srcKey, snapshot); err != nil {
+			if apierrors.IsNotFound(err) {
+				return errResponse(http.StatusNotFound, err)
+			}
+			return errResponse(http.StatusInternalServerError,
+				fmt.Errorf("failed to get VirtualMachineSnapshot %q: %w", srcKey.Name, err))
+		}
+		srcKey.Name = snapshot.Spec.VMName
+		if srcKey.Name == "" {
+			srcKey.Name = snapshot.Labels[vmopv1.VMNameForSnapshotLabel]
+		}
+		if srcKey.Name == "" {
+			return errResponse(http.StatusNotFound,
+				fmt.Errorf("VirtualMachineSnapshot %q does not refer to a VM", snapshot.Name))
+		}
+	}
+
+	srcVM := &vmopv1.VirtualMachine{}
+	if err := h.Client.Get(ctx, srcKey, srcVM); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errResponse(http.StatusNotFound, err)
+		}
+		return errResponse(http.StatusInternalServerError,
+			fmt.Errorf("failed to get VirtualMachine %q: %w", srcKey.Name, err))
+	}
+
+	var (
+		capacity = resource.NewQuantity(0, resource.BinarySI)
+		found    bool
+	)
+	for _, vol := range srcVM.Status.Volumes {
+		if vol.Type != vmopv1.VolumeTypeClassic {
+			continue
+		}
+		if vol.Limit == nil {
+			return errResponse(http.StatusNotFound,
+				fmt.Errorf("no capacity found for disk %q in VirtualMachine %q status.volumes",
+					vol.Name, srcVM.Name))
+		}
+		capacity.Add(*vol.Limit)
+		found = true
+	}
+	if !found {
+		return errResponse(http.StatusNotFound,
+			fmt.Errorf("no disks found in VirtualMachine %q status.volumes", srcVM.Name))
+	}
+
+	return capacity, nil
+}
+
+// createCapacityResponse returns the response with the capacity requested by
+// a new VM, which is the provided capacity of its disks plus its swap space.
+func (h *VMRequestedCapacityHandler) createCapacityResponse(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine,
+	sc *storagev1.StorageClass,
+	capacity *resource.Quantity) CapacityResponse {
+
 	// Account for potential swap space, which is the difference in total memory and reserved memory.
 	// This is *only* done on create.
 	swapCapacity, err := getSwapCapacity(ctx, h.Client, vm)
@@ -303,7 +389,7 @@ func (h *VMRequestedCapacityHandler) HandleCreate(ctx *pkgctx.WebhookRequestCont
 		RequestedCapacities: []*RequestedCapacity{
 			{
 				Capacity:         *capacity,
-				StorageClassName: scName,
+				StorageClassName: sc.Name,
 				// If this parameter does not exist, then it is not necessarily an error condition. Return
 				// an empty value for StoragePolicyID and let Storage Policy Quota extension service decide
 				// what to do.
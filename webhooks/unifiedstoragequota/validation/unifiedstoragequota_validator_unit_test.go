@@ -1339,6 +1339,129 @@ func testVMRequestedCapacityHandlerHandleCreate() {
 				})
 			})
 
+			When("vm is cloned", func() {
+				var srcVM *vmopv1.VirtualMachine
+
+				BeforeEach(func() {
+					srcVM = builder.DummyVirtualMachine()
+					srcVM.Name = "source-vm"
+					srcVM.Namespace = dummyNamespaceName
+					srcVM.Status.Volumes = []vmopv1.VirtualMachineVolumeStatus{
+						{
+							Name:  disk0,
+							Type:  vmopv1.VolumeTypeClassic,
+							Limit: resource.NewQuantity(10*1024*1024*1024, resource.BinarySI),
+						},
+						{
+							Name:  disk1,
+							Type:  vmopv1.VolumeTypeClassic,
+							Limit: resource.NewQuantity(5*1024*1024*1024, resource.BinarySI),
+						},
+						{
+							Name:  "pvc-volume",
+							Type:  vmopv1.VolumeTypeManaged,
+							Limit: resource.NewQuantity(100*1024*1024*1024, resource.BinarySI),
+						},
+					}
+
+					vm = &vmopv1.VirtualMachine{
+						Spec: vmopv1.VirtualMachineSpec{
+							Image: &vmopv1.VirtualMachineImageRef{
+								Name: srcVM.Name,
+								Kind: "VirtualMachine",
+							},
+						},
+					}
+				})
+
+				When("the source VM exists", func() {
+					BeforeEach(func() {
+						withObjects = append(withObjects, srcVM)
+					})
+
+					It("should return the capacity of the source VM's classic disks", func() {
+						Expect(resp.Allowed).To(BeTrue())
+						Expect(int(resp.Result.Code)).To(Equal(http.StatusOK))
+
+						Expect(resp.RequestedCapacities).To(HaveLen(1))
+						Expect(resp.RequestedCapacities[0].Capacity.String()).To(Equal(resource.NewQuantity(15*1024*1024*1024, resource.BinarySI).String()))
+						Expect(resp.RequestedCapacities[0].StoragePolicyID).To(Equal("id42"))
+						Expect(resp.RequestedCapacities[0].StorageClassName).To(Equal(builder.DummyStorageClassName))
+					})
+				})
+
+				When("the source VM does not exist", func() {
+					It("should write StatusNotFound and an empty RequestedCapacity to the response", func() {
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(int(resp.Result.Code)).To(Equal(http.StatusNotFound))
+
+						Expect(resp.RequestedCapacities).To(BeNil())
+					})
+				})
+
+				When("the source VM's disk capacity is not known", func() {
+					BeforeEach(func() {
+						srcVM.Status.Volumes[1].Limit = nil
+						withObjects = append(withObjects, srcVM)
+					})
+
+					It("should write StatusNotFound and an empty RequestedCapacity to the response", func() {
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(int(resp.Result.Code)).To(Equal(http.StatusNotFound))
+
+						Expect(resp.RequestedCapacities).To(BeNil())
+					})
+				})
+
+				When("the source VM has no disk status", func() {
+					BeforeEach(func() {
+						srcVM.Status.Volumes = nil
+						withObjects = append(withObjects, srcVM)
+					})
+
+					It("should write StatusNotFound and an empty RequestedCapacity to the response", func() {
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(int(resp.Result.Code)).To(Equal(http.StatusNotFound))
+
+						Expect(resp.RequestedCapacities).To(BeNil())
+					})
+				})
+
+				When("the VM is cloned from a VirtualMachineSnapshot", func() {
+					BeforeEach(func() {
+						vmSnapshot := builder.DummyVirtualMachineSnapshot(dummyNamespaceName, "source-snapshot", srcVM.Name)
+						withObjects = append(withObjects, srcVM, vmSnapshot)
+
+						vm.Spec.Image.Kind = "VirtualMachineSnapshot"
+						vm.Spec.Image.Name = vmSnapshot.Name
+					})
+
+					It("should return the capacity of the classic disks of the snapshot's VM", func() {
+						Expect(resp.Allowed).To(BeTrue())
+						Expect(int(resp.Result.Code)).To(Equal(http.StatusOK))
+
+						Expect(resp.RequestedCapacities).To(HaveLen(1))
+						Expect(resp.RequestedCapacities[0].Capacity.String()).To(Equal(resource.NewQuantity(15*1024*1024*1024, resource.BinarySI).String()))
+					})
+				})
+
+				When("the source VirtualMachineSnapshot does not exist", func() {
+					BeforeEach(func() {
+						withObjects = append(withObjects, srcVM)
+
+						vm.Spec.Image.Kind = "VirtualMachineSnapshot"
+						vm.Spec.Image.Name = "source-snapshot"
+					})
+
+					It("should write StatusNotFound and an empty RequestedCapacity to the response", func() {
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(int(resp.Result.Code)).To(Equal(http.StatusNotFound))
+
+						Expect(resp.RequestedCapacities).To(BeNil())
+					})
+				})
+			})
+
 			When("vm uses invalid image kind", func() {
 				BeforeEach(func() {
 					vm = &vmopv1.VirtualMachine{
@@ -992,6 +992,12 @@ func CleanupApplyPowerStateChangeTimeAnno(
 		return false
 	}
 
+	if oldVM.Spec.CurrentSnapshotName != "" {
+		// VM's power state is restored from a snapshot, keep the
+		// apply-power-state time set by a group that is being reverted.
+		return false
+	}
+
 	if oldVM.Spec.PowerState != vm.Spec.PowerState {
 		// VM's power state is updated directly without a new apply-power-state
 		// time set from a parent group, remove the stale annotation to apply
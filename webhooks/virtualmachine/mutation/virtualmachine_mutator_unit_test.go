@@ -1565,6 +1565,23 @@ func unitTestsMutating() {
 				Expect(ctx.vm.Annotations).ToNot(HaveKey(constants.ApplyPowerStateTimeAnnotation))
 			})
 		})
+
+		When("VM's power state is restored from a snapshot with a pre-existing apply power state change time annotation", func() {
+			BeforeEach(func() {
+				now := time.Now().UTC()
+				oldVM.Annotations[constants.ApplyPowerStateTimeAnnotation] = now.Format(time.RFC3339Nano)
+				ctx.vm.Annotations[constants.ApplyPowerStateTimeAnnotation] = now.Format(time.RFC3339Nano)
+				oldVM.Spec.CurrentSnapshotName = "snap-1"
+				oldVM.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+				ctx.vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+			})
+
+			It("should be a no-op", func() {
+				mutated := mutation.CleanupApplyPowerStateChangeTimeAnno(&ctx.WebhookRequestContext, ctx.vm, oldVM)
+				Expect(mutated).To(BeFalse())
+				Expect(ctx.vm.Annotations).To(HaveKey(constants.ApplyPowerStateTimeAnnotation))
+			})
+		})
 	})
 
 	Describe("ResolveClassAndClassName", func() {
@@ -37,6 +37,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha6/sysprep"
 	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
@@ -44,12 +45,15 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
 	cloudinitvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/validate"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ignition"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	spqutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube/spq"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/pkg/vmconfig/anno2extraconfig"
+	vmconfserialport "github.com/vmware-tanzu/vm-operator/pkg/vmconfig/serialport"
 	"github.com/vmware-tanzu/vm-operator/webhooks/common"
 )
 
@@ -60,10 +64,16 @@ const (
 
 	vmiKind     = "VirtualMachineImage"
 	cvmiKind    = "ClusterVirtualMachineImage"
+	vmKind      = "VirtualMachine"
+	vmSnapKind  = "VirtualMachineSnapshot"
 	vmclassKind = "VirtualMachineClass"
 
 	readinessProbeOnlyOneAction                = "only one action can be specified"
 	tcpReadinessProbeNotAllowedVPC             = "VPC networking doesn't allow TCP readiness probe to be specified"
+	httpReadinessProbeNotAllowedVPC            = "VPC networking doesn't allow HTTP readiness probe to be specified"
+	execProbeCommandNotAbsolute                = "must be an absolute path"
+	ipPoolNameRequiresNamedNetwork             = "ipPoolName is available only with the Named network provider"
+	ipPoolNameMutuallyExclusive                = "field is mutually exclusive with ipPoolName"
 	updatesNotAllowedWhenPowerOn               = "updates to this field is not allowed when VM power is on"
 	addingNewCdromNotAllowedWhenPowerOn        = "adding new CD-ROMs is not allowed when VM is powered on"
 	removingCdromNotAllowedWhenPowerOn         = "removing CD-ROMs is not allowed when VM is powered on"
@@ -83,7 +93,15 @@ const (
 	invalidMinHardwareVersionNotSupported      = "should be less than or equal to %d"
 	invalidMinHardwareVersionDowngrade         = "cannot downgrade hardware version"
 	invalidMinHardwareVersionPowerState        = "cannot upgrade hardware version unless powered off"
-	invalidImageKind                           = "supported: " + vmiKind + "; " + cvmiKind
+	invalidImageKind                           = "supported: " + vmiKind + "; " + cvmiKind + "; " + vmKind + "; " + vmSnapKind
+	invalidCloneModeImageKind                  = "may only be set when spec.image.kind is " + vmKind + " or " + vmSnapKind
+	cloneSourceNotFoundFmt                     = "%s %s not found"
+	cloneSourceNotCreated                      = "source VM has not been created"
+	cloneSourceNotPoweredOff                   = "source VM must be powered off for a full clone"
+	cloneSourceNoCurrentSnapshot               = "source VM must have a current snapshot for a linked clone"
+	cloneSourceSnapshotNotReady                = "source snapshot is not ready"
+	cloneSourceSnapshotHasPVCs                 = "cannot clone a snapshot of a VM with PersistentVolumeClaim volumes"
+	cloneClassNotCompatible                    = "class devices must match those of the source VM's class %s"
 	invalidZone                                = "cannot use zone that is being deleted"
 	restrictedToPrivUsers                      = "restricted to privileged users"
 	controllerBusNumberRangeFmt                = "%s controllerBusNumber must be in the range of 0 to %d"
@@ -98,6 +116,7 @@ const (
 	labelSelectorCanNotContainVMOperatorLabels = "label selector can not contain VM Operator managed labels (vmoperator.vmware.com)"
 	guestCustomizationVCDParityNotEnabled      = "VC guest customization VCD parity capability is not enabled"
 	bootstrapProviderTypeCannotBeChanged       = "bootstrap provider type cannot be changed"
+	bootstrapGenerationCannotBeDecreased       = "bootstrap generation cannot be decreased"
 	forbiddenRemovableVolume                   = "cannot remove volume with removable=false"
 )
 
@@ -166,6 +185,8 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateVolumes(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateInstanceStorageVolumes(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateReadinessProbe(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateStartupProbe(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateLivenessProbe(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAdvanced(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validatePowerStateOnCreate(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNextRestartTimeOnCreate(ctx, vm)...)
@@ -266,11 +287,14 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateCrypto(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAvailabilityZone(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateBootstrapProviderImmutable(ctx, vm, oldVM)...)
+	fieldErrs = append(fieldErrs, v.validateBootstrapGeneration(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateBootstrap(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNetwork(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateVolumes(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateInstanceStorageVolumes(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateReadinessProbe(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateStartupProbe(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateLivenessProbe(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAdvanced(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNextRestartTimeOnUpdate(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateAnnotation(ctx, vm, oldVM)...)
@@ -322,6 +346,32 @@ func (v validator) validateBootstrapProviderImmutable(
 		return append(fieldErrs, field.Forbidden(p.Child("linuxPrep"), bootstrapProviderTypeCannotBeChanged))
 	case oldBS.Sysprep != nil && bs.Sysprep == nil:
 		return append(fieldErrs, field.Forbidden(p.Child("sysprep"), bootstrapProviderTypeCannotBeChanged))
+	case oldBS.Ignition != nil && bs.Ignition == nil:
+		return append(fieldErrs, field.Forbidden(p.Child("ignition"), bootstrapProviderTypeCannotBeChanged))
+	}
+
+	return nil
+}
+
+func (v validator) validateBootstrapGeneration(
+	_ *pkgctx.WebhookRequestContext,
+	vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	var generation, oldGeneration int64
+	if bs := vm.Spec.Bootstrap; bs != nil {
+		generation = bs.Generation
+	}
+	if bs := oldVM.Spec.Bootstrap; bs != nil {
+		oldGeneration = bs.Generation
+	}
+
+	if generation < oldGeneration {
+		return field.ErrorList{
+			field.Invalid(
+				field.NewPath("spec", "bootstrap", "generation"),
+				generation,
+				bootstrapGenerationCannotBeDecreased),
+		}
 	}
 
 	return nil
@@ -340,6 +390,7 @@ func (v validator) validateBootstrap(
 		linuxPrep  *vmopv1.VirtualMachineBootstrapLinuxPrepSpec
 		sysPrep    *vmopv1.VirtualMachineBootstrapSysprepSpec
 		vAppConfig *vmopv1.VirtualMachineBootstrapVAppConfigSpec
+		ign        *vmopv1.VirtualMachineBootstrapIgnitionSpec
 	)
 
 	if vm.Spec.Bootstrap != nil {
@@ -347,12 +398,13 @@ func (v validator) validateBootstrap(
 		linuxPrep = vm.Spec.Bootstrap.LinuxPrep
 		sysPrep = vm.Spec.Bootstrap.Sysprep
 		vAppConfig = vm.Spec.Bootstrap.VAppConfig
+		ign = vm.Spec.Bootstrap.Ignition
 	}
 
 	if cloudInit != nil {
 		p := bootstrapPath.Child("cloudInit")
 
-		if linuxPrep != nil || sysPrep != nil || vAppConfig != nil {
+		if linuxPrep != nil || sysPrep != nil || vAppConfig != nil || ign != nil {
 			allErrs = append(allErrs, field.Forbidden(p,
 				"CloudInit may not be used with any other bootstrap provider"))
 		}
@@ -362,7 +414,11 @@ func (v validator) validateBootstrap(
 				allErrs = append(allErrs, field.Invalid(p, "cloudInit",
 					"cloudConfig and rawCloudConfig are mutually exclusive"))
 			}
-			allErrs = append(allErrs, cloudinitvalidate.CloudConfigJSONRawMessage(p, *v)...)
+			if errs := cloudinitvalidate.CloudConfig(p, *v); len(errs) > 0 {
+				allErrs = append(allErrs, errs...)
+			} else {
+				allErrs = append(allErrs, cloudinit.ValidateSchema(p, *v)...)
+			}
 		}
 
 	}
@@ -447,6 +503,47 @@ func (v validator) validateBootstrap(
 
 	}
 
+	if ign != nil {
+		allErrs = append(allErrs, v.validateIgnition(bootstrapPath.Child("ignition"), vm, ign)...)
+	}
+
+	return allErrs
+}
+
+func (v validator) validateIgnition(
+	p *field.Path,
+	vm *vmopv1.VirtualMachine,
+	ign *vmopv1.VirtualMachineBootstrapIgnitionSpec) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if bs := vm.Spec.Bootstrap; bs.CloudInit != nil || bs.LinuxPrep != nil ||
+		bs.Sysprep != nil || bs.VAppConfig != nil {
+
+		allErrs = append(allErrs, field.Forbidden(p,
+			"Ignition may not be used with any other bootstrap provider"))
+	}
+
+	c := p.Child("config")
+	switch config := ign.Config; {
+	case config.From != nil && config.Value != nil:
+		allErrs = append(allErrs, field.Invalid(c.Child("value"), "value",
+			"from and value are mutually exclusive"))
+	case config.From == nil && config.Value == nil:
+		allErrs = append(allErrs, field.Required(c,
+			"either from or value must be provided"))
+	case config.Value != nil:
+		// The Secret is not read by the webhook, so only an inline config
+		// is validated here.
+		plainText, err := pkgutil.TryToDecodeBase64Gzip([]byte(*config.Value))
+		if err == nil {
+			_, err = ignition.ValidateConfig([]byte(plainText))
+		}
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(c.Child("value"), "value", err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -558,10 +655,135 @@ func (v validator) validateImageOnCreate(
 		allErrs = append(allErrs, field.Required(f, ""))
 	case vm.Spec.Image.Kind == "":
 		allErrs = append(allErrs, field.Required(f.Child("kind"), invalidImageKind))
+	case vm.Spec.Image.Kind == vmKind || vm.Spec.Image.Kind == vmSnapKind:
+		allErrs = append(allErrs, v.validateCloneSourceOnCreate(ctx, vm)...)
 	case vm.Spec.Image.Kind != vmiKind && vm.Spec.Image.Kind != cvmiKind:
 		allErrs = append(allErrs, field.Invalid(f.Child("kind"), vm.Spec.Image.Kind, invalidImageKind))
 	}
 
+	if vm.Spec.CloneMode != "" && !vmopv1util.IsClonedVM(*vm) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec", "cloneMode"), vm.Spec.CloneMode, invalidCloneModeImageKind))
+	}
+
+	return allErrs
+}
+
+// validateCloneSourceOnCreate validates the VirtualMachine or
+// VirtualMachineSnapshot from which a new VM is cloned.
+func (v validator) validateCloneSourceOnCreate(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	var (
+		allErrs field.ErrorList
+		f       = field.NewPath("spec", "image", "name")
+		srcKey  = ctrlclient.ObjectKey{Namespace: vm.Namespace, Name: vm.Spec.Image.Name}
+	)
+
+	if vm.Spec.Image.Kind == vmSnapKind {
+		var snapshot vmopv1.VirtualMachineSnapshot
+		if err := v.client.Get(ctx, srcKey, &snapshot); err != nil {
+			if apierrors.IsNotFound(err) {
+				return append(allErrs, field.Invalid(f, srcKey.Name,
+					fmt.Sprintf(cloneSourceNotFoundFmt, vmSnapKind, srcKey.Name)))
+			}
+			return append(allErrs, field.InternalError(f, err))
+		}
+		if !conditions.IsTrue(&snapshot, vmopv1.VirtualMachineSnapshotReadyCondition) {
+			return append(allErrs, field.Invalid(f, srcKey.Name, cloneSourceSnapshotNotReady))
+		}
+
+		srcKey.Name = snapshot.Spec.VMName
+		if srcKey.Name == "" {
+			srcKey.Name = snapshot.Labels[vmopv1.VMNameForSnapshotLabel]
+		}
+	}
+
+	var srcVM vmopv1.VirtualMachine
+	if err := v.client.Get(ctx, srcKey, &srcVM); err != nil {
+		if apierrors.IsNotFound(err) {
+			return append(allErrs, field.Invalid(f, vm.Spec.Image.Name,
+				fmt.Sprintf(cloneSourceNotFoundFmt, vmKind, srcKey.Name)))
+		}
+		return append(allErrs, field.InternalError(f, err))
+	}
+
+	if srcVM.Status.UniqueID == "" {
+		return append(allErrs, field.Invalid(f, vm.Spec.Image.Name, cloneSourceNotCreated))
+	}
+
+	// The PVC volumes of a cloned VM are copied from the source VM's current
+	// PVCs, which do not have the data of the snapshot.
+	if vm.Spec.Image.Kind == vmSnapKind && vmopv1util.HasPVCVolumes(srcVM) {
+		allErrs = append(allErrs, field.Invalid(f, vm.Spec.Image.Name, cloneSourceSnapshotHasPVCs))
+	}
+
+	if vm.Spec.Image.Kind == vmKind {
+		switch vm.Spec.CloneMode {
+		case vmopv1.VirtualMachineCloneModeLinked:
+			if srcVM.Status.CurrentSnapshot == nil {
+				allErrs = append(allErrs, field.Invalid(f, vm.Spec.Image.Name, cloneSourceNoCurrentSnapshot))
+			}
+		default:
+			if srcVM.Status.PowerState != vmopv1.VirtualMachinePowerStateOff {
+				allErrs = append(allErrs, field.Invalid(f, vm.Spec.Image.Name, cloneSourceNotPoweredOff))
+			}
+		}
+	}
+
+	allErrs = append(allErrs, v.validateCloneClass(ctx, vm, &srcVM)...)
+
+	return allErrs
+}
+
+// validateCloneClass validates that the class of a cloned VM is compatible
+// with the class of the source VM. Since the clone carries over the source's
+// vGPU and DirectPath IO devices, a different class is only allowed when it
+// specifies the same devices.
+func (v validator) validateCloneClass(
+	ctx *pkgctx.WebhookRequestContext,
+	vm, srcVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if vm.Spec.ClassName == "" || vm.Spec.ClassName == srcVM.Spec.ClassName {
+		return allErrs
+	}
+
+	f := field.NewPath("spec", "className")
+
+	var srcDevices vmopv1.VirtualDevices
+	if srcVM.Spec.ClassName != "" {
+		var srcClass vmopv1.VirtualMachineClass
+		if err := v.client.Get(
+			ctx,
+			ctrlclient.ObjectKey{Namespace: srcVM.Namespace, Name: srcVM.Spec.ClassName},
+			&srcClass); err != nil {
+
+			return append(allErrs, field.InternalError(f, err))
+		}
+		srcDevices = srcClass.Spec.Hardware.Devices
+	}
+
+	var class vmopv1.VirtualMachineClass
+	if err := v.client.Get(
+		ctx,
+		ctrlclient.ObjectKey{Namespace: vm.Namespace, Name: vm.Spec.ClassName},
+		&class); err != nil {
+
+		if apierrors.IsNotFound(err) {
+			// The class's existence is verified when the VM is reconciled.
+			return allErrs
+		}
+		return append(allErrs, field.InternalError(f, err))
+	}
+
+	if !equality.Semantic.DeepEqual(class.Spec.Hardware.Devices, srcDevices) {
+		allErrs = append(allErrs, field.Invalid(f, vm.Spec.ClassName,
+			fmt.Sprintf(cloneClassNotCompatible, srcVM.Spec.ClassName)))
+	}
+
 	return allErrs
 }
 
@@ -786,6 +1008,7 @@ func (v validator) validateNetwork(
 		for i, interfaceSpec := range networkSpec.Interfaces {
 			allErrs = append(allErrs, v.validateNetworkInterfaceSpec(p.Index(i), interfaceSpec, vm.Name)...)
 			allErrs = append(allErrs, v.validateNetworkInterfaceSpecWithBootstrap(ctx, p.Index(i), interfaceSpec, vm)...)
+			allErrs = append(allErrs, v.validateNetworkInterfaceIPPool(ctx, p.Index(i), interfaceSpec)...)
 		}
 	}
 
@@ -793,6 +1016,10 @@ func (v validator) validateNetwork(
 		allErrs = append(allErrs, v.validateNetworkVLANs(networkPath.Child("vlans"), vm)...)
 	}
 
+	if len(networkSpec.Bonds) > 0 || len(networkSpec.Bridges) > 0 {
+		allErrs = append(allErrs, v.validateNetworkBondsAndBridges(networkPath, vm)...)
+	}
+
 	if oldVM != nil {
 		if pkgcfg.FromContext(ctx).Features.MutableNetworks {
 			allErrs = append(allErrs, v.validateNetworkInterfaceMacAddressNotChanged(ctx, vm, oldVM)...)
@@ -872,6 +1099,8 @@ func (v validator) validateNetworkVLANs(
 		interfaceNames = sets.New[string]()
 		// Track VLAN Link to an existing interface device name
 		interfaceDeviceNames = sets.New[string]()
+		// Track VLAN Link to an existing bond or bridge name
+		aggregateNames = sets.New[string]()
 		// Track VLAN IDs per link to detect duplicates.
 		vlanIDsPerLink = map[string]map[int64]string{}
 	)
@@ -882,6 +1111,12 @@ func (v validator) validateNetworkVLANs(
 			interfaceDeviceNames.Insert(iface.GuestDeviceName)
 		}
 	}
+	for _, bond := range networkSpec.Bonds {
+		aggregateNames.Insert(bond.Name)
+	}
+	for _, bridge := range networkSpec.Bridges {
+		aggregateNames.Insert(bridge.Name)
+	}
 
 	for i, vlanSpec := range networkSpec.VLANs {
 		vlanPath := vlansPath.Index(i)
@@ -905,10 +1140,10 @@ func (v validator) validateNetworkVLANs(
 			))
 			continue
 		}
-		// Validate Link references an existing interface.
-		if !interfaceNames.Has(vlanSpec.Link) {
+		// Validate Link references an existing interface, bond, or bridge.
+		if !interfaceNames.Has(vlanSpec.Link) && !aggregateNames.Has(vlanSpec.Link) {
 			allErrs = append(allErrs, field.Invalid(vlanPath.Child("link"), vlanSpec.Link,
-				"link must reference an existing interface name",
+				"link must reference an existing interface, bond, or bridge name",
 			))
 			continue
 		}
@@ -930,6 +1165,141 @@ func (v validator) validateNetworkVLANs(
 	return allErrs
 }
 
+// validateNetworkBondsAndBridges validates the bonds and bridges configuration
+// in the network spec. Bonds and bridges are only supported with CloudInit
+// bootstrap provider.
+//
+//nolint:gocyclo
+func (v validator) validateNetworkBondsAndBridges(
+	networkPath *field.Path,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	networkSpec := vm.Spec.Network
+	bondsPath := networkPath.Child("bonds")
+	bridgesPath := networkPath.Child("bridges")
+
+	if vm.Spec.Bootstrap == nil || vm.Spec.Bootstrap.CloudInit == nil {
+		if len(networkSpec.Bonds) > 0 {
+			allErrs = append(allErrs, field.Forbidden(
+				bondsPath, "bonds is available only with the following bootstrap providers: CloudInit",
+			))
+		}
+		if len(networkSpec.Bridges) > 0 {
+			allErrs = append(allErrs, field.Forbidden(
+				bridgesPath, "bridges is available only with the following bootstrap providers: CloudInit",
+			))
+		}
+		return allErrs
+	}
+
+	var (
+		// Track the names that a bond or bridge name may not conflict with.
+		usedNames = sets.New[string]()
+		// Track bond and bridge members to an existing interface name.
+		interfaceNames = sets.New[string]()
+		// Track bridge members to an existing bond name.
+		bondNames = sets.New[string]()
+		// Track the bond or bridge that each interface is a member of.
+		memberOf = map[string]string{}
+	)
+
+	for _, iface := range networkSpec.Interfaces {
+		interfaceNames.Insert(iface.Name)
+		usedNames.Insert(iface.Name)
+		if iface.GuestDeviceName != "" {
+			usedNames.Insert(iface.GuestDeviceName)
+		}
+	}
+	for _, vlan := range networkSpec.VLANs {
+		usedNames.Insert(vlan.Name)
+	}
+
+	validateMembers := func(
+		membersPath *field.Path,
+		aggregateName string,
+		members []string,
+		isMember func(string) bool,
+		errMsg string) {
+
+		for j, member := range members {
+			memberPath := membersPath.Index(j)
+			if !isMember(member) {
+				allErrs = append(allErrs, field.Invalid(memberPath, member, errMsg))
+				continue
+			}
+			if owner, ok := memberOf[member]; ok {
+				allErrs = append(allErrs, field.Invalid(memberPath, member,
+					fmt.Sprintf("interface %q is already a member of %q", member, owner),
+				))
+				continue
+			}
+			memberOf[member] = aggregateName
+		}
+	}
+
+	for i, bond := range networkSpec.Bonds {
+		bondPath := bondsPath.Index(i)
+
+		if usedNames.Has(bond.Name) {
+			allErrs = append(allErrs, field.Invalid(bondPath.Child("name"), bond.Name,
+				"bond name must not conflict with an interface name, guestDeviceName, vlan, or bridge",
+			))
+		}
+		usedNames.Insert(bond.Name)
+		bondNames.Insert(bond.Name)
+
+		if len(bond.Interfaces) == 0 {
+			allErrs = append(allErrs, field.Required(bondPath.Child("interfaces"),
+				"interfaces must reference at least one interface name",
+			))
+			continue
+		}
+
+		validateMembers(bondPath.Child("interfaces"), bond.Name, bond.Interfaces,
+			interfaceNames.Has, "interfaces must reference existing interface names")
+
+		if p := bond.Parameters; p != nil {
+			paramsPath := bondPath.Child("parameters")
+			if p.Primary != "" && !slices.Contains(bond.Interfaces, p.Primary) {
+				allErrs = append(allErrs, field.Invalid(paramsPath.Child("primary"), p.Primary,
+					"primary must reference a member of the bond",
+				))
+			}
+			if p.LACPRate != "" && p.Mode != vmopv1.VirtualMachineNetworkBondMode8023AD {
+				allErrs = append(allErrs, field.Invalid(paramsPath.Child("lacpRate"), p.LACPRate,
+					fmt.Sprintf("lacpRate is only valid with mode %s", vmopv1.VirtualMachineNetworkBondMode8023AD),
+				))
+			}
+		}
+	}
+
+	for i, bridge := range networkSpec.Bridges {
+		bridgePath := bridgesPath.Index(i)
+
+		if usedNames.Has(bridge.Name) {
+			allErrs = append(allErrs, field.Invalid(bridgePath.Child("name"), bridge.Name,
+				"bridge name must not conflict with an interface name, guestDeviceName, vlan, or bond",
+			))
+		}
+		usedNames.Insert(bridge.Name)
+
+		if len(bridge.Interfaces) == 0 {
+			allErrs = append(allErrs, field.Required(bridgePath.Child("interfaces"),
+				"interfaces must reference at least one interface or bond name",
+			))
+			continue
+		}
+
+		validateMembers(bridgePath.Child("interfaces"), bridge.Name, bridge.Interfaces,
+			func(name string) bool { return interfaceNames.Has(name) || bondNames.Has(name) },
+			"interfaces must reference existing interface or bond names")
+	}
+
+	return allErrs
+}
+
 // Note the code for VDS is basically done, but only support this for VPC right
 // now since that is what matters.
 var macAddressSupportNetworkGroups = []string{
@@ -1002,7 +1372,7 @@ func (v validator) validateNetworkInterfaceSpec(
 	if ipv4 := interfaceSpec.Gateway4; ipv4 != "" && ipv4 != "None" {
 		p := interfacePath.Child("gateway4")
 
-		if len(ipv4Addrs) == 0 {
+		if len(ipv4Addrs) == 0 && interfaceSpec.IPPoolName == "" {
 			allErrs = append(allErrs, field.Invalid(p, ipv4, "gateway4 must have an IPv4 address in the addresses field"))
 		}
 
@@ -1014,7 +1384,7 @@ func (v validator) validateNetworkInterfaceSpec(
 	if ipv6 := interfaceSpec.Gateway6; ipv6 != "" && ipv6 != "None" {
 		p := interfacePath.Child("gateway6")
 
-		if len(ipv6Addrs) == 0 {
+		if len(ipv6Addrs) == 0 && interfaceSpec.IPPoolName == "" {
 			allErrs = append(allErrs, field.Invalid(p, ipv6, "gateway6 must have an IPv6 address in the addresses field"))
 		}
 
@@ -1087,6 +1457,46 @@ func (v validator) validateNetworkInterfaceSpec(
 	return allErrs
 }
 
+// validateNetworkInterfaceIPPool validates an interface's reference to an IP
+// pool. Whether the pool exists is not validated since the VM's addresses are
+// allocated when the VM is reconciled.
+func (v validator) validateNetworkInterfaceIPPool(
+	ctx *pkgctx.WebhookRequestContext,
+	interfacePath *field.Path,
+	interfaceSpec vmopv1.VirtualMachineNetworkInterfaceSpec) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if interfaceSpec.IPPoolName == "" {
+		return allErrs
+	}
+
+	p := interfacePath.Child("ipPoolName")
+
+	if pkgcfg.FromContext(ctx).NetworkProviderType != pkgcfg.NetworkProviderTypeNamed {
+		allErrs = append(allErrs, field.Forbidden(p, ipPoolNameRequiresNamedNetwork))
+	}
+
+	for _, msg := range validation.NameIsDNSSubdomain(interfaceSpec.IPPoolName, false) {
+		allErrs = append(allErrs, field.Invalid(p, interfaceSpec.IPPoolName, msg))
+	}
+
+	if len(interfaceSpec.Addresses) > 0 {
+		allErrs = append(allErrs, field.Invalid(interfacePath.Child("addresses"),
+			strings.Join(interfaceSpec.Addresses, ","), ipPoolNameMutuallyExclusive))
+	}
+	if interfaceSpec.DHCP4 {
+		allErrs = append(allErrs, field.Invalid(interfacePath.Child("dhcp4"),
+			interfaceSpec.DHCP4, ipPoolNameMutuallyExclusive))
+	}
+	if interfaceSpec.DHCP6 {
+		allErrs = append(allErrs, field.Invalid(interfacePath.Child("dhcp6"),
+			interfaceSpec.DHCP6, ipPoolNameMutuallyExclusive))
+	}
+
+	return allErrs
+}
+
 func (v validator) validateNetworkSpecWithBootStrap(
 	_ *pkgctx.WebhookRequestContext,
 	networkPath *field.Path,
@@ -1097,6 +1507,7 @@ func (v validator) validateNetworkSpecWithBootStrap(
 		cloudInit   *vmopv1.VirtualMachineBootstrapCloudInitSpec
 		linuxPrep   *vmopv1.VirtualMachineBootstrapLinuxPrepSpec
 		sysPrep     *vmopv1.VirtualMachineBootstrapSysprepSpec
+		ign         *vmopv1.VirtualMachineBootstrapIgnitionSpec
 		allErrs     field.ErrorList
 	)
 
@@ -1104,6 +1515,7 @@ func (v validator) validateNetworkSpecWithBootStrap(
 		cloudInit = vm.Spec.Bootstrap.CloudInit
 		linuxPrep = vm.Spec.Bootstrap.LinuxPrep
 		sysPrep = vm.Spec.Bootstrap.Sysprep
+		ign = vm.Spec.Bootstrap.Ignition
 	}
 
 	if len(networkSpec.Nameservers) > 0 {
@@ -1115,11 +1527,11 @@ func (v validator) validateNetworkSpecWithBootStrap(
 					"nameservers is available only for CloudInit when UseGlobalNameserversAsDefault is true",
 				))
 			}
-		} else if linuxPrep == nil && sysPrep == nil {
+		} else if linuxPrep == nil && sysPrep == nil && ign == nil {
 			allErrs = append(allErrs, field.Invalid(
 				networkPath.Child("nameservers"),
 				strings.Join(networkSpec.Nameservers, ","),
-				"nameservers is available only with the following bootstrap providers: LinuxPrep and Sysprep",
+				"nameservers is available only with the following bootstrap providers: Ignition, LinuxPrep, and Sysprep",
 			))
 		}
 	}
@@ -1133,11 +1545,11 @@ func (v validator) validateNetworkSpecWithBootStrap(
 					"searchDomains is available only for CloudInit when UseGlobalSearchDomainsAsDefault is true",
 				))
 			}
-		} else if linuxPrep == nil && sysPrep == nil {
+		} else if linuxPrep == nil && sysPrep == nil && ign == nil {
 			allErrs = append(allErrs, field.Invalid(
 				networkPath.Child("searchDomains"),
 				strings.Join(networkSpec.SearchDomains, ","),
-				"searchDomains is available only with the following bootstrap providers: LinuxPrep and Sysprep",
+				"searchDomains is available only with the following bootstrap providers: Ignition, LinuxPrep, and Sysprep",
 			))
 		}
 	}
@@ -1145,8 +1557,9 @@ func (v validator) validateNetworkSpecWithBootStrap(
 	return allErrs
 }
 
-// MTU, routes, and searchDomains are available only with CloudInit.
-// Nameservers is available only with CloudInit and Sysprep.
+// GuestDeviceName, MTU, routes, and searchDomains are available only with
+// CloudInit and Ignition. Nameservers is available only with CloudInit,
+// Ignition, and Sysprep.
 func (v validator) validateNetworkInterfaceSpecWithBootstrap(
 	_ *pkgctx.WebhookRequestContext,
 	interfacePath *field.Path,
@@ -1158,60 +1571,62 @@ func (v validator) validateNetworkInterfaceSpecWithBootstrap(
 	var (
 		cloudInit *vmopv1.VirtualMachineBootstrapCloudInitSpec
 		sysPrep   *vmopv1.VirtualMachineBootstrapSysprepSpec
+		ign       *vmopv1.VirtualMachineBootstrapIgnitionSpec
 	)
 
 	if vm.Spec.Bootstrap != nil {
 		cloudInit = vm.Spec.Bootstrap.CloudInit
 		sysPrep = vm.Spec.Bootstrap.Sysprep
+		ign = vm.Spec.Bootstrap.Ignition
 	}
 
 	if guestDeviceName := interfaceSpec.GuestDeviceName; guestDeviceName != "" {
-		if cloudInit == nil {
+		if cloudInit == nil && ign == nil {
 			allErrs = append(allErrs, field.Invalid(
 				interfacePath.Child("guestDeviceName"),
 				guestDeviceName,
-				"guestDeviceName is available only with the following bootstrap providers: CloudInit",
+				"guestDeviceName is available only with the following bootstrap providers: CloudInit and Ignition",
 			))
 		}
 	}
 
 	if mtu := interfaceSpec.MTU; mtu != nil {
-		if cloudInit == nil {
+		if cloudInit == nil && ign == nil {
 			allErrs = append(allErrs, field.Invalid(
 				interfacePath.Child("mtu"),
 				mtu,
-				"mtu is available only with the following bootstrap providers: CloudInit",
+				"mtu is available only with the following bootstrap providers: CloudInit and Ignition",
 			))
 		}
 	}
 
 	if routes := interfaceSpec.Routes; len(routes) > 0 {
-		if cloudInit == nil {
+		if cloudInit == nil && ign == nil {
 			allErrs = append(allErrs, field.Invalid(
 				interfacePath.Child("routes"),
 				// Not exposing routes here in error message
 				"routes",
-				"routes is available only with the following bootstrap providers: CloudInit",
+				"routes is available only with the following bootstrap providers: CloudInit and Ignition",
 			))
 		}
 	}
 
 	if nameservers := interfaceSpec.Nameservers; len(nameservers) > 0 {
-		if cloudInit == nil && sysPrep == nil {
+		if cloudInit == nil && ign == nil && sysPrep == nil {
 			allErrs = append(allErrs, field.Invalid(
 				interfacePath.Child("nameservers"),
 				strings.Join(nameservers, ","),
-				"nameservers is available only with the following bootstrap providers: CloudInit and Sysprep",
+				"nameservers is available only with the following bootstrap providers: CloudInit, Ignition, and Sysprep",
 			))
 		}
 	}
 
 	if searchDomains := interfaceSpec.SearchDomains; len(searchDomains) > 0 {
-		if cloudInit == nil {
+		if cloudInit == nil && ign == nil {
 			allErrs = append(allErrs, field.Invalid(
 				interfacePath.Child("searchDomains"),
 				strings.Join(searchDomains, ","),
-				"searchDomains is available only with the following bootstrap providers: CloudInit",
+				"searchDomains is available only with the following bootstrap providers: CloudInit and Ignition",
 			))
 		}
 	}
@@ -1799,12 +2214,74 @@ func (v validator) validateReadinessProbe(
 		return allErrs
 	}
 
-	readinessProbePath := field.NewPath("spec", "readinessProbe")
+	return v.validateProbeActions(ctx, field.NewPath("spec", "readinessProbe"), probe)
+}
+
+func (v validator) validateLivenessProbe(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	probe := vm.Spec.LivenessProbe
+	if probe == nil {
+		return allErrs
+	}
+
+	return v.validateProbeActions(
+		ctx,
+		field.NewPath("spec", "livenessProbe"),
+		&vmopv1.VirtualMachineReadinessProbeSpec{
+			TCPSocket:      probe.TCPSocket,
+			HTTPGet:        probe.HTTPGet,
+			Exec:           probe.Exec,
+			GuestHeartbeat: probe.GuestHeartbeat,
+			GuestInfo:      probe.GuestInfo,
+		})
+}
+
+func (v validator) validateStartupProbe(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	probe := vm.Spec.StartupProbe
+	if probe == nil {
+		return allErrs
+	}
+
+	return v.validateProbeActions(
+		ctx,
+		field.NewPath("spec", "startupProbe"),
+		&vmopv1.VirtualMachineReadinessProbeSpec{
+			TCPSocket:      probe.TCPSocket,
+			HTTPGet:        probe.HTTPGet,
+			Exec:           probe.Exec,
+			GuestHeartbeat: probe.GuestHeartbeat,
+			GuestInfo:      probe.GuestInfo,
+		})
+}
+
+// validateProbeActions validates the actions of a readiness, startup, or
+// liveness probe.
+func (v validator) validateProbeActions(
+	ctx *pkgctx.WebhookRequestContext,
+	probePath *field.Path,
+	probe *vmopv1.VirtualMachineReadinessProbeSpec) field.ErrorList {
+
+	var allErrs field.ErrorList
 
 	actionsCnt := 0
 	if probe.TCPSocket != nil {
 		actionsCnt++
 	}
+	if probe.HTTPGet != nil {
+		actionsCnt++
+	}
+	if probe.Exec != nil {
+		actionsCnt++
+	}
 	if probe.GuestHeartbeat != nil {
 		actionsCnt++
 	}
@@ -1812,11 +2289,11 @@ func (v validator) validateReadinessProbe(
 		actionsCnt++
 	}
 	if actionsCnt > 1 {
-		allErrs = append(allErrs, field.Forbidden(readinessProbePath, readinessProbeOnlyOneAction))
+		allErrs = append(allErrs, field.Forbidden(probePath, readinessProbeOnlyOneAction))
 	}
 
 	if probe.TCPSocket != nil {
-		tcpSocketPath := readinessProbePath.Child("tcpSocket")
+		tcpSocketPath := probePath.Child("tcpSocket")
 
 		// TCP readiness probe is not allowed under VPC Networking
 		if pkgcfg.FromContext(ctx).NetworkProviderType == pkgcfg.NetworkProviderTypeVPC {
@@ -1834,9 +2311,64 @@ func (v validator) validateReadinessProbe(
 		}
 	}
 
+	if probe.HTTPGet != nil {
+		httpGetPath := probePath.Child("httpGet")
+
+		// HTTP readiness probe is not allowed under VPC Networking
+		if pkgcfg.FromContext(ctx).NetworkProviderType == pkgcfg.NetworkProviderTypeVPC {
+			allErrs = append(allErrs, field.Forbidden(httpGetPath, httpReadinessProbeNotAllowedVPC))
+		} else if probe.HTTPGet.Port.IntValue() != allowedRestrictedNetworkTCPProbePort {
+			// Validate port if environment is a restricted network environment between SV CP VMs and Workload VMs e.g. VMC.
+			isRestrictedEnv, err := v.isNetworkRestrictedForReadinessProbe(ctx)
+			if err != nil {
+				allErrs = append(allErrs, field.Forbidden(httpGetPath, err.Error()))
+			} else if isRestrictedEnv {
+				allErrs = append(allErrs,
+					field.NotSupported(httpGetPath.Child("port"), probe.HTTPGet.Port.IntValue(),
+						[]string{strconv.Itoa(allowedRestrictedNetworkTCPProbePort)}))
+			}
+		}
+
+		for i, r := range probe.HTTPGet.ExpectedStatuses {
+			if r.Max != 0 && r.Max < r.Min {
+				allErrs = append(allErrs, field.Invalid(
+					httpGetPath.Child("expectedStatuses").Index(i).Child("max"),
+					r.Max,
+					"must be greater than or equal to min"))
+			}
+		}
+	}
+
+	if probe.Exec != nil {
+		execPath := probePath.Child("exec")
+
+		if len(probe.Exec.Command) == 0 {
+			allErrs = append(allErrs, field.Required(execPath.Child("command"), ""))
+		} else if !isAbsoluteGuestPath(probe.Exec.Command[0]) {
+			allErrs = append(allErrs, field.Invalid(
+				execPath.Child("command").Index(0),
+				probe.Exec.Command[0],
+				execProbeCommandNotAbsolute))
+		}
+
+		if probe.Exec.SecretName == "" {
+			allErrs = append(allErrs, field.Required(execPath.Child("secretName"), ""))
+		}
+	}
+
 	return allErrs
 }
 
+// windowsAbsolutePathRegex matches a Windows path with a drive letter, ex.
+// C:\, or a UNC path, ex. \\server\share.
+var windowsAbsolutePathRegex = regexp.MustCompile(`^([a-zA-Z]:[\\/]|\\\\)`)
+
+// isAbsoluteGuestPath returns true if p is an absolute path on a Linux or
+// Windows guest.
+func isAbsoluteGuestPath(p string) bool {
+	return strings.HasPrefix(p, "/") || windowsAbsolutePathRegex.MatchString(p)
+}
+
 var megaByte = resource.MustParse("1Mi")
 
 func (v validator) validateAdvanced(
@@ -2112,10 +2644,33 @@ func (v validator) validateHardware(
 
 	allErrs = append(allErrs, v.validateCdrom(ctx, newVM, oldVM)...)
 	allErrs = append(allErrs, v.validateControllers(ctx, newVM, oldVM)...)
+	allErrs = append(allErrs, v.validateSerialConsole(ctx, newVM, oldVM)...)
 
 	return allErrs
 }
 
+// validateSerialConsole disallows enabling the serial console when the
+// feature is not enabled. A serial console that is already enabled may remain
+// enabled so the VM can still be updated.
+func (v validator) validateSerialConsole(
+	ctx *pkgctx.WebhookRequestContext,
+	newVM, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	if pkgcfg.FromContext(ctx).Features.VMSerialConsole ||
+		!vmconfserialport.IsEnabled(newVM) ||
+		(oldVM != nil && vmconfserialport.IsEnabled(oldVM)) {
+
+		return nil
+	}
+
+	return field.ErrorList{
+		field.Invalid(
+			field.NewPath("spec", "hardware", "serialConsole", "enabled"),
+			true,
+			fmt.Sprintf(featureNotEnabled, "Serial Console")),
+	}
+}
+
 func (v validator) validateHardwareWhenPoweredOn(
 	ctx *pkgctx.WebhookRequestContext,
 	newVM, oldVM *vmopv1.VirtualMachine) field.ErrorList {
@@ -2138,6 +2693,7 @@ func (v validator) validateImmutableFields(
 	allErrs = append(allErrs, v.validateImageOnUpdate(ctx, vm, oldVM)...)
 	allErrs = append(allErrs, v.validateClassOnUpdate(ctx, vm, oldVM)...)
 	allErrs = append(allErrs, validation.ValidateImmutableField(vm.Spec.StorageClass, oldVM.Spec.StorageClass, specPath.Child("storageClass"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(vm.Spec.CloneMode, oldVM.Spec.CloneMode, specPath.Child("cloneMode"))...)
 	// New VMs always have non-empty biosUUID. Existing VMs being upgraded may have an empty biosUUID.
 	if oldVM.Spec.BiosUUID != "" {
 		allErrs = append(allErrs, validation.ValidateImmutableField(vm.Spec.BiosUUID, oldVM.Spec.BiosUUID, specPath.Child("biosUUID"))...)
@@ -2310,6 +2866,10 @@ func (v validator) validateAnnotation(ctx *pkgctx.WebhookRequestContext, vm, old
 		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.FirstBootDoneAnnotation), modifyAnnotationNotAllowedForNonAdmin))
 	}
 
+	if vm.Annotations[vmopv1.SerialConsolePortAnnotation] != oldVM.Annotations[vmopv1.SerialConsolePortAnnotation] {
+		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.SerialConsolePortAnnotation), modifyAnnotationNotAllowedForNonAdmin))
+	}
+
 	if vm.Annotations[vmopv1.RestoredVMAnnotation] != oldVM.Annotations[vmopv1.RestoredVMAnnotation] {
 		allErrs = append(allErrs, field.Forbidden(annotationPath.Key(vmopv1.RestoredVMAnnotation), modifyAnnotationNotAllowedForNonAdmin))
 	}
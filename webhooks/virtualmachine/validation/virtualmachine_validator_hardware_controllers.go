@@ -464,7 +464,7 @@ func (v validator) getVMImageConfigSpec(
 	vm *vmopv1.VirtualMachine) (
 	vimtypes.VirtualMachineConfigSpec, error) {
 
-	if vmopv1util.IsImagelessVM(*vm) {
+	if vmopv1util.IsImagelessVM(*vm) || vmopv1util.IsClonedVM(*vm) {
 		return vimtypes.VirtualMachineConfigSpec{}, nil
 	}
 
@@ -35,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha6/sysprep"
 	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	pkgbuilder "github.com/vmware-tanzu/vm-operator/pkg/builder"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
@@ -68,7 +69,7 @@ const (
 	invalidKind                    = "InvalidKind"
 	newVMClass                     = "new-class"
 	oldVMClass                     = "old-class"
-	invalidImageKindMsg            = "supported: " + vmiKind + "; " + cvmiKind
+	invalidImageKindMsg            = "supported: " + vmiKind + "; " + cvmiKind + "; VirtualMachine; VirtualMachineSnapshot"
 	testBuildVersion               = "v1.0.0"
 
 	invalidClassInstanceReference              = "must specify a valid reference to a VirtualMachineClassInstance object"
@@ -473,6 +474,43 @@ func unitTestsValidateCreate() {
 		)
 	})
 
+	Context("Serial console", func() {
+		DescribeTable("create", doTest,
+			Entry("should disallow enabling the serial console when the feature is disabled",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						if ctx.vm.Spec.Hardware == nil {
+							ctx.vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{}
+						}
+						ctx.vm.Spec.Hardware.SerialConsole = &vmopv1.VirtualMachineSerialConsoleSpec{
+							Enabled: true,
+						}
+					},
+					expectAllowed: false,
+					validate: doValidateWithMsg(
+						field.Invalid(specPath.Child("hardware", "serialConsole", "enabled"), true, "the Serial Console feature is not enabled").Error(),
+					),
+				},
+			),
+			Entry("should allow enabling the serial console when the feature is enabled",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.Features.VMSerialConsole = true
+						})
+						if ctx.vm.Spec.Hardware == nil {
+							ctx.vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{}
+						}
+						ctx.vm.Spec.Hardware.SerialConsole = &vmopv1.VirtualMachineSerialConsoleSpec{
+							Enabled: true,
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
 	Context("availability zone and zone", func() {
 		DescribeTable("create", doTest,
 			Entry("should allow when VM specifies no availability zone, there are availability zones and zones",
@@ -1351,6 +1389,7 @@ func unitTestsValidateCreate() {
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Annotations[vmopv1.InstanceIDAnnotation] = dummyInstanceIDVal
 						ctx.vm.Annotations[vmopv1.FirstBootDoneAnnotation] = dummyFirstBootDoneVal
+						ctx.vm.Annotations[vmopv1.SerialConsolePortAnnotation] = "50000"
 						ctx.vm.Annotations[vmopv1.RestoredVMAnnotation] = dummyRegisteredAnnVal
 						ctx.vm.Annotations[vmopv1.ImportedVMAnnotation] = dummyImportedAnnVal
 						ctx.vm.Annotations[vmopv1.FailedOverVMAnnotation] = dummyFailedOverAnnVal
@@ -1363,6 +1402,7 @@ func unitTestsValidateCreate() {
 						field.Forbidden(annotationPath.Key(vmopv1.FailedOverVMAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(vmopv1.InstanceIDAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(vmopv1.FirstBootDoneAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
+						field.Forbidden(annotationPath.Key(vmopv1.SerialConsolePortAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(anno2extraconfig.ManagementProxyAllowListAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 						field.Forbidden(annotationPath.Key(anno2extraconfig.ManagementProxyWatermarkAnnotation), "modifying this annotation is not allowed for non-admin users").Error(),
 					),
@@ -1375,6 +1415,7 @@ func unitTestsValidateCreate() {
 
 						ctx.vm.Annotations[vmopv1.InstanceIDAnnotation] = dummyInstanceIDVal
 						ctx.vm.Annotations[vmopv1.FirstBootDoneAnnotation] = dummyFirstBootDoneVal
+						ctx.vm.Annotations[vmopv1.SerialConsolePortAnnotation] = "50000"
 						ctx.vm.Annotations[vmopv1.RestoredVMAnnotation] = dummyRegisteredAnnVal
 						ctx.vm.Annotations[vmopv1.ImportedVMAnnotation] = dummyImportedAnnVal
 						ctx.vm.Annotations[vmopv1.FailedOverVMAnnotation] = dummyFailedOverAnnVal
@@ -1591,6 +1632,258 @@ func unitTestsValidateCreate() {
 					expectAllowed: true,
 				},
 			),
+			Entry("should fail when Readiness probe has TCP and HTTP actions",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							TCPSocket: &vmopv1.TCPSocketAction{Port: intstr.FromInt(6443)},
+							HTTPGet:   &vmopv1.HTTPGetAction{Port: intstr.FromInt(6443)},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe: Forbidden: only one action can be specified`),
+				},
+			),
+			Entry("should deny when HTTP readiness probe is specified under VPC networking",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							HTTPGet: &vmopv1.HTTPGetAction{},
+						}
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+						})
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe.httpGet: Forbidden: VPC networking doesn't allow HTTP readiness probe to be specified`),
+				},
+			),
+			Entry("should deny when restricted network and HTTP port in readiness probe is not 6443",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						cm := &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      config.ProviderConfigMapName,
+								Namespace: ctx.Namespace,
+							},
+							Data: map[string]string{
+								"IsRestrictedNetwork": "true",
+							},
+						}
+						Expect(ctx.Client.Create(ctx, cm)).To(Succeed())
+
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							HTTPGet: &vmopv1.HTTPGetAction{Port: intstr.FromInt(443)},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe.httpGet.port: Unsupported value: 443: supported values: "6443"`),
+				},
+			),
+			Entry("should deny when HTTP readiness probe has an expected status range with max less than min",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							HTTPGet: &vmopv1.HTTPGetAction{
+								Port: intstr.FromInt(6443),
+								ExpectedStatuses: []vmopv1.HTTPStatusRange{
+									{Min: 200},
+									{Min: 300, Max: 204},
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe.httpGet.expectedStatuses[1].max: Invalid value: 204: must be greater than or equal to min`),
+				},
+			),
+			Entry("should allow HTTP readiness probe",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						cm := &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      config.ProviderConfigMapName,
+								Namespace: ctx.Namespace,
+							},
+							Data: make(map[string]string),
+						}
+						Expect(ctx.Client.Create(ctx, cm)).To(Succeed())
+
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							HTTPGet: &vmopv1.HTTPGetAction{
+								Path:   "/healthz",
+								Port:   intstr.FromInt(8080),
+								Scheme: vmopv1.URISchemeHTTPS,
+								ExpectedStatuses: []vmopv1.HTTPStatusRange{
+									{Min: 200, Max: 299},
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should fail when exec readiness probe is specified with another action",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							Exec: &vmopv1.ExecAction{
+								Command:    []string{"/usr/local/bin/check-ready"},
+								SecretName: "guest-creds",
+							},
+							GuestHeartbeat: &vmopv1.GuestHeartbeatAction{},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe: Forbidden: only one action can be specified`),
+				},
+			),
+			Entry("should fail when exec readiness probe command is not an absolute path",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							Exec: &vmopv1.ExecAction{
+								Command:    []string{"check-ready"},
+								SecretName: "guest-creds",
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe.exec.command[0]: Invalid value: "check-ready": must be an absolute path`),
+				},
+			),
+			Entry("should allow exec readiness probe with a Windows command",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							Exec: &vmopv1.ExecAction{
+								Command:    []string{`C:\Windows\System32\cmd.exe`, "/c", "exit 0"},
+								SecretName: "guest-creds",
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should fail when exec readiness probe secret name is empty",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							Exec: &vmopv1.ExecAction{
+								Command: []string{"/usr/local/bin/check-ready"},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.readinessProbe.exec.secretName: Required value`),
+				},
+			),
+			Entry("should allow exec readiness probe under VPC networking",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							Exec: &vmopv1.ExecAction{
+								Command:    []string{"/usr/local/bin/check-ready", "--quiet"},
+								SecretName: "guest-creds",
+							},
+						}
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+						})
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
+	Context("Liveness Probe", func() {
+
+		DescribeTable("create", doTest,
+			Entry("should fail when Liveness probe has multiple actions",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeSpec{
+							GuestInfo: []vmopv1.GuestInfoAction{
+								{
+									Key: "my-key",
+								},
+							},
+							GuestHeartbeat: &vmopv1.GuestHeartbeatAction{},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.livenessProbe: Forbidden: only one action can be specified`),
+				},
+			),
+			Entry("should deny when TCP liveness probe is specified under VPC networking",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeSpec{
+							TCPSocket: &vmopv1.TCPSocketAction{},
+						}
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+						})
+					},
+					validate: doValidateWithMsg(
+						`spec.livenessProbe.tcpSocket: Forbidden: VPC networking doesn't allow TCP readiness probe to be specified`),
+				},
+			),
+			Entry("should allow guest heartbeat liveness probe",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.LivenessProbe = &vmopv1.VirtualMachineLivenessProbeSpec{
+							GuestHeartbeat:      &vmopv1.GuestHeartbeatAction{},
+							InitialDelaySeconds: 60,
+							FailureThreshold:    3,
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
+	Context("Startup Probe", func() {
+
+		DescribeTable("create", doTest,
+			Entry("should fail when Startup probe has multiple actions",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.StartupProbe = &vmopv1.VirtualMachineStartupProbeSpec{
+							GuestInfo: []vmopv1.GuestInfoAction{
+								{
+									Key: "my-key",
+								},
+							},
+							GuestHeartbeat: &vmopv1.GuestHeartbeatAction{},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.startupProbe: Forbidden: only one action can be specified`),
+				},
+			),
+			Entry("should allow guest info startup probe",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.StartupProbe = &vmopv1.VirtualMachineStartupProbeSpec{
+							GuestInfo: []vmopv1.GuestInfoAction{
+								{
+									Key: "my-key",
+								},
+							},
+							FailureThreshold: 60,
+						}
+						ctx.vm.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
+							GuestHeartbeat:   &vmopv1.GuestHeartbeatAction{},
+							SuccessThreshold: 2,
+							FailureThreshold: 3,
+						}
+					},
+					expectAllowed: true,
+				},
+			),
 		)
 	})
 
@@ -1966,6 +2259,96 @@ func unitTestsValidateCreate() {
 					expectAllowed: true,
 				},
 			),
+			Entry("allow Ignition with config from Secret",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: common.ValueOrSecretKeySelector{
+									From: &common.SecretKeySelector{Name: "my-secret", Key: "config.ign"},
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("allow Ignition with inline config",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: common.ValueOrSecretKeySelector{
+									Value: ptr.To(`{"ignition":{"version":"3.4.0"}}`),
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow Ignition with inline config with unsupported version",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: common.ValueOrSecretKeySelector{
+									Value: ptr.To(`{"ignition":{"version":"2.3.0"}}`),
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition.config.value: Invalid value: "value": unsupported ignition config version "2.3.0", must be one of: 3.0.0, 3.1.0, 3.2.0, 3.3.0, 3.4.0, 3.5.0`,
+					),
+				},
+			),
+			Entry("disallow Ignition without config",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition.config: Required value: either from or value must be provided`,
+					),
+				},
+			),
+			Entry("disallow Ignition with config from Secret and inline",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: common.ValueOrSecretKeySelector{
+									From:  &common.SecretKeySelector{Name: "my-secret", Key: "config.ign"},
+									Value: ptr.To(`{"ignition":{"version":"3.4.0"}}`),
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition.config.value: Invalid value: "value": from and value are mutually exclusive`,
+					),
+				},
+			),
+			Entry("disallow Ignition and vAppConfig specified at the same time",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: common.ValueOrSecretKeySelector{
+									From: &common.SecretKeySelector{Name: "my-secret", Key: "config.ign"},
+								},
+							},
+							VAppConfig: &vmopv1.VirtualMachineBootstrapVAppConfigSpec{},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition: Forbidden: Ignition may not be used with any other bootstrap provider`,
+					),
+				},
+			),
 			Entry("disallow CloudInit mixing inline CloudConfig and RawCloudConfig",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -1981,6 +2364,45 @@ func unitTestsValidateCreate() {
 					),
 				},
 			),
+			Entry("disallow CloudInit inline CloudConfig with invalid modules",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								CloudConfig: &cloudinit.CloudConfig{
+									CACerts: &cloudinit.CACerts{
+										Trusted: []common.ValueOrSecretKeySelector{{}},
+									},
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.cloudInit.cloudConfig.ca_certs.trusted[0]: Required value: either from or value must be provided`,
+					),
+				},
+			),
+			Entry("allow CloudInit inline CloudConfig with modules that reference data from secrets",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								CloudConfig: &cloudinit.CloudConfig{
+									Timezone: "Etc/UTC",
+									CACerts: &cloudinit.CACerts{
+										Trusted: []common.ValueOrSecretKeySelector{
+											{
+												From: &common.SecretKeySelector{Name: "my-secret", Key: "ca.crt"},
+											},
+										},
+									},
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
 			Entry("disallow Sysprep mixing inline Sysprep and RawSysprep",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2460,7 +2882,7 @@ func unitTestsValidateCreate() {
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.interfaces[0].guestDeviceName: Invalid value: "mydev": guestDeviceName is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.interfaces[0].guestDeviceName: Invalid value: "mydev": guestDeviceName is available only with the following bootstrap providers: CloudInit and Ignition`,
 					),
 				},
 			),
@@ -2555,7 +2977,7 @@ func unitTestsValidateCreate() {
 				},
 			),
 
-			// Please note mtu is available only with the following bootstrap providers: CloudInit
+			// Please note mtu is available only with the following bootstrap providers: CloudInit and Ignition
 			Entry("validate mtu when bootstrap doesn't support mtu",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2573,11 +2995,39 @@ func unitTestsValidateCreate() {
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.interfaces[0].mtu: Invalid value: 9000: mtu is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.interfaces[0].mtu: Invalid value: 9000: mtu is available only with the following bootstrap providers: CloudInit and Ignition`,
 					),
 				},
 			),
 
+			Entry("validate interface fields when bootstrap is Ignition",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: common.ValueOrSecretKeySelector{
+									From: &common.SecretKeySelector{Name: "my-secret", Key: "config.ign"},
+								},
+							},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							HostName:    "my-vm",
+							Nameservers: []string{"8.8.8.8"},
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:            "eth0",
+									GuestDeviceName: "eth42",
+									MTU:             ptr.To[int64](9000),
+									Nameservers:     []string{"8.8.8.8"},
+									SearchDomains:   []string{"dev.local"},
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
 			Entry("validate mtu when bootstrap supports mtu",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2599,7 +3049,7 @@ func unitTestsValidateCreate() {
 			),
 
 			// Please note nameservers is available only with the following bootstrap
-			// providers: CloudInit and Sysprep.
+			// providers: CloudInit, Ignition, and Sysprep.
 			Entry("validate nameservers when bootstrap doesn't support nameservers",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2614,7 +3064,7 @@ func unitTestsValidateCreate() {
 					validate: doValidateWithMsg(
 						`spec.network.interfaces[0].nameservers[0]: Invalid value: "not-an-ip": must be an IPv4 or IPv6 address`,
 						`spec.network.interfaces[0].nameservers[1]: Invalid value: "192.168.1.1/24": must be an IPv4 or IPv6 address`,
-						`spec.network.interfaces[0].nameservers: Invalid value: "not-an-ip,192.168.1.1/24": nameservers is available only with the following bootstrap providers: CloudInit and Sysprep`,
+						`spec.network.interfaces[0].nameservers: Invalid value: "not-an-ip,192.168.1.1/24": nameservers is available only with the following bootstrap providers: CloudInit, Ignition, and Sysprep`,
 					),
 				},
 			),
@@ -2630,7 +3080,7 @@ func unitTestsValidateCreate() {
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.interfaces[0].nameservers: Invalid value: "192.168.1.1": nameservers is available only with the following bootstrap providers: CloudInit and Sysprep`,
+						`spec.network.interfaces[0].nameservers: Invalid value: "192.168.1.1": nameservers is available only with the following bootstrap providers: CloudInit, Ignition, and Sysprep`,
 					),
 				},
 			),
@@ -2652,7 +3102,7 @@ func unitTestsValidateCreate() {
 				},
 			),
 
-			// Please note routes is available only with the following bootstrap providers: CloudInit
+			// Please note routes is available only with the following bootstrap providers: CloudInit and Ignition
 			Entry("validate routes when bootstrap doesn't support routes",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2681,7 +3131,7 @@ func unitTestsValidateCreate() {
 						`spec.network.interfaces[0].routes[0].via: Invalid value: "192.168.1": must be an IPv4 or IPv6 address`,
 						`spec.network.interfaces[0].routes[1].via: Invalid value: "2463:foobar": must be an IPv4 or IPv6 address`,
 						`spec.network.interfaces[0].routes[2]: Invalid value: "": cannot mix IP address families`,
-						`spec.network.interfaces[0].routes: Invalid value: "routes": routes is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.interfaces[0].routes: Invalid value: "routes": routes is available only with the following bootstrap providers: CloudInit and Ignition`,
 					),
 				},
 			),
@@ -2730,7 +3180,7 @@ func unitTestsValidateCreate() {
 				},
 			),
 
-			// Please note this feature is available only with the following bootstrap providers: CloudInit
+			// Please note this feature is available only with the following bootstrap providers: CloudInit and Ignition
 			Entry("validate searchDomains when bootstrap doesn't support searchDomains",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2740,7 +3190,7 @@ func unitTestsValidateCreate() {
 						ctx.vm.Spec.Network.Interfaces[0].SearchDomains = []string{"dev.local"}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.interfaces[0].searchDomains: Invalid value: "dev.local": searchDomains is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.interfaces[0].searchDomains: Invalid value: "dev.local": searchDomains is available only with the following bootstrap providers: CloudInit and Ignition`,
 					),
 				},
 			),
@@ -2910,44 +3360,194 @@ func unitTestsValidateCreate() {
 							},
 							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
 								{
-									Name: "vlan100",
+									Name: "vlan100",
+									ID:   100,
+									Link: "eth0",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.vlans: Forbidden: vlans is available only with the following bootstrap providers: CloudInit`,
+					),
+				},
+			),
+
+			Entry("disallow VLANs without any bootstrap",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth0",
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "vlan100",
+									ID:   100,
+									Link: "eth0",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.vlans: Forbidden: vlans is available only with the following bootstrap providers: CloudInit`,
+					),
+				},
+			),
+
+			Entry("disallow VLAN with invalid link reference",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth0",
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "vlan100",
+									ID:   100,
+									Link: "eth1",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.vlans[0].link: Invalid value: "eth1": link must reference an existing interface, bond, or bridge name`,
+					),
+				},
+			),
+
+			Entry("disallow VLAN with empty link",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth0",
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "vlan100",
+									ID:   100,
+									Link: "",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.vlans[0].link: Required value: link must reference an interface name`,
+					),
+				},
+			),
+
+			Entry("disallow duplicate VLAN IDs on the same parent link",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth0",
+								},
+								{
+									Name: "eth1",
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "vlan100a",
+									ID:   100,
+									Link: "eth1",
+								},
+								{
+									Name: "vlan100b",
+									ID:   100,
+									Link: "eth1",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.vlans[1].id: Invalid value: 100: VLAN ID 100 is already used by VLAN "vlan100a" on the same parent link "eth1"`,
+					),
+				},
+			),
+
+			Entry("disallow VLAN name conflicting with interface name",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name: "eth0",
+								},
+								{
+									Name: "eth1",
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "eth1",
 									ID:   100,
-									Link: "eth0",
+									Link: "eth1",
 								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.vlans: Forbidden: vlans is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.vlans[0].name: Invalid value: "eth1": vlan name must not conflict with an interface name`,
 					),
 				},
 			),
 
-			Entry("disallow VLANs without any bootstrap",
+			Entry("disallow VLAN name conflicting with interface guestDeviceName",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
 							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
 								{
 									Name: "eth0",
 								},
+								{
+									Name:            "eth1",
+									GuestDeviceName: "mynic",
+								},
 							},
 							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
 								{
-									Name: "vlan100",
+									Name: "mynic",
 									ID:   100,
-									Link: "eth0",
+									Link: "eth1",
 								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.vlans: Forbidden: vlans is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.vlans[0].name: Invalid value: "mynic": vlan name must not conflict with an interface guestDeviceName`,
 					),
 				},
 			),
 
-			Entry("disallow VLAN with invalid link reference",
+			Entry("allow valid bonds and bridges",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
@@ -2958,27 +3558,48 @@ func unitTestsValidateCreate() {
 								{
 									Name: "eth0",
 								},
+								{
+									Name: "eth1",
+								},
+								{
+									Name: "eth2",
+								},
+							},
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
+								{
+									Name:       "bond0",
+									Interfaces: []string{"eth0", "eth1"},
+									Parameters: &vmopv1.VirtualMachineNetworkBondParameters{
+										Mode:                           vmopv1.VirtualMachineNetworkBondModeActiveBackup,
+										Primary:                        "eth0",
+										MIIMonitorIntervalMilliseconds: 100,
+									},
+								},
+							},
+							Bridges: []vmopv1.VirtualMachineNetworkBridgeSpec{
+								{
+									Name:       "br0",
+									Interfaces: []string{"bond0", "eth2"},
+								},
 							},
 							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
 								{
 									Name: "vlan100",
 									ID:   100,
-									Link: "eth1",
+									Link: "br0",
 								},
 							},
 						}
 					},
-					validate: doValidateWithMsg(
-						`spec.network.vlans[0].link: Invalid value: "eth1": link must reference an existing interface name`,
-					),
+					expectAllowed: true,
 				},
 			),
 
-			Entry("disallow VLAN with empty link",
+			Entry("disallow bonds and bridges without CloudInit bootstrap",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
-							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{},
 						}
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
 							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
@@ -2986,22 +3607,28 @@ func unitTestsValidateCreate() {
 									Name: "eth0",
 								},
 							},
-							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
 								{
-									Name: "vlan100",
-									ID:   100,
-									Link: "",
+									Name:       "bond0",
+									Interfaces: []string{"eth0"},
+								},
+							},
+							Bridges: []vmopv1.VirtualMachineNetworkBridgeSpec{
+								{
+									Name:       "br0",
+									Interfaces: []string{"bond0"},
 								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.vlans[0].link: Required value: link must reference an interface name`,
+						`spec.network.bonds: Forbidden: bonds is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.bridges: Forbidden: bridges is available only with the following bootstrap providers: CloudInit`,
 					),
 				},
 			),
 
-			Entry("disallow duplicate VLAN IDs on the same parent link",
+			Entry("disallow bond with invalid members and parameters",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
@@ -3016,27 +3643,29 @@ func unitTestsValidateCreate() {
 									Name: "eth1",
 								},
 							},
-							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
-								{
-									Name: "vlan100a",
-									ID:   100,
-									Link: "eth1",
-								},
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
 								{
-									Name: "vlan100b",
-									ID:   100,
-									Link: "eth1",
+									Name:       "eth1",
+									Interfaces: []string{"eth0", "eth2"},
+									Parameters: &vmopv1.VirtualMachineNetworkBondParameters{
+										Mode:     vmopv1.VirtualMachineNetworkBondModeActiveBackup,
+										Primary:  "eth1",
+										LACPRate: vmopv1.VirtualMachineNetworkBondLACPRateFast,
+									},
 								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.vlans[1].id: Invalid value: 100: VLAN ID 100 is already used by VLAN "vlan100a" on the same parent link "eth1"`,
+						`spec.network.bonds[0].name: Invalid value: "eth1": bond name must not conflict with an interface name, guestDeviceName, vlan, or bridge`,
+						`spec.network.bonds[0].interfaces[1]: Invalid value: "eth2": interfaces must reference existing interface names`,
+						`spec.network.bonds[0].parameters.primary: Invalid value: "eth1": primary must reference a member of the bond`,
+						`spec.network.bonds[0].parameters.lacpRate: Invalid value: "fast": lacpRate is only valid with mode 802.3ad`,
 					),
 				},
 			),
 
-			Entry("disallow VLAN name conflicting with interface name",
+			Entry("disallow interface that is a member of a bond and a bridge",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
@@ -3051,48 +3680,88 @@ func unitTestsValidateCreate() {
 									Name: "eth1",
 								},
 							},
-							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
 								{
-									Name: "eth1",
-									ID:   100,
-									Link: "eth1",
+									Name:       "bond0",
+									Interfaces: []string{"eth0", "eth1"},
+								},
+							},
+							Bridges: []vmopv1.VirtualMachineNetworkBridgeSpec{
+								{
+									Name:       "br0",
+									Interfaces: []string{"eth1", "eth3"},
 								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.vlans[0].name: Invalid value: "eth1": vlan name must not conflict with an interface name`,
+						`spec.network.bridges[0].interfaces[0]: Invalid value: "eth1": interface "eth1" is already a member of "bond0"`,
+						`spec.network.bridges[0].interfaces[1]: Invalid value: "eth3": interfaces must reference existing interface or bond names`,
 					),
 				},
 			),
 
-			Entry("disallow VLAN name conflicting with interface guestDeviceName",
+			Entry("allow ipPoolName with Named network provider",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
-							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
-						}
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeNamed
+						})
 						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
 							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
 								{
-									Name: "eth0",
+									Name:       "eth0",
+									IPPoolName: "my-pool",
+									Gateway4:   "192.168.10.254",
 								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallow ipPoolName with other network providers",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeVDS
+						})
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
 								{
-									Name:            "eth1",
-									GuestDeviceName: "mynic",
+									Name:       "eth0",
+									IPPoolName: "my-pool",
 								},
 							},
-							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.interfaces[0].ipPoolName: Forbidden: ipPoolName is available only with the Named network provider`,
+					),
+				},
+			),
+
+			Entry("disallow ipPoolName with addresses and dhcp",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeNamed
+						})
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
 								{
-									Name: "mynic",
-									ID:   100,
-									Link: "eth1",
+									Name:       "eth0",
+									IPPoolName: "my-pool",
+									Addresses:  []string{"192.168.10.10/24"},
+									DHCP6:      true,
 								},
 							},
 						}
 					},
 					validate: doValidateWithMsg(
-						`spec.network.vlans[0].name: Invalid value: "mynic": vlan name must not conflict with an interface guestDeviceName`,
+						`spec.network.interfaces[0].addresses: Invalid value: "192.168.10.10/24": field is mutually exclusive with ipPoolName`,
+						`spec.network.interfaces[0].dhcp6: Invalid value: true: field is mutually exclusive with ipPoolName`,
 					),
 				},
 			),
@@ -3809,6 +4478,202 @@ func unitTestsValidateCreate() {
 		)
 	})
 
+	Context("Clone", func() {
+		const (
+			srcVMName       = "dummy-src-vm"
+			srcSnapshotName = "dummy-src-vm-snapshot"
+		)
+
+		imageNamePath := field.NewPath("spec", "image", "name")
+
+		createSrcVM := func(
+			ctx *unitValidatingWebhookContext,
+			mutateFn func(srcVM *vmopv1.VirtualMachine)) {
+
+			srcVM := builder.DummyVirtualMachine()
+			srcVM.Name = srcVMName
+			srcVM.Namespace = ctx.vm.Namespace
+			srcVM.Spec.ClassName = ctx.vm.Spec.ClassName
+			srcVM.Status.UniqueID = "vm-42"
+			srcVM.Status.PowerState = vmopv1.VirtualMachinePowerStateOff
+			if mutateFn != nil {
+				mutateFn(srcVM)
+			}
+			Expect(ctx.Client.Create(ctx, srcVM)).To(Succeed())
+		}
+
+		createSrcSnapshot := func(ctx *unitValidatingWebhookContext, ready bool) {
+			snapshot := builder.DummyVirtualMachineSnapshot(ctx.vm.Namespace, srcSnapshotName, srcVMName)
+			if ready {
+				conditions.MarkTrue(snapshot, vmopv1.VirtualMachineSnapshotReadyCondition)
+			}
+			Expect(ctx.Client.Create(ctx, snapshot)).To(Succeed())
+		}
+
+		cloneFrom := func(ctx *unitValidatingWebhookContext, kind, name string) {
+			ctx.vm.Spec.Image = &vmopv1.VirtualMachineImageRef{
+				Kind: kind,
+				Name: name,
+			}
+			ctx.vm.Spec.ImageName = ""
+		}
+
+		DescribeTable("clone create", doTest,
+			Entry("allow full clone of a powered off VM",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, nil)
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow full clone of a powered on VM",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, func(srcVM *vmopv1.VirtualMachine) {
+							srcVM.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+						})
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+						ctx.vm.Spec.CloneMode = vmopv1.VirtualMachineCloneModeFull
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(imageNamePath, srcVMName, "source VM must be powered off for a full clone").Error(),
+					),
+				},
+			),
+			Entry("allow linked clone of a powered on VM with a current snapshot",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, func(srcVM *vmopv1.VirtualMachine) {
+							srcVM.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+							srcVM.Status.CurrentSnapshot = &vmopv1.VirtualMachineSnapshotReference{
+								Type: vmopv1.VirtualMachineSnapshotReferenceTypeManaged,
+								Name: srcSnapshotName,
+							}
+						})
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+						ctx.vm.Spec.CloneMode = vmopv1.VirtualMachineCloneModeLinked
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow linked clone of a VM without a current snapshot",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, nil)
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+						ctx.vm.Spec.CloneMode = vmopv1.VirtualMachineCloneModeLinked
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(imageNamePath, srcVMName, "source VM must have a current snapshot for a linked clone").Error(),
+					),
+				},
+			),
+			Entry("disallow clone of a VM that does not exist",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(imageNamePath, srcVMName, "VirtualMachine "+srcVMName+" not found").Error(),
+					),
+				},
+			),
+			Entry("disallow clone of a VM that has not been created",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, func(srcVM *vmopv1.VirtualMachine) {
+							srcVM.Status.UniqueID = ""
+						})
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(imageNamePath, srcVMName, "source VM has not been created").Error(),
+					),
+				},
+			),
+			Entry("allow clone of a ready snapshot",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, func(srcVM *vmopv1.VirtualMachine) {
+							srcVM.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+							srcVM.Spec.Volumes = nil
+						})
+						createSrcSnapshot(ctx, true)
+						cloneFrom(ctx, "VirtualMachineSnapshot", srcSnapshotName)
+						ctx.vm.Spec.CloneMode = vmopv1.VirtualMachineCloneModeLinked
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow clone of a snapshot of a VM with PVC volumes",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, func(srcVM *vmopv1.VirtualMachine) {
+							Expect(srcVM.Spec.Volumes).ToNot(BeEmpty())
+						})
+						createSrcSnapshot(ctx, true)
+						cloneFrom(ctx, "VirtualMachineSnapshot", srcSnapshotName)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(imageNamePath, srcSnapshotName,
+							"cannot clone a snapshot of a VM with PersistentVolumeClaim volumes").Error(),
+					),
+				},
+			),
+			Entry("disallow clone of a snapshot that is not ready",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createSrcVM(ctx, nil)
+						createSrcSnapshot(ctx, false)
+						cloneFrom(ctx, "VirtualMachineSnapshot", srcSnapshotName)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(imageNamePath, srcSnapshotName, "source snapshot is not ready").Error(),
+					),
+				},
+			),
+			Entry("disallow a class whose devices differ from the source VM's class",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						srcClass := builder.DummyVirtualMachineClass(oldVMClass)
+						srcClass.Namespace = ctx.vm.Namespace
+						srcClass.Spec.Hardware.Devices.VGPUDevices = []vmopv1.VGPUDevice{
+							{ProfileName: "profile"},
+						}
+						Expect(ctx.Client.Create(ctx, srcClass)).To(Succeed())
+
+						class := builder.DummyVirtualMachineClass(newVMClass)
+						class.Namespace = ctx.vm.Namespace
+						Expect(ctx.Client.Create(ctx, class)).To(Succeed())
+
+						createSrcVM(ctx, func(srcVM *vmopv1.VirtualMachine) {
+							srcVM.Spec.ClassName = oldVMClass
+						})
+						cloneFrom(ctx, "VirtualMachine", srcVMName)
+						ctx.vm.Spec.ClassName = newVMClass
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(field.NewPath("spec", "className"), newVMClass,
+							"class devices must match those of the source VM's class "+oldVMClass).Error(),
+					),
+				},
+			),
+			Entry("disallow spec.cloneMode when not cloning",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.CloneMode = vmopv1.VirtualMachineCloneModeLinked
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(field.NewPath("spec", "cloneMode"), vmopv1.VirtualMachineCloneModeLinked,
+							"may only be set when spec.image.kind is VirtualMachine or VirtualMachineSnapshot").Error(),
+					),
+				},
+			),
+		)
+	})
+
 	Context("Affinity", func() {
 		BeforeEach(func() {
 			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
@@ -5144,6 +6009,17 @@ func unitTestsValidateUpdate() { //nolint:gocyclo
 					validate: doValidateWithMsg(`spec.bootstrap.sysprep: Forbidden: bootstrap provider type cannot be changed`),
 				},
 			),
+			Entry("disallow unsetting Ignition",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{},
+						}
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{}
+					},
+					validate: doValidateWithMsg(`spec.bootstrap.ignition: Forbidden: bootstrap provider type cannot be changed`),
+				},
+			),
 			Entry("disallow changing bootstrap providers",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -5171,6 +6047,35 @@ func unitTestsValidateUpdate() { //nolint:gocyclo
 					expectAllowed: true,
 				},
 			),
+			Entry("allow increasing bootstrap generation",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit:  &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+							Generation: 1,
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow decreasing bootstrap generation",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit:  &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+							Generation: 2,
+						}
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit:  &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+							Generation: 1,
+						}
+					},
+					validate: doValidateWithMsg(`spec.bootstrap.generation: Invalid value: 1: bootstrap generation cannot be decreased`),
+				},
+			),
 
 			Entry("allow bootstrap update if VM is desired powered on with halt annotation",
 				testParams{
@@ -5361,6 +6266,19 @@ func unitTestsValidateUpdate() { //nolint:gocyclo
 				},
 			),
 
+			Entry("forbid changing cloneMode",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.CloneMode = vmopv1.VirtualMachineCloneModeFull
+
+						ctx.vm = ctx.oldVM.DeepCopy()
+						ctx.vm.Spec.CloneMode = vmopv1.VirtualMachineCloneModeLinked
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(field.NewPath("spec", "cloneMode"), vmopv1.VirtualMachineCloneModeLinked, apivalidation.FieldImmutableErrorMsg).Error()),
+				},
+			),
+
 			Entry("forbid unset of image when FSS_WCP_VMSERVICE_INCREMENTAL_RESTORE is disabled",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
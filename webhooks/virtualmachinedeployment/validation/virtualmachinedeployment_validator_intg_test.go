@@ -0,0 +1,154 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateUpdate,
+	)
+}
+
+type intgValidatingWebhookContext struct {
+	builder.IntegrationTestContext
+	d *vmopv1.VirtualMachineDeployment
+}
+
+func newIntgValidatingWebhookContext() *intgValidatingWebhookContext {
+	ctx := &intgValidatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	ctx.d = builder.DummyVirtualMachineDeployment()
+	ctx.d.Namespace = ctx.Namespace
+	ctx.d.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"foo": "bar"},
+	}
+	ctx.d.Spec.Template.Labels = map[string]string{"foo": "bar"}
+
+	return ctx
+}
+
+func intgTestsValidateCreate() {
+	var (
+		ctx *intgValidatingWebhookContext
+		err error
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+	})
+
+	JustBeforeEach(func() {
+		err = ctx.Client.Create(suite, ctx.d)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("the selector matches the template labels", func() {
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the selector does not match the template labels", func() {
+		BeforeEach(func() {
+			ctx.d.Spec.Template.Labels = map[string]string{"foo": "baz"}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(field.NewPath("spec", "template", "metadata", "labels").String()))
+		})
+	})
+
+	When("maxSurge and maxUnavailable are both zero", func() {
+		BeforeEach(func() {
+			ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+				MaxSurge:       ptr.To(intstr.FromInt32(0)),
+				MaxUnavailable: ptr.To(intstr.FromString("0%")),
+			}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("may not be 0 when maxSurge is 0"))
+		})
+	})
+}
+
+func intgTestsValidateUpdate() {
+	var (
+		ctx *intgValidatingWebhookContext
+		err error
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+		Expect(ctx.Client.Create(ctx, ctx.d)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		err = ctx.Client.Update(suite, ctx.d)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("the selector is changed", func() {
+		BeforeEach(func() {
+			ctx.d.Spec.Selector.MatchLabels["hello"] = "world"
+			ctx.d.Spec.Template.Labels["hello"] = "world"
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("field is immutable"))
+		})
+	})
+
+	When("the template is changed", func() {
+		BeforeEach(func() {
+			ctx.d.Spec.Template.Spec.ClassName = "new-class"
+		})
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+}
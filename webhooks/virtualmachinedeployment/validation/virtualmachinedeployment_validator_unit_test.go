@@ -0,0 +1,330 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+type testParams struct {
+	setup         func(ctx *unitValidatingWebhookContext)
+	validate      func(ctx *unitValidatingWebhookContext, response admission.Response)
+	expectAllowed bool
+}
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	d, oldD *vmopv1.VirtualMachineDeployment
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	d := builder.DummyVirtualMachineDeployment()
+	d.Name = "dummy-deployment-for-webhook-validation"
+	d.Namespace = "dummy-deployment-namespace-for-webhook-validation"
+	d.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"foo": "bar"},
+	}
+	d.Spec.Template.Labels = map[string]string{"foo": "bar"}
+	obj, err := builder.ToUnstructured(d)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldD   *vmopv1.VirtualMachineDeployment
+		oldObj *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldD = d.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldD)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		d:                                   d,
+		oldD:                                oldD,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	doTest := func(args testParams) {
+		if args.setup != nil {
+			args.setup(ctx)
+		}
+
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.d)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(args.expectAllowed))
+
+		if args.validate != nil {
+			args.validate(ctx, response)
+		}
+	}
+
+	expectReason := func(reason string) func(*unitValidatingWebhookContext, admission.Response) {
+		return func(_ *unitValidatingWebhookContext, response admission.Response) {
+			Expect(string(response.Result.Reason)).To(ContainSubstring(reason))
+		}
+	}
+
+	DescribeTable("create table", doTest,
+		Entry("should allow a valid deployment",
+			testParams{
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny a selector that does not match the template labels",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Template.Labels = map[string]string{"foo": "baz"}
+				},
+				validate:      expectReason(`spec.template.metadata.labels: Invalid value: {"foo":"baz"}: must match spec.selector "foo=bar"`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an empty selector",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Selector = &metav1.LabelSelector{}
+				},
+				validate:      expectReason("empty selector is invalid for deployment"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an unsupported delete policy",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.DeletePolicy = "LeastRecentlyUsed"
+				},
+				validate:      expectReason("spec.deletePolicy: Unsupported value"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should allow a supported delete policy",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.DeletePolicy = vmopv1.VirtualMachineReplicaSetDeletePolicyOldest
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny an unsupported strategy type",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.Type = "BlueGreen"
+				},
+				validate:      expectReason("spec.strategy.type: Unsupported value"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny rollingUpdate with the Recreate strategy",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.Type = vmopv1.VirtualMachineDeploymentStrategyTypeRecreate
+					ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{}
+				},
+				validate:      expectReason(`spec.strategy.rollingUpdate: Forbidden: may not be specified when strategy type is "Recreate"`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should allow valid rolling update parameters",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+						MaxSurge:       ptr.To(intstr.FromInt32(2)),
+						MaxUnavailable: ptr.To(intstr.FromString("50%")),
+					}
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny maxSurge and maxUnavailable both being zero",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+						MaxSurge:       ptr.To(intstr.FromString("0%")),
+						MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					}
+				},
+				validate:      expectReason("spec.strategy.rollingUpdate.maxUnavailable: Invalid value: \"0\": may not be 0 when maxSurge is 0"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a negative maxSurge",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+						MaxSurge: ptr.To(intstr.FromInt32(-1)),
+					}
+				},
+				validate:      expectReason("spec.strategy.rollingUpdate.maxSurge: Invalid value: \"-1\": must be greater than or equal to 0"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid maxUnavailable",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+						MaxUnavailable: ptr.To(intstr.FromString("ten")),
+					}
+				},
+				validate:      expectReason("spec.strategy.rollingUpdate.maxUnavailable: Invalid value: \"ten\": must be an integer or percentage"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a percentage greater than 100%",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.d.Spec.Strategy.RollingUpdate = &vmopv1.VirtualMachineDeploymentRollingUpdate{
+						MaxUnavailable: ptr.To(intstr.FromString("110%")),
+					}
+				},
+				validate:      expectReason("spec.strategy.rollingUpdate.maxUnavailable: Invalid value: \"110%\": must not be greater than 100%"),
+				expectAllowed: false,
+			},
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.d)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the template is changed", func() {
+		BeforeEach(func() {
+			ctx.d.Spec.Template.Spec.ClassName = "new-class"
+			ctx.d.Spec.Replicas = ptr.To(int32(5))
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the selector is changed", func() {
+		BeforeEach(func() {
+			ctx.d.Spec.Selector.MatchLabels["hello"] = "world"
+			ctx.d.Spec.Template.Labels["hello"] = "world"
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.selector: Forbidden: field is immutable"))
+		})
+	})
+
+	When("the update is performed while object deletion", func() {
+		BeforeEach(func() {
+			t := metav1.Now()
+			ctx.d.DeletionTimestamp = &t
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
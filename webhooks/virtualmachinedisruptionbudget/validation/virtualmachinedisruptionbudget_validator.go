@@ -0,0 +1,181 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinedisruptionbudget,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinedisruptionbudgets,versions=v1alpha6,name=default.validating.virtualmachinedisruptionbudget.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineDisruptionBudget validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineDisruptionBudget{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	db, err := v.disruptionBudgetFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, db)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	db, err := v.disruptionBudgetFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, db)
+}
+
+func (v validator) validate(
+	ctx *pkgctx.WebhookRequestContext,
+	db *vmopv1.VirtualMachineDisruptionBudget) admission.Response {
+
+	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, v.validateSelector(ctx, db)...)
+	fieldErrs = append(fieldErrs, v.validateBudget(ctx, db)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateSelector(
+	_ *pkgctx.WebhookRequestContext,
+	db *vmopv1.VirtualMachineDisruptionBudget) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if db.Spec.Selector == nil {
+		return allErrs
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(db.Spec.Selector); err != nil {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				field.NewPath("spec", "selector"),
+				db.Spec.Selector,
+				err.Error(),
+			),
+		)
+	}
+
+	return allErrs
+}
+
+func (v validator) validateBudget(
+	_ *pkgctx.WebhookRequestContext,
+	db *vmopv1.VirtualMachineDisruptionBudget) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	switch {
+	case db.Spec.MinAvailable != nil && db.Spec.MaxUnavailable != nil:
+		allErrs = append(allErrs, field.Forbidden(
+			specPath.Child("maxUnavailable"),
+			"may not be specified when minAvailable is specified"))
+	case db.Spec.MinAvailable == nil && db.Spec.MaxUnavailable == nil:
+		allErrs = append(allErrs, field.Required(
+			specPath,
+			"one of minAvailable or maxUnavailable must be specified"))
+	}
+
+	allErrs = append(allErrs, validateIntOrPercent(db.Spec.MinAvailable, specPath.Child("minAvailable"))...)
+	allErrs = append(allErrs, validateIntOrPercent(db.Spec.MaxUnavailable, specPath.Child("maxUnavailable"))...)
+
+	return allErrs
+}
+
+func validateIntOrPercent(v *intstr.IntOrString, fieldPath *field.Path) field.ErrorList {
+	if v == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	// Scaling a percentage against 100 VMs yields the percentage itself.
+	n, err := intstr.GetScaledValueFromIntOrPercent(v, 100, false)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(fieldPath, v.String(), "must be an integer or percentage (e.g '5%')"))
+	case n < 0:
+		allErrs = append(allErrs, field.Invalid(fieldPath, v.String(), "must be greater than or equal to 0"))
+	case v.Type == intstr.String && n > 100:
+		allErrs = append(allErrs, field.Invalid(fieldPath, v.String(), "must not be greater than 100%"))
+	}
+
+	return allErrs
+}
+
+// disruptionBudgetFromUnstructured returns the VirtualMachineDisruptionBudget
+// from the unstructured object.
+func (v validator) disruptionBudgetFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineDisruptionBudget, error) {
+
+	db := &vmopv1.VirtualMachineDisruptionBudget{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), db); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
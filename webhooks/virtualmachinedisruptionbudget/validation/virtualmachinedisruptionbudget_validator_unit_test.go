@@ -0,0 +1,276 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+type testParams struct {
+	setup         func(ctx *unitValidatingWebhookContext)
+	validate      func(ctx *unitValidatingWebhookContext, response admission.Response)
+	expectAllowed bool
+}
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	db, oldDB *vmopv1.VirtualMachineDisruptionBudget
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	db := builder.DummyVirtualMachineDisruptionBudget()
+	db.Name = "dummy-budget-for-webhook-validation"
+	db.Namespace = "dummy-budget-namespace-for-webhook-validation"
+	db.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"foo": "bar"},
+	}
+	obj, err := builder.ToUnstructured(db)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldDB  *vmopv1.VirtualMachineDisruptionBudget
+		oldObj *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldDB = db.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldDB)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		db:                                  db,
+		oldDB:                               oldDB,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	doTest := func(args testParams) {
+		if args.setup != nil {
+			args.setup(ctx)
+		}
+
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.db)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(args.expectAllowed))
+
+		if args.validate != nil {
+			args.validate(ctx, response)
+		}
+	}
+
+	expectReason := func(reason string) func(*unitValidatingWebhookContext, admission.Response) {
+		return func(_ *unitValidatingWebhookContext, response admission.Response) {
+			Expect(string(response.Result.Reason)).To(ContainSubstring(reason))
+		}
+	}
+
+	DescribeTable("create table", doTest,
+		Entry("should allow a valid budget",
+			testParams{
+				expectAllowed: true,
+			},
+		),
+		Entry("should allow minAvailable as a percentage",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.MaxUnavailable = nil
+					ctx.db.Spec.MinAvailable = ptr.To(intstr.FromString("50%"))
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny both minAvailable and maxUnavailable",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.MinAvailable = ptr.To(intstr.FromInt32(1))
+				},
+				validate:      expectReason("spec.maxUnavailable: Forbidden: may not be specified when minAvailable is specified"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny neither minAvailable nor maxUnavailable",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.MaxUnavailable = nil
+				},
+				validate:      expectReason("spec: Required value: one of minAvailable or maxUnavailable must be specified"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a negative maxUnavailable",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.MaxUnavailable = ptr.To(intstr.FromInt32(-1))
+				},
+				validate:      expectReason("spec.maxUnavailable: Invalid value: \"-1\": must be greater than or equal to 0"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid minAvailable",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.MaxUnavailable = nil
+					ctx.db.Spec.MinAvailable = ptr.To(intstr.FromString("half"))
+				},
+				validate:      expectReason("spec.minAvailable: Invalid value: \"half\": must be an integer or percentage"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a percentage greater than 100%",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.MaxUnavailable = ptr.To(intstr.FromString("110%"))
+				},
+				validate:      expectReason("spec.maxUnavailable: Invalid value: \"110%\": must not be greater than 100%"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid selector",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.db.Spec.Selector = &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "foo",
+								Operator: "Unknown",
+							},
+						},
+					}
+				},
+				validate:      expectReason("spec.selector: Invalid value"),
+				expectAllowed: false,
+			},
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.db)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the budget is changed", func() {
+		BeforeEach(func() {
+			ctx.db.Spec.MaxUnavailable = ptr.To(intstr.FromString("25%"))
+			ctx.db.Spec.Selector.MatchLabels["hello"] = "world"
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("both minAvailable and maxUnavailable are specified", func() {
+		BeforeEach(func() {
+			ctx.db.Spec.MinAvailable = ptr.To(intstr.FromInt32(1))
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+		})
+	})
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -101,6 +102,7 @@ func (v validator) ValidateCreate(
 	fieldErrs = append(fieldErrs, v.validatePowerState(ctx, vmGroup, nil)...)
 	fieldErrs = append(fieldErrs, v.validateBootOrderMembers(ctx, vmGroup)...)
 	fieldErrs = append(fieldErrs, v.validateGroupName(ctx, vmGroup)...)
+	fieldErrs = append(fieldErrs, v.validateSnapshot(ctx, vmGroup, nil)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -135,6 +137,7 @@ func (v validator) ValidateUpdate(
 
 	fieldErrs = append(fieldErrs, v.validateBootOrderMembers(ctx, vmGroup)...)
 	fieldErrs = append(fieldErrs, v.validateGroupName(ctx, vmGroup)...)
+	fieldErrs = append(fieldErrs, v.validateSnapshot(ctx, vmGroup, oldVMGroup)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -258,3 +261,41 @@ func (v validator) validateGroupName(
 
 	return allErrs
 }
+
+// validateSnapshot validates the group's spec.currentSnapshotName field.
+func (v validator) validateSnapshot(
+	_ *pkgctx.WebhookRequestContext,
+	vmGroup, oldVMGroup *vmopv1.VirtualMachineGroup) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	snapshotPath := field.NewPath("spec", "currentSnapshotName")
+
+	if oldVMGroup == nil {
+		if vmGroup.Spec.CurrentSnapshotName != "" {
+			allErrs = append(allErrs, field.Forbidden(snapshotPath,
+				"creating group with current snapshot is not allowed"))
+		}
+		return allErrs
+	}
+
+	if vmGroup.Spec.CurrentSnapshotName == "" {
+		return allErrs
+	}
+
+	if strings.TrimSpace(vmGroup.Spec.CurrentSnapshotName) == "" {
+		allErrs = append(allErrs, field.Invalid(snapshotPath,
+			vmGroup.Spec.CurrentSnapshotName, "currentSnapshotName cannot be empty"))
+		return allErrs
+	}
+
+	// If a revert is in progress, a new revert is not allowed.
+	if oldVMGroup.Spec.CurrentSnapshotName != "" &&
+		oldVMGroup.Spec.CurrentSnapshotName != vmGroup.Spec.CurrentSnapshotName {
+
+		allErrs = append(allErrs, field.Forbidden(snapshotPath,
+			"a snapshot revert is already in progress"))
+	}
+
+	return allErrs
+}
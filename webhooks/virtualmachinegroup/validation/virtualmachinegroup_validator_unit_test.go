@@ -119,6 +119,7 @@ func unitTestsValidateCreate() {
 		nextForceSyncTime     string
 		duplicateMember       bool
 		selfReferenced        bool
+		currentSnapshotName   string
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string) {
@@ -176,6 +177,8 @@ func unitTestsValidateCreate() {
 			ctx.vmGroup.Spec.GroupName = ctx.vmGroup.Name
 		}
 
+		ctx.vmGroup.Spec.CurrentSnapshotName = args.currentSnapshotName
+
 		var err error
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmGroup)
 		Expect(err).ToNot(HaveOccurred())
@@ -205,6 +208,8 @@ func unitTestsValidateCreate() {
 			createArgs{duplicateMember: true}, false, "spec.bootOrder[1].members[0]: Duplicate value: \"VirtualMachine/vm-dup\""),
 		Entry("should not work with self reference member or group name",
 			createArgs{selfReferenced: true}, false, selfRefMemberOrGroupMsg),
+		Entry("should not work with current snapshot name",
+			createArgs{currentSnapshotName: "vmgs-1"}, false, "spec.currentSnapshotName: Forbidden: creating group with current snapshot is not allowed"),
 	)
 }
 
@@ -222,6 +227,8 @@ func unitTestsValidateUpdate() {
 		nextForceSyncTime            string
 		duplicateMember              bool
 		selfReferenced               bool
+		oldCurrentSnapshotName       string
+		newCurrentSnapshotName       string
 	}
 
 	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string) {
@@ -293,6 +300,9 @@ func unitTestsValidateUpdate() {
 			ctx.vmGroup.Spec.GroupName = ctx.vmGroup.Name
 		}
 
+		ctx.oldVMGroup.Spec.CurrentSnapshotName = args.oldCurrentSnapshotName
+		ctx.vmGroup.Spec.CurrentSnapshotName = args.newCurrentSnapshotName
+
 		var err error
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmGroup)
 		Expect(err).ToNot(HaveOccurred())
@@ -328,6 +338,14 @@ func unitTestsValidateUpdate() {
 			updateArgs{duplicateMember: true}, false, "spec.bootOrder[1].members[0]: Duplicate value: \"VirtualMachineGroup/vmg-dup\""),
 		Entry("should not work with self reference member or group name",
 			updateArgs{selfReferenced: true}, false, selfRefMemberOrGroupMsg),
+		Entry("should work with setting current snapshot name",
+			updateArgs{newCurrentSnapshotName: "vmgs-1"}, true, ""),
+		Entry("should work with clearing current snapshot name",
+			updateArgs{oldCurrentSnapshotName: "vmgs-1"}, true, ""),
+		Entry("should not work with whitespace current snapshot name",
+			updateArgs{newCurrentSnapshotName: " "}, false, "currentSnapshotName cannot be empty"),
+		Entry("should not work with changing current snapshot name while a revert is in progress",
+			updateArgs{oldCurrentSnapshotName: "vmgs-1", newCurrentSnapshotName: "vmgs-2"}, false, "a snapshot revert is already in progress"),
 	)
 }
 
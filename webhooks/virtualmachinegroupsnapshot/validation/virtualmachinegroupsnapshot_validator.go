@@ -0,0 +1,133 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinegroupsnapshot,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinegroupsnapshots,versions=v1alpha6,name=default.validating.virtualmachinegroupsnapshot.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineGroupSnapshot validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineGroupSnapshot{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	groupSnapshot, err := v.groupSnapshotFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	// The name of the group snapshot is the value of the label that the
+	// snapshots created by the group snapshot have.
+	if len(groupSnapshot.Name) > utilvalidation.LabelValueMaxLength {
+		fieldErrs = append(fieldErrs, field.TooLong(
+			field.NewPath("metadata", "name"), groupSnapshot.Name, utilvalidation.LabelValueMaxLength))
+	}
+
+	if groupSnapshot.Spec.GroupName == "" {
+		fieldErrs = append(fieldErrs, field.Required(field.NewPath("spec", "groupName"), "groupName must be provided"))
+	}
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineGroupSnapshot update is valid.
+// The spec of a group snapshot may not be changed.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	groupSnapshot, err := v.groupSnapshotFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldGroupSnapshot, err := v.groupSnapshotFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(groupSnapshot.Spec.GroupName, oldGroupSnapshot.Spec.GroupName, specPath.Child("groupName"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(groupSnapshot.Spec.Memory, oldGroupSnapshot.Spec.Memory, specPath.Child("memory"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(groupSnapshot.Spec.Quiesce, oldGroupSnapshot.Spec.Quiesce, specPath.Child("quiesce"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(groupSnapshot.Spec.Description, oldGroupSnapshot.Spec.Description, specPath.Child("description"))...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+// groupSnapshotFromUnstructured returns the VirtualMachineGroupSnapshot from
+// the unstructured object.
+func (v validator) groupSnapshotFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineGroupSnapshot, error) {
+
+	groupSnapshot := &vmopv1.VirtualMachineGroupSnapshot{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), groupSnapshot); err != nil {
+		return nil, err
+	}
+	return groupSnapshot, nil
+}
@@ -0,0 +1,227 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	groupSnapshot, oldGroupSnapshot *vmopv1.VirtualMachineGroupSnapshot
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	groupSnapshot := builder.DummyVirtualMachineGroupSnapshot(
+		"dummy-group-snapshot-namespace-for-webhook-validation",
+		"dummy-group-snapshot-for-webhook-validation",
+		builder.DummyVMGroupName)
+	obj, err := builder.ToUnstructured(groupSnapshot)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldGroupSnapshot *vmopv1.VirtualMachineGroupSnapshot
+		oldObj           *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldGroupSnapshot = groupSnapshot.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldGroupSnapshot)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		groupSnapshot:                       groupSnapshot,
+		oldGroupSnapshot:                    oldGroupSnapshot,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.groupSnapshot)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateCreate(&ctx.WebhookRequestContext)
+	})
+
+	When("the group snapshot is valid", func() {
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the group name is empty", func() {
+		BeforeEach(func() {
+			ctx.groupSnapshot.Spec.GroupName = ""
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.groupName: Required value"))
+		})
+	})
+
+	When("the name is the maximum length of a label value", func() {
+		BeforeEach(func() {
+			ctx.groupSnapshot.Name = strings.Repeat("a", 63)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the name is longer than the maximum length of a label value", func() {
+		BeforeEach(func() {
+			ctx.groupSnapshot.Name = strings.Repeat("a", 64)
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("metadata.name: Too long"))
+		})
+	})
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.groupSnapshot)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the labels are changed", func() {
+		BeforeEach(func() {
+			ctx.groupSnapshot.Labels = map[string]string{"foo": "bar"}
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	DescribeTable("spec is immutable",
+		func(mutate func(*vmopv1.VirtualMachineGroupSnapshot), field string) {
+			mutate(ctx.groupSnapshot)
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.groupSnapshot)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring(field + ": Invalid value"))
+		},
+		Entry("groupName",
+			func(s *vmopv1.VirtualMachineGroupSnapshot) { s.Spec.GroupName = "other-group" },
+			"spec.groupName"),
+		Entry("memory",
+			func(s *vmopv1.VirtualMachineGroupSnapshot) { s.Spec.Memory = true },
+			"spec.memory"),
+		Entry("quiesce",
+			func(s *vmopv1.VirtualMachineGroupSnapshot) { s.Spec.Quiesce = &vmopv1.QuiesceSpec{} },
+			"spec.quiesce"),
+		Entry("description",
+			func(s *vmopv1.VirtualMachineGroupSnapshot) { s.Spec.Description = "changed" },
+			"spec.description"),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
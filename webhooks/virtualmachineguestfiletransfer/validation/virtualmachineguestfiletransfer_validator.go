@@ -0,0 +1,193 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachineguestfiletransfer,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineguestfiletransfers,versions=v1alpha6,name=default.validating.virtualmachineguestfiletransfer.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineGuestFileTransfer validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineGuestFileTransfer{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	fileTransfer, err := v.fileTransferFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if fileTransfer.Spec.VMName == "" {
+		fieldErrs = append(fieldErrs, field.Required(specPath.Child("vmName"), "vmName must be provided"))
+	}
+
+	switch fileTransfer.Spec.Direction {
+	case vmopv1.VirtualMachineGuestFileTransferDirectionToGuest,
+		vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest:
+	default:
+		fieldErrs = append(fieldErrs, field.NotSupported(specPath.Child("direction"), fileTransfer.Spec.Direction, []string{
+			string(vmopv1.VirtualMachineGuestFileTransferDirectionToGuest),
+			string(vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest),
+		}))
+	}
+
+	if fileTransfer.Spec.GuestPath == "" {
+		fieldErrs = append(fieldErrs, field.Required(specPath.Child("guestPath"), "guestPath must be provided"))
+	}
+
+	if fileTransfer.Spec.CredentialsSecretName == "" {
+		fieldErrs = append(fieldErrs, field.Required(specPath.Child("credentialsSecretName"), "credentialsSecretName must be provided"))
+	}
+
+	fieldErrs = append(fieldErrs, v.validateObject(ctx, fileTransfer.Spec.Object, specPath.Child("object"))...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineGuestFileTransfer update is
+// valid. A transfer is performed at most once, so its spec may not be changed.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	fileTransfer, err := v.fileTransferFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldFileTransfer, err := v.fileTransferFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(fileTransfer.Spec.VMName, oldFileTransfer.Spec.VMName, specPath.Child("vmName"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(fileTransfer.Spec.Direction, oldFileTransfer.Spec.Direction, specPath.Child("direction"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(fileTransfer.Spec.GuestPath, oldFileTransfer.Spec.GuestPath, specPath.Child("guestPath"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(fileTransfer.Spec.Object, oldFileTransfer.Spec.Object, specPath.Child("object"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(fileTransfer.Spec.CredentialsSecretName, oldFileTransfer.Spec.CredentialsSecretName, specPath.Child("credentialsSecretName"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(fileTransfer.Spec.Overwrite, oldFileTransfer.Spec.Overwrite, specPath.Child("overwrite"))...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateObject(
+	ctx *pkgctx.WebhookRequestContext,
+	obj vmopv1.VirtualMachineGuestFileTransferObject,
+	objPath *field.Path) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	switch obj.Kind {
+	case vmopv1.VirtualMachineGuestFileTransferObjectKindSecret,
+		vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap:
+	case vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim:
+		if !pkgcfg.FromContext(ctx).Features.VMGuestFileTransferPVC {
+			allErrs = append(allErrs, field.Invalid(objPath.Child("kind"), obj.Kind,
+				"copying files to or from a PersistentVolumeClaim is not enabled"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(objPath.Child("kind"), obj.Kind, []string{
+			string(vmopv1.VirtualMachineGuestFileTransferObjectKindSecret),
+			string(vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap),
+			string(vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim),
+		}))
+	}
+
+	if obj.Name == "" {
+		allErrs = append(allErrs, field.Required(objPath.Child("name"), "name must be provided"))
+	}
+
+	switch {
+	case obj.Key == "":
+		allErrs = append(allErrs, field.Required(objPath.Child("key"), "key must be provided"))
+	case obj.Kind == vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim:
+		// The key is the path of the file relative to the root of the
+		// volume, and may not refer to a file outside of the volume.
+		if !filepath.IsLocal(obj.Key) || filepath.Clean(obj.Key) == "." {
+			allErrs = append(allErrs, field.Invalid(objPath.Child("key"), obj.Key,
+				"must be a relative path to a file in the volume"))
+		}
+	}
+
+	return allErrs
+}
+
+// fileTransferFromUnstructured returns the VirtualMachineGuestFileTransfer
+// from the unstructured object.
+func (v validator) fileTransferFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineGuestFileTransfer, error) {
+
+	fileTransfer := &vmopv1.VirtualMachineGuestFileTransfer{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), fileTransfer); err != nil {
+		return nil, err
+	}
+	return fileTransfer, nil
+}
@@ -0,0 +1,295 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	fileTransfer, oldFileTransfer *vmopv1.VirtualMachineGuestFileTransfer
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	fileTransfer := builder.DummyVirtualMachineGuestFileTransfer(
+		"dummy-file-transfer-namespace-for-webhook-validation",
+		"dummy-file-transfer-for-webhook-validation",
+		"dummy-vm")
+	obj, err := builder.ToUnstructured(fileTransfer)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldFileTransfer *vmopv1.VirtualMachineGuestFileTransfer
+		oldObj          *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldFileTransfer = fileTransfer.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldFileTransfer)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		fileTransfer:                        fileTransfer,
+		oldFileTransfer:                     oldFileTransfer,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.Features.VMGuestFileTransferPVC = true
+		})
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.fileTransfer)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateCreate(&ctx.WebhookRequestContext)
+	})
+
+	When("the transfer is valid", func() {
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the transfer copies a file from the guest to a ConfigMap", func() {
+		BeforeEach(func() {
+			ctx.fileTransfer.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+			ctx.fileTransfer.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindConfigMap
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the transfer copies a file from the guest to a PersistentVolumeClaim", func() {
+		BeforeEach(func() {
+			ctx.fileTransfer.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+			ctx.fileTransfer.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim
+			ctx.fileTransfer.Spec.Object.Key = "certs/ca.crt"
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+
+		When("copying files to or from PersistentVolumeClaims is not enabled", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.VMGuestFileTransferPVC = false
+				})
+			})
+
+			It("should deny the request", func() {
+				Expect(response.Allowed).To(BeFalse())
+				Expect(string(response.Result.Reason)).To(ContainSubstring("spec.object.kind: Invalid value"))
+			})
+		})
+	})
+
+	DescribeTable("invalid specs",
+		func(mutate func(*vmopv1.VirtualMachineGuestFileTransfer), reason string) {
+			mutate(ctx.fileTransfer)
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.fileTransfer)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring(reason))
+		},
+		Entry("empty vmName",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.VMName = "" },
+			"spec.vmName: Required value"),
+		Entry("unsupported direction",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.Direction = "Sideways" },
+			"spec.direction: Unsupported value"),
+		Entry("empty guestPath",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.GuestPath = "" },
+			"spec.guestPath: Required value"),
+		Entry("empty credentialsSecretName",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.CredentialsSecretName = "" },
+			"spec.credentialsSecretName: Required value"),
+		Entry("unsupported object kind",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.Object.Kind = "Pod" },
+			"spec.object.kind: Unsupported value"),
+		Entry("empty object name",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.Object.Name = "" },
+			"spec.object.name: Required value"),
+		Entry("empty object key",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.Object.Key = "" },
+			"spec.object.key: Required value"),
+		Entry("absolute PersistentVolumeClaim key",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) {
+				t.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim
+				t.Spec.Object.Key = "/etc/passwd"
+			},
+			"spec.object.key: Invalid value"),
+		Entry("PersistentVolumeClaim key outside of the volume",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) {
+				t.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim
+				t.Spec.Object.Key = "certs/../../ca.crt"
+			},
+			"spec.object.key: Invalid value"),
+		Entry("PersistentVolumeClaim key of the volume root",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) {
+				t.Spec.Object.Kind = vmopv1.VirtualMachineGuestFileTransferObjectKindPersistentVolumeClaim
+				t.Spec.Object.Key = "certs/.."
+			},
+			"spec.object.key: Invalid value"),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.fileTransfer)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the labels are changed", func() {
+		BeforeEach(func() {
+			ctx.fileTransfer.Labels = map[string]string{"foo": "bar"}
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	DescribeTable("spec is immutable",
+		func(mutate func(*vmopv1.VirtualMachineGuestFileTransfer), field string) {
+			mutate(ctx.fileTransfer)
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.fileTransfer)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring(field + ": Invalid value"))
+		},
+		Entry("vmName",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.VMName = "other-vm" },
+			"spec.vmName"),
+		Entry("direction",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) {
+				t.Spec.Direction = vmopv1.VirtualMachineGuestFileTransferDirectionFromGuest
+			},
+			"spec.direction"),
+		Entry("guestPath",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.GuestPath = "/tmp/other.pem" },
+			"spec.guestPath"),
+		Entry("object",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.Object.Key = "ca.crt" },
+			"spec.object"),
+		Entry("credentialsSecretName",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.CredentialsSecretName = "other-credentials" },
+			"spec.credentialsSecretName"),
+		Entry("overwrite",
+			func(t *vmopv1.VirtualMachineGuestFileTransfer) { t.Spec.Overwrite = true },
+			"spec.overwrite"),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
@@ -0,0 +1,221 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+
+	// maxNameLength is the maximum length of a pool's name. The names of the
+	// VirtualMachineIPAddressClaims for the pool's addresses are the pool's
+	// name followed by a dash and up to 32 hexadecimal characters, and must
+	// not exceed the maximum length of an object name.
+	maxNameLength = validation.DNS1123SubdomainMaxLength - 33
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachineippool,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineippools,versions=v1alpha6,name=default.validating.virtualmachineippool.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineIPPool validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineIPPool{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	pool, err := v.ipPoolFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, pool)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	pool, err := v.ipPoolFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, pool)
+}
+
+func (v validator) validate(
+	ctx *pkgctx.WebhookRequestContext,
+	pool *vmopv1.VirtualMachineIPPool) admission.Response {
+
+	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, v.validateMetadata(ctx, pool)...)
+	fieldErrs = append(fieldErrs, v.validateSpec(ctx, pool)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateMetadata(
+	_ *pkgctx.WebhookRequestContext,
+	pool *vmopv1.VirtualMachineIPPool) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if len(pool.Name) > maxNameLength {
+		allErrs = append(allErrs, field.TooLong(
+			field.NewPath("metadata", "name"), pool.Name, maxNameLength))
+	}
+
+	return allErrs
+}
+
+func (v validator) validateSpec(
+	_ *pkgctx.WebhookRequestContext,
+	pool *vmopv1.VirtualMachineIPPool) field.ErrorList {
+
+	var (
+		allErrs  field.ErrorList
+		specPath = field.NewPath("spec")
+		cidrs    []netip.Prefix
+	)
+
+	if len(pool.Spec.CIDRs) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("cidrs"), ""))
+	}
+
+	for i, c := range pool.Spec.CIDRs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("cidrs").Index(i), c, "must be an IPv4 or IPv6 CIDR"))
+			continue
+		}
+		for _, o := range cidrs {
+			if o.Overlaps(p) {
+				allErrs = append(allErrs, field.Invalid(
+					specPath.Child("cidrs").Index(i), c, "must not overlap with another CIDR"))
+				break
+			}
+		}
+		cidrs = append(cidrs, p.Masked())
+	}
+
+	for i, e := range pool.Spec.Exclusions {
+		if strings.Contains(e, "/") {
+			if _, err := netip.ParsePrefix(e); err != nil {
+				allErrs = append(allErrs, field.Invalid(
+					specPath.Child("exclusions").Index(i), e, "must be an IPv4 or IPv6 address or CIDR"))
+			}
+		} else if _, err := netip.ParseAddr(e); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("exclusions").Index(i), e, "must be an IPv4 or IPv6 address or CIDR"))
+		}
+	}
+
+	allErrs = append(allErrs, validateGateway(specPath.Child("gateway4"), pool.Spec.Gateway4, true, cidrs)...)
+	allErrs = append(allErrs, validateGateway(specPath.Child("gateway6"), pool.Spec.Gateway6, false, cidrs)...)
+
+	for i, n := range pool.Spec.Nameservers {
+		if _, err := netip.ParseAddr(n); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("nameservers").Index(i), n, "must be an IPv4 or IPv6 address"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateGateway(
+	fieldPath *field.Path,
+	gateway string,
+	isIPv4 bool,
+	cidrs []netip.Prefix) field.ErrorList {
+
+	if gateway == "" {
+		return nil
+	}
+
+	family := "IPv4"
+	if !isIPv4 {
+		family = "IPv6"
+	}
+
+	a, err := netip.ParseAddr(gateway)
+	if err != nil || a.Is4() != isIPv4 {
+		return field.ErrorList{
+			field.Invalid(fieldPath, gateway, fmt.Sprintf("must be a valid %s address", family)),
+		}
+	}
+
+	for _, p := range cidrs {
+		if p.Contains(a) {
+			return nil
+		}
+	}
+
+	return field.ErrorList{
+		field.Invalid(fieldPath, gateway, fmt.Sprintf("must be in one of the %s CIDRs", family)),
+	}
+}
+
+// ipPoolFromUnstructured returns the VirtualMachineIPPool from the
+// unstructured object.
+func (v validator) ipPoolFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineIPPool, error) {
+
+	pool := &vmopv1.VirtualMachineIPPool{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
@@ -0,0 +1,221 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateUpdate,
+	)
+}
+
+type intgValidatingWebhookContext struct {
+	builder.IntegrationTestContext
+	pool *vmopv1.VirtualMachineIPPool
+}
+
+func newIntgValidatingWebhookContext() *intgValidatingWebhookContext {
+	ctx := &intgValidatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	ctx.pool = builder.DummyVirtualMachineIPPool()
+	ctx.pool.Namespace = ctx.Namespace
+
+	return ctx
+}
+
+func intgTestsValidateCreate() {
+	var (
+		ctx *intgValidatingWebhookContext
+		err error
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+	})
+
+	JustBeforeEach(func() {
+		err = ctx.Client.Create(suite, ctx.pool)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("the pool is valid", func() {
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the pool has IPv4 and IPv6 CIDRs", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "fd00::/120")
+			ctx.pool.Spec.Gateway6 = "fd00::1"
+			ctx.pool.Spec.Exclusions = append(ctx.pool.Spec.Exclusions, "fd00::2")
+		})
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the name is too long", func() {
+		BeforeEach(func() {
+			ctx.pool.GenerateName = ""
+			ctx.pool.Name = strings.Repeat("a", 221)
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("metadata.name: Too long"))
+		})
+	})
+
+	When("a CIDR is invalid", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.CIDRs = []string{"192.168.10.0/33"}
+			ctx.pool.Spec.Gateway4 = ""
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.cidrs[0]: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must be an IPv4 or IPv6 CIDR"))
+		})
+	})
+
+	When("the CIDRs overlap", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "192.168.10.128/25")
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.cidrs[1]: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must not overlap with another CIDR"))
+		})
+	})
+
+	When("an exclusion is invalid", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.Exclusions = []string{"192.168.10.300"}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.exclusions[0]: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must be an IPv4 or IPv6 address or CIDR"))
+		})
+	})
+
+	When("the gateway is outside of the CIDRs", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.Gateway4 = "192.168.11.1"
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be in one of the IPv4 CIDRs"))
+		})
+	})
+
+	When("the IPv6 gateway is an IPv4 address", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.Gateway6 = "192.168.10.1"
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.gateway6: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must be a valid IPv6 address"))
+		})
+	})
+
+	When("a nameserver is invalid", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.Nameservers = []string{"dns.example.com"}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.nameservers[0]: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must be an IPv4 or IPv6 address"))
+		})
+	})
+}
+
+func intgTestsValidateUpdate() {
+	var (
+		ctx *intgValidatingWebhookContext
+		err error
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+		Expect(ctx.Client.Create(ctx, ctx.pool)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		err = ctx.Client.Update(suite, ctx.pool)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("a CIDR is added", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "192.168.11.0/24")
+		})
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the CIDR containing the gateway is removed", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.CIDRs = []string{"192.168.11.0/24"}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be in one of the IPv4 CIDRs"))
+		})
+	})
+
+	When("a nameserver is changed to an invalid address", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.Nameservers = []string{"192.168.10"}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.nameservers[0]: Invalid value"))
+		})
+	})
+}
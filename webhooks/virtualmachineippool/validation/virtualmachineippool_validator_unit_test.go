@@ -0,0 +1,300 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+type testParams struct {
+	setup         func(ctx *unitValidatingWebhookContext)
+	validate      func(ctx *unitValidatingWebhookContext, response admission.Response)
+	expectAllowed bool
+}
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	pool, oldPool *vmopv1.VirtualMachineIPPool
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	pool := builder.DummyVirtualMachineIPPool()
+	pool.Name = "dummy-pool-for-webhook-validation"
+	pool.Namespace = "dummy-pool-namespace-for-webhook-validation"
+	obj, err := builder.ToUnstructured(pool)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldPool *vmopv1.VirtualMachineIPPool
+		oldObj  *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldPool = pool.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldPool)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		pool:                                pool,
+		oldPool:                             oldPool,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	doTest := func(args testParams) {
+		if args.setup != nil {
+			args.setup(ctx)
+		}
+
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.pool)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(args.expectAllowed))
+
+		if args.validate != nil {
+			args.validate(ctx, response)
+		}
+	}
+
+	expectReason := func(reason string) func(*unitValidatingWebhookContext, admission.Response) {
+		return func(_ *unitValidatingWebhookContext, response admission.Response) {
+			Expect(string(response.Result.Reason)).To(ContainSubstring(reason))
+		}
+	}
+
+	DescribeTable("create table", doTest,
+		Entry("should allow a valid pool",
+			testParams{
+				expectAllowed: true,
+			},
+		),
+		Entry("should allow a dual-stack pool",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "2001:db8:101::/64")
+					ctx.pool.Spec.Exclusions = append(ctx.pool.Spec.Exclusions, "2001:db8:101::2")
+					ctx.pool.Spec.Gateway6 = "2001:db8:101::1"
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should allow the longest name",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Name = strings.Repeat("a", 220)
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny a name that is too long",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Name = strings.Repeat("a", 221)
+				},
+				validate:      expectReason("metadata.name: Too long: may not be more than 220 bytes"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny no CIDRs",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.CIDRs = nil
+					ctx.pool.Spec.Gateway4 = ""
+				},
+				validate:      expectReason("spec.cidrs: Required value"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid CIDR",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "192.168.11.0")
+				},
+				validate:      expectReason(`spec.cidrs[1]: Invalid value: "192.168.11.0": must be an IPv4 or IPv6 CIDR`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny overlapping CIDRs",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "192.168.10.128/25")
+				},
+				validate:      expectReason(`spec.cidrs[1]: Invalid value: "192.168.10.128/25": must not overlap with another CIDR`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid exclusion",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.Exclusions = []string{"192.168.10.300"}
+				},
+				validate:      expectReason(`spec.exclusions[0]: Invalid value: "192.168.10.300": must be an IPv4 or IPv6 address or CIDR`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an IPv6 gateway4",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.Gateway4 = "2001:db8:101::1"
+				},
+				validate:      expectReason(`spec.gateway4: Invalid value: "2001:db8:101::1": must be a valid IPv4 address`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a gateway4 outside of the CIDRs",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.Gateway4 = "192.168.11.1"
+				},
+				validate:      expectReason(`spec.gateway4: Invalid value: "192.168.11.1": must be in one of the IPv4 CIDRs`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a gateway6 without IPv6 CIDRs",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.Gateway6 = "2001:db8:101::1"
+				},
+				validate:      expectReason(`spec.gateway6: Invalid value: "2001:db8:101::1": must be in one of the IPv6 CIDRs`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid nameserver",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.pool.Spec.Nameservers = []string{"dns.local"}
+				},
+				validate:      expectReason(`spec.nameservers[0]: Invalid value: "dns.local": must be an IPv4 or IPv6 address`),
+				expectAllowed: false,
+			},
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.pool)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("a CIDR is added", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.CIDRs = append(ctx.pool.Spec.CIDRs, "192.168.11.0/24")
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the gateway is invalid", func() {
+		BeforeEach(func() {
+			ctx.pool.Spec.Gateway4 = "192.168.11.1"
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+		})
+	})
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
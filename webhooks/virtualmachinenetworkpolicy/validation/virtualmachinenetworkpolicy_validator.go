@@ -0,0 +1,213 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+
+	peerRequiresOneOf                   = "exactly one of vmSelector or ipBlock must be specified"
+	namespaceSelectorRequiresVMSelector = "may only be specified with vmSelector"
+	endPortRequiresPort                 = "may only be specified with port"
+	endPortLessThanPort                 = "must be greater than or equal to port"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinenetworkpolicy,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinenetworkpolicies,versions=v1alpha6,name=default.validating.virtualmachinenetworkpolicy.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineNetworkPolicy validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineNetworkPolicy{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	policy, err := v.networkPolicyFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, policy)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	policy, err := v.networkPolicyFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, policy)
+}
+
+func (v validator) validate(
+	ctx *pkgctx.WebhookRequestContext,
+	policy *vmopv1.VirtualMachineNetworkPolicy) admission.Response {
+
+	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, v.validateSpec(ctx, policy)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateSpec(
+	_ *pkgctx.WebhookRequestContext,
+	policy *vmopv1.VirtualMachineNetworkPolicy) field.ErrorList {
+
+	var (
+		allErrs  field.ErrorList
+		specPath = field.NewPath("spec")
+	)
+
+	allErrs = append(allErrs, validateSelector(specPath.Child("vmSelector"), &policy.Spec.VMSelector)...)
+
+	for i, r := range policy.Spec.Ingress {
+		rulePath := specPath.Child("ingress").Index(i)
+		for j := range r.From {
+			allErrs = append(allErrs, validatePeer(rulePath.Child("from").Index(j), r.From[j])...)
+		}
+		for j := range r.Ports {
+			allErrs = append(allErrs, validatePort(rulePath.Child("ports").Index(j), r.Ports[j])...)
+		}
+	}
+
+	for i, r := range policy.Spec.Egress {
+		rulePath := specPath.Child("egress").Index(i)
+		for j := range r.To {
+			allErrs = append(allErrs, validatePeer(rulePath.Child("to").Index(j), r.To[j])...)
+		}
+		for j := range r.Ports {
+			allErrs = append(allErrs, validatePort(rulePath.Child("ports").Index(j), r.Ports[j])...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateSelector(fieldPath *field.Path, selector *metav1.LabelSelector) field.ErrorList {
+	if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+		return field.ErrorList{
+			field.Invalid(fieldPath, selector, err.Error()),
+		}
+	}
+	return nil
+}
+
+func validatePeer(
+	fieldPath *field.Path,
+	peer vmopv1.VirtualMachineNetworkPolicyPeer) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if (peer.VMSelector == nil) == (peer.IPBlock == nil) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, "", peerRequiresOneOf))
+	}
+
+	if peer.VMSelector != nil {
+		allErrs = append(allErrs, validateSelector(fieldPath.Child("vmSelector"), peer.VMSelector)...)
+	}
+
+	if peer.NamespaceSelector != nil {
+		if peer.VMSelector == nil {
+			allErrs = append(allErrs, field.Forbidden(
+				fieldPath.Child("namespaceSelector"), namespaceSelectorRequiresVMSelector))
+		}
+		allErrs = append(allErrs, validateSelector(fieldPath.Child("namespaceSelector"), peer.NamespaceSelector)...)
+	}
+
+	if peer.IPBlock != nil {
+		if _, err := netip.ParsePrefix(peer.IPBlock.CIDR); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("ipBlock", "cidr"), peer.IPBlock.CIDR, "must be an IPv4 or IPv6 CIDR"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePort(
+	fieldPath *field.Path,
+	port vmopv1.VirtualMachineNetworkPolicyPort) field.ErrorList {
+
+	if port.EndPort == nil {
+		return nil
+	}
+
+	if port.Port == nil {
+		return field.ErrorList{
+			field.Forbidden(fieldPath.Child("endPort"), endPortRequiresPort),
+		}
+	}
+
+	if *port.EndPort < *port.Port {
+		return field.ErrorList{
+			field.Invalid(fieldPath.Child("endPort"), *port.EndPort, endPortLessThanPort),
+		}
+	}
+
+	return nil
+}
+
+// networkPolicyFromUnstructured returns the VirtualMachineNetworkPolicy from
+// the unstructured object.
+func (v validator) networkPolicyFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineNetworkPolicy, error) {
+
+	policy := &vmopv1.VirtualMachineNetworkPolicy{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
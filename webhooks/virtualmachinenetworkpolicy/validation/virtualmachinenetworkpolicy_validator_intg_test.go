@@ -0,0 +1,257 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateUpdate,
+	)
+}
+
+type intgValidatingWebhookContext struct {
+	builder.IntegrationTestContext
+	policy *vmopv1.VirtualMachineNetworkPolicy
+}
+
+func newIntgValidatingWebhookContext() *intgValidatingWebhookContext {
+	ctx := &intgValidatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	ctx.policy = builder.DummyVirtualMachineNetworkPolicy()
+	ctx.policy.Namespace = ctx.Namespace
+
+	return ctx
+}
+
+func intgTestsValidateCreate() {
+	var (
+		ctx *intgValidatingWebhookContext
+		err error
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+	})
+
+	JustBeforeEach(func() {
+		err = ctx.Client.Create(suite, ctx.policy)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("the policy is valid", func() {
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the policy has egress rules", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.PolicyTypes = []vmopv1.VirtualMachineNetworkPolicyType{
+				vmopv1.VirtualMachineNetworkPolicyTypeIngress,
+				vmopv1.VirtualMachineNetworkPolicyTypeEgress,
+			}
+			ctx.policy.Spec.Egress = []vmopv1.VirtualMachineNetworkPolicyEgressRule{
+				{
+					To: []vmopv1.VirtualMachineNetworkPolicyPeer{
+						{
+							VMSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "cache"},
+							},
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+						{
+							IPBlock: &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+								CIDR: "2001:db8::/64",
+							},
+						},
+					},
+					Ports: []vmopv1.VirtualMachineNetworkPolicyPort{
+						{
+							Port:    ptr.To[int32](6379),
+							EndPort: ptr.To[int32](6380),
+						},
+					},
+				},
+			}
+		})
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the VM selector is invalid", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.VMSelector.MatchExpressions = []metav1.LabelSelectorRequirement{
+				{
+					Key:      "app",
+					Operator: "Matches",
+				},
+			}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.vmSelector: Invalid value"))
+		})
+	})
+
+	When("a peer specifies both a VM selector and an IP block", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From[0].IPBlock = &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+				CIDR: "192.168.20.0/24",
+			}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.ingress[0].from[0]: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("exactly one of vmSelector or ipBlock must be specified"))
+		})
+	})
+
+	When("a peer specifies neither a VM selector nor an IP block", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From[0].VMSelector = nil
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one of vmSelector or ipBlock must be specified"))
+		})
+	})
+
+	When("a peer specifies a namespace selector without a VM selector", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From[1].NamespaceSelector = &metav1.LabelSelector{}
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.ingress[0].from[1].namespaceSelector: Forbidden"))
+			Expect(err.Error()).To(ContainSubstring("may only be specified with vmSelector"))
+		})
+	})
+
+	When("a CIDR is invalid", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From[1].IPBlock.CIDR = "192.168.10.0"
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.ingress[0].from[1].ipBlock.cidr: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must be an IPv4 or IPv6 CIDR"))
+		})
+	})
+
+	When("an end port is specified without a port", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].Ports[0].Port = nil
+			ctx.policy.Spec.Ingress[0].Ports[0].EndPort = ptr.To[int32](5433)
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.ingress[0].ports[0].endPort: Forbidden"))
+			Expect(err.Error()).To(ContainSubstring("may only be specified with port"))
+		})
+	})
+
+	When("the end port is less than the port", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].Ports[0].EndPort = ptr.To[int32](5431)
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.ingress[0].ports[0].endPort: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("must be greater than or equal to port"))
+		})
+	})
+}
+
+func intgTestsValidateUpdate() {
+	var (
+		ctx *intgValidatingWebhookContext
+		err error
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+		Expect(ctx.Client.Create(ctx, ctx.policy)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		err = ctx.Client.Update(suite, ctx.policy)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	When("a peer is added", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From = append(ctx.policy.Spec.Ingress[0].From,
+				vmopv1.VirtualMachineNetworkPolicyPeer{
+					IPBlock: &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+						CIDR: "192.168.20.0/24",
+					},
+				})
+		})
+		It("should allow the request", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("a CIDR is changed to an invalid value", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From[1].IPBlock.CIDR = "192.168.10.0/33"
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be an IPv4 or IPv6 CIDR"))
+		})
+	})
+
+	When("the end port is changed to be less than the port", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].Ports[0].EndPort = ptr.To[int32](80)
+		})
+		It("should deny the request", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be greater than or equal to port"))
+		})
+	})
+}
@@ -0,0 +1,295 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+type testParams struct {
+	setup         func(ctx *unitValidatingWebhookContext)
+	validate      func(ctx *unitValidatingWebhookContext, response admission.Response)
+	expectAllowed bool
+}
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	policy, oldPolicy *vmopv1.VirtualMachineNetworkPolicy
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	policy := builder.DummyVirtualMachineNetworkPolicy()
+	policy.Name = "dummy-policy-for-webhook-validation"
+	policy.Namespace = "dummy-policy-namespace-for-webhook-validation"
+	obj, err := builder.ToUnstructured(policy)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldPolicy *vmopv1.VirtualMachineNetworkPolicy
+		oldObj    *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldPolicy = policy.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldPolicy)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		policy:                              policy,
+		oldPolicy:                           oldPolicy,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	doTest := func(args testParams) {
+		if args.setup != nil {
+			args.setup(ctx)
+		}
+
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.policy)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(args.expectAllowed))
+
+		if args.validate != nil {
+			args.validate(ctx, response)
+		}
+	}
+
+	expectReason := func(reason string) func(*unitValidatingWebhookContext, admission.Response) {
+		return func(_ *unitValidatingWebhookContext, response admission.Response) {
+			Expect(string(response.Result.Reason)).To(ContainSubstring(reason))
+		}
+	}
+
+	DescribeTable("create table", doTest,
+		Entry("should allow a valid policy",
+			testParams{
+				expectAllowed: true,
+			},
+		),
+		Entry("should allow an egress rule with a port range",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Egress = []vmopv1.VirtualMachineNetworkPolicyEgressRule{
+						{
+							To: []vmopv1.VirtualMachineNetworkPolicyPeer{
+								{
+									IPBlock: &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+										CIDR: "2001:db8::/64",
+									},
+								},
+							},
+							Ports: []vmopv1.VirtualMachineNetworkPolicyPort{
+								{
+									Port:    ptr.To[int32](8000),
+									EndPort: ptr.To[int32](9000),
+								},
+							},
+						},
+					}
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny an invalid vmSelector",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.VMSelector.MatchExpressions = []metav1.LabelSelectorRequirement{
+						{
+							Key:      "app",
+							Operator: "Bogus",
+						},
+					}
+				},
+				validate:      expectReason("spec.vmSelector: Invalid value"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a peer with vmSelector and ipBlock",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Ingress[0].From[0].IPBlock = &vmopv1.VirtualMachineNetworkPolicyIPBlock{
+						CIDR: "192.168.10.0/24",
+					}
+				},
+				validate:      expectReason("spec.ingress[0].from[0]: Invalid value: \"\": exactly one of vmSelector or ipBlock must be specified"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an empty peer",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Ingress[0].From[0] = vmopv1.VirtualMachineNetworkPolicyPeer{}
+				},
+				validate:      expectReason("exactly one of vmSelector or ipBlock must be specified"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a namespaceSelector without vmSelector",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Ingress[0].From[1].NamespaceSelector = &metav1.LabelSelector{}
+				},
+				validate:      expectReason("spec.ingress[0].from[1].namespaceSelector: Forbidden: may only be specified with vmSelector"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid CIDR",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Ingress[0].From[1].IPBlock.CIDR = "192.168.10.0"
+				},
+				validate:      expectReason(`spec.ingress[0].from[1].ipBlock.cidr: Invalid value: "192.168.10.0": must be an IPv4 or IPv6 CIDR`),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an endPort without port",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Ingress[0].Ports[0].Port = nil
+					ctx.policy.Spec.Ingress[0].Ports[0].EndPort = ptr.To[int32](9000)
+				},
+				validate:      expectReason("spec.ingress[0].ports[0].endPort: Forbidden: may only be specified with port"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an endPort less than port",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.policy.Spec.Ingress[0].Ports[0].EndPort = ptr.To[int32](80)
+				},
+				validate:      expectReason("spec.ingress[0].ports[0].endPort: Invalid value: 80: must be greater than or equal to port"),
+				expectAllowed: false,
+			},
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.policy)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("a rule is added", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Egress = []vmopv1.VirtualMachineNetworkPolicyEgressRule{{}}
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("a CIDR is invalid", func() {
+		BeforeEach(func() {
+			ctx.policy.Spec.Ingress[0].From[1].IPBlock.CIDR = "bogus"
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+		})
+	})
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/builder"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/oci"
 	"github.com/vmware-tanzu/vm-operator/webhooks/common"
 )
 
@@ -84,7 +85,11 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 
 	fieldErrs = append(fieldErrs, v.validateSource(ctx, vmpub)...)
-	fieldErrs = append(fieldErrs, v.validateTargetLocation(ctx, vmpub)...)
+	if vmpub.Spec.Target.OCI != nil {
+		fieldErrs = append(fieldErrs, v.validateTargetOCI(vmpub)...)
+	} else {
+		fieldErrs = append(fieldErrs, v.validateTargetLocation(ctx, vmpub)...)
+	}
 	// Validate that users are not adding any quota related annotations.
 	fieldErrs = append(fieldErrs, v.validateAsyncQuotaAnnotations(ctx, vmpub, nil)...)
 
@@ -181,6 +186,36 @@ func (v validator) validateTargetLocation(ctx *pkgctx.WebhookRequestContext, vmp
 	return allErrs
 }
 
+// validateTargetOCI validates the target OCI repository. The target location
+// is ignored when the target is an OCI repository.
+func (v validator) validateTargetOCI(vmpub *vmopv1.VirtualMachinePublishRequest) field.ErrorList {
+	var allErrs field.ErrorList
+
+	targetOCI := vmpub.Spec.Target.OCI
+	targetOCIPath := field.NewPath("spec").Child("target", "oci")
+
+	if targetOCI.Repository == "" {
+		allErrs = append(allErrs, field.Required(targetOCIPath.Child("repository"), ""))
+	} else if _, err := oci.ParseRepository(targetOCI.Repository, ""); err != nil {
+		allErrs = append(allErrs, field.Invalid(targetOCIPath.Child("repository"),
+			targetOCI.Repository, err.Error()))
+	} else if _, err := oci.ParseRepository(targetOCI.Repository, targetOCI.Tag); err != nil {
+		allErrs = append(allErrs, field.Invalid(targetOCIPath.Child("tag"),
+			targetOCI.Tag, err.Error()))
+	}
+
+	// The target item name is the name of the VirtualMachineImage that is
+	// created for the pushed artifact.
+	if itemName := vmpub.Spec.Target.Item.Name; itemName != "" {
+		for _, msg := range validation.NameIsDNSSubdomain(itemName, false) {
+			allErrs = append(allErrs, field.Invalid(
+				field.NewPath("spec").Child("target", "item", "name"), itemName, msg))
+		}
+	}
+
+	return allErrs
+}
+
 // validateCreateVMGroupPublishRequestOwnership ensures a VirtualMachineGroupPublishRequest owner reference is set
 // when a VirtualMachinePublishRequest has a managed by VirtualMachineGroupPublishRequest label.
 func (v validator) validateCreateVMGroupPublishRequestOwnership(vmPub *vmopv1.VirtualMachinePublishRequest) error {
@@ -127,6 +127,11 @@ func unitTestsValidateCreate() {
 		targetLocationNotFound          bool
 		targetItemAlreadyExists         bool
 		managedByLabelExists            bool
+		targetOCI                       bool
+		targetOCIRepositoryEmpty        bool
+		targetOCIRepositoryInvalid      bool
+		targetOCITagInvalid             bool
+		targetOCIItemNameInvalid        bool
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -176,6 +181,28 @@ func unitTestsValidateCreate() {
 			Expect(ctx.Client.Delete(ctx, ctx.cl)).To(Succeed())
 		}
 
+		if args.targetOCI {
+			// The target location is ignored when the target is an OCI
+			// repository.
+			ctx.vmPub.Spec.Target.Location = vmopv1.VirtualMachinePublishRequestTargetLocation{}
+			ctx.vmPub.Spec.Target.OCI = &vmopv1.VirtualMachinePublishRequestTargetOCI{
+				Repository: "registry.example.com/project/my-image",
+				Tag:        "v1",
+			}
+			if args.targetOCIRepositoryEmpty {
+				ctx.vmPub.Spec.Target.OCI.Repository = ""
+			}
+			if args.targetOCIRepositoryInvalid {
+				ctx.vmPub.Spec.Target.OCI.Repository = "registry.example.com/project/my-image:v1"
+			}
+			if args.targetOCITagInvalid {
+				ctx.vmPub.Spec.Target.OCI.Tag = "-v1"
+			}
+			if args.targetOCIItemNameInvalid {
+				ctx.vmPub.Spec.Target.Item.Name = "My_Image"
+			}
+		}
+
 		if args.targetItemAlreadyExists {
 			clItem := utils.DummyContentLibraryItem("dummy-item", ctx.vmPub.Namespace)
 			Expect(ctx.Client.Create(ctx, clItem)).To(Succeed())
@@ -206,6 +233,7 @@ func unitTestsValidateCreate() {
 
 	sourcePath := field.NewPath("spec").Child("source")
 	targetLocationPath := field.NewPath("spec").Child("target", "location")
+	targetOCIPath := field.NewPath("spec").Child("target", "oci")
 	DescribeTable("create table", validateCreate,
 		Entry("should allow valid", createArgs{}, true, nil, nil),
 		Entry("should deny invalid source API version", createArgs{invalidSourceAPIVersion: true}, false,
@@ -229,6 +257,15 @@ func unitTestsValidateCreate() {
 		Entry("should deny if managed-by label is set by a non privileged user", createArgs{managedByLabelExists: true}, false,
 			"", fmt.Errorf("cannot add the %q label without a VirtualMachineGroupPublishRequest owner reference",
 				vmopv1.VirtualMachinePublishRequestManagedByLabelKey)),
+		Entry("should allow valid target OCI repository", createArgs{targetOCI: true}, true, nil, nil),
+		Entry("should deny if target OCI repository is empty", createArgs{targetOCI: true, targetOCIRepositoryEmpty: true}, false,
+			field.Required(targetOCIPath.Child("repository"), "").Error(), nil),
+		Entry("should deny if target OCI repository includes a tag", createArgs{targetOCI: true, targetOCIRepositoryInvalid: true}, false,
+			targetOCIPath.Child("repository").String()+": Invalid value", nil),
+		Entry("should deny invalid target OCI tag", createArgs{targetOCI: true, targetOCITagInvalid: true}, false,
+			targetOCIPath.Child("tag").String()+": Invalid value", nil),
+		Entry("should deny if target item name is not a valid image name", createArgs{targetOCI: true, targetOCIItemNameInvalid: true}, false,
+			"spec.target.item.name: Invalid value", nil),
 	)
 
 	type createArgsAnnotations struct {
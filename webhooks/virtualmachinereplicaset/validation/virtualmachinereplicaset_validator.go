@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -68,6 +69,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 
 	fieldErrs = append(fieldErrs, v.validateLabelSelectorLabelMatch(ctx, rs, nil)...)
+	fieldErrs = append(fieldErrs, v.validateDeletePolicy(ctx, rs)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -89,6 +91,7 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 
 	var fieldErrs field.ErrorList
 	fieldErrs = append(fieldErrs, v.validateLabelSelectorLabelMatch(ctx, rs, nil)...)
+	fieldErrs = append(fieldErrs, v.validateDeletePolicy(ctx, rs)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -133,6 +136,33 @@ func (v validator) validateLabelSelectorLabelMatch(
 	return allErrs
 }
 
+var supportedDeletePolicies = []string{
+	vmopv1.VirtualMachineReplicaSetDeletePolicyRandom,
+	vmopv1.VirtualMachineReplicaSetDeletePolicyNewest,
+	vmopv1.VirtualMachineReplicaSetDeletePolicyOldest,
+	vmopv1.VirtualMachineReplicaSetDeletePolicyNotReadyFirst,
+}
+
+func (v validator) validateDeletePolicy(
+	_ *pkgctx.WebhookRequestContext,
+	rs *vmopv1.VirtualMachineReplicaSet) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if p := rs.Spec.DeletePolicy; p != "" && !slices.Contains(supportedDeletePolicies, p) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(
+				field.NewPath("spec", "deletePolicy"),
+				p,
+				supportedDeletePolicies,
+			),
+		)
+	}
+
+	return allErrs
+}
+
 // rsFromUnstructured returns the VirtualMachineClass from the unstructured object.
 func (v validator) rsFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachineReplicaSet, error) {
 	rs := &vmopv1.VirtualMachineReplicaSet{}
@@ -57,6 +57,15 @@ func unitTests() {
 		),
 		unitTestValidateTemplateObjectMetaAndSelectorMatching,
 	)
+	Describe(
+		"DeletePolicy",
+		Label(
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestValidateDeletePolicy,
+	)
 }
 
 type unitValidatingWebhookContext struct {
@@ -247,6 +256,45 @@ func unitTestValidateTemplateObjectMetaAndSelectorMatching() {
 	})
 }
 
+func unitTestValidateDeletePolicy() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	doTest := func(deletePolicy string, expectAllowed bool) {
+		ctx.rs.Spec.DeletePolicy = deletePolicy
+
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.rs)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(expectAllowed))
+		if !expectAllowed {
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.deletePolicy: Unsupported value"))
+		}
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(expectAllowed))
+	}
+
+	DescribeTable("delete policy validations", doTest,
+		Entry("should allow an empty policy", "", true),
+		Entry("should allow Random", vmopv1.VirtualMachineReplicaSetDeletePolicyRandom, true),
+		Entry("should allow Newest", vmopv1.VirtualMachineReplicaSetDeletePolicyNewest, true),
+		Entry("should allow Oldest", vmopv1.VirtualMachineReplicaSetDeletePolicyOldest, true),
+		Entry("should allow NotReadyFirst", vmopv1.VirtualMachineReplicaSetDeletePolicyNotReadyFirst, true),
+		Entry("should reject an unknown policy", "LeastRecentlyUsed", false),
+	)
+}
+
 func unitTestsValidateUpdate() {
 	var (
 		ctx      *unitValidatingWebhookContext
@@ -0,0 +1,153 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachineserialconsolerequest,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineserialconsolerequests,versions=v1alpha6,name=default.validating.virtualmachineserialconsolerequest.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineserialconsolerequests,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineserialconsolerequests/status,verbs=get
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create virtualmachineserialconsolerequest validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(client client.Client) builder.Validator {
+	return validator{
+		client:    client,
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	client    client.Client
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineSerialConsoleRequest{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	scr, err := v.serialConsoleRequestFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+	fieldErrs = append(fieldErrs, v.validateSpec(scr)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	scr, err := v.serialConsoleRequestFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldscr, err := v.serialConsoleRequestFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+	fieldErrs = append(fieldErrs, v.validateImmutableFields(scr, oldscr)...)
+	fieldErrs = append(fieldErrs, v.validateUUIDLabel(scr, oldscr)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateSpec(scr *vmopv1.VirtualMachineSerialConsoleRequest) field.ErrorList {
+	var fieldErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if scr.Spec.Name == "" {
+		fieldErrs = append(fieldErrs, field.Required(specPath.Child("name"), ""))
+	}
+
+	if ttl := scr.Spec.TTLSeconds; ttl != nil && *ttl <= 0 {
+		fieldErrs = append(fieldErrs, field.Invalid(specPath.Child("ttlSeconds"), *ttl, "must be greater than 0"))
+	}
+
+	return fieldErrs
+}
+
+func (v validator) validateImmutableFields(scr, oldscr *vmopv1.VirtualMachineSerialConsoleRequest) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validation.ValidateImmutableField(scr.Spec.Name, oldscr.Spec.Name, specPath.Child("name"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(scr.Spec.TTLSeconds, oldscr.Spec.TTLSeconds, specPath.Child("ttlSeconds"))...)
+
+	return allErrs
+}
+
+func (v validator) validateUUIDLabel(scr, oldscr *vmopv1.VirtualMachineSerialConsoleRequest) field.ErrorList {
+	var allErrs field.ErrorList
+
+	oldUUIDLabelVal := oldscr.Labels[vmopv1.SerialConsoleRequestUUIDLabelKey]
+	if oldUUIDLabelVal == "" {
+		return allErrs
+	}
+
+	newUUIDLabelVal := scr.Labels[vmopv1.SerialConsoleRequestUUIDLabelKey]
+	labelsPath := field.NewPath("metadata", "labels")
+	allErrs = append(allErrs, validation.ValidateImmutableField(newUUIDLabelVal, oldUUIDLabelVal, labelsPath.Key(vmopv1.SerialConsoleRequestUUIDLabelKey))...)
+
+	return allErrs
+}
+
+// serialConsoleRequestFromUnstructured returns the request from the
+// unstructured object.
+func (v validator) serialConsoleRequestFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachineSerialConsoleRequest, error) {
+	scr := &vmopv1.VirtualMachineSerialConsoleRequest{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), scr); err != nil {
+		return nil, err
+	}
+	return scr, nil
+}
@@ -0,0 +1,223 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	scr    *vmopv1.VirtualMachineSerialConsoleRequest
+	oldScr *vmopv1.VirtualMachineSerialConsoleRequest
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	scr := builder.DummyVirtualMachineSerialConsoleRequest("some-namespace", "some-name", "some-vm-name")
+	scr.Labels = map[string]string{
+		vmopv1.SerialConsoleRequestUUIDLabelKey: "some-uuid",
+	}
+	obj, err := builder.ToUnstructured(scr)
+	Expect(err).ToNot(HaveOccurred())
+
+	var oldScr *vmopv1.VirtualMachineSerialConsoleRequest
+	var oldObj *unstructured.Unstructured
+
+	if isUpdate {
+		oldScr = scr.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldScr)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj),
+		scr:                                 scr,
+		oldScr:                              oldScr,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	type createArgs struct {
+		emptyVirtualMachineName bool
+		ttlSeconds              *int64
+	}
+
+	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
+		var err error
+
+		if args.emptyVirtualMachineName {
+			ctx.scr.Spec.Name = ""
+		}
+		if args.ttlSeconds != nil {
+			ctx.scr.Spec.TTLSeconds = args.ttlSeconds
+		}
+
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.scr)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(expectedAllowed))
+		if expectedReason != "" {
+			Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+		}
+		if expectedErr != nil {
+			Expect(response.Result.Message).To(Equal(expectedErr.Error()))
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("create table", validateCreate,
+		Entry("should allow valid", createArgs{}, true, nil, nil),
+		Entry("should deny empty virtualmachinename", createArgs{emptyVirtualMachineName: true}, false, "spec.name: Required value", nil),
+		Entry("should allow ttlSeconds", createArgs{ttlSeconds: ptr.To[int64](60)}, true, nil, nil),
+		Entry("should deny zero ttlSeconds", createArgs{ttlSeconds: ptr.To[int64](0)}, false, "spec.ttlSeconds: Invalid value: 0: must be greater than 0", nil),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	type updateArgs struct {
+		updateVirtualMachineName bool
+		updateUUIDLabel          bool
+		updateTTLSeconds         bool
+	}
+
+	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
+		var err error
+
+		if args.updateVirtualMachineName {
+			ctx.scr.Spec.Name = "new-vm-name"
+		}
+
+		if args.updateUUIDLabel {
+			ctx.scr.Labels[vmopv1.SerialConsoleRequestUUIDLabelKey] = "new-uuid"
+		}
+
+		if args.updateTTLSeconds {
+			ctx.scr.Spec.TTLSeconds = ptr.To[int64](60)
+		}
+
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.scr)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(expectedAllowed))
+		if expectedReason != "" {
+			Expect(string(response.Result.Reason)).To(Equal(expectedReason))
+		}
+		if expectedErr != nil {
+			Expect(response.Result.Message).To(Equal(expectedErr.Error()))
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("update table", validateUpdate,
+		Entry("should allow", updateArgs{}, true, nil, nil),
+		Entry("should deny Virtualmachine Name change", updateArgs{updateVirtualMachineName: true}, false, "spec.name: Invalid value: \"new-vm-name\": field is immutable", nil),
+		Entry("should deny UUID label change", updateArgs{updateUUIDLabel: true}, false, "metadata.labels[vmoperator.vmware.com/serialconsolerequest-uuid]: Invalid value: \"new-uuid\": field is immutable", nil),
+		Entry("should deny TTLSeconds change", updateArgs{updateTTLSeconds: true}, false, "spec.ttlSeconds: Invalid value: 60: field is immutable", nil),
+	)
+
+	When("the update is performed while object deletion", func() {
+		JustBeforeEach(func() {
+			t := metav1.Now()
+			ctx.WebhookRequestContext.Obj.SetDeletionTimestamp(&t)
+			response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
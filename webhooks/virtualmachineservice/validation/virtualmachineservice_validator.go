@@ -184,6 +184,10 @@ func (v validator) validateSpec(ctx *pkgctx.WebhookRequestContext, vmService *vm
 		allErrs = append(allErrs, field.NotSupported(specPath.Child("type"), vmService.Spec.Type, supportedServiceType.List()))
 	}
 
+	allErrs = append(allErrs, validateSessionAffinity(vmService, specPath)...)
+	allErrs = append(allErrs, validateExternalTrafficPolicy(vmService, specPath)...)
+	allErrs = append(allErrs, validateIPFamilies(vmService, specPath)...)
+
 	if len(vmService.Spec.LoadBalancerSourceRanges) > 0 {
 		fldPath := specPath.Child("loadBalancerSourceRanges")
 
@@ -270,6 +274,88 @@ func validateServicePort(sp *vmopv1.VirtualMachineServicePort, requireName bool,
 	return allErrs
 }
 
+func validateSessionAffinity(vmService *vmopv1.VirtualMachineService, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if vmService.Spec.SessionAffinity == vmopv1.VirtualMachineServiceSessionAffinityClientIP &&
+		vmService.Spec.Type == vmopv1.VirtualMachineServiceTypeExternalName {
+
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("sessionAffinity"),
+			"may not be set to 'ClientIP' for ExternalName services"))
+	}
+
+	if c := vmService.Spec.SessionAffinityConfig; c != nil {
+		fldPath := specPath.Child("sessionAffinityConfig")
+
+		if vmService.Spec.SessionAffinity != vmopv1.VirtualMachineServiceSessionAffinityClientIP {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "may only be used when `sessionAffinity` is 'ClientIP'"))
+		} else if c.ClientIP == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("clientIP"), ""))
+		}
+	}
+
+	return allErrs
+}
+
+func validateExternalTrafficPolicy(vmService *vmopv1.VirtualMachineService, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if vmService.Spec.ExternalTrafficPolicy != "" &&
+		vmService.Spec.Type != vmopv1.VirtualMachineServiceTypeLoadBalancer {
+
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("externalTrafficPolicy"),
+			"may only be used when `type` is 'LoadBalancer'"))
+	}
+
+	if vmService.Spec.HealthCheckNodePort != 0 {
+		fldPath := specPath.Child("healthCheckNodePort")
+
+		if vmService.Spec.Type != vmopv1.VirtualMachineServiceTypeLoadBalancer {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "may only be used when `type` is 'LoadBalancer'"))
+		} else if vmService.Spec.ExternalTrafficPolicy != vmopv1.VirtualMachineServiceExternalTrafficPolicyLocal {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "may only be used when `externalTrafficPolicy` is 'Local'"))
+		}
+
+		for _, msg := range validation.IsValidPortNum(int(vmService.Spec.HealthCheckNodePort)) {
+			allErrs = append(allErrs, field.Invalid(fldPath, vmService.Spec.HealthCheckNodePort, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIPFamilies(vmService *vmopv1.VirtualMachineService, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if vmService.Spec.Type == vmopv1.VirtualMachineServiceTypeExternalName {
+		if len(vmService.Spec.IPFamilies) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("ipFamilies"), "may not be set for ExternalName services"))
+		}
+		if vmService.Spec.IPFamilyPolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("ipFamilyPolicy"), "may not be set for ExternalName services"))
+		}
+		return allErrs
+	}
+
+	fldPath := specPath.Child("ipFamilies")
+	seen := sets.New[vmopv1.VirtualMachineServiceIPFamily]()
+	for i, ipFamily := range vmService.Spec.IPFamilies {
+		if seen.Has(ipFamily) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), ipFamily))
+		}
+		seen.Insert(ipFamily)
+	}
+
+	if p := vmService.Spec.IPFamilyPolicy; p != nil &&
+		*p == vmopv1.VirtualMachineServiceIPFamilyPolicySingleStack && len(vmService.Spec.IPFamilies) > 1 {
+
+		allErrs = append(allErrs, field.Invalid(fldPath, vmService.Spec.IPFamilies,
+			"may contain only one IP family when `ipFamilyPolicy` is 'SingleStack'"))
+	}
+
+	return allErrs
+}
+
 // There is much more nuance to this for a Service - like changing the Type - but let this be
 // pretty simple for now.
 func (v validator) validateAllowedChanges(ctx *pkgctx.WebhookRequestContext, vmService, oldVMService *vmopv1.VirtualMachineService) field.ErrorList {
@@ -285,6 +371,13 @@ func (v validator) validateAllowedChanges(ctx *pkgctx.WebhookRequestContext, vmS
 		allErrs = append(allErrs, field.Forbidden(specPath.Child("clusterIP"), "field is immutable"))
 	}
 
+	// Service's primary IP family cannot be changed through updates so neither should ours.
+	if oldIPFamilies := oldVMService.Spec.IPFamilies; len(oldIPFamilies) > 0 {
+		if ipFamilies := vmService.Spec.IPFamilies; len(ipFamilies) == 0 || ipFamilies[0] != oldIPFamilies[0] {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("ipFamilies").Index(0), "primary IP family is immutable"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -14,6 +14,7 @@ import (
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -91,6 +92,17 @@ func unitTestsValidateCreate() {
 		invalidClusterIP      bool
 		invalidLBSourceRanges bool
 		invalidExternalName   bool
+
+		sessionAffinityConfigWithoutClientIP bool
+		sessionAffinityConfigWithNone        bool
+		sessionAffinityClientIPExternalName  bool
+		externalTrafficPolicyClusterIP       bool
+		healthCheckNodePortCluster           bool
+		healthCheckNodePortLocal             bool
+		duplicateIPFamilies                  bool
+		singleStackDualIPFamilies            bool
+		dualStackIPFamilies                  bool
+		ipFamiliesExternalName               bool
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -123,6 +135,56 @@ func unitTestsValidateCreate() {
 			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
 			ctx.vmService.Spec.ExternalName = "InValid!"
 		}
+		if args.sessionAffinityConfigWithoutClientIP {
+			ctx.vmService.Spec.SessionAffinity = vmopv1.VirtualMachineServiceSessionAffinityClientIP
+			ctx.vmService.Spec.SessionAffinityConfig = &vmopv1.VirtualMachineServiceSessionAffinityConfig{}
+		}
+		if args.sessionAffinityConfigWithNone {
+			ctx.vmService.Spec.SessionAffinity = vmopv1.VirtualMachineServiceSessionAffinityNone
+			ctx.vmService.Spec.SessionAffinityConfig = &vmopv1.VirtualMachineServiceSessionAffinityConfig{
+				ClientIP: &vmopv1.VirtualMachineServiceClientIPConfig{},
+			}
+		}
+		if args.sessionAffinityClientIPExternalName {
+			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
+			ctx.vmService.Spec.ExternalName = "my-external-name"
+			ctx.vmService.Spec.SessionAffinity = vmopv1.VirtualMachineServiceSessionAffinityClientIP
+		}
+		if args.externalTrafficPolicyClusterIP {
+			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeClusterIP
+			ctx.vmService.Spec.ExternalTrafficPolicy = vmopv1.VirtualMachineServiceExternalTrafficPolicyLocal
+		}
+		if args.healthCheckNodePortCluster {
+			ctx.vmService.Spec.ExternalTrafficPolicy = vmopv1.VirtualMachineServiceExternalTrafficPolicyCluster
+			ctx.vmService.Spec.HealthCheckNodePort = 30042
+		}
+		if args.healthCheckNodePortLocal {
+			ctx.vmService.Spec.ExternalTrafficPolicy = vmopv1.VirtualMachineServiceExternalTrafficPolicyLocal
+			ctx.vmService.Spec.HealthCheckNodePort = 30042
+		}
+		if args.duplicateIPFamilies {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+		}
+		if args.singleStackDualIPFamilies || args.dualStackIPFamilies {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+				vmopv1.VirtualMachineServiceIPFamilyIPv6,
+			}
+			ctx.vmService.Spec.IPFamilyPolicy = ptr.To(vmopv1.VirtualMachineServiceIPFamilyPolicyPreferDualStack)
+			if args.singleStackDualIPFamilies {
+				ctx.vmService.Spec.IPFamilyPolicy = ptr.To(vmopv1.VirtualMachineServiceIPFamilyPolicySingleStack)
+			}
+		}
+		if args.ipFamiliesExternalName {
+			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
+			ctx.vmService.Spec.ExternalName = "my-external-name"
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmService)
 		Expect(err).ToNot(HaveOccurred())
@@ -154,6 +216,16 @@ func unitTestsValidateCreate() {
 		Entry("should deny invalid ClusterIP", createArgs{invalidClusterIP: true}, false, "spec.clusterIP: Invalid value: \"100.1000.1.1\": must be a valid IP address", nil),
 		Entry("should deny invalid LoadBalancerSourceRanges", createArgs{invalidLBSourceRanges: true}, false, `spec.loadBalancerSourceRanges[0]: Invalid value: "10.1.1.1/42": must be compatible with https://pkg.go.dev/net#ParseCIDR`, nil),
 		Entry("should deny invalid ExternalName", createArgs{invalidExternalName: true}, false, "spec.externalName: Invalid value: \"InValid!\": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters", nil),
+		Entry("should deny sessionAffinityConfig without clientIP", createArgs{sessionAffinityConfigWithoutClientIP: true}, false, "spec.sessionAffinityConfig.clientIP: Required value", nil),
+		Entry("should deny sessionAffinityConfig when sessionAffinity is None", createArgs{sessionAffinityConfigWithNone: true}, false, "spec.sessionAffinityConfig: Forbidden: may only be used when `sessionAffinity` is 'ClientIP'", nil),
+		Entry("should deny ClientIP sessionAffinity for ExternalName", createArgs{sessionAffinityClientIPExternalName: true}, false, "spec.sessionAffinity: Forbidden: may not be set to 'ClientIP' for ExternalName services", nil),
+		Entry("should deny externalTrafficPolicy for ClusterIP", createArgs{externalTrafficPolicyClusterIP: true}, false, "spec.externalTrafficPolicy: Forbidden: may only be used when `type` is 'LoadBalancer'", nil),
+		Entry("should deny healthCheckNodePort when externalTrafficPolicy is Cluster", createArgs{healthCheckNodePortCluster: true}, false, "spec.healthCheckNodePort: Forbidden: may only be used when `externalTrafficPolicy` is 'Local'", nil),
+		Entry("should allow healthCheckNodePort when externalTrafficPolicy is Local", createArgs{healthCheckNodePortLocal: true}, true, nil, nil),
+		Entry("should deny duplicate ipFamilies", createArgs{duplicateIPFamilies: true}, false, `spec.ipFamilies[1]: Duplicate value: "IPv4"`, nil),
+		Entry("should deny two ipFamilies with SingleStack", createArgs{singleStackDualIPFamilies: true}, false, "may contain only one IP family when `ipFamilyPolicy` is 'SingleStack'", nil),
+		Entry("should allow two ipFamilies with PreferDualStack", createArgs{dualStackIPFamilies: true}, true, nil, nil),
+		Entry("should deny ipFamilies for ExternalName", createArgs{ipFamiliesExternalName: true}, false, "spec.ipFamilies: Forbidden: may not be set for ExternalName services", nil),
 	)
 
 	validatePortCreate := func(expectedReason string, ports []vmopv1.VirtualMachineServicePort) {
@@ -256,6 +328,8 @@ func unitTestsValidateUpdate() {
 	type updateArgs struct {
 		updateType      bool
 		updateClusterIP bool
+		updateIPFamily  bool
+		addIPFamily     bool
 	}
 
 	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -267,6 +341,24 @@ func unitTestsValidateUpdate() {
 		if args.updateClusterIP {
 			ctx.vmService.Spec.ClusterIP = "9.9.9.9"
 		}
+		if args.updateIPFamily || args.addIPFamily {
+			ctx.oldVMService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(ctx.oldVMService)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		if args.updateIPFamily {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv6,
+			}
+		}
+		if args.addIPFamily {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+				vmopv1.VirtualMachineServiceIPFamilyIPv6,
+			}
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmService)
 		Expect(err).ToNot(HaveOccurred())
@@ -292,6 +384,8 @@ func unitTestsValidateUpdate() {
 		Entry("should allow", updateArgs{}, true, nil, nil),
 		Entry("should deny Type change", updateArgs{updateType: true}, false, "spec.type: Forbidden: field is immutable", nil),
 		Entry("should deny ClusterIP change", updateArgs{updateClusterIP: true}, false, "spec.clusterIP: Forbidden: field is immutable", nil),
+		Entry("should deny primary IP family change", updateArgs{updateIPFamily: true}, false, "spec.ipFamilies[0]: Forbidden: primary IP family is immutable", nil),
+		Entry("should allow secondary IP family addition", updateArgs{addIPFamily: true}, true, nil, nil),
 	)
 
 	When("the update is performed while object deletion", func() {
@@ -0,0 +1,151 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinesnapshotexport,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinesnapshotexports,versions=v1alpha6,name=default.validating.virtualmachinesnapshotexport.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineSnapshotExport validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineSnapshotExport{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	snapshotExport, err := v.snapshotExportFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if snapshotExport.Spec.SnapshotName == "" {
+		fieldErrs = append(fieldErrs, field.Required(specPath.Child("snapshotName"), "snapshotName must be provided"))
+	}
+
+	fieldErrs = append(fieldErrs, v.validateTarget(snapshotExport.Spec.Target, specPath.Child("target"))...)
+
+	for i, ns := range snapshotExport.Spec.ImportNamespaces {
+		if ns == "" {
+			fieldErrs = append(fieldErrs, field.Invalid(specPath.Child("importNamespaces").Index(i), ns, "namespace must not be empty"))
+		}
+	}
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineSnapshotExport update is
+// valid. The snapshot and target of an export may not be changed.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	snapshotExport, err := v.snapshotExportFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldSnapshotExport, err := v.snapshotExportFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(snapshotExport.Spec.SnapshotName, oldSnapshotExport.Spec.SnapshotName, specPath.Child("snapshotName"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(snapshotExport.Spec.Target, oldSnapshotExport.Spec.Target, specPath.Child("target"))...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateTarget(
+	target vmopv1.VirtualMachineSnapshotExportTarget,
+	targetPath *field.Path) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	switch {
+	case target.PersistentVolumeClaim == nil && target.OVA == nil:
+		allErrs = append(allErrs, field.Required(targetPath, "one of persistentVolumeClaim or ova must be provided"))
+	case target.PersistentVolumeClaim != nil && target.OVA != nil:
+		allErrs = append(allErrs, field.Forbidden(targetPath, "only one of persistentVolumeClaim or ova may be provided"))
+	case target.OVA != nil && target.OVA.ContentLibraryName == "":
+		allErrs = append(allErrs, field.Required(targetPath.Child("ova", "contentLibraryName"), "contentLibraryName must be provided"))
+	}
+
+	return allErrs
+}
+
+// snapshotExportFromUnstructured returns the VirtualMachineSnapshotExport
+// from the unstructured object.
+func (v validator) snapshotExportFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineSnapshotExport, error) {
+
+	snapshotExport := &vmopv1.VirtualMachineSnapshotExport{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), snapshotExport); err != nil {
+		return nil, err
+	}
+	return snapshotExport, nil
+}
@@ -0,0 +1,260 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	snapshotExport, oldSnapshotExport *vmopv1.VirtualMachineSnapshotExport
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	snapshotExport := builder.DummyVirtualMachineSnapshotExport(
+		"dummy-snapshot-export-namespace-for-webhook-validation",
+		"dummy-snapshot-export-for-webhook-validation",
+		"dummy-snapshot")
+	obj, err := builder.ToUnstructured(snapshotExport)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldSnapshotExport *vmopv1.VirtualMachineSnapshotExport
+		oldObj            *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldSnapshotExport = snapshotExport.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldSnapshotExport)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		snapshotExport:                      snapshotExport,
+		oldSnapshotExport:                   oldSnapshotExport,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.snapshotExport)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateCreate(&ctx.WebhookRequestContext)
+	})
+
+	When("the export is valid", func() {
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the export targets an OVA", func() {
+		BeforeEach(func() {
+			ctx.snapshotExport.Spec.Target = vmopv1.VirtualMachineSnapshotExportTarget{
+				OVA: &vmopv1.VirtualMachineSnapshotExportOVATarget{
+					ContentLibraryName: "my-library",
+				},
+			}
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+
+		When("the content library name is empty", func() {
+			BeforeEach(func() {
+				ctx.snapshotExport.Spec.Target.OVA.ContentLibraryName = ""
+			})
+
+			It("should deny the request", func() {
+				Expect(response.Allowed).To(BeFalse())
+				Expect(string(response.Result.Reason)).To(ContainSubstring("spec.target.ova.contentLibraryName: Required value"))
+			})
+		})
+	})
+
+	When("the snapshot name is empty", func() {
+		BeforeEach(func() {
+			ctx.snapshotExport.Spec.SnapshotName = ""
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.snapshotName: Required value"))
+		})
+	})
+
+	When("no target is specified", func() {
+		BeforeEach(func() {
+			ctx.snapshotExport.Spec.Target = vmopv1.VirtualMachineSnapshotExportTarget{}
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.target: Required value"))
+		})
+	})
+
+	When("both targets are specified", func() {
+		BeforeEach(func() {
+			ctx.snapshotExport.Spec.Target.OVA = &vmopv1.VirtualMachineSnapshotExportOVATarget{
+				ContentLibraryName: "my-library",
+			}
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.target: Forbidden"))
+		})
+	})
+
+	When("an import namespace is empty", func() {
+		BeforeEach(func() {
+			ctx.snapshotExport.Spec.ImportNamespaces = []string{"ns-1", ""}
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.importNamespaces[1]: Invalid value"))
+		})
+	})
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.snapshotExport)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the import namespaces are changed", func() {
+		BeforeEach(func() {
+			ctx.snapshotExport.Spec.ImportNamespaces = []string{"ns-1"}
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	DescribeTable("snapshot and target are immutable",
+		func(mutate func(*vmopv1.VirtualMachineSnapshotExport), field string) {
+			mutate(ctx.snapshotExport)
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.snapshotExport)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring(field + ": Invalid value"))
+		},
+		Entry("snapshotName",
+			func(e *vmopv1.VirtualMachineSnapshotExport) { e.Spec.SnapshotName = "other-snapshot" },
+			"spec.snapshotName"),
+		Entry("target",
+			func(e *vmopv1.VirtualMachineSnapshotExport) {
+				e.Spec.Target.PersistentVolumeClaim.StorageClass = "other"
+			},
+			"spec.target"),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
@@ -0,0 +1,130 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinesnapshotimport,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinesnapshotimports,versions=v1alpha6,name=default.validating.virtualmachinesnapshotimport.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineSnapshotImport validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineSnapshotImport{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	snapshotImport, err := v.snapshotImportFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	sourcePath := field.NewPath("spec", "source")
+
+	if snapshotImport.Spec.Source.Namespace == "" {
+		fieldErrs = append(fieldErrs, field.Required(sourcePath.Child("namespace"), "namespace must be provided"))
+	}
+	if snapshotImport.Spec.Source.Name == "" {
+		fieldErrs = append(fieldErrs, field.Required(sourcePath.Child("name"), "name must be provided"))
+	}
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineSnapshotImport update is
+// valid. The spec of an import may not be changed.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	snapshotImport, err := v.snapshotImportFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldSnapshotImport, err := v.snapshotImportFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(snapshotImport.Spec.Source, oldSnapshotImport.Spec.Source, specPath.Child("source"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(snapshotImport.Spec.VMName, oldSnapshotImport.Spec.VMName, specPath.Child("vmName"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(snapshotImport.Spec.ClassName, oldSnapshotImport.Spec.ClassName, specPath.Child("className"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(snapshotImport.Spec.StorageClass, oldSnapshotImport.Spec.StorageClass, specPath.Child("storageClass"))...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+// snapshotImportFromUnstructured returns the VirtualMachineSnapshotImport
+// from the unstructured object.
+func (v validator) snapshotImportFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineSnapshotImport, error) {
+
+	snapshotImport := &vmopv1.VirtualMachineSnapshotImport{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), snapshotImport); err != nil {
+		return nil, err
+	}
+	return snapshotImport, nil
+}
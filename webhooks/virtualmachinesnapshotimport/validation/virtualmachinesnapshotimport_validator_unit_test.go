@@ -0,0 +1,216 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	snapshotImport, oldSnapshotImport *vmopv1.VirtualMachineSnapshotImport
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	snapshotImport := builder.DummyVirtualMachineSnapshotImport(
+		"dummy-snapshot-import-namespace-for-webhook-validation",
+		"dummy-snapshot-import-for-webhook-validation",
+		"dummy-snapshot-export-namespace",
+		"dummy-snapshot-export")
+	obj, err := builder.ToUnstructured(snapshotImport)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldSnapshotImport *vmopv1.VirtualMachineSnapshotImport
+		oldObj            *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldSnapshotImport = snapshotImport.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldSnapshotImport)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		snapshotImport:                      snapshotImport,
+		oldSnapshotImport:                   oldSnapshotImport,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.snapshotImport)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateCreate(&ctx.WebhookRequestContext)
+	})
+
+	When("the import is valid", func() {
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the source namespace is empty", func() {
+		BeforeEach(func() {
+			ctx.snapshotImport.Spec.Source.Namespace = ""
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.source.namespace: Required value"))
+		})
+	})
+
+	When("the source name is empty", func() {
+		BeforeEach(func() {
+			ctx.snapshotImport.Spec.Source.Name = ""
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring("spec.source.name: Required value"))
+		})
+	})
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.snapshotImport)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the labels are changed", func() {
+		BeforeEach(func() {
+			ctx.snapshotImport.Labels = map[string]string{"foo": "bar"}
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	DescribeTable("spec is immutable",
+		func(mutate func(*vmopv1.VirtualMachineSnapshotImport), field string) {
+			mutate(ctx.snapshotImport)
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.snapshotImport)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(string(response.Result.Reason)).To(ContainSubstring(field + ": Invalid value"))
+		},
+		Entry("source",
+			func(i *vmopv1.VirtualMachineSnapshotImport) { i.Spec.Source.Name = "other-export" },
+			"spec.source"),
+		Entry("vmName",
+			func(i *vmopv1.VirtualMachineSnapshotImport) { i.Spec.VMName = "other-vm" },
+			"spec.vmName"),
+		Entry("className",
+			func(i *vmopv1.VirtualMachineSnapshotImport) { i.Spec.ClassName = "other-class" },
+			"spec.className"),
+		Entry("storageClass",
+			func(i *vmopv1.VirtualMachineSnapshotImport) { i.Spec.StorageClass = "other-storage-class" },
+			"spec.storageClass"),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
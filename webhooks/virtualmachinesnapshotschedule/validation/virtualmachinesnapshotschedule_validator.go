@@ -0,0 +1,222 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+
+	scheduleTimeZone       = "time zone must be specified with spec.timeZone"
+	scheduleNeverActivated = "schedule is never activated"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinesnapshotschedule,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinesnapshotschedules,versions=v1alpha6,name=default.validating.virtualmachinesnapshotschedule.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineSnapshotSchedule validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineSnapshotSchedule{}).Name())
+}
+
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	schedule, err := v.snapshotScheduleFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, schedule)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	schedule, err := v.snapshotScheduleFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	return v.validate(ctx, schedule)
+}
+
+func (v validator) validate(
+	ctx *pkgctx.WebhookRequestContext,
+	schedule *vmopv1.VirtualMachineSnapshotSchedule) admission.Response {
+
+	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, v.validateMetadata(ctx, schedule)...)
+	fieldErrs = append(fieldErrs, v.validateSelector(ctx, schedule)...)
+	fieldErrs = append(fieldErrs, v.validateSchedule(ctx, schedule)...)
+	fieldErrs = append(fieldErrs, v.validateRetention(ctx, schedule)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+// validateMetadata validates that the schedule's name may be used as the
+// value of the label that the snapshots created by the schedule have.
+func (v validator) validateMetadata(
+	_ *pkgctx.WebhookRequestContext,
+	schedule *vmopv1.VirtualMachineSnapshotSchedule) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if len(schedule.Name) > validation.LabelValueMaxLength {
+		allErrs = append(allErrs, field.TooLong(
+			field.NewPath("metadata", "name"), schedule.Name, validation.LabelValueMaxLength))
+	}
+
+	return allErrs
+}
+
+func (v validator) validateSelector(
+	_ *pkgctx.WebhookRequestContext,
+	schedule *vmopv1.VirtualMachineSnapshotSchedule) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if schedule.Spec.Selector == nil {
+		return allErrs
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(schedule.Spec.Selector); err != nil {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				field.NewPath("spec", "selector"),
+				schedule.Spec.Selector,
+				err.Error(),
+			),
+		)
+	}
+
+	return allErrs
+}
+
+func (v validator) validateSchedule(
+	_ *pkgctx.WebhookRequestContext,
+	schedule *vmopv1.VirtualMachineSnapshotSchedule) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	var (
+		s   cron.Schedule
+		err error
+	)
+	if strings.HasPrefix(schedule.Spec.Schedule, "TZ=") ||
+		strings.HasPrefix(schedule.Spec.Schedule, "CRON_TZ=") {
+
+		err = errors.New(scheduleTimeZone)
+	} else if s, err = cron.ParseStandard(schedule.Spec.Schedule); err == nil &&
+		s.Next(time.Now()).IsZero() {
+
+		err = errors.New(scheduleNeverActivated)
+	}
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("schedule"), schedule.Spec.Schedule, err.Error()))
+	}
+
+	if tz := schedule.Spec.TimeZone; tz != nil {
+		if _, err := time.LoadLocation(*tz); err != nil || *tz == "" {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("timeZone"), *tz, "unknown time zone"))
+		}
+	}
+
+	return allErrs
+}
+
+func (v validator) validateRetention(
+	_ *pkgctx.WebhookRequestContext,
+	schedule *vmopv1.VirtualMachineSnapshotSchedule) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	retention := schedule.Spec.Retention
+	if retention == nil {
+		return allErrs
+	}
+
+	retentionPath := field.NewPath("spec", "retention")
+
+	if retention.MaxCount != nil && *retention.MaxCount < 1 {
+		allErrs = append(allErrs, field.Invalid(
+			retentionPath.Child("maxCount"), *retention.MaxCount, "must be greater than 0"))
+	}
+
+	if retention.MaxAge != nil && retention.MaxAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(
+			retentionPath.Child("maxAge"), retention.MaxAge.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// snapshotScheduleFromUnstructured returns the VirtualMachineSnapshotSchedule
+// from the unstructured object.
+func (v validator) snapshotScheduleFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineSnapshotSchedule, error) {
+
+	schedule := &vmopv1.VirtualMachineSnapshotSchedule{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
@@ -0,0 +1,309 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+type testParams struct {
+	setup         func(ctx *unitValidatingWebhookContext)
+	validate      func(ctx *unitValidatingWebhookContext, response admission.Response)
+	expectAllowed bool
+}
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	schedule, oldSchedule *vmopv1.VirtualMachineSnapshotSchedule
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	schedule := builder.DummyVirtualMachineSnapshotSchedule(
+		"dummy-schedule-namespace-for-webhook-validation",
+		"dummy-schedule-for-webhook-validation")
+	schedule.Spec.Selector.MatchLabels = map[string]string{"foo": "bar"}
+	obj, err := builder.ToUnstructured(schedule)
+	Expect(err).ToNot(HaveOccurred())
+
+	var (
+		oldSchedule *vmopv1.VirtualMachineSnapshotSchedule
+		oldObj      *unstructured.Unstructured
+	)
+
+	if isUpdate {
+		oldSchedule = schedule.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldSchedule)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, nil...),
+		schedule:                            schedule,
+		oldSchedule:                         oldSchedule,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	doTest := func(args testParams) {
+		if args.setup != nil {
+			args.setup(ctx)
+		}
+
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+		Expect(response.Allowed).To(Equal(args.expectAllowed))
+
+		if args.validate != nil {
+			args.validate(ctx, response)
+		}
+	}
+
+	expectReason := func(reason string) func(*unitValidatingWebhookContext, admission.Response) {
+		return func(_ *unitValidatingWebhookContext, response admission.Response) {
+			Expect(string(response.Result.Reason)).To(ContainSubstring(reason))
+		}
+	}
+
+	DescribeTable("create table", doTest,
+		Entry("should allow a valid schedule",
+			testParams{
+				expectAllowed: true,
+			},
+		),
+		Entry("should allow a cron schedule with a time zone and retention policy",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Schedule = "0 2 * * mon-fri"
+					ctx.schedule.Spec.TimeZone = ptr.To("America/New_York")
+					ctx.schedule.Spec.Retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{
+						MaxCount: ptr.To[int32](7),
+						MaxAge:   &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					}
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny an invalid schedule",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Schedule = "0 25 * * *"
+				},
+				validate:      expectReason("spec.schedule: Invalid value: \"0 25 * * *\": end of range (25) above maximum (23): 25"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a schedule with a time zone",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Schedule = "CRON_TZ=Asia/Tokyo 0 2 * * *"
+				},
+				validate:      expectReason("spec.schedule: Invalid value: \"CRON_TZ=Asia/Tokyo 0 2 * * *\": time zone must be specified with spec.timeZone"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a schedule that is never activated",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Schedule = "0 0 31 2 *"
+				},
+				validate:      expectReason("spec.schedule: Invalid value: \"0 0 31 2 *\": schedule is never activated"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an unknown time zone",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.TimeZone = ptr.To("Nowhere/Special")
+				},
+				validate:      expectReason("spec.timeZone: Invalid value: \"Nowhere/Special\": unknown time zone"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a max count less than 1",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{
+						MaxCount: ptr.To[int32](0),
+					}
+				},
+				validate:      expectReason("spec.retention.maxCount: Invalid value: 0: must be greater than 0"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny a max age that is not positive",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Retention = &vmopv1.VirtualMachineSnapshotRetentionPolicy{
+						MaxAge: &metav1.Duration{},
+					}
+				},
+				validate:      expectReason("spec.retention.maxAge: Invalid value: \"0s\": must be greater than 0"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should allow a name that is the maximum length of a label value",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Name = strings.Repeat("a", 63)
+				},
+				expectAllowed: true,
+			},
+		),
+		Entry("should deny a name that is longer than the maximum length of a label value",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Name = strings.Repeat("a", 64)
+				},
+				validate:      expectReason("metadata.name: Too long"),
+				expectAllowed: false,
+			},
+		),
+		Entry("should deny an invalid selector",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.schedule.Spec.Selector = &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "foo",
+								Operator: "Unknown",
+							},
+						},
+					}
+				},
+				validate:      expectReason("spec.selector: Invalid value"),
+				expectAllowed: false,
+			},
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		response = ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+	})
+
+	When("the schedule is changed", func() {
+		BeforeEach(func() {
+			ctx.schedule.Spec.Schedule = "@daily"
+			ctx.schedule.Spec.Suspend = true
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+
+	When("the schedule is changed to an invalid value", func() {
+		BeforeEach(func() {
+			ctx.schedule.Spec.Schedule = "@never"
+		})
+
+		It("should deny the request", func() {
+			Expect(response.Allowed).To(BeFalse())
+		})
+	})
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Result).ToNot(BeNil())
+		})
+	})
+}
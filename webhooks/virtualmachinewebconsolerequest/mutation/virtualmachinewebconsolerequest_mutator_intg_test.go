@@ -0,0 +1,77 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Mutate",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Mutation,
+			testlabels.Webhook,
+		),
+		intgTestsMutating,
+	)
+}
+
+type intgMutatingWebhookContext struct {
+	builder.IntegrationTestContext
+	wcr *vmopv1.VirtualMachineWebConsoleRequest
+}
+
+func newIntgMutatingWebhookContext() *intgMutatingWebhookContext {
+	ctx := &intgMutatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	_, publicKeyPem := builder.WebConsoleRequestKeyPair()
+	ctx.wcr = builder.DummyVirtualMachineWebConsoleRequest(ctx.Namespace, "dummy-wcr", "dummy-vm", publicKeyPem)
+
+	return ctx
+}
+
+func intgTestsMutating() {
+	var (
+		ctx *intgMutatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgMutatingWebhookContext()
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("a web console request is created", func() {
+		BeforeEach(func() {
+			ctx.wcr.Annotations = map[string]string{
+				vmopv1.WebConsoleRequestRequesterAnnotation: "forged-user",
+			}
+		})
+
+		It("should set the requester annotation to the user that created it", func() {
+			Expect(ctx.Client.Create(ctx, ctx.wcr)).To(Succeed())
+
+			wcr := &vmopv1.VirtualMachineWebConsoleRequest{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(ctx.wcr), wcr)).To(Succeed())
+			Expect(wcr.Annotations).To(HaveKey(vmopv1.WebConsoleRequestRequesterAnnotation))
+			Expect(wcr.Annotations[vmopv1.WebConsoleRequestRequesterAnnotation]).ToNot(BeEmpty())
+			Expect(wcr.Annotations[vmopv1.WebConsoleRequestRequesterAnnotation]).ToNot(Equal("forged-user"))
+		})
+	})
+}
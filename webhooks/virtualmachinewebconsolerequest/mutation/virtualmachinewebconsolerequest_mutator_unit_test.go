@@ -0,0 +1,107 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinewebconsolerequest/mutation"
+)
+
+func unitTests() {
+	Describe(
+		"Mutate",
+		Label(
+			testlabels.Create,
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Mutation,
+			testlabels.Webhook,
+		),
+		unitTestsMutating,
+	)
+}
+
+type unitMutationWebhookContext struct {
+	builder.UnitTestContextForMutatingWebhook
+	wcr *vmopv1.VirtualMachineWebConsoleRequest
+}
+
+func newUnitTestContextForMutatingWebhook() *unitMutationWebhookContext {
+	_, publicKeyPem := builder.WebConsoleRequestKeyPair()
+	wcr := builder.DummyVirtualMachineWebConsoleRequest("dummy-ns", "dummy-wcr", "dummy-vm", publicKeyPem)
+	obj, err := builder.ToUnstructured(wcr)
+	Expect(err).ToNot(HaveOccurred())
+
+	return &unitMutationWebhookContext{
+		UnitTestContextForMutatingWebhook: *suite.NewUnitTestContextForMutatingWebhook(obj),
+		wcr:                               wcr,
+	}
+}
+
+func unitTestsMutating() {
+	const username = "sso:jdoe@vsphere.local"
+
+	var (
+		ctx *unitMutationWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForMutatingWebhook()
+		ctx.WebhookRequestContext.UserInfo.Username = username
+		rawObj, err := json.Marshal(ctx.wcr)
+		Expect(err).ToNot(HaveOccurred())
+		ctx.RawObj = rawObj
+	})
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	Describe("SetRequesterAnnotation", func() {
+		It("should set the requester annotation", func() {
+			Expect(mutation.SetRequesterAnnotation(&ctx.WebhookRequestContext, ctx.wcr)).To(BeTrue())
+			Expect(ctx.wcr.Annotations).To(HaveKeyWithValue(vmopv1.WebConsoleRequestRequesterAnnotation, username))
+		})
+
+		It("should overwrite a requester annotation set by the user", func() {
+			ctx.wcr.Annotations = map[string]string{
+				vmopv1.WebConsoleRequestRequesterAnnotation: "someone-else",
+			}
+			Expect(mutation.SetRequesterAnnotation(&ctx.WebhookRequestContext, ctx.wcr)).To(BeTrue())
+			Expect(ctx.wcr.Annotations).To(HaveKeyWithValue(vmopv1.WebConsoleRequestRequesterAnnotation, username))
+		})
+
+		It("should not mutate when the annotation is already the requester", func() {
+			ctx.wcr.Annotations = map[string]string{
+				vmopv1.WebConsoleRequestRequesterAnnotation: username,
+			}
+			Expect(mutation.SetRequesterAnnotation(&ctx.WebhookRequestContext, ctx.wcr)).To(BeFalse())
+		})
+	})
+
+	Describe("Mutate", func() {
+		It("should patch the requester annotation on create", func() {
+			ctx.WebhookRequestContext.Op = admissionv1.Create
+			response := ctx.Mutate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patches).ToNot(BeEmpty())
+		})
+
+		It("should not mutate on update", func() {
+			ctx.WebhookRequestContext.Op = admissionv1.Update
+			response := ctx.Mutate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patches).To(BeEmpty())
+		})
+	})
+}
@@ -34,6 +34,7 @@ const (
 // +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha6-virtualmachinewebconsolerequest,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinewebconsolerequests,versions=v1alpha6,name=default.validating.virtualmachinewebconsolerequest.v1alpha6.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinewebconsolerequests,verbs=get;list
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinewebconsolerequests/status,verbs=get
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // AddToManager adds the webhook to the provided manager.
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
@@ -46,13 +47,15 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) err
 }
 
 // NewValidator returns the package's Validator.
-func NewValidator(_ client.Client) builder.Validator {
+func NewValidator(client client.Client) builder.Validator {
 	return validator{
+		client:    client,
 		converter: runtime.DefaultUnstructuredConverter,
 	}
 }
 
 type validator struct {
+	client    client.Client
 	converter runtime.UnstructuredConverter
 }
 
@@ -97,6 +100,8 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 	fieldErrs = append(fieldErrs, v.validateImmutableFields(wcr, oldwcr)...)
 	fieldErrs = append(fieldErrs, v.validateUUIDLabel(wcr, oldwcr)...)
+	fieldErrs = append(fieldErrs, v.validateRequesterAnnotation(wcr, oldwcr)...)
+	fieldErrs = append(fieldErrs, v.validateRevoked(wcr, oldwcr)...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -116,6 +121,7 @@ func (v validator) validateSpec(ctx *pkgctx.WebhookRequestContext, wcr *vmopv1.V
 
 	fieldErrs = append(fieldErrs, v.validateVirtualMachineName(specPath.Child("Name"), wcr)...)
 	fieldErrs = append(fieldErrs, v.validatePublicKey(specPath.Child("publicKey"), wcr.Spec.PublicKey)...)
+	fieldErrs = append(fieldErrs, v.validateTTLSeconds(ctx, specPath.Child("ttlSeconds"), wcr)...)
 
 	return fieldErrs
 }
@@ -155,12 +161,45 @@ func (v validator) validatePublicKey(path *field.Path, publicKey string) field.E
 	return allErrs
 }
 
+func (v validator) validateTTLSeconds(
+	ctx *pkgctx.WebhookRequestContext,
+	path *field.Path,
+	wcr *vmopv1.VirtualMachineWebConsoleRequest) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if wcr.Spec.TTLSeconds == nil {
+		return allErrs
+	}
+
+	ttl := *wcr.Spec.TTLSeconds
+	if ttl <= 0 {
+		allErrs = append(allErrs, field.Invalid(path, ttl, "must be greater than 0"))
+		return allErrs
+	}
+
+	maxTTL, err := virtualmachinewebconsolerequest.GetMaxTTL(ctx, v.client, wcr.Namespace)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(path, err))
+		return allErrs
+	}
+
+	if maxSeconds := int64(maxTTL.Seconds()); ttl > maxSeconds {
+		allErrs = append(allErrs, field.Invalid(path, ttl,
+			fmt.Sprintf("must be less than or equal to %d, the maximum allowed by namespace %s",
+				maxSeconds, wcr.Namespace)))
+	}
+
+	return allErrs
+}
+
 func (v validator) validateImmutableFields(wcr, oldwcr *vmopv1.VirtualMachineWebConsoleRequest) field.ErrorList {
 	var allErrs field.ErrorList
 	specPath := field.NewPath("spec")
 
 	allErrs = append(allErrs, validation.ValidateImmutableField(wcr.Spec.Name, oldwcr.Spec.Name, specPath.Child("Name"))...)
 	allErrs = append(allErrs, validation.ValidateImmutableField(wcr.Spec.PublicKey, oldwcr.Spec.PublicKey, specPath.Child("publicKey"))...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(wcr.Spec.TTLSeconds, oldwcr.Spec.TTLSeconds, specPath.Child("ttlSeconds"))...)
 
 	return allErrs
 }
@@ -188,3 +227,28 @@ func (v validator) validateUUIDLabel(wcr, oldwcr *vmopv1.VirtualMachineWebConsol
 
 	return allErrs
 }
+
+func (v validator) validateRequesterAnnotation(wcr, oldwcr *vmopv1.VirtualMachineWebConsoleRequest) field.ErrorList {
+	var allErrs field.ErrorList
+
+	oldRequester, ok := oldwcr.Annotations[vmopv1.WebConsoleRequestRequesterAnnotation]
+	if !ok {
+		return allErrs
+	}
+
+	newRequester := wcr.Annotations[vmopv1.WebConsoleRequestRequesterAnnotation]
+	annotationsPath := field.NewPath("metadata", "annotations")
+	allErrs = append(allErrs, validation.ValidateImmutableField(newRequester, oldRequester, annotationsPath.Key(vmopv1.WebConsoleRequestRequesterAnnotation))...)
+
+	return allErrs
+}
+
+func (v validator) validateRevoked(wcr, oldwcr *vmopv1.VirtualMachineWebConsoleRequest) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if oldwcr.Spec.Revoked && !wcr.Spec.Revoked {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "revoked"), "a revoked request cannot be un-revoked"))
+	}
+
+	return allErrs
+}
@@ -6,17 +6,21 @@ package validation_test
 
 import (
 	"crypto/rsa"
+	"strconv"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha6"
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinewebconsolerequest"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -60,6 +64,8 @@ type unitValidatingWebhookContext struct {
 	privateKey *rsa.PrivateKey
 }
 
+const maxTTLSeconds = 600
+
 func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
 	privateKey, publicKeyPem := builder.WebConsoleRequestKeyPair()
 
@@ -67,6 +73,9 @@ func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhoo
 	wcr.Labels = map[string]string{
 		virtualmachinewebconsolerequest.UUIDLabelKey: "some-uuid",
 	}
+	wcr.Annotations = map[string]string{
+		vmopv1.WebConsoleRequestRequesterAnnotation: "some-user",
+	}
 	obj, err := builder.ToUnstructured(wcr)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -79,8 +88,17 @@ func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhoo
 		Expect(err).ToNot(HaveOccurred())
 	}
 
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: wcr.Namespace,
+			Annotations: map[string]string{
+				vmopv1.WebConsoleRequestMaxTTLSecondsAnnotation: strconv.Itoa(maxTTLSeconds),
+			},
+		},
+	}
+
 	return &unitValidatingWebhookContext{
-		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj),
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, ns),
 		wcr:                                 wcr,
 		oldWcr:                              oldWcr,
 		privateKey:                          privateKey,
@@ -96,6 +114,8 @@ func unitTestsValidateCreate() {
 		emptyVirtualMachineName bool
 		emptyPublicKey          bool
 		invalidPublicKey        bool
+		ttlSeconds              *int64
+		noNamespaceMaxTTL       bool
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -110,6 +130,15 @@ func unitTestsValidateCreate() {
 		if args.invalidPublicKey {
 			ctx.wcr.Spec.PublicKey = "invalid-public-key"
 		}
+		if args.ttlSeconds != nil {
+			ctx.wcr.Spec.TTLSeconds = args.ttlSeconds
+		}
+		if args.noNamespaceMaxTTL {
+			ns := &corev1.Namespace{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: ctx.wcr.Namespace}, ns)).To(Succeed())
+			ns.Annotations = nil
+			Expect(ctx.Client.Update(ctx, ns)).To(Succeed())
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.wcr)
 		Expect(err).ToNot(HaveOccurred())
@@ -136,6 +165,10 @@ func unitTestsValidateCreate() {
 		Entry("should deny empty virtualmachinename", createArgs{emptyVirtualMachineName: true}, false, "spec.Name: Required value", nil),
 		Entry("should deny empty publickey", createArgs{emptyPublicKey: true}, false, "spec.publicKey: Required value", nil),
 		Entry("should deny invalid publickey", createArgs{invalidPublicKey: true}, false, "spec.publicKey: Invalid value: \"\": invalid public key format", nil),
+		Entry("should allow ttlSeconds within namespace max", createArgs{ttlSeconds: ptr.To[int64](maxTTLSeconds)}, true, nil, nil),
+		Entry("should deny ttlSeconds greater than namespace max", createArgs{ttlSeconds: ptr.To[int64](maxTTLSeconds + 1)}, false, "spec.ttlSeconds: Invalid value: 601: must be less than or equal to 600, the maximum allowed by namespace some-namespace", nil),
+		Entry("should deny ttlSeconds greater than default when namespace has no max", createArgs{ttlSeconds: ptr.To[int64](121), noNamespaceMaxTTL: true}, false, "spec.ttlSeconds: Invalid value: 121: must be less than or equal to 120, the maximum allowed by namespace some-namespace", nil),
+		Entry("should deny zero ttlSeconds", createArgs{ttlSeconds: ptr.To[int64](0)}, false, "spec.ttlSeconds: Invalid value: 0: must be greater than 0", nil),
 	)
 }
 
@@ -149,6 +182,10 @@ func unitTestsValidateUpdate() {
 		updateVirtualMachineName bool
 		updatePublicKey          bool
 		updateUUIDLabel          bool
+		updateTTLSeconds         bool
+		updateRequester          bool
+		revoke                   bool
+		unrevoke                 bool
 	}
 
 	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -166,6 +203,24 @@ func unitTestsValidateUpdate() {
 			ctx.wcr.Labels[virtualmachinewebconsolerequest.UUIDLabelKey] = "new-uuid"
 		}
 
+		if args.updateTTLSeconds {
+			ctx.wcr.Spec.TTLSeconds = ptr.To[int64](60)
+		}
+
+		if args.updateRequester {
+			ctx.wcr.Annotations[vmopv1.WebConsoleRequestRequesterAnnotation] = "new-user"
+		}
+
+		if args.revoke {
+			ctx.wcr.Spec.Revoked = true
+		}
+
+		if args.unrevoke {
+			ctx.oldWcr.Spec.Revoked = true
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(ctx.oldWcr)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured((ctx.wcr))
 		Expect(err).ToNot(HaveOccurred())
 
@@ -191,6 +246,10 @@ func unitTestsValidateUpdate() {
 		Entry("should deny Virtualmachine Name change", updateArgs{updateVirtualMachineName: true}, false, "spec.Name: Invalid value: \"new-vm-name\": field is immutable", nil),
 		Entry("should deny PublicKey change", updateArgs{updatePublicKey: true}, false, "spec.publicKey: Invalid value: \"new-public-key\": field is immutable", nil),
 		Entry("should deny UUID label change", updateArgs{updateUUIDLabel: true}, false, "metadata.labels[vmoperator.vmware.com/webconsolerequest-uuid]: Invalid value: \"new-uuid\": field is immutable", nil),
+		Entry("should deny TTLSeconds change", updateArgs{updateTTLSeconds: true}, false, "spec.ttlSeconds: Invalid value: 60: field is immutable", nil),
+		Entry("should deny requester annotation change", updateArgs{updateRequester: true}, false, "metadata.annotations[vmoperator.vmware.com/webconsolerequest-requester]: Invalid value: \"new-user\": field is immutable", nil),
+		Entry("should allow revoke", updateArgs{revoke: true}, true, nil, nil),
+		Entry("should deny unrevoke", updateArgs{unrevoke: true}, false, "spec.revoked: Forbidden: a revoked request cannot be un-revoked", nil),
 	)
 
 	When("the update is performed while object deletion", func() {
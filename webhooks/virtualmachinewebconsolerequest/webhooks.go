@@ -8,11 +8,15 @@ import (
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinewebconsolerequest/mutation"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinewebconsolerequest/v1alpha1"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinewebconsolerequest/validation"
 )
 
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	if err := mutation.AddToManager(ctx, mgr); err != nil {
+		return err
+	}
 	if err := validation.AddToManager(ctx, mgr); err != nil {
 		return err
 	}
@@ -16,13 +16,23 @@ import (
 	"github.com/vmware-tanzu/vm-operator/webhooks/unifiedstoragequota"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachine"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachineclass"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinedeployment"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinedisruptionbudget"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinegroup"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinegrouppublishrequest"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinegroupsnapshot"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachineguestfiletransfer"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachineippool"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinenetworkpolicy"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinepublishrequest"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinereplicaset"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachineserialconsolerequest"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachineservice"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinesetresourcepolicy"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinesnapshot"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinesnapshotexport"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinesnapshotimport"
+	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinesnapshotschedule"
 	"github.com/vmware-tanzu/vm-operator/webhooks/virtualmachinewebconsolerequest"
 )
 
@@ -52,11 +62,34 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) err
 	if err := virtualmachinewebconsolerequest.AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to initialize VirtualMachineWebConsoleRequest webhooks: %w", err)
 	}
+	if pkgcfg.FromContext(ctx).Features.VMSerialConsole {
+		if err := virtualmachineserialconsolerequest.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSerialConsoleRequest webhooks: %w", err)
+		}
+	}
+	if err := virtualmachineguestfiletransfer.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize VirtualMachineGuestFileTransfer webhooks: %w", err)
+	}
+	if err := virtualmachineippool.AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed to initialize VirtualMachineIPPool webhooks: %w", err)
+	}
+
+	if pkgcfg.FromContext(ctx).Features.VMNetworkPolicies {
+		if err := virtualmachinenetworkpolicy.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineNetworkPolicy webhooks: %w", err)
+		}
+	}
 
 	if pkgcfg.FromContext(ctx).Features.K8sWorkloadMgmtAPI {
 		if err := virtualmachinereplicaset.AddToManager(ctx, mgr); err != nil {
 			return fmt.Errorf("failed to initialize VirtualMachineReplicaSet webhooks: %w", err)
 		}
+		if err := virtualmachinedeployment.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineDeployment webhooks: %w", err)
+		}
+		if err := virtualmachinedisruptionbudget.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineDisruptionBudget webhooks: %w", err)
+		}
 	}
 
 	if err := unifiedstoragequota.AddToManager(ctx, mgr); err != nil {
@@ -72,10 +105,25 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) err
 		}
 	}
 
+	if pkgcfg.FromContext(ctx).Features.VMGroups && pkgcfg.FromContext(ctx).Features.VMSnapshots {
+		if err := virtualmachinegroupsnapshot.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineGroupSnapshot webhooks: %w", err)
+		}
+	}
+
 	if pkgcfg.FromContext(ctx).Features.VMSnapshots {
 		if err := virtualmachinesnapshot.AddToManager(ctx, mgr); err != nil {
 			return fmt.Errorf("failed to initialize VirtualMachineSnapshot webhooks: %w", err)
 		}
+		if err := virtualmachinesnapshotexport.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSnapshotExport webhooks: %w", err)
+		}
+		if err := virtualmachinesnapshotimport.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSnapshotImport webhooks: %w", err)
+		}
+		if err := virtualmachinesnapshotschedule.AddToManager(ctx, mgr); err != nil {
+			return fmt.Errorf("failed to initialize VirtualMachineSnapshotSchedule webhooks: %w", err)
+		}
 	}
 
 	return nil